@@ -761,7 +761,7 @@ func (c *Converter) convertValue(vocabName string, v rdf.VocabularyValue) *gen.K
 
 // convertTypeToName makes a Titled version of the VocabularyType's name.
 func (c *Converter) convertTypeToName(v rdf.VocabularyType) string {
-	return strings.Title(v.Name)
+	return gen.CamelName(v.Name)
 }
 
 // propertyKinds determines what Kind names are referenced by the
@@ -1298,7 +1298,7 @@ var (
 func toIdentifier(n rdf.NameGetter) gen.Identifier {
 	return gen.Identifier{
 		LowerName: n.GetName(),
-		CamelName: strings.Title(n.GetName()),
+		CamelName: gen.CamelName(n.GetName()),
 	}
 }
 
@@ -1416,11 +1416,18 @@ func backPopulateProperty(r *rdf.RDFRegistry, p rdf.VocabularyProperty, genRefs
 	return
 }
 
+// unaliasedVocabularies are the vocabularies whose JSON-LD context defines all
+// of their terms, so they are used without alias.
+var unaliasedVocabularies = map[string]bool{
+	"activitystreams": true,
+	"securityv1":      true,
+}
+
 // vocabName turns a vocabulary name into an alias based on the capitalization
-// of the name. Note that "ActivityStreams" will not be aliased, it will return
-// an empty string.
+// of the name. Note that "ActivityStreams" and "SecurityV1" will not be
+// aliased, it will return an empty string.
 func vocabNameToAlias(name string) string {
-	if strings.ToLower(name) == "activitystreams" {
+	if unaliasedVocabularies[strings.ToLower(name)] {
 		return ""
 	}
 	s := ""
@@ -3,7 +3,6 @@ package gen
 import (
 	"fmt"
 	"github.com/dave/jennifer/jen"
-	"strings"
 )
 
 // GenerateConstants generates string constants for the type and property
@@ -21,16 +20,16 @@ func GenerateConstants(types []*TypeGenerator, props []*PropertyGenerator) (c []
 	for _, p := range props {
 		c = append(c,
 			jen.Commentf(
-				"%s%sPropertyName is the string literal of the name for the %s property in the %s vocabulary.", p.VocabName(), strings.Title(p.PropertyName()), p.PropertyName(), p.VocabName(),
+				"%s%sPropertyName is the string literal of the name for the %s property in the %s vocabulary.", p.VocabName(), CamelName(p.PropertyName()), p.PropertyName(), p.VocabName(),
 			).Line().Var().Id(
-				fmt.Sprintf("%s%sPropertyName", p.VocabName(), strings.Title(p.PropertyName())),
+				fmt.Sprintf("%s%sPropertyName", p.VocabName(), CamelName(p.PropertyName())),
 			).String().Op("=").Lit(p.PropertyName()))
 		if p.HasNaturalLanguageMap() {
 			c = append(c,
 				jen.Commentf(
-					"%s%sPropertyMapName is the string literal of the name for the %s property in the %s vocabulary when it is a natural language map.", p.VocabName(), strings.Title(p.PropertyName()), p.PropertyName(), p.VocabName(),
+					"%s%sPropertyMapName is the string literal of the name for the %s property in the %s vocabulary when it is a natural language map.", p.VocabName(), CamelName(p.PropertyName()), p.PropertyName(), p.VocabName(),
 				).Line().Var().Id(
-					fmt.Sprintf("%s%sPropertyMapName", p.VocabName(), strings.Title(p.PropertyName())),
+					fmt.Sprintf("%s%sPropertyMapName", p.VocabName(), CamelName(p.PropertyName())),
 				).String().Op("=").Lit(p.PropertyName()+"Map"))
 		}
 	}
@@ -491,6 +491,17 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 				).Op(":=").Id("m").Index(
 					jen.Id("propName"),
 				),
+				jen.If(
+					jen.Id("!ok").Op("&&").Len(jen.Id("alias")).Op(">").Lit(0),
+				).Block(
+					jen.Commentf("Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias."),
+					jen.List(
+						jen.Id("i"),
+						jen.Id("ok"),
+					).Op("=").Id("m").Index(
+						jen.Lit(p.PropertyName()),
+					),
+				),
 				mapProperty,
 				jen.If(jen.Id("ok")).Block(
 					p.wrapDeserializeCode(valueDeserializeFns, typeDeserializeFns),
@@ -1083,6 +1094,37 @@ func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExi
 		).Line()
 	}
 	if p.hasTypeKind() {
+		impliedType := jen.Empty()
+		if k, ok := p.onlyTypeKind(); ok {
+			impliedType = jen.If(
+				jen.List(
+					jen.Id("_"),
+					jen.Id("ok"),
+				).Op(":=").Id("m").Index(jen.Lit("type")),
+				jen.Op("!").Id("ok"),
+			).Block(
+				jen.If(
+					jen.List(
+						jen.Id("_"),
+						jen.Id("ok"),
+					).Op("=").Id("m").Index(jen.Lit(jsonLDTypeKeyword)),
+					jen.Op("!").Id("ok"),
+				).Block(
+					jen.Commentf("Values may omit their type, as they can only be a %s.", k.Name.LowerName),
+					jen.Id("typed").Op(":=").Make(jen.Map(jen.String()).Interface(), jen.Len(jen.Id("m")).Op("+").Lit(1)),
+					jen.For(
+						jen.List(
+							jen.Id("k"),
+							jen.Id("v"),
+						).Op(":=").Range().Id("m"),
+					).Block(
+						jen.Id("typed").Index(jen.Id("k")).Op("=").Id("v"),
+					),
+					jen.Id("typed").Index(jen.Lit("type")).Op("=").Lit(k.Name.LowerName),
+					jen.Id("m").Op("=").Id("typed"),
+				),
+			).Line()
+		}
 		iriCode = iriCode.If(
 			jen.List(
 				jen.Id("m"),
@@ -1090,6 +1132,7 @@ func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExi
 			).Op(":=").Id("i").Assert(jen.Map(jen.String()).Interface()),
 			jen.Id("ok"),
 		).Block(
+			impliedType,
 			typeExisting,
 		).Line()
 	}
@@ -1202,20 +1245,14 @@ func (p *FunctionalPropertyGenerator) hasValueKind() bool {
 
 // nameMethod returns the Name method for this functional property.
 func (p *FunctionalPropertyGenerator) nameMethod() *codegen.Method {
-	nameImpl := jen.Return(
-		jen.Lit(p.PropertyName()),
-	)
+	nameImpl := p.returnNameWithAlias(p.PropertyName())
 	if p.hasNaturalLanguageMap {
 		nameImpl = jen.If(
 			jen.Id(codegen.This()).Dot(isLanguageMapMethod).Call(),
 		).Block(
-			jen.Return(
-				jen.Lit(p.PropertyName() + "Map"),
-			),
+			p.returnNameWithAlias(p.PropertyName() + "Map"),
 		).Else().Block(
-			jen.Return(
-				jen.Lit(p.PropertyName()),
-			),
+			p.returnNameWithAlias(p.PropertyName()),
 		)
 	}
 	return codegen.NewCommentedValueMethod(
@@ -1227,6 +1264,6 @@ func (p *FunctionalPropertyGenerator) nameMethod() *codegen.Method {
 		[]jen.Code{
 			nameImpl,
 		},
-		fmt.Sprintf("%s returns the name of this property: %q.", nameMethod, p.PropertyName()),
+		fmt.Sprintf("%s returns the name of this property: %q, prefixed by the alias of its vocabulary if it has one.", nameMethod, p.PropertyName()),
 	)
 }
@@ -961,6 +961,17 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 			).Op(":=").Id("m").Index(
 				jen.Id("propName"),
 			),
+			jen.If(
+				jen.Id("!ok").Op("&&").Len(jen.Id("alias")).Op(">").Lit(0),
+			).Block(
+				jen.Commentf("Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias."),
+				jen.List(
+					jen.Id("i"),
+					jen.Id("ok"),
+				).Op("=").Id("m").Index(
+					jen.Lit(p.PropertyName()),
+				),
+			),
 			mapProperty,
 			jen.If(
 				jen.Id("ok"),
@@ -1029,22 +1040,16 @@ func (p *NonFunctionalPropertyGenerator) thisIRI() *jen.Statement {
 
 // nameMethod returns the Name method for this non-functional property.
 func (p *NonFunctionalPropertyGenerator) nameMethod() *codegen.Method {
-	nameImpl := jen.Return(
-		jen.Lit(p.PropertyName()),
-	)
+	nameImpl := p.returnNameWithAlias(p.PropertyName())
 	if p.hasNaturalLanguageMap {
 		nameImpl = jen.If(
 			jen.Id(codegen.This()).Dot(lenMethod).Call().Op("==").Lit(1).Op(
 				"&&",
 			).Id(codegen.This()).Dot(atMethodName).Call(jen.Lit(0)).Dot(isLanguageMapMethod).Call(),
 		).Block(
-			jen.Return(
-				jen.Lit(p.PropertyName() + "Map"),
-			),
+			p.returnNameWithAlias(p.PropertyName() + "Map"),
 		).Else().Block(
-			jen.Return(
-				jen.Lit(p.PropertyName()),
-			),
+			p.returnNameWithAlias(p.PropertyName()),
 		)
 	}
 	return codegen.NewCommentedValueMethod(
@@ -1056,6 +1061,6 @@ func (p *NonFunctionalPropertyGenerator) nameMethod() *codegen.Method {
 		[]jen.Code{
 			nameImpl,
 		},
-		fmt.Sprintf("%s returns the name of this property: %q.", nameMethod, p.PropertyName()),
+		fmt.Sprintf("%s returns the name of this property: %q, prefixed by the alias of its vocabulary if it has one.", nameMethod, p.PropertyName()),
 	)
 }
@@ -134,7 +134,7 @@ func (p Package) WriteDir() string {
 
 // Name returns the name of this package.
 func (p Package) Name() string {
-	return strings.Replace(p.name, "_", "", -1)
+	return strings.NewReplacer("_", "", "-", "").Replace(p.name)
 }
 
 // IsPublic returns whether this package is intended to house public files for
@@ -485,7 +485,7 @@ func genInit(pkg Package,
 func toPublicConstructor(vocabName string, m *ManagerGenerator, pg *PropertyGenerator) *codegen.Function {
 	return codegen.NewCommentedFunction(
 		m.pkg.Path(),
-		fmt.Sprintf("New%s%sProperty", vocabName, strings.Title(pg.PropertyName())),
+		fmt.Sprintf("New%s%sProperty", vocabName, CamelName(pg.PropertyName())),
 		/*params=*/ nil,
 		[]jen.Code{jen.Qual(pg.GetPublicPackage().Path(), pg.InterfaceName())},
 		[]jen.Code{
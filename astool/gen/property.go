@@ -77,6 +77,13 @@ type Identifier struct {
 	CamelName string
 }
 
+// CamelName converts a name in a vocabulary into one usable in identifiers in
+// code. Names may contain hyphens, such as "postal-code", which are removed
+// after capitalizing the letter following them.
+func CamelName(name string) string {
+	return strings.Replace(strings.Title(name), "-", "", -1)
+}
+
 // Kind is data that describes a concrete Go type, how to serialize and
 // deserialize such types, compare the types, and other meta-information to use
 // during Go code generation.
@@ -427,6 +434,22 @@ func (p *PropertyGenerator) commonMethods() (m []*codegen.Method) {
 	return m
 }
 
+// returnNameWithAlias returns code that returns the given name of this
+// property, prefixed with the alias of its vocabulary if it has one.
+func (p *PropertyGenerator) returnNameWithAlias(name string) jen.Code {
+	return jen.If(
+		jen.Len(jen.Id(codegen.This()).Dot(aliasMember)).Op(">").Lit(0),
+	).Block(
+		jen.Return(
+			jen.Id(codegen.This()).Dot(aliasMember).Op("+").Lit(":" + name),
+		),
+	).Else().Block(
+		jen.Return(
+			jen.Lit(name),
+		),
+	)
+}
+
 // isMethodName returns the identifier to use for methods that determine if a
 // property holds a specific Kind of value.
 func (p *PropertyGenerator) isMethodName(i int) string {
@@ -465,6 +488,20 @@ func (p *PropertyGenerator) hasURIKind() bool {
 	return false
 }
 
+// onlyTypeKind returns the Kind of the single type this property may be, if it
+// may not be any other type.
+func (p *PropertyGenerator) onlyTypeKind() (k Kind, ok bool) {
+	for _, kind := range p.kinds {
+		if kind.isValue() {
+			continue
+		} else if ok {
+			return Kind{}, false
+		}
+		k, ok = kind, true
+	}
+	return
+}
+
 // hasTypeKind returns true if this property has a Kind that is a type.
 func (p *PropertyGenerator) hasTypeKind() bool {
 	for _, k := range p.kinds {
@@ -281,9 +281,11 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 	aliasToId := make(map[string]string)
 	aliasFetching := jen.Empty()
 	impl := jen.Empty()
+	specificity := jen.Empty()
 	for i, t := range r.types {
 		if i > 0 {
 			impl = impl.Else()
+			specificity = specificity.Else()
 		}
 		// Get the vocab URI in http and https forms
 		vocabHttps := *t.vocabURI
@@ -325,6 +327,12 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 		}
 		// Fetch the identifier holding the alias for this vocabulary,
 		aliasId := aliasToId[vocabHttps.String()]
+		// The more types a type extends, the more specific it is.
+		specificity = specificity.If(
+			jen.Id("typeString").Op("==").Id(aliasId).Op("+").Lit(t.TypeName()),
+		).Block(
+			jen.Return(jen.Lit(len(t.getAllParentExtends(nil, t)))),
+		)
 		impl = impl.If(
 			jen.Id("typeString").Op("==").Id(aliasId).Op("+").Lit(t.TypeName()),
 		).Block(
@@ -409,11 +417,11 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				),
 			),
 			jen.Id("aliasMap").Op(":=").Id(toAliasMapFnName).Call(jen.Id("rawContext")),
+			aliasFetching,
 			jen.Commentf("Begin: Private lambda to handle a single string %q value. Makes code generation easier.", typePropertyName),
 			jen.Id("handleFn").Op(":=").Func().Parens(
 				jen.Id("typeString").String(),
 			).Error().Block(
-				aliasFetching,
 				impl.Else().Block(
 					jen.Return(
 						jen.Id(errorUnhandled),
@@ -421,6 +429,17 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				),
 			),
 			jen.Commentf("End: Private lambda"),
+			jen.Commentf("Begin: Private lambda to determine how specific a single string %q value is. Unknown types are the least specific.", typePropertyName),
+			jen.Id("specificityFn").Op(":=").Func().Parens(
+				jen.Id("typeString").String(),
+			).Int().Block(
+				specificity.Else().Block(
+					jen.Return(
+						jen.Lit(-1),
+					),
+				),
+			),
+			jen.Commentf("End: Private lambda"),
 			jen.If(
 				jen.List(
 					jen.Id("typeStr"),
@@ -438,6 +457,11 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				).Op(":=").Id("typeValue").Assert(jen.Index().Interface()),
 				jen.Id("ok"),
 			).Block(
+				jen.Id("typeStrs").Op(":=").Make(
+					jen.Index().String(),
+					jen.Lit(0),
+					jen.Len(jen.Id("typeIArr")),
+				),
 				jen.For(
 					jen.List(
 						jen.Id("_"),
@@ -451,25 +475,59 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 						).Op(":=").Id("typeI").Assert(jen.String()),
 						jen.Id("ok"),
 					).Block(
-						jen.If(
-							jen.List(
-								jen.Err(),
-							).Op(":=").Id("handleFn").Call(jen.Id("typeStr")),
-							jen.Err().Op("==").Nil(),
-						).Block(
-							jen.Return(jen.Nil()),
-						).Else().If(
-							jen.Err().Op("==").Id(errorUnhandled),
-						).Block(
-							jen.Commentf("Keep trying other types: only if all fail do we return this error."),
-							jen.Continue(),
-						).Else().Block(
-							jen.Return(jen.Err()),
+						jen.Id("typeStrs").Op("=").Append(
+							jen.Id("typeStrs"),
+							jen.Id("typeStr"),
 						),
 					),
 				),
+				jen.Commentf("Try the most specific types first, keeping the given order of equally specific types."),
+				jen.Qual("sort", "SliceStable").Call(
+					jen.Id("typeStrs"),
+					jen.Func().Parens(
+						jen.List(
+							jen.Id("i"),
+							jen.Id("j"),
+						).Int(),
+					).Bool().Block(
+						jen.Return(
+							jen.Id("specificityFn").Call(
+								jen.Id("typeStrs").Index(jen.Id("i")),
+							).Op(">").Id("specificityFn").Call(
+								jen.Id("typeStrs").Index(jen.Id("j")),
+							),
+						),
+					),
+				),
+				jen.Id("err").Op(":=").Id(errorUnhandled),
+				jen.For(
+					jen.List(
+						jen.Id("_"),
+						jen.Id("typeStr"),
+					).Op(":=").Range().Id("typeStrs"),
+				).Block(
+					jen.If(
+						jen.Id("e").Op(":=").Id("handleFn").Call(jen.Id("typeStr")),
+						jen.Id("e").Op("==").Nil(),
+					).Block(
+						jen.Return(jen.Nil()),
+					).Else().If(
+						jen.Id("e").Op("==").Id(errorUnhandled),
+					).Block(
+						jen.Commentf("Keep trying other types: only if all fail do we return this error."),
+						jen.Continue(),
+					).Else().If(
+						jen.Id("e").Op("==").Id(errorNoMatch),
+					).Block(
+						jen.Commentf("Keep trying less specific types, which may have a matching callback."),
+						jen.Id("err").Op("=").Id("e"),
+						jen.Continue(),
+					).Else().Block(
+						jen.Return(jen.Id("e")),
+					),
+				),
 				jen.Return(
-					jen.Id(errorUnhandled),
+					jen.Id("err"),
 				),
 			).Else().Block(
 				jen.Return(
@@ -477,7 +535,7 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				),
 			),
 		},
-		fmt.Sprintf("%s determines the ActivityStreams type of the payload, then applies the first callback function whose signature accepts the ActivityStreams value's type. This strictly assures that the callback function will only be passed ActivityStream objects whose type matches its interface. Returns an error if the ActivityStreams type does not match callbackers or is not a type handled by the generated code. If multiple types are present, it will check each one in order and apply only the first one. It returns an unhandled error for a multi-typed object if none of the types were able to be handled.", resolveMethod)))
+		fmt.Sprintf("%s determines the ActivityStreams type of the payload, then applies the first callback function whose signature accepts the ActivityStreams value's type. This strictly assures that the callback function will only be passed ActivityStream objects whose type matches its interface. Returns an error if the ActivityStreams type does not match callbackers or is not a type handled by the generated code. If multiple types are present, it will check the most specific known type first, falling back to less specific ones if no callback accepts it, and apply only the first one that succeeds. Equally specific types are checked in the order given. The value passed to the callback retains all of the types in its 'type' property. It returns an unhandled error for a multi-typed object if none of the types were able to be handled.", resolveMethod)))
 	return
 }
 
@@ -878,6 +936,11 @@ func (r *ResolverGenerator) toAliasFunction() *codegen.Function {
 					jen.Id("https").String(),
 				),
 			).Block(
+				jen.Commentf("Vocabularies such as vcard are commonly referred to with a trailing '#'."),
+				jen.Id("s").Op("=").Qual("strings", "TrimSuffix").Call(
+					jen.Id("s"),
+					jen.Lit("#"),
+				),
 				jen.If(
 					jen.Qual("strings", "HasPrefix").Call(
 						jen.Id("s"),
@@ -945,6 +1008,16 @@ func (r *ResolverGenerator) toAliasFunction() *codegen.Function {
 								jen.Id("val"),
 							).Op(":=").Range().Id("r"),
 						).Block(
+							jen.Commentf("A vocabulary used without an alias takes precedence over any alias for it."),
+							jen.If(
+								jen.List(
+									jen.Id("existing"),
+									jen.Id("ok"),
+								).Op(":=").Id("m").Index(jen.Id("k")),
+								jen.Id("ok").Op("&&").Id("existing").Op("==").Lit(""),
+							).Block(
+								jen.Continue(),
+							),
 							jen.Id("m").Index(
 								jen.Id("k"),
 							).Op("=").Id("val"),
@@ -959,13 +1032,25 @@ func (r *ResolverGenerator) toAliasFunction() *codegen.Function {
 							jen.Id("val"),
 						).Op(":=").Range().Id("v"),
 					).Block(
-						jen.Commentf("Only handle string aliases."),
-						jen.Switch(jen.Id("conc").Op(":=").Id("val").Assert(jen.Type())).Block(
-							jen.Case(jen.String()).Block(
-								jen.Id("m").Index(
-									jen.Id("k"),
-								).Op("=").Id("conc"),
-							),
+						jen.Commentf("Only handle string aliases of vocabularies."),
+						jen.Id("conc").Op(",").Id("ok").Op(":=").Id("val").Assert(jen.String()),
+						jen.If(jen.Op("!").Id("ok")).Block(
+							jen.Continue(),
+						),
+						jen.If(
+							jen.List(
+								jen.Id("ok"),
+								jen.Id("http"),
+								jen.Id("https"),
+							).Op(":=").Id("toHttpHttpsFn").Call(jen.Id("conc")),
+							jen.Id("ok"),
+						).Block(
+							jen.Id("m").Index(
+								jen.Id("http"),
+							).Op("=").Id("k"),
+							jen.Id("m").Index(
+								jen.Id("https"),
+							).Op("=").Id("k"),
 						),
 					),
 				),
@@ -6,7 +6,6 @@ import (
 	"github.com/go-fed/activity/astool/codegen"
 	"net/url"
 	"sort"
-	"strings"
 	"sync"
 )
 
@@ -29,6 +28,7 @@ const (
 	aliasMember                = "alias"
 	getMethodFormat            = "Get%s"
 	constructorName            = "New"
+	jsonLDTypeKeyword          = "@type"
 )
 
 const (
@@ -37,10 +37,12 @@ const (
 	//
 	// TODO: Figure out how to obtain these names at code-generation
 	// runtime.
-	typeMember    = "ActivityStreamsType"
-	getIdFunction = "GetActivityStreamsId"
-	setIdFunction = "SetActivityStreamsId"
-	idType        = "ActivityStreamsIdProperty"
+	typeMember      = "ActivityStreamsType"
+	getIdFunction   = "GetActivityStreamsId"
+	setIdFunction   = "SetActivityStreamsId"
+	idType          = "ActivityStreamsIdProperty"
+	getTypeFunction = "GetActivityStreamsType"
+	typeType        = "ActivityStreamsTypeProperty"
 )
 
 // typePropertyConstructorName returns the package variable name for the
@@ -79,6 +81,12 @@ func TypeInterface(pkg Package) *codegen.Interface {
 			Ret:     nil,
 			Comment: fmt.Sprintf("%s sets the \"id\" property.", setIdFunction),
 		},
+		{
+			Name:    getTypeFunction,
+			Params:  nil,
+			Ret:     []jen.Code{jen.Qual(pkg.Path(), typeType)},
+			Comment: fmt.Sprintf("%s returns the \"type\" property if it exists, and nil otherwise. It holds all of the types given, including any that are not known.", getTypeFunction),
+		},
 		{
 			Name:    contextMethod,
 			Params:  nil,
@@ -251,7 +259,7 @@ func (t *TypeGenerator) apply(m *ManagerGenerator) error {
 				continue
 			}
 			// Kluge: convert.toIdentifier must match this!
-			if e := p.SetKindFns(t.TypeName(), strings.Title(t.TypeName()), t.vocabName, kind, deser); e != nil {
+			if e := p.SetKindFns(t.TypeName(), CamelName(t.TypeName()), t.vocabName, kind, deser); e != nil {
 				return e
 			}
 			propsSet[p] = true
@@ -472,7 +480,7 @@ func (*TypeGenerator) memberName(p Property) string {
 	return fmt.Sprintf(
 		"%s%s",
 		p.VocabName(),
-		strings.Title(p.PropertyName()))
+		CamelName(p.PropertyName()))
 }
 
 // members returns all the properties this type has as its members.
@@ -851,6 +859,15 @@ func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
 	return
 }
 
+// aliasedTypeName returns the name of this type as it is serialized by
+// default, prefixed by the alias of its vocabulary if it has one.
+func (t *TypeGenerator) aliasedTypeName() string {
+	if len(t.vocabAlias) > 0 {
+		return t.vocabAlias + ":" + t.TypeName()
+	}
+	return t.TypeName()
+}
+
 // deserializationFn returns free function reference that can be used to
 // treat a TypeGenerator as another property's Kind.
 func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
@@ -873,15 +890,20 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 			).Line())
 	}
 	deserCode = deserCode.Commentf("End: Known property deserialization").Line()
-	knownProps := jen.Commentf("Begin: Code that ensures a property name is unknown").Line()
-	for i, prop := range t.allProperties() {
-		if i > 0 {
-			knownProps = knownProps.Else()
-		}
-		knownProps = knownProps.If(
+	knownProps := jen.Commentf("Begin: Code that ensures a property name is unknown").Line().If(
+		jen.Id("k").Op("==").Lit(jsonLDTypeKeyword),
+	).Block(
+		jen.Continue(),
+	)
+	for _, prop := range t.allProperties() {
+		knownProps = knownProps.Else().If(
 			jen.Id("k").Op("==").Lit(prop.PropertyName()),
 		).Block(
 			jen.Continue(),
+		).Else().If(
+			jen.Id(codegen.This()).Dot(t.memberName(prop)).Op("!=").Nil().Op("&&").Id("k").Op("==").Id(codegen.This()).Dot(t.memberName(prop)).Dot(nameMethod).Call(),
+		).Block(
+			jen.Continue(),
 		)
 		if prop.HasNaturalLanguageMap() {
 			knownProps = knownProps.Else().If(
@@ -923,11 +945,20 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 				jen.Id(aliasMember):   jen.Id("alias"),
 				jen.Id(unknownMember): jen.Make(jen.Map(jen.String()).Interface()),
 			}),
+			jen.List(
+				jen.Id("typeValue"),
+				jen.Id("ok"),
+			).Op(":=").Id("m").Index(jen.Lit("type")),
 			jen.If(
+				jen.Op("!").Id("ok"),
+			).Block(
+				jen.Commentf("Some implementations use the JSON-LD keyword instead."),
 				jen.List(
 					jen.Id("typeValue"),
 					jen.Id("ok"),
-				).Op(":=").Id("m").Index(jen.Lit("type")),
+				).Op("=").Id("m").Index(jen.Lit(jsonLDTypeKeyword)),
+			),
+			jen.If(
 				jen.Op("!").Id("ok"),
 			).Block(
 				jen.Return(
@@ -1081,7 +1112,7 @@ func (t *TypeGenerator) constructorFn() *codegen.Function {
 		},
 		[]jen.Code{
 			jen.Id("typeProp").Op(":=").Id(typePropertyConstructorName()).Call(),
-			jen.Id("typeProp").Dot("AppendXMLSchemaString").Call(jen.Lit(t.TypeName())),
+			jen.Id("typeProp").Dot("AppendXMLSchemaString").Call(jen.Lit(t.aliasedTypeName())),
 			jen.Return(
 				jen.Op("&").Qual(t.PrivatePackage().Path(), t.StructName()).Values(
 					jen.Dict{
@@ -0,0 +1,141 @@
+// Package generate exposes the astool code generation pipeline as a Go API.
+//
+// Applications use it to generate Go code for their own extension
+// vocabularies, without needing to invoke the astool command. The input format
+// of the specifications is the same one the astool command accepts, and is
+// documented in the astool README.
+//
+// Extensions of ActivityStreams must be preceded by the ActivityStreams
+// specification, so that the generated resolvers and type predicates handle
+// both the core and the extension types.
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/astool/convert"
+	"github.com/go-fed/activity/astool/gen"
+	"github.com/go-fed/activity/astool/rdf"
+	"github.com/go-fed/activity/astool/rdf/owl"
+	"github.com/go-fed/activity/astool/rdf/rdfs"
+	"github.com/go-fed/activity/astool/rdf/rfc"
+	"github.com/go-fed/activity/astool/rdf/schema"
+	"github.com/go-fed/activity/astool/rdf/xsd"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// NewRegistry returns a registry with the built-in knowledge of the OWL2,
+// RDF, RDF Schema, Schema.org, XML Schema, and RFC ontologies that are needed
+// to parse specifications.
+func NewRegistry() (*rdf.RDFRegistry, error) {
+	r := rdf.NewRDFRegistry()
+	for _, o := range []rdf.Ontology{
+		&xsd.XMLOntology{Package: "xml"},
+		&owl.OWLOntology{},
+		&rdf.RDFOntology{Package: "rdf"},
+		&rdfs.RDFSchemaOntology{},
+		&schema.SchemaOntology{},
+		&rfc.RFCOntology{Package: "rfc"},
+	} {
+		if err := r.AddOntology(o); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Config determines what code is generated.
+type Config struct {
+	// Specs are the JSON-LD specifications to generate code for, in order
+	// of derivation: a specification may only depend on the ones before
+	// it.
+	Specs [][]byte
+	// Path is the Go import path of the directory the generated code will
+	// be written to, such as "github.com/me/myapp/vocab".
+	Path string
+	// Types optionally restricts code generation to the named types, and
+	// the types they extend from. If empty, all types are generated.
+	Types []string
+	// Properties optionally restricts code generation to the named
+	// properties. If empty, all properties applicable to the generated
+	// types are generated.
+	Properties []string
+}
+
+// File is a generated Go source file.
+type File struct {
+	// Directory is the location of this file, relative to the directory
+	// the generated code is written to.
+	Directory string
+	// FileName is the name of this file.
+	FileName string
+	// Contents is the Go source code.
+	Contents []byte
+}
+
+// Generate parses the specifications and generates the Go code for them.
+func Generate(c Config) ([]*File, error) {
+	if len(c.Specs) == 0 {
+		return nil, fmt.Errorf("no specifications to generate code for")
+	}
+	inputs := make([]rdf.JSONLD, 0, len(c.Specs))
+	for i, b := range c.Specs {
+		var j rdf.JSONLD
+		if err := json.Unmarshal(b, &j); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal specification at %d: %s", i, err)
+		}
+		inputs = append(inputs, j)
+	}
+	registry, err := NewRegistry()
+	if err != nil {
+		return nil, err
+	}
+	p, err := rdf.ParseVocabularies(registry, inputs)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Types) > 0 || len(c.Properties) > 0 {
+		if err := p.Subset(c.Types, c.Properties); err != nil {
+			return nil, err
+		}
+	}
+	conv := &convert.Converter{
+		GenRoot:       gen.NewPackageManager(c.Path, ""),
+		PackagePolicy: convert.IndividualUnderRoot,
+	}
+	cf, err := conv.Convert(p)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*File, 0, len(cf))
+	for _, f := range cf {
+		var b bytes.Buffer
+		if err := f.F.Render(&b); err != nil {
+			return nil, fmt.Errorf("cannot render %s: %s", f.FileName, err)
+		}
+		files = append(files, &File{
+			Directory: f.Directory,
+			FileName:  f.FileName,
+			Contents:  b.Bytes(),
+		})
+	}
+	return files, nil
+}
+
+// Write saves the generated files under the directory dir, creating any
+// subdirectories as needed.
+func Write(dir string, files []*File) error {
+	for _, f := range files {
+		d := filepath.Join(dir, filepath.FromSlash(f.Directory))
+		if err := os.MkdirAll(d, 0777); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(d, f.FileName), f.Contents, 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package generate
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testSpec is a vocabulary extending ActivityStreams with a type of object and
+// a functional property of it.
+const testSpec = `{
+  "@context": [
+    {
+      "as": "https://www.w3.org/ns/activitystreams",
+      "owl": "http://www.w3.org/2002/07/owl#",
+      "rdf": "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+      "rdfs": "http://www.w3.org/2000/01/rdf-schema#",
+      "schema": "http://schema.org/",
+      "xsd": "http://www.w3.org/2001/XMLSchema#"
+    },
+    {
+      "domain": "rdfs:domain",
+      "members": "owl:members",
+      "name": "schema:name",
+      "notes": "rdfs:comment",
+      "range": "rdfs:range",
+      "subClassOf": "rdfs:subClassOf",
+      "disjointWith": "owl:disjointWith",
+      "unionOf": "owl:unionOf",
+      "url": "schema:URL"
+    }
+  ],
+  "id": "https://example.com/ns#",
+  "type": "owl:Ontology",
+  "name": "Test",
+  "members": [
+    {
+      "id": "https://example.com/ns#Widget",
+      "type": "owl:Class",
+      "notes": "A widget.",
+      "subClassOf": {
+        "type": "owl:Class",
+        "url": "https://www.w3.org/TR/activitystreams-vocabulary/#dfn-object",
+        "name": "as:Object"
+      },
+      "disjointWith": [],
+      "name": "Widget",
+      "url": "https://example.com/ns#Widget"
+    },
+    {
+      "id": "https://example.com/ns#color",
+      "type": [
+        "rdf:Property",
+        "owl:FunctionalProperty"
+      ],
+      "notes": "The color of a widget.",
+      "domain": {
+        "type": "owl:Class",
+        "unionOf": {
+          "type": "owl:Class",
+          "url": "https://example.com/ns#Widget",
+          "name": "Widget"
+        }
+      },
+      "range": {
+        "type": "owl:Class",
+        "unionOf": {
+          "type": "owl:Class",
+          "url": "http://www.w3.org/2001/XMLSchema#string",
+          "name": "xsd:string"
+        }
+      },
+      "name": "color",
+      "url": "https://example.com/ns#color"
+    }
+  ]
+}`
+
+// generateTestSpec generates the code of testSpec, preceded by the
+// ActivityStreams specification it extends.
+func generateTestSpec(t *testing.T) []*File {
+	t.Helper()
+	as, err := ioutil.ReadFile("../activitystreams.jsonld")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := Generate(Config{
+		Specs:      [][]byte{as, []byte(testSpec)},
+		Path:       "example.com/vocab",
+		Types:      []string{"Widget"},
+		Properties: []string{"color"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// findFile returns the generated file in the directory, or nil.
+func findFile(files []*File, dir, name string) *File {
+	for _, f := range files {
+		if f.Directory == dir && f.FileName == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func TestGenerate(t *testing.T) {
+	files := generateTestSpec(t)
+	t.Run("GeneratesType", func(t *testing.T) {
+		for _, test := range []struct {
+			dir      string
+			name     string
+			contains string
+		}{
+			{"impl/test/type_widget", "gen_type_test_widget.go", "func (this TestWidget) GetTestColor() vocab.TestColorProperty {"},
+			{"impl/test/property_color", "gen_property_test_color.go", "type TestColorProperty struct {"},
+			{"vocab", "gen_type_test_widget_interface.go", "type TestWidget interface {"},
+			{"", "gen_pkg_test_type_constructors.go", "func NewTestWidget() vocab.TestWidget {"},
+		} {
+			f := findFile(files, test.dir, test.name)
+			if f == nil {
+				t.Errorf("%s/%s was not generated", test.dir, test.name)
+			} else if !bytes.Contains(f.Contents, []byte(test.contains)) {
+				t.Errorf("%s/%s does not contain %q", test.dir, test.name, test.contains)
+			}
+		}
+	})
+	t.Run("GeneratesOnlySubset", func(t *testing.T) {
+		for _, f := range files {
+			if strings.Contains(f.Directory, "type_note") || strings.Contains(f.Directory, "property_content") {
+				t.Errorf("%s/%s is outside of the subset", f.Directory, f.FileName)
+			}
+		}
+	})
+	t.Run("GeneratesGoSource", func(t *testing.T) {
+		fset := token.NewFileSet()
+		for _, f := range files {
+			if _, err := parser.ParseFile(fset, f.FileName, f.Contents, 0); err != nil {
+				t.Errorf("%s/%s: %s", f.Directory, f.FileName, err)
+			}
+		}
+	})
+}
+
+func TestGenerateWithoutSpecs(t *testing.T) {
+	if _, err := Generate(Config{Path: "example.com/vocab"}); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := []*File{
+		{Directory: "", FileName: "gen_doc.go", Contents: []byte("package vocab\n")},
+		{Directory: "impl/test/type_widget", FileName: "gen_pkg.go", Contents: []byte("package typewidget\n")},
+	}
+	if err := Write(dir, files); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		b, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(f.Directory), f.FileName))
+		if err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(b, f.Contents) {
+			t.Errorf("%s/%s: got %q, want %q", f.Directory, f.FileName, b, f.Contents)
+		}
+	}
+}
@@ -6,22 +6,20 @@ import (
 	"fmt"
 	"github.com/go-fed/activity/astool/convert"
 	"github.com/go-fed/activity/astool/gen"
+	"github.com/go-fed/activity/astool/generate"
 	"github.com/go-fed/activity/astool/rdf"
-	"github.com/go-fed/activity/astool/rdf/owl"
-	"github.com/go-fed/activity/astool/rdf/rdfs"
-	"github.com/go-fed/activity/astool/rdf/rfc"
-	"github.com/go-fed/activity/astool/rdf/schema"
-	"github.com/go-fed/activity/astool/rdf/xsd"
 	"io/ioutil"
 	"os"
 	"strings"
 )
 
 const (
-	pathFlag = "path"
-	specFlag = "spec"
-	helpText = `
-Usage: astool [-spec=<file>] [-path=<gopath prefix>] <directory>
+	pathFlag     = "path"
+	specFlag     = "spec"
+	typeFlag     = "type"
+	propertyFlag = "property"
+	helpText     = `
+Usage: astool [-spec=<file>] [-path=<gopath prefix>] [-type=<names>] [-property=<names>] <directory>
 
 The ActivityStreams tool (astool) is used to generate ActivityStreams types,
 properties, and values from an OWL2 RDF specification. The tool generates the
@@ -137,6 +135,18 @@ specification uses a definition that the tool doesn't currently know. In such a
 case, please file an issue at https://github.com/go-fed/activity in order to
 include the missing definition.
 
+Applications that only use a handful of types may generate just those, which
+reduces the size of the generated code and the time needed to compile it. The
+types they extend from are always generated, as are the 'id' and 'type'
+properties:
+
+    astool -spec activitystreams.jsonld -type Note,Person,Create,Follow .
+
+By default, every property applicable to the generated types is also
+generated. To only generate specific properties, list them as well:
+
+    astool -spec activitystreams.jsonld -type Note,Create -property to,cc,object,content .
+
 Experimental support for generating the code as a module is provided by settting
 the 'path' flag, which will prefix all generated code with the 'path':
 
@@ -151,17 +161,6 @@ the 'path' flag, which will prefix all generated code with the 'path':
 // certain ontologies being aliased in some specifications and not others.
 var registry *rdf.RDFRegistry
 
-// mustAddOntology ensures that the registry global variable is not nil, and
-// then adds the specific ontology or panics if it cannot.
-func mustAddOntology(o rdf.Ontology) {
-	if registry == nil {
-		registry = rdf.NewRDFRegistry()
-	}
-	if err := registry.AddOntology(o); err != nil {
-		panic(err)
-	}
-}
-
 // At init time, get our built-in knowledge of OWL and other RDF ontologies
 // into the registry, before main executes.
 func init() {
@@ -171,12 +170,10 @@ func init() {
 			helpText)
 		flag.PrintDefaults()
 	}
-	mustAddOntology(&xsd.XMLOntology{Package: "xml"})
-	mustAddOntology(&owl.OWLOntology{})
-	mustAddOntology(&rdf.RDFOntology{Package: "rdf"})
-	mustAddOntology(&rdfs.RDFSchemaOntology{})
-	mustAddOntology(&schema.SchemaOntology{})
-	mustAddOntology(&rfc.RFCOntology{Package: "rfc"})
+	var err error
+	if registry, err = generate.NewRegistry(); err != nil {
+		panic(err)
+	}
 }
 
 // list is a flag-friendly comma-separated list of strings. Also allows multiple
@@ -226,8 +223,10 @@ func (s settableString) IsSet() bool {
 // CommandLineFlags manages the flags defined by this tool.
 type CommandLineFlags struct {
 	// Flags
-	specs list
-	path  settableString
+	specs      list
+	path       settableString
+	types      list
+	properties list
 	// Additional data
 	pathAutoDetected bool
 	// Destination on the file system for the code generation
@@ -244,6 +243,8 @@ func NewCommandLineFlags() (*CommandLineFlags, error) {
 		pathFlag,
 		"Package path to use for all generated package paths. If using GOPATH, this is automatically detected as $GOPATH/<path>/ when generating in a subdirectory. Cannot be explicitly set to be empty.")
 	flag.Var(&(c.specs), specFlag, "Input JSON-LD specification used to generate Go code.")
+	flag.Var(&(c.types), typeFlag, "Names of the only types to generate, along with the types they extend. If empty, all types are generated.")
+	flag.Var(&(c.properties), propertyFlag, "Names of the only properties to generate. If empty, all properties of the generated types are generated.")
 	flag.Parse()
 	args := flag.Args()
 	if len(args) != 1 {
@@ -337,6 +338,21 @@ func (c *CommandLineFlags) Path() string {
 	return c.path.String()
 }
 
+// IsSubset returns true if only some types or properties are to be generated.
+func (c *CommandLineFlags) IsSubset() bool {
+	return len(c.types) > 0 || len(c.properties) > 0
+}
+
+// Types returns the type flag.
+func (c *CommandLineFlags) Types() []string {
+	return c.types
+}
+
+// Properties returns the property flag.
+func (c *CommandLineFlags) Properties() []string {
+	return c.properties
+}
+
 // NewPackageManager creates the correct package manager for the flag inputs.
 func (c *CommandLineFlags) NewPackageManager() *gen.PackageManager {
 	g := gen.NewPackageManager(c.Path(), "")
@@ -389,6 +405,15 @@ func main() {
 		panic(err)
 	}
 
+	// Only keep the requested types and properties
+	if cmd.IsSubset() {
+		fmt.Printf("Subsetting vocabularies...\n")
+		if err := p.Subset(cmd.Types(), cmd.Properties()); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
 	// Convert to generated code
 	fmt.Printf("Converting %d types, properties, and values...\n", p.Size())
 	c := &convert.Converter{
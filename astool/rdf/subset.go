@@ -0,0 +1,237 @@
+package rdf
+
+import (
+	"fmt"
+)
+
+const (
+	// idPropertyName and typePropertyName are always kept when subsetting,
+	// since every generated type relies on them.
+	idPropertyName   = "id"
+	typePropertyName = "type"
+)
+
+// subsetKey uniquely identifies a type or property across the specifications
+// in a ParsedVocabulary.
+type subsetKey struct {
+	vocab string
+	name  string
+}
+
+// subsetter holds the scratch state while computing a subset of a
+// ParsedVocabulary.
+type subsetter struct {
+	// specs are the vocabularies that came from specifications, keyed by
+	// their URI as found in a ParsedVocabulary's Order. Intrinsically
+	// known vocabularies are not included, and are never pruned.
+	specs map[string]*Vocabulary
+	// keepTypes and keepProps are the types and properties to keep.
+	keepTypes map[subsetKey]bool
+	keepProps map[subsetKey]bool
+}
+
+// Subset prunes the specification vocabularies so that only the named types
+// and properties, and the ones they require, are kept. This reduces the size
+// of the generated code for applications that only use a small part of a
+// vocabulary.
+//
+// Every type that a named type extends from is kept. If no type names are
+// given, every type is kept. If no property names are given, every property
+// whose domain includes a kept type is kept. Otherwise only the named
+// properties are kept. The 'id' and 'type' properties are always kept.
+//
+// Properties whose range is entirely made of pruned types are also pruned.
+//
+// Returns an error if a name is not found in any specification vocabulary.
+func (p *ParsedVocabulary) Subset(typeNames, propertyNames []string) error {
+	s := &subsetter{
+		specs:     make(map[string]*Vocabulary, len(p.Order)),
+		keepTypes: make(map[subsetKey]bool),
+		keepProps: make(map[subsetKey]bool),
+	}
+	for i, uri := range p.Order {
+		if i == len(p.Order)-1 {
+			s.specs[uri] = &p.Vocab
+		} else {
+			s.specs[uri] = p.References[uri]
+		}
+	}
+	// Determine the types to keep.
+	if len(typeNames) == 0 {
+		for uri, v := range s.specs {
+			for name := range v.Types {
+				s.keepTypes[subsetKey{uri, name}] = true
+			}
+		}
+	}
+	for _, name := range typeNames {
+		found := false
+		for uri, v := range s.specs {
+			if _, ok := v.Types[name]; ok {
+				found = true
+				if err := s.keepTypeAndParents(subsetKey{uri, name}); err != nil {
+					return err
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("cannot subset: no type named %q", name)
+		}
+	}
+	// Determine the properties to keep.
+	for _, name := range propertyNames {
+		found := false
+		for uri, v := range s.specs {
+			if _, ok := v.Properties[name]; ok {
+				found = true
+				s.keepProps[subsetKey{uri, name}] = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("cannot subset: no property named %q", name)
+		}
+	}
+	for uri, v := range s.specs {
+		for name, prop := range v.Properties {
+			k := subsetKey{uri, name}
+			if name == idPropertyName || name == typePropertyName {
+				s.keepProps[k] = true
+				continue
+			} else if len(propertyNames) > 0 {
+				continue
+			}
+			for _, d := range prop.Domain {
+				if dk, ok := s.resolve(v, d); ok && s.keepTypes[dk] {
+					s.keepProps[k] = true
+					break
+				}
+			}
+		}
+	}
+	// Prune the ranges of the kept properties, dropping properties that
+	// can no longer hold any value.
+	for uri, v := range s.specs {
+		for name, prop := range v.Properties {
+			k := subsetKey{uri, name}
+			if !s.keepProps[k] {
+				continue
+			}
+			hadRange := len(prop.Range) > 0
+			prop.Range = s.filterTypes(v, prop.Range)
+			prop.Domain = s.filterTypes(v, prop.Domain)
+			prop.DoesNotApplyTo = s.filterTypes(v, prop.DoesNotApplyTo)
+			if hadRange && len(prop.Range) == 0 {
+				delete(s.keepProps, k)
+				continue
+			}
+			v.Properties[name] = prop
+		}
+	}
+	// Prune the references on the kept types.
+	for uri, v := range s.specs {
+		for name, t := range v.Types {
+			if !s.keepTypes[subsetKey{uri, name}] {
+				continue
+			}
+			t.Properties = s.filterProperties(v, t.Properties)
+			t.WithoutProperties = s.filterProperties(v, t.WithoutProperties)
+			t.DisjointWith = s.filterTypes(v, t.DisjointWith)
+			v.Types[name] = t
+		}
+	}
+	// Finally, remove everything that is not kept.
+	for uri, v := range s.specs {
+		for name := range v.Types {
+			if !s.keepTypes[subsetKey{uri, name}] {
+				delete(v.Types, name)
+			}
+		}
+		for name := range v.Properties {
+			if !s.keepProps[subsetKey{uri, name}] {
+				delete(v.Properties, name)
+			}
+		}
+	}
+	return nil
+}
+
+// keepTypeAndParents marks the type as kept, as well as all of the types it
+// extends from.
+func (s *subsetter) keepTypeAndParents(k subsetKey) error {
+	if s.keepTypes[k] {
+		return nil
+	}
+	s.keepTypes[k] = true
+	v := s.specs[k.vocab]
+	t, ok := v.Types[k.name]
+	if !ok {
+		return fmt.Errorf("cannot subset: type %q extends from unknown type", k.name)
+	}
+	for _, ext := range t.Extends {
+		if ek, ok := s.resolve(v, ext); ok {
+			if err := s.keepTypeAndParents(ek); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolve determines the key of a reference made from within the vocabulary
+// v. Returns false if the reference is to a vocabulary that is not a
+// specification, which is never pruned.
+func (s *subsetter) resolve(v *Vocabulary, r VocabularyReference) (subsetKey, bool) {
+	if len(r.Vocab) == 0 {
+		for uri, spec := range s.specs {
+			if spec == v {
+				return subsetKey{uri, r.Name}, true
+			}
+		}
+		return subsetKey{}, false
+	}
+	uri := r.Vocab
+	if v.Registry != nil {
+		if u, err := v.Registry.ResolveAlias(r.Vocab); err == nil {
+			uri = u
+		}
+	}
+	if _, ok := s.specs[uri]; ok {
+		return subsetKey{uri, r.Name}, true
+	}
+	httpURI, httpsURI, err := ToHttpAndHttps(uri)
+	if err != nil {
+		return subsetKey{}, false
+	}
+	if _, ok := s.specs[httpURI]; ok {
+		return subsetKey{httpURI, r.Name}, true
+	} else if _, ok := s.specs[httpsURI]; ok {
+		return subsetKey{httpsURI, r.Name}, true
+	}
+	return subsetKey{}, false
+}
+
+// filterTypes removes references to types that are not kept. References to
+// values and to vocabularies that are not specifications are left alone.
+func (s *subsetter) filterTypes(v *Vocabulary, refs []VocabularyReference) []VocabularyReference {
+	var out []VocabularyReference
+	for _, r := range refs {
+		k, ok := s.resolve(v, r)
+		if !ok || s.keepTypes[k] {
+			out = append(out, r)
+		} else if _, isType := s.specs[k.vocab].Types[k.name]; !isType {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// filterProperties removes references to properties that are not kept.
+func (s *subsetter) filterProperties(v *Vocabulary, refs []VocabularyReference) []VocabularyReference {
+	var out []VocabularyReference
+	for _, r := range refs {
+		if k, ok := s.resolve(v, r); !ok || s.keepProps[k] {
+			out = append(out, r)
+		}
+	}
+	return out
+}
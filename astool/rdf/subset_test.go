@@ -0,0 +1,142 @@
+package rdf
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+const testSubsetURI = "https://example.com/ns"
+
+// testSubsetVocabulary creates a vocabulary with a small hierarchy of types:
+// Like extends Activity, which with Note extends Object, and Widget extends
+// nothing.
+func testSubsetVocabulary() *ParsedVocabulary {
+	ref := func(names ...string) (refs []VocabularyReference) {
+		for _, n := range names {
+			refs = append(refs, VocabularyReference{Name: n})
+		}
+		return
+	}
+	xsdString := []VocabularyReference{{Name: "string", Vocab: "xsd"}}
+	return &ParsedVocabulary{
+		Vocab: Vocabulary{
+			Name: "Test",
+			Types: map[string]VocabularyType{
+				"Object":   {Name: "Object"},
+				"Activity": {Name: "Activity", Extends: ref("Object")},
+				"Like":     {Name: "Like", Extends: ref("Activity")},
+				"Note":     {Name: "Note", Extends: ref("Object"), DisjointWith: ref("Widget")},
+				"Widget":   {Name: "Widget", DisjointWith: ref("Note")},
+			},
+			Properties: map[string]VocabularyProperty{
+				"id":      {Name: "id", Domain: ref("Object")},
+				"type":    {Name: "type", Domain: ref("Object")},
+				"name":    {Name: "name", Domain: ref("Object"), Range: xsdString},
+				"content": {Name: "content", Domain: ref("Note"), Range: xsdString},
+				"object":  {Name: "object", Domain: ref("Activity"), Range: ref("Object")},
+				"subject": {Name: "subject", Domain: ref("Object"), Range: ref("Widget", "Note")},
+				"gadget":  {Name: "gadget", Domain: ref("Note"), Range: ref("Widget")},
+			},
+		},
+		References: map[string]*Vocabulary{},
+		Order:      []string{testSubsetURI},
+	}
+}
+
+// sortedKeys returns the sorted names of the types or properties.
+func sortedKeys(m interface{}) []string {
+	var names []string
+	switch v := m.(type) {
+	case map[string]VocabularyType:
+		for n := range v {
+			names = append(names, n)
+		}
+	case map[string]VocabularyProperty:
+		for n := range v {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestSubset(t *testing.T) {
+	tests := []struct {
+		name          string
+		typeNames     []string
+		propertyNames []string
+		wantErr       bool
+		wantTypes     string
+		wantProps     string
+		// wantRanges are the names of the ranges of kept properties.
+		wantRanges map[string]string
+	}{
+		{
+			name:      "KeepsParentTypes",
+			typeNames: []string{"Like"},
+			wantTypes: "[Activity Like Object]",
+			wantProps: "[id name object type]",
+		},
+		{
+			name:          "KeepsEveryTypeWithoutTypeNames",
+			propertyNames: []string{"content"},
+			wantTypes:     "[Activity Like Note Object Widget]",
+			wantProps:     "[content id type]",
+		},
+		{
+			name:       "PrunesRanges",
+			typeNames:  []string{"Note"},
+			wantTypes:  "[Note Object]",
+			wantProps:  "[content id name subject type]",
+			wantRanges: map[string]string{"subject": "[Note]", "name": "[string]"},
+		},
+		{
+			name:          "PrunesPropertiesWithoutRange",
+			typeNames:     []string{"Note"},
+			propertyNames: []string{"gadget", "content"},
+			wantTypes:     "[Note Object]",
+			wantProps:     "[content id type]",
+		},
+		{
+			name:      "ErrorOnUnknownType",
+			typeNames: []string{"Nothing"},
+			wantErr:   true,
+		},
+		{
+			name:          "ErrorOnUnknownProperty",
+			typeNames:     []string{"Note"},
+			propertyNames: []string{"nothing"},
+			wantErr:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := testSubsetVocabulary()
+			err := p.Subset(test.typeNames, test.propertyNames)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprint(sortedKeys(p.Vocab.Types)); got != test.wantTypes {
+				t.Errorf("got types %s, want %s", got, test.wantTypes)
+			}
+			if got := fmt.Sprint(sortedKeys(p.Vocab.Properties)); got != test.wantProps {
+				t.Errorf("got properties %s, want %s", got, test.wantProps)
+			}
+			for name, want := range test.wantRanges {
+				var got []string
+				for _, r := range p.Vocab.Properties[name].Range {
+					got = append(got, r.Name)
+				}
+				if fmt.Sprint(got) != want {
+					t.Errorf("got range %v of %s, want %s", got, name, want)
+				}
+			}
+		})
+	}
+}
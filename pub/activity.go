@@ -6,7 +6,9 @@ import (
 
 // Activity represents any ActivityStreams Activity type.
 //
-// The Activity types provided in the streams package implement this.
+// The Activity types provided in the streams package implement this. Since
+// intransitive activities such as Arrive, Travel, and Question have no "object"
+// property, it is not part of this interface.
 type Activity interface {
 	// Activity is also a vocab.Type
 	vocab.Type
@@ -31,13 +33,8 @@ type Activity interface {
 	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
 	// it exists, and nil otherwise.
 	GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty
-	// GetActivityStreamsObject returns the "object" property if it exists,
-	// and nil otherwise.
-	GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty
 	// SetActivityStreamsActor sets the "actor" property.
 	SetActivityStreamsActor(i vocab.ActivityStreamsActorProperty)
-	// SetActivityStreamsObject sets the "object" property.
-	SetActivityStreamsObject(i vocab.ActivityStreamsObjectProperty)
 	// SetActivityStreamsTo sets the "to" property.
 	SetActivityStreamsTo(i vocab.ActivityStreamsToProperty)
 	// SetActivityStreamsAttributedTo sets the "attributedTo" property.
@@ -0,0 +1,559 @@
+// The gRPC service of the operations of an Actor, served by the actorgrpc
+// package. Regenerate the Go code after changing it, in this directory:
+//
+//   protoc --go_out=. --go_opt=paths=source_relative \
+//     --go-grpc_out=. --go-grpc_opt=paths=source_relative actor.proto
+//
+// Callers authenticate with an OAuth access token in the "authorization"
+// metadata, as "Bearer <token>".
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: actor.proto
+
+package actorgrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubmitRequest submits an activity or object to an outbox.
+type SubmitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IRI of the outbox to submit to.
+	Outbox string `protobuf:"bytes,1,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	// The serialized activity or object. Objects are wrapped in a Create
+	// activity.
+	Activity []byte `protobuf:"bytes,2,opt,name=activity,proto3" json:"activity,omitempty"`
+}
+
+func (x *SubmitRequest) Reset() {
+	*x = SubmitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_actor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRequest) ProtoMessage() {}
+
+func (x *SubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_actor_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRequest) Descriptor() ([]byte, []int) {
+	return file_actor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitRequest) GetOutbox() string {
+	if x != nil {
+		return x.Outbox
+	}
+	return ""
+}
+
+func (x *SubmitRequest) GetActivity() []byte {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+// FollowRequest follows an actor on behalf of the owner of an outbox.
+type FollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IRI of the outbox of the follower.
+	Outbox string `protobuf:"bytes,1,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	// The IRI of the follower.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The IRI of the actor to follow.
+	Object string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_actor_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_actor_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_actor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FollowRequest) GetOutbox() string {
+	if x != nil {
+		return x.Outbox
+	}
+	return ""
+}
+
+func (x *FollowRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *FollowRequest) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+// BlockRequest blocks an actor on behalf of the owner of an outbox.
+type BlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IRI of the outbox of the blocker.
+	Outbox string `protobuf:"bytes,1,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	// The IRI of the blocker.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The IRI of the actor to block.
+	Object string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_actor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_actor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_actor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockRequest) GetOutbox() string {
+	if x != nil {
+		return x.Outbox
+	}
+	return ""
+}
+
+func (x *BlockRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BlockRequest) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+// ActivityResponse holds the activity sent by a request.
+type ActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized activity, with the ids assigned to it.
+	Activity []byte `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+}
+
+func (x *ActivityResponse) Reset() {
+	*x = ActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_actor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityResponse) ProtoMessage() {}
+
+func (x *ActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_actor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityResponse.ProtoReflect.Descriptor instead.
+func (*ActivityResponse) Descriptor() ([]byte, []int) {
+	return file_actor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityResponse) GetActivity() []byte {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+// InboxRequest queries an inbox.
+type InboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IRI of the inbox to query.
+	Inbox string `protobuf:"bytes,1,opt,name=inbox,proto3" json:"inbox,omitempty"`
+}
+
+func (x *InboxRequest) Reset() {
+	*x = InboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_actor_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxRequest) ProtoMessage() {}
+
+func (x *InboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_actor_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxRequest.ProtoReflect.Descriptor instead.
+func (*InboxRequest) Descriptor() ([]byte, []int) {
+	return file_actor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InboxRequest) GetInbox() string {
+	if x != nil {
+		return x.Inbox
+	}
+	return ""
+}
+
+// InboxResponse holds the inbox queried.
+type InboxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized OrderedCollectionPage of the inbox.
+	Page []byte `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *InboxResponse) Reset() {
+	*x = InboxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_actor_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxResponse) ProtoMessage() {}
+
+func (x *InboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_actor_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxResponse.ProtoReflect.Descriptor instead.
+func (*InboxResponse) Descriptor() ([]byte, []int) {
+	return file_actor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InboxResponse) GetPage() []byte {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_actor_proto protoreflect.FileDescriptor
+
+var file_actor_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x67,
+	0x6f, 0x66, 0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x22, 0x43, 0x0a,
+	0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x22, 0x55, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x54, 0x0a, 0x0c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x2e, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22,
+	0x24, 0x0a, 0x0c, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x62, 0x6f, 0x78, 0x22, 0x23, 0x0a, 0x0d, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x32, 0xac, 0x02, 0x0a, 0x05, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x1d,
+	0x2e, 0x67, 0x6f, 0x66, 0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x67, 0x6f, 0x66, 0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x66, 0x65,
+	0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x6f, 0x66, 0x65, 0x64,
+	0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x66, 0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x67, 0x6f, 0x66, 0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x66, 0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x66,
+	0x65, 0x64, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x62, 0x6f,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x66, 0x65, 0x64, 0x2f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2f, 0x70, 0x75, 0x62, 0x2f, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_actor_proto_rawDescOnce sync.Once
+	file_actor_proto_rawDescData = file_actor_proto_rawDesc
+)
+
+func file_actor_proto_rawDescGZIP() []byte {
+	file_actor_proto_rawDescOnce.Do(func() {
+		file_actor_proto_rawDescData = protoimpl.X.CompressGZIP(file_actor_proto_rawDescData)
+	})
+	return file_actor_proto_rawDescData
+}
+
+var file_actor_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_actor_proto_goTypes = []any{
+	(*SubmitRequest)(nil),    // 0: gofed.actorrpc.SubmitRequest
+	(*FollowRequest)(nil),    // 1: gofed.actorrpc.FollowRequest
+	(*BlockRequest)(nil),     // 2: gofed.actorrpc.BlockRequest
+	(*ActivityResponse)(nil), // 3: gofed.actorrpc.ActivityResponse
+	(*InboxRequest)(nil),     // 4: gofed.actorrpc.InboxRequest
+	(*InboxResponse)(nil),    // 5: gofed.actorrpc.InboxResponse
+}
+var file_actor_proto_depIdxs = []int32{
+	0, // 0: gofed.actorrpc.Actor.Submit:input_type -> gofed.actorrpc.SubmitRequest
+	1, // 1: gofed.actorrpc.Actor.Follow:input_type -> gofed.actorrpc.FollowRequest
+	2, // 2: gofed.actorrpc.Actor.Block:input_type -> gofed.actorrpc.BlockRequest
+	4, // 3: gofed.actorrpc.Actor.Inbox:input_type -> gofed.actorrpc.InboxRequest
+	3, // 4: gofed.actorrpc.Actor.Submit:output_type -> gofed.actorrpc.ActivityResponse
+	3, // 5: gofed.actorrpc.Actor.Follow:output_type -> gofed.actorrpc.ActivityResponse
+	3, // 6: gofed.actorrpc.Actor.Block:output_type -> gofed.actorrpc.ActivityResponse
+	5, // 7: gofed.actorrpc.Actor.Inbox:output_type -> gofed.actorrpc.InboxResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_actor_proto_init() }
+func file_actor_proto_init() {
+	if File_actor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_actor_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_actor_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*FollowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_actor_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_actor_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ActivityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_actor_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*InboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_actor_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*InboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_actor_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_actor_proto_goTypes,
+		DependencyIndexes: file_actor_proto_depIdxs,
+		MessageInfos:      file_actor_proto_msgTypes,
+	}.Build()
+	File_actor_proto = out.File
+	file_actor_proto_rawDesc = nil
+	file_actor_proto_goTypes = nil
+	file_actor_proto_depIdxs = nil
+}
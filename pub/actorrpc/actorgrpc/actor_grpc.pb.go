@@ -0,0 +1,256 @@
+// The gRPC service of the operations of an Actor, served by the actorgrpc
+// package. Regenerate the Go code after changing it, in this directory:
+//
+//   protoc --go_out=. --go_opt=paths=source_relative \
+//     --go-grpc_out=. --go-grpc_opt=paths=source_relative actor.proto
+//
+// Callers authenticate with an OAuth access token in the "authorization"
+// metadata, as "Bearer <token>".
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: actor.proto
+
+package actorgrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Actor_Submit_FullMethodName = "/gofed.actorrpc.Actor/Submit"
+	Actor_Follow_FullMethodName = "/gofed.actorrpc.Actor/Follow"
+	Actor_Block_FullMethodName  = "/gofed.actorrpc.Actor/Block"
+	Actor_Inbox_FullMethodName  = "/gofed.actorrpc.Actor/Inbox"
+)
+
+// ActorClient is the client API for Actor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Actor sends activities on behalf of actors, and reads their inboxes.
+type ActorClient interface {
+	// Submit sends an activity or object to an outbox.
+	Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*ActivityResponse, error)
+	// Follow sends a Follow activity of an actor, addressed to it.
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*ActivityResponse, error)
+	// Block sends an unaddressed Block activity of an actor.
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*ActivityResponse, error)
+	// Inbox returns the inbox of an actor.
+	Inbox(ctx context.Context, in *InboxRequest, opts ...grpc.CallOption) (*InboxResponse, error)
+}
+
+type actorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewActorClient(cc grpc.ClientConnInterface) ActorClient {
+	return &actorClient{cc}
+}
+
+func (c *actorClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*ActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityResponse)
+	err := c.cc.Invoke(ctx, Actor_Submit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actorClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*ActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityResponse)
+	err := c.cc.Invoke(ctx, Actor_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actorClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*ActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityResponse)
+	err := c.cc.Invoke(ctx, Actor_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actorClient) Inbox(ctx context.Context, in *InboxRequest, opts ...grpc.CallOption) (*InboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxResponse)
+	err := c.cc.Invoke(ctx, Actor_Inbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ActorServer is the server API for Actor service.
+// All implementations must embed UnimplementedActorServer
+// for forward compatibility.
+//
+// Actor sends activities on behalf of actors, and reads their inboxes.
+type ActorServer interface {
+	// Submit sends an activity or object to an outbox.
+	Submit(context.Context, *SubmitRequest) (*ActivityResponse, error)
+	// Follow sends a Follow activity of an actor, addressed to it.
+	Follow(context.Context, *FollowRequest) (*ActivityResponse, error)
+	// Block sends an unaddressed Block activity of an actor.
+	Block(context.Context, *BlockRequest) (*ActivityResponse, error)
+	// Inbox returns the inbox of an actor.
+	Inbox(context.Context, *InboxRequest) (*InboxResponse, error)
+	mustEmbedUnimplementedActorServer()
+}
+
+// UnimplementedActorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedActorServer struct{}
+
+func (UnimplementedActorServer) Submit(context.Context, *SubmitRequest) (*ActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (UnimplementedActorServer) Follow(context.Context, *FollowRequest) (*ActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedActorServer) Block(context.Context, *BlockRequest) (*ActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedActorServer) Inbox(context.Context, *InboxRequest) (*InboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inbox not implemented")
+}
+func (UnimplementedActorServer) mustEmbedUnimplementedActorServer() {}
+func (UnimplementedActorServer) testEmbeddedByValue()               {}
+
+// UnsafeActorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActorServer will
+// result in compilation errors.
+type UnsafeActorServer interface {
+	mustEmbedUnimplementedActorServer()
+}
+
+func RegisterActorServer(s grpc.ServiceRegistrar, srv ActorServer) {
+	// If the following call pancis, it indicates UnimplementedActorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Actor_ServiceDesc, srv)
+}
+
+func _Actor_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActorServer).Submit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Actor_Submit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActorServer).Submit(ctx, req.(*SubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Actor_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActorServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Actor_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActorServer).Follow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Actor_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActorServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Actor_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActorServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Actor_Inbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActorServer).Inbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Actor_Inbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActorServer).Inbox(ctx, req.(*InboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Actor_ServiceDesc is the grpc.ServiceDesc for Actor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Actor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gofed.actorrpc.Actor",
+	HandlerType: (*ActorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Submit",
+			Handler:    _Actor_Submit_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _Actor_Follow_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _Actor_Block_Handler,
+		},
+		{
+			MethodName: "Inbox",
+			Handler:    _Actor_Inbox_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "actor.proto",
+}
@@ -0,0 +1,146 @@
+// Package actorgrpc serves an actorrpc.Service with gRPC, for applications
+// whose web frontend is a separate process from the federation daemon and
+// already speaks gRPC. Its service is defined in actor.proto, from which
+// clients in other languages are generated too.
+//
+// The daemon registers the Service with a gRPC server:
+//
+//	s := grpc.NewServer(grpc.Creds(creds))
+//	actorgrpc.RegisterActorServer(s, actorgrpc.NewServer(actorrpc.NewService(actor, db, auth)))
+//	err := s.Serve(l)
+//
+// And the frontend calls it with the access token of the actor it acts as:
+//
+//	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds), grpc.WithPerRPCCredentials(actorgrpc.Token(accessToken)))
+//	// Handle error
+//	resp, err := actorgrpc.NewActorClient(conn).Inbox(ctx, &actorgrpc.InboxRequest{Inbox: inbox})
+//
+// It is a module of its own, so applications using only the pub package do
+// not depend on gRPC.
+package actorgrpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-fed/activity/pub/actorrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authorizationMetadata is the metadata carrying the access token.
+	authorizationMetadata = "authorization"
+	// bearerPrefix prefixes the access token in the authorization
+	// metadata.
+	bearerPrefix = "Bearer "
+)
+
+// Token is the OAuth access token of the actor a client acts as, sent with
+// every call as required by the server. It requires transport security.
+type Token string
+
+// PerRPCCredentials must be implemented by Token.
+var _ credentials.PerRPCCredentials = Token("")
+
+// GetRequestMetadata returns the authorization metadata of the token.
+func (t Token) GetRequestMetadata(c context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationMetadata: bearerPrefix + string(t)}, nil
+}
+
+// RequireTransportSecurity returns true, as the token must not be sent in the
+// clear.
+func (t Token) RequireTransportSecurity() bool {
+	return true
+}
+
+// server is an ActorServer calling an actorrpc.Service.
+type server struct {
+	UnimplementedActorServer
+	s *actorrpc.Service
+}
+
+// NewServer creates an ActorServer calling the Service with the context and the
+// access token of each call.
+func NewServer(s *actorrpc.Service) ActorServer {
+	return &server{s: s}
+}
+
+// Submit sends the activity or object to the outbox.
+func (x *server) Submit(c context.Context, req *SubmitRequest) (*ActivityResponse, error) {
+	var resp actorrpc.ActivityResponse
+	err := x.s.SubmitContext(c, &actorrpc.SubmitRequest{
+		Token:    accessToken(c),
+		Outbox:   req.GetOutbox(),
+		Activity: req.GetActivity(),
+	}, &resp)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &ActivityResponse{Activity: resp.Activity}, nil
+}
+
+// Follow sends a Follow activity of the object, addressed to it.
+func (x *server) Follow(c context.Context, req *FollowRequest) (*ActivityResponse, error) {
+	var resp actorrpc.ActivityResponse
+	err := x.s.FollowContext(c, &actorrpc.FollowRequest{
+		Token:  accessToken(c),
+		Outbox: req.GetOutbox(),
+		Actor:  req.GetActor(),
+		Object: req.GetObject(),
+	}, &resp)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &ActivityResponse{Activity: resp.Activity}, nil
+}
+
+// Block sends an unaddressed Block activity of the object.
+func (x *server) Block(c context.Context, req *BlockRequest) (*ActivityResponse, error) {
+	var resp actorrpc.ActivityResponse
+	err := x.s.BlockContext(c, &actorrpc.BlockRequest{
+		Token:  accessToken(c),
+		Outbox: req.GetOutbox(),
+		Actor:  req.GetActor(),
+		Object: req.GetObject(),
+	}, &resp)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &ActivityResponse{Activity: resp.Activity}, nil
+}
+
+// Inbox returns the inbox from the database.
+func (x *server) Inbox(c context.Context, req *InboxRequest) (*InboxResponse, error) {
+	var resp actorrpc.InboxResponse
+	err := x.s.InboxContext(c, &actorrpc.InboxRequest{
+		Token: accessToken(c),
+		Inbox: req.GetInbox(),
+	}, &resp)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &InboxResponse{Page: resp.Page}, nil
+}
+
+// accessToken returns the access token in the authorization metadata of the
+// call, or an empty string if there is none.
+func accessToken(c context.Context) string {
+	md, _ := metadata.FromIncomingContext(c)
+	for _, v := range md.Get(authorizationMetadata) {
+		if len(v) > len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(v[len(bearerPrefix):])
+		}
+	}
+	return ""
+}
+
+// toStatus converts an error of the Service to a gRPC status error.
+func toStatus(err error) error {
+	if err == actorrpc.ErrUnauthorized {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
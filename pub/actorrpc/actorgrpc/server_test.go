@@ -0,0 +1,176 @@
+package actorgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/actorrpc"
+	"github.com/go-fed/activity/pub/oauth"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	testOutbox = "https://example.com/alice/outbox"
+	testInbox  = "https://example.com/alice/inbox"
+	testActor  = "https://example.com/alice"
+	testPeer   = "https://other.example.com/bob"
+)
+
+// fakeActor is a FederatingActor sending every activity as is.
+type fakeActor struct {
+	pub.FederatingActor
+	sent vocab.Type
+	// hasDeadline is whether the context of Send had a deadline.
+	hasDeadline bool
+}
+
+func (f *fakeActor) Send(c context.Context, outbox *url.URL, t vocab.Type) (pub.Activity, error) {
+	f.sent = t
+	_, f.hasDeadline = c.Deadline()
+	return t.(pub.Activity), nil
+}
+
+// fakeDatabase is a Database holding the inbox and outbox of one actor.
+type fakeDatabase struct {
+	pub.Database
+}
+
+func (fakeDatabase) Lock(c context.Context, id *url.URL) error   { return nil }
+func (fakeDatabase) Unlock(c context.Context, id *url.URL) error { return nil }
+func (fakeDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	return url.Parse(testActor)
+}
+func (fakeDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	return url.Parse(testActor)
+}
+func (fakeDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(inboxIRI)
+	page.SetActivityStreamsId(id)
+	return page, nil
+}
+
+// setup serves the Service with gRPC in memory, and returns a client of it,
+// along with a token of the actor granted the scopes.
+func setup(t *testing.T, scopes ...string) (*fakeActor, ActorClient, string, func()) {
+	actor := &fakeActor{}
+	db := fakeDatabase{}
+	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	tokens := oauth.NewMemoryTokens(clock, 0)
+	auth := &oauth.Authenticator{Tokens: tokens, Database: db, Clock: clock}
+	u, _ := url.Parse(testActor)
+	tok, err := tokens.IssueToken(context.Background(), u, scopes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := bufconn.Listen(1 << 16)
+	s := grpc.NewServer()
+	RegisterActorServer(s, NewServer(actorrpc.NewService(actor, db, auth)))
+	go s.Serve(l)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(c context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(c)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return actor, NewActorClient(conn), tok.AccessToken, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+// withToken returns a context sending the access token.
+func withToken(c context.Context, accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(c, authorizationMetadata, bearerPrefix+accessToken)
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Follow", func(t *testing.T) {
+		actor, cl, tok, done := setup(t, oauth.ScopeWrite)
+		defer done()
+		resp, err := cl.Follow(withToken(ctx, tok), &FollowRequest{Outbox: testOutbox, Actor: testActor, Object: testPeer})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal(resp.GetActivity(), &m); err != nil {
+			t.Fatal(err)
+		} else if m["type"] != "Follow" || m["object"] != testPeer {
+			t.Fatalf("got %v", m)
+		} else if actor.sent == nil {
+			t.Fatal("did not send the follow")
+		}
+	})
+	t.Run("SendsWithCallContext", func(t *testing.T) {
+		actor, cl, tok, done := setup(t, oauth.ScopeWrite)
+		defer done()
+		c, cancel := context.WithTimeout(withToken(ctx, tok), time.Minute)
+		defer cancel()
+		if _, err := cl.Block(c, &BlockRequest{Outbox: testOutbox, Actor: testActor, Object: testPeer}); err != nil {
+			t.Fatal(err)
+		} else if !actor.hasDeadline {
+			t.Fatal("sent without the deadline of the call")
+		}
+	})
+	t.Run("Inbox", func(t *testing.T) {
+		_, cl, tok, done := setup(t, oauth.ScopeRead)
+		defer done()
+		resp, err := cl.Inbox(withToken(ctx, tok), &InboxRequest{Inbox: testInbox})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal(resp.GetPage(), &m); err != nil {
+			t.Fatal(err)
+		} else if m["id"] != testInbox {
+			t.Fatalf("got %v", m)
+		}
+	})
+	t.Run("PermissionDeniedWithoutToken", func(t *testing.T) {
+		actor, cl, _, done := setup(t, oauth.ScopeWrite)
+		defer done()
+		_, err := cl.Block(ctx, &BlockRequest{Outbox: testOutbox, Actor: testActor, Object: testPeer})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("got %v", err)
+		} else if actor.sent != nil {
+			t.Fatal("sent a block")
+		}
+	})
+	t.Run("PermissionDeniedWithoutScope", func(t *testing.T) {
+		_, cl, tok, done := setup(t, oauth.ScopeWrite)
+		defer done()
+		_, err := cl.Inbox(withToken(ctx, tok), &InboxRequest{Inbox: testInbox})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("got %v", err)
+		}
+	})
+}
+
+func TestToken(t *testing.T) {
+	md, err := Token("abc").GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	} else if md[authorizationMetadata] != "Bearer abc" {
+		t.Fatalf("got %v", md)
+	}
+	c := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	if got := accessToken(c); got != "abc" {
+		t.Fatalf("got %q", got)
+	}
+}
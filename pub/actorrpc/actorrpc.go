@@ -0,0 +1,309 @@
+// Package actorrpc exposes the operations of an Actor to other processes, such
+// as a web frontend deployed separately from the federation daemon.
+//
+// The Service is served with the standard library's net/rpc package, and its
+// methods take and return plain message types. For example, serving it with
+// JSON-RPC:
+//
+//	s := rpc.NewServer()
+//	s.RegisterName(actorrpc.ServiceName, actorrpc.NewService(actor, db, auth))
+//	for {
+//	    conn, err := l.Accept()
+//	    // Handle error
+//	    go s.ServeCodec(jsonrpc.NewServerCodec(conn))
+//	}
+//
+// The actorgrpc module serves the same Service with gRPC instead.
+//
+// Callers authenticate every request with an OAuth access token of the actor
+// they act as, validated by an oauth.Authenticator: sending activities
+// requires a token granted oauth.ScopeWrite for the actor of the outbox, and
+// reading an inbox one granted oauth.ScopeRead for the actor of the inbox.
+package actorrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/oauth"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// ServiceName is the name the Service is registered under by the Client.
+const ServiceName = "Actor"
+
+// ErrUnauthorized is returned by the Service when the access token of a request
+// is invalid, or does not allow the request.
+var ErrUnauthorized = errors.New("actorrpc: unauthorized")
+
+// SubmitRequest submits an activity or object to an outbox.
+type SubmitRequest struct {
+	// Token is the access token of the actor of the outbox.
+	Token string
+	// Outbox is the IRI of the outbox to submit to.
+	Outbox string
+	// Activity is the serialized activity or object. Objects are wrapped
+	// in a Create activity.
+	Activity json.RawMessage
+}
+
+// FollowRequest follows an actor on behalf of the owner of an outbox.
+type FollowRequest struct {
+	// Token is the access token of the follower.
+	Token string
+	// Outbox is the IRI of the outbox of the follower.
+	Outbox string
+	// Actor is the IRI of the follower.
+	Actor string
+	// Object is the IRI of the actor to follow.
+	Object string
+}
+
+// BlockRequest blocks an actor on behalf of the owner of an outbox. The Block
+// activity is not delivered to the blocked actor.
+type BlockRequest struct {
+	// Token is the access token of the blocker.
+	Token string
+	// Outbox is the IRI of the outbox of the blocker.
+	Outbox string
+	// Actor is the IRI of the blocker.
+	Actor string
+	// Object is the IRI of the actor to block.
+	Object string
+}
+
+// ActivityResponse holds the activity sent by a request.
+type ActivityResponse struct {
+	// Activity is the serialized activity, with the ids assigned to it.
+	Activity json.RawMessage
+}
+
+// InboxRequest queries an inbox.
+type InboxRequest struct {
+	// Token is the access token of the actor of the inbox.
+	Token string
+	// Inbox is the IRI of the inbox to query.
+	Inbox string
+}
+
+// InboxResponse holds the inbox queried.
+type InboxResponse struct {
+	// Page is the serialized OrderedCollectionPage of the inbox, as
+	// returned by the Database.
+	Page json.RawMessage
+}
+
+// Service implements the operations of an Actor for net/rpc. Its methods must
+// not be called directly by applications, which use a Client instead. Its
+// methods taking a context serve the same operations with other RPC
+// frameworks, such as gRPC with the actorgrpc module, canceling them with the
+// context of the call.
+type Service struct {
+	actor pub.FederatingActor
+	db    pub.Database
+	auth  *oauth.Authenticator
+}
+
+// NewService creates a Service sending activities with the actor, querying the
+// database, and authorizing the requests with the Authenticator.
+func NewService(actor pub.FederatingActor, db pub.Database, auth *oauth.Authenticator) *Service {
+	return &Service{
+		actor: actor,
+		db:    db,
+		auth:  auth,
+	}
+}
+
+// Submit sends the activity or object to the outbox.
+func (s *Service) Submit(req *SubmitRequest, resp *ActivityResponse) error {
+	return s.SubmitContext(context.Background(), req, resp)
+}
+
+// Follow sends a Follow activity of the object, addressed to it.
+func (s *Service) Follow(req *FollowRequest, resp *ActivityResponse) error {
+	return s.FollowContext(context.Background(), req, resp)
+}
+
+// Block sends an unaddressed Block activity of the object.
+func (s *Service) Block(req *BlockRequest, resp *ActivityResponse) error {
+	return s.BlockContext(context.Background(), req, resp)
+}
+
+// Inbox returns the inbox from the database.
+func (s *Service) Inbox(req *InboxRequest, resp *InboxResponse) error {
+	return s.InboxContext(context.Background(), req, resp)
+}
+
+// SubmitContext is Submit with the context of the call.
+func (s *Service) SubmitContext(c context.Context, req *SubmitRequest, resp *ActivityResponse) error {
+	var m map[string]interface{}
+	if err := json.Unmarshal(req.Activity, &m); err != nil {
+		return err
+	}
+	t, err := streams.ToType(c, m)
+	if err != nil {
+		return err
+	}
+	actors, err := actorsOf(t)
+	if err != nil {
+		return err
+	}
+	return s.send(c, req.Token, req.Outbox, actors, t, resp)
+}
+
+// FollowContext is Follow with the context of the call.
+func (s *Service) FollowContext(c context.Context, req *FollowRequest, resp *ActivityResponse) error {
+	actor, object, err := parseActorAndObject(req.Actor, req.Object)
+	if err != nil {
+		return err
+	}
+	follow := streams.NewActivityStreamsFollow()
+	follow.SetActivityStreamsActor(actorProperty(actor))
+	follow.SetActivityStreamsObject(objectProperty(object))
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(object)
+	follow.SetActivityStreamsTo(to)
+	return s.send(c, req.Token, req.Outbox, []*url.URL{actor}, follow, resp)
+}
+
+// BlockContext is Block with the context of the call.
+func (s *Service) BlockContext(c context.Context, req *BlockRequest, resp *ActivityResponse) error {
+	actor, object, err := parseActorAndObject(req.Actor, req.Object)
+	if err != nil {
+		return err
+	}
+	block := streams.NewActivityStreamsBlock()
+	block.SetActivityStreamsActor(actorProperty(actor))
+	block.SetActivityStreamsObject(objectProperty(object))
+	return s.send(c, req.Token, req.Outbox, []*url.URL{actor}, block, resp)
+}
+
+// InboxContext is Inbox with the context of the call.
+func (s *Service) InboxContext(c context.Context, req *InboxRequest, resp *InboxResponse) error {
+	inbox, err := parseIRI(req.Inbox)
+	if err != nil {
+		return err
+	}
+	t, ok, err := s.auth.ValidToken(c, req.Token)
+	if err != nil {
+		return err
+	} else if !ok {
+		return ErrUnauthorized
+	}
+	if ok, err = s.auth.AuthorizeGetInbox(c, t, inbox); err != nil {
+		return err
+	} else if !ok {
+		return ErrUnauthorized
+	}
+	if err = s.db.Lock(c, inbox); err != nil {
+		return err
+	}
+	page, err := s.db.GetInbox(c, inbox)
+	s.db.Unlock(c, inbox)
+	if err != nil {
+		return err
+	}
+	resp.Page, err = serialize(page)
+	return err
+}
+
+// send sends the value to the outbox, and sets the activity sent in the
+// response. The access token must allow posting to the outbox, and belong to
+// every actor of the activity.
+func (s *Service) send(c context.Context, accessToken, outbox string, actors []*url.URL, t vocab.Type, resp *ActivityResponse) error {
+	outboxIRI, err := parseIRI(outbox)
+	if err != nil {
+		return err
+	}
+	token, ok, err := s.auth.ValidToken(c, accessToken)
+	if err != nil {
+		return err
+	} else if !ok {
+		return ErrUnauthorized
+	}
+	for _, actor := range actors {
+		if actor.String() != token.Actor.String() {
+			return ErrUnauthorized
+		}
+	}
+	if ok, err = s.auth.AuthorizePostOutbox(c, token, outboxIRI); err != nil {
+		return err
+	} else if !ok {
+		return ErrUnauthorized
+	}
+	activity, err := s.actor.Send(c, outboxIRI, t)
+	if err != nil {
+		return err
+	}
+	resp.Activity, err = serialize(activity)
+	return err
+}
+
+// parseIRI parses an absolute IRI.
+func parseIRI(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	} else if !u.IsAbs() {
+		return nil, fmt.Errorf("actorrpc: IRI is not absolute: %q", s)
+	}
+	return u, nil
+}
+
+// parseActorAndObject parses the IRIs of the actor and object of an activity.
+func parseActorAndObject(actor, object string) (actorIRI, objectIRI *url.URL, err error) {
+	if actorIRI, err = parseIRI(actor); err != nil {
+		return
+	}
+	objectIRI, err = parseIRI(object)
+	return
+}
+
+// actorsOf returns the IRIs of the actors of the value, if it has an actor
+// property.
+func actorsOf(t vocab.Type) ([]*url.URL, error) {
+	a, ok := t.(interface {
+		GetActivityStreamsActor() vocab.ActivityStreamsActorProperty
+	})
+	if !ok || a.GetActivityStreamsActor() == nil {
+		return nil, nil
+	}
+	p := a.GetActivityStreamsActor()
+	actors := make([]*url.URL, 0, p.Len())
+	for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+		id, err := pub.ToId(iter)
+		if err != nil {
+			return nil, err
+		}
+		actors = append(actors, id)
+	}
+	return actors, nil
+}
+
+// actorProperty returns an actor property of the IRI.
+func actorProperty(iri *url.URL) vocab.ActivityStreamsActorProperty {
+	p := streams.NewActivityStreamsActorProperty()
+	p.AppendIRI(iri)
+	return p
+}
+
+// objectProperty returns an object property of the IRI.
+func objectProperty(iri *url.URL) vocab.ActivityStreamsObjectProperty {
+	p := streams.NewActivityStreamsObjectProperty()
+	p.AppendIRI(iri)
+	return p
+}
+
+// serialize serializes the value to JSON.
+func serialize(t vocab.Type) (json.RawMessage, error) {
+	m, err := pub.Serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
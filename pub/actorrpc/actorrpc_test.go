@@ -0,0 +1,238 @@
+package actorrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/oauth"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	testOutbox = "https://example.com/alice/outbox"
+	testInbox  = "https://example.com/alice/inbox"
+	testActor  = "https://example.com/alice"
+	testPeer   = "https://other.example.com/bob"
+)
+
+// fakeActor is a FederatingActor sending every value as is.
+type fakeActor struct {
+	pub.FederatingActor
+	outbox string
+	sent   vocab.Type
+}
+
+func (f *fakeActor) Send(c context.Context, outbox *url.URL, t vocab.Type) (pub.Activity, error) {
+	f.outbox = outbox.String()
+	f.sent = t
+	if a, ok := t.(pub.Activity); ok {
+		return a, nil
+	}
+	create := streams.NewActivityStreamsCreate()
+	obj := streams.NewActivityStreamsObjectProperty()
+	obj.AppendType(t)
+	create.SetActivityStreamsObject(obj)
+	return create, nil
+}
+
+// fakeDatabase is a Database holding the inbox and outbox of one actor.
+type fakeDatabase struct {
+	pub.Database
+	locked int
+}
+
+func (f *fakeDatabase) Lock(c context.Context, id *url.URL) error {
+	f.locked++
+	return nil
+}
+
+func (f *fakeDatabase) Unlock(c context.Context, id *url.URL) error {
+	f.locked--
+	return nil
+}
+
+func (f *fakeDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	return url.Parse(testActor)
+}
+
+func (f *fakeDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	return url.Parse(testActor)
+}
+
+func (f *fakeDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(inboxIRI)
+	page.SetActivityStreamsId(id)
+	return page, nil
+}
+
+// setup serves a Service over JSON-RPC, and returns a Client calling it with a
+// token of the actor granted the scopes, which must be closed.
+func setup(t *testing.T, actorIRI string, scopes ...string) (*fakeActor, *fakeDatabase, *Client, *rpc.Client) {
+	actor := &fakeActor{}
+	db := &fakeDatabase{}
+	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	tokens := oauth.NewMemoryTokens(clock, 0)
+	auth := &oauth.Authenticator{Tokens: tokens, Database: db, Clock: clock}
+	tok, err := tokens.IssueToken(context.Background(), mustParse(actorIRI), scopes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := rpc.NewServer()
+	if err := s.RegisterName(ServiceName, NewService(actor, db, auth)); err != nil {
+		t.Fatal(err)
+	}
+	server, client := net.Pipe()
+	go s.ServeCodec(jsonrpc.NewServerCodec(server))
+	cl := jsonrpc.NewClient(client)
+	return actor, db, NewClient(cl, tok.AccessToken), cl
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// decode unmarshals the serialized value.
+func decode(t *testing.T, b json.RawMessage) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("cannot decode %s: %s", b, err)
+	}
+	return m
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Submit", func(t *testing.T) {
+		actor, _, cl, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		b, err := cl.Submit(ctx, testOutbox, json.RawMessage(`{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","content":"hello"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actor.outbox != testOutbox {
+			t.Fatalf("got outbox %q", actor.outbox)
+		} else if actor.sent.GetTypeName() != "Note" {
+			t.Fatalf("got %s", actor.sent.GetTypeName())
+		}
+		if m := decode(t, b); m["type"] != "Create" {
+			t.Fatalf("got %v", m)
+		}
+	})
+	t.Run("Follow", func(t *testing.T) {
+		actor, _, cl, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		b, err := cl.Follow(ctx, testOutbox, testActor, testPeer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := decode(t, b)
+		if m["type"] != "Follow" || m["actor"] != testActor || m["object"] != testPeer || m["to"] != testPeer {
+			t.Fatalf("got %v", m)
+		}
+		if actor.outbox != testOutbox {
+			t.Fatalf("got outbox %q", actor.outbox)
+		}
+	})
+	t.Run("Block", func(t *testing.T) {
+		_, _, cl, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		b, err := cl.Block(ctx, testOutbox, testActor, testPeer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := decode(t, b)
+		if m["type"] != "Block" || m["object"] != testPeer {
+			t.Fatalf("got %v", m)
+		} else if _, ok := m["to"]; ok {
+			t.Fatalf("block is addressed: %v", m)
+		}
+	})
+	t.Run("RejectsRelativeIRI", func(t *testing.T) {
+		actor, _, cl, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		_, err := cl.Follow(ctx, testOutbox, testActor, "/bob")
+		if err == nil {
+			t.Fatal("expected an error")
+		} else if actor.sent != nil {
+			t.Fatal("sent a follow")
+		}
+	})
+	t.Run("Inbox", func(t *testing.T) {
+		_, db, cl, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		b, err := cl.Inbox(ctx, testInbox)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m := decode(t, b); m["type"] != "OrderedCollectionPage" || m["id"] != testInbox {
+			t.Fatalf("got %v", m)
+		} else if db.locked != 0 {
+			t.Fatal("inbox left locked")
+		}
+	})
+	t.Run("RejectsUnknownToken", func(t *testing.T) {
+		_, _, _, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		if _, err := NewClient(rc, "unknown").Inbox(ctx, testInbox); err == nil || err.Error() != ErrUnauthorized.Error() {
+			t.Fatalf("got %v", err)
+		}
+	})
+	t.Run("RejectsReadTokenSending", func(t *testing.T) {
+		actor, _, cl, rc := setup(t, testActor, oauth.ScopeRead)
+		defer rc.Close()
+		if _, err := cl.Follow(ctx, testOutbox, testActor, testPeer); err == nil || err.Error() != ErrUnauthorized.Error() {
+			t.Fatalf("got %v", err)
+		} else if actor.sent != nil {
+			t.Fatal("sent a follow")
+		}
+	})
+	t.Run("RejectsWriteTokenReadingInbox", func(t *testing.T) {
+		_, _, cl, rc := setup(t, testActor, oauth.ScopeWrite)
+		defer rc.Close()
+		if _, err := cl.Inbox(ctx, testInbox); err == nil || err.Error() != ErrUnauthorized.Error() {
+			t.Fatalf("got %v", err)
+		}
+	})
+	t.Run("RejectsTokenOfOtherActor", func(t *testing.T) {
+		actor, _, cl, rc := setup(t, testPeer, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		if _, err := cl.Block(ctx, testOutbox, testPeer, testActor); err == nil || err.Error() != ErrUnauthorized.Error() {
+			t.Fatalf("got %v", err)
+		} else if actor.sent != nil {
+			t.Fatal("sent a block")
+		}
+	})
+	t.Run("RejectsActivityOfOtherActor", func(t *testing.T) {
+		actor, _, cl, rc := setup(t, testActor, oauth.ScopeWrite)
+		defer rc.Close()
+		_, err := cl.Submit(ctx, testOutbox, json.RawMessage(`{"@context":"https://www.w3.org/ns/activitystreams","type":"Like","actor":"`+testPeer+`","object":"https://example.com/note/1"}`))
+		if err == nil || err.Error() != ErrUnauthorized.Error() {
+			t.Fatalf("got %v", err)
+		} else if actor.sent != nil {
+			t.Fatal("sent a like")
+		}
+	})
+	t.Run("ContextCanceled", func(t *testing.T) {
+		_, _, cl, rc := setup(t, testActor, oauth.ScopeRead, oauth.ScopeWrite)
+		defer rc.Close()
+		c, cancel := context.WithCancel(ctx)
+		cancel()
+		if _, err := cl.Inbox(c, testInbox); err != context.Canceled {
+			t.Fatalf("got %v", err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package actorrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/rpc"
+)
+
+// Client calls a Service in another process, on behalf of the actor of an
+// access token.
+type Client struct {
+	rpc   *rpc.Client
+	token string
+}
+
+// NewClient creates a Client calling the Service registered under ServiceName
+// with the RPC client, such as one returned by jsonrpc.Dial, authenticating
+// with the access token.
+func NewClient(rpc *rpc.Client, accessToken string) *Client {
+	return &Client{rpc: rpc, token: accessToken}
+}
+
+// Submit submits the serialized activity or object to the outbox, and returns
+// the serialized activity sent.
+func (cl *Client) Submit(c context.Context, outbox string, activity json.RawMessage) (json.RawMessage, error) {
+	var resp ActivityResponse
+	err := cl.call(c, "Submit", &SubmitRequest{Token: cl.token, Outbox: outbox, Activity: activity}, &resp)
+	return resp.Activity, err
+}
+
+// Follow sends a Follow of the object by the actor owning the outbox, and
+// returns the serialized activity sent.
+func (cl *Client) Follow(c context.Context, outbox, actor, object string) (json.RawMessage, error) {
+	var resp ActivityResponse
+	err := cl.call(c, "Follow", &FollowRequest{Token: cl.token, Outbox: outbox, Actor: actor, Object: object}, &resp)
+	return resp.Activity, err
+}
+
+// Block sends a Block of the object by the actor owning the outbox, and returns
+// the serialized activity sent.
+func (cl *Client) Block(c context.Context, outbox, actor, object string) (json.RawMessage, error) {
+	var resp ActivityResponse
+	err := cl.call(c, "Block", &BlockRequest{Token: cl.token, Outbox: outbox, Actor: actor, Object: object}, &resp)
+	return resp.Activity, err
+}
+
+// Inbox returns the serialized OrderedCollectionPage of the inbox.
+func (cl *Client) Inbox(c context.Context, inbox string) (json.RawMessage, error) {
+	var resp InboxResponse
+	err := cl.call(c, "Inbox", &InboxRequest{Token: cl.token, Inbox: inbox}, &resp)
+	return resp.Page, err
+}
+
+// call calls the method of the Service, returning early if the context is
+// done. The call itself cannot be canceled.
+func (cl *Client) call(c context.Context, method string, req, resp interface{}) error {
+	call := cl.rpc.Go(ServiceName+"."+method, req, resp, make(chan *rpc.Call, 1))
+	select {
+	case <-c.Done():
+		return c.Err()
+	case call = <-call.Done:
+		return call.Error
+	}
+}
@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// alsoKnownAsProperty is the property listing the other ids of an actor, as
+// Mastodon uses it. It is not part of the generated vocabulary.
+const alsoKnownAsProperty = "alsoKnownAs"
+
+// AliasDatabase may be implemented by a Database serving actors under several
+// ids, such as after moving the server to a new domain while the old one still
+// resolves. The handlers of NewActivityStreamsHandler redirect the requests of
+// alias ids to the canonical ones with 301 Moved Permanently.
+//
+// The actors list their aliases with SetAlsoKnownAs, so peers following the
+// redirects recognize them as the same actors.
+type AliasDatabase interface {
+	// CanonicalId returns the id the value is served under, and whether
+	// the id is an alias of it.
+	CanonicalId(c context.Context, id *url.URL) (canonical *url.URL, isAlias bool, err error)
+}
+
+// canonicalId returns the id the value is served under, if the id is an alias
+// of it.
+func canonicalId(c context.Context, db Database, id *url.URL) (*url.URL, bool, error) {
+	if adb, ok := db.(AliasDatabase); ok {
+		return adb.CanonicalId(c, id)
+	}
+	return nil, false, nil
+}
+
+// GetAlsoKnownAs reads the 'alsoKnownAs' property of an actor, listing its
+// other ids. Values that are not IRIs are skipped, and listed by ReadWarnings.
+func GetAlsoKnownAs(actor vocab.Type) []*url.URL {
+	u, ok := actor.(unknownPropertieser)
+	if !ok {
+		return nil
+	}
+	var values []interface{}
+	switch v := u.GetUnknownProperties()[alsoKnownAsProperty].(type) {
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	case []string:
+		for _, s := range v {
+			values = append(values, s)
+		}
+	}
+	var iris []*url.URL
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if iri, err := url.Parse(s); err == nil && iri.IsAbs() {
+			iris = append(iris, iri)
+		}
+	}
+	return iris
+}
+
+// SetAlsoKnownAs sets the 'alsoKnownAs' property of an actor, listing its other
+// ids, or removes it if there are none. It does nothing if the actor cannot
+// have properties outside of the generated vocabularies.
+func SetAlsoKnownAs(actor vocab.Type, iris []*url.URL) {
+	u, ok := actor.(unknownPropertieser)
+	if !ok {
+		return
+	}
+	if len(iris) == 0 {
+		delete(u.GetUnknownProperties(), alsoKnownAsProperty)
+		return
+	}
+	values := make([]interface{}, len(iris))
+	for i, iri := range iris {
+		values[i] = iri.String()
+	}
+	u.GetUnknownProperties()[alsoKnownAsProperty] = values
+}
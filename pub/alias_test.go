@@ -0,0 +1,68 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+// aliasDatabase is a Database serving the actors of an old domain under a new
+// one.
+type aliasDatabase struct {
+	*MockDatabase
+}
+
+func (a *aliasDatabase) CanonicalId(c context.Context, id *url.URL) (*url.URL, bool, error) {
+	if id.Host != "old.example.com" {
+		return nil, false, nil
+	}
+	canonical := *id
+	canonical.Host = "example.com"
+	return &canonical, true, nil
+}
+
+func TestAlsoKnownAs(t *testing.T) {
+	ctx := context.Background()
+	t.Run("RoundTrips", func(t *testing.T) {
+		person := streams.NewActivityStreamsPerson()
+		person.SetActivityStreamsId(newIdProperty(mustParse(testPersonIRI)))
+		SetAlsoKnownAs(person, []*url.URL{mustParse("https://old.example.com/person")})
+		m, err := serialize(person)
+		assertEqual(t, err, nil)
+		v, err := streams.ToType(ctx, m)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(GetAlsoKnownAs(v)), "[https://old.example.com/person]")
+		SetAlsoKnownAs(v, nil)
+		assertEqual(t, len(GetAlsoKnownAs(v)), 0)
+	})
+	t.Run("ReadsSingleIRI", func(t *testing.T) {
+		person := streams.NewActivityStreamsPerson()
+		person.GetUnknownProperties()[alsoKnownAsProperty] = "https://old.example.com/person"
+		assertEqual(t, fmt.Sprint(GetAlsoKnownAs(person)), "[https://old.example.com/person]")
+	})
+}
+
+func TestAliasRedirects(t *testing.T) {
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	db := &aliasDatabase{NewMockDatabase(ctl)}
+	authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+		return false, nil
+	}
+	h := NewActivityStreamsHandler(authFn, db, NewMockClock(ctl))
+	req := httptest.NewRequest("GET", "https://old.example.com/person", nil)
+	req.Header.Set(acceptHeader, activityStreamsMediaTypes[0])
+	rec := httptest.NewRecorder()
+	isASRequest, err := h(ctx, rec, req)
+	assertEqual(t, err, nil)
+	assertEqual(t, isASRequest, true)
+	assertEqual(t, rec.Code, http.StatusMovedPermanently)
+	assertEqual(t, rec.Header().Get(locationHeader), "https://example.com/person")
+}
@@ -0,0 +1,450 @@
+package pub
+
+import (
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// Types of media attachments.
+	documentAttachmentType = "Document"
+	imageAttachmentType    = "Image"
+	videoAttachmentType    = "Video"
+	audioAttachmentType    = "Audio"
+)
+
+// Attachment describes a media attachment, such as an image on a Note.
+//
+// It holds the properties that peer software such as Mastodon and PeerTube
+// rely upon, so that applications do not need to set them on the
+// ActivityStreams types by hand.
+type Attachment struct {
+	// Type is the ActivityStreams type of the attachment, which is one of
+	// "Document", "Image", "Video", or "Audio". When empty, it is
+	// determined from the MediaType.
+	Type string
+	// URL is the location of the media.
+	URL *url.URL
+	// MediaType is the MIME type of the media, such as "image/png".
+	MediaType string
+	// Description is the alternative text of the media, which is the
+	// 'name' property of the attachment.
+	Description string
+	// Blurhash is a compact placeholder for the media, as used by
+	// Mastodon. Optional.
+	Blurhash string
+	// FocalPoint is the point of interest of the media, as used by
+	// Mastodon. Optional.
+	FocalPoint *FocalPoint
+}
+
+// FocalPoint is the point of interest in an image or video.
+//
+// Both coordinates range from -1.0 to 1.0, with (0, 0) at the center, (-1, 1)
+// at the top-left, and (1, -1) at the bottom-right.
+type FocalPoint struct {
+	X float64
+	Y float64
+}
+
+// ValidateMediaType ensures the media type is a valid MIME type of the form
+// "type/subtype", with optional parameters. Wildcards are not allowed.
+func ValidateMediaType(mediaType string) error {
+	mt, _, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return fmt.Errorf("invalid media type %q: %s", mediaType, err)
+	}
+	parts := strings.Split(mt, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return fmt.Errorf("invalid media type %q: must be of the form type/subtype", mediaType)
+	} else if parts[0] == "*" || parts[1] == "*" {
+		return fmt.Errorf("invalid media type %q: wildcards are not allowed", mediaType)
+	}
+	return nil
+}
+
+// NewAttachment creates the ActivityStreams value for the attachment, which
+// can be appended to the 'attachment' property of an object.
+//
+// Returns an error if the URL is missing, the MediaType is invalid or does not
+// match the Type, or the FocalPoint is out of range.
+func NewAttachment(a Attachment) (vocab.Type, error) {
+	if a.URL == nil {
+		return nil, fmt.Errorf("attachment has no url")
+	}
+	if err := ValidateMediaType(a.MediaType); err != nil {
+		return nil, err
+	}
+	mt, _, _ := mime.ParseMediaType(a.MediaType)
+	typeName := a.Type
+	if len(typeName) == 0 {
+		typeName = attachmentTypeForMediaType(mt)
+	} else if typeName != documentAttachmentType && typeName != attachmentTypeForMediaType(mt) {
+		return nil, fmt.Errorf("attachment of type %q cannot have media type %q", typeName, a.MediaType)
+	}
+	if fp := a.FocalPoint; fp != nil && (fp.X < -1 || fp.X > 1 || fp.Y < -1 || fp.Y > 1) {
+		return nil, fmt.Errorf("attachment focal point (%v, %v) is out of range", fp.X, fp.Y)
+	}
+	var t attachmentType
+	switch typeName {
+	case documentAttachmentType:
+		t = streams.NewActivityStreamsDocument()
+	case imageAttachmentType:
+		t = streams.NewActivityStreamsImage()
+	case videoAttachmentType:
+		t = streams.NewActivityStreamsVideo()
+	case audioAttachmentType:
+		t = streams.NewActivityStreamsAudio()
+	default:
+		return nil, fmt.Errorf("unknown attachment type %q", typeName)
+	}
+	u := streams.NewActivityStreamsUrlProperty()
+	u.AppendIRI(a.URL)
+	t.SetActivityStreamsUrl(u)
+	mtProp := streams.NewActivityStreamsMediaTypeProperty()
+	mtProp.Set(a.MediaType)
+	t.SetActivityStreamsMediaType(mtProp)
+	if len(a.Description) > 0 {
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString(a.Description)
+		t.SetActivityStreamsName(name)
+	}
+	if len(a.Blurhash) > 0 {
+		blurhash := streams.NewTootBlurhashProperty()
+		blurhash.Set(a.Blurhash)
+		t.SetTootBlurhash(blurhash)
+	}
+	if a.FocalPoint != nil {
+		fp := streams.NewTootFocalPointProperty()
+		fp.AppendXMLSchemaFloat(a.FocalPoint.X)
+		fp.AppendXMLSchemaFloat(a.FocalPoint.Y)
+		t.SetTootFocalPoint(fp)
+	}
+	return t, nil
+}
+
+// AppendAttachments creates the ActivityStreams values for the attachments and
+// appends them to the 'attachment' property of the object, creating the
+// property if needed.
+//
+// Returns an error if the object cannot have attachments, or if any of the
+// attachments is invalid. No attachments are appended if an error is
+// returned.
+func AppendAttachments(o vocab.Type, as ...Attachment) error {
+	ar, ok := o.(attachmenter)
+	if !ok {
+		return fmt.Errorf("cannot append attachments: %T has no attachment property", o)
+	}
+	ts := make([]vocab.Type, 0, len(as))
+	for _, a := range as {
+		t, err := NewAttachment(a)
+		if err != nil {
+			return err
+		}
+		ts = append(ts, t)
+	}
+	prop := ar.GetActivityStreamsAttachment()
+	if prop == nil {
+		prop = streams.NewActivityStreamsAttachmentProperty()
+		ar.SetActivityStreamsAttachment(prop)
+	}
+	for _, t := range ts {
+		if err := prop.AppendType(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToAttachment reads a Document, Image, Video, or Audio value, or any type
+// extending Document, into an Attachment.
+//
+// The first entry of the 'url' property is used, which may be an IRI or a
+// Link. The 'mediaType' of a Link is used when the value itself has none.
+func ToAttachment(t vocab.Type) (Attachment, error) {
+	var a Attachment
+	at, ok := t.(attachmentType)
+	if !ok || !streams.IsOrExtendsActivityStreamsDocument(t) {
+		return a, fmt.Errorf("cannot read attachment: %T is not a Document", t)
+	}
+	a.Type = t.GetTypeName()
+	if mt := at.GetActivityStreamsMediaType(); mt != nil && !mt.IsIRI() {
+		a.MediaType = mt.Get()
+	}
+	if u := at.GetActivityStreamsUrl(); u != nil && u.Len() > 0 {
+		iter := u.At(0)
+		if iter.IsIRI() {
+			a.URL = iter.GetIRI()
+		} else if iter.IsXMLSchemaAnyURI() {
+			a.URL = iter.GetXMLSchemaAnyURI()
+		} else if iter.IsActivityStreamsLink() {
+			link := iter.GetActivityStreamsLink()
+			if href := link.GetActivityStreamsHref(); href != nil {
+				a.URL = href.Get()
+			}
+			if mt := link.GetActivityStreamsMediaType(); len(a.MediaType) == 0 && mt != nil && !mt.IsIRI() {
+				a.MediaType = mt.Get()
+			}
+		}
+	}
+	if a.URL == nil {
+		return a, fmt.Errorf("cannot read attachment: %s has no url", a.Type)
+	}
+	if name := at.GetActivityStreamsName(); name != nil {
+		for iter := name.Begin(); iter != name.End(); iter = iter.Next() {
+			if iter.IsXMLSchemaString() {
+				a.Description = iter.GetXMLSchemaString()
+				break
+			}
+		}
+	}
+	if b := at.GetTootBlurhash(); b != nil && b.IsXMLSchemaString() {
+		a.Blurhash = b.Get()
+	}
+	if fp := at.GetTootFocalPoint(); fp != nil && fp.Len() == 2 && fp.At(0).IsXMLSchemaFloat() && fp.At(1).IsXMLSchemaFloat() {
+		a.FocalPoint = &FocalPoint{X: fp.At(0).Get(), Y: fp.At(1).Get()}
+	}
+	return a, nil
+}
+
+// GetAttachments reads the media attachments of an object.
+//
+// Entries in the 'attachment' property that are IRIs or that are not media,
+// such as the PropertyValue entries on Mastodon profiles, are skipped.
+func GetAttachments(o vocab.Type) ([]Attachment, error) {
+	ar, ok := o.(attachmenter)
+	if !ok {
+		return nil, nil
+	}
+	prop := ar.GetActivityStreamsAttachment()
+	if prop == nil {
+		return nil, nil
+	}
+	var as []Attachment
+	for iter := prop.Begin(); iter != prop.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil || !streams.IsOrExtendsActivityStreamsDocument(t) {
+			continue
+		}
+		a, err := ToAttachment(t)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	return as, nil
+}
+
+// attachmentTypeForMediaType determines the ActivityStreams type of an
+// attachment from its media type.
+func attachmentTypeForMediaType(mediaType string) string {
+	switch strings.SplitN(mediaType, "/", 2)[0] {
+	case "image":
+		return imageAttachmentType
+	case "video":
+		return videoAttachmentType
+	case "audio":
+		return audioAttachmentType
+	default:
+		return documentAttachmentType
+	}
+}
+
+// MediaVariant is one of the urls of a media value, such as one of the
+// resolutions of a video or one of the formats of an image.
+type MediaVariant struct {
+	// URL is the location of the variant.
+	URL *url.URL
+	// MediaType is the MIME type of the variant, such as "video/mp4".
+	MediaType string
+	// Width and Height are the dimensions of the variant in pixels, or
+	// zero when unknown.
+	Width  int
+	Height int
+}
+
+// MediaConstraints are the requirements used to select a MediaVariant.
+type MediaConstraints struct {
+	// MediaTypes are the acceptable media types, most preferred first. A
+	// subtype may be a wildcard, such as "image/*". When empty, any media
+	// type is acceptable.
+	MediaTypes []string
+	// MaxWidth and MaxHeight bound the dimensions of the variant in
+	// pixels. Zero means unbounded.
+	MaxWidth  int
+	MaxHeight int
+}
+
+// GetMediaVariants reads the 'url' property of a media value, such as an Image
+// used as an icon or a Video attachment.
+//
+// Links provide their own 'mediaType', 'width', and 'height'. IRIs use the
+// 'mediaType' of the media value and have unknown dimensions. Mentions are
+// skipped.
+func GetMediaVariants(t vocab.Type) ([]MediaVariant, error) {
+	at, ok := t.(attachmentType)
+	if !ok {
+		return nil, fmt.Errorf("cannot get media variants: %T has no url property", t)
+	}
+	var mediaType string
+	if mt := at.GetActivityStreamsMediaType(); mt != nil && !mt.IsIRI() {
+		mediaType = mt.Get()
+	}
+	u := at.GetActivityStreamsUrl()
+	if u == nil {
+		return nil, nil
+	}
+	var vs []MediaVariant
+	for iter := u.Begin(); iter != u.End(); iter = iter.Next() {
+		if iter.IsIRI() {
+			vs = append(vs, MediaVariant{URL: iter.GetIRI(), MediaType: mediaType})
+		} else if iter.IsXMLSchemaAnyURI() {
+			vs = append(vs, MediaVariant{URL: iter.GetXMLSchemaAnyURI(), MediaType: mediaType})
+		} else if iter.IsActivityStreamsLink() {
+			link := iter.GetActivityStreamsLink()
+			href := link.GetActivityStreamsHref()
+			if href == nil || href.Get() == nil {
+				return nil, fmt.Errorf("cannot get media variants: link has no href")
+			}
+			v := MediaVariant{URL: href.Get(), MediaType: mediaType}
+			if mt := link.GetActivityStreamsMediaType(); mt != nil && !mt.IsIRI() {
+				v.MediaType = mt.Get()
+			}
+			if w := link.GetActivityStreamsWidth(); w != nil && !w.IsIRI() {
+				v.Width = w.Get()
+			}
+			if h := link.GetActivityStreamsHeight(); h != nil && !h.IsIRI() {
+				v.Height = h.Get()
+			}
+			vs = append(vs, v)
+		}
+	}
+	return vs, nil
+}
+
+// AppendMediaVariants appends the variants as Links to the 'url' property of a
+// media value, creating the property if needed.
+//
+// Returns an error if the value has no 'url' property, or if any of the
+// variants has no URL or an invalid MediaType. No variants are appended if an
+// error is returned.
+func AppendMediaVariants(t vocab.Type, vs ...MediaVariant) error {
+	at, ok := t.(attachmentType)
+	if !ok {
+		return fmt.Errorf("cannot append media variants: %T has no url property", t)
+	}
+	links := make([]vocab.ActivityStreamsLink, 0, len(vs))
+	for _, v := range vs {
+		if v.URL == nil {
+			return fmt.Errorf("cannot append media variant: no url")
+		}
+		link := streams.NewActivityStreamsLink()
+		href := streams.NewActivityStreamsHrefProperty()
+		href.Set(v.URL)
+		link.SetActivityStreamsHref(href)
+		if len(v.MediaType) > 0 {
+			if err := ValidateMediaType(v.MediaType); err != nil {
+				return err
+			}
+			mt := streams.NewActivityStreamsMediaTypeProperty()
+			mt.Set(v.MediaType)
+			link.SetActivityStreamsMediaType(mt)
+		}
+		if v.Width > 0 {
+			w := streams.NewActivityStreamsWidthProperty()
+			w.Set(v.Width)
+			link.SetActivityStreamsWidth(w)
+		}
+		if v.Height > 0 {
+			h := streams.NewActivityStreamsHeightProperty()
+			h.Set(v.Height)
+			link.SetActivityStreamsHeight(h)
+		}
+		links = append(links, link)
+	}
+	u := at.GetActivityStreamsUrl()
+	if u == nil {
+		u = streams.NewActivityStreamsUrlProperty()
+		at.SetActivityStreamsUrl(u)
+	}
+	for _, link := range links {
+		u.AppendActivityStreamsLink(link)
+	}
+	return nil
+}
+
+// SelectMediaVariant chooses the variant of a media value that best matches the
+// constraints.
+//
+// Variants with an unacceptable media type are never chosen. Otherwise a
+// more preferred media type wins. Among variants of equally preferred media
+// types, the largest one within the bounds is chosen. If none are within the
+// bounds, the smallest one is chosen instead. Variants of unknown dimensions
+// are considered within the bounds, but smaller than any known dimension.
+//
+// Returns an error if no variant has an acceptable media type.
+func SelectMediaVariant(t vocab.Type, c MediaConstraints) (MediaVariant, error) {
+	vs, err := GetMediaVariants(t)
+	if err != nil {
+		return MediaVariant{}, err
+	}
+	best := -1
+	bestRank := 0
+	for i, v := range vs {
+		rank, ok := c.mediaTypeRank(v.MediaType)
+		if !ok {
+			continue
+		}
+		if best < 0 || rank < bestRank || (rank == bestRank && c.isBetterSize(v, vs[best])) {
+			best = i
+			bestRank = rank
+		}
+	}
+	if best < 0 {
+		return MediaVariant{}, fmt.Errorf("no media variant has an acceptable media type")
+	}
+	return vs[best], nil
+}
+
+// mediaTypeRank returns the index of the first acceptable media type matching
+// the given one, and false if it is not acceptable.
+func (c MediaConstraints) mediaTypeRank(mediaType string) (int, bool) {
+	if len(c.MediaTypes) == 0 {
+		return 0, true
+	}
+	mt, _, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return 0, false
+	}
+	for i, accept := range c.MediaTypes {
+		if accept == mt {
+			return i, true
+		} else if strings.HasSuffix(accept, "/*") && strings.HasPrefix(mt, strings.TrimSuffix(accept, "*")) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// fits determines whether the variant is within the bounds.
+func (c MediaConstraints) fits(v MediaVariant) bool {
+	return (c.MaxWidth == 0 || v.Width <= c.MaxWidth) && (c.MaxHeight == 0 || v.Height <= c.MaxHeight)
+}
+
+// isBetterSize determines whether the variant a has a better size than the
+// variant b for the bounds.
+func (c MediaConstraints) isBetterSize(a, b MediaVariant) bool {
+	aFits, bFits := c.fits(a), c.fits(b)
+	aArea, bArea := a.Width*a.Height, b.Width*b.Height
+	if aFits != bFits {
+		return aFits
+	} else if aFits {
+		return aArea > bArea
+	}
+	return aArea < bArea
+}
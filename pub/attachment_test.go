@@ -0,0 +1,252 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+func TestValidateMediaType(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		isValid bool
+	}{
+		{"Image", "image/png", true},
+		{"With Parameters", "text/html; charset=utf-8", true},
+		{"Empty", "", false},
+		{"No Subtype", "image", false},
+		{"Empty Subtype", "image/", false},
+		{"Wildcard", "image/*", false},
+		{"Extra Slash", "image/png/x", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateMediaType(test.input)
+			if test.isValid && err != nil {
+				t.Fatalf("expected %q to be valid, got %s", test.input, err)
+			} else if !test.isValid && err == nil {
+				t.Fatalf("expected %q to be invalid", test.input)
+			}
+		})
+	}
+}
+
+func TestNewAttachment(t *testing.T) {
+	t.Run("DeterminesTypeFromMediaType", func(t *testing.T) {
+		v, err := NewAttachment(Attachment{
+			URL:         mustParse(testNoteId1),
+			MediaType:   "image/png",
+			Description: "A description",
+		})
+		assertEqual(t, err, nil)
+		assertEqual(t, v.GetTypeName(), "Image")
+	})
+	t.Run("KeepsDocumentForAnyMediaType", func(t *testing.T) {
+		v, err := NewAttachment(Attachment{
+			Type:      "Document",
+			URL:       mustParse(testNoteId1),
+			MediaType: "video/mp4",
+		})
+		assertEqual(t, err, nil)
+		assertEqual(t, v.GetTypeName(), "Document")
+	})
+	t.Run("ErrorsOnMismatchedMediaType", func(t *testing.T) {
+		_, err := NewAttachment(Attachment{
+			Type:      "Image",
+			URL:       mustParse(testNoteId1),
+			MediaType: "video/mp4",
+		})
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("ErrorsOnMissingURL", func(t *testing.T) {
+		_, err := NewAttachment(Attachment{MediaType: "image/png"})
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("ErrorsOnFocalPointOutOfRange", func(t *testing.T) {
+		_, err := NewAttachment(Attachment{
+			URL:        mustParse(testNoteId1),
+			MediaType:  "image/png",
+			FocalPoint: &FocalPoint{X: 1.5, Y: 0},
+		})
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("Serializes", func(t *testing.T) {
+		v, err := NewAttachment(Attachment{
+			URL:         mustParse(testNoteId1),
+			MediaType:   "image/png",
+			Description: "A description",
+			Blurhash:    "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH",
+			FocalPoint:  &FocalPoint{X: -0.5, Y: 0.25},
+		})
+		assertEqual(t, err, nil)
+		m, err := serialize(v)
+		assertEqual(t, err, nil)
+		b, err := json.Marshal(m)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), `{"@context":["https://www.w3.org/ns/activitystreams",{"t":"http://joinmastodon.org/ns"}],"mediaType":"image/png","name":"A description","t:blurhash":"UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH","t:focalPoint":[-0.5,0.25],"type":"Image","url":"https://example.com/note/1"}`)
+	})
+}
+
+func TestGetAttachments(t *testing.T) {
+	t.Run("RoundTrips", func(t *testing.T) {
+		expected := []Attachment{
+			{
+				Type:        "Image",
+				URL:         mustParse(testNoteId1),
+				MediaType:   "image/png",
+				Description: "A description",
+				Blurhash:    "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH",
+				FocalPoint:  &FocalPoint{X: -0.5, Y: 0.25},
+			},
+			{
+				Type:      "Audio",
+				URL:       mustParse(testNoteId2),
+				MediaType: "audio/ogg",
+			},
+		}
+		note := streams.NewActivityStreamsNote()
+		err := AppendAttachments(note, expected...)
+		assertEqual(t, err, nil)
+		m, err := serialize(note)
+		assertEqual(t, err, nil)
+		b, err := json.Marshal(m)
+		assertEqual(t, err, nil)
+		var raw map[string]interface{}
+		err = json.Unmarshal(b, &raw)
+		assertEqual(t, err, nil)
+		v, err := streams.ToType(context.Background(), raw)
+		assertEqual(t, err, nil)
+		actual, err := GetAttachments(v)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(actual), len(expected))
+		for i := range expected {
+			assertEqual(t, actual[i].Type, expected[i].Type)
+			assertEqual(t, actual[i].URL.String(), expected[i].URL.String())
+			assertEqual(t, actual[i].MediaType, expected[i].MediaType)
+			assertEqual(t, actual[i].Description, expected[i].Description)
+			assertEqual(t, actual[i].Blurhash, expected[i].Blurhash)
+			if expected[i].FocalPoint == nil {
+				assertEqual(t, actual[i].FocalPoint == nil, true)
+			} else {
+				assertEqual(t, *actual[i].FocalPoint, *expected[i].FocalPoint)
+			}
+		}
+	})
+	t.Run("ReadsLinkURL", func(t *testing.T) {
+		var raw map[string]interface{}
+		err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "attachment": [
+    {
+      "type": "PropertyValue",
+      "name": "Website",
+      "value": "https://example.com"
+    },
+    {
+      "type": "Video",
+      "name": "A video",
+      "url": {
+        "type": "Link",
+        "href": "https://example.com/video.mp4",
+        "mediaType": "video/mp4"
+      }
+    }
+  ]
+}`), &raw)
+		assertEqual(t, err, nil)
+		v, err := streams.ToType(context.Background(), raw)
+		assertEqual(t, err, nil)
+		actual, err := GetAttachments(v)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(actual), 1)
+		assertEqual(t, actual[0].Type, "Video")
+		assertEqual(t, actual[0].URL.String(), "https://example.com/video.mp4")
+		assertEqual(t, actual[0].MediaType, "video/mp4")
+		assertEqual(t, actual[0].Description, "A video")
+	})
+}
+
+func TestSelectMediaVariant(t *testing.T) {
+	newVideo := func(t *testing.T) vocab.ActivityStreamsVideo {
+		video := streams.NewActivityStreamsVideo()
+		err := AppendMediaVariants(video,
+			MediaVariant{URL: mustParse("https://example.com/video-1080.mp4"), MediaType: "video/mp4", Width: 1920, Height: 1080},
+			MediaVariant{URL: mustParse("https://example.com/video-480.mp4"), MediaType: "video/mp4", Width: 854, Height: 480},
+			MediaVariant{URL: mustParse("https://example.com/video-720.webm"), MediaType: "video/webm", Width: 1280, Height: 720},
+			MediaVariant{URL: mustParse("https://example.com/video.torrent"), MediaType: "application/x-bittorrent"})
+		assertEqual(t, err, nil)
+		return video
+	}
+	tests := []struct {
+		name        string
+		constraints MediaConstraints
+		expected    string
+		isErr       bool
+	}{
+		{
+			name:        "Largest Without Constraints",
+			constraints: MediaConstraints{},
+			expected:    "https://example.com/video-1080.mp4",
+		},
+		{
+			name:        "Largest Within Bounds",
+			constraints: MediaConstraints{MaxWidth: 1280},
+			expected:    "https://example.com/video-720.webm",
+		},
+		{
+			name:        "Smallest If None Within Bounds",
+			constraints: MediaConstraints{MediaTypes: []string{"video/mp4"}, MaxHeight: 240},
+			expected:    "https://example.com/video-480.mp4",
+		},
+		{
+			name:        "Preferred Media Type",
+			constraints: MediaConstraints{MediaTypes: []string{"video/webm", "video/mp4"}, MaxWidth: 1920},
+			expected:    "https://example.com/video-720.webm",
+		},
+		{
+			name:        "Wildcard Media Type",
+			constraints: MediaConstraints{MediaTypes: []string{"application/*"}},
+			expected:    "https://example.com/video.torrent",
+		},
+		{
+			name:        "No Acceptable Media Type",
+			constraints: MediaConstraints{MediaTypes: []string{"image/png"}},
+			isErr:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := SelectMediaVariant(newVideo(t), test.constraints)
+			if test.isErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			assertEqual(t, err, nil)
+			assertEqual(t, v.URL.String(), test.expected)
+		})
+	}
+	t.Run("UsesMediaTypeOfValueForIRIs", func(t *testing.T) {
+		image, err := NewAttachment(Attachment{
+			URL:       mustParse("https://example.com/icon.png"),
+			MediaType: "image/png",
+		})
+		assertEqual(t, err, nil)
+		v, err := SelectMediaVariant(image, MediaConstraints{MediaTypes: []string{"image/*"}})
+		assertEqual(t, err, nil)
+		assertEqual(t, v.URL.String(), "https://example.com/icon.png")
+		assertEqual(t, v.MediaType, "image/png")
+	})
+}
@@ -0,0 +1,104 @@
+package pub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// AudienceCache caches the inboxes that a Collection or OrderedCollection
+// resolves to when delivering, so consecutive deliveries addressed to the same
+// collection, such as an actor's followers, do not dereference every one of
+// its members each time.
+//
+// A FederatingProtocol may implement AudienceCache to enable the caching. The
+// collection itself is still dereferenced on every delivery, and its version
+// identifies the members it had when its inboxes were resolved. A cached entry
+// must only be returned for the same collection and version.
+type AudienceCache interface {
+	// GetAudience returns the inboxes the collection resolved to at the
+	// version, and whether they were found.
+	GetAudience(c context.Context, collectionIRI *url.URL, version string) (inboxes []*url.URL, found bool, err error)
+	// SetAudience caches the inboxes the collection resolved to at the
+	// version.
+	SetAudience(c context.Context, collectionIRI *url.URL, version string, inboxes []*url.URL) error
+}
+
+// collectionVersion identifies the members of a collection, regardless of
+// their order.
+func collectionVersion(members []*url.URL) string {
+	s := make([]string, 0, len(members))
+	for _, m := range members {
+		s = append(s, m.String())
+	}
+	sort.Strings(s)
+	h := sha256.New()
+	for _, m := range s {
+		h.Write([]byte(m))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// memoryAudienceEntry is the latest version of a collection's resolved inboxes.
+type memoryAudienceEntry struct {
+	version string
+	inboxes []*url.URL
+	expires time.Time
+}
+
+// memoryAudienceCache is an AudienceCache keeping the latest version of each
+// collection in memory for a short time.
+type memoryAudienceCache struct {
+	mu      sync.Mutex
+	clock   Clock
+	ttl     time.Duration
+	entries map[string]memoryAudienceEntry
+}
+
+// NewMemoryAudienceCache creates an AudienceCache that keeps the inboxes of the
+// latest version of each collection in memory, for the duration of the ttl.
+//
+// A short ttl, such as a few minutes, suits bursts of activities addressed to
+// the same followers while bounding how long a peer that moved its inbox is
+// delivered to at its old one.
+func NewMemoryAudienceCache(clock Clock, ttl time.Duration) AudienceCache {
+	return &memoryAudienceCache{
+		clock:   clock,
+		ttl:     ttl,
+		entries: make(map[string]memoryAudienceEntry),
+	}
+}
+
+// GetAudience returns the inboxes of the collection at the version, unless
+// they expired.
+func (m *memoryAudienceCache) GetAudience(c context.Context, collectionIRI *url.URL, version string) (inboxes []*url.URL, found bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[collectionIRI.String()]
+	if !ok || e.version != version {
+		return nil, false, nil
+	}
+	if !m.clock.Now().Before(e.expires) {
+		delete(m.entries, collectionIRI.String())
+		return nil, false, nil
+	}
+	return e.inboxes, true, nil
+}
+
+// SetAudience caches the inboxes of the collection at the version, replacing
+// any other version.
+func (m *memoryAudienceCache) SetAudience(c context.Context, collectionIRI *url.URL, version string, inboxes []*url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[collectionIRI.String()] = memoryAudienceEntry{
+		version: version,
+		inboxes: inboxes,
+		expires: m.clock.Now().Add(m.ttl),
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// audienceCacheProtocol is a FederatingProtocol that is an AudienceCache.
+type audienceCacheProtocol struct {
+	*MockFederatingProtocol
+	AudienceCache
+}
+
+// newPersonWithInbox creates a Person with the id and an inbox.
+func newPersonWithInbox(id string) vocab.ActivityStreamsPerson {
+	p := streams.NewActivityStreamsPerson()
+	idProp := streams.NewActivityStreamsIdProperty()
+	idProp.Set(mustParse(id))
+	p.SetActivityStreamsId(idProp)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(mustParse(id + "/inbox"))
+	p.SetActivityStreamsInbox(inbox)
+	return p
+}
+
+func TestMemoryAudienceCache(t *testing.T) {
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	cache := NewMemoryAudienceCache(clock, time.Minute)
+	col := mustParse(testAudienceIRI)
+	inboxes := []*url.URL{mustParse(testFederatedActorIRI + "/inbox")}
+	err := cache.SetAudience(ctx, col, "v1", inboxes)
+	assertEqual(t, err, nil)
+	actual, found, err := cache.GetAudience(ctx, col, "v1")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, true)
+	assertEqual(t, fmt.Sprint(actual), fmt.Sprint(inboxes))
+	_, found, err = cache.GetAudience(ctx, col, "v2")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, false)
+	now = now.Add(time.Minute)
+	_, found, err = cache.GetAudience(ctx, col, "v1")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, false)
+}
+
+func TestCollectionVersion(t *testing.T) {
+	a := collectionVersion([]*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+	b := collectionVersion([]*url.URL{mustParse(testFederatedActorIRI2), mustParse(testFederatedActorIRI)})
+	c := collectionVersion([]*url.URL{mustParse(testFederatedActorIRI)})
+	assertEqual(t, a, b)
+	assertEqual(t, a == c, false)
+}
+
+func TestResolveInboxesWithAudienceCache(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	a := &sideEffectActor{
+		s2s: &audienceCacheProtocol{
+			MockFederatingProtocol: NewMockFederatingProtocol(ctl),
+			AudienceCache:          NewMemoryAudienceCache(clock, time.Minute),
+		},
+	}
+	tp := NewMockTransport(ctl)
+	gomock.InOrder(
+		tp.EXPECT().Dereference(ctx, mustParse(testAudienceIRI)).Return(mustSerializeToBytes(testCollectionOfActors), nil),
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(mustSerializeToBytes(newPersonWithInbox(testFederatedActorIRI)), nil),
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(mustSerializeToBytes(newPersonWithInbox(testFederatedActorIRI2)), nil),
+		// Only the collection is dereferenced again.
+		tp.EXPECT().Dereference(ctx, mustParse(testAudienceIRI)).Return(mustSerializeToBytes(testCollectionOfActors), nil),
+	)
+	expected := fmt.Sprint([]*url.URL{
+		mustParse(testFederatedActorIRI + "/inbox"),
+		mustParse(testFederatedActorIRI2 + "/inbox"),
+	})
+	for i := 0; i < 2; i++ {
+		inboxes, err := a.resolveInboxes(ctx, tp, []*url.URL{mustParse(testAudienceIRI)}, 0, 0)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(inboxes), expected)
+	}
+}
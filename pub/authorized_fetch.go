@@ -0,0 +1,107 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	// The WWW-Authenticate header.
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	// signatureChallenge asks for the headers Mastodon signs on GET requests.
+	signatureChallenge = `Signature realm="activitypub",headers="(request-target) host date"`
+)
+
+// ObjectAuthenticator may be implemented by a CommonBehavior, or by a
+// DelegateActor, to require valid HTTP Signatures on the GET requests of the
+// actor's inbox and outbox, as Mastodon's authorized fetch mode does. It is
+// called before AuthenticateGetInbox and AuthenticateGetOutbox.
+//
+// AuthorizedFetch implements it with a SignatureVerifier.
+type ObjectAuthenticator interface {
+	// AuthenticateGetObject verifies the HTTP Signature of a GET request,
+	// and returns the context to continue serving the request with, such
+	// as one identifying the signer with WithSourceReader.
+	//
+	// If the signature is missing or invalid, an error is returned and
+	// the request is responded 401 Unauthorized, with a challenge asking
+	// for a signature.
+	AuthenticateGetObject(c context.Context, r *http.Request) (context.Context, error)
+}
+
+// AuthorizedFetch requires the GET requests of ActivityPub endpoints to be
+// signed by the actors of peers.
+//
+// A CommonBehavior embeds it to enforce authorized fetch on the actor's inbox
+// and outbox, and its Authenticate method wraps the AuthenticateFunc of the
+// handlers of NewActivityStreamsHandler.
+type AuthorizedFetch struct {
+	verifier *SignatureVerifier
+}
+
+// ObjectAuthenticator must be implemented by AuthorizedFetch.
+var _ ObjectAuthenticator = &AuthorizedFetch{}
+
+// NewAuthorizedFetch creates an AuthorizedFetch verifying the signatures with
+// the SignatureVerifier.
+func NewAuthorizedFetch(v *SignatureVerifier) *AuthorizedFetch {
+	return &AuthorizedFetch{verifier: v}
+}
+
+// AuthenticateGetObject verifies the HTTP Signature of the request, and returns
+// a context identifying the signer with WithSourceReader.
+func (f *AuthorizedFetch) AuthenticateGetObject(c context.Context, r *http.Request) (context.Context, error) {
+	signer, err := f.verifier.Verify(c, r)
+	if err != nil {
+		return c, err
+	}
+	return WithSourceReader(c, signer), nil
+}
+
+// Authenticate returns an AuthenticateFunc responding 401 Unauthorized to the
+// requests without a valid HTTP Signature, and authenticating the others with
+// the next AuthenticateFunc, if any.
+func (f *AuthorizedFetch) Authenticate(next AuthenticateFunc) AuthenticateFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (shouldReturn bool, err error) {
+		if _, err := f.verifier.Verify(c, r); err != nil {
+			writeUnauthorized(w)
+			return true, nil
+		}
+		if next == nil {
+			return false, nil
+		}
+		return next(c, w, r)
+	}
+}
+
+// authenticateGetObject verifies the HTTP Signature of a GET request if the
+// delegate requires authorized fetch, and returns the context to continue
+// serving the request with. The request is responded 401 Unauthorized if it
+// does not verify.
+func (b *baseActor) authenticateGetObject(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	oa, ok := b.delegate.(ObjectAuthenticator)
+	if !ok {
+		return c, true
+	}
+	ac, err := oa.AuthenticateGetObject(c, r)
+	if err != nil {
+		writeUnauthorized(w)
+		return c, false
+	}
+	return ac, true
+}
+
+// AuthenticateGetObject defers to the delegate to verify the HTTP Signature of
+// the request, if it requires authorized fetch.
+func (a *sideEffectActor) AuthenticateGetObject(c context.Context, r *http.Request) (context.Context, error) {
+	if oa, ok := a.common.(ObjectAuthenticator); ok {
+		return oa.AuthenticateGetObject(c, r)
+	}
+	return c, nil
+}
+
+// writeUnauthorized responds 401 Unauthorized, asking for an HTTP Signature.
+func writeUnauthorized(w http.ResponseWriter) {
+	w.Header().Set(wwwAuthenticateHeader, signatureChallenge)
+	w.WriteHeader(http.StatusUnauthorized)
+}
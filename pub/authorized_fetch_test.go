@@ -0,0 +1,115 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// authorizedFetchCommon is a CommonBehavior requiring signed GET requests.
+type authorizedFetchCommon struct {
+	*MockCommonBehavior
+	*AuthorizedFetch
+}
+
+func TestAuthorizedFetch(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	keyId := testFederatedActorIRI + "#main-key"
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pem, err := EncodePublicKeyPEM(privKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	actorJSON := []byte(fmt.Sprintf(`{"id":%q,"type":"Person","publicKey":{"id":%q,"owner":%q,"publicKeyPem":%q}}`,
+		testFederatedActorIRI, keyId, testFederatedActorIRI, pem))
+	sign := func(r *http.Request) *http.Request {
+		r.Header.Set("Date", now.Format(http.TimeFormat))
+		if err := NewRSASHA256Signer("(request-target)", "host", "date").SignRequest(privKey, keyId, r); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	setupFn := func(ctl *gomock.Controller) (tp *MockTransport, common *authorizedFetchCommon, a Actor) {
+		tp = NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		common = &authorizedFetchCommon{
+			MockCommonBehavior: NewMockCommonBehavior(ctl),
+			AuthorizedFetch:    NewAuthorizedFetch(NewSignatureVerifier(tp, clock, time.Hour)),
+		}
+		a = NewFederatingActor(common, NewMockFederatingProtocol(ctl), NewMockDatabase(ctl), clock)
+		return
+	}
+	t.Run("DeniesUnsignedGetInbox", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		handled, err := a.GetInbox(ctx, resp, toAPRequest(toGetInboxRequest()))
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, resp.Header().Get(wwwAuthenticateHeader), signatureChallenge)
+	})
+	t.Run("DeniesGetInboxSignedWithDateOnly", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl)
+		r := toAPRequest(toGetInboxRequest())
+		r.Header.Set("Date", now.Format(http.TimeFormat))
+		if err := NewRSASHA256Signer("date").SignRequest(privKey, keyId, r); err != nil {
+			t.Fatal(err)
+		}
+		resp := httptest.NewRecorder()
+		handled, err := a.GetInbox(ctx, resp, r)
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("IdentifiesSignerOfGetOutbox", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, common, a := setupFn(ctl)
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(keyId)).Return(actorJSON, nil)
+		var signer *url.URL
+		common.EXPECT().AuthenticateGetOutbox(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			signer, _ = c.Value(sourceReaderContextKey{}).(*url.URL)
+			w.WriteHeader(http.StatusForbidden)
+			return false, nil
+		})
+		resp := httptest.NewRecorder()
+		handled, err := a.GetOutbox(ctx, resp, sign(toAPRequest(toGetOutboxRequest())))
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("AuthenticateFuncDeniesUnsigned", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, common, _ := setupFn(ctl)
+		called := false
+		authFn := common.Authenticate(func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			called = true
+			return false, nil
+		})
+		resp := httptest.NewRecorder()
+		shouldReturn, err := authFn(ctx, resp, toAPRequest(httptest.NewRequest("GET", testNoteId1, nil)))
+		assertEqual(t, err, nil)
+		assertEqual(t, shouldReturn, true)
+		assertEqual(t, called, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+}
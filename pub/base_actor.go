@@ -71,6 +71,7 @@ func NewSocialActor(c CommonBehavior,
 			c2s:    c2s,
 			db:     db,
 			clock:  clock,
+			iris:   NewIRIInterner(defaultIRIInternerSize),
 		},
 		enableSocialProtocol: true,
 		clock:                clock,
@@ -100,6 +101,7 @@ func NewFederatingActor(c CommonBehavior,
 				s2s:    s2s,
 				db:     db,
 				clock:  clock,
+				iris:   NewIRIInterner(defaultIRIInternerSize),
 			},
 			enableFederatedProtocol: true,
 			clock:                   clock,
@@ -129,6 +131,7 @@ func NewActor(c CommonBehavior,
 				s2s:    s2s,
 				db:     db,
 				clock:  clock,
+				iris:   NewIRIInterner(defaultIRIInternerSize),
 			},
 			enableSocialProtocol:    true,
 			enableFederatedProtocol: true,
@@ -183,8 +186,11 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 	// Begin processing the request, but have not yet applied
 	// authorization (ex: blocks). Obtain the activity reject unknown
 	// activities.
-	raw, err := ioutil.ReadAll(r.Body)
-	if err != nil {
+	raw, err := readInboxBody(r, b.maxInboxBodyBytes(c))
+	if code := bodyErrorStatus(err); code != 0 {
+		w.WriteHeader(code)
+		return true, nil
+	} else if err != nil {
 		return true, err
 	}
 	var m map[string]interface{}
@@ -232,6 +238,9 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		if err == ErrObjectRequired || err == ErrTargetRequired {
 			w.WriteHeader(http.StatusBadRequest)
 			return true, nil
+		} else if err == ErrInteractionRejected {
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
 		}
 		return true, err
 	}
@@ -255,6 +264,11 @@ func (b *baseActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.R
 	if !isActivityPubGet(r) {
 		return false, nil
 	}
+	// Require a valid HTTP Signature in authorized fetch mode.
+	c, ok := b.authenticateGetObject(c, w, r)
+	if !ok {
+		return true, nil
+	}
 	// Delegate authenticating and authorizing the request.
 	authenticated, err := b.delegate.AuthenticateGetInbox(c, w, r)
 	if err != nil {
@@ -284,7 +298,7 @@ func (b *baseActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.R
 		return true, err
 	}
 	// Write the response.
-	addResponseHeaders(w.Header(), b.clock, raw)
+	addResponseHeaders(c, r, w.Header(), b.clock, raw)
 	w.WriteHeader(http.StatusOK)
 	n, err := w.Write(raw)
 	if err != nil {
@@ -344,12 +358,23 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	// The HTTP request steps are complete, complete the rest of the outbox
 	// and delivery process.
 	outboxId := requestId(r)
+	// Reject values whose side effects cannot succeed before any of them,
+	// listing what is wrong to the client.
+	if v, ok := b.delegate.(outboxValidator); ok {
+		if err = v.validateOutbox(c, outboxId, asValue); err != nil {
+			if ve, ok := err.(*ValidationError); ok {
+				return true, writeValidationError(w, ve)
+			}
+			return true, err
+		}
+	}
 	activity, err := b.deliver(c, outboxId, asValue, m)
 	// Special case: We know it is a bad request if the object or
-	// target properties needed to be populated, but weren't.
+	// target properties needed to be populated, but weren't, or the
+	// object referenced does not exist.
 	//
 	// Send the rejection to the client.
-	if err == ErrObjectRequired || err == ErrTargetRequired {
+	if err == ErrObjectRequired || err == ErrTargetRequired || err == ErrObjectNotFound {
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
 	} else if err != nil {
@@ -369,6 +394,11 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 	if !isActivityPubGet(r) {
 		return false, nil
 	}
+	// Require a valid HTTP Signature in authorized fetch mode.
+	c, ok := b.authenticateGetObject(c, w, r)
+	if !ok {
+		return true, nil
+	}
 	// Delegate authenticating and authorizing the request.
 	authenticated, err := b.delegate.AuthenticateGetOutbox(c, w, r)
 	if err != nil {
@@ -393,7 +423,7 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 		return true, err
 	}
 	// Write the response.
-	addResponseHeaders(w.Header(), b.clock, raw)
+	addResponseHeaders(c, r, w.Header(), b.clock, raw)
 	w.WriteHeader(http.StatusOK)
 	n, err := w.Write(raw)
 	if err != nil {
@@ -468,3 +498,16 @@ func (b *baseActor) deliver(c context.Context, outbox *url.URL, asValue vocab.Ty
 func (b *baseActorFederating) Send(c context.Context, outbox *url.URL, t vocab.Type) (Activity, error) {
 	return b.deliver(c, outbox, t, nil)
 }
+
+// ReplayInbox triggers the side effects of an activity received in the inbox
+// again, if the delegate supports it.
+func (b *baseActor) ReplayInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	if !b.enableFederatedProtocol {
+		return ErrReplayUnsupported
+	}
+	r, ok := b.delegate.(InboxReplayer)
+	if !ok {
+		return ErrReplayUnsupported
+	}
+	return r.ReplayInbox(c, inboxIRI, activity)
+}
@@ -105,7 +105,7 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusOK)
 		respV := resp.Result()
-		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"")
+		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/activity+json")
 		assertEqual(t, respV.Header.Get(dateHeader), nowDateHeader())
 		assertNotEqual(t, len(respV.Header.Get(digestHeader)), 0)
 		b, err := ioutil.ReadAll(respV.Body)
@@ -233,6 +233,32 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusCreated)
 	})
+	t.Run("PostOutboxDoesNotWrapIntransitiveActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(testArriveNoId))
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(true, nil)
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, toDeserializedForm(testArriveNoId)).Return(ctx, nil)
+		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testArriveNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+			activity = withNewId(activity)
+			return nil
+		})
+		delegate.EXPECT().PostOutbox(
+			ctx,
+			withNewId(toDeserializedForm(testArriveNoId)),
+			mustParse(testMyOutboxIRI),
+			mustSerialize(testArriveNoId),
+		).Return(true, nil)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+	})
 	t.Run("PostOutboxBadRequestForErrObjectRequired", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -332,7 +358,7 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusOK)
 		respV := resp.Result()
-		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"")
+		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/activity+json")
 		assertEqual(t, respV.Header.Get(dateHeader), nowDateHeader())
 		assertNotEqual(t, len(respV.Header.Get(digestHeader)), 0)
 		b, err := ioutil.ReadAll(respV.Body)
@@ -540,7 +566,7 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusOK)
 		respV := resp.Result()
-		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"")
+		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/activity+json")
 		assertEqual(t, respV.Header.Get(dateHeader), nowDateHeader())
 		assertNotEqual(t, len(respV.Header.Get(digestHeader)), 0)
 		b, err := ioutil.ReadAll(respV.Body)
@@ -643,7 +669,7 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusOK)
 		respV := resp.Result()
-		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"")
+		assertEqual(t, respV.Header.Get(contentTypeHeader), "application/activity+json")
 		assertEqual(t, respV.Header.Get(dateHeader), nowDateHeader())
 		assertNotEqual(t, len(respV.Header.Get(digestHeader)), 0)
 		b, err := ioutil.ReadAll(respV.Body)
@@ -0,0 +1,101 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+)
+
+// Blocklist may be implemented by a CommonBehavior to block domains and actors
+// from federating with the actors of the application.
+//
+// The activities posted to an inbox by blocked actors, or by actors of blocked
+// domains, are refused with 403 Forbidden before any side effect, like the
+// FederatingProtocol's Blocked does. Blocked recipients are silently skipped
+// when delivering, without being dereferenced, and so are the inboxes hosted
+// on blocked domains.
+type Blocklist interface {
+	// IsBlockedDomain determines whether the domain, such as
+	// "example.com", is blocked.
+	IsBlockedDomain(c context.Context, host string) (blocked bool, err error)
+	// IsBlockedActor determines whether the actor is blocked.
+	IsBlockedActor(c context.Context, actorIRI *url.URL) (blocked bool, err error)
+}
+
+// BlocklistHook may be implemented by a Blocklist to be notified of what it
+// blocked, such as for logging.
+type BlocklistHook interface {
+	// BlockedSender is called when the activity of a blocked sender is
+	// refused.
+	BlockedSender(c context.Context, actorIRI *url.URL)
+	// BlockedRecipient is called when a blocked recipient, or an inbox on
+	// a blocked domain, is skipped.
+	BlockedRecipient(c context.Context, iri *url.URL)
+}
+
+// isBlocked determines whether the IRI is of a blocked actor, or on a blocked
+// domain.
+func isBlocked(c context.Context, bl Blocklist, iri *url.URL) (bool, error) {
+	if blocked, err := bl.IsBlockedDomain(c, iri.Host); err != nil || blocked {
+		return blocked, err
+	}
+	return bl.IsBlockedActor(c, iri)
+}
+
+// isBlockedSender determines whether any of the actors sending an activity is
+// blocked by the Blocklist, if the application has one.
+func (a *sideEffectActor) isBlockedSender(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	bl, ok := a.common.(Blocklist)
+	if !ok {
+		return false, nil
+	}
+	for _, iri := range actorIRIs {
+		if blocked, err := isBlocked(c, bl, iri); err != nil {
+			return false, err
+		} else if blocked {
+			if h, ok := bl.(BlocklistHook); ok {
+				h.BlockedSender(c, iri)
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isBlockedRecipient determines whether a recipient is blocked by the
+// Blocklist, if the application has one.
+func (a *sideEffectActor) isBlockedRecipient(c context.Context, iri *url.URL) (bool, error) {
+	bl, ok := a.common.(Blocklist)
+	if !ok {
+		return false, nil
+	}
+	blocked, err := isBlocked(c, bl, iri)
+	if err == nil && blocked {
+		if h, ok := bl.(BlocklistHook); ok {
+			h.BlockedRecipient(c, iri)
+		}
+	}
+	return blocked, err
+}
+
+// filterBlockedInboxes removes the inboxes on blocked domains, such as the
+// shared inboxes of cached audiences.
+func (a *sideEffectActor) filterBlockedInboxes(c context.Context, inboxes []*url.URL) ([]*url.URL, error) {
+	bl, ok := a.common.(Blocklist)
+	if !ok {
+		return inboxes, nil
+	}
+	kept := inboxes[:0]
+	for _, inbox := range inboxes {
+		blocked, err := bl.IsBlockedDomain(c, inbox.Host)
+		if err != nil {
+			return nil, err
+		} else if blocked {
+			if h, ok := bl.(BlocklistHook); ok {
+				h.BlockedRecipient(c, inbox)
+			}
+			continue
+		}
+		kept = append(kept, inbox)
+	}
+	return kept, nil
+}
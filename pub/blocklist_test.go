@@ -0,0 +1,123 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+// blocklistCommon is a CommonBehavior blocking a domain and an actor, and
+// recording what it blocked.
+type blocklistCommon struct {
+	*MockCommonBehavior
+	senders    []*url.URL
+	recipients []*url.URL
+}
+
+func (b *blocklistCommon) IsBlockedDomain(c context.Context, host string) (bool, error) {
+	return host == "blocked.example.com", nil
+}
+
+func (b *blocklistCommon) IsBlockedActor(c context.Context, actorIRI *url.URL) (bool, error) {
+	return actorIRI.String() == testFederatedActorIRI2, nil
+}
+
+func (b *blocklistCommon) BlockedSender(c context.Context, actorIRI *url.URL) {
+	b.senders = append(b.senders, actorIRI)
+}
+
+func (b *blocklistCommon) BlockedRecipient(c context.Context, iri *url.URL) {
+	b.recipients = append(b.recipients, iri)
+}
+
+func TestBlocklist(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (common *blocklistCommon, fp *MockFederatingProtocol, tp *MockTransport, a *sideEffectActor) {
+		setupData()
+		common = &blocklistCommon{MockCommonBehavior: NewMockCommonBehavior(ctl)}
+		fp = NewMockFederatingProtocol(ctl)
+		tp = NewMockTransport(ctl)
+		a = &sideEffectActor{
+			common: common,
+			s2s:    fp,
+			db:     NewMockDatabase(ctl),
+			clock:  NewMockClock(ctl),
+		}
+		return
+	}
+	t.Run("AuthorizesUnblockedSender", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, a := setupFn(ctl)
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		resp := httptest.NewRecorder()
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		assertEqual(t, err, nil)
+		assertEqual(t, b, true)
+	})
+	t.Run("ForbidsBlockedSender", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		common, _, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate2)
+		assertEqual(t, err, nil)
+		assertEqual(t, b, false)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+		assertEqual(t, len(common.senders), 1)
+		assertEqual(t, common.senders[0].String(), testFederatedActorIRI2)
+	})
+	t.Run("ForbidsBlockedEmbeddedSender", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		common, _, _, a := setupFn(ctl)
+		person := streams.NewActivityStreamsPerson()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testFederatedActorIRI2))
+		person.SetActivityStreamsId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendActivityStreamsPerson(person)
+		create := streams.NewActivityStreamsCreate()
+		activityId := streams.NewActivityStreamsIdProperty()
+		activityId.Set(mustParse(testFederatedActivityIRI))
+		create.SetActivityStreamsId(activityId)
+		create.SetActivityStreamsActor(actor)
+		resp := httptest.NewRecorder()
+		b, err := a.AuthorizePostInbox(ctx, resp, create)
+		assertEqual(t, err, nil)
+		assertEqual(t, b, false)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+		assertEqual(t, len(common.senders), 1)
+		assertEqual(t, common.senders[0].String(), testFederatedActorIRI2)
+	})
+	t.Run("SkipsBlockedRecipientsWithoutDereferencing", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		common, _, tp, a := setupFn(ctl)
+		inboxes, err := a.resolveInboxes(ctx, tp, []*url.URL{
+			mustParse(testFederatedActorIRI2),
+			mustParse("https://blocked.example.com/person"),
+		}, 0, 1)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(inboxes), 0)
+		assertEqual(t, len(common.recipients), 2)
+	})
+	t.Run("SkipsInboxesOnBlockedDomains", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		common, _, _, a := setupFn(ctl)
+		inboxes, err := a.filterBlockedInboxes(ctx, []*url.URL{
+			mustParse("https://blocked.example.com/inbox"),
+			mustParse(testMyInboxIRI),
+		})
+		assertEqual(t, err, nil)
+		assertEqual(t, len(inboxes), 1)
+		assertEqual(t, inboxes[0].String(), testMyInboxIRI)
+		assertEqual(t, len(common.recipients), 1)
+	})
+}
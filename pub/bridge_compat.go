@@ -0,0 +1,97 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// endpointsProperty is the ActivityPub 'endpoints' property of actors,
+	// which is not part of the generated vocabulary.
+	endpointsProperty = "endpoints"
+	// sharedInboxProperty is the 'sharedInbox' of an actor's endpoints.
+	sharedInboxProperty = "sharedInbox"
+)
+
+// BridgeCompatibility holds the settings that tolerate payloads produced by
+// bridges, such as Bridgy Fed and AT Protocol bridges, which do not follow every
+// ActivityPub convention. Everything is disabled by default.
+//
+// Bridged actor ids often have unusual paths, such as another site's URL or a
+// DID, which need no setting since only their hosts are ever compared.
+type BridgeCompatibility struct {
+	// SharedInboxFallback delivers to the sharedInbox endpoint of actors
+	// that have no inbox.
+	SharedInboxFallback bool
+	// OffOriginObjectHosts are the hosts, such as "web.brid.gy", whose
+	// Update and Delete activities may have objects with ids on other
+	// hosts. Objects of activities from other hosts must share their
+	// host.
+	OffOriginObjectHosts []string
+}
+
+// allowsOffOriginObjects determines whether the activities from the host may
+// have objects with ids on other hosts.
+func (b BridgeCompatibility) allowsOffOriginObjects(host string) bool {
+	for _, h := range b.OffOriginObjectHosts {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// BridgeCompatibilityProvider may be implemented by a FederatingProtocol to
+// enable BridgeCompatibility for the payloads it receives and the deliveries
+// it makes.
+type BridgeCompatibilityProvider interface {
+	// BridgeCompatibility returns the settings for the context.
+	BridgeCompatibility(c context.Context) BridgeCompatibility
+}
+
+// bridgeCompatibility returns the settings of the FederatingProtocol, if it
+// provides them.
+func (a *sideEffectActor) bridgeCompatibility(c context.Context) BridgeCompatibility {
+	if p, ok := a.s2s.(BridgeCompatibilityProvider); ok {
+		return p.BridgeCompatibility(c)
+	}
+	return BridgeCompatibility{}
+}
+
+// getDeliveryInbox extracts the IRI to deliver to from an actor type, which is
+// its 'inbox', or else its sharedInbox if the BridgeCompatibility allows it.
+func getDeliveryInbox(t vocab.Type, b BridgeCompatibility) (*url.URL, error) {
+	u, err := getInbox(t)
+	if err == nil || !b.SharedInboxFallback {
+		return u, err
+	}
+	if shared := getSharedInbox(t); shared != nil {
+		return shared, nil
+	}
+	return nil, err
+}
+
+// getSharedInbox extracts the sharedInbox endpoint IRI from an actor type, or
+// returns nil if it has none.
+func getSharedInbox(t vocab.Type) *url.URL {
+	u, ok := t.(unknownPropertieser)
+	if !ok {
+		return nil
+	}
+	endpoints, ok := u.GetUnknownProperties()[endpointsProperty].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	s, ok := endpoints[sharedInboxProperty].(string)
+	if !ok {
+		return nil
+	}
+	shared, err := url.Parse(s)
+	if err != nil || !shared.IsAbs() {
+		return nil
+	}
+	return shared
+}
@@ -0,0 +1,71 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// mustToType deserializes an ActivityStreams value.
+func mustToType(s string) vocab.Type {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		panic(err)
+	}
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestGetDeliveryInbox(t *testing.T) {
+	// A bridged actor, with a DID in its id, that only has a shared inbox.
+	bridged := mustToType(`{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type": "Person",
+		"id": "https://bsky.brid.gy/ap/did:plc:abc123",
+		"endpoints": {"sharedInbox": "https://bsky.brid.gy/ap/sharedInbox"}
+	}`)
+	t.Run("ErrorsWithoutFallback", func(t *testing.T) {
+		_, err := getDeliveryInbox(bridged, BridgeCompatibility{})
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("FallsBackToSharedInbox", func(t *testing.T) {
+		u, err := getDeliveryInbox(bridged, BridgeCompatibility{SharedInboxFallback: true})
+		assertEqual(t, err, nil)
+		assertEqual(t, u.String(), "https://bsky.brid.gy/ap/sharedInbox")
+	})
+	t.Run("PrefersInbox", func(t *testing.T) {
+		actor := mustToType(`{
+			"@context": "https://www.w3.org/ns/activitystreams",
+			"type": "Person",
+			"id": "https://web.brid.gy/r/https://example.com/",
+			"inbox": "https://web.brid.gy/r/https://example.com/inbox",
+			"endpoints": {"sharedInbox": "https://web.brid.gy/inbox"}
+		}`)
+		u, err := getDeliveryInbox(actor, BridgeCompatibility{SharedInboxFallback: true})
+		assertEqual(t, err, nil)
+		assertEqual(t, u.String(), "https://web.brid.gy/r/https://example.com/inbox")
+	})
+}
+
+func TestMustHaveActivityOriginMatchObjectsWithBridges(t *testing.T) {
+	update := mustToType(`{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type": "Update",
+		"id": "https://web.brid.gy/r/https://example.com/post#update",
+		"object": "https://example.com/post"
+	}`).(Activity)
+	err := mustHaveActivityOriginMatchObjects(update, BridgeCompatibility{})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	err = mustHaveActivityOriginMatchObjects(update, BridgeCompatibility{OffOriginObjectHosts: []string{"web.brid.gy"}})
+	assertEqual(t, err, nil)
+}
@@ -0,0 +1,210 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// InboundTopic is the topic the BrokerConnector publishes accepted
+	// inbound activities to.
+	InboundTopic = "activitypub.inbound"
+	// DeliveryTopic is the topic the BrokerConnector publishes delivery
+	// events to.
+	DeliveryTopic = "activitypub.delivery"
+	// InteractionTopic is the topic the BrokerConnector publishes the
+	// decisions of interaction policies to.
+	InteractionTopic = "activitypub.interaction"
+)
+
+// Broker publishes messages to a message broker, such as a Kafka topic or a NATS
+// subject. Adapting a broker client only needs its publish call, such as:
+//
+//	type natsBroker struct{ nc *nats.Conn }
+//
+//	func (n natsBroker) Publish(c context.Context, topic string, message []byte) error {
+//	    return n.nc.Publish(topic, message)
+//	}
+type Broker interface {
+	// Publish sends the message to the topic.
+	Publish(c context.Context, topic string, message []byte) error
+}
+
+// InboundEvent is the message published for an accepted inbound activity.
+type InboundEvent struct {
+	// Inbox is the IRI of the inbox the activity was received in.
+	Inbox string `json:"inbox"`
+	// Activity is the serialized activity.
+	Activity json.RawMessage `json:"activity"`
+	// Time is when the activity was accepted.
+	Time time.Time `json:"time"`
+}
+
+// DeliveryEvent is the message published for a delivery attempt.
+type DeliveryEvent struct {
+	// Inbox is the IRI of the inbox delivered to.
+	Inbox string `json:"inbox"`
+	// StatusCode is the status code of the response, or zero if there was
+	// none.
+	StatusCode int `json:"statusCode,omitempty"`
+	// Error describes why the request failed, if it did.
+	Error string `json:"error,omitempty"`
+	// Latency is the duration of the request, in milliseconds.
+	Latency int64 `json:"latency"`
+	// Time is when the delivery was attempted.
+	Time time.Time `json:"time"`
+}
+
+// InteractionEvent is the message published for the decision on an inbound
+// interaction with an object with an interaction policy.
+type InteractionEvent struct {
+	// Inbox is the IRI of the inbox the activity was posted to.
+	Inbox string `json:"inbox"`
+	// Object is the IRI of the object interacted with.
+	Object string `json:"object"`
+	// Decision is "permitted", "pendingApproval" or "rejected".
+	Decision string `json:"decision"`
+	// Activity is the serialized activity.
+	Activity json.RawMessage `json:"activity"`
+	// Time is when the interaction was decided.
+	Time time.Time `json:"time"`
+}
+
+// OutboxSubmission is the message consumed to post an activity to an outbox.
+type OutboxSubmission struct {
+	// Outbox is the IRI of the outbox to post to.
+	Outbox string `json:"outbox"`
+	// Activity is the serialized activity or object. Objects are wrapped
+	// in a Create activity.
+	Activity json.RawMessage `json:"activity"`
+}
+
+// BrokerConnector connects the federation to a message broker, so other
+// services may be built around it: it publishes accepted inbound activities and
+// delivery events, and posts the outbox submissions consumed from the broker.
+//
+// It is wired in by:
+//
+//   - embedding it in a FederatingProtocol, whose InboxAccepted and
+//     InteractionDecided it then implements, to publish inbound activities
+//     and the decisions of the interaction policies they are subject to,
+//     combining it with InboxAcceptedHooks if the FederatingProtocol has
+//     other InboxAcceptedHooks,
+//   - setting its RequestLogger on HttpSigTransports to publish delivery
+//     events, and
+//   - calling HandleOutboxSubmission from the broker client's consumer.
+type BrokerConnector struct {
+	broker Broker
+	clock  Clock
+}
+
+// InboxAcceptedHook must be implemented by BrokerConnector.
+var _ InboxAcceptedHook = &BrokerConnector{}
+
+// InteractionPolicyHook must be implemented by BrokerConnector.
+var _ InteractionPolicyHook = &BrokerConnector{}
+
+// NewBrokerConnector creates a BrokerConnector publishing to the Broker.
+func NewBrokerConnector(broker Broker, clock Clock) *BrokerConnector {
+	return &BrokerConnector{
+		broker: broker,
+		clock:  clock,
+	}
+}
+
+// InboxAccepted publishes the accepted activity to the InboundTopic.
+func (b *BrokerConnector) InboxAccepted(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	raw, err := marshal(c, nil, activity, false)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(InboundEvent{
+		Inbox:    inboxIRI.String(),
+		Activity: raw,
+		Time:     b.clock.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	return b.broker.Publish(c, InboundTopic, msg)
+}
+
+// InteractionDecided publishes the decision on the interaction to the
+// InteractionTopic.
+func (b *BrokerConnector) InteractionDecided(c context.Context, inboxIRI *url.URL, activity Activity, object *url.URL, d InteractionDecision) error {
+	raw, err := marshal(c, nil, activity, false)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(InteractionEvent{
+		Inbox:    inboxIRI.String(),
+		Object:   object.String(),
+		Decision: d.String(),
+		Activity: raw,
+		Time:     b.clock.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	return b.broker.Publish(c, InteractionTopic, msg)
+}
+
+// RequestLogger returns a RequestLogger publishing every POST request to the
+// DeliveryTopic. If next is not nil, it is also called with every RequestLog.
+//
+// Failures to publish are ignored, since they cannot fail the delivery.
+func (b *BrokerConnector) RequestLogger(next RequestLogger) RequestLogger {
+	return func(c context.Context, l RequestLog) {
+		if l.Method == http.MethodPost && l.URL != nil {
+			e := DeliveryEvent{
+				Inbox:      l.URL.String(),
+				StatusCode: l.StatusCode,
+				Latency:    int64(l.Latency / time.Millisecond),
+				Time:       b.clock.Now().UTC(),
+			}
+			if l.Err != nil {
+				e.Error = l.Err.Error()
+			} else if !isSuccess(l.StatusCode) {
+				e.Error = http.StatusText(l.StatusCode)
+			}
+			if msg, err := json.Marshal(e); err == nil {
+				b.broker.Publish(c, DeliveryTopic, msg)
+			}
+		}
+		if next != nil {
+			next(c, l)
+		}
+	}
+}
+
+// HandleOutboxSubmission posts the activity of a consumed OutboxSubmission
+// message to its outbox with the actor, as if submitted by a client, and
+// returns the activity sent.
+func (b *BrokerConnector) HandleOutboxSubmission(c context.Context, actor FederatingActor, message []byte) (Activity, error) {
+	var s OutboxSubmission
+	if err := json.Unmarshal(message, &s); err != nil {
+		return nil, err
+	}
+	outbox, err := url.Parse(s.Outbox)
+	if err != nil {
+		return nil, err
+	} else if !outbox.IsAbs() {
+		return nil, fmt.Errorf("outbox submission has no absolute outbox IRI: %q", s.Outbox)
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(s.Activity, &m); err != nil {
+		return nil, err
+	}
+	var t vocab.Type
+	if t, err = streams.ToType(c, m); err != nil {
+		return nil, err
+	}
+	return actor.Send(c, outbox, t)
+}
@@ -0,0 +1,176 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// brokerMessage is a message published to a recordingBroker.
+type brokerMessage struct {
+	topic   string
+	message []byte
+}
+
+// recordingBroker is a Broker recording the messages published to it.
+type recordingBroker struct {
+	mu       sync.Mutex
+	messages []brokerMessage
+}
+
+func (r *recordingBroker) Publish(c context.Context, topic string, message []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, brokerMessage{topic, message})
+	return nil
+}
+
+// sendingActor is a FederatingActor recording the activities sent.
+type sendingActor struct {
+	FederatingActor
+	outbox string
+	sent   vocab.Type
+}
+
+func (s *sendingActor) Send(c context.Context, outbox *url.URL, t vocab.Type) (Activity, error) {
+	s.outbox = outbox.String()
+	s.sent = t
+	a, _ := t.(Activity)
+	return a, nil
+}
+
+// brokerProtocol is a FederatingProtocol publishing accepted activities.
+type brokerProtocol struct {
+	*MockFederatingProtocol
+	*BrokerConnector
+}
+
+func TestBrokerConnector(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	setupFn := func(ctl *gomock.Controller) (r *recordingBroker, b *BrokerConnector) {
+		setupData()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now).AnyTimes()
+		r = &recordingBroker{}
+		b = NewBrokerConnector(r, cl)
+		return
+	}
+	t.Run("PublishesInteractionDecided", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, b := setupFn(ctl)
+		err := b.InteractionDecided(ctx, mustParse(testMyInboxIRI), testListen, mustParse(testNoteId1), InteractionPendingApproval)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(r.messages), 1)
+		assertEqual(t, r.messages[0].topic, InteractionTopic)
+		var e InteractionEvent
+		err = json.Unmarshal(r.messages[0].message, &e)
+		assertEqual(t, err, nil)
+		assertEqual(t, e.Object, testNoteId1)
+		assertEqual(t, e.Decision, "pendingApproval")
+	})
+	t.Run("PublishesInboxAccepted", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, b := setupFn(ctl)
+		err := b.InboxAccepted(ctx, mustParse(testMyInboxIRI), testListen)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(r.messages), 1)
+		assertEqual(t, r.messages[0].topic, InboundTopic)
+		var e InboundEvent
+		err = json.Unmarshal(r.messages[0].message, &e)
+		assertEqual(t, err, nil)
+		assertEqual(t, e.Inbox, testMyInboxIRI)
+		assertEqual(t, e.Time.Equal(now), true)
+		var m map[string]interface{}
+		err = json.Unmarshal(e.Activity, &m)
+		assertEqual(t, err, nil)
+		assertEqual(t, m["type"], "Listen")
+	})
+	t.Run("PublishesDeliveries", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, b := setupFn(ctl)
+		var next int
+		l := b.RequestLogger(func(c context.Context, l RequestLog) {
+			next++
+		})
+		l(ctx, RequestLog{Method: http.MethodGet, URL: mustParse(testPersonIRI), StatusCode: http.StatusOK})
+		l(ctx, RequestLog{Method: http.MethodPost, URL: mustParse(testFederatedActorIRI + "/inbox"), StatusCode: http.StatusAccepted, Latency: 1500 * time.Millisecond})
+		l(ctx, RequestLog{Method: http.MethodPost, URL: mustParse(testFederatedActorIRI2 + "/inbox"), StatusCode: http.StatusServiceUnavailable})
+		l(ctx, RequestLog{Method: http.MethodPost, URL: mustParse(testFederatedActorIRI + "/inbox"), Err: errors.New("refused")})
+		assertEqual(t, next, 4)
+		assertEqual(t, len(r.messages), 3)
+		var e []DeliveryEvent
+		for _, m := range r.messages {
+			assertEqual(t, m.topic, DeliveryTopic)
+			var d DeliveryEvent
+			err := json.Unmarshal(m.message, &d)
+			assertEqual(t, err, nil)
+			e = append(e, d)
+		}
+		assertEqual(t, e[0].Inbox, testFederatedActorIRI+"/inbox")
+		assertEqual(t, e[0].StatusCode, http.StatusAccepted)
+		assertEqual(t, e[0].Latency, int64(1500))
+		assertEqual(t, e[0].Error, "")
+		assertEqual(t, e[1].Error, "Service Unavailable")
+		assertEqual(t, e[2].StatusCode, 0)
+		assertEqual(t, e[2].Error, "refused")
+	})
+	t.Run("SendsOutboxSubmission", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, b := setupFn(ctl)
+		actor := &sendingActor{}
+		msg := []byte(`{"outbox":"` + testMyOutboxIRI + `","activity":{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","content":"hello"}}`)
+		_, err := b.HandleOutboxSubmission(ctx, actor, msg)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.outbox, testMyOutboxIRI)
+		assertEqual(t, actor.sent.GetTypeName(), "Note")
+	})
+	t.Run("RejectsRelativeOutbox", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, b := setupFn(ctl)
+		actor := &sendingActor{}
+		msg := []byte(`{"outbox":"/outbox","activity":{"type":"Note"}}`)
+		_, err := b.HandleOutboxSubmission(ctx, actor, msg)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, actor.sent, nil)
+	})
+	t.Run("PublishedByPostInbox", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, b := setupFn(ctl)
+		fp := NewMockFederatingProtocol(ctl)
+		db := NewMockDatabase(ctl)
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    &brokerProtocol{fp, b},
+			db:     db,
+		}
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(r.messages), 1)
+		assertEqual(t, r.messages[0].topic, InboundTopic)
+	})
+}
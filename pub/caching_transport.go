@@ -0,0 +1,196 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConditionalDereferencer is a Transport able to revalidate a cached value with
+// a conditional GET request, such as the HttpSigTransport.
+type ConditionalDereferencer interface {
+	// DereferenceConditional is like Dereference, but sends the validators
+	// of a cached response, such as an If-None-Match header. If the peer
+	// responds with 304 Not Modified, notModified is true and the body is
+	// nil. The headers of the response are returned, so the caller may
+	// determine its freshness.
+	DereferenceConditional(c context.Context, iri *url.URL, validators http.Header) (body []byte, header http.Header, notModified bool, err error)
+}
+
+// validatorsContextKey is the context key of the validators of a conditional
+// GET request.
+type validatorsContextKey struct{}
+
+// withValidators returns a context making the GET requests made with it
+// conditional on the validators, and accepting 304 Not Modified responses.
+func withValidators(c context.Context, validators http.Header) context.Context {
+	return context.WithValue(c, validatorsContextKey{}, validators)
+}
+
+// validatorsFrom returns the validators of the context, or nil if its GET
+// requests are not conditional.
+func validatorsFrom(c context.Context) http.Header {
+	v, _ := c.Value(validatorsContextKey{}).(http.Header)
+	return v
+}
+
+// cachingTransportSweepSize is the number of cached values above which the
+// stale ones without validators are removed when another is cached.
+const cachingTransportSweepSize = 4096
+
+// cachedResponse is a dereferenced value cached by a CachingTransport.
+type cachedResponse struct {
+	body         []byte
+	etag         string
+	lastModified string
+	expires      time.Time
+}
+
+// CachingTransport is a Transport caching the values it dereferences, so the
+// actors and objects that side effects dereference repeatedly are fetched once
+// while they are fresh. Every other call is passed to the wrapped Transport.
+//
+// The Cache-Control, Expires, and Age headers of the responses determine how
+// long values are fresh, and responses with "no-store" are not cached. Values
+// without freshness information are fresh for the default TTL. If the wrapped
+// Transport is a ConditionalDereferencer, stale values with an ETag or
+// Last-Modified header are revalidated with conditional GET requests instead of
+// fetched again. Otherwise, the headers of the responses are unknown, and
+// values are fresh for the default TTL.
+//
+// Since a Transport makes requests on behalf of one actor, the cache is private
+// to the actor, and also caches responses with "private". It is safe for
+// concurrent use.
+type CachingTransport struct {
+	Transport
+	clock      Clock
+	defaultTTL time.Duration
+	mu         sync.Mutex
+	entries    map[string]cachedResponse
+}
+
+// NewCachingTransport wraps the Transport, caching the values without freshness
+// information for the default TTL.
+func NewCachingTransport(t Transport, clock Clock, defaultTTL time.Duration) *CachingTransport {
+	return &CachingTransport{
+		Transport:  t,
+		clock:      clock,
+		defaultTTL: defaultTTL,
+		entries:    make(map[string]cachedResponse),
+	}
+}
+
+// Dereference returns the cached value of the IRI while it is fresh, and
+// otherwise fetches or revalidates it with the wrapped Transport.
+func (t *CachingTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	k := iri.String()
+	now := t.clock.Now()
+	t.mu.Lock()
+	e, cached := t.entries[k]
+	t.mu.Unlock()
+	if cached && now.Before(e.expires) {
+		return e.body, nil
+	}
+	cd, ok := t.Transport.(ConditionalDereferencer)
+	if !ok {
+		b, err := t.Transport.Dereference(c, iri)
+		if err != nil {
+			return nil, err
+		}
+		t.store(k, cachedResponse{body: b, expires: now.Add(t.defaultTTL)}, now)
+		return b, nil
+	}
+	validators := http.Header{}
+	if cached && len(e.etag) > 0 {
+		validators.Set("If-None-Match", e.etag)
+	}
+	if cached && len(e.lastModified) > 0 {
+		validators.Set("If-Modified-Since", e.lastModified)
+	}
+	b, header, notModified, err := cd.DereferenceConditional(c, iri, validators)
+	if err != nil {
+		return nil, err
+	}
+	if notModified {
+		if !cached {
+			// The peer did not honor the validators.
+			return t.Transport.Dereference(c, iri)
+		}
+		b = e.body
+	}
+	if expires, store := t.freshUntil(header, now); store {
+		t.store(k, cachedResponse{
+			body:         b,
+			etag:         header.Get("ETag"),
+			lastModified: header.Get("Last-Modified"),
+			expires:      expires,
+		}, now)
+	} else {
+		t.Forget(iri)
+	}
+	return b, nil
+}
+
+// Forget removes the cached value of the IRI, such as when it is updated or
+// deleted by an activity.
+func (t *CachingTransport) Forget(iri *url.URL) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.entries, iri.String())
+}
+
+// store caches the value.
+func (t *CachingTransport) store(k string, e cachedResponse, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.entries) >= cachingTransportSweepSize {
+		for k, e := range t.entries {
+			if !now.Before(e.expires) && len(e.etag) == 0 && len(e.lastModified) == 0 {
+				delete(t.entries, k)
+			}
+		}
+	}
+	t.entries[k] = e
+}
+
+// freshUntil determines until when a response with the headers is fresh, and
+// whether it may be stored.
+func (t *CachingTransport) freshUntil(header http.Header, now time.Time) (expires time.Time, store bool) {
+	age := time.Duration(0)
+	if a, err := strconv.ParseInt(header.Get("Age"), 10, 64); err == nil && a > 0 {
+		age = time.Duration(a) * time.Second
+	}
+	for _, d := range strings.Split(header.Get(cacheControlHeader), ",") {
+		kv := strings.SplitN(strings.TrimSpace(d), "=", 2)
+		switch strings.ToLower(kv[0]) {
+		case "no-store":
+			return time.Time{}, false
+		case "no-cache":
+			// Stored, but revalidated before every use.
+			return now, true
+		case "max-age":
+			if len(kv) == 2 {
+				if s, err := strconv.ParseInt(strings.Trim(kv[1], `"`), 10, 64); err == nil {
+					return now.Add(time.Duration(s)*time.Second - age), true
+				}
+			}
+		}
+	}
+	if e := header.Get("Expires"); len(e) > 0 {
+		exp, err := http.ParseTime(e)
+		if err != nil {
+			// Invalid dates are in the past.
+			return now, true
+		}
+		// The peer's clock may differ from this server's.
+		if date, err := http.ParseTime(header.Get(dateHeader)); err == nil {
+			return now.Add(exp.Sub(date) - age), true
+		}
+		return exp, true
+	}
+	return now.Add(t.defaultTTL), true
+}
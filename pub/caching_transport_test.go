@@ -0,0 +1,136 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCachingTransport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	iri := mustParse(testFederatedActorIRI)
+	t.Run("CachesForDefaultTTL", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewFakeClock(now)
+		tp := NewMockTransport(ctl)
+		ct := NewCachingTransport(tp, clock, time.Minute)
+		tp.EXPECT().Dereference(ctx, iri).Return([]byte("{}"), nil).Times(2)
+		for i := 0; i < 2; i++ {
+			b, err := ct.Dereference(ctx, iri)
+			assertEqual(t, err, nil)
+			assertEqual(t, string(b), "{}")
+		}
+		clock.Advance(time.Minute)
+		_, err := ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ForgetsValue", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		ct := NewCachingTransport(tp, NewFakeClock(now), time.Minute)
+		tp.EXPECT().Dereference(ctx, iri).Return([]byte("{}"), nil).Times(2)
+		_, err := ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		ct.Forget(iri)
+		_, err = ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+	})
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// setupFn returns a CachingTransport of an HttpSigTransport whose peer
+	// responds with the headers, or 304 Not Modified to requests with the
+	// ETag, recording the If-None-Match header of each request.
+	setupFn := func(header http.Header) (clock *FakeClock, ct *CachingTransport, inm *[]string) {
+		clock = NewFakeClock(now)
+		inm = &[]string{}
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			*inm = append(*inm, req.Header.Get("If-None-Match"))
+			if etag := header.Get("ETag"); len(etag) > 0 && req.Header.Get("If-None-Match") == etag {
+				return &http.Response{
+					StatusCode: http.StatusNotModified,
+					Header:     header,
+					Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"type":"Person"}`))),
+			}, nil
+		})
+		tp := NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+		ct = NewCachingTransport(tp, clock, time.Hour)
+		return
+	}
+	t.Run("HonorsMaxAge", func(t *testing.T) {
+		clock, ct, inm := setupFn(http.Header{"Cache-Control": {"public, max-age=60"}, "Age": {"30"}})
+		_, err := ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		clock.Advance(29 * time.Second)
+		_, err = ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*inm), 1)
+		clock.Advance(time.Second)
+		_, err = ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*inm), 2)
+	})
+	t.Run("RevalidatesWithETag", func(t *testing.T) {
+		clock, ct, inm := setupFn(http.Header{"Cache-Control": {"max-age=60"}, "Etag": {`"v1"`}})
+		_, err := ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		clock.Advance(time.Minute)
+		b, err := ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), `{"type":"Person"}`)
+		assertEqual(t, len(*inm), 2)
+		assertEqual(t, (*inm)[0], "")
+		assertEqual(t, (*inm)[1], `"v1"`)
+		// Revalidating refreshed the value.
+		_, err = ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*inm), 2)
+	})
+	t.Run("DoesNotStoreNoStore", func(t *testing.T) {
+		_, ct, inm := setupFn(http.Header{"Cache-Control": {"no-store"}})
+		for i := 0; i < 2; i++ {
+			_, err := ct.Dereference(ctx, iri)
+			assertEqual(t, err, nil)
+		}
+		assertEqual(t, len(*inm), 2)
+	})
+	t.Run("HonorsExpiresRelativeToDate", func(t *testing.T) {
+		// The peer's clock is a day ahead.
+		clock, ct, inm := setupFn(http.Header{
+			"Date":    {now.Add(24 * time.Hour).Format(http.TimeFormat)},
+			"Expires": {now.Add(24*time.Hour + time.Minute).Format(http.TimeFormat)},
+		})
+		_, err := ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		clock.Advance(59 * time.Second)
+		_, err = ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*inm), 1)
+		clock.Advance(time.Second)
+		_, err = ct.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*inm), 2)
+	})
+}
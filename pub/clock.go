@@ -9,3 +9,25 @@ type Clock interface {
 	// Now returns the current time.
 	Now() time.Time
 }
+
+// TimerClock may be implemented by a Clock to also determine when waits end,
+// such as the waits between retries and flushes. Waits with other Clocks end
+// after the real duration.
+//
+// FakeClock implements TimerClock, so tests control both the time and the
+// waits without sleeping.
+type TimerClock interface {
+	Clock
+	// After returns a channel receiving the current time once the duration
+	// has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// after returns a channel receiving the time once the duration has elapsed on
+// the clock.
+func after(clock Clock, d time.Duration) <-chan time.Time {
+	if t, ok := clock.(TimerClock); ok {
+		return t.After(d)
+	}
+	return time.After(d)
+}
@@ -0,0 +1,97 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// CollectionPrivacy determines how much of a followers or following collection
+// the ActivityStreams handlers serve, and to whom.
+type CollectionPrivacy int
+
+const (
+	// PublicCollection serves the collection and its pages to everyone.
+	PublicCollection CollectionPrivacy = iota
+	// CountOnlyCollection serves only the 'totalItems' of the collection to
+	// everyone, and none of its pages.
+	CountOnlyCollection
+	// OwnerPagedCollection serves the collection and its pages to the
+	// actor owning it, and only its 'totalItems' to everyone else.
+	OwnerPagedCollection
+	// HiddenCollection serves the collection and its pages to the actor
+	// owning it, and responds Not Found to everyone else.
+	HiddenCollection
+)
+
+// CollectionPrivacyDatabase may be implemented by a Database to restrict the
+// followers and following collections served by the handlers of
+// NewActivityStreamsHandler, such as for actors hiding their social graph.
+//
+// The actor requesting a collection is the one identified by WithSourceReader,
+// once the application authenticated the request. Requests without it are
+// served as to anyone but the owner.
+type CollectionPrivacyDatabase interface {
+	// CollectionPrivacy returns the privacy of the collection, or of the
+	// collection a page is part of, and the actor owning it. Values that
+	// are not restricted are PublicCollection.
+	CollectionPrivacy(c context.Context, id *url.URL) (p CollectionPrivacy, owner *url.URL, err error)
+}
+
+// collectionPrivacy returns the privacy of the value served at the id, and the
+// actor owning it.
+func collectionPrivacy(c context.Context, db Database, id *url.URL) (CollectionPrivacy, *url.URL, error) {
+	if pdb, ok := db.(CollectionPrivacyDatabase); ok {
+		return pdb.CollectionPrivacy(c, id)
+	}
+	return PublicCollection, nil, nil
+}
+
+// restrictCollection returns the part of the collection or collection page the
+// requester may be served, or nil if it may not be served at all.
+func restrictCollection(c context.Context, t vocab.Type, p CollectionPrivacy, owner *url.URL) (vocab.Type, error) {
+	if p == PublicCollection {
+		return t, nil
+	}
+	reader, _ := c.Value(sourceReaderContextKey{}).(*url.URL)
+	isOwner := reader != nil && owner != nil && reader.String() == owner.String()
+	if p != CountOnlyCollection && isOwner {
+		return t, nil
+	} else if p == HiddenCollection {
+		return nil, nil
+	} else if streams.IsOrExtendsActivityStreamsCollectionPage(t) || streams.IsOrExtendsActivityStreamsOrderedCollectionPage(t) {
+		return nil, nil
+	}
+	return countOnlyCollection(t)
+}
+
+// countOnlyCollection returns a collection with the id and 'totalItems' of the
+// collection, counting its items if it has no 'totalItems'.
+func countOnlyCollection(t vocab.Type) (vocab.Type, error) {
+	id, err := GetId(t)
+	if err != nil {
+		return nil, err
+	}
+	n := 0
+	if ti, ok := t.(totalItemser); ok && ti.GetActivityStreamsTotalItems() != nil {
+		n = ti.GetActivityStreamsTotalItems().Get()
+	} else if i, ok := t.(itemser); ok && i.GetActivityStreamsItems() != nil {
+		n = i.GetActivityStreamsItems().Len()
+	} else if oi, ok := t.(orderedItemser); ok && oi.GetActivityStreamsOrderedItems() != nil {
+		n = oi.GetActivityStreamsOrderedItems().Len()
+	}
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(n)
+	if streams.IsOrExtendsActivityStreamsOrderedCollection(t) {
+		oc := streams.NewActivityStreamsOrderedCollection()
+		oc.SetActivityStreamsId(newIdProperty(id))
+		oc.SetActivityStreamsTotalItems(total)
+		return oc, nil
+	}
+	col := streams.NewActivityStreamsCollection()
+	col.SetActivityStreamsId(newIdProperty(id))
+	col.SetActivityStreamsTotalItems(total)
+	return col, nil
+}
@@ -0,0 +1,88 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// privacyDatabase is a Database restricting every collection with the same
+// privacy.
+type privacyDatabase struct {
+	*MockDatabase
+	privacy CollectionPrivacy
+}
+
+func (p *privacyDatabase) CollectionPrivacy(c context.Context, id *url.URL) (CollectionPrivacy, *url.URL, error) {
+	return p.privacy, mustParse(testPersonIRI), nil
+}
+
+func TestCollectionPrivacy(t *testing.T) {
+	ctx := context.Background()
+	const followersIRI = "https://example.com/addison/followers"
+	newFollowers := func() vocab.Type {
+		col := streams.NewActivityStreamsCollection()
+		col.SetActivityStreamsId(newIdProperty(mustParse(followersIRI)))
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI))
+		items.AppendIRI(mustParse(testFederatedActorIRI2))
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	newFollowersPage := func() vocab.Type {
+		page := streams.NewActivityStreamsCollectionPage()
+		page.SetActivityStreamsId(newIdProperty(mustParse(followersIRI + "?page=1")))
+		return page
+	}
+	serve := func(c context.Context, p CollectionPrivacy, v vocab.Type) *httptest.ResponseRecorder {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := &privacyDatabase{NewMockDatabase(ctl), p}
+		clock := NewMockClock(ctl)
+		db.EXPECT().Lock(gomock.Any(), gomock.Any())
+		db.EXPECT().Get(gomock.Any(), gomock.Any()).Return(v, nil)
+		db.EXPECT().Unlock(gomock.Any(), gomock.Any())
+		clock.EXPECT().Now().Return(now()).AnyTimes()
+		authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			return false, nil
+		}
+		h := NewCachingActivityStreamsHandler(authFn, db, clock, NewMemorySerializationCache(10))
+		req := httptest.NewRequest("GET", followersIRI, nil)
+		req.Header.Set(acceptHeader, activityStreamsMediaTypes[0])
+		rec := httptest.NewRecorder()
+		_, err := h(c, rec, req)
+		assertEqual(t, err, nil)
+		return rec
+	}
+	owner := WithSourceReader(ctx, mustParse(testPersonIRI))
+	other := WithSourceReader(ctx, mustParse(testFederatedActorIRI))
+	const full = `{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/addison/followers","items":["https://other.example.com/dakota","https://other.example.com/addison"],"type":"Collection"}`
+	const countOnly = `{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/addison/followers","totalItems":2,"type":"Collection"}`
+	t.Run("Public", func(t *testing.T) {
+		rec := serve(other, PublicCollection, newFollowers())
+		assertEqual(t, rec.Code, http.StatusOK)
+		assertEqual(t, rec.Body.String(), full)
+	})
+	t.Run("CountOnlyToEveryone", func(t *testing.T) {
+		assertEqual(t, serve(owner, CountOnlyCollection, newFollowers()).Body.String(), countOnly)
+		assertEqual(t, serve(other, CountOnlyCollection, newFollowers()).Body.String(), countOnly)
+		assertEqual(t, serve(owner, CountOnlyCollection, newFollowersPage()).Code, http.StatusNotFound)
+	})
+	t.Run("OwnerPaged", func(t *testing.T) {
+		assertEqual(t, serve(owner, OwnerPagedCollection, newFollowers()).Body.String(), full)
+		assertEqual(t, serve(owner, OwnerPagedCollection, newFollowersPage()).Code, http.StatusOK)
+		assertEqual(t, serve(other, OwnerPagedCollection, newFollowers()).Body.String(), countOnly)
+		assertEqual(t, serve(ctx, OwnerPagedCollection, newFollowers()).Body.String(), countOnly)
+		assertEqual(t, serve(other, OwnerPagedCollection, newFollowersPage()).Code, http.StatusNotFound)
+	})
+	t.Run("Hidden", func(t *testing.T) {
+		assertEqual(t, serve(owner, HiddenCollection, newFollowers()).Body.String(), full)
+		assertEqual(t, serve(other, HiddenCollection, newFollowers()).Code, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,204 @@
+package pub
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultMaxIdleConnsPerHost is the default number of idle connections
+	// kept per peer host, larger than the standard library's so fan-outs to
+	// large peers do not reconnect.
+	defaultMaxIdleConnsPerHost = 16
+	// defaultIdleConnTimeout is the default time an idle connection is
+	// kept.
+	defaultIdleConnTimeout = 90 * time.Second
+	// defaultTLSSessionCacheSize is the default number of TLS sessions kept
+	// for resumption.
+	defaultTLSSessionCacheSize = 256
+	// maxRedirects is the number of redirects followed by a request, as
+	// many as the standard library's client follows.
+	maxRedirects = 10
+)
+
+// ConnectionOptions tunes the connections of a ConnectionClient. Zero values
+// select the defaults.
+type ConnectionOptions struct {
+	// MaxIdleConns limits the number of idle connections across every
+	// host. Zero means no limit.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the number of idle connections kept per
+	// host.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept.
+	IdleConnTimeout time.Duration
+	// TLSSessionCacheSize is the number of TLS sessions kept so connections
+	// to known hosts resume them, skipping the full handshake. A negative
+	// size disables resumption.
+	TLSSessionCacheSize int
+	// Timeout limits the time of each request, including reading the
+	// response body. Zero means no limit.
+	Timeout time.Duration
+	// AllowPrivateAddresses allows connecting to loopback, private, or
+	// link-local addresses. By default they are not, so neither host names
+	// resolving to them nor redirects to them make this server request its
+	// own internal services. The proxy of the environment is dialed as any
+	// other address, so a proxy at a private address requires allowing
+	// them. Allowing them is useful during development.
+	AllowPrivateAddresses bool
+}
+
+// ConnectionStats counts the connections used by the requests of a
+// ConnectionClient, since fan-out performance is dominated by connection setup.
+type ConnectionStats struct {
+	// Requests is the number of requests that obtained a connection.
+	Requests int
+	// NewConnections is the number of requests that had to dial a new
+	// connection.
+	NewConnections int
+	// ReusedConnections is the number of requests that reused a previous
+	// connection.
+	ReusedConnections int
+	// IdleReused is the number of reused connections that had been idle.
+	IdleReused int
+	// IdleTime is the total time the reused connections had been idle.
+	IdleTime time.Duration
+}
+
+// ReuseRate is the fraction of requests that reused a connection, or 0 if
+// there were none.
+func (s ConnectionStats) ReuseRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.ReusedConnections) / float64(s.Requests)
+}
+
+// ConnectionClient is an HttpClient with tunable persistent connections, which
+// reports their reuse. It is safe for concurrent use, and is meant to be shared
+// by every HttpSigTransport of an application.
+type ConnectionClient struct {
+	client *http.Client
+	mu     sync.Mutex
+	stats  ConnectionStats
+	// isPrivate determines whether an IP address may not be connected
+	// to, unless private addresses are allowed.
+	isPrivate    func(ip net.IP) bool
+	allowPrivate bool
+}
+
+// HttpClient must be implemented by ConnectionClient.
+var _ HttpClient = &ConnectionClient{}
+
+// NewConnectionClient creates a ConnectionClient with the options.
+func NewConnectionClient(o ConnectionOptions) *ConnectionClient {
+	if o.MaxIdleConnsPerHost == 0 {
+		o.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	if o.IdleConnTimeout == 0 {
+		o.IdleConnTimeout = defaultIdleConnTimeout
+	}
+	if o.TLSSessionCacheSize == 0 {
+		o.TLSSessionCacheSize = defaultTLSSessionCacheSize
+	}
+	tlsConfig := &tls.Config{}
+	if o.TLSSessionCacheSize > 0 {
+		tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(o.TLSSessionCacheSize)
+	}
+	cc := &ConnectionClient{
+		isPrivate:    isPrivateIP,
+		allowPrivate: o.AllowPrivateAddresses,
+	}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   cc.control,
+	}
+	cc.client = &http.Client{
+		Timeout:       o.Timeout,
+		CheckRedirect: cc.checkRedirect,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         dialer.DialContext,
+			MaxIdleConns:        o.MaxIdleConns,
+			MaxIdleConnsPerHost: o.MaxIdleConnsPerHost,
+			IdleConnTimeout:     o.IdleConnTimeout,
+			TLSClientConfig:     tlsConfig,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+	return cc
+}
+
+// control rejects connections to private addresses, unless they are allowed.
+// It is called with the resolved address, so host names resolving to private
+// addresses are rejected too.
+func (cc *ConnectionClient) control(network, address string, _ syscall.RawConn) error {
+	if cc.allowPrivate {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || cc.isPrivate(ip) {
+		return fmt.Errorf("cannot connect to %s: address is private", address)
+	}
+	return nil
+}
+
+// checkRedirect ensures every redirect may be followed, so a peer cannot
+// redirect a request to a private host.
+func (cc *ConnectionClient) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.New("stopped after 10 redirects")
+	}
+	return checkDestination(req.URL, cc.allowPrivate)
+}
+
+// Do sends the request, counting the connection it obtains.
+func (cc *ConnectionClient) Do(req *http.Request) (*http.Response, error) {
+	trace := &httptrace.ClientTrace{
+		GotConn: cc.gotConn,
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+	return cc.client.Do(req)
+}
+
+// gotConn counts a connection obtained for a request.
+func (cc *ConnectionClient) gotConn(info httptrace.GotConnInfo) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.stats.Requests++
+	if !info.Reused {
+		cc.stats.NewConnections++
+		return
+	}
+	cc.stats.ReusedConnections++
+	if info.WasIdle {
+		cc.stats.IdleReused++
+		cc.stats.IdleTime += info.IdleTime
+	}
+}
+
+// Stats returns the counts of the connections used so far.
+func (cc *ConnectionClient) Stats() ConnectionStats {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.stats
+}
+
+// CloseIdleConnections closes the idle connections, such as before shutting
+// down.
+func (cc *ConnectionClient) CloseIdleConnections() {
+	if t, ok := cc.client.Transport.(*http.Transport); ok {
+		t.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,101 @@
+package pub
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestConnectionClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer srv.Close()
+	cc := NewConnectionClient(ConnectionOptions{AllowPrivateAddresses: true})
+	defer cc.CloseIdleConnections()
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequest("GET", srv.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := cc.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	s := cc.Stats()
+	assertEqual(t, s.Requests, 3)
+	assertEqual(t, s.NewConnections, 1)
+	assertEqual(t, s.ReusedConnections, 2)
+	assertEqual(t, s.ReuseRate() > 0.6, true)
+}
+
+func TestConnectionClientPrivateAddresses(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprint(w, "ok")
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(cc *ConnectionClient, iri string) error {
+		req, err := http.NewRequest("GET", iri, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := cc.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+	t.Run("RejectsHostResolvingToPrivateAddress", func(t *testing.T) {
+		hits = 0
+		cc := NewConnectionClient(ConnectionOptions{})
+		defer cc.CloseIdleConnections()
+		for _, iri := range []string{"http://localhost:" + u.Port() + "/", srv.URL} {
+			err := get(cc, iri)
+			if err == nil || !strings.Contains(err.Error(), "address is private") {
+				t.Fatalf("expected a private address error for %s, got %v", iri, err)
+			}
+		}
+		assertEqual(t, hits, 0)
+	})
+	t.Run("RejectsRedirectToPrivateHost", func(t *testing.T) {
+		redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://localhost:"+u.Port()+"/", http.StatusFound)
+		}))
+		defer redirector.Close()
+		hits = 0
+		cc := NewConnectionClient(ConnectionOptions{})
+		defer cc.CloseIdleConnections()
+		// Only the address of the redirector is public for this test.
+		cc.isPrivate = func(ip net.IP) bool {
+			return false
+		}
+		err := get(cc, redirector.URL)
+		if err == nil || !strings.Contains(err.Error(), "host is private") {
+			t.Fatalf("expected a private host error, got %v", err)
+		}
+		assertEqual(t, hits, 0)
+	})
+	t.Run("AllowsPrivateAddresses", func(t *testing.T) {
+		hits = 0
+		cc := NewConnectionClient(ConnectionOptions{AllowPrivateAddresses: true})
+		defer cc.CloseIdleConnections()
+		assertEqual(t, get(cc, srv.URL), nil)
+		assertEqual(t, hits, 1)
+	})
+}
@@ -93,7 +93,8 @@ type Database interface {
 	// Delete removes the entry with the given id.
 	//
 	// Delete is only called for federated objects. Deletes from the Social
-	// Protocol instead call Update to create a Tombstone.
+	// Protocol instead call Update to create a Tombstone, as do the deletes
+	// of federated objects by a Tombstoner.
 	//
 	// The library makes this call only after acquiring a lock first.
 	Delete(c context.Context, id *url.URL) error
@@ -0,0 +1,123 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// coalescedDelivery is a delivery waiting in the queue of its host.
+type coalescedDelivery struct {
+	c    context.Context
+	b    []byte
+	to   *url.URL
+	done chan error
+}
+
+// DeliveryCoalescer is a Transport that coalesces the deliveries to each
+// destination host: deliveries queued for the same host within a short window
+// are sent sequentially, instead of concurrently. Consecutive requests to a
+// host then reuse one connection and the signer negotiated by the first one,
+// improving the throughput of busy relays and of fan-outs to large peers.
+//
+// Deliveries to different hosts are still sent concurrently. Every other call
+// is passed to the wrapped Transport.
+//
+// Applications opt into coalescing by wrapping the Transports returned by
+// their CommonBehavior's NewTransport.
+type DeliveryCoalescer struct {
+	Transport
+	clock  Clock
+	window time.Duration
+	mu     sync.Mutex
+	queues map[string][]*coalescedDelivery
+}
+
+// reportingDeliverer must be implemented by DeliveryCoalescer.
+var _ reportingDeliverer = &DeliveryCoalescer{}
+
+// NewDeliveryCoalescer wraps the Transport, queueing each delivery for up to the
+// window so it may be coalesced with the following deliveries to the same
+// host.
+func NewDeliveryCoalescer(t Transport, clock Clock, window time.Duration) *DeliveryCoalescer {
+	return &DeliveryCoalescer{
+		Transport: t,
+		clock:     clock,
+		window:    window,
+		queues:    make(map[string][]*coalescedDelivery),
+	}
+}
+
+// Deliver queues the payload for the recipient's host, and returns once it has
+// been delivered.
+//
+// The payload is not copied and must not be modified until Deliver returns.
+func (d *DeliveryCoalescer) Deliver(c context.Context, b []byte, to *url.URL) error {
+	return <-d.enqueue(c, b, to)
+}
+
+// BatchDeliver queues the payload for the host of each recipient, and returns
+// once it has been delivered to all of them. Returns an error if any of the
+// deliveries had an error.
+func (d *DeliveryCoalescer) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return deliveryError(d.BatchDeliverWithReport(c, b, recipients))
+}
+
+// BatchDeliverWithReport is like BatchDeliver, but returns the outcome of the
+// delivery to each recipient, in the order of the recipients.
+func (d *DeliveryCoalescer) BatchDeliverWithReport(c context.Context, b []byte, recipients []*url.URL) []DeliveryOutcome {
+	outcomes := make([]DeliveryOutcome, len(recipients))
+	done := make([]<-chan error, len(recipients))
+	for i, r := range recipients {
+		outcomes[i] = DeliveryOutcome{Inbox: r, Time: d.clock.Now()}
+		done[i] = d.enqueue(c, b, r)
+	}
+	for i := range recipients {
+		if err := <-done[i]; err != nil {
+			outcomes[i].fail(err)
+		}
+	}
+	return outcomes
+}
+
+// enqueue adds a delivery to the queue of the recipient's host, starting to
+// flush the queue if it was empty. The returned channel receives the outcome of
+// the delivery.
+func (d *DeliveryCoalescer) enqueue(c context.Context, b []byte, to *url.URL) <-chan error {
+	done := make(chan error, 1)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	q, flushing := d.queues[to.Host]
+	d.queues[to.Host] = append(q, &coalescedDelivery{c: c, b: b, to: to, done: done})
+	if !flushing {
+		go d.flush(to.Host)
+	}
+	return done
+}
+
+// flush waits for the window, then sequentially sends the deliveries queued for
+// the host until none remain.
+func (d *DeliveryCoalescer) flush(host string) {
+	<-after(d.clock, d.window)
+	for {
+		d.mu.Lock()
+		q := d.queues[host]
+		if len(q) == 0 {
+			delete(d.queues, host)
+			d.mu.Unlock()
+			return
+		}
+		// Keep the host's entry, so deliveries queued while this batch is
+		// sent join the next batch instead of flushing concurrently.
+		d.queues[host] = q[:0:0]
+		d.mu.Unlock()
+		for _, p := range q {
+			if err := p.c.Err(); err != nil {
+				p.done <- err
+				continue
+			}
+			p.done <- d.Transport.Deliver(p.c, p.b, p.to)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// sequentialTransport records the deliveries made to it, and whether any were
+// made concurrently.
+type sequentialTransport struct {
+	*MockTransport
+	mu         sync.Mutex
+	inFlight   int
+	concurrent bool
+	delivered  []string
+}
+
+func (s *sequentialTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	s.mu.Lock()
+	s.inFlight++
+	if s.inFlight > 1 {
+		s.concurrent = true
+	}
+	s.mu.Unlock()
+	time.Sleep(time.Millisecond)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+	s.delivered = append(s.delivered, to.String())
+	if to.Host == "fail.example.com" {
+		return fmt.Errorf("unreachable")
+	}
+	return nil
+}
+
+func TestDeliveryCoalescer(t *testing.T) {
+	ctx := context.Background()
+	t.Run("DeliversToOneHostSequentially", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Time{}).AnyTimes()
+		tp := &sequentialTransport{MockTransport: NewMockTransport(ctl)}
+		d := NewDeliveryCoalescer(tp, clock, 5*time.Millisecond)
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				err := d.Deliver(ctx, []byte("{}"), mustParse(fmt.Sprintf("https://other.example.com/inbox/%d", i)))
+				assertEqual(t, err, nil)
+			}(i)
+		}
+		wg.Wait()
+		assertEqual(t, tp.concurrent, false)
+		assertEqual(t, len(tp.delivered), 5)
+	})
+	t.Run("ReportsEachRecipient", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Time{}).AnyTimes()
+		tp := &sequentialTransport{MockTransport: NewMockTransport(ctl)}
+		d := NewDeliveryCoalescer(tp, clock, 0)
+		outcomes := d.BatchDeliverWithReport(ctx, []byte("{}"), []*url.URL{
+			mustParse("https://other.example.com/inbox"),
+			mustParse("https://fail.example.com/inbox"),
+			mustParse("https://other.example.com/users/2/inbox"),
+		})
+		assertEqual(t, len(outcomes), 3)
+		assertEqual(t, outcomes[0].Delivered(), true)
+		assertEqual(t, outcomes[1].Error, "unreachable")
+		assertEqual(t, outcomes[2].Delivered(), true)
+		assertEqual(t, outcomes[2].Inbox.String(), "https://other.example.com/users/2/inbox")
+	})
+	t.Run("SkipsCanceledDeliveries", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		tp := &sequentialTransport{MockTransport: NewMockTransport(ctl)}
+		d := NewDeliveryCoalescer(tp, clock, 0)
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		err := d.Deliver(canceled, []byte("{}"), mustParse("https://other.example.com/inbox"))
+		assertEqual(t, err, context.Canceled)
+		assertEqual(t, len(tp.delivered), 0)
+	})
+	t.Run("WaitsForTheWindow", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewFakeClock(time.Time{})
+		tp := &sequentialTransport{MockTransport: NewMockTransport(ctl)}
+		d := NewDeliveryCoalescer(tp, clock, time.Second)
+		done := make(chan error)
+		go func() { done <- d.Deliver(ctx, []byte("{}"), mustParse("https://other.example.com/inbox")) }()
+		clock.BlockUntil(1)
+		assertEqual(t, len(tp.delivered), 0)
+		clock.Advance(time.Second)
+		assertEqual(t, <-done, nil)
+		assertEqual(t, len(tp.delivered), 1)
+	})
+}
@@ -0,0 +1,128 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+)
+
+// DeliveryRecord is the state of delivering an activity to one inbox.
+type DeliveryRecord struct {
+	// ActivityId is the id of the activity delivered.
+	ActivityId *url.URL
+	// Box is the IRI of the outbox delivering the activity, or of the inbox
+	// forwarding it.
+	Box *url.URL
+	// Inbox is the IRI the activity is delivered to.
+	Inbox *url.URL
+	// Attempts is the number of attempts, including the current one.
+	Attempts int
+	// LastError describes why the last attempt failed. It is empty if the
+	// activity was delivered, or the attempt has not finished.
+	LastError string
+}
+
+// DeliveryPersister may be implemented by a Database to journal every delivery
+// made by the actor, so the undelivered ones can be resumed with
+// ResumeDeliveries after the process restarts, instead of being lost.
+type DeliveryPersister interface {
+	// BeforeDelivery is called before the activity is POSTed to the inbox.
+	BeforeDelivery(c context.Context, r DeliveryRecord) error
+	// AfterDelivery is called after the activity was POSTed to the inbox,
+	// with the LastError of the attempt, if it failed. The records of
+	// delivered activities may be removed.
+	AfterDelivery(c context.Context, r DeliveryRecord) error
+	// Undelivered returns the records whose last attempt did not finish,
+	// or failed.
+	Undelivered(c context.Context) ([]DeliveryRecord, error)
+}
+
+// beforeDeliveries journals the first attempts to deliver the activity to the
+// recipients.
+func beforeDeliveries(c context.Context, p DeliveryPersister, id, box *url.URL, recipients []*url.URL) error {
+	for _, r := range recipients {
+		err := p.BeforeDelivery(c, DeliveryRecord{
+			ActivityId: id,
+			Box:        box,
+			Inbox:      r,
+			Attempts:   1,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// afterDeliveries journals the outcomes of the first attempts to deliver the
+// activity.
+func afterDeliveries(c context.Context, p DeliveryPersister, id, box *url.URL, outcomes []DeliveryOutcome) error {
+	for _, o := range outcomes {
+		err := p.AfterDelivery(c, DeliveryRecord{
+			ActivityId: id,
+			Box:        box,
+			Inbox:      o.Inbox,
+			Attempts:   1,
+			LastError:  o.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResumeDeliveries attempts the undelivered deliveries journaled by the
+// Database again, such as after a restart, with the Transports of the
+// CommonBehavior. The activities are read from the Database.
+//
+// Returns an error if any of the deliveries failed again, which remain
+// undelivered.
+func ResumeDeliveries(c context.Context, db Database, common CommonBehavior) error {
+	p, ok := db.(DeliveryPersister)
+	if !ok {
+		return nil
+	}
+	records, err := p.Undelivered(c)
+	if err != nil {
+		return err
+	}
+	cache, _ := common.(SerializationCache)
+	transports := make(map[string]Transport)
+	var outcomes []DeliveryOutcome
+	for _, r := range records {
+		if err = db.Lock(c, r.ActivityId); err != nil {
+			return err
+		}
+		t, err := db.Get(c, r.ActivityId)
+		db.Unlock(c, r.ActivityId)
+		if err != nil {
+			return err
+		}
+		b, err := marshal(c, cache, t, false)
+		if err != nil {
+			return err
+		}
+		tp, ok := transports[r.Box.String()]
+		if !ok {
+			if tp, err = common.NewTransport(c, r.Box, goFedUserAgent()); err != nil {
+				return err
+			}
+			transports[r.Box.String()] = tp
+		}
+		r.Attempts++
+		r.LastError = ""
+		if err = p.BeforeDelivery(c, r); err != nil {
+			return err
+		}
+		o := DeliveryOutcome{Inbox: r.Inbox}
+		if err = tp.Deliver(c, b, r.Inbox); err != nil {
+			o.fail(err)
+			r.LastError = o.Error
+		}
+		if err = p.AfterDelivery(c, r); err != nil {
+			return err
+		}
+		outcomes = append(outcomes, o)
+	}
+	return deliveryError(outcomes)
+}
@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// persistingDatabase is a Database journaling its deliveries in memory.
+type persistingDatabase struct {
+	*MockDatabase
+	calls   []string
+	records map[string]DeliveryRecord
+}
+
+func (p *persistingDatabase) BeforeDelivery(c context.Context, r DeliveryRecord) error {
+	p.calls = append(p.calls, fmt.Sprintf("before %s %d", r.Inbox, r.Attempts))
+	p.records[r.Inbox.String()] = r
+	return nil
+}
+
+func (p *persistingDatabase) AfterDelivery(c context.Context, r DeliveryRecord) error {
+	p.calls = append(p.calls, fmt.Sprintf("after %s %d %q", r.Inbox, r.Attempts, r.LastError))
+	if r.LastError == "" {
+		delete(p.records, r.Inbox.String())
+	} else {
+		p.records[r.Inbox.String()] = r
+	}
+	return nil
+}
+
+func (p *persistingDatabase) Undelivered(c context.Context) ([]DeliveryRecord, error) {
+	var records []DeliveryRecord
+	for _, r := range p.records {
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func TestDeliveryPersister(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	cb := NewMockCommonBehavior(ctl)
+	db := &persistingDatabase{
+		MockDatabase: NewMockDatabase(ctl),
+		records:      make(map[string]DeliveryRecord),
+	}
+	a := &sideEffectActor{common: cb, db: db, clock: clock}
+	tp := NewMockTransport(ctl)
+	outbox := mustParse(testMyOutboxIRI)
+	activityId := mustParse(testFederatedActivityIRI)
+	b := mustSerializeToBytes(testListen)
+	gomock.InOrder(
+		cb.EXPECT().NewTransport(ctx, outbox, goFedUserAgent()).Return(tp, nil),
+		tp.EXPECT().Deliver(ctx, b, mustParse(testFederatedActorIRI)).Return(nil),
+		tp.EXPECT().Deliver(ctx, b, mustParse(testFederatedActorIRI2)).Return(fmt.Errorf("connection refused")),
+		// ResumeDeliveries
+		db.MockDatabase.EXPECT().Lock(ctx, activityId),
+		db.MockDatabase.EXPECT().Get(ctx, activityId).Return(testListen, nil),
+		db.MockDatabase.EXPECT().Unlock(ctx, activityId),
+		cb.EXPECT().NewTransport(ctx, outbox, goFedUserAgent()).Return(tp, nil),
+		tp.EXPECT().Deliver(ctx, b, mustParse(testFederatedActorIRI2)).Return(nil),
+	)
+	err := a.deliverToRecipients(ctx, outbox, testListen, []*url.URL{
+		mustParse(testFederatedActorIRI),
+		mustParse(testFederatedActorIRI2),
+	})
+	assertNotEqual(t, err, nil)
+	assertEqual(t, fmt.Sprint(db.calls), fmt.Sprint([]string{
+		"before " + testFederatedActorIRI + " 1",
+		"before " + testFederatedActorIRI2 + " 1",
+		"after " + testFederatedActorIRI + ` 1 ""`,
+		"after " + testFederatedActorIRI2 + ` 1 "connection refused"`,
+	}))
+	assertEqual(t, len(db.records), 1)
+	assertEqual(t, db.records[testFederatedActorIRI2].ActivityId.String(), testFederatedActivityIRI)
+	assertEqual(t, db.records[testFederatedActorIRI2].Box.String(), testMyOutboxIRI)
+	db.calls = nil
+	err = ResumeDeliveries(ctx, db, cb)
+	assertEqual(t, err, nil)
+	assertEqual(t, fmt.Sprint(db.calls), fmt.Sprint([]string{
+		"before " + testFederatedActorIRI2 + " 2",
+		"after " + testFederatedActorIRI2 + ` 2 ""`,
+	}))
+	assertEqual(t, len(db.records), 0)
+}
@@ -0,0 +1,51 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// QueuedDelivery is a pending delivery of a payload to one inbox.
+type QueuedDelivery struct {
+	// Id identifies the delivery within its queue, and is assigned by the
+	// queue when it is enqueued.
+	Id string
+	// Inbox is the IRI the payload is delivered to.
+	Inbox *url.URL
+	// Outbox is the IRI of the outbox the payload is delivered from, or of
+	// the inbox forwarding it, which the Transport delivering it is created
+	// for. It may be nil if the deliveries of the queue are not made by
+	// actors.
+	Outbox *url.URL
+	// Payload is the serialized activity.
+	Payload []byte
+	// Header holds headers to send with the payload beyond the ones the
+	// Transport sets, and may be nil.
+	Header http.Header
+	// Attempts is the number of failed attempts so far.
+	Attempts int
+	// NotBefore is when the delivery is next due.
+	NotBefore time.Time
+	// LastError describes why the last attempt failed, if any.
+	LastError string
+}
+
+// DeliveryQueue holds the pending deliveries to peer inboxes, so they survive
+// failed attempts and, for durable queues, restarts.
+//
+// A dequeued delivery is in flight until it is marked as either delivered or
+// failed.
+type DeliveryQueue interface {
+	// Enqueue adds the delivery to the queue, assigning its Id.
+	Enqueue(c context.Context, d QueuedDelivery) error
+	// Dequeue returns up to n deliveries that are due at the time, the
+	// earliest due first, and marks them as in flight.
+	Dequeue(c context.Context, now time.Time, n int) ([]QueuedDelivery, error)
+	// MarkDelivered removes the in flight delivery from the queue.
+	MarkDelivered(c context.Context, d QueuedDelivery) error
+	// MarkFailed returns the in flight delivery to the queue, with the
+	// Attempts, NotBefore, and LastError of the given delivery.
+	MarkFailed(c context.Context, d QueuedDelivery) error
+}
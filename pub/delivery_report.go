@@ -0,0 +1,217 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// DeliveryOutcome is the outcome of delivering an activity to one inbox.
+type DeliveryOutcome struct {
+	// Inbox is the IRI the activity was delivered to.
+	Inbox *url.URL
+	// Error describes why the delivery failed, and is empty if it
+	// succeeded.
+	Error string
+	// StatusCode is the status code the peer responded to a failed
+	// delivery with, or zero if it did not respond, such as after a
+	// timeout.
+	StatusCode int
+	// Time is when the delivery was attempted.
+	Time time.Time
+}
+
+// Delivered determines whether the delivery succeeded.
+func (o DeliveryOutcome) Delivered() bool {
+	return len(o.Error) == 0
+}
+
+// Permanent determines whether the delivery failed in a way that retrying it
+// will not fix, which is a peer responding with a 4xx status code other than
+// 408 Request Timeout or 429 Too Many Requests. Failures without a status code,
+// such as timeouts, and 5xx status codes may be retried.
+func (o DeliveryOutcome) Permanent() bool {
+	return isPermanentStatus(o.StatusCode)
+}
+
+// fail records the error of the delivery.
+func (o *DeliveryOutcome) fail(err error) {
+	o.Error = err.Error()
+	if se, ok := err.(*StatusError); ok {
+		o.StatusCode = se.StatusCode
+	}
+}
+
+// isPermanentError determines whether a delivery failed with a status code that
+// retrying will not change.
+func isPermanentError(err error) bool {
+	se, ok := err.(*StatusError)
+	return ok && isPermanentStatus(se.StatusCode)
+}
+
+// isPermanentStatus determines whether the status code of a failed delivery is
+// one that retrying will not change.
+func isPermanentStatus(code int) bool {
+	return code >= 400 && code < 500 && code != http.StatusRequestTimeout && code != http.StatusTooManyRequests
+}
+
+// DeliveryReport holds the latest outcome of delivering an activity to each of
+// its recipient inboxes.
+type DeliveryReport struct {
+	// ActivityId is the id of the delivered activity.
+	ActivityId *url.URL
+	// Outcomes holds one outcome per inbox.
+	Outcomes []DeliveryOutcome
+}
+
+// Delivered returns the number of inboxes the activity was delivered to.
+func (r DeliveryReport) Delivered() int {
+	n := 0
+	for _, o := range r.Outcomes {
+		if o.Delivered() {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns the inboxes the activity failed to be delivered to.
+func (r DeliveryReport) Failed() []*url.URL {
+	var failed []*url.URL
+	for _, o := range r.Outcomes {
+		if !o.Delivered() {
+			failed = append(failed, o.Inbox)
+		}
+	}
+	return failed
+}
+
+// Servers returns the number of peer hosts whose every inbox was delivered to,
+// out of the number of peer hosts, such as to show "delivered to 132/140
+// servers".
+func (r DeliveryReport) Servers() (delivered, total int) {
+	hosts := make(map[string]bool)
+	for _, o := range r.Outcomes {
+		ok, seen := hosts[o.Inbox.Host]
+		hosts[o.Inbox.Host] = o.Delivered() && (ok || !seen)
+	}
+	for _, ok := range hosts {
+		if ok {
+			delivered++
+		}
+	}
+	return delivered, len(hosts)
+}
+
+// DeliveryReportStore persists the outcomes of delivering activities, so that
+// applications may show the delivery status of an activity and retry the
+// deliveries that failed.
+//
+// A CommonBehavior may implement DeliveryReportStore to have the outcomes of
+// every delivery made by the actor persisted.
+type DeliveryReportStore interface {
+	// SetDeliveryOutcomes saves the outcomes of delivering the activity.
+	// An outcome replaces any previous outcome for the same inbox, such as
+	// when a failed delivery is retried.
+	SetDeliveryOutcomes(c context.Context, activityId *url.URL, outcomes []DeliveryOutcome) error
+	// GetDeliveryStatus returns the latest outcomes of delivering the
+	// activity.
+	GetDeliveryStatus(c context.Context, activityId *url.URL) (DeliveryReport, error)
+}
+
+// reportingDeliverer is a Transport that reports the outcome of delivering to
+// each recipient of a batch.
+type reportingDeliverer interface {
+	BatchDeliverWithReport(c context.Context, b []byte, recipients []*url.URL) []DeliveryOutcome
+}
+
+// deliverWithReport delivers the payload to the recipients, returning the
+// outcome of each delivery. Transports unable to report on a batch are used to
+// deliver to one recipient at a time.
+func deliverWithReport(c context.Context, t Transport, clock Clock, b []byte, recipients []*url.URL) []DeliveryOutcome {
+	if rd, ok := t.(reportingDeliverer); ok {
+		return rd.BatchDeliverWithReport(c, b, recipients)
+	}
+	outcomes := make([]DeliveryOutcome, 0, len(recipients))
+	for _, r := range recipients {
+		o := DeliveryOutcome{Inbox: r, Time: clock.Now()}
+		if err := t.Deliver(c, b, r); err != nil {
+			o.fail(err)
+		}
+		outcomes = append(outcomes, o)
+	}
+	return outcomes
+}
+
+// DeliveryError is the error of a batch delivery that failed for some of its
+// recipients. It holds the outcome of every delivery, so callers can retry the
+// failures that are not Permanent.
+type DeliveryError struct {
+	// Outcomes holds the outcome of the delivery to each recipient,
+	// including the successful ones, in the order of the recipients.
+	Outcomes []DeliveryOutcome
+}
+
+// Error describes the failed deliveries.
+func (e *DeliveryError) Error() string {
+	var errs []string
+	for _, o := range e.Failed() {
+		errs = append(errs, o.Error)
+	}
+	return fmt.Sprintf("batch deliver had at least one failure: %s", strings.Join(errs, "; "))
+}
+
+// Failed returns the outcomes of the failed deliveries.
+func (e *DeliveryError) Failed() []DeliveryOutcome {
+	var failed []DeliveryOutcome
+	for _, o := range e.Outcomes {
+		if !o.Delivered() {
+			failed = append(failed, o)
+		}
+	}
+	return failed
+}
+
+// deliveryError returns a DeliveryError if any of the deliveries failed.
+func deliveryError(outcomes []DeliveryOutcome) error {
+	for _, o := range outcomes {
+		if !o.Delivered() {
+			return &DeliveryError{Outcomes: outcomes}
+		}
+	}
+	return nil
+}
+
+// RedeliverFailed delivers the activity again to the inboxes it previously
+// failed to be delivered to, and saves the new outcomes in the store.
+//
+// Returns an error if any of the deliveries failed again.
+func RedeliverFailed(c context.Context, store DeliveryReportStore, t Transport, clock Clock, activity vocab.Type) error {
+	id, err := GetId(activity)
+	if err != nil {
+		return err
+	}
+	report, err := store.GetDeliveryStatus(c, id)
+	if err != nil {
+		return err
+	}
+	failed := report.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	cache, _ := store.(SerializationCache)
+	b, err := marshal(c, cache, activity, false)
+	if err != nil {
+		return err
+	}
+	outcomes := deliverWithReport(c, t, clock, b, failed)
+	if err = store.SetDeliveryOutcomes(c, id, outcomes); err != nil {
+		return err
+	}
+	return deliveryError(outcomes)
+}
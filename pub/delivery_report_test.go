@@ -0,0 +1,170 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+// memoryDeliveryReportStore is a DeliveryReportStore that keeps the outcomes in
+// memory.
+type memoryDeliveryReportStore map[string]map[string]DeliveryOutcome
+
+func (m memoryDeliveryReportStore) SetDeliveryOutcomes(c context.Context, activityId *url.URL, outcomes []DeliveryOutcome) error {
+	if m[activityId.String()] == nil {
+		m[activityId.String()] = make(map[string]DeliveryOutcome)
+	}
+	for _, o := range outcomes {
+		m[activityId.String()][o.Inbox.String()] = o
+	}
+	return nil
+}
+
+func (m memoryDeliveryReportStore) GetDeliveryStatus(c context.Context, activityId *url.URL) (DeliveryReport, error) {
+	r := DeliveryReport{ActivityId: activityId}
+	for _, o := range m[activityId.String()] {
+		r.Outcomes = append(r.Outcomes, o)
+	}
+	return r, nil
+}
+
+// deliveryReportCommonBehavior is a CommonBehavior that is a
+// DeliveryReportStore.
+type deliveryReportCommonBehavior struct {
+	*MockCommonBehavior
+	memoryDeliveryReportStore
+}
+
+func TestDeliveryReport(t *testing.T) {
+	r := DeliveryReport{
+		Outcomes: []DeliveryOutcome{
+			{Inbox: mustParse("https://a.example.com/inbox")},
+			{Inbox: mustParse("https://b.example.com/users/1/inbox")},
+			{Inbox: mustParse("https://b.example.com/users/2/inbox"), Error: "timeout"},
+			{Inbox: mustParse("https://c.example.com/inbox"), Error: "gone"},
+		},
+	}
+	assertEqual(t, r.Delivered(), 2)
+	assertEqual(t, fmt.Sprint(r.Failed()), "[https://b.example.com/users/2/inbox https://c.example.com/inbox]")
+	delivered, total := r.Servers()
+	assertEqual(t, delivered, 1)
+	assertEqual(t, total, 3)
+}
+
+func TestHttpSigTransportBatchDeliverWithReport(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host", "digest"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "down.example.com" {
+			return newResponse(http.StatusServiceUnavailable), nil
+		} else if req.URL.Host == "gone.example.com" {
+			return newResponse(http.StatusGone), nil
+		}
+		return newResponse(http.StatusAccepted), nil
+	})
+	tp := NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+	recipients := []*url.URL{
+		mustParse("https://up.example.com/inbox"),
+		mustParse("https://down.example.com/inbox"),
+		mustParse("https://gone.example.com/inbox"),
+	}
+	outcomes := tp.BatchDeliverWithReport(context.Background(), []byte("{}"), recipients)
+	assertEqual(t, len(outcomes), 3)
+	assertEqual(t, outcomes[0].Inbox.String(), recipients[0].String())
+	assertEqual(t, outcomes[0].Delivered(), true)
+	assertEqual(t, outcomes[1].Delivered(), false)
+	assertEqual(t, outcomes[1].StatusCode, http.StatusServiceUnavailable)
+	assertEqual(t, outcomes[1].Permanent(), false)
+	assertEqual(t, outcomes[2].StatusCode, http.StatusGone)
+	assertEqual(t, outcomes[2].Permanent(), true)
+	err = tp.BatchDeliver(context.Background(), []byte("{}"), recipients)
+	de, ok := err.(*DeliveryError)
+	if !ok {
+		t.Fatalf("expected a *DeliveryError, got %T", err)
+	}
+	assertEqual(t, len(de.Outcomes), 3)
+	failed := de.Failed()
+	assertEqual(t, len(failed), 2)
+	assertEqual(t, failed[0].Inbox.String(), recipients[1].String())
+	assertEqual(t, failed[1].Inbox.String(), recipients[2].String())
+}
+
+func TestDeliveryOutcomePermanent(t *testing.T) {
+	tests := []struct {
+		code int
+		want bool
+	}{
+		{0, false},
+		{http.StatusBadRequest, true},
+		{http.StatusForbidden, true},
+		{http.StatusGone, true},
+		{http.StatusRequestTimeout, false},
+		{http.StatusTooManyRequests, false},
+		{http.StatusInternalServerError, false},
+		{http.StatusBadGateway, false},
+	}
+	for _, test := range tests {
+		t.Run(http.StatusText(test.code), func(t *testing.T) {
+			o := DeliveryOutcome{Error: "failed", StatusCode: test.code}
+			assertEqual(t, o.Permanent(), test.want)
+		})
+	}
+}
+
+func TestDeliverToRecipientsWithReport(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	cb := &deliveryReportCommonBehavior{
+		MockCommonBehavior:        NewMockCommonBehavior(ctl),
+		memoryDeliveryReportStore: memoryDeliveryReportStore{},
+	}
+	a := &sideEffectActor{common: cb, clock: clock}
+	tp := NewMockTransport(ctl)
+	deliverErr := fmt.Errorf("connection refused")
+	gomock.InOrder(
+		cb.MockCommonBehavior.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil),
+		tp.EXPECT().Deliver(ctx, mustSerializeToBytes(testListen), mustParse(testFederatedActorIRI)).Return(nil),
+		tp.EXPECT().Deliver(ctx, mustSerializeToBytes(testListen), mustParse(testFederatedActorIRI2)).Return(deliverErr),
+		// RedeliverFailed
+		tp.EXPECT().Deliver(ctx, mustSerializeToBytes(testListen), mustParse(testFederatedActorIRI2)).Return(nil),
+	)
+	err := a.deliverToRecipients(ctx, mustParse(testMyOutboxIRI), testListen, []*url.URL{
+		mustParse(testFederatedActorIRI),
+		mustParse(testFederatedActorIRI2),
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	id := mustParse(testFederatedActivityIRI)
+	report, err := cb.GetDeliveryStatus(ctx, id)
+	assertEqual(t, err, nil)
+	assertEqual(t, report.Delivered(), 1)
+	assertEqual(t, fmt.Sprint(report.Failed()), fmt.Sprint([]*url.URL{mustParse(testFederatedActorIRI2)}))
+	err = RedeliverFailed(ctx, cb, tp, clock, testListen)
+	assertEqual(t, err, nil)
+	report, err = cb.GetDeliveryStatus(ctx, id)
+	assertEqual(t, err, nil)
+	assertEqual(t, report.Delivered(), 2)
+}
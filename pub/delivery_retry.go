@@ -0,0 +1,261 @@
+package pub
+
+import (
+	"context"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Backoff determines when failed deliveries are attempted again. The delay
+// doubles with every failed attempt, from Base up to Max.
+type Backoff struct {
+	// Base is the delay after the first failed attempt.
+	Base time.Duration
+	// Max is the longest delay.
+	Max time.Duration
+	// Jitter is the fraction of each delay, between 0 and 1, randomly
+	// removed from it, so peers recovering from an outage are not retried
+	// all at once.
+	Jitter float64
+	// MaxAttempts is the number of attempts after which a delivery is
+	// given up. Zero or negative numbers never give up.
+	MaxAttempts int
+}
+
+// DefaultBackoff retries a delivery for about two days.
+var DefaultBackoff = Backoff{
+	Base:        time.Minute,
+	Max:         6 * time.Hour,
+	Jitter:      0.2,
+	MaxAttempts: 16,
+}
+
+// Delay returns how long to wait before attempting a delivery again, after the
+// number of failed attempts.
+func (b Backoff) Delay(attempts int) time.Duration {
+	d := b.Base
+	for i := 1; i < attempts && d < b.Max; i++ {
+		d *= 2
+	}
+	if d > b.Max {
+		d = b.Max
+	}
+	if b.Jitter > 0 {
+		d -= time.Duration(float64(d) * b.Jitter * rand.Float64())
+	}
+	return d
+}
+
+// givesUp determines whether a delivery is given up after the number of failed
+// attempts.
+func (b Backoff) givesUp(attempts int) bool {
+	return b.MaxAttempts > 0 && attempts >= b.MaxAttempts
+}
+
+// MemoryDeliveryQueue is a DeliveryQueue held in memory, for applications whose
+// pending deliveries do not need to survive restarts. It is safe for concurrent
+// use.
+type MemoryDeliveryQueue struct {
+	mu       sync.Mutex
+	nextId   int64
+	pending  map[string]QueuedDelivery
+	inflight map[string]QueuedDelivery
+}
+
+// DeliveryQueue must be implemented by MemoryDeliveryQueue.
+var _ DeliveryQueue = &MemoryDeliveryQueue{}
+
+// NewMemoryDeliveryQueue creates an empty MemoryDeliveryQueue.
+func NewMemoryDeliveryQueue() *MemoryDeliveryQueue {
+	return &MemoryDeliveryQueue{
+		pending:  make(map[string]QueuedDelivery),
+		inflight: make(map[string]QueuedDelivery),
+	}
+}
+
+// Enqueue adds the delivery to the pending deliveries, assigning its Id.
+func (q *MemoryDeliveryQueue) Enqueue(c context.Context, d QueuedDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.nextId++
+	d.Id = strconv.FormatInt(q.nextId, 10)
+	q.pending[d.Id] = d
+	return nil
+}
+
+// Dequeue moves up to n due pending deliveries in flight, and returns them.
+func (q *MemoryDeliveryQueue) Dequeue(c context.Context, now time.Time, n int) ([]QueuedDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var due []QueuedDelivery
+	for _, d := range q.pending {
+		if !d.NotBefore.After(now) {
+			due = append(due, d)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].NotBefore.Equal(due[j].NotBefore) {
+			// Ids are increasing decimal numbers.
+			return len(due[i].Id) < len(due[j].Id) || len(due[i].Id) == len(due[j].Id) && due[i].Id < due[j].Id
+		}
+		return due[i].NotBefore.Before(due[j].NotBefore)
+	})
+	if n < len(due) {
+		due = due[:n]
+	}
+	for _, d := range due {
+		delete(q.pending, d.Id)
+		q.inflight[d.Id] = d
+	}
+	return due, nil
+}
+
+// MarkDelivered removes the in flight delivery.
+func (q *MemoryDeliveryQueue) MarkDelivered(c context.Context, d QueuedDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.inflight, d.Id)
+	return nil
+}
+
+// MarkFailed returns the in flight delivery to the pending deliveries.
+func (q *MemoryDeliveryQueue) MarkFailed(c context.Context, d QueuedDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.inflight, d.Id)
+	q.pending[d.Id] = d
+	return nil
+}
+
+// Len returns the number of pending and in flight deliveries.
+func (q *MemoryDeliveryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending) + len(q.inflight)
+}
+
+// DeliveryRetrier retries the deliveries an actor failed to make, with
+// exponential backoff, instead of dropping them.
+//
+// A CommonBehavior implements DeliveryRetries to have the actor schedule its
+// failed deliveries with a DeliveryRetrier. The application then attempts them
+// again by running the DeliveryRetrier:
+//
+//	r := pub.NewDeliveryRetrier(pub.NewMemoryDeliveryQueue(), pub.DefaultBackoff, clock, common)
+//	go r.Run(ctx, time.Minute)
+//
+// Deliveries are given up after the backoff's MaxAttempts, and removed from the
+// queue.
+type DeliveryRetrier struct {
+	queue   DeliveryQueue
+	backoff Backoff
+	clock   Clock
+	common  CommonBehavior
+}
+
+// NewDeliveryRetrier creates a DeliveryRetrier keeping the pending deliveries in
+// the queue, and delivering them with the Transports of the CommonBehavior.
+func NewDeliveryRetrier(queue DeliveryQueue, backoff Backoff, clock Clock, common CommonBehavior) *DeliveryRetrier {
+	return &DeliveryRetrier{
+		queue:   queue,
+		backoff: backoff,
+		clock:   clock,
+		common:  common,
+	}
+}
+
+// DeliveryRetries may be implemented by a CommonBehavior to have the deliveries
+// failed by the actor retried by a DeliveryRetrier.
+type DeliveryRetries interface {
+	// DeliveryRetrier returns the DeliveryRetrier scheduling the failed
+	// deliveries.
+	DeliveryRetrier(c context.Context) *DeliveryRetrier
+}
+
+// Schedule enqueues the failed deliveries of the payload from the outbox, to be
+// attempted again after the backoff's first delay.
+func (r *DeliveryRetrier) Schedule(c context.Context, outbox *url.URL, payload []byte, outcomes []DeliveryOutcome) error {
+	now := r.clock.Now()
+	for _, o := range outcomes {
+		if o.Delivered() || o.Permanent() || r.backoff.givesUp(1) {
+			continue
+		}
+		err := r.queue.Enqueue(c, QueuedDelivery{
+			Inbox:     o.Inbox,
+			Outbox:    outbox,
+			Payload:   payload,
+			Attempts:  1,
+			NotBefore: now.Add(r.backoff.Delay(1)),
+			LastError: o.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryDue attempts up to n due deliveries again, and returns the number
+// attempted. Deliveries without an Outbox cannot be attempted, and are removed.
+func (r *DeliveryRetrier) RetryDue(c context.Context, n int) (int, error) {
+	due, err := r.queue.Dequeue(c, r.clock.Now(), n)
+	if err != nil {
+		return 0, err
+	}
+	transports := make(map[string]Transport)
+	for i, d := range due {
+		if d.Outbox == nil {
+			if err = r.queue.MarkDelivered(c, d); err != nil {
+				return i, err
+			}
+			continue
+		}
+		t, ok := transports[d.Outbox.String()]
+		if !ok {
+			if t, err = r.common.NewTransport(c, d.Outbox, goFedUserAgent()); err == nil {
+				transports[d.Outbox.String()] = t
+			}
+		}
+		if err == nil {
+			err = t.Deliver(c, d.Payload, d.Inbox)
+		}
+		if err == nil {
+			err = r.queue.MarkDelivered(c, d)
+		} else if d.Attempts++; r.backoff.givesUp(d.Attempts) || isPermanentError(err) {
+			err = r.queue.MarkDelivered(c, d)
+		} else {
+			d.NotBefore = r.clock.Now().Add(r.backoff.Delay(d.Attempts))
+			d.LastError = err.Error()
+			err = r.queue.MarkFailed(c, d)
+		}
+		if err != nil {
+			return i + 1, err
+		}
+	}
+	return len(due), nil
+}
+
+// Run retries the due deliveries every interval of the clock, until the context
+// is done. Errors from the queue do not stop it.
+func (r *DeliveryRetrier) Run(c context.Context, interval time.Duration) error {
+	for {
+		select {
+		case <-c.Done():
+			return c.Err()
+		case <-after(r.clock, interval):
+			for {
+				n, err := r.RetryDue(c, retryBatchSize)
+				if err != nil || n < retryBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// retryBatchSize is the number of deliveries dequeued at once by Run.
+const retryBatchSize = 64
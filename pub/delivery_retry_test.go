@@ -0,0 +1,213 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// retryingCommonBehavior is a CommonBehavior whose failed deliveries are
+// retried.
+type retryingCommonBehavior struct {
+	*MockCommonBehavior
+	retrier *DeliveryRetrier
+}
+
+func (r *retryingCommonBehavior) DeliveryRetrier(c context.Context) *DeliveryRetrier {
+	return r.retrier
+}
+
+func TestBackoff(t *testing.T) {
+	b := Backoff{Base: time.Minute, Max: time.Hour}
+	assertEqual(t, b.Delay(1), time.Minute)
+	assertEqual(t, b.Delay(2), 2*time.Minute)
+	assertEqual(t, b.Delay(4), 8*time.Minute)
+	assertEqual(t, b.Delay(7), time.Hour)
+	assertEqual(t, b.Delay(100), time.Hour)
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := b.Delay(2); d < time.Minute || d > 2*time.Minute {
+			t.Fatalf("delay out of range: %s", d)
+		}
+	}
+	assertEqual(t, b.givesUp(100), false)
+	b.MaxAttempts = 3
+	assertEqual(t, b.givesUp(2), false)
+	assertEqual(t, b.givesUp(3), true)
+}
+
+func TestMemoryDeliveryQueue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	q := NewMemoryDeliveryQueue()
+	assertEqual(t, q.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI), Payload: []byte("later"), NotBefore: now.Add(time.Hour)}), nil)
+	for _, p := range []string{"first", "second", "third"} {
+		assertEqual(t, q.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI2), Payload: []byte(p), NotBefore: now}), nil)
+	}
+	due, err := q.Dequeue(ctx, now, 2)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(due), 2)
+	assertEqual(t, string(due[0].Payload), "first")
+	assertEqual(t, string(due[1].Payload), "second")
+	assertEqual(t, q.MarkDelivered(ctx, due[0]), nil)
+	due[1].NotBefore = now.Add(time.Minute)
+	assertEqual(t, q.MarkFailed(ctx, due[1]), nil)
+	assertEqual(t, q.Len(), 3)
+	due, err = q.Dequeue(ctx, now.Add(time.Minute), 10)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(due), 2)
+	assertEqual(t, string(due[0].Payload), "third")
+	assertEqual(t, string(due[1].Payload), "second")
+}
+
+func TestDeliveryRetrier(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	backoff := Backoff{Base: time.Minute, Max: time.Hour, MaxAttempts: 3}
+	setupFn := func(ctl *gomock.Controller) (cl *MockClock, tp *MockTransport, q *MemoryDeliveryQueue, cb *retryingCommonBehavior) {
+		setupData()
+		cl = NewMockClock(ctl)
+		tp = NewMockTransport(ctl)
+		q = NewMemoryDeliveryQueue()
+		cb = &retryingCommonBehavior{MockCommonBehavior: NewMockCommonBehavior(ctl)}
+		cb.retrier = NewDeliveryRetrier(q, backoff, cl, cb)
+		return
+	}
+	t.Run("SchedulesFailedDeliveries", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, q, cb := setupFn(ctl)
+		cl.EXPECT().Now().Return(now).AnyTimes()
+		a := &sideEffectActor{common: cb, clock: cl}
+		b := mustSerializeToBytes(testListen)
+		gomock.InOrder(
+			cb.MockCommonBehavior.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil),
+			tp.EXPECT().Deliver(ctx, b, mustParse(testFederatedActorIRI)).Return(nil),
+			tp.EXPECT().Deliver(ctx, b, mustParse(testFederatedActorIRI2)).Return(fmt.Errorf("connection refused")),
+		)
+		err := a.deliverToRecipients(ctx, mustParse(testMyOutboxIRI), testListen, []*url.URL{
+			mustParse(testFederatedActorIRI),
+			mustParse(testFederatedActorIRI2),
+		})
+		assertEqual(t, err, nil)
+		due, err := q.Dequeue(ctx, now.Add(time.Minute), 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 1)
+		assertEqual(t, due[0].Inbox.String(), testFederatedActorIRI2)
+		assertEqual(t, due[0].Outbox.String(), testMyOutboxIRI)
+		assertEqual(t, string(due[0].Payload), string(b))
+		assertEqual(t, due[0].Attempts, 1)
+		assertEqual(t, due[0].NotBefore.Equal(now.Add(time.Minute)), true)
+		assertEqual(t, due[0].LastError, "connection refused")
+	})
+	t.Run("RetriesWithBackoffThenGivesUp", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, q, cb := setupFn(ctl)
+		r := cb.retrier
+		outbox := mustParse(testMyOutboxIRI)
+		cl.EXPECT().Now().Return(now)
+		err := r.Schedule(ctx, outbox, []byte("{}"), []DeliveryOutcome{
+			{Inbox: mustParse(testFederatedActorIRI), Error: "timeout"},
+		})
+		assertEqual(t, err, nil)
+		// Not due yet.
+		cl.EXPECT().Now().Return(now.Add(30 * time.Second))
+		n, err := r.RetryDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 0)
+		// Second attempt fails, and is delayed twice as long.
+		cl.EXPECT().Now().Return(now.Add(time.Minute)).Times(2)
+		cb.MockCommonBehavior.EXPECT().NewTransport(ctx, outbox, goFedUserAgent()).Return(tp, nil).Times(2)
+		tp.EXPECT().Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI)).Return(fmt.Errorf("timeout")).Times(2)
+		n, err = r.RetryDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+		cl.EXPECT().Now().Return(now.Add(2 * time.Minute))
+		n, err = r.RetryDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 0)
+		// Third attempt fails, and is given up.
+		cl.EXPECT().Now().Return(now.Add(3 * time.Minute))
+		n, err = r.RetryDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+		assertEqual(t, q.Len(), 0)
+	})
+	t.Run("GivesUpPermanentFailures", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, q, cb := setupFn(ctl)
+		r := cb.retrier
+		outbox := mustParse(testMyOutboxIRI)
+		cl.EXPECT().Now().Return(now)
+		// A peer responding with a 4xx status code is not retried.
+		err := r.Schedule(ctx, outbox, []byte("{}"), []DeliveryOutcome{
+			{Inbox: mustParse(testFederatedActorIRI), Error: "gone", StatusCode: http.StatusGone},
+			{Inbox: mustParse(testFederatedActorIRI2), Error: "unavailable", StatusCode: http.StatusServiceUnavailable},
+		})
+		assertEqual(t, err, nil)
+		assertEqual(t, q.Len(), 1)
+		// Nor is a retry the peer responds to with one.
+		cl.EXPECT().Now().Return(now.Add(time.Minute))
+		cb.MockCommonBehavior.EXPECT().NewTransport(ctx, outbox, goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI2)).Return(&StatusError{
+			Method:     http.MethodPost,
+			URL:        mustParse(testFederatedActorIRI2),
+			StatusCode: http.StatusForbidden,
+		})
+		n, err := r.RetryDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+		assertEqual(t, q.Len(), 0)
+	})
+	t.Run("RemovesDelivered", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, q, cb := setupFn(ctl)
+		r := cb.retrier
+		outbox := mustParse(testMyOutboxIRI)
+		cl.EXPECT().Now().Return(now).AnyTimes()
+		assertEqual(t, q.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI), Outbox: outbox, Payload: []byte("a"), NotBefore: now}), nil)
+		assertEqual(t, q.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI2), Outbox: outbox, Payload: []byte("b"), NotBefore: now}), nil)
+		assertEqual(t, q.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI2), Payload: []byte("no outbox"), NotBefore: now}), nil)
+		cb.MockCommonBehavior.EXPECT().NewTransport(ctx, outbox, goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(ctx, []byte("a"), mustParse(testFederatedActorIRI)).Return(nil)
+		tp.EXPECT().Deliver(ctx, []byte("b"), mustParse(testFederatedActorIRI2)).Return(nil)
+		n, err := r.RetryDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 3)
+		assertEqual(t, q.Len(), 0)
+	})
+	t.Run("RunRetriesEveryInterval", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, q, cb := setupFn(ctl)
+		clock := NewFakeClock(now)
+		r := NewDeliveryRetrier(q, backoff, clock, cb)
+		outbox := mustParse(testMyOutboxIRI)
+		assertEqual(t, q.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI), Outbox: outbox, Payload: []byte("a"), NotBefore: now.Add(time.Minute)}), nil)
+		delivered := make(chan struct{})
+		cb.MockCommonBehavior.EXPECT().NewTransport(gomock.Any(), outbox, goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(gomock.Any(), []byte("a"), mustParse(testFederatedActorIRI)).Do(func(c context.Context, b []byte, to *url.URL) {
+			close(delivered)
+		}).Return(nil)
+		c, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- r.Run(c, time.Minute) }()
+		clock.BlockUntil(1)
+		// Nothing is due before the first interval elapses.
+		clock.Advance(30 * time.Second)
+		assertEqual(t, q.Len(), 1)
+		clock.Advance(30 * time.Second)
+		<-delivered
+		cancel()
+		assertEqual(t, <-done, context.Canceled)
+		assertEqual(t, q.Len(), 0)
+	})
+}
@@ -0,0 +1,263 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The on-disk format of a DeliverySpool, version 1.
+//
+// A spool is a directory holding three directories:
+//
+//   - "pending" holds the deliveries waiting to be due,
+//   - "inflight" holds the dequeued deliveries not yet marked as delivered or
+//     failed, and
+//   - "tmp" holds partially written files.
+//
+// Each delivery is one file named after its id with the ".json" extension. Ids
+// are the enqueue time in nanoseconds since the Unix epoch, zero-padded to 20
+// digits, a hyphen, and 16 random hexadecimal digits, so they sort by enqueue
+// time. Files are written in "tmp" then renamed, so a reader never sees a
+// partial file.
+//
+// A file holds a JSON object with the members:
+//
+//   - "version": the number 1,
+//   - "id": the id of the delivery,
+//   - "inbox": the IRI delivered to,
+//   - "outbox": the IRI delivered from, which may be omitted,
+//   - "payload": the payload, encoded in standard base64,
+//   - "header": an object of header names to arrays of values, which may be
+//     omitted,
+//   - "attempts": the number of failed attempts,
+//   - "notBefore": when the delivery is next due, in RFC 3339 format, and
+//   - "lastError": the error of the last failed attempt, which may be
+//     omitted.
+//
+// Readers must reject files of other versions.
+const (
+	// deliverySpoolVersion is the version of the on-disk format written.
+	deliverySpoolVersion = 1
+	// deliverySpoolPending is the directory of pending deliveries.
+	deliverySpoolPending = "pending"
+	// deliverySpoolInflight is the directory of in flight deliveries.
+	deliverySpoolInflight = "inflight"
+	// deliverySpoolTmp is the directory of partially written files.
+	deliverySpoolTmp = "tmp"
+	// deliverySpoolExt is the extension of delivery files.
+	deliverySpoolExt = ".json"
+)
+
+// deliverySpoolFile is the content of a delivery file.
+type deliverySpoolFile struct {
+	Version   int         `json:"version"`
+	Id        string      `json:"id"`
+	Inbox     string      `json:"inbox"`
+	Outbox    string      `json:"outbox,omitempty"`
+	Payload   []byte      `json:"payload"`
+	Header    http.Header `json:"header,omitempty"`
+	Attempts  int         `json:"attempts"`
+	NotBefore time.Time   `json:"notBefore"`
+	LastError string      `json:"lastError,omitempty"`
+}
+
+// DeliverySpool is a DeliveryQueue keeping one file per pending delivery in a
+// directory, giving small deployments durable deliveries without a database.
+// It is safe for concurrent use within one process, but a directory must not be
+// used by several processes at once.
+//
+// The on-disk format is versioned and documented, so deliveries can be
+// inspected and spooled by other tools.
+type DeliverySpool struct {
+	mu    sync.Mutex
+	dir   string
+	clock Clock
+}
+
+// DeliveryQueue must be implemented by DeliverySpool.
+var _ DeliveryQueue = &DeliverySpool{}
+
+// NewDeliverySpool opens the spool in the directory, creating it if needed.
+//
+// Deliveries left in flight, such as by a crash, are returned to the pending
+// deliveries, so they are attempted again.
+func NewDeliverySpool(dir string, clock Clock) (*DeliverySpool, error) {
+	for _, d := range []string{deliverySpoolPending, deliverySpoolInflight, deliverySpoolTmp} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0700); err != nil {
+			return nil, err
+		}
+	}
+	s := &DeliverySpool{dir: dir, clock: clock}
+	names, err := s.list(deliverySpoolInflight)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if err = os.Rename(s.path(deliverySpoolInflight, name), s.path(deliverySpoolPending, name)); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Enqueue writes the delivery to the pending deliveries, assigning its Id.
+func (s *DeliverySpool) Enqueue(c context.Context, d QueuedDelivery) error {
+	var r [8]byte
+	if _, err := rand.Read(r[:]); err != nil {
+		return err
+	}
+	d.Id = fmt.Sprintf("%020d-%s", s.clock.Now().UnixNano(), hex.EncodeToString(r[:]))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(deliverySpoolPending, d)
+}
+
+// Dequeue moves up to n due pending deliveries in flight, and returns them.
+func (s *DeliverySpool) Dequeue(c context.Context, now time.Time, n int) ([]QueuedDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names, err := s.list(deliverySpoolPending)
+	if err != nil {
+		return nil, err
+	}
+	var due []QueuedDelivery
+	for _, name := range names {
+		d, err := s.read(deliverySpoolPending, name)
+		if err != nil {
+			return nil, err
+		}
+		if !d.NotBefore.After(now) {
+			due = append(due, d)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NotBefore.Before(due[j].NotBefore)
+	})
+	if n < len(due) {
+		due = due[:n]
+	}
+	for _, d := range due {
+		name := d.Id + deliverySpoolExt
+		if err = os.Rename(s.path(deliverySpoolPending, name), s.path(deliverySpoolInflight, name)); err != nil {
+			return nil, err
+		}
+	}
+	return due, nil
+}
+
+// MarkDelivered deletes the in flight delivery.
+func (s *DeliverySpool) MarkDelivered(c context.Context, d QueuedDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return os.Remove(s.path(deliverySpoolInflight, d.Id+deliverySpoolExt))
+}
+
+// MarkFailed rewrites the in flight delivery with its new attempts, and returns
+// it to the pending deliveries.
+func (s *DeliverySpool) MarkFailed(c context.Context, d QueuedDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.write(deliverySpoolPending, d); err != nil {
+		return err
+	}
+	err := os.Remove(s.path(deliverySpoolInflight, d.Id+deliverySpoolExt))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// path returns the path of the file in the spool's directory.
+func (s *DeliverySpool) path(dir, name string) string {
+	return filepath.Join(s.dir, dir, name)
+}
+
+// list returns the names of the delivery files in the directory, sorted.
+func (s *DeliverySpool) list(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(filepath.Join(s.dir, dir))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, info := range infos {
+		if !info.IsDir() && strings.HasSuffix(info.Name(), deliverySpoolExt) {
+			names = append(names, info.Name())
+		}
+	}
+	return names, nil
+}
+
+// read reads the delivery file in the directory.
+func (s *DeliverySpool) read(dir, name string) (QueuedDelivery, error) {
+	b, err := ioutil.ReadFile(s.path(dir, name))
+	if err != nil {
+		return QueuedDelivery{}, err
+	}
+	var f deliverySpoolFile
+	if err = json.Unmarshal(b, &f); err != nil {
+		return QueuedDelivery{}, fmt.Errorf("cannot read spooled delivery %s: %s", name, err)
+	}
+	if f.Version != deliverySpoolVersion {
+		return QueuedDelivery{}, fmt.Errorf("spooled delivery %s has unsupported version %d", name, f.Version)
+	}
+	inbox, err := url.Parse(f.Inbox)
+	if err != nil {
+		return QueuedDelivery{}, err
+	}
+	var outbox *url.URL
+	if f.Outbox != "" {
+		if outbox, err = url.Parse(f.Outbox); err != nil {
+			return QueuedDelivery{}, err
+		}
+	}
+	return QueuedDelivery{
+		Id:        f.Id,
+		Inbox:     inbox,
+		Outbox:    outbox,
+		Payload:   f.Payload,
+		Header:    f.Header,
+		Attempts:  f.Attempts,
+		NotBefore: f.NotBefore,
+		LastError: f.LastError,
+	}, nil
+}
+
+// write atomically writes the delivery file in the directory.
+func (s *DeliverySpool) write(dir string, d QueuedDelivery) error {
+	var outbox string
+	if d.Outbox != nil {
+		outbox = d.Outbox.String()
+	}
+	b, err := json.Marshal(deliverySpoolFile{
+		Version:   deliverySpoolVersion,
+		Id:        d.Id,
+		Inbox:     d.Inbox.String(),
+		Outbox:    outbox,
+		Payload:   d.Payload,
+		Header:    d.Header,
+		Attempts:  d.Attempts,
+		NotBefore: d.NotBefore.UTC(),
+		LastError: d.LastError,
+	})
+	if err != nil {
+		return err
+	}
+	name := d.Id + deliverySpoolExt
+	tmp := s.path(deliverySpoolTmp, name)
+	if err = ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(dir, name))
+}
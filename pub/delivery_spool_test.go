@@ -0,0 +1,114 @@
+package pub
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestDeliverySpool(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	setupFn := func(ctl *gomock.Controller) (dir string, s *DeliverySpool) {
+		dir, err := ioutil.TempDir("", "spool")
+		if err != nil {
+			t.Fatal(err)
+		}
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		s, err = NewDeliverySpool(dir, clock)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	t.Run("DequeuesDueDeliveriesEarliestFirst", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir, s := setupFn(ctl)
+		defer os.RemoveAll(dir)
+		err := s.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI), Payload: []byte("later"), NotBefore: now.Add(time.Hour)})
+		assertEqual(t, err, nil)
+		err = s.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI2), Payload: []byte("second"), NotBefore: now})
+		assertEqual(t, err, nil)
+		err = s.Enqueue(ctx, QueuedDelivery{
+			Inbox:     mustParse(testFederatedActorIRI),
+			Outbox:    mustParse(testMyOutboxIRI),
+			Payload:   []byte("first"),
+			Header:    http.Header{"Content-Type": []string{"application/activity+json"}},
+			NotBefore: now.Add(-time.Minute),
+		})
+		assertEqual(t, err, nil)
+		due, err := s.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 2)
+		assertEqual(t, string(due[0].Payload), "first")
+		assertEqual(t, due[0].Header.Get("Content-Type"), "application/activity+json")
+		assertEqual(t, due[0].Outbox.String(), testMyOutboxIRI)
+		assertEqual(t, string(due[1].Payload), "second")
+		assertEqual(t, due[1].Outbox, (*url.URL)(nil))
+		// In flight deliveries are not dequeued again.
+		due, err = s.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 0)
+	})
+	t.Run("MarksDeliveredAndFailed", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir, s := setupFn(ctl)
+		defer os.RemoveAll(dir)
+		for _, p := range []string{"a", "b"} {
+			err := s.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI), Payload: []byte(p), NotBefore: now})
+			assertEqual(t, err, nil)
+		}
+		due, err := s.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 2)
+		assertEqual(t, s.MarkDelivered(ctx, due[0]), nil)
+		failed := due[1]
+		failed.Attempts++
+		failed.LastError = "unreachable"
+		failed.NotBefore = now.Add(time.Minute)
+		assertEqual(t, s.MarkFailed(ctx, failed), nil)
+		due, err = s.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 0)
+		due, err = s.Dequeue(ctx, now.Add(time.Minute), 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 1)
+		assertEqual(t, due[0].Attempts, 1)
+		assertEqual(t, due[0].LastError, "unreachable")
+	})
+	t.Run("RecoversInflightDeliveries", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir, s := setupFn(ctl)
+		defer os.RemoveAll(dir)
+		err := s.Enqueue(ctx, QueuedDelivery{Inbox: mustParse(testFederatedActorIRI), Payload: []byte("a"), NotBefore: now})
+		assertEqual(t, err, nil)
+		_, err = s.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		s, err = NewDeliverySpool(dir, NewMockClock(ctl))
+		assertEqual(t, err, nil)
+		due, err := s.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 1)
+	})
+	t.Run("RejectsOtherVersions", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir, s := setupFn(ctl)
+		defer os.RemoveAll(dir)
+		err := ioutil.WriteFile(filepath.Join(dir, deliverySpoolPending, "x.json"), []byte(`{"version":2}`), 0600)
+		assertEqual(t, err, nil)
+		_, err = s.Dequeue(ctx, now, 10)
+		assertNotEqual(t, err, nil)
+	})
+}
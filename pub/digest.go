@@ -0,0 +1,100 @@
+package pub
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// DigestAlgorithm is an algorithm of the Digest header of RFC 3230, as named in
+// RFC 5843.
+type DigestAlgorithm string
+
+const (
+	// DigestSHA256 is the SHA-256 algorithm, which every peer supports.
+	DigestSHA256 DigestAlgorithm = sha256Digest
+	// DigestSHA512 is the SHA-512 algorithm.
+	DigestSHA512 DigestAlgorithm = "SHA-512"
+)
+
+// defaultDigestAlgorithms are the algorithms of the Digest header of requests
+// by default.
+var defaultDigestAlgorithms = []DigestAlgorithm{DigestSHA256}
+
+// sum returns the digest of the bytes, or false if the algorithm is not
+// supported.
+func (d DigestAlgorithm) sum(b []byte) ([]byte, bool) {
+	switch DigestAlgorithm(strings.ToUpper(string(d))) {
+	case DigestSHA256:
+		s := sha256.Sum256(b)
+		return s[:], true
+	case DigestSHA512:
+		s := sha512.Sum512(b)
+		return s[:], true
+	}
+	return nil, false
+}
+
+// SetDigestAlgorithms sets the algorithms of the Digest header of POST
+// requests, which has a value for each of them. The default is SHA-256 alone,
+// which peers expect, so it should remain among the algorithms.
+func (h HttpSigTransport) SetDigestAlgorithms(algs ...DigestAlgorithm) error {
+	if len(algs) == 0 {
+		return fmt.Errorf("no %s algorithm", digestHeader)
+	}
+	for _, a := range algs {
+		if _, ok := a.sum(nil); !ok {
+			return fmt.Errorf("unsupported %s algorithm: %s", digestHeader, a)
+		}
+	}
+	h.headers.mu.Lock()
+	defer h.headers.mu.Unlock()
+	h.headers.digests = append([]DigestAlgorithm(nil), algs...)
+	return nil
+}
+
+// getDigestAlgorithms returns the algorithms of the Digest header of requests.
+func (o *headerOptions) getDigestAlgorithms() []DigestAlgorithm {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if len(o.digests) == 0 {
+		return defaultDigestAlgorithms
+	}
+	return o.digests
+}
+
+// digestHeaderValues returns the Digest header value for a payload, with a
+// digest for each of the algorithms.
+func digestHeaderValues(b []byte, algs []DigestAlgorithm) string {
+	values := make([]string, 0, len(algs))
+	for _, a := range algs {
+		if s, ok := a.sum(b); ok {
+			values = append(values, string(a)+digestDelimiter+base64.StdEncoding.EncodeToString(s))
+		}
+	}
+	return strings.Join(values, ",")
+}
+
+// checkDigests determines whether every digest of a supported algorithm in the
+// Digest header value matches the bytes, and whether there is any. Digests of
+// unsupported algorithms are ignored.
+func checkDigests(header string, b []byte) (matches, supported bool) {
+	matches = true
+	for _, d := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(d), digestDelimiter, 2)
+		if len(kv) != 2 {
+			continue
+		}
+		s, ok := DigestAlgorithm(kv[0]).sum(b)
+		if !ok {
+			continue
+		}
+		supported = true
+		if kv[1] != base64.StdEncoding.EncodeToString(s) {
+			matches = false
+		}
+	}
+	return matches && supported, supported
+}
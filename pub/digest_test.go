@@ -0,0 +1,52 @@
+package pub
+
+import (
+	"testing"
+)
+
+func TestCheckDigests(t *testing.T) {
+	body := []byte(`{"type":"Create"}`)
+	both := digestHeaderValues(body, []DigestAlgorithm{DigestSHA256, DigestSHA512})
+	tests := []struct {
+		name      string
+		header    string
+		matches   bool
+		supported bool
+	}{
+		{"SHA256", digestHeaderValues(body, defaultDigestAlgorithms), true, true},
+		{"SHA512", digestHeaderValues(body, []DigestAlgorithm{DigestSHA512}), true, true},
+		{"Both", both, true, true},
+		{"LowerCaseAlgorithm", "sha-256" + digestHeaderValues(body, defaultDigestAlgorithms)[len(sha256Digest):], true, true},
+		{"IgnoresUnsupported", "MD5=abc, " + digestHeaderValues(body, defaultDigestAlgorithms), true, true},
+		{"OnlyUnsupported", "MD5=abc", false, false},
+		{"OneMismatch", digestHeaderValues(body, defaultDigestAlgorithms) + "," + digestHeaderValues([]byte("{}"), []DigestAlgorithm{DigestSHA512}), false, true},
+		{"Empty", "", false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matches, supported := checkDigests(test.header, body)
+			assertEqual(t, matches, test.matches)
+			assertEqual(t, supported, test.supported)
+		})
+	}
+}
+
+func TestVerifyDigestAlgorithms(t *testing.T) {
+	body := []byte(`{"type":"Create"}`)
+	signed := []string{"(request-target)", "date", "digest"}
+	t.Run("VerifiesSHA512", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Digest", digestHeaderValues(body, []DigestAlgorithm{DigestSHA512}))
+		assertEqual(t, verifyDigest(r, signed, 1024), nil)
+	})
+	t.Run("RejectsUnsupportedAlgorithms", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Digest", "MD5=abc")
+		assertNotEqual(t, verifyDigest(r, signed, 1024), nil)
+	})
+	t.Run("RejectsAnyMismatch", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Digest", digestHeaderValues(body, defaultDigestAlgorithms)+","+digestHeaderValues([]byte("{}"), []DigestAlgorithm{DigestSHA512}))
+		assertNotEqual(t, verifyDigest(r, signed, 1024), nil)
+	})
+}
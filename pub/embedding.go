@@ -0,0 +1,144 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Properties of serialized activities changed by embedding.
+const (
+	idProperty     = "id"
+	objectProperty = "object"
+	btoProperty    = "bto"
+	bccProperty    = "bcc"
+)
+
+// Embedding determines whether the objects of an activity delivered to a peer
+// are embedded in it, or referenced by their IRI.
+type Embedding int
+
+const (
+	// EmbedAsIs delivers the objects as they are in the activity.
+	EmbedAsIs Embedding = iota
+	// EmbedObjects embeds the objects referenced by IRI that are in the
+	// Database, for peers that do not dereference them, such as the
+	// objects of Announces.
+	EmbedObjects
+	// ReferenceObjects replaces the embedded objects that have an id with
+	// their IRI, keeping the payload small for peers that dereference
+	// them.
+	ReferenceObjects
+)
+
+// EmbeddingPolicy may be implemented by a CommonBehavior to determine, per
+// activity and recipient, whether the objects of delivered activities are
+// embedded or referenced. Recipients with different Embeddings receive
+// different payloads.
+//
+// Embedded objects are delivered without their 'bto' and 'bcc', but are not
+// otherwise checked against the audience of the activity: the policy must not
+// embed objects the recipient is not allowed to see.
+type EmbeddingPolicy interface {
+	// Embedding returns how the objects of the activity are delivered to
+	// the inbox.
+	Embedding(c context.Context, activity Activity, inbox *url.URL) Embedding
+}
+
+// deliveryGroup is a payload delivered to some of the recipients of an
+// activity.
+type deliveryGroup struct {
+	payload    []byte
+	recipients []*url.URL
+}
+
+// embeddingGroups groups the recipients of the activity by the payload they
+// receive, as determined by the EmbeddingPolicy. The payload b is the activity
+// as is.
+func (a *sideEffectActor) embeddingGroups(c context.Context, activity Activity, b []byte, recipients []*url.URL) ([]deliveryGroup, error) {
+	p, ok := a.common.(EmbeddingPolicy)
+	if !ok {
+		return []deliveryGroup{{payload: b, recipients: recipients}}, nil
+	}
+	var groups []deliveryGroup
+	index := make(map[Embedding]int)
+	for _, r := range recipients {
+		e := p.Embedding(c, activity, r)
+		i, ok := index[e]
+		if !ok {
+			payload := b
+			if e != EmbedAsIs {
+				var err error
+				if payload, err = a.embed(c, b, e); err != nil {
+					return nil, err
+				}
+			}
+			i = len(groups)
+			index[e] = i
+			groups = append(groups, deliveryGroup{payload: payload})
+		}
+		groups[i].recipients = append(groups[i].recipients, r)
+	}
+	return groups, nil
+}
+
+// embed returns the serialized activity with its objects embedded or
+// referenced.
+func (a *sideEffectActor) embed(c context.Context, b []byte, e Embedding) ([]byte, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	v, ok := m[objectProperty]
+	if !ok {
+		return b, nil
+	}
+	if arr, isArr := v.([]interface{}); isArr {
+		out := make([]interface{}, len(arr))
+		for i, o := range arr {
+			out[i] = a.embedObject(c, o, e)
+		}
+		m[objectProperty] = out
+	} else {
+		m[objectProperty] = a.embedObject(c, v, e)
+	}
+	return json.Marshal(m)
+}
+
+// embedObject returns the serialized object embedded or referenced. Objects
+// that cannot be are returned as they are.
+func (a *sideEffectActor) embedObject(c context.Context, o interface{}, e Embedding) interface{} {
+	switch e {
+	case ReferenceObjects:
+		if m, ok := o.(map[string]interface{}); ok {
+			if id, ok := m[idProperty].(string); ok && len(id) > 0 {
+				return id
+			}
+		}
+	case EmbedObjects:
+		s, ok := o.(string)
+		if !ok {
+			return o
+		}
+		iri, err := url.Parse(s)
+		if err != nil {
+			return o
+		}
+		if err = a.db.Lock(c, iri); err != nil {
+			return o
+		}
+		t, err := a.db.Get(c, iri)
+		a.db.Unlock(c, iri)
+		if err != nil {
+			return o
+		}
+		m, err := t.Serialize()
+		if err != nil {
+			return o
+		}
+		delete(m, btoProperty)
+		delete(m, bccProperty)
+		return m
+	}
+	return o
+}
@@ -0,0 +1,87 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+// embeddingCommonBehavior is a CommonBehavior with an EmbeddingPolicy.
+type embeddingCommonBehavior struct {
+	*MockCommonBehavior
+	embedding map[string]Embedding
+}
+
+func (e *embeddingCommonBehavior) Embedding(c context.Context, activity Activity, inbox *url.URL) Embedding {
+	return e.embedding[inbox.String()]
+}
+
+func TestEmbeddingPolicy(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	note := streams.NewActivityStreamsNote()
+	note.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+	bto := streams.NewActivityStreamsBtoProperty()
+	bto.AppendIRI(mustParse(testFederatedActorIRI2))
+	note.SetActivityStreamsBto(bto)
+	// objectOf delivers the activity, and returns the 'object' each inbox
+	// received.
+	objectOf := func(t *testing.T, activity Activity, embedding map[string]Embedding) map[string]interface{} {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cb := &embeddingCommonBehavior{MockCommonBehavior: NewMockCommonBehavior(ctl), embedding: embedding}
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1)).AnyTimes()
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(note, nil).AnyTimes()
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).AnyTimes()
+		a := &sideEffectActor{common: cb, db: db}
+		tp := NewMockTransport(ctl)
+		cb.MockCommonBehavior.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		received := make(map[string]interface{})
+		tp.EXPECT().BatchDeliver(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(c context.Context, b []byte, recipients []*url.URL) error {
+			var m map[string]interface{}
+			if err := json.Unmarshal(b, &m); err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range recipients {
+				received[r.String()] = m[objectProperty]
+			}
+			return nil
+		}).AnyTimes()
+		err := a.deliverToRecipients(ctx, mustParse(testMyOutboxIRI), activity, []*url.URL{
+			mustParse(testFederatedActorIRI),
+			mustParse(testFederatedActorIRI2),
+		})
+		assertEqual(t, err, nil)
+		return received
+	}
+	t.Run("EmbedsReferencedObjects", func(t *testing.T) {
+		announce := streams.NewActivityStreamsAnnounce()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		announce.SetActivityStreamsObject(op)
+		received := objectOf(t, announce, map[string]Embedding{testFederatedActorIRI: EmbedObjects})
+		embedded, ok := received[testFederatedActorIRI].(map[string]interface{})
+		assertEqual(t, ok, true)
+		assertEqual(t, embedded[idProperty], testNoteId1)
+		_, hasBto := embedded[btoProperty]
+		assertEqual(t, hasBto, false)
+		assertEqual(t, received[testFederatedActorIRI2], testNoteId1)
+	})
+	t.Run("ReferencesEmbeddedObjects", func(t *testing.T) {
+		create := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		embedded := streams.NewActivityStreamsNote()
+		embedded.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+		op.AppendActivityStreamsNote(embedded)
+		create.SetActivityStreamsObject(op)
+		received := objectOf(t, create, map[string]Embedding{testFederatedActorIRI2: ReferenceObjects})
+		_, ok := received[testFederatedActorIRI].(map[string]interface{})
+		assertEqual(t, ok, true)
+		assertEqual(t, received[testFederatedActorIRI2], testNoteId1)
+	})
+}
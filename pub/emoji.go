@@ -0,0 +1,192 @@
+package pub
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// shortcodeRegexp matches the :shortcodes: of custom emojis, as Mastodon does.
+var shortcodeRegexp = regexp.MustCompile(`:([a-zA-Z0-9_]{2,}):`)
+
+// Emoji is a custom emoji, displayed in place of its :shortcode: in the content,
+// summary, and name of the objects tagging it, as Mastodon does.
+type Emoji struct {
+	// Id is the IRI of the emoji.
+	Id *url.URL
+	// Shortcode is the name of the emoji, without colons.
+	Shortcode string
+	// Icon is the location of the image of the emoji.
+	Icon *url.URL
+	// MediaType is the MIME type of the image, such as "image/png".
+	// Optional.
+	MediaType string
+	// Updated is when the image last changed, so peers refresh their copy.
+	// Optional.
+	Updated time.Time
+}
+
+// TootEmoji builds the Emoji of Mastodon's vocabulary, with an Image icon, so
+// the application can serve it at its id, or tag objects with it.
+func (e Emoji) TootEmoji() (vocab.TootEmoji, error) {
+	if e.Id == nil || e.Icon == nil {
+		return nil, fmt.Errorf("emoji %q has no id or icon", e.Shortcode)
+	} else if !shortcodeRegexp.MatchString(":" + e.Shortcode + ":") {
+		return nil, fmt.Errorf("invalid emoji shortcode %q", e.Shortcode)
+	}
+	t := streams.NewTootEmoji()
+	t.SetActivityStreamsId(newIdProperty(e.Id))
+	name := streams.NewActivityStreamsNameProperty()
+	name.AppendXMLSchemaString(":" + e.Shortcode + ":")
+	t.SetActivityStreamsName(name)
+	image := streams.NewActivityStreamsImage()
+	u := streams.NewActivityStreamsUrlProperty()
+	u.AppendIRI(e.Icon)
+	image.SetActivityStreamsUrl(u)
+	if len(e.MediaType) > 0 {
+		mt := streams.NewActivityStreamsMediaTypeProperty()
+		mt.Set(e.MediaType)
+		image.SetActivityStreamsMediaType(mt)
+	}
+	icon := streams.NewActivityStreamsIconProperty()
+	icon.AppendActivityStreamsImage(image)
+	t.SetActivityStreamsIcon(icon)
+	if !e.Updated.IsZero() {
+		updated := streams.NewActivityStreamsUpdatedProperty()
+		updated.Set(e.Updated)
+		t.SetActivityStreamsUpdated(updated)
+	}
+	return t, nil
+}
+
+// FindEmojis returns the emojis whose :shortcode: appears in the text, once
+// each, in the order they first appear.
+func FindEmojis(text string, emojis []Emoji) []Emoji {
+	byCode := make(map[string]Emoji, len(emojis))
+	for _, e := range emojis {
+		byCode[e.Shortcode] = e
+	}
+	var found []Emoji
+	for _, m := range shortcodeRegexp.FindAllStringSubmatch(text, -1) {
+		if e, ok := byCode[m[1]]; ok {
+			found = append(found, e)
+			delete(byCode, m[1])
+		}
+	}
+	return found
+}
+
+// TagEmojis appends the emojis to the 'tag' property of the object, creating
+// the property if needed, so peers display them in place of their
+// :shortcodes:.
+func TagEmojis(o vocab.Type, emojis []Emoji) error {
+	if len(emojis) == 0 {
+		return nil
+	}
+	t, ok := o.(tagger)
+	if !ok {
+		return fmt.Errorf("cannot tag emojis: %T has no tag property", o)
+	}
+	tags := make([]vocab.TootEmoji, 0, len(emojis))
+	for _, e := range emojis {
+		te, err := e.TootEmoji()
+		if err != nil {
+			return err
+		}
+		tags = append(tags, te)
+	}
+	tag := t.GetActivityStreamsTag()
+	if tag == nil {
+		tag = streams.NewActivityStreamsTagProperty()
+		t.SetActivityStreamsTag(tag)
+	}
+	for _, te := range tags {
+		tag.AppendTootEmoji(te)
+	}
+	return nil
+}
+
+// GetEmojis reads the custom emojis tagged on an object, such as a Note
+// received from a peer. Malformed emojis are skipped, and listed by
+// ReadWarnings.
+func GetEmojis(o vocab.Type) []Emoji {
+	t, ok := o.(tagger)
+	if !ok || t.GetActivityStreamsTag() == nil {
+		return nil
+	}
+	var emojis []Emoji
+	tag := t.GetActivityStreamsTag()
+	for iter := tag.Begin(); iter != tag.End(); iter = iter.Next() {
+		if !iter.IsTootEmoji() {
+			continue
+		}
+		if e, ok := ReadEmoji(iter.GetTootEmoji()); ok {
+			emojis = append(emojis, e)
+		}
+	}
+	return emojis
+}
+
+// RenderEmojis replaces the :shortcodes: of the emojis in the HTML content with
+// images of them.
+func RenderEmojis(content string, emojis []Emoji) string {
+	byCode := make(map[string]Emoji, len(emojis))
+	for _, e := range emojis {
+		byCode[e.Shortcode] = e
+	}
+	return shortcodeRegexp.ReplaceAllStringFunc(content, func(s string) string {
+		e, ok := byCode[strings.Trim(s, ":")]
+		if !ok || e.Icon == nil {
+			return s
+		}
+		return fmt.Sprintf(`<img class="emoji" src="%s" alt="%s" title="%s" draggable="false">`,
+			html.EscapeString(e.Icon.String()), s, s)
+	})
+}
+
+// ReadEmoji reads a custom emoji, and returns whether it is well formed: it
+// has a valid :shortcode: name and an icon with an absolute url.
+func ReadEmoji(t vocab.TootEmoji) (e Emoji, ok bool) {
+	if id := t.GetActivityStreamsId(); id != nil {
+		e.Id = id.Get()
+	}
+	if name := t.GetActivityStreamsName(); name != nil && name.Len() > 0 && name.At(0).IsXMLSchemaString() {
+		e.Shortcode = strings.Trim(name.At(0).GetXMLSchemaString(), ":")
+	}
+	if !shortcodeRegexp.MatchString(":" + e.Shortcode + ":") {
+		return e, false
+	}
+	if updated := t.GetActivityStreamsUpdated(); updated != nil && updated.IsXMLSchemaDateTime() {
+		e.Updated = updated.Get()
+	}
+	icon := t.GetActivityStreamsIcon()
+	if icon == nil || icon.Len() == 0 {
+		return e, false
+	}
+	// Some implementations send several icons, the first is used.
+	if iter := icon.At(0); iter.IsActivityStreamsImage() {
+		image := iter.GetActivityStreamsImage()
+		if mt := image.GetActivityStreamsMediaType(); mt != nil && mt.IsRFCRfc2045() {
+			e.MediaType = mt.Get()
+		}
+		if u := image.GetActivityStreamsUrl(); u != nil && u.Len() > 0 {
+			if ui := u.At(0); ui.IsIRI() {
+				e.Icon = ui.GetIRI()
+			} else if ui.IsActivityStreamsLink() && ui.GetActivityStreamsLink().GetActivityStreamsHref() != nil {
+				e.Icon = ui.GetActivityStreamsLink().GetActivityStreamsHref().GetIRI()
+			}
+		}
+	} else if iter.IsIRI() {
+		e.Icon = iter.GetIRI()
+	}
+	if e.Icon == nil || !e.Icon.IsAbs() {
+		return e, false
+	}
+	return e, true
+}
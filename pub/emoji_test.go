@@ -0,0 +1,85 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+)
+
+func TestEmoji(t *testing.T) {
+	ctx := context.Background()
+	blobcat := Emoji{
+		Id:        mustParse("https://example.com/emojis/blobcat"),
+		Shortcode: "blobcat",
+		Icon:      mustParse("https://example.com/files/blobcat.png"),
+		MediaType: "image/png",
+		Updated:   time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC),
+	}
+	party := Emoji{
+		Id:        mustParse("https://example.com/emojis/party"),
+		Shortcode: "party_parrot",
+		Icon:      mustParse("https://example.com/files/party.gif"),
+	}
+	emojis := []Emoji{blobcat, party}
+	t.Run("FindsShortcodesOnce", func(t *testing.T) {
+		found := FindEmojis("hi :party_parrot: :blobcat: :party_parrot: :unknown:", emojis)
+		assertEqual(t, len(found), 2)
+		assertEqual(t, found[0].Shortcode, "party_parrot")
+		assertEqual(t, found[1].Shortcode, "blobcat")
+	})
+	t.Run("TagsAndReadsBack", func(t *testing.T) {
+		note := streams.NewActivityStreamsNote()
+		tag := streams.NewActivityStreamsTagProperty()
+		tag.AppendIRI(mustParse(testFederatedActorIRI))
+		note.SetActivityStreamsTag(tag)
+		err := TagEmojis(note, []Emoji{blobcat})
+		assertEqual(t, err, nil)
+		assertEqual(t, note.GetActivityStreamsTag().Len(), 2)
+		assertEqual(t, note.GetActivityStreamsTag().At(1).IsTootEmoji(), true)
+		got := GetEmojis(note)
+		assertEqual(t, len(got), 1)
+		assertEqual(t, got[0].Id.String(), blobcat.Id.String())
+		assertEqual(t, got[0].Icon.String(), blobcat.Icon.String())
+		assertEqual(t, got[0].MediaType, "image/png")
+		assertEqual(t, got[0].Updated.Equal(blobcat.Updated), true)
+	})
+	t.Run("ReadsPeerEmoji", func(t *testing.T) {
+		var m map[string]interface{}
+		err := json.Unmarshal([]byte(`{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#", "Emoji": "toot:Emoji"}],
+  "id": "https://other.example.com/notes/1",
+  "type": "Note",
+  "content": "<p>:blobcat:</p>",
+  "tag": {
+    "id": "https://other.example.com/emojis/1",
+    "type": "Emoji",
+    "name": ":blobcat:",
+    "icon": {"type": "Image", "mediaType": "image/png", "url": "https://other.example.com/blobcat.png"}
+  }
+}`), &m)
+		assertEqual(t, err, nil)
+		note, err := streams.ToType(ctx, m)
+		assertEqual(t, err, nil)
+		got := GetEmojis(note)
+		assertEqual(t, len(got), 1)
+		assertEqual(t, got[0].Shortcode, "blobcat")
+		assertEqual(t, RenderEmojis("<p>:blobcat: :other:</p>", got),
+			`<p><img class="emoji" src="https://other.example.com/blobcat.png" alt=":blobcat:" title=":blobcat:" draggable="false"> :other:</p>`)
+	})
+	t.Run("InvalidShortcode", func(t *testing.T) {
+		_, err := Emoji{Id: blobcat.Id, Icon: blobcat.Icon, Shortcode: "no spaces"}.TootEmoji()
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("PostTagsUsedEmojis", func(t *testing.T) {
+		p := Post{Content: "<p>:blobcat:</p>", Visibility: DirectVisibility, Mentions: []*url.URL{mustParse(testFederatedActorIRI)}, Emojis: emojis}
+		n, err := p.Note(PostAuthor{Actor: mustParse(testPersonIRI)}, now())
+		assertEqual(t, err, nil)
+		got := GetEmojis(n)
+		assertEqual(t, fmt.Sprint(len(got), n.GetActivityStreamsTag().Len()), "1 2")
+	})
+}
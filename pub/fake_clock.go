@@ -0,0 +1,108 @@
+package pub
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// fakeWaiter is a wait on a FakeClock.
+type fakeWaiter struct {
+	until time.Time
+	ch    chan time.Time
+}
+
+// FakeClock is a TimerClock for tests, whose time only moves when the test
+// advances it. It is safe for concurrent use.
+//
+// A test of a subsystem waiting in a goroutine first waits for it to block on
+// the clock, then advances the clock past the wait:
+//
+//	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+//	go r.Run(ctx, time.Minute)
+//	clock.BlockUntil(1)
+//	clock.Advance(time.Minute)
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+// TimerClock must be implemented by FakeClock.
+var _ TimerClock = &FakeClock{}
+
+// NewFakeClock creates a FakeClock at the time.
+func NewFakeClock(now time.Time) *FakeClock {
+	f := &FakeClock{now: now}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now returns the time of the clock.
+func (f *FakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// After returns a channel receiving the time of the clock once it has been
+// advanced by the duration. Zero or negative durations have elapsed already.
+func (f *FakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- f.now
+		return ch
+	}
+	f.waiters = append(f.waiters, fakeWaiter{until: f.now.Add(d), ch: ch})
+	sort.SliceStable(f.waiters, func(i, j int) bool {
+		return f.waiters[i].until.Before(f.waiters[j].until)
+	})
+	f.cond.Broadcast()
+	return ch
+}
+
+// Advance moves the time of the clock forward by the duration, ending the waits
+// that elapse.
+func (f *FakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	f.fire()
+}
+
+// Step moves the time of the clock forward to the end of the next wait, and
+// ends it along with any other wait elapsing at the same time. It returns false
+// if nothing waits on the clock.
+func (f *FakeClock) Step() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.waiters) == 0 {
+		return false
+	}
+	if f.waiters[0].until.After(f.now) {
+		f.now = f.waiters[0].until
+	}
+	f.fire()
+	return true
+}
+
+// BlockUntil waits until n waits are pending on the clock.
+func (f *FakeClock) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// fire ends the waits that elapsed. The lock must be held.
+func (f *FakeClock) fire() {
+	i := 0
+	for ; i < len(f.waiters) && !f.waiters[i].until.After(f.now); i++ {
+		f.waiters[i].ch <- f.now
+	}
+	f.waiters = f.waiters[i:]
+}
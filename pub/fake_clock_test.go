@@ -0,0 +1,52 @@
+package pub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	received := func(ch <-chan time.Time) bool {
+		select {
+		case <-ch:
+			return true
+		default:
+			return false
+		}
+	}
+	t.Run("AdvanceEndsElapsedWaits", func(t *testing.T) {
+		f := NewFakeClock(now)
+		short, long := f.After(time.Minute), f.After(time.Hour)
+		f.Advance(59 * time.Second)
+		assertEqual(t, received(short), false)
+		f.Advance(time.Second)
+		assertEqual(t, received(short), true)
+		assertEqual(t, received(long), false)
+		assertEqual(t, f.Now(), now.Add(time.Minute))
+	})
+	t.Run("StepMovesToNextWait", func(t *testing.T) {
+		f := NewFakeClock(now)
+		long, short := f.After(time.Hour), f.After(time.Minute)
+		assertEqual(t, f.Step(), true)
+		assertEqual(t, f.Now(), now.Add(time.Minute))
+		assertEqual(t, received(short), true)
+		assertEqual(t, received(long), false)
+		assertEqual(t, f.Step(), true)
+		assertEqual(t, f.Now(), now.Add(time.Hour))
+		assertEqual(t, received(long), true)
+		assertEqual(t, f.Step(), false)
+	})
+	t.Run("ElapsedWaitEndsAtOnce", func(t *testing.T) {
+		f := NewFakeClock(now)
+		assertEqual(t, received(f.After(0)), true)
+	})
+	t.Run("BlockUntilWaits", func(t *testing.T) {
+		f := NewFakeClock(now)
+		done := make(chan time.Time)
+		go func() { done <- <-f.After(time.Minute) }()
+		f.BlockUntil(1)
+		f.Advance(time.Minute)
+		assertEqual(t, <-done, now.Add(time.Minute))
+	})
+}
@@ -0,0 +1,81 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+)
+
+// FederatedTimelinePolicy determines which of the Public activities of peers
+// accepted into the inboxes of this server are kept out of the
+// FederatedTimeline, such as those of blocked hosts or sensitive content.
+type FederatedTimelinePolicy interface {
+	// ExcludeFromFederatedTimeline determines whether the activity
+	// accepted into the inbox is not added to the timeline.
+	ExcludeFromFederatedTimeline(c context.Context, inboxIRI *url.URL, activity Activity) bool
+}
+
+// FederatedTimeline is a server-wide "federated timeline": an OrderedCollection
+// of the activities of peers addressed to the Public collection that were
+// accepted into the inboxes of this server's actors, newest first. Activities
+// delivered to several inboxes, or relayed again, are added once.
+//
+// Content relayed to an actor subscribed to a relay arrives in its inbox as
+// Announces, and is assembled like any other.
+//
+// Like a PublicTimeline, it keeps only its newest items, is stored in the
+// Database as the value of its IRI, and is served in pages by its Handler. It is fed by embedding it in a
+// FederatingProtocol, whose InboxAccepted it then implements, by combining it
+// with other hooks with InboxAcceptedHooks, or by calling Add.
+type FederatedTimeline struct {
+	timeline
+	policy FederatedTimelinePolicy
+}
+
+// InboxAcceptedHook must be implemented by FederatedTimeline.
+var _ InboxAcceptedHook = &FederatedTimeline{}
+
+// NewFederatedTimeline creates a FederatedTimeline at the IRI, stored in the
+// Database and served in pages of up to pageSize items. The policy may be nil,
+// excluding no Public activities.
+func NewFederatedTimeline(iri *url.URL, db Database, pageSize int, policy FederatedTimelinePolicy) *FederatedTimeline {
+	return &FederatedTimeline{
+		timeline: newTimeline(iri, db, pageSize),
+		policy:   policy,
+	}
+}
+
+// InboxAccepted adds the activity accepted into the inbox to the timeline.
+func (f *FederatedTimeline) InboxAccepted(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	return f.Add(c, inboxIRI, activity)
+}
+
+// Add prepends the activity accepted into the inbox to the timeline, unless it
+// is not addressed to the Public collection, is owned by this server, or the
+// policy excludes it.
+func (f *FederatedTimeline) Add(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	if !isPublicActivity(activity) {
+		return nil
+	}
+	id, err := GetId(activity)
+	if err != nil {
+		return err
+	}
+	if owns, err := f.owns(c, id); err != nil {
+		return err
+	} else if owns {
+		return nil
+	}
+	if f.policy != nil && f.policy.ExcludeFromFederatedTimeline(c, inboxIRI, activity) {
+		return nil
+	}
+	return f.prepend(c, id)
+}
+
+// owns determines whether this server owns the activity.
+func (f *FederatedTimeline) owns(c context.Context, id *url.URL) (bool, error) {
+	if err := f.db.Lock(c, id); err != nil {
+		return false, err
+	}
+	defer f.db.Unlock(c, id)
+	return f.db.Owns(c, id)
+}
@@ -0,0 +1,89 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// excludeInbox is a FederatedTimelinePolicy excluding the activities accepted
+// into an inbox.
+type excludeInbox string
+
+func (e excludeInbox) ExcludeFromFederatedTimeline(c context.Context, inboxIRI *url.URL, activity Activity) bool {
+	return inboxIRI.String() == string(e)
+}
+
+func TestFederatedTimeline(t *testing.T) {
+	ctx := context.Background()
+	timelineIRI := mustParse("https://example.com/timelines/federated")
+	const announceId = "https://other.example.com/announce/1"
+	publicAnnounce := func() Activity {
+		a := streams.NewActivityStreamsAnnounce()
+		a.SetActivityStreamsId(newIdProperty(mustParse(announceId)))
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(PublicActivityPubIRI))
+		a.SetActivityStreamsTo(to)
+		return a
+	}
+	newTimeline := func(ids ...string) vocab.ActivityStreamsOrderedCollection {
+		oc := streams.NewActivityStreamsOrderedCollection()
+		oc.SetActivityStreamsId(newIdProperty(timelineIRI))
+		oi := streams.NewActivityStreamsOrderedItemsProperty()
+		for _, id := range ids {
+			oi.AppendIRI(mustParse(id))
+		}
+		oc.SetActivityStreamsOrderedItems(oi)
+		return oc
+	}
+	expectOwns := func(db *MockDatabase, owns bool) {
+		db.EXPECT().Lock(ctx, mustParse(announceId))
+		db.EXPECT().Owns(ctx, mustParse(announceId)).Return(owns, nil)
+		db.EXPECT().Unlock(ctx, mustParse(announceId))
+	}
+	t.Run("AddsAcceptedActivities", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectOwns(db, false)
+		db.EXPECT().Lock(ctx, timelineIRI)
+		db.EXPECT().Exists(ctx, timelineIRI).Return(true, nil)
+		db.EXPECT().Get(ctx, timelineIRI).Return(newTimeline(testNoteId1), nil)
+		db.EXPECT().Update(ctx, newTimeline(announceId, testNoteId1))
+		db.EXPECT().Unlock(ctx, timelineIRI)
+		f := NewFederatedTimeline(timelineIRI, db, 0, nil)
+		assertEqual(t, f.InboxAccepted(ctx, mustParse(testMyInboxIRI), publicAnnounce()), nil)
+	})
+	t.Run("DeduplicatesActivities", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectOwns(db, false)
+		db.EXPECT().Lock(ctx, timelineIRI)
+		db.EXPECT().Exists(ctx, timelineIRI).Return(true, nil)
+		db.EXPECT().Get(ctx, timelineIRI).Return(newTimeline(announceId), nil)
+		db.EXPECT().Unlock(ctx, timelineIRI)
+		f := NewFederatedTimeline(timelineIRI, db, 0, nil)
+		assertEqual(t, f.Add(ctx, mustParse(testMyInboxIRI), publicAnnounce()), nil)
+	})
+	t.Run("IgnoresLocalActivities", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectOwns(db, true)
+		f := NewFederatedTimeline(timelineIRI, db, 0, nil)
+		assertEqual(t, f.Add(ctx, mustParse(testMyInboxIRI), publicAnnounce()), nil)
+	})
+	t.Run("PolicyExcludes", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectOwns(db, false)
+		f := NewFederatedTimeline(timelineIRI, db, 0, excludeInbox(testMyInboxIRI))
+		assertEqual(t, f.Add(ctx, mustParse(testMyInboxIRI), publicAnnounce()), nil)
+	})
+}
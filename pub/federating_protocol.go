@@ -2,9 +2,11 @@ package pub
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-fed/activity/streams/vocab"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // FederatingProtocol contains behaviors an application needs to satisfy for the
@@ -122,3 +124,66 @@ type FederatingProtocol interface {
 	// API is enabled.
 	GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error)
 }
+
+// InboxForwardingRules may be implemented by a FederatingProtocol to decide
+// which of the application's Collections and OrderedCollections participate in
+// inbox forwarding, instead of every owned collection addressed by an activity.
+//
+// For example, an application may forward to an actor's followers, but never
+// to the members of a private list.
+type InboxForwardingRules interface {
+	// ForwardsToCollection determines whether the collection, owned by
+	// this server and addressed in the 'to', 'cc', or 'audience' of the
+	// activity, may be forwarded to. Collections that may not are never
+	// loaded, nor passed to FilterForwarding.
+	//
+	// Returning false for every collection opts out of inbox forwarding.
+	//
+	// The activity is provided as a reference for more intelligent logic
+	// to be used, but the implementation must not modify it.
+	ForwardsToCollection(c context.Context, collectionIRI *url.URL, a Activity) (bool, error)
+}
+
+// InboxAcceptedHook may be implemented by a FederatingProtocol to be notified of
+// every new activity accepted into an inbox, after its side effects were
+// applied, such as to publish it to a message broker.
+//
+// A FederatingProtocol embedding several InboxAcceptedHooks, such as an
+// InboxStream and a FederatedTimeline, does not implement it, since the method
+// of neither is promoted. It implements it with InboxAcceptedHooks instead:
+//
+//	func (p *myFederating) InboxAccepted(c context.Context, inboxIRI *url.URL, a pub.Activity) error {
+//	    return pub.InboxAcceptedHooks(p.InboxStream, p.FederatedTimeline).InboxAccepted(c, inboxIRI, a)
+//	}
+type InboxAcceptedHook interface {
+	// InboxAccepted is called with the activity accepted into the inbox.
+	// Returning an error does not fail the request, as the activity
+	// remains accepted, so the error is only logged.
+	//
+	// The implementation must not modify the activity.
+	InboxAccepted(c context.Context, inboxIRI *url.URL, a Activity) error
+}
+
+// inboxAcceptedHooks is an InboxAcceptedHook calling several of them.
+type inboxAcceptedHooks []InboxAcceptedHook
+
+// InboxAcceptedHooks combines the InboxAcceptedHooks into one, calling each of
+// them in order. Every hook is called even if an earlier one fails, and the
+// errors of all of them are returned together.
+func InboxAcceptedHooks(hooks ...InboxAcceptedHook) InboxAcceptedHook {
+	return inboxAcceptedHooks(hooks)
+}
+
+// InboxAccepted calls every hook with the activity accepted into the inbox.
+func (h inboxAcceptedHooks) InboxAccepted(c context.Context, inboxIRI *url.URL, a Activity) error {
+	var errs []string
+	for _, hook := range h {
+		if err := hook.InboxAccepted(c, inboxIRI, a); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("inbox accepted hooks failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
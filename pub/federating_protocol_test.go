@@ -0,0 +1,76 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+// countingHook is an InboxAcceptedHook counting its calls, and failing with
+// its error.
+type countingHook struct {
+	calls int
+	err   error
+}
+
+func (h *countingHook) InboxAccepted(c context.Context, inboxIRI *url.URL, a Activity) error {
+	h.calls++
+	return h.err
+}
+
+// inboxAcceptedProtocol is a FederatingProtocol with an InboxAcceptedHook.
+type inboxAcceptedProtocol struct {
+	*MockFederatingProtocol
+	InboxAcceptedHook
+}
+
+func TestInboxAcceptedHooks(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	t.Run("CallsEveryHook", func(t *testing.T) {
+		first := &countingHook{err: errors.New("first failed")}
+		second := &countingHook{}
+		third := &countingHook{err: errors.New("third failed")}
+		err := InboxAcceptedHooks(first, second, third).InboxAccepted(ctx, inboxIRI, testListen)
+		assertEqual(t, first.calls, 1)
+		assertEqual(t, second.calls, 1)
+		assertEqual(t, third.calls, 1)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, strings.Contains(err.Error(), "first failed"), true)
+		assertEqual(t, strings.Contains(err.Error(), "third failed"), true)
+	})
+	t.Run("SucceedsIfEveryHookDoes", func(t *testing.T) {
+		assertEqual(t, InboxAcceptedHooks(&countingHook{}, &countingHook{}).InboxAccepted(ctx, inboxIRI, testListen), nil)
+	})
+	t.Run("PostInboxSucceedsIfHookFails", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		db := NewMockDatabase(ctl)
+		fp := NewMockFederatingProtocol(ctl)
+		first := &countingHook{err: errors.New("broker unavailable")}
+		second := &countingHook{}
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    &inboxAcceptedProtocol{MockFederatingProtocol: fp, InboxAcceptedHook: InboxAcceptedHooks(first, second)},
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
+		assertEqual(t, a.PostInbox(ctx, inboxIRI, testListen), nil)
+		assertEqual(t, first.calls, 1)
+		assertEqual(t, second.calls, 1)
+	})
+}
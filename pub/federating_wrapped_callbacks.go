@@ -23,6 +23,11 @@ const (
 	// OnFollowAutomaticallyAccept triggers the side effect of sending a
 	// Reject of this Follow request in response.
 	OnFollowAutomaticallyReject
+	// OnFollowDecideByCallback calls the DecideFollow callback to
+	// determine whether to accept the Follow request, reject it, or leave
+	// it pending for the application to respond to later with a
+	// FollowResponder.
+	OnFollowDecideByCallback
 )
 
 // FederatingWrappedCallbacks lists the callback functions that already have
@@ -38,6 +43,16 @@ type FederatingWrappedCallbacks struct {
 	//
 	// Create calls Create for each object in the federated Activity.
 	Create func(context.Context, vocab.ActivityStreamsCreate) error
+	// Vote is called for each Note in a federated Create that is a vote in
+	// a Question owned by this server, naming one of its options and
+	// 'inReplyTo' it, instead of creating the Note in the database. The
+	// Question is locked in the database during the call, so the
+	// application may count the vote with TallyVote and update it. Votes in
+	// closed Questions are dropped.
+	//
+	// If Vote is nil, votes are created in the database like other
+	// objects.
+	Vote func(c context.Context, create vocab.ActivityStreamsCreate, v PollVote) error
 	// Update handles additional side effects for the Update ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -50,7 +65,8 @@ type FederatingWrappedCallbacks struct {
 	// Delete handles additional side effects for the Delete ActivityStreams
 	// type, specific to the application using go-fed.
 	//
-	// Delete removes the federated entry from the database.
+	// Delete removes the federated entry from the database, or replaces it
+	// with a Tombstone if the actor is a Tombstoner.
 	Delete func(context.Context, vocab.ActivityStreamsDelete) error
 	// Follow handles additional side effects for the Follow ActivityStreams
 	// type, specific to the application using go-fed.
@@ -61,6 +77,16 @@ type FederatingWrappedCallbacks struct {
 	// OnFollow determines what action to take for this particular callback
 	// if a Follow Activity is handled.
 	OnFollow OnFollowBehavior
+	// DecideFollow determines the action to take for a Follow of the
+	// actor of the inbox if OnFollow is OnFollowDecideByCallback:
+	// OnFollowAutomaticallyAccept or OnFollowAutomaticallyReject sends the
+	// response right away, while OnFollowDoNothing leaves the Follow
+	// pending, such as for its approval by the actor.
+	DecideFollow func(context.Context, vocab.ActivityStreamsFollow) (OnFollowBehavior, error)
+	// OnFollowEmbedding determines how much of the Follow is embedded in
+	// the Accept or Reject automatically sent in response to it, unless
+	// the FederatingProtocol is a HandshakeEmbeddingPolicy.
+	OnFollowEmbedding HandshakeEmbedding
 	// Accept handles additional side effects for the Accept ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -73,11 +99,18 @@ type FederatingWrappedCallbacks struct {
 	// Reject handles additional side effects for the Reject ActivityStreams
 	// type, specific to the application using go-fed.
 	//
-	// The wrapping function has no default side effects. However, if this
-	// 'Reject' is in response to a 'Follow' then the client MUST NOT go
-	// forward with adding the 'actor' to the original 'actor's 'following'
-	// collection by the client application.
+	// The wrapping function determines if this 'Reject' is in response to
+	// a 'Follow' by the actor of this inbox, found embedded or in the
+	// database. If so, the 'actor's the Follow was for are removed from the
+	// original 'actor's 'following' collection, where the application may
+	// have added them while the Follow was pending, and FollowRejected is
+	// called.
 	Reject func(context.Context, vocab.ActivityStreamsReject) error
+	// FollowRejected is called when a 'Reject' of a 'Follow' by the actor
+	// of this inbox is received, after the rejecting actors are removed
+	// from its 'following' collection, so the application may update its
+	// state of the pending Follow.
+	FollowRejected func(c context.Context, reject vocab.ActivityStreamsReject, follow vocab.ActivityStreamsFollow) error
 	// Add handles additional side effects for the Add ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -98,11 +131,18 @@ type FederatingWrappedCallbacks struct {
 	// The wrapping function will add the activity to the "likes" collection
 	// on all 'object' targets owned by this server.
 	Like func(context.Context, vocab.ActivityStreamsLike) error
+	// MaintainLikes keeps the "likes" collections of the objects owned by
+	// this server consistent: a Like already in them, or by an actor who
+	// already liked the object, is not added again, and their 'totalItems'
+	// count their items so they need not be scanned.
+	MaintainLikes bool
 	// Announce handles additional side effects for the Announce
 	// ActivityStreams type, specific to the application using go-fed.
 	//
 	// The wrapping function will add the activity to the "shares"
-	// collection on all 'object' targets owned by this server.
+	// collection on all 'object' targets owned by this server, unless it
+	// is already there or one of its actors already shared the object.
+	// Announce is called for repeats as well.
 	Announce func(context.Context, vocab.ActivityStreamsAnnounce) error
 	// Undo handles additional side effects for the Undo ActivityStreams
 	// type, specific to the application using go-fed.
@@ -112,8 +152,16 @@ type FederatingWrappedCallbacks struct {
 	// It enforces that the actors on the Undo must correspond to all of the
 	// 'object' actors in some manner.
 	//
-	// It is expected that the application will implement the proper
-	// reversal of activities that are being undone.
+	// The wrapping function also reverses the changes the side effects of
+	// the undone activities made to the collections of this server: an
+	// undone Follow is removed from the 'followers', an undone Accept of a
+	// Follow from the 'following', and an undone Like or Announce from the
+	// "likes" or "shares" collections, and an undone Block is passed to
+	// Unblock. Undone activities referenced by IRI are reversed if the
+	// database has them, and must be by the actor of the Undo.
+	//
+	// It is expected that the application will implement the reversal of
+	// other side effects of the activities that are being undone.
 	Undo func(context.Context, vocab.ActivityStreamsUndo) error
 	// Block handles additional side effects for the Block ActivityStreams
 	// type, specific to the application using go-fed.
@@ -123,6 +171,13 @@ type FederatingWrappedCallbacks struct {
 	// received from a federated peer, as delivering Blocks explicitly
 	// deviates from the original ActivityPub specification.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Unblock handles the Blocks undone by a received Undo, so that the
+	// application lifts the blocks it recorded for them.
+	//
+	// It is called by the wrapping function of Undo, once for each undone
+	// Block by the actor of the Undo, before the Undo function. Blocks
+	// referenced by IRI are only undone if the database has them.
+	Unblock func(context.Context, vocab.ActivityStreamsBlock) error
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -138,6 +193,15 @@ type FederatingWrappedCallbacks struct {
 	deliver func(c context.Context, outboxIRI *url.URL, activity Activity) error
 	// newTransport creates a new Transport.
 	newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
+	// bridgeCompatibility tolerates payloads produced by bridges.
+	bridgeCompatibility BridgeCompatibility
+	// tombstone replaces deleted objects with Tombstones.
+	tombstone bool
+	// clock is the Clock dating the Tombstones.
+	clock Clock
+	// handshakeEmbedding is the HandshakeEmbeddingPolicy of the
+	// FederatingProtocol, if any.
+	handshakeEmbedding HandshakeEmbeddingPolicy
 }
 
 // callbacks returns the WrappedCallbacks members into a single interface slice
@@ -258,6 +322,11 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 		} else if t == nil {
 			return fmt.Errorf("cannot handle federated create: object is neither a value nor IRI")
 		}
+		if w.Vote != nil {
+			if isVote, err := w.vote(c, a, t); err != nil || isVote {
+				return err
+			}
+		}
 		id, err := GetId(t)
 		if err != nil {
 			return err
@@ -289,7 +358,7 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
-	if err := mustHaveActivityOriginMatchObjects(a); err != nil {
+	if err := mustHaveActivityOriginMatchObjects(a, w.bridgeCompatibility); err != nil {
 		return err
 	}
 	// Create anonymous loop function to be able to properly scope the defer
@@ -330,7 +399,7 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
-	if err := mustHaveActivityOriginMatchObjects(a); err != nil {
+	if err := mustHaveActivityOriginMatchObjects(a, w.bridgeCompatibility); err != nil {
 		return err
 	}
 	// Create anonymous loop function to be able to properly scope the defer
@@ -345,7 +414,7 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 			return err
 		}
 		defer w.db.Unlock(c, id)
-		if err := w.db.Delete(c, id); err != nil {
+		if err := w.deleteOrTombstone(c, id); err != nil {
 			return err
 		}
 		return nil
@@ -397,81 +466,119 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 		}
 	}
 	if isMe {
-		// Prepare the response.
-		var response Activity
-		if w.OnFollow == OnFollowAutomaticallyAccept {
-			response = streams.NewActivityStreamsAccept()
-		} else if w.OnFollow == OnFollowAutomaticallyReject {
-			response = streams.NewActivityStreamsReject()
-		} else {
-			return fmt.Errorf("unknown OnFollowBehavior: %d", w.OnFollow)
-		}
-		// Set us as the 'actor'.
-		me := streams.NewActivityStreamsActorProperty()
-		response.SetActivityStreamsActor(me)
-		me.AppendIRI(actorIRI)
-		// Set the Follow as the 'object' property.
-		op := streams.NewActivityStreamsObjectProperty()
-		response.SetActivityStreamsObject(op)
-		op.AppendActivityStreamsFollow(a)
-		// Add all actors on the original Follow to the 'to' property.
-		recipients := make([]*url.URL, 0)
-		to := streams.NewActivityStreamsToProperty()
-		response.SetActivityStreamsTo(to)
-		followActors := a.GetActivityStreamsActor()
-		for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
+		behavior := w.OnFollow
+		if behavior == OnFollowDecideByCallback {
+			if w.DecideFollow == nil {
+				return fmt.Errorf("OnFollowDecideByCallback requires a DecideFollow callback")
 			}
-			to.AppendIRI(id)
-			recipients = append(recipients, id)
-		}
-		if w.OnFollow == OnFollowAutomaticallyAccept {
-			// If automatically accepting, then also update our
-			// followers collection with the new actors.
-			//
-			// If automatically rejecting, do not update the
-			// followers collection.
-			if err := w.db.Lock(c, actorIRI); err != nil {
+			if behavior, err = w.DecideFollow(c, a); err != nil {
 				return err
 			}
-			// WARNING: Unlock not deferred.
-			followers, err := w.db.Followers(c, actorIRI)
-			if err != nil {
-				w.db.Unlock(c, actorIRI)
-				return err
-			}
-			items := followers.GetActivityStreamsItems()
-			for _, elem := range recipients {
-				items.PrependIRI(elem)
-			}
-			if err = w.db.Update(c, followers); err != nil {
-				w.db.Unlock(c, actorIRI)
+		}
+		if behavior != OnFollowDoNothing {
+			if err := w.respondToFollow(c, a, actorIRI, behavior); err != nil {
 				return err
 			}
-			w.db.Unlock(c, actorIRI)
-			// Unlock must be called by now and every branch above.
 		}
-		// Lock without defer!
-		w.db.Lock(c, w.inboxIRI)
-		outboxIRI, err := w.db.OutboxForInbox(c, w.inboxIRI)
+	}
+	if w.Follow != nil {
+		return w.Follow(c, a)
+	}
+	return nil
+}
+
+// respondToFollow sends an Accept or Reject of the Follow of the actor, as the
+// behavior determines. Accepting it adds the actors of the Follow to the
+// followers of the actor first.
+func (w FederatingWrappedCallbacks) respondToFollow(c context.Context, a vocab.ActivityStreamsFollow, actorIRI *url.URL, behavior OnFollowBehavior) error {
+	// Prepare the response, with the Follow as the 'object' property.
+	op, err := handshakeObjectProperty(a, w.followEmbedding(c, a))
+	if err != nil {
+		return err
+	}
+	var response Activity
+	if behavior == OnFollowAutomaticallyAccept {
+		accept := streams.NewActivityStreamsAccept()
+		accept.SetActivityStreamsObject(op)
+		response = accept
+	} else if behavior == OnFollowAutomaticallyReject {
+		reject := streams.NewActivityStreamsReject()
+		reject.SetActivityStreamsObject(op)
+		response = reject
+	} else {
+		return fmt.Errorf("unknown OnFollowBehavior: %d", behavior)
+	}
+	// Set us as the 'actor'.
+	me := streams.NewActivityStreamsActorProperty()
+	response.SetActivityStreamsActor(me)
+	me.AppendIRI(actorIRI)
+	// Add all actors on the original Follow to the 'to' property.
+	recipients := make([]*url.URL, 0)
+	to := streams.NewActivityStreamsToProperty()
+	response.SetActivityStreamsTo(to)
+	followActors := a.GetActivityStreamsActor()
+	for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
+		id, err := ToId(iter)
 		if err != nil {
-			w.db.Unlock(c, w.inboxIRI)
 			return err
 		}
-		w.db.Unlock(c, w.inboxIRI)
-		// Everything must be unlocked by now.
-		if err := w.addNewIds(c, response); err != nil {
+		to.AppendIRI(id)
+		recipients = append(recipients, id)
+	}
+	if behavior == OnFollowAutomaticallyAccept {
+		// If accepting, then also update our followers collection
+		// with the new actors.
+		//
+		// If rejecting, do not update the followers collection.
+		if err := w.db.Lock(c, actorIRI); err != nil {
 			return err
-		} else if err := w.deliver(c, outboxIRI, response); err != nil {
+		}
+		// WARNING: Unlock not deferred.
+		followers, err := w.db.Followers(c, actorIRI)
+		if err != nil {
+			w.db.Unlock(c, actorIRI)
+			return err
+		}
+		items := followers.GetActivityStreamsItems()
+		for _, elem := range recipients {
+			prependItemIRI(c, items, elem)
+		}
+		if err = w.db.Update(c, followers); err != nil {
+			w.db.Unlock(c, actorIRI)
 			return err
 		}
+		w.db.Unlock(c, actorIRI)
+		// Unlock must be called by now and every branch above.
 	}
-	if w.Follow != nil {
-		return w.Follow(c, a)
+	// Lock without defer!
+	w.db.Lock(c, w.inboxIRI)
+	outboxIRI, err := w.db.OutboxForInbox(c, w.inboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.inboxIRI)
+		return err
 	}
-	return nil
+	w.db.Unlock(c, w.inboxIRI)
+	// Everything must be unlocked by now.
+	if err := w.addNewIds(c, response); err != nil {
+		return err
+	}
+	return w.deliver(c, outboxIRI, response)
+}
+
+// followEmbedding returns how the Follow is embedded in the response to it.
+func (w FederatingWrappedCallbacks) followEmbedding(c context.Context, a vocab.ActivityStreamsFollow) HandshakeEmbedding {
+	if w.handshakeEmbedding == nil {
+		return w.OnFollowEmbedding
+	}
+	actors := a.GetActivityStreamsActor()
+	if actors == nil || actors.Len() == 0 {
+		return w.OnFollowEmbedding
+	}
+	peer, err := ToId(actors.At(0))
+	if err != nil {
+		return w.OnFollowEmbedding
+	}
+	return w.handshakeEmbedding.HandshakeEmbedding(c, a, peer)
 }
 
 // accept implements the federating Accept activity side effects.
@@ -604,7 +711,11 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 					acceptActors[id.String()] = false
 				}
 				// Verify all actor(s) were on the original Follow.
-				followObj := follow.GetActivityStreamsObject()
+				followObjecter, ok := follow.(objecter)
+				if !ok {
+					return fmt.Errorf("a Follow in an Accept has no object property: %T", follow)
+				}
+				followObj := followObjecter.GetActivityStreamsObject()
 				for iter := followObj.Begin(); iter != followObj.End(); iter = iter.Next() {
 					id, err := ToId(iter)
 					if err != nil {
@@ -641,7 +752,7 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 					w.db.Unlock(c, actorIRI)
 					return err
 				}
-				items.PrependIRI(id)
+				prependItemIRI(c, items, id)
 			}
 			if err = w.db.Update(c, following); err != nil {
 				w.db.Unlock(c, actorIRI)
@@ -659,6 +770,38 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 
 // reject implements the federating Reject activity side effects.
 func (w FederatingWrappedCallbacks) reject(c context.Context, a vocab.ActivityStreamsReject) error {
+	if op := a.GetActivityStreamsObject(); op != nil && op.Len() > 0 {
+		actorIRI, err := w.inboxActor(c)
+		if err != nil {
+			return err
+		}
+		follow, err := w.followBy(c, op, actorIRI)
+		if err != nil {
+			return err
+		}
+		// Only the actors the Follow was for may reject it.
+		if follow != nil {
+			actors := actorIds(a)
+			rejecting := make(map[string]bool)
+			if fop := follow.GetActivityStreamsObject(); fop != nil {
+				for _, id := range objectIds(fop) {
+					if actors[id.String()] {
+						rejecting[id.String()] = true
+					}
+				}
+			}
+			if len(rejecting) > 0 {
+				if err := w.removeFollowing(c, actorIRI, rejecting); err != nil {
+					return err
+				}
+				if w.FollowRejected != nil {
+					if err := w.FollowRejected(c, a, follow); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
 	if w.Reject != nil {
 		return w.Reject(c, a)
 	}
@@ -753,23 +896,43 @@ func (w FederatingWrappedCallbacks) like(c context.Context, a vocab.ActivityStre
 		}
 		// Prepend the activity's 'id' on the 'likes' Collection or
 		// OrderedCollection.
+		var existing []IdProperty
 		if col, ok := likesT.(itemser); ok {
 			items := col.GetActivityStreamsItems()
 			if items == nil {
 				items = streams.NewActivityStreamsItemsProperty()
 				col.SetActivityStreamsItems(items)
 			}
-			items.PrependIRI(id)
+			if w.MaintainLikes {
+				for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+					existing = append(existing, iter)
+				}
+				if repeated, err := w.isRepeated(c, existing, a, id, objId); err != nil || repeated {
+					return err
+				}
+			}
+			prependItemIRI(c, items, id)
 		} else if oCol, ok := likesT.(orderedItemser); ok {
 			oItems := oCol.GetActivityStreamsOrderedItems()
 			if oItems == nil {
 				oItems = streams.NewActivityStreamsOrderedItemsProperty()
 				oCol.SetActivityStreamsOrderedItems(oItems)
 			}
-			oItems.PrependIRI(id)
+			if w.MaintainLikes {
+				for iter := oItems.Begin(); iter != oItems.End(); iter = iter.Next() {
+					existing = append(existing, iter)
+				}
+				if repeated, err := w.isRepeated(c, existing, a, id, objId); err != nil || repeated {
+					return err
+				}
+			}
+			prependOrderedItemIRI(c, oItems, id)
 		} else {
 			return fmt.Errorf("likes type is neither a Collection nor an OrderedCollection: %T", likesT)
 		}
+		if w.MaintainLikes {
+			addTotalItems(likesT, 1)
+		}
 		err = w.db.Update(c, t)
 		if err != nil {
 			return err
@@ -794,6 +957,9 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 		return err
 	}
 	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
@@ -833,21 +999,34 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 			shares.SetActivityStreamsCollection(col)
 		}
 		// Prepend the activity's 'id' on the 'shares' Collection or
-		// OrderedCollection.
+		// OrderedCollection, unless it repeats a share.
+		var existing []IdProperty
 		if col, ok := sharesT.(itemser); ok {
 			items := col.GetActivityStreamsItems()
 			if items == nil {
 				items = streams.NewActivityStreamsItemsProperty()
 				col.SetActivityStreamsItems(items)
 			}
-			items.PrependIRI(id)
+			for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+				existing = append(existing, iter)
+			}
+			if repeated, err := w.isRepeated(c, existing, a, id, objId); err != nil || repeated {
+				return err
+			}
+			prependItemIRI(c, items, id)
 		} else if oCol, ok := sharesT.(orderedItemser); ok {
 			oItems := oCol.GetActivityStreamsOrderedItems()
 			if oItems == nil {
 				oItems = streams.NewActivityStreamsOrderedItemsProperty()
 				oCol.SetActivityStreamsOrderedItems(oItems)
 			}
-			oItems.PrependIRI(id)
+			for iter := oItems.Begin(); iter != oItems.End(); iter = iter.Next() {
+				existing = append(existing, iter)
+			}
+			if repeated, err := w.isRepeated(c, existing, a, id, objId); err != nil || repeated {
+				return err
+			}
+			prependOrderedItemIRI(c, oItems, id)
 		} else {
 			return fmt.Errorf("shares type is neither a Collection nor an OrderedCollection: %T", sharesT)
 		}
@@ -868,6 +1047,93 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 	return nil
 }
 
+// InteractionDatabase may be implemented by a Database to find the Likes and
+// Announces of an actor on an object directly, so that the wrapped callbacks
+// recognize an actor liking or sharing an object again under a new id without
+// reading the entries of its "likes" or "shares" collection.
+//
+// It is called while the lock of the object is held, and so must not call
+// Lock itself.
+type InteractionDatabase interface {
+	// InteractionOf returns the id of the activity of the type, "Like" or
+	// "Announce", by the actor on the object, or nil if there is none.
+	InteractionOf(c context.Context, typeName string, actorIRI, objectIRI *url.URL) (activityIRI *url.URL, err error)
+}
+
+// isRepeated determines whether the activity on the object repeats one of the
+// entries of its collection: either the same activity delivered again, such as
+// both to a shared inbox and by inbox forwarding, or another activity by one of
+// its actors.
+//
+// Entries are compared by id, and by actor when they are embedded. Entries
+// referenced by IRI are not fetched: other activities by the actors are only
+// found if the Database is an InteractionDatabase.
+func (w FederatingWrappedCallbacks) isRepeated(c context.Context, entries []IdProperty, a Activity, id, objId *url.URL) (bool, error) {
+	var actorIRIs []*url.URL
+	actors := make(map[string]bool)
+	if ap := a.GetActivityStreamsActor(); ap != nil {
+		for iter := ap.Begin(); iter != ap.End(); iter = iter.Next() {
+			actorId, err := ToId(iter)
+			if err != nil {
+				return false, err
+			}
+			actorIRIs = append(actorIRIs, actorId)
+			actors[actorId.String()] = true
+		}
+	}
+	for _, entry := range entries {
+		entryId, err := ToId(entry)
+		if err != nil {
+			continue
+		} else if entryId.String() == id.String() {
+			return true, nil
+		}
+		if t := entry.GetType(); t != nil && hasActorIn(t, actors) {
+			return true, nil
+		}
+	}
+	idb, ok := w.db.(InteractionDatabase)
+	if !ok {
+		return false, nil
+	}
+	for _, actorIRI := range actorIRIs {
+		prev, err := idb.InteractionOf(c, a.GetTypeName(), actorIRI, objId)
+		if err != nil {
+			return false, err
+		} else if prev != nil && prev.String() != id.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getIfExists returns the value in the Database, or nil if it has none.
+func (w FederatingWrappedCallbacks) getIfExists(c context.Context, iri *url.URL) (vocab.Type, error) {
+	if err := w.db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer w.db.Unlock(c, iri)
+	if exists, err := w.db.Exists(c, iri); err != nil || !exists {
+		return nil, err
+	}
+	return w.db.Get(c, iri)
+}
+
+// hasActorIn determines whether any actor of the value is in the set of actor
+// ids.
+func hasActorIn(t vocab.Type, actors map[string]bool) bool {
+	a, ok := t.(actorer)
+	if !ok || a.GetActivityStreamsActor() == nil {
+		return false
+	}
+	for iter := a.GetActivityStreamsActor().Begin(); iter != a.GetActivityStreamsActor().End(); iter = iter.Next() {
+		if actorId, err := ToId(iter); err == nil && actors[actorId.String()] {
+			return true
+		}
+	}
+	return false
+}
+
 // undo implements the federating Undo activity side effects.
 func (w FederatingWrappedCallbacks) undo(c context.Context, a vocab.ActivityStreamsUndo) error {
 	op := a.GetActivityStreamsObject()
@@ -878,6 +1144,9 @@ func (w FederatingWrappedCallbacks) undo(c context.Context, a vocab.ActivityStre
 	if err := mustHaveActivityActorsMatchObjectActors(c, actors, op, w.newTransport, w.inboxIRI); err != nil {
 		return err
 	}
+	if err := w.undoSideEffects(c, a); err != nil {
+		return err
+	}
 	if w.Undo != nil {
 		return w.Undo(c, a)
 	}
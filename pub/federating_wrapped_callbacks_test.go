@@ -1,7 +1,14 @@
 package pub
 
 import (
+	"context"
+	"fmt"
+	"net/url"
 	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
 )
 
 // TestFederatedCallbacks tests the overriding functionality.
@@ -114,33 +121,150 @@ func TestFederatedDelete(t *testing.T) {
 }
 
 func TestFederatedFollow(t *testing.T) {
+	ctx := context.Background()
+	const followersIRI = "https://maybe.example.com/person/followers"
+	newFollow := func() vocab.ActivityStreamsFollow {
+		follow := streams.NewActivityStreamsFollow()
+		follow.SetActivityStreamsId(newIdProperty(mustParse(testFederatedActivityIRI)))
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(testFederatedActorIRI))
+		follow.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testPersonIRI))
+		follow.SetActivityStreamsObject(op)
+		return follow
+	}
+	// setupFn returns the callbacks of the inbox, recording the activity
+	// delivered in response.
+	setupFn := func(ctl *gomock.Controller, b OnFollowBehavior) (*MockDatabase, *FederatingWrappedCallbacks, *Activity) {
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI)).AnyTimes()
+		db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testPersonIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI)).AnyTimes()
+		var delivered Activity
+		w := &FederatingWrappedCallbacks{
+			OnFollow: b,
+			db:       db,
+			inboxIRI: mustParse(testMyInboxIRI),
+			addNewIds: func(c context.Context, a Activity) error {
+				return nil
+			},
+			deliver: func(c context.Context, outboxIRI *url.URL, a Activity) error {
+				assertEqual(t, outboxIRI.String(), testMyOutboxIRI)
+				delivered = a
+				return nil
+			},
+		}
+		return db, w, &delivered
+	}
+	expectOutbox := func(db *MockDatabase) {
+		db.EXPECT().OutboxForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testMyOutboxIRI), nil)
+	}
+	expectFollowersUpdate := func(db *MockDatabase) {
+		followers := newItemsCollection()
+		followers.SetActivityStreamsId(newIdProperty(mustParse(followersIRI)))
+		db.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		db.EXPECT().Followers(ctx, mustParse(testPersonIRI)).Return(followers, nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(itemIds(v)), "["+testFederatedActorIRI+"]")
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+	}
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		assertEqual(t, FederatingWrappedCallbacks{}.follow(ctx, streams.NewActivityStreamsFollow()), ErrObjectRequired)
 	})
 	t.Run("ErrorIfObjectLengthZero", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		follow := streams.NewActivityStreamsFollow()
+		follow.SetActivityStreamsObject(streams.NewActivityStreamsObjectProperty())
+		assertEqual(t, FederatingWrappedCallbacks{}.follow(ctx, follow), ErrObjectRequired)
 	})
 	t.Run("OnFollowNothingDoesNothing", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w, delivered := setupFn(ctl, OnFollowDoNothing)
+		assertEqual(t, w.follow(ctx, newFollow()), nil)
+		assertEqual(t, *delivered, nil)
 	})
 	t.Run("OnFollowAutomaticallyAcceptUpdatesFollowers", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, _ := setupFn(ctl, OnFollowAutomaticallyAccept)
+		expectFollowersUpdate(db)
+		expectOutbox(db)
+		assertEqual(t, w.follow(ctx, newFollow()), nil)
 	})
 	t.Run("OnFollowAutomaticallyAcceptDelivers", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, delivered := setupFn(ctl, OnFollowAutomaticallyAccept)
+		expectFollowersUpdate(db)
+		expectOutbox(db)
+		assertEqual(t, w.follow(ctx, newFollow()), nil)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsAccept(*delivered), true)
+		assertEqual(t, (*delivered).GetActivityStreamsTo().At(0).GetIRI().String(), testFederatedActorIRI)
 	})
 	t.Run("OnFollowAutomaticallyRejectDelivers", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
-	})
-	t.Run("OnFollowNothingCallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
-	})
-	t.Run("OnFollowAutomaticallyAcceptCallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
-	})
-	t.Run("OnFollowAutomaticallyRejectCallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
-	})
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, delivered := setupFn(ctl, OnFollowAutomaticallyReject)
+		expectOutbox(db)
+		assertEqual(t, w.follow(ctx, newFollow()), nil)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsReject(*delivered), true)
+	})
+	t.Run("OnFollowDecideByCallbackAccepts", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, delivered := setupFn(ctl, OnFollowDecideByCallback)
+		w.DecideFollow = func(c context.Context, f vocab.ActivityStreamsFollow) (OnFollowBehavior, error) {
+			return OnFollowAutomaticallyAccept, nil
+		}
+		expectFollowersUpdate(db)
+		expectOutbox(db)
+		assertEqual(t, w.follow(ctx, newFollow()), nil)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsAccept(*delivered), true)
+	})
+	t.Run("OnFollowDecideByCallbackLeavesPending", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w, delivered := setupFn(ctl, OnFollowDecideByCallback)
+		w.DecideFollow = func(c context.Context, f vocab.ActivityStreamsFollow) (OnFollowBehavior, error) {
+			return OnFollowDoNothing, nil
+		}
+		assertEqual(t, w.follow(ctx, newFollow()), nil)
+		assertEqual(t, *delivered, nil)
+	})
+	t.Run("OnFollowDecideByCallbackRequiresCallback", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w, _ := setupFn(ctl, OnFollowDecideByCallback)
+		assertNotEqual(t, w.follow(ctx, newFollow()), nil)
+	})
+	for name, b := range map[string]OnFollowBehavior{
+		"Nothing":             OnFollowDoNothing,
+		"AutomaticallyAccept": OnFollowAutomaticallyAccept,
+		"AutomaticallyReject": OnFollowAutomaticallyReject,
+	} {
+		b := b
+		t.Run("OnFollow"+name+"CallsCustomCallback", func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			db, w, _ := setupFn(ctl, b)
+			if b == OnFollowAutomaticallyAccept {
+				expectFollowersUpdate(db)
+			}
+			if b != OnFollowDoNothing {
+				expectOutbox(db)
+			}
+			called := false
+			w.Follow = func(c context.Context, f vocab.ActivityStreamsFollow) error {
+				called = true
+				return nil
+			}
+			assertEqual(t, w.follow(ctx, newFollow()), nil)
+			assertEqual(t, called, true)
+		})
+	}
 }
 
 func TestFederatedAccept(t *testing.T) {
@@ -168,8 +292,84 @@ func TestFederatedAccept(t *testing.T) {
 }
 
 func TestFederatedReject(t *testing.T) {
+	ctx := context.Background()
+	const followingIRI = "https://maybe.example.com/person/following"
+	newReject := func(actor string) vocab.ActivityStreamsReject {
+		follow := streams.NewActivityStreamsFollow()
+		follow.SetActivityStreamsId(newIdProperty(mustParse(testNewActivityIRI)))
+		fa := streams.NewActivityStreamsActorProperty()
+		fa.AppendIRI(mustParse(testPersonIRI))
+		follow.SetActivityStreamsActor(fa)
+		fo := streams.NewActivityStreamsObjectProperty()
+		fo.AppendIRI(mustParse(testFederatedActorIRI))
+		follow.SetActivityStreamsObject(fo)
+		reject := streams.NewActivityStreamsReject()
+		ra := streams.NewActivityStreamsActorProperty()
+		ra.AppendIRI(mustParse(actor))
+		reject.SetActivityStreamsActor(ra)
+		ro := streams.NewActivityStreamsObjectProperty()
+		ro.AppendActivityStreamsFollow(follow)
+		reject.SetActivityStreamsObject(ro)
+		return reject
+	}
+	expectInboxActor := func(db *MockDatabase) {
+		db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testPersonIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+	}
+	t.Run("RemovesPendingFollowing", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectInboxActor(db)
+		following := newItemsCollection(testFederatedActorIRI2, testFederatedActorIRI)
+		following.SetActivityStreamsId(newIdProperty(mustParse(followingIRI)))
+		db.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(following, nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			items := v.(vocab.ActivityStreamsCollection).GetActivityStreamsItems()
+			assertEqual(t, items.Len(), 1)
+			assertEqual(t, items.At(0).GetIRI().String(), testFederatedActorIRI2)
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		var rejected vocab.ActivityStreamsFollow
+		w := FederatingWrappedCallbacks{
+			db:       db,
+			inboxIRI: mustParse(testMyInboxIRI),
+			FollowRejected: func(c context.Context, r vocab.ActivityStreamsReject, f vocab.ActivityStreamsFollow) error {
+				rejected = f
+				return nil
+			},
+		}
+		assertEqual(t, w.reject(ctx, newReject(testFederatedActorIRI)), nil)
+		assertNotEqual(t, rejected, nil)
+	})
+	t.Run("IgnoresRejectByOthers", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectInboxActor(db)
+		w := FederatingWrappedCallbacks{
+			db:       db,
+			inboxIRI: mustParse(testMyInboxIRI),
+			FollowRejected: func(c context.Context, r vocab.ActivityStreamsReject, f vocab.ActivityStreamsFollow) error {
+				t.Fatal("FollowRejected called for a Reject by another actor")
+				return nil
+			},
+		}
+		assertEqual(t, w.reject(ctx, newReject(testFederatedActorIRI2)), nil)
+	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		called := false
+		w := FederatingWrappedCallbacks{
+			Reject: func(c context.Context, r vocab.ActivityStreamsReject) error {
+				called = true
+				return nil
+			},
+		}
+		assertEqual(t, w.reject(ctx, streams.NewActivityStreamsReject()), nil)
+		assertEqual(t, called, true)
 	})
 }
 
@@ -227,60 +427,316 @@ func TestFederatedRemove(t *testing.T) {
 	})
 }
 
+// interactionDatabase is a Database finding the interactions of actors with
+// objects by their type, actor and object, separated by spaces.
+type interactionDatabase struct {
+	*MockDatabase
+	interactions map[string]string
+}
+
+func (d interactionDatabase) InteractionOf(c context.Context, typeName string, actorIRI, objectIRI *url.URL) (*url.URL, error) {
+	id, ok := d.interactions[typeName+" "+actorIRI.String()+" "+objectIRI.String()]
+	if !ok {
+		return nil, nil
+	}
+	return url.Parse(id)
+}
+
 func TestFederatedLike(t *testing.T) {
+	ctx := context.Background()
+	const likeId = "https://other.example.com/like/1"
+	const earlier = "https://other.example.com/like/0"
+	setupFn := func(ctl *gomock.Controller, owns bool, note vocab.Type) (*MockDatabase, FederatingWrappedCallbacks) {
+		setupData()
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(owns, nil)
+		if owns {
+			db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(note, nil)
+		}
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		return db, FederatingWrappedCallbacks{db: db}
+	}
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		l := streams.NewActivityStreamsLike()
+		l.SetActivityStreamsId(newIdProperty(mustParse(likeId)))
+		assertEqual(t, FederatingWrappedCallbacks{}.like(ctx, l), ErrObjectRequired)
 	})
 	t.Run("ErrorIfObjectLengthZero", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		l := streams.NewActivityStreamsLike()
+		l.SetActivityStreamsId(newIdProperty(mustParse(likeId)))
+		l.SetActivityStreamsObject(streams.NewActivityStreamsObjectProperty())
+		assertEqual(t, FederatingWrappedCallbacks{}.like(ctx, l), ErrObjectRequired)
 	})
 	t.Run("SkipsUnownedObjects", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w := setupFn(ctl, false, nil)
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
 	})
 	t.Run("AddsToNewLikesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNoteWithLikes(nil))
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(likesOf(v)), "["+likeId+"]")
+			return nil
+		})
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
 	})
 	t.Run("AddsToExistingLikesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNoteWithLikes(newItemsCollection(earlier)))
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(likesOf(v)), "["+likeId+" "+earlier+"]")
+			return nil
+		})
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
 	})
 	t.Run("AddsToExistingLikesOrderedCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNoteWithLikes(streams.NewActivityStreamsOrderedCollection()))
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(likesOf(v)), "["+likeId+"]")
+			return nil
+		})
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
+	})
+	t.Run("MaintainLikesCountsItems", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNoteWithLikes(newItemsCollection(earlier)))
+		w.MaintainLikes = true
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			likes := v.(vocab.ActivityStreamsNote).GetActivityStreamsLikes().GetActivityStreamsCollection()
+			assertEqual(t, likes.GetActivityStreamsTotalItems().Get(), 2)
+			return nil
+		})
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
+	})
+	t.Run("MaintainLikesDeduplicatesRepeatsByActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNoteWithLikes(newItemsCollection(earlier)))
+		w.MaintainLikes = true
+		w.db = interactionDatabase{db, map[string]string{
+			"Like " + testFederatedActorIRI + " " + testNoteId1: earlier,
+		}}
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
 	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w := setupFn(ctl, false, nil)
+		called := false
+		w.Like = func(c context.Context, l vocab.ActivityStreamsLike) error {
+			called = true
+			return nil
+		}
+		assertEqual(t, w.like(ctx, newLikeOf(likeId, testFederatedActorIRI, testNoteId1)), nil)
+		assertEqual(t, called, true)
 	})
 }
 
 func TestFederatedAnnounce(t *testing.T) {
+	ctx := context.Background()
+	const announceId = "https://other.example.com/announce/1"
+	newAnnounce := func(id, actor string) vocab.ActivityStreamsAnnounce {
+		a := streams.NewActivityStreamsAnnounce()
+		a.SetActivityStreamsId(newIdProperty(mustParse(id)))
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(actor))
+		a.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	// newNote returns a Note whose shares hold the shares, if any.
+	newNote := func(shares vocab.Type) vocab.ActivityStreamsNote {
+		n := streams.NewActivityStreamsNote()
+		n.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+		if shares != nil {
+			sp := streams.NewActivityStreamsSharesProperty()
+			if col, ok := shares.(vocab.ActivityStreamsCollection); ok {
+				sp.SetActivityStreamsCollection(col)
+			} else {
+				sp.SetActivityStreamsOrderedCollection(shares.(vocab.ActivityStreamsOrderedCollection))
+			}
+			n.SetActivityStreamsShares(sp)
+		}
+		return n
+	}
+	newCollection := func(ids ...string) vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		for _, id := range ids {
+			items.AppendIRI(mustParse(id))
+		}
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	// sharesOf returns the ids of the shares of the updated Note.
+	sharesOf := func(t *testing.T, v vocab.Type) []string {
+		var ids []string
+		shares := v.(vocab.ActivityStreamsNote).GetActivityStreamsShares()
+		if col := shares.GetActivityStreamsCollection(); col != nil {
+			for iter := col.GetActivityStreamsItems().Begin(); iter != nil; iter = iter.Next() {
+				ids = append(ids, iter.GetIRI().String())
+			}
+		} else {
+			oc := shares.GetActivityStreamsOrderedCollection()
+			for iter := oc.GetActivityStreamsOrderedItems().Begin(); iter != nil; iter = iter.Next() {
+				ids = append(ids, iter.GetIRI().String())
+			}
+		}
+		return ids
+	}
+	setupFn := func(ctl *gomock.Controller, owns bool, note vocab.Type) (*MockDatabase, FederatingWrappedCallbacks) {
+		setupData()
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(owns, nil)
+		if owns {
+			db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(note, nil)
+		}
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		return db, FederatingWrappedCallbacks{db: db}
+	}
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := streams.NewActivityStreamsAnnounce()
+		a.SetActivityStreamsId(newIdProperty(mustParse(announceId)))
+		assertEqual(t, FederatingWrappedCallbacks{}.announce(ctx, a), ErrObjectRequired)
+	})
 	t.Run("SkipsUnownedObjects", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w := setupFn(ctl, false, nil)
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
 	})
 	t.Run("AddsToNewSharesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNote(nil))
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(sharesOf(t, v)), "["+announceId+"]")
+			return nil
+		})
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
 	})
 	t.Run("AddsToExistingSharesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		const earlier = "https://other.example.com/announce/0"
+		db, w := setupFn(ctl, true, newNote(newCollection(earlier)))
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(sharesOf(t, v)), "["+announceId+" "+earlier+"]")
+			return nil
+		})
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
 	})
 	t.Run("AddsToExistingSharesOrderedCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true, newNote(streams.NewActivityStreamsOrderedCollection()))
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(sharesOf(t, v)), "["+announceId+"]")
+			return nil
+		})
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
+	})
+	t.Run("DeduplicatesRedelivery", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w := setupFn(ctl, true, newNote(newCollection(announceId)))
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
+	})
+	t.Run("DeduplicatesRepeatsByActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		const earlier = "https://other.example.com/announce/0"
+		db, w := setupFn(ctl, true, newNote(newCollection(earlier)))
+		w.db = interactionDatabase{db, map[string]string{
+			"Announce " + testFederatedActorIRI + " " + testNoteId1: earlier,
+		}}
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
 	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, w := setupFn(ctl, false, nil)
+		called := false
+		w.Announce = func(c context.Context, a vocab.ActivityStreamsAnnounce) error {
+			called = true
+			return nil
+		}
+		assertEqual(t, w.announce(ctx, newAnnounce(announceId, testFederatedActorIRI)), nil)
+		assertEqual(t, called, true)
 	})
 }
 
 func TestFederatedUndo(t *testing.T) {
+	ctx := context.Background()
+	undoOf := func(actor string, objs ...vocab.Type) vocab.ActivityStreamsUndo {
+		u := streams.NewActivityStreamsUndo()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(actor))
+		u.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		for _, obj := range objs {
+			op.AppendType(obj)
+		}
+		u.SetActivityStreamsObject(op)
+		return u
+	}
+	newBlock := func() vocab.ActivityStreamsBlock {
+		b := streams.NewActivityStreamsBlock()
+		b.SetActivityStreamsId(newIdProperty(mustParse(testFederatedActivityIRI)))
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(testFederatedActorIRI))
+		b.SetActivityStreamsActor(ap)
+		return b
+	}
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		u := streams.NewActivityStreamsUndo()
+		assertEqual(t, FederatingWrappedCallbacks{}.undo(ctx, u), ErrObjectRequired)
 	})
 	t.Run("ErrorIfObjectLengthZero", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		assertEqual(t, FederatingWrappedCallbacks{}.undo(ctx, undoOf(testFederatedActorIRI)), ErrObjectRequired)
 	})
 	t.Run("ErrorIfActorMismatch", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		u := undoOf(testFederatedActorIRI2, newBlock())
+		assertNotEqual(t, FederatingWrappedCallbacks{}.undo(ctx, u), nil)
 	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		called := false
+		w := FederatingWrappedCallbacks{
+			Undo: func(c context.Context, u vocab.ActivityStreamsUndo) error {
+				called = true
+				return nil
+			},
+		}
+		assertEqual(t, w.undo(ctx, undoOf(testFederatedActorIRI, newBlock())), nil)
+		assertEqual(t, called, true)
+	})
+	t.Run("CallsUnblockBeforeUndo", func(t *testing.T) {
+		var calls []string
+		w := FederatingWrappedCallbacks{
+			Unblock: func(c context.Context, b vocab.ActivityStreamsBlock) error {
+				id, err := GetId(b)
+				assertEqual(t, err, nil)
+				calls = append(calls, "Unblock "+id.String())
+				return nil
+			},
+			Undo: func(c context.Context, u vocab.ActivityStreamsUndo) error {
+				calls = append(calls, "Undo")
+				return nil
+			},
+		}
+		assertEqual(t, w.undo(ctx, undoOf(testFederatedActorIRI, newBlock())), nil)
+		assertEqual(t, fmt.Sprint(calls), "[Unblock "+testFederatedActivityIRI+" Undo]")
 	})
 }
 
@@ -0,0 +1,430 @@
+// Package federation wires the subsystems of pub into a federating server from
+// a single Options struct with sane defaults: the Actor, a pool of signing and
+// caching Transports, the retries of failed deliveries, the verification of
+// HTTP Signatures, the Blocklist, and the WebFinger and NodeInfo endpoints.
+//
+// An application provides its Database, FederatingProtocol and KeyStore, and
+// serves the Federation:
+//
+//	f, err := federation.New(federation.Options{
+//	    Host:     "example.com",
+//	    Database: db,
+//	    Protocol: protocol,
+//	    KeyStore: keys,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	go f.Run(ctx)
+//	return http.ListenAndServe(":8080", f)
+//
+// The FederatingProtocol authenticates the activities posted to inboxes with the
+// Verifier of the Federation, in its AuthenticatePostInbox.
+//
+// Applications outgrowing the defaults configure the subsystems through the
+// fields of the Federation, or wire them by hand as this package does.
+package federation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/router"
+	"github.com/go-fed/activity/pub/webfinger"
+	"github.com/go-fed/activity/pub/wellknown"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// DefaultActorPrefix is the path of the actors before their usernames,
+	// unless the Options set another.
+	DefaultActorPrefix = "/users"
+	// DefaultCacheTTL is how long dereferenced values and the keys of
+	// peers are cached, unless the Options set another.
+	DefaultCacheTTL = 10 * time.Minute
+	// DefaultKeyRotationWindow is how long the replaced keys of actors are
+	// still published, unless the Options set another.
+	DefaultKeyRotationWindow = 7 * 24 * time.Hour
+	// DefaultRetryInterval is how often due deliveries are retried by Run,
+	// unless the Options set another.
+	DefaultRetryInterval = time.Minute
+	// defaultRequestTimeout limits the requests to peers of the default
+	// HttpClient.
+	defaultRequestTimeout = 30 * time.Second
+)
+
+// Options configures a Federation. Host, Database, Protocol and KeyStore are
+// required; the other fields have defaults.
+type Options struct {
+	// Host is the host of this server, such as "example.com", whose actors
+	// have IRIs like "https://example.com/users/addison".
+	Host string
+	// Database stores the actors and their collections and activities.
+	Database pub.Database
+	// Protocol is the application's side of the federating protocol.
+	Protocol pub.FederatingProtocol
+	// Social is the application's side of the client-to-server protocol.
+	// Nil only federates.
+	Social pub.SocialProtocol
+	// KeyStore holds the key pairs of the actors, which sign their
+	// requests. The Transports follow the rotations of the keys.
+	KeyStore pub.KeyStore
+	// InstanceActor is the IRI of the actor representing this server,
+	// whose key pairs in the KeyStore sign the requests made on behalf of
+	// no actor, such as fetching the keys of peers. Nil makes them
+	// unsigned, which peers requiring authorized fetch reject.
+	InstanceActor *url.URL
+	// AuthenticateGetInbox authenticates the owners of the inboxes
+	// reading them, as the CommonBehavior's AuthenticateGetInbox. Nil
+	// responds to every read with http.StatusUnauthorized.
+	AuthenticateGetInbox func(c context.Context, w http.ResponseWriter, r *http.Request) (authenticated bool, err error)
+	// AuthenticateGetOutbox authenticates the reads of outboxes, as the
+	// CommonBehavior's AuthenticateGetOutbox. Nil authenticates everyone.
+	AuthenticateGetOutbox func(c context.Context, w http.ResponseWriter, r *http.Request) (authenticated bool, err error)
+	// GetOutbox returns the page of the outbox of the request to respond
+	// with, as the CommonBehavior's GetOutbox. Nil responds with the
+	// outbox in the Database.
+	GetOutbox func(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error)
+	// Clock determines the time. Nil is the system clock.
+	Clock pub.Clock
+	// Client makes the requests to peers. Nil is a pub.ConnectionClient
+	// timing out requests after 30 seconds.
+	Client pub.HttpClient
+	// AppAgent identifies this application in the User-Agent of its
+	// requests. Empty is the Host.
+	AppAgent string
+	// DeliveryQueue keeps the failed deliveries until they are retried.
+	// Nil keeps them in memory.
+	DeliveryQueue pub.DeliveryQueue
+	// Backoff determines when failed deliveries are retried. The zero
+	// value is pub.DefaultBackoff.
+	Backoff pub.Backoff
+	// RetryInterval is how often Run retries the due deliveries. Zero is
+	// DefaultRetryInterval.
+	RetryInterval time.Duration
+	// CacheTTL is how long the dereferenced values without freshness
+	// information, and the keys of peers, are cached. Zero is
+	// DefaultCacheTTL.
+	CacheTTL time.Duration
+	// KeyRotationWindow is how long the replaced keys of the actors are
+	// still published. Zero is DefaultKeyRotationWindow.
+	KeyRotationWindow time.Duration
+	// Blocklist blocks domains and actors from federating with the actors
+	// of this server. Nil blocks no one.
+	Blocklist pub.Blocklist
+	// NodeInfo provides the NodeInfo document of this server. Nil disables
+	// the NodeInfo endpoints.
+	NodeInfo wellknown.NodeInfoFunc
+	// ActorPrefix is the path of the actors before their usernames. Empty
+	// is DefaultActorPrefix.
+	ActorPrefix string
+	// Fallback serves the requests that are not ActivityPub requests,
+	// such as web pages sharing the paths of the actors. Nil responds
+	// with http.StatusNotFound.
+	Fallback http.Handler
+}
+
+// systemClock is the Clock of the system time.
+type systemClock struct{}
+
+// Now returns the system time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Federation is a federating server: the Actor of its actors, and the
+// subsystems it is wired with. It serves their inboxes, outboxes,
+// ActivityStreams representations and well-known endpoints as an
+// http.Handler.
+//
+// It is the CommonBehavior of its Actor, signing the requests of each actor
+// with its keys in the KeyStore, retrying the failed deliveries, and blocking
+// the domains and actors of the Blocklist.
+type Federation struct {
+	// Actor is the Actor of the actors of this server, which delivers
+	// their activities with its Send.
+	Actor pub.FederatingActor
+	// Verifier verifies the HTTP Signatures of the requests of peers, for
+	// the FederatingProtocol's AuthenticatePostInbox.
+	Verifier *pub.SignatureVerifier
+	// Retrier retries the failed deliveries of the Actor.
+	Retrier *pub.DeliveryRetrier
+	// WellKnown serves the WebFinger and NodeInfo endpoints.
+	WellKnown *wellknown.Handler
+	// Handler serves the ActivityStreams representations of the values in
+	// the Database.
+	Handler pub.HandlerFunc
+
+	opts       Options
+	clock      pub.Clock
+	client     pub.HttpClient
+	cacheTTL   time.Duration
+	mu         sync.Mutex
+	transports map[string]pub.Transport
+}
+
+// Federation is the CommonBehavior of its Actor, with retried deliveries and a
+// Blocklist.
+var (
+	_ pub.CommonBehavior  = &Federation{}
+	_ pub.DeliveryRetries = &Federation{}
+	_ pub.Blocklist       = &Federation{}
+)
+
+// New wires a Federation from the Options.
+func New(o Options) (*Federation, error) {
+	if len(o.Host) == 0 {
+		return nil, fmt.Errorf("federation options require a Host")
+	} else if o.Database == nil {
+		return nil, fmt.Errorf("federation options require a Database")
+	} else if o.Protocol == nil {
+		return nil, fmt.Errorf("federation options require a Protocol")
+	} else if o.KeyStore == nil {
+		return nil, fmt.Errorf("federation options require a KeyStore")
+	}
+	f := &Federation{
+		opts:       o,
+		clock:      o.Clock,
+		client:     o.Client,
+		cacheTTL:   o.CacheTTL,
+		transports: make(map[string]pub.Transport),
+	}
+	if f.clock == nil {
+		f.clock = systemClock{}
+	}
+	if f.client == nil {
+		f.client = pub.NewConnectionClient(pub.ConnectionOptions{Timeout: defaultRequestTimeout})
+	}
+	if f.cacheTTL == 0 {
+		f.cacheTTL = DefaultCacheTTL
+	}
+	if len(f.opts.AppAgent) == 0 {
+		f.opts.AppAgent = o.Host
+	}
+	if len(f.opts.ActorPrefix) == 0 {
+		f.opts.ActorPrefix = DefaultActorPrefix
+	}
+	f.opts.ActorPrefix = strings.TrimSuffix(f.opts.ActorPrefix, "/")
+	if f.opts.KeyRotationWindow == 0 {
+		f.opts.KeyRotationWindow = DefaultKeyRotationWindow
+	}
+	if f.opts.RetryInterval == 0 {
+		f.opts.RetryInterval = DefaultRetryInterval
+	}
+	if f.opts.Backoff == (pub.Backoff{}) {
+		f.opts.Backoff = pub.DefaultBackoff
+	}
+	queue := o.DeliveryQueue
+	if queue == nil {
+		queue = pub.NewMemoryDeliveryQueue()
+	}
+	keyFetching, err := f.newKeyFetchingTransport()
+	if err != nil {
+		return nil, err
+	}
+	f.Verifier = pub.NewSignatureVerifier(keyFetching, f.clock, f.cacheTTL)
+	f.Retrier = pub.NewDeliveryRetrier(queue, f.opts.Backoff, f.clock, f)
+	if o.Social != nil {
+		f.Actor = pub.NewActor(f, o.Social, o.Protocol, o.Database, f.clock)
+	} else {
+		f.Actor = pub.NewFederatingActor(f, o.Protocol, o.Database, f.clock)
+	}
+	f.Handler = pub.NewActivityStreamsHandler(func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+		return false, nil
+	}, o.Database, f.clock)
+	f.WellKnown = &wellknown.Handler{
+		Host: o.Host,
+		WebFinger: &webfinger.Handler{
+			Domain:   o.Host,
+			DB:       o.Database,
+			ActorIRI: f.ActorIRI,
+			User:     f.User,
+		},
+		NodeInfo: o.NodeInfo,
+		HostMeta: true,
+	}
+	return f, nil
+}
+
+// newKeyFetchingTransport returns the Transport fetching the keys of peers,
+// signed by the InstanceActor if there is one.
+func (f *Federation) newKeyFetchingTransport() (pub.Transport, error) {
+	if f.opts.InstanceActor == nil {
+		t := pub.NewHttpSigTransport(f.client, f.opts.AppAgent, f.clock, nil, nil, "", nil)
+		t.SetGetSigning(pub.DoNotSignGet)
+		return t, nil
+	}
+	keys := pub.NewKeyStoreProvider(f.opts.KeyStore, f.opts.InstanceActor, f.opts.KeyRotationWindow, f.clock)
+	return pub.NewInstanceActorTransport(f.client, f.opts.AppAgent, f.clock, pub.InstanceActorKeys(keys), nil)
+}
+
+// ActorIRI returns the IRI of the actor of the username.
+func (f *Federation) ActorIRI(username string) *url.URL {
+	return &url.URL{Scheme: "https", Host: f.opts.Host, Path: f.opts.ActorPrefix + "/" + username}
+}
+
+// User returns the username of the actor IRI, if it is the IRI of an actor of
+// this server.
+func (f *Federation) User(actorIRI *url.URL) (string, bool) {
+	if actorIRI.Host != f.opts.Host || !strings.HasPrefix(actorIRI.Path, f.opts.ActorPrefix+"/") {
+		return "", false
+	}
+	user := strings.TrimPrefix(actorIRI.Path, f.opts.ActorPrefix+"/")
+	return user, len(user) > 0 && !strings.Contains(user, "/")
+}
+
+// Routes returns the routes of the actors, for applications registering them
+// with chi, echo or gin instead of serving the Federation. The well-known
+// endpoints are registered with the WellKnown handler.
+func (f *Federation) Routes(collections ...string) *router.Routes {
+	return &router.Routes{
+		Actor:       f.Actor,
+		Handler:     f.Handler,
+		ActorPrefix: f.opts.ActorPrefix,
+		Collections: collections,
+		Fallback:    f.opts.Fallback,
+	}
+}
+
+// ServeHTTP serves the well-known endpoints, the inboxes and outboxes of the
+// actors, and the ActivityStreams representations of the values in the
+// Database. Other requests are served by the Fallback.
+func (f *Federation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, wellknown.Prefix) || r.URL.Path == wellknown.DefaultNodeInfoDocumentPath {
+		f.WellKnown.ServeHTTP(w, r)
+		return
+	}
+	var h pub.HandlerFunc
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/inbox") && r.Method == http.MethodPost:
+		h = f.Actor.PostInbox
+	case strings.HasSuffix(r.URL.Path, "/inbox"):
+		h = f.Actor.GetInbox
+	case strings.HasSuffix(r.URL.Path, "/outbox") && r.Method == http.MethodPost:
+		h = f.Actor.PostOutbox
+	case strings.HasSuffix(r.URL.Path, "/outbox"):
+		h = f.Actor.GetOutbox
+	default:
+		h = f.Handler
+	}
+	handled, err := h(r.Context(), w, r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	} else if !handled {
+		if f.opts.Fallback != nil {
+			f.opts.Fallback.ServeHTTP(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	}
+}
+
+// Run retries the due failed deliveries every RetryInterval, until the context
+// is done.
+func (f *Federation) Run(c context.Context) error {
+	return f.Retrier.Run(c, f.opts.RetryInterval)
+}
+
+// AuthenticateGetInbox authenticates the read of an inbox with the
+// AuthenticateGetInbox of the Options, or refuses it.
+func (f *Federation) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	if f.opts.AuthenticateGetInbox != nil {
+		return f.opts.AuthenticateGetInbox(c, w, r)
+	}
+	w.WriteHeader(http.StatusUnauthorized)
+	return false, nil
+}
+
+// AuthenticateGetOutbox authenticates the read of an outbox with the
+// AuthenticateGetOutbox of the Options, or allows it.
+func (f *Federation) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	if f.opts.AuthenticateGetOutbox != nil {
+		return f.opts.AuthenticateGetOutbox(c, w, r)
+	}
+	return true, nil
+}
+
+// GetOutbox returns the page of the outbox of the request with the GetOutbox of
+// the Options, or from the Database.
+func (f *Federation) GetOutbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	if f.opts.GetOutbox != nil {
+		return f.opts.GetOutbox(c, r)
+	}
+	outboxIRI := &url.URL{Scheme: "https", Host: f.opts.Host, Path: r.URL.Path}
+	if err := f.opts.Database.Lock(c, outboxIRI); err != nil {
+		return nil, err
+	}
+	defer f.opts.Database.Unlock(c, outboxIRI)
+	return f.opts.Database.GetOutbox(c, outboxIRI)
+}
+
+// NewTransport returns the Transport of the actor of the inbox or outbox. The
+// Transports are pooled by actor, caching the values they dereference, and
+// sign with the current key of the actor in the KeyStore.
+func (f *Federation) NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (pub.Transport, error) {
+	actorIRI, err := f.actorForBox(c, actorBoxIRI)
+	if err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if t, ok := f.transports[actorIRI.String()]; ok {
+		return t, nil
+	}
+	kp, err := f.opts.KeyStore.GetKeyPair(c, actorIRI)
+	if err != nil {
+		return nil, err
+	} else if kp.KeyId == nil || kp.PrivateKey == nil {
+		return nil, fmt.Errorf("key pair of %s has no key id or private key", actorIRI)
+	}
+	h, err := pub.NewHttpSigTransportWithSchemes(f.client, f.opts.AppAgent, f.clock, kp.KeyId.String(), kp.PrivateKey, nil, pub.DefaultSignatureSchemes...)
+	if err != nil {
+		return nil, err
+	}
+	h.SetKeyProvider(pub.NewKeyStoreProvider(f.opts.KeyStore, actorIRI, f.opts.KeyRotationWindow, f.clock))
+	t := pub.NewCachingTransport(h, f.clock, f.cacheTTL)
+	f.transports[actorIRI.String()] = t
+	return t, nil
+}
+
+// actorForBox returns the actor of the outbox or inbox.
+func (f *Federation) actorForBox(c context.Context, boxIRI *url.URL) (*url.URL, error) {
+	db := f.opts.Database
+	if err := db.Lock(c, boxIRI); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, boxIRI)
+	if strings.HasSuffix(boxIRI.Path, "/inbox") {
+		return db.ActorForInbox(c, boxIRI)
+	}
+	return db.ActorForOutbox(c, boxIRI)
+}
+
+// DeliveryRetrier returns the Retrier.
+func (f *Federation) DeliveryRetrier(c context.Context) *pub.DeliveryRetrier {
+	return f.Retrier
+}
+
+// IsBlockedDomain determines whether the Blocklist of the Options blocks the
+// domain.
+func (f *Federation) IsBlockedDomain(c context.Context, host string) (bool, error) {
+	if f.opts.Blocklist == nil {
+		return false, nil
+	}
+	return f.opts.Blocklist.IsBlockedDomain(c, host)
+}
+
+// IsBlockedActor determines whether the Blocklist of the Options blocks the
+// actor.
+func (f *Federation) IsBlockedActor(c context.Context, actorIRI *url.URL) (bool, error) {
+	if f.opts.Blocklist == nil {
+		return false, nil
+	}
+	return f.opts.Blocklist.IsBlockedActor(c, actorIRI)
+}
@@ -0,0 +1,178 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/webfinger"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	testActorIRI  = "https://example.com/users/addison"
+	testOutboxIRI = "https://example.com/users/addison/outbox"
+)
+
+func assertEqual(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// actorDatabase is a Database holding one actor.
+type actorDatabase struct {
+	pub.Database
+}
+
+func (actorDatabase) Lock(c context.Context, id *url.URL) error   { return nil }
+func (actorDatabase) Unlock(c context.Context, id *url.URL) error { return nil }
+func (actorDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	return id.String() == testActorIRI, nil
+}
+func (actorDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	return streams.NewActivityStreamsPerson(), nil
+}
+func (actorDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	return mustParse(testActorIRI), nil
+}
+
+// keyStore is a KeyStore holding the key pair of one actor, counting its
+// reads.
+type keyStore struct {
+	pub.KeyStore
+	kp    pub.KeyPair
+	reads int
+}
+
+func (k *keyStore) GetKeyPair(c context.Context, actorIRI *url.URL) (pub.KeyPair, error) {
+	k.reads++
+	return k.kp, nil
+}
+
+// protocol is a FederatingProtocol whose methods are not called.
+type protocol struct {
+	pub.FederatingProtocol
+}
+
+// blockedDomain blocks the domain "blocked.example".
+type blockedDomain struct{}
+
+func (blockedDomain) IsBlockedDomain(c context.Context, host string) (bool, error) {
+	return host == "blocked.example", nil
+}
+
+func (blockedDomain) IsBlockedActor(c context.Context, actorIRI *url.URL) (bool, error) {
+	return false, nil
+}
+
+func newTestFederation(t *testing.T, o Options) (*Federation, *keyStore) {
+	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	kp, err := pub.GenerateKeyPair(mustParse(testActorIRI+"#main-key"), clock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := &keyStore{kp: kp}
+	o.Host = "example.com"
+	o.Database = actorDatabase{}
+	o.Protocol = protocol{}
+	o.KeyStore = ks
+	o.Clock = clock
+	f, err := New(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, ks
+}
+
+func TestNew(t *testing.T) {
+	t.Run("RequiresOptions", func(t *testing.T) {
+		_, err := New(Options{Host: "example.com"})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+	t.Run("MapsUsers", func(t *testing.T) {
+		f, _ := newTestFederation(t, Options{})
+		assertEqual(t, f.ActorIRI("addison").String(), testActorIRI)
+		user, ok := f.User(mustParse(testActorIRI))
+		assertEqual(t, user, "addison")
+		assertEqual(t, ok, true)
+		_, ok = f.User(mustParse(testOutboxIRI))
+		assertEqual(t, ok, false)
+		_, ok = f.User(mustParse("https://other.example.com/users/addison"))
+		assertEqual(t, ok, false)
+	})
+}
+
+func TestFederation(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ServesWebFinger", func(t *testing.T) {
+		f, _ := newTestFederation(t, Options{})
+		w := httptest.NewRecorder()
+		f.ServeHTTP(w, httptest.NewRequest(http.MethodGet, webfinger.Path+"?resource=acct:addison@example.com", nil))
+		assertEqual(t, w.Code, http.StatusOK)
+		var jrd webfinger.JRD
+		if err := json.Unmarshal(w.Body.Bytes(), &jrd); err != nil {
+			t.Fatal(err)
+		}
+		self, _ := jrd.ActorIRI()
+		assertEqual(t, self, testActorIRI)
+	})
+	t.Run("ServesFallback", func(t *testing.T) {
+		f, _ := newTestFederation(t, Options{Fallback: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})})
+		w := httptest.NewRecorder()
+		f.ServeHTTP(w, httptest.NewRequest(http.MethodGet, testActorIRI, nil))
+		assertEqual(t, w.Code, http.StatusTeapot)
+	})
+	t.Run("RefusesInboxReadsByDefault", func(t *testing.T) {
+		f, _ := newTestFederation(t, Options{})
+		w := httptest.NewRecorder()
+		authenticated, err := f.AuthenticateGetInbox(ctx, w, httptest.NewRequest(http.MethodGet, testActorIRI+"/inbox", nil))
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, w.Code, http.StatusUnauthorized)
+	})
+	t.Run("PoolsTransportsByActor", func(t *testing.T) {
+		f, ks := newTestFederation(t, Options{})
+		t1, err := f.NewTransport(ctx, mustParse(testOutboxIRI), "go-fed")
+		assertEqual(t, err, nil)
+		t2, err := f.NewTransport(ctx, mustParse(testOutboxIRI), "go-fed")
+		assertEqual(t, err, nil)
+		assertEqual(t, t1, t2)
+		assertEqual(t, ks.reads, 1)
+		_, ok := t1.(*pub.CachingTransport)
+		assertEqual(t, ok, true)
+	})
+	t.Run("RetriesDeliveries", func(t *testing.T) {
+		f, _ := newTestFederation(t, Options{})
+		assertEqual(t, f.DeliveryRetrier(ctx), f.Retrier)
+	})
+	t.Run("BlocksWithBlocklist", func(t *testing.T) {
+		f, _ := newTestFederation(t, Options{})
+		blocked, err := f.IsBlockedDomain(ctx, "blocked.example")
+		assertEqual(t, err, nil)
+		assertEqual(t, blocked, false)
+		f, _ = newTestFederation(t, Options{Blocklist: blockedDomain{}})
+		blocked, err = f.IsBlockedDomain(ctx, "blocked.example")
+		assertEqual(t, err, nil)
+		assertEqual(t, blocked, true)
+	})
+}
@@ -0,0 +1,62 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// ErrFollowResponseUnsupported is returned when responding to a Follow with an
+// Actor whose delegate cannot respond to it, such as one created by
+// NewCustomActor.
+var ErrFollowResponseUnsupported = errors.New("actor does not support responding to follows")
+
+// FollowResponder is implemented by the Actors created by NewFederatingActor
+// and NewActor, and by DelegateActors able to respond to Follows.
+//
+// It responds to the Follows left pending by the DecideFollow callback of
+// OnFollowDecideByCallback, such as once their actor approves them.
+type FollowResponder interface {
+	// RespondToFollow sends an Accept of the Follow received in the inbox
+	// if accept is true, adding its actors to the followers of the actor
+	// of the inbox, or a Reject of it otherwise. The Follow must be of the
+	// actor of the inbox.
+	RespondToFollow(c context.Context, inboxIRI *url.URL, follow vocab.ActivityStreamsFollow, accept bool) error
+}
+
+// RespondToFollow responds to a pending Follow received in the inbox, if the
+// delegate supports it.
+func (b *baseActor) RespondToFollow(c context.Context, inboxIRI *url.URL, follow vocab.ActivityStreamsFollow, accept bool) error {
+	if !b.enableFederatedProtocol {
+		return ErrFollowResponseUnsupported
+	}
+	r, ok := b.delegate.(FollowResponder)
+	if !ok {
+		return ErrFollowResponseUnsupported
+	}
+	return r.RespondToFollow(c, inboxIRI, follow, accept)
+}
+
+// RespondToFollow sends an Accept or Reject of the Follow of the actor of the
+// inbox, as the wrapped callbacks do when automatically responding to it.
+func (a *sideEffectActor) RespondToFollow(c context.Context, inboxIRI *url.URL, follow vocab.ActivityStreamsFollow, accept bool) error {
+	wrapped, _, err := a.inboxCallbacks(c, inboxIRI)
+	if err != nil {
+		return err
+	}
+	actorIRI, err := wrapped.inboxActor(c)
+	if err != nil {
+		return err
+	}
+	if !hasObject(follow.GetActivityStreamsObject(), actorIRI) {
+		return fmt.Errorf("cannot respond to a follow of another actor than %s", actorIRI)
+	}
+	behavior := OnFollowAutomaticallyReject
+	if accept {
+		behavior = OnFollowAutomaticallyAccept
+	}
+	return wrapped.respondToFollow(c, follow, actorIRI, behavior)
+}
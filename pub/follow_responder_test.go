@@ -0,0 +1,46 @@
+package pub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRespondToFollow(t *testing.T) {
+	ctx := context.Background()
+	newFollow := func(object string) vocab.ActivityStreamsFollow {
+		follow := streams.NewActivityStreamsFollow()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(testFederatedActorIRI))
+		follow.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(object))
+		follow.SetActivityStreamsObject(op)
+		return follow
+	}
+	t.Run("UnsupportedByCustomActors", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := NewCustomActor(NewMockDelegateActor(ctl), false, true, NewMockClock(ctl))
+		r, ok := a.(FollowResponder)
+		assertEqual(t, ok, true)
+		err := r.RespondToFollow(ctx, mustParse(testMyInboxIRI), newFollow(testPersonIRI), true)
+		assertEqual(t, err, ErrFollowResponseUnsupported)
+	})
+	t.Run("ErrorIfFollowOfAnotherActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		fp := NewMockFederatingProtocol(ctl)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testPersonIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+		a := &sideEffectActor{db: db, s2s: fp, common: NewMockCommonBehavior(ctl)}
+		err := a.RespondToFollow(ctx, mustParse(testMyInboxIRI), newFollow(testFederatedActorIRI2), true)
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,281 @@
+package pub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-fed/activity/streams"
+)
+
+const (
+	// CollectionSynchronizationHeader is the header of deliveries carrying
+	// a CollectionSynchronization, as specified by FEP-8fcf.
+	CollectionSynchronizationHeader = "Collection-Synchronization"
+)
+
+// collectionSynchronizationParam matches a parameter of a
+// Collection-Synchronization header value.
+var collectionSynchronizationParam = regexp.MustCompile(`([A-Za-z]+)="([^"]*)"`)
+
+// CollectionSynchronization summarizes the followers of a peer's actor that are
+// on this server, as specified by FEP-8fcf "Followers collection
+// synchronization across servers".
+//
+// A peer sends it in the Collection-Synchronization header of its deliveries
+// so this server may cheaply detect whether both agree on which of its actors
+// follow the peer's actor, and only then fetch the partial followers
+// collection to find out how they differ.
+type CollectionSynchronization struct {
+	// CollectionId is the followers collection of the actor.
+	CollectionId *url.URL
+	// URL is the partial followers collection, holding only the followers
+	// on the receiving server. It must share the origin of CollectionId.
+	URL *url.URL
+	// Digest is the FollowersDigest of the partial followers collection.
+	Digest string
+}
+
+// String returns the Collection-Synchronization header value.
+func (s CollectionSynchronization) String() string {
+	return fmt.Sprintf("collectionId=%q, url=%q, digest=%q", s.CollectionId.String(), s.URL.String(), s.Digest)
+}
+
+// ParseCollectionSynchronization parses a Collection-Synchronization header
+// value.
+//
+// Returns an error if a parameter is missing, or if the URL does not share the
+// origin of the CollectionId. The application must still ensure that the
+// CollectionId is the followers collection of the actor that sent it.
+func ParseCollectionSynchronization(v string) (s CollectionSynchronization, err error) {
+	params := make(map[string]string)
+	for _, m := range collectionSynchronizationParam.FindAllStringSubmatch(v, -1) {
+		params[m[1]] = m[2]
+	}
+	for _, name := range []string{"collectionId", "url", "digest"} {
+		if len(params[name]) == 0 {
+			return s, fmt.Errorf("collection synchronization %q has no %s", v, name)
+		}
+	}
+	if s.CollectionId, err = url.Parse(params["collectionId"]); err != nil {
+		return
+	}
+	if s.URL, err = url.Parse(params["url"]); err != nil {
+		return
+	}
+	if !sameOrigin(s.CollectionId, s.URL) {
+		return s, fmt.Errorf("collection synchronization url %s does not share the origin of %s", s.URL.String(), s.CollectionId.String())
+	}
+	s.Digest = strings.ToLower(params["digest"])
+	return
+}
+
+// FollowersDigest returns the digest of followers, which is the hex encoded
+// exclusive or of the SHA-256 hashes of every follower's IRI. It does not
+// depend on the order of the followers.
+func FollowersDigest(followers []*url.URL) string {
+	var digest [sha256.Size]byte
+	for _, f := range followers {
+		sum := sha256.Sum256([]byte(f.String()))
+		for i := range digest {
+			digest[i] ^= sum[i]
+		}
+	}
+	return hex.EncodeToString(digest[:])
+}
+
+// PartialFollowers returns the followers that share the origin of the peer,
+// which are the ones a partial followers collection for the peer holds.
+func PartialFollowers(followers []*url.URL, peer *url.URL) []*url.URL {
+	var partial []*url.URL
+	for _, f := range followers {
+		if sameOrigin(f, peer) {
+			partial = append(partial, f)
+		}
+	}
+	return partial
+}
+
+// FollowersInSync determines whether this server agrees with the peer that sent
+// the CollectionSynchronization. The localFollowers are the actors on this
+// server that follow the peer's actor.
+func FollowersInSync(s CollectionSynchronization, localFollowers []*url.URL) bool {
+	return FollowersDigest(localFollowers) == s.Digest
+}
+
+// FetchPartialFollowers dereferences the partial followers collection of a
+// CollectionSynchronization, returning the followers the peer lists for this
+// server.
+func FetchPartialFollowers(c context.Context, t Transport, s CollectionSynchronization) ([]*url.URL, error) {
+	if !sameOrigin(s.CollectionId, s.URL) {
+		return nil, fmt.Errorf("collection synchronization url %s does not share the origin of %s", s.URL.String(), s.CollectionId.String())
+	}
+	b, err := t.Dereference(c, s.URL)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	col, err := streams.ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	var followers []*url.URL
+	if v, ok := col.(itemser); ok {
+		if i := v.GetActivityStreamsItems(); i != nil {
+			for iter := i.Begin(); iter != i.End(); iter = iter.Next() {
+				id, err := ToId(iter)
+				if err != nil {
+					return nil, err
+				}
+				followers = append(followers, id)
+			}
+		}
+	} else if v, ok := col.(orderedItemser); ok {
+		if i := v.GetActivityStreamsOrderedItems(); i != nil {
+			for iter := i.Begin(); iter != i.End(); iter = iter.Next() {
+				id, err := ToId(iter)
+				if err != nil {
+					return nil, err
+				}
+				followers = append(followers, id)
+			}
+		}
+	} else {
+		return nil, fmt.Errorf("partial followers collection %s is a %s, not a collection", s.URL.String(), col.GetTypeName())
+	}
+	return followers, nil
+}
+
+// DiffFollowers compares the actors on this server that follow a peer's actor
+// with the followers the peer lists for this server.
+//
+// The onlyLocal followers are unknown to the peer, so their Follow may need to
+// be sent again or removed locally. The onlyRemote followers are unknown to
+// this server, so an Undo of their Follow may need to be sent to the peer.
+func DiffFollowers(local, remote []*url.URL) (onlyLocal, onlyRemote []*url.URL) {
+	localSet := make(map[string]bool, len(local))
+	for _, f := range local {
+		localSet[f.String()] = true
+	}
+	remoteSet := make(map[string]bool, len(remote))
+	for _, f := range remote {
+		remoteSet[f.String()] = true
+		if !localSet[f.String()] {
+			onlyRemote = append(onlyRemote, f)
+		}
+	}
+	for _, f := range local {
+		if !remoteSet[f.String()] {
+			onlyLocal = append(onlyLocal, f)
+		}
+	}
+	return
+}
+
+// FollowersSynchronizationRequestFunc determines which actor's followers a
+// request for a partial followers collection is for, and the actor of the peer
+// that signed the request.
+type FollowersSynchronizationRequestFunc func(c context.Context, r *http.Request) (actorIRI, peerActorIRI *url.URL, err error)
+
+// NewFollowersSynchronizationHandler creates a HandlerFunc serving the partial
+// followers collections referenced by CollectionSynchronization headers. Each
+// holds only the followers of the actor that share the origin of the peer
+// requesting it, as an OrderedCollection.
+//
+// The requests must be authenticated by authFn, so that a peer only learns
+// which of its own actors are followers.
+func NewFollowersSynchronizationHandler(authFn AuthenticateFunc, reqFn FollowersSynchronizationRequestFunc, db Database, clock Clock) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub GET request
+		if !isActivityPubGet(r) {
+			return
+		}
+		isASRequest = true
+		// Authenticate the request
+		var shouldReturn bool
+		if shouldReturn, err = authFn(c, w, r); err != nil {
+			return
+		} else if shouldReturn {
+			return
+		}
+		actorIRI, peerActorIRI, err := reqFn(c, r)
+		if err != nil {
+			return
+		}
+		followers, err := getFollowers(c, db, actorIRI)
+		if err != nil {
+			return
+		}
+		partial := PartialFollowers(followers, peerActorIRI)
+		// Build the partial collection.
+		col := streams.NewActivityStreamsOrderedCollection()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(requestId(r))
+		col.SetActivityStreamsId(id)
+		total := streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(len(partial))
+		col.SetActivityStreamsTotalItems(total)
+		oi := streams.NewActivityStreamsOrderedItemsProperty()
+		for _, f := range partial {
+			oi.AppendIRI(f)
+		}
+		col.SetActivityStreamsOrderedItems(oi)
+		m, err := serialize(col)
+		if err != nil {
+			return
+		}
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return
+		}
+		addResponseHeaders(c, r, w.Header(), clock, raw)
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// getFollowers obtains the IRIs of the actor's followers from the database.
+func getFollowers(c context.Context, db Database, actorIRI *url.URL) (followers []*url.URL, err error) {
+	err = db.Lock(c, actorIRI)
+	if err != nil {
+		return
+	}
+	// WARNING: Unlock not deferred
+	col, err := db.Followers(c, actorIRI)
+	db.Unlock(c, actorIRI)
+	if err != nil {
+		return
+	}
+	if i := col.GetActivityStreamsItems(); i != nil {
+		for iter := i.Begin(); iter != i.End(); iter = iter.Next() {
+			var id *url.URL
+			id, err = ToId(iter)
+			if err != nil {
+				return
+			}
+			followers = append(followers, id)
+		}
+	}
+	return
+}
+
+// sameOrigin determines whether two IRIs share a scheme and host.
+func sameOrigin(a, b *url.URL) bool {
+	return strings.EqualFold(a.Scheme, b.Scheme) && strings.EqualFold(a.Host, b.Host)
+}
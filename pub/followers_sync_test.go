@@ -0,0 +1,127 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+func TestFollowersDigest(t *testing.T) {
+	a := mustParse(testFederatedActorIRI)
+	b := mustParse(testFederatedActorIRI2)
+	t.Run("IsIndependentOfOrder", func(t *testing.T) {
+		assertEqual(t, FollowersDigest([]*url.URL{a, b}), FollowersDigest([]*url.URL{b, a}))
+	})
+	t.Run("IsZeroWhenEmpty", func(t *testing.T) {
+		assertEqual(t, FollowersDigest(nil), strings.Repeat("0", 64))
+	})
+	t.Run("CancelsOutDuplicates", func(t *testing.T) {
+		assertEqual(t, FollowersDigest([]*url.URL{a, b, b}), FollowersDigest([]*url.URL{a}))
+	})
+}
+
+func TestCollectionSynchronization(t *testing.T) {
+	s := CollectionSynchronization{
+		CollectionId: mustParse("https://other.example.com/users/sam/followers"),
+		URL:          mustParse("https://other.example.com/users/sam/followers_sync"),
+		Digest:       FollowersDigest([]*url.URL{mustParse(testPersonIRI)}),
+	}
+	t.Run("RoundTrips", func(t *testing.T) {
+		actual, err := ParseCollectionSynchronization(s.String())
+		assertEqual(t, err, nil)
+		assertEqual(t, actual.CollectionId.String(), s.CollectionId.String())
+		assertEqual(t, actual.URL.String(), s.URL.String())
+		assertEqual(t, actual.Digest, s.Digest)
+	})
+	t.Run("RejectsOtherOrigin", func(t *testing.T) {
+		_, err := ParseCollectionSynchronization(`collectionId="https://other.example.com/followers", url="https://evil.example.com/sync", digest="00"`)
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("RejectsMissingDigest", func(t *testing.T) {
+		_, err := ParseCollectionSynchronization(`collectionId="https://other.example.com/followers", url="https://other.example.com/sync"`)
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("FollowersInSync", func(t *testing.T) {
+		assertEqual(t, FollowersInSync(s, []*url.URL{mustParse(testPersonIRI)}), true)
+		assertEqual(t, FollowersInSync(s, nil), false)
+	})
+}
+
+func TestDiffFollowers(t *testing.T) {
+	local := []*url.URL{mustParse("https://example.com/a"), mustParse("https://example.com/b")}
+	remote := []*url.URL{mustParse("https://example.com/b"), mustParse("https://example.com/c")}
+	onlyLocal, onlyRemote := DiffFollowers(local, remote)
+	assertEqual(t, fmt.Sprint(onlyLocal), fmt.Sprint([]*url.URL{mustParse("https://example.com/a")}))
+	assertEqual(t, fmt.Sprint(onlyRemote), fmt.Sprint([]*url.URL{mustParse("https://example.com/c")}))
+}
+
+func TestFetchPartialFollowers(t *testing.T) {
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	s := CollectionSynchronization{
+		CollectionId: mustParse("https://other.example.com/users/sam/followers"),
+		URL:          mustParse("https://other.example.com/users/sam/followers_sync"),
+	}
+	col := streams.NewActivityStreamsOrderedCollection()
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	oi.AppendIRI(mustParse(testPersonIRI))
+	col.SetActivityStreamsOrderedItems(oi)
+	tp := NewMockTransport(ctl)
+	tp.EXPECT().Dereference(ctx, s.URL).Return(mustSerializeToBytes(col), nil)
+	followers, err := FetchPartialFollowers(ctx, tp, s)
+	assertEqual(t, err, nil)
+	assertEqual(t, fmt.Sprint(followers), fmt.Sprint([]*url.URL{mustParse(testPersonIRI)}))
+}
+
+func TestFollowersSynchronizationHandler(t *testing.T) {
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	actorIRI := mustParse(testPersonIRI)
+	peerActorIRI := mustParse(testFederatedActorIRI)
+	followers := streams.NewActivityStreamsCollection()
+	items := streams.NewActivityStreamsItemsProperty()
+	items.AppendIRI(mustParse(testFederatedActorIRI2))
+	items.AppendIRI(mustParse("https://third.example.com/ann"))
+	followers.SetActivityStreamsItems(items)
+	db := NewMockDatabase(ctl)
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	gomock.InOrder(
+		db.EXPECT().Lock(ctx, actorIRI),
+		db.EXPECT().Followers(ctx, actorIRI).Return(followers, nil),
+		db.EXPECT().Unlock(ctx, actorIRI),
+	)
+	authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+		return false, nil
+	}
+	reqFn := func(c context.Context, r *http.Request) (*url.URL, *url.URL, error) {
+		return actorIRI, peerActorIRI, nil
+	}
+	h := NewFollowersSynchronizationHandler(authFn, reqFn, db, clock)
+	req := toAPRequest(httptest.NewRequest(http.MethodGet, testPersonIRI+"/followers_sync", nil))
+	resp := httptest.NewRecorder()
+	isASRequest, err := h(ctx, resp, req)
+	assertEqual(t, err, nil)
+	assertEqual(t, isASRequest, true)
+	assertEqual(t, resp.Code, http.StatusOK)
+	var m map[string]interface{}
+	err = json.Unmarshal(resp.Body.Bytes(), &m)
+	assertEqual(t, err, nil)
+	assertEqual(t, fmt.Sprint(m["orderedItems"]), testFederatedActorIRI2)
+	assertEqual(t, fmt.Sprint(m["totalItems"]), "1")
+}
@@ -0,0 +1,132 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// GoneActorPurgeFunc removes what is kept of a remote actor found to be gone,
+// according to the application's policy, such as its pending activities,
+// follows, and posts.
+type GoneActorPurgeFunc func(c context.Context, actorIRI *url.URL) error
+
+// GoneActors remembers the remote actors whose IRIs, or the IRIs of their keys,
+// respond with 410 Gone, such as after a "self-destructing" Delete of a
+// suspended or deleted account. It is safe for concurrent use.
+//
+// An HttpSigTransport given GoneActors with SetGoneActors records gone actors
+// and stops fetching them. A FederatingProtocol implementing
+// GoneActorsProvider has the activities from gone actors ignored.
+//
+// Key IRIs with a fragment, such as "https://example.com/users/a#main-key",
+// are recorded as their actor's IRI without the fragment.
+type GoneActors struct {
+	mu    sync.RWMutex
+	clock Clock
+	purge GoneActorPurgeFunc
+	gone  map[string]time.Time
+}
+
+// NewGoneActors creates an empty GoneActors. The purge function, if not nil,
+// is called once for each actor found to be gone.
+func NewGoneActors(clock Clock, purge GoneActorPurgeFunc) *GoneActors {
+	return &GoneActors{
+		clock: clock,
+		purge: purge,
+		gone:  make(map[string]time.Time),
+	}
+}
+
+// goneActorKey returns the IRI of the actor, without the fragment of a key IRI.
+func goneActorKey(iri *url.URL) string {
+	u := *iri
+	u.Fragment = ""
+	return u.String()
+}
+
+// IsGone determines whether the actor, or the actor of the key, is gone.
+func (g *GoneActors) IsGone(iri *url.URL) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	_, ok := g.gone[goneActorKey(iri)]
+	return ok
+}
+
+// GoneSince returns when the actor, or the actor of the key, was found to be
+// gone.
+func (g *GoneActors) GoneSince(iri *url.URL) (time.Time, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	t, ok := g.gone[goneActorKey(iri)]
+	return t, ok
+}
+
+// Observe records the actor, or the actor of the key, as gone if the error is
+// that of a fetch responded to with 410 Gone. It returns the error of purging a
+// newly gone actor.
+//
+// Applications call it with the errors of fetching keys to verify HTTP
+// Signatures with transports other than an HttpSigTransport.
+func (g *GoneActors) Observe(c context.Context, iri *url.URL, err error) error {
+	if !IsGoneError(err) {
+		return nil
+	}
+	return g.observe(c, iri)
+}
+
+// observe records the actor, or the actor of the key, as gone, purging it if it
+// was not already.
+func (g *GoneActors) observe(c context.Context, iri *url.URL) error {
+	k := goneActorKey(iri)
+	g.mu.Lock()
+	_, ok := g.gone[k]
+	if !ok {
+		g.gone[k] = g.clock.Now()
+	}
+	g.mu.Unlock()
+	if ok || g.purge == nil {
+		return nil
+	}
+	actorIRI, err := url.Parse(k)
+	if err != nil {
+		return err
+	}
+	return g.purge(c, actorIRI)
+}
+
+// Forget removes the actor from the gone actors, such as if its server
+// restores the account.
+func (g *GoneActors) Forget(iri *url.URL) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.gone, goneActorKey(iri))
+}
+
+// GoneActorsProvider may be implemented by a FederatingProtocol to ignore the
+// activities received from actors that are gone.
+type GoneActorsProvider interface {
+	// GoneActors returns the gone actors for the context.
+	GoneActors(c context.Context) *GoneActors
+}
+
+// isFromGoneActor determines whether any actor of the activity is gone,
+// according to the FederatingProtocol.
+func (a *sideEffectActor) isFromGoneActor(c context.Context, activity Activity) bool {
+	p, ok := a.s2s.(GoneActorsProvider)
+	if !ok {
+		return false
+	}
+	g := p.GoneActors(c)
+	actors := activity.GetActivityStreamsActor()
+	if g == nil || actors == nil {
+		return false
+	}
+	for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+		if id, err := ToId(iter); err == nil && g.IsGone(id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+// goneActorsProtocol is a FederatingProtocol providing GoneActors.
+type goneActorsProtocol struct {
+	*MockFederatingProtocol
+	gone *GoneActors
+}
+
+func (g *goneActorsProtocol) GoneActors(c context.Context) *GoneActors {
+	return g.gone
+}
+
+func TestGoneActors(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("TransportStopsFetchingGoneActors", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		requests := 0
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			return newResponse(http.StatusGone), nil
+		})
+		var purged []string
+		g := NewGoneActors(clock, func(c context.Context, actorIRI *url.URL) error {
+			purged = append(purged, actorIRI.String())
+			return nil
+		})
+		tp := NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+		tp.SetGoneActors(g)
+		for i := 0; i < 2; i++ {
+			_, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI+"#main-key"))
+			assertEqual(t, IsGoneError(err), true)
+		}
+		assertEqual(t, requests, 1)
+		assertEqual(t, len(purged), 1)
+		assertEqual(t, purged[0], testFederatedActorIRI)
+		assertEqual(t, g.IsGone(mustParse(testFederatedActorIRI)), true)
+	})
+	t.Run("ObservesOnlyGoneErrors", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		g := NewGoneActors(clock, nil)
+		iri := mustParse(testFederatedActorIRI)
+		err := g.Observe(ctx, iri, &StatusError{Method: http.MethodGet, URL: iri, StatusCode: http.StatusNotFound})
+		assertEqual(t, err, nil)
+		assertEqual(t, g.IsGone(iri), false)
+		err = g.Observe(ctx, iri, &StatusError{Method: http.MethodGet, URL: iri, StatusCode: http.StatusGone})
+		assertEqual(t, err, nil)
+		assertEqual(t, g.IsGone(iri), true)
+		g.Forget(iri)
+		assertEqual(t, g.IsGone(iri), false)
+	})
+	t.Run("IgnoresActivitiesFromGoneActors", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		g := NewGoneActors(clock, nil)
+		g.observe(ctx, mustParse(testFederatedActorIRI))
+		// No database calls are expected.
+		a := &sideEffectActor{
+			s2s:   &goneActorsProtocol{MockFederatingProtocol: NewMockFederatingProtocol(ctl), gone: g},
+			db:    NewMockDatabase(ctl),
+			clock: clock,
+		}
+		err := a.PostInbox(ctx, mustParse(testMyInboxIRI), testListen)
+		assertEqual(t, err, nil)
+	})
+}
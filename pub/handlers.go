@@ -2,7 +2,6 @@ package pub
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"net/http"
@@ -42,9 +41,20 @@ type AuthenticateFunc func(c context.Context, w http.ResponseWriter, r *http.Req
 // an ActivityStreams representation of data.
 //
 // Strips retrieved ActivityStreams values of sensitive fields ('bto' and 'bcc')
-// before responding with them. Sets the appropriate HTTP status code for
-// Tombstone Activities as well.
+// before responding with them, and of their 'source' unless the context
+// identifies their author with WithSourceReader. Sets the appropriate HTTP status code for
+// Tombstone Activities as well. Followers and following collections are
+// restricted if the Database implements CollectionPrivacyDatabase, aliases
+// redirected if it implements AliasDatabase, and values withheld from the
+// requesters it does not authorize if it implements ObjectAuthorizer.
 func NewActivityStreamsHandler(authFn AuthenticateFunc, db Database, clock Clock) HandlerFunc {
+	return NewCachingActivityStreamsHandler(authFn, db, clock, nil)
+}
+
+// NewCachingActivityStreamsHandler is like NewActivityStreamsHandler, but
+// memoizes the responses it serves in the SerializationCache, so a value is
+// serialized once per revision no matter how often it is requested.
+func NewCachingActivityStreamsHandler(authFn AuthenticateFunc, db Database, clock Clock, cache SerializationCache) HandlerFunc {
 	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
 		// Do nothing if it is not an ActivityPub GET request
 		if !isActivityPubGet(r) {
@@ -59,6 +69,15 @@ func NewActivityStreamsHandler(authFn AuthenticateFunc, db Database, clock Clock
 			return
 		}
 		id := requestId(r)
+		// Redirect aliases to the canonical id.
+		canonical, isAlias, err := canonicalId(c, db, id)
+		if err != nil {
+			return
+		} else if isAlias {
+			w.Header().Set(locationHeader, canonical.String())
+			w.WriteHeader(http.StatusMovedPermanently)
+			return
+		}
 		// Lock and obtain a copy of the requested ActivityStreams value
 		err = db.Lock(c, id)
 		if err != nil {
@@ -74,19 +93,45 @@ func NewActivityStreamsHandler(authFn AuthenticateFunc, db Database, clock Clock
 		// Unlock must have been called by this point and in every
 		// branch above
 		//
-		// Remove sensitive fields.
-		clearSensitiveFields(t)
-		// Serialize the fetched value.
-		m, err := serialize(t)
+		// Respond as the ObjectAuthorizer decides for the requester.
+		access, err := authorizeGetObject(c, db, t)
 		if err != nil {
 			return
+		} else if access == ForbidObject {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		} else if access == HideObject {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// Restrict private collections to what the requester may see.
+		// The restricted views are not cached, as they depend on the
+		// requester.
+		privacy, owner, err := collectionPrivacy(c, db, id)
+		if err != nil {
+			return
+		} else if privacy != PublicCollection {
+			cache = nil
+			if t, err = restrictCollection(c, t, privacy, owner); err != nil {
+				return
+			} else if t == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		}
+		// Remove sensitive fields.
+		clearSensitiveFields(t)
+		// Serialize the fetched value. Values keeping their source for
+		// their author are not cached, so it is not served to others.
+		if clearSources(c, t) {
+			cache = nil
 		}
-		raw, err := json.Marshal(m)
+		raw, err := marshal(c, cache, t, true)
 		if err != nil {
 			return
 		}
 		// Construct the response.
-		addResponseHeaders(w.Header(), clock, raw)
+		addResponseHeaders(c, r, w.Header(), clock, raw)
 		// Write the response.
 		if streams.IsOrExtendsActivityStreamsTombstone(t) {
 			w.WriteHeader(http.StatusGone)
@@ -0,0 +1,118 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// HandshakeEmbedding determines how much of a Follow is embedded in the Accept
+// or Reject automatically sent in response to it.
+//
+// Peers disagree on it: some only match the id of the Follow, others require it
+// embedded in full, and some reject a Follow embedded with properties they do
+// not expect.
+type HandshakeEmbedding int
+
+const (
+	// EmbedFullFollow embeds the Follow as it was received.
+	EmbedFullFollow HandshakeEmbedding = iota
+	// EmbedMinimalFollow embeds a Follow with only the 'id', 'actor' and
+	// 'object' of the one received.
+	EmbedMinimalFollow
+	// EmbedFollowId references the Follow by its IRI.
+	EmbedFollowId
+)
+
+// HandshakeEmbeddingPolicy may be implemented by a FederatingProtocol to
+// determine, per peer, how much of a Follow is embedded in the Accept or Reject
+// sent in response. It takes precedence over the OnFollowEmbedding of the
+// FederatingWrappedCallbacks.
+type HandshakeEmbeddingPolicy interface {
+	// HandshakeEmbedding returns how the Follow by the peer actor is
+	// embedded in the response to it.
+	HandshakeEmbedding(c context.Context, follow vocab.ActivityStreamsFollow, peer *url.URL) HandshakeEmbedding
+}
+
+// peerHandshakeEmbedding is a HandshakeEmbeddingPolicy choosing the
+// HandshakeEmbedding by the software of the peer host.
+type peerHandshakeEmbedding struct {
+	prober     *PeerProber
+	bySoftware map[string]HandshakeEmbedding
+	fallback   HandshakeEmbedding
+}
+
+// NewPeerHandshakeEmbedding returns a HandshakeEmbeddingPolicy detecting the
+// software of the peer hosts with the PeerProber, and choosing the
+// HandshakeEmbedding for it in bySoftware, keyed by lowercase software name such
+// as "mastodon". Peers running other software, or whose software is unknown,
+// get the fallback.
+func NewPeerHandshakeEmbedding(p *PeerProber, bySoftware map[string]HandshakeEmbedding, fallback HandshakeEmbedding) HandshakeEmbeddingPolicy {
+	return &peerHandshakeEmbedding{
+		prober:     p,
+		bySoftware: bySoftware,
+		fallback:   fallback,
+	}
+}
+
+// HandshakeEmbedding returns the HandshakeEmbedding for the software of the
+// peer host.
+func (p *peerHandshakeEmbedding) HandshakeEmbedding(c context.Context, follow vocab.ActivityStreamsFollow, peer *url.URL) HandshakeEmbedding {
+	info, err := p.prober.Probe(c, peer.Host)
+	if err != nil {
+		return p.fallback
+	}
+	for name, e := range p.bySoftware {
+		if info.IsSoftware(name) {
+			return e
+		}
+	}
+	return p.fallback
+}
+
+// handshakeObjectProperty creates the 'object' property of the response to the
+// Follow, embedding it as determined by the HandshakeEmbedding. A Follow without
+// an id is always embedded in full, since the peer could not match it
+// otherwise.
+func handshakeObjectProperty(follow vocab.ActivityStreamsFollow, e HandshakeEmbedding) (vocab.ActivityStreamsObjectProperty, error) {
+	op := streams.NewActivityStreamsObjectProperty()
+	id := follow.GetActivityStreamsId()
+	if id == nil || !id.IsIRI() {
+		e = EmbedFullFollow
+	}
+	switch e {
+	case EmbedFollowId:
+		op.AppendIRI(id.Get())
+	case EmbedMinimalFollow:
+		minimal := streams.NewActivityStreamsFollow()
+		minimal.SetActivityStreamsId(newIdProperty(id.Get()))
+		actors := streams.NewActivityStreamsActorProperty()
+		if ap := follow.GetActivityStreamsActor(); ap != nil {
+			for iter := ap.Begin(); iter != ap.End(); iter = iter.Next() {
+				iri, err := ToId(iter)
+				if err != nil {
+					return nil, err
+				}
+				actors.AppendIRI(iri)
+			}
+		}
+		minimal.SetActivityStreamsActor(actors)
+		objects := streams.NewActivityStreamsObjectProperty()
+		if fop := follow.GetActivityStreamsObject(); fop != nil {
+			for iter := fop.Begin(); iter != fop.End(); iter = iter.Next() {
+				iri, err := ToId(iter)
+				if err != nil {
+					return nil, err
+				}
+				objects.AppendIRI(iri)
+			}
+		}
+		minimal.SetActivityStreamsObject(objects)
+		op.AppendActivityStreamsFollow(minimal)
+	default:
+		op.AppendActivityStreamsFollow(follow)
+	}
+	return op, nil
+}
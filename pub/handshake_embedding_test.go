@@ -0,0 +1,104 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHandshakeObjectProperty(t *testing.T) {
+	const followId = "https://example.com/follow/1"
+	newFollow := func(id string) vocab.ActivityStreamsFollow {
+		f := streams.NewActivityStreamsFollow()
+		if len(id) > 0 {
+			f.SetActivityStreamsId(newIdProperty(mustParse(id)))
+		}
+		actors := streams.NewActivityStreamsActorProperty()
+		actors.AppendIRI(mustParse(testFederatedActorIRI))
+		f.SetActivityStreamsActor(actors)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActorIRI2))
+		f.SetActivityStreamsObject(op)
+		summary := streams.NewActivityStreamsSummaryProperty()
+		summary.AppendXMLSchemaString("Please accept")
+		f.SetActivityStreamsSummary(summary)
+		return f
+	}
+	// serialized returns the 'object' of an Accept with the property.
+	serialized := func(t *testing.T, op vocab.ActivityStreamsObjectProperty) interface{} {
+		accept := streams.NewActivityStreamsAccept()
+		accept.SetActivityStreamsObject(op)
+		return mustSerialize(accept)[objectProperty]
+	}
+	t.Run("EmbedsFullFollow", func(t *testing.T) {
+		op, err := handshakeObjectProperty(newFollow(followId), EmbedFullFollow)
+		assertEqual(t, err, nil)
+		m := serialized(t, op).(map[string]interface{})
+		assertEqual(t, m["summary"], "Please accept")
+	})
+	t.Run("EmbedsMinimalFollow", func(t *testing.T) {
+		op, err := handshakeObjectProperty(newFollow(followId), EmbedMinimalFollow)
+		assertEqual(t, err, nil)
+		m := serialized(t, op).(map[string]interface{})
+		assertEqual(t, m[idProperty], followId)
+		assertEqual(t, m["type"], "Follow")
+		assertEqual(t, m["actor"], testFederatedActorIRI)
+		assertEqual(t, m[objectProperty], testFederatedActorIRI2)
+		_, hasSummary := m["summary"]
+		assertEqual(t, hasSummary, false)
+	})
+	t.Run("ReferencesFollowId", func(t *testing.T) {
+		op, err := handshakeObjectProperty(newFollow(followId), EmbedFollowId)
+		assertEqual(t, err, nil)
+		assertEqual(t, serialized(t, op), followId)
+	})
+	t.Run("EmbedsFollowWithoutId", func(t *testing.T) {
+		op, err := handshakeObjectProperty(newFollow(""), EmbedFollowId)
+		assertEqual(t, err, nil)
+		m := serialized(t, op).(map[string]interface{})
+		assertEqual(t, m["summary"], "Please accept")
+	})
+}
+
+func TestPeerHandshakeEmbedding(t *testing.T) {
+	ctx := context.Background()
+	follow := streams.NewActivityStreamsFollow()
+	wellKnown := []byte(`{"links":[{"rel":"http://nodeinfo.diaspora.software/ns/schema/2.1","href":"https://other.example.com/nodeinfo/2.1"}]}`)
+	probe := func(ctl *gomock.Controller, software string) *PeerProber {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)).AnyTimes()
+		tp := NewMockTransport(ctl)
+		if len(software) == 0 {
+			tp.EXPECT().Fetch(ctx, mustParse("https://other.example.com/.well-known/nodeinfo"), nodeinfoAcceptHeaderValue).Return(nil, "", fmt.Errorf("not found"))
+		} else {
+			doc := []byte(`{"software":{"name":"` + software + `","version":"1.0"}}`)
+			tp.EXPECT().Fetch(ctx, mustParse("https://other.example.com/.well-known/nodeinfo"), nodeinfoAcceptHeaderValue).Return(wellKnown, "application/json", nil)
+			tp.EXPECT().Fetch(ctx, mustParse("https://other.example.com/nodeinfo/2.1"), nodeinfoAcceptHeaderValue).Return(doc, "application/json", nil)
+		}
+		return NewPeerProber(tp, clock, time.Hour)
+	}
+	bySoftware := map[string]HandshakeEmbedding{"mastodon": EmbedMinimalFollow}
+	t.Run("ChoosesBySoftware", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p := NewPeerHandshakeEmbedding(probe(ctl, "Mastodon"), bySoftware, EmbedFollowId)
+		assertEqual(t, p.HandshakeEmbedding(ctx, follow, mustParse(testFederatedActorIRI)), EmbedMinimalFollow)
+	})
+	t.Run("FallsBackForOtherSoftware", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p := NewPeerHandshakeEmbedding(probe(ctl, "pleroma"), bySoftware, EmbedFollowId)
+		assertEqual(t, p.HandshakeEmbedding(ctx, follow, mustParse(testFederatedActorIRI)), EmbedFollowId)
+	})
+	t.Run("FallsBackForUnknownSoftware", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p := NewPeerHandshakeEmbedding(probe(ctl, ""), bySoftware, EmbedFollowId)
+		assertEqual(t, p.HandshakeEmbedding(ctx, follow, mustParse(testFederatedActorIRI)), EmbedFollowId)
+	})
+}
@@ -0,0 +1,336 @@
+package pub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// requestTarget is the pseudo-header of an HTTP Signature covering the
+	// method and the path of a request.
+	requestTarget = "(request-target)"
+	// createdTarget is the pseudo-header of an HTTP Signature covering its
+	// creation time, as its created parameter.
+	createdTarget = "(created)"
+)
+
+// signatureParams are the parameters of an HTTP Signature.
+type signatureParams struct {
+	keyId     string
+	algorithm string
+	// headers are the lowercased names of the covered headers.
+	headers   []string
+	signature []byte
+	// created is the created parameter, the Unix time the signature was
+	// made at, or empty if there is none.
+	created string
+}
+
+// parseSignature parses the HTTP Signature of a request from its Signature
+// header, or else its Authorization header.
+func parseSignature(h http.Header) (p signatureParams, err error) {
+	s := h.Get(signatureHeader)
+	if len(s) == 0 {
+		a := h.Get("Authorization")
+		if !strings.HasPrefix(a, "Signature ") {
+			return p, fmt.Errorf("request has no HTTP Signature")
+		}
+		s = strings.TrimPrefix(a, "Signature ")
+	}
+	for len(s) > 0 {
+		s = strings.TrimLeft(s, " ,")
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			break
+		}
+		k := strings.TrimSpace(s[:eq])
+		s = s[eq+1:]
+		var v string
+		if strings.HasPrefix(s, `"`) {
+			end := strings.IndexByte(s[1:], '"')
+			if end < 0 {
+				return p, fmt.Errorf("malformed HTTP Signature: unterminated %s", k)
+			}
+			v, s = s[1:end+1], s[end+2:]
+		} else if comma := strings.IndexByte(s, ','); comma >= 0 {
+			v, s = s[:comma], s[comma:]
+		} else {
+			v, s = s, ""
+		}
+		switch k {
+		case "keyId":
+			p.keyId = v
+		case "algorithm":
+			p.algorithm = strings.ToLower(v)
+		case "headers":
+			p.headers = strings.Fields(strings.ToLower(v))
+		case "created":
+			p.created = v
+		case "signature":
+			if p.signature, err = base64.StdEncoding.DecodeString(v); err != nil {
+				return p, fmt.Errorf("malformed HTTP Signature: %s", err)
+			}
+		}
+	}
+	if len(p.keyId) == 0 {
+		return p, fmt.Errorf("HTTP Signature has no keyId")
+	} else if len(p.signature) == 0 {
+		return p, fmt.Errorf("HTTP Signature has no signature")
+	} else if len(p.headers) == 0 {
+		// The default of the draft specification.
+		p.headers = []string{"date"}
+	}
+	return p, nil
+}
+
+// RequestTargetForm is a way of covering the (request-target) in the signing
+// string of an HTTP Signature. Peers disagree on it, so signatures are verified
+// with each form that applies to the request.
+type RequestTargetForm int
+
+const (
+	// RequestTargetWithQuery is the lowercased method, a space, and the
+	// path and query of the request, as the draft specification and most
+	// peers construct it. Requests are signed with it.
+	RequestTargetWithQuery RequestTargetForm = iota
+	// RequestTargetWithoutQuery is the lowercased method, a space, and the
+	// path of the request, as some peers construct it.
+	RequestTargetWithoutQuery
+)
+
+// String returns the name of the form.
+func (f RequestTargetForm) String() string {
+	switch f {
+	case RequestTargetWithQuery:
+		return "withQuery"
+	case RequestTargetWithoutQuery:
+		return "withoutQuery"
+	}
+	return "unknown"
+}
+
+// requestTargetForms returns the forms of the (request-target) to verify the
+// signature of the request covering the headers with, most common first.
+func requestTargetForms(r *http.Request, headers []string) []RequestTargetForm {
+	forms := []RequestTargetForm{RequestTargetWithQuery}
+	for _, name := range headers {
+		if strings.ToLower(name) == requestTarget && r.URL != nil && len(r.URL.RawQuery) > 0 {
+			forms = append(forms, RequestTargetWithoutQuery)
+			break
+		}
+	}
+	return forms
+}
+
+// signingString returns the string signed by an HTTP Signature covering the
+// headers of the request, with the (request-target) in its most common form.
+//
+// Signers of the httpsig package omit the (request-target) instead, so their
+// signatures covering it do not verify with peers.
+func signingString(r *http.Request, headers []string) (string, error) {
+	return signingStringForm(r, headers, "", RequestTargetWithQuery)
+}
+
+// signingStringForm returns the string signed by an HTTP Signature covering
+// the headers of the request, with the (request-target) in the form.
+//
+// The derived components of RFC 9421, such as "@request-target" and "@method",
+// may be covered as well, for peers naming them instead of the
+// (request-target). Their lines are the quoted name of the component and its
+// value. The (created) is the created parameter of the signature.
+func signingStringForm(r *http.Request, headers []string, created string, form RequestTargetForm) (string, error) {
+	lines := make([]string, len(headers))
+	for i, name := range headers {
+		name = strings.ToLower(name)
+		if name == createdTarget {
+			if len(created) == 0 {
+				return "", fmt.Errorf("signature covering %s has no created parameter", createdTarget)
+			}
+			lines[i] = createdTarget + ": " + created
+			continue
+		} else if name == requestTarget || strings.HasPrefix(name, "@") {
+			line, err := targetLine(r, name, form)
+			if err != nil {
+				return "", err
+			}
+			lines[i] = line
+			continue
+		}
+		vs, ok := r.Header[textproto.CanonicalMIMEHeaderKey(name)]
+		if !ok && name == "host" && len(r.Host) > 0 {
+			vs, ok = []string{r.Host}, true
+		}
+		if !ok {
+			return "", fmt.Errorf("signed header %q is missing", name)
+		}
+		trimmed := make([]string, len(vs))
+		for j, v := range vs {
+			trimmed[j] = strings.TrimSpace(v)
+		}
+		lines[i] = name + ": " + strings.Join(trimmed, ", ")
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// targetLine returns the line of the signing string of the (request-target) in
+// the form, or of a derived component of RFC 9421.
+func targetLine(r *http.Request, name string, form RequestTargetForm) (string, error) {
+	if r.URL == nil {
+		return "", fmt.Errorf("cannot sign the %s of a response", name)
+	}
+	var v string
+	switch name {
+	case requestTarget:
+		target := r.URL.RequestURI()
+		if form == RequestTargetWithoutQuery {
+			target = r.URL.EscapedPath()
+		}
+		return fmt.Sprintf("%s: %s %s", requestTarget, strings.ToLower(r.Method), target), nil
+	case "@request-target":
+		v = r.URL.RequestURI()
+	case "@method":
+		v = strings.ToUpper(r.Method)
+	case "@path":
+		v = r.URL.EscapedPath()
+	case "@query":
+		v = "?" + r.URL.RawQuery
+	case "@authority":
+		v = strings.ToLower(r.Host)
+		if len(v) == 0 {
+			v = strings.ToLower(r.URL.Host)
+		}
+	default:
+		return "", fmt.Errorf("unsupported signed component %q", name)
+	}
+	return fmt.Sprintf("%q: %s", name, v), nil
+}
+
+// SignatureMismatchError is returned when an HTTP Signature does not verify
+// with the public key of its keyId, listing the signing strings it was
+// verified against, so the disagreement with the peer can be found.
+type SignatureMismatchError struct {
+	// KeyId is the keyId of the signature.
+	KeyId string
+	// SigningStrings are the strings the signature was verified against,
+	// one for each RequestTargetForm tried.
+	SigningStrings []string
+}
+
+// Error describes the mismatch.
+func (e *SignatureMismatchError) Error() string {
+	return fmt.Sprintf("HTTP Signature of %s does not verify with the signing strings %q", e.KeyId, e.SigningStrings)
+}
+
+// verifySignature verifies the HTTP Signature of the request with the public
+// key. The rsa-sha256 and hs2019 algorithms are supported with RSA keys.
+//
+// The signature verifies if it does with any of the forms of the
+// (request-target) of the request.
+func verifySignature(r *http.Request, p signatureParams, key crypto.PublicKey) error {
+	switch p.algorithm {
+	case "", SignatureAlgorithmRSASHA256, SignatureAlgorithmHS2019:
+	default:
+		return fmt.Errorf("unsupported HTTP Signature algorithm %q", p.algorithm)
+	}
+	pub, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+	mismatch := &SignatureMismatchError{KeyId: p.keyId}
+	for _, form := range requestTargetForms(r, p.headers) {
+		s, err := signingStringForm(r, p.headers, p.created, form)
+		if err != nil {
+			return err
+		}
+		h := sha256.Sum256([]byte(s))
+		if rsa.VerifyPKCS1v15(pub, crypto.SHA256, h[:], p.signature) == nil {
+			return nil
+		}
+		mismatch.SigningStrings = append(mismatch.SigningStrings, s)
+	}
+	return mismatch
+}
+
+// rsaSHA256Signer is an httpsig.Signer making rsa-sha256 signatures that peers
+// verify, named after the algorithm.
+type rsaSHA256Signer struct {
+	algorithm string
+	headers   []string
+}
+
+// NewRSASHA256Signer creates an httpsig.Signer making rsa-sha256 HTTP
+// Signatures covering the headers, for NewHttpSigTransport. Unlike the Signers
+// of the httpsig package, its signatures covering the (request-target) verify
+// with peers such as Mastodon, Pleroma, and GoToSocial. The headers may also
+// name derived components of RFC 9421, such as "@request-target" and
+// "@method", for peers covering them instead.
+//
+// The signing keys must be *rsa.PrivateKey.
+func NewRSASHA256Signer(headers ...string) httpsig.Signer {
+	return &rsaSHA256Signer{algorithm: SignatureAlgorithmRSASHA256, headers: headers}
+}
+
+// NewHS2019Signer is like NewRSASHA256Signer, but names the algorithm of its
+// signatures hs2019, which peers such as Mastodon verify as rsa-sha256 with RSA
+// keys, and which some peers require.
+func NewHS2019Signer(headers ...string) httpsig.Signer {
+	return &rsaSHA256Signer{algorithm: SignatureAlgorithmHS2019, headers: headers}
+}
+
+// SignRequest adds the Signature header to the request.
+func (s *rsaSHA256Signer) SignRequest(pKey crypto.PrivateKey, pubKeyId string, r *http.Request) error {
+	str, err := signingString(r, s.headers)
+	if err != nil {
+		return err
+	}
+	sig, err := s.sign(pKey, str)
+	if err != nil {
+		return err
+	}
+	r.Header.Set(signatureHeader, s.header(pubKeyId, sig))
+	return nil
+}
+
+// SignResponse adds the Signature header to the response. The headers must not
+// include the (request-target).
+func (s *rsaSHA256Signer) SignResponse(pKey crypto.PrivateKey, pubKeyId string, w http.ResponseWriter) error {
+	str, err := signingString(&http.Request{Header: w.Header()}, s.headers)
+	if err != nil {
+		return err
+	}
+	sig, err := s.sign(pKey, str)
+	if err != nil {
+		return err
+	}
+	w.Header().Set(signatureHeader, s.header(pubKeyId, sig))
+	return nil
+}
+
+// sign signs the signing string with the key.
+func (s *rsaSHA256Signer) sign(pKey crypto.PrivateKey, str string) ([]byte, error) {
+	k, ok := pKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s signing key must be *rsa.PrivateKey: %T", s.algorithm, pKey)
+	}
+	h := sha256.Sum256([]byte(str))
+	return rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, h[:])
+}
+
+// header returns the Signature header value of the signature.
+func (s *rsaSHA256Signer) header(pubKeyId string, sig []byte) string {
+	headers := make([]string, len(s.headers))
+	for i, h := range s.headers {
+		headers[i] = strings.ToLower(h)
+	}
+	return fmt.Sprintf(`keyId="%s",algorithm="%s",headers="%s",signature="%s"`,
+		pubKeyId, s.algorithm, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig))
+}
@@ -0,0 +1,103 @@
+package pub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseSignature(t *testing.T) {
+	t.Run("ParsesQuotedParameters", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("Signature", `keyId="https://example.com/key,1",algorithm="RSA-SHA256",headers="(request-target) Host date",signature="AQID"`)
+		p, err := parseSignature(h)
+		assertEqual(t, err, nil)
+		assertEqual(t, p.keyId, "https://example.com/key,1")
+		assertEqual(t, p.algorithm, "rsa-sha256")
+		assertEqual(t, len(p.headers), 3)
+		assertEqual(t, p.headers[1], "host")
+		assertEqual(t, string(p.signature), "\x01\x02\x03")
+	})
+	t.Run("ParsesAuthorization", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("Authorization", `Signature keyId="https://example.com/key",signature="AQID"`)
+		p, err := parseSignature(h)
+		assertEqual(t, err, nil)
+		assertEqual(t, p.keyId, "https://example.com/key")
+		assertEqual(t, len(p.headers), 1)
+		assertEqual(t, p.headers[0], "date")
+	})
+	t.Run("RequiresSignature", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("Signature", `keyId="https://example.com/key"`)
+		_, err := parseSignature(h)
+		assertNotEqual(t, err, nil)
+		_, err = parseSignature(http.Header{})
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestVerifySignature(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/addison/inbox?page=1", nil)
+		req.Header.Set("Date", "Tue, 07 Jun 2022 20:51:35 GMT")
+		return req
+	}
+	sign := func(req *http.Request, s string, headers ...string) signatureParams {
+		h := sha256.Sum256([]byte(s))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, privKey, crypto.SHA256, h[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signatureParams{keyId: testPersonIRI + "#main-key", headers: headers, signature: sig}
+	}
+	t.Run("VerifiesRequestTargetWithQuery", func(t *testing.T) {
+		req := newRequest()
+		p := sign(req, "(request-target): post /addison/inbox?page=1\ndate: Tue, 07 Jun 2022 20:51:35 GMT", "(request-target)", "date")
+		assertEqual(t, verifySignature(req, p, privKey.Public()), nil)
+	})
+	t.Run("VerifiesRequestTargetWithoutQuery", func(t *testing.T) {
+		req := newRequest()
+		p := sign(req, "(request-target): post /addison/inbox\ndate: Tue, 07 Jun 2022 20:51:35 GMT", "(request-target)", "date")
+		assertEqual(t, verifySignature(req, p, privKey.Public()), nil)
+	})
+	t.Run("VerifiesDerivedComponents", func(t *testing.T) {
+		req := newRequest()
+		p := sign(req, "\"@method\": POST\n\"@request-target\": /addison/inbox?page=1\n\"@authority\": example.com\ndate: Tue, 07 Jun 2022 20:51:35 GMT", "@method", "@request-target", "@authority", "date")
+		assertEqual(t, verifySignature(req, p, privKey.Public()), nil)
+	})
+	t.Run("SignsDerivedComponents", func(t *testing.T) {
+		req := newRequest()
+		err := NewHS2019Signer("@method", "@path", "@query", "date").SignRequest(privKey, testPersonIRI+"#main-key", req)
+		assertEqual(t, err, nil)
+		p, err := parseSignature(req.Header)
+		assertEqual(t, err, nil)
+		assertEqual(t, verifySignature(req, p, privKey.Public()), nil)
+	})
+	t.Run("ReportsSigningStringsTried", func(t *testing.T) {
+		req := newRequest()
+		p := sign(req, "(request-target): get /addison/inbox", "(request-target)", "date")
+		err := verifySignature(req, p, privKey.Public())
+		mismatch, ok := err.(*SignatureMismatchError)
+		assertEqual(t, ok, true)
+		assertEqual(t, mismatch.KeyId, testPersonIRI+"#main-key")
+		assertEqual(t, len(mismatch.SigningStrings), 2)
+		assertEqual(t, mismatch.SigningStrings[1], "(request-target): post /addison/inbox\ndate: Tue, 07 Jun 2022 20:51:35 GMT")
+	})
+	t.Run("RejectsUnknownComponents", func(t *testing.T) {
+		req := newRequest()
+		p := sign(req, "", "@unknown")
+		err := verifySignature(req, p, privKey.Public())
+		assertNotEqual(t, err, nil)
+		_, ok := err.(*SignatureMismatchError)
+		assertEqual(t, ok, false)
+	})
+}
@@ -0,0 +1,66 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+)
+
+// InboxForwardingOptions bound the search the inbox forwarding algorithm makes
+// for values owned by this server in the 'inReplyTo', 'object', 'target' and
+// 'tag' chains of an activity, and let applications veto forwarding.
+type InboxForwardingOptions struct {
+	// MaxDepth is how deep to search within the activity. Zero uses the
+	// MaxInboxForwardingRecursionDepth of the FederatingProtocol, and a
+	// negative number indicates infinite recursion.
+	MaxDepth int
+	// MaxDereferences is the most IRIs dereferenced during the search,
+	// after which the IRIs not yet dereferenced are only checked for
+	// ownership. Zero or negative numbers indicate no limit.
+	MaxDereferences int
+	// Veto determines whether the activity received in the inbox must not
+	// be forwarded, such as when its author is muted. It is called once
+	// the activity is known to be new, before the search. Nil vetoes
+	// nothing.
+	Veto func(c context.Context, inboxIRI *url.URL, activity Activity) (bool, error)
+}
+
+// InboxForwardingOptionsProvider may be implemented by a FederatingProtocol to
+// configure the inbox forwarding of the activities it receives.
+type InboxForwardingOptionsProvider interface {
+	// InboxForwardingOptions returns the options for the context.
+	InboxForwardingOptions(c context.Context) InboxForwardingOptions
+}
+
+// inboxForwardingOptions returns the options of the FederatingProtocol, if it
+// provides them.
+func (a *sideEffectActor) inboxForwardingOptions(c context.Context) InboxForwardingOptions {
+	if p, ok := a.s2s.(InboxForwardingOptionsProvider); ok {
+		return p.InboxForwardingOptions(c)
+	}
+	return InboxForwardingOptions{}
+}
+
+// dereferenceBudget counts the dereferences left during a search. A negative
+// budget has no limit.
+type dereferenceBudget int
+
+// newDereferenceBudget returns the budget of the maximum, where zero or
+// negative numbers indicate no limit.
+func newDereferenceBudget(max int) *dereferenceBudget {
+	b := dereferenceBudget(max)
+	if max <= 0 {
+		b = -1
+	}
+	return &b
+}
+
+// spend uses one dereference of the budget, returning false if none is left.
+func (b *dereferenceBudget) spend() bool {
+	if *b < 0 {
+		return true
+	} else if *b == 0 {
+		return false
+	}
+	*b--
+	return true
+}
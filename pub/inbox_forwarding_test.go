@@ -0,0 +1,99 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+// forwardingOptionsProtocol is a FederatingProtocol with
+// InboxForwardingOptions.
+type forwardingOptionsProtocol struct {
+	*MockFederatingProtocol
+	opts InboxForwardingOptions
+}
+
+func (f *forwardingOptionsProtocol) InboxForwardingOptions(c context.Context) InboxForwardingOptions {
+	return f.opts
+}
+
+func TestInboxForwardingOptions(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller, opts InboxForwardingOptions) (*MockCommonBehavior, *MockDatabase, *sideEffectActor) {
+		setupData()
+		c := NewMockCommonBehavior(ctl)
+		db := NewMockDatabase(ctl)
+		a := &sideEffectActor{
+			common: c,
+			s2s:    &forwardingOptionsProtocol{NewMockFederatingProtocol(ctl), opts},
+			db:     db,
+		}
+		return c, db, a
+	}
+	t.Run("VetoesForwarding", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var vetoed []*url.URL
+		_, db, a := setupFn(ctl, InboxForwardingOptions{
+			Veto: func(c context.Context, inboxIRI *url.URL, activity Activity) (bool, error) {
+				vetoed = append(vetoed, activity.GetActivityStreamsId().Get())
+				return true, nil
+			},
+		})
+		input := mustAddAudienceIds(testListen)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().Create(ctx, input).Return(nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+		)
+		err := a.InboxForwarding(ctx, mustParse(testMyInboxIRI), input)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(vetoed), 1)
+		assertEqual(t, vetoed[0].String(), testFederatedActivityIRI)
+	})
+	t.Run("LimitsDereferences", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cm, db, a := setupFn(ctl, InboxForwardingOptions{MaxDepth: 3, MaxDereferences: 1})
+		input := mustAddTagIds(
+			mustAddAudienceIds(testListen))
+		tp := NewMockTransport(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().Create(ctx, input).Return(nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
+			// hasInboxForwardingValues
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI2)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			// Only the first IRI is dereferenced.
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil),
+			tp.EXPECT().Dereference(ctx, mustParse(testTagIRI)).Return(mustSerializeToBytes(newObjectWithId(testTagIRI)), nil),
+			// Deferred
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+		)
+		err := a.InboxForwarding(ctx, mustParse(testMyInboxIRI), input)
+		assertEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,194 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// inboxStreamKeepAlive is how often an idle Server-Sent Events stream
+	// is sent a comment, so proxies do not close it.
+	inboxStreamKeepAlive = 30 * time.Second
+	// sseContentType is the media type of Server-Sent Events.
+	sseContentType = "text/event-stream"
+)
+
+// InboxActivity is an activity accepted into an inbox, as received by an
+// InboxSubscriber.
+type InboxActivity struct {
+	// Inbox is the IRI of the inbox the activity was accepted into.
+	Inbox *url.URL
+	// Activity is the activity, which must not be modified, as every
+	// subscriber receives the same value.
+	Activity Activity
+	// Time is when the activity was accepted.
+	Time time.Time
+}
+
+// InboxStream publishes the activities accepted into inboxes, once their side
+// effects succeeded, to the InboxSubscribers of the inboxes, so applications
+// update timelines in real time instead of polling the Database. Its Handler
+// streams them to clients as Server-Sent Events.
+//
+// It is fed by embedding it in a FederatingProtocol, whose InboxAccepted it
+// then implements, or by calling InboxAccepted from the application's own, such
+// as with InboxAcceptedHooks along with other hooks. It is safe for concurrent
+// use.
+type InboxStream struct {
+	clock  Clock
+	buffer int
+	mu     sync.RWMutex
+	subs   map[*InboxSubscriber]struct{}
+}
+
+// InboxAcceptedHook must be implemented by InboxStream.
+var _ InboxAcceptedHook = &InboxStream{}
+
+// NewInboxStream creates an InboxStream whose subscribers each buffer up to
+// the number of activities they have not received yet.
+func NewInboxStream(clock Clock, buffer int) *InboxStream {
+	return &InboxStream{
+		clock:  clock,
+		buffer: buffer,
+		subs:   make(map[*InboxSubscriber]struct{}),
+	}
+}
+
+// InboxSubscriber receives the activities accepted into an inbox, or into
+// every inbox, from an InboxStream until it is closed.
+//
+// Publishing never waits on a subscriber: the activities accepted while its
+// buffer is full are dropped, and counted by Dropped.
+type InboxSubscriber struct {
+	// C receives the activities, and is closed by Close.
+	C       <-chan InboxActivity
+	c       chan InboxActivity
+	inbox   string
+	s       *InboxStream
+	dropped int64
+	once    sync.Once
+}
+
+// Subscribe registers a subscriber to the activities accepted into the inbox,
+// or into every inbox if the IRI is nil. It must be closed once done.
+func (s *InboxStream) Subscribe(inboxIRI *url.URL) *InboxSubscriber {
+	c := make(chan InboxActivity, s.buffer)
+	sub := &InboxSubscriber{C: c, c: c, s: s}
+	if inboxIRI != nil {
+		sub.inbox = inboxIRI.String()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs[sub] = struct{}{}
+	return sub
+}
+
+// Close unregisters the subscriber and closes its channel.
+func (sub *InboxSubscriber) Close() {
+	sub.once.Do(func() {
+		sub.s.mu.Lock()
+		defer sub.s.mu.Unlock()
+		delete(sub.s.subs, sub)
+		close(sub.c)
+	})
+}
+
+// Dropped returns the number of activities dropped because the buffer of the
+// subscriber was full.
+func (sub *InboxSubscriber) Dropped() int64 {
+	return atomic.LoadInt64(&sub.dropped)
+}
+
+// InboxAccepted publishes the activity accepted into the inbox to its
+// subscribers.
+func (s *InboxStream) InboxAccepted(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	a := InboxActivity{Inbox: inboxIRI, Activity: activity, Time: s.clock.Now()}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for sub := range s.subs {
+		if len(sub.inbox) > 0 && sub.inbox != inboxIRI.String() {
+			continue
+		}
+		select {
+		case sub.c <- a:
+		default:
+			atomic.AddInt64(&sub.dropped, 1)
+		}
+	}
+	return nil
+}
+
+// InboxStreamAuthFunc authenticates a request to stream an inbox, returning the
+// IRI of the inbox the requester owns. As with the AuthenticateGetInbox of a
+// CommonBehavior, it writes the response itself when authentication fails, and
+// must not write one when returning an error.
+type InboxStreamAuthFunc func(c context.Context, w http.ResponseWriter, r *http.Request) (inboxIRI *url.URL, authenticated bool, err error)
+
+// Handler returns an http.Handler streaming the activities accepted into the
+// inbox of the requester as Server-Sent Events, until the client disconnects.
+// Each event is of type "activity", with the id of the activity as its id and
+// the serialized activity as its data:
+//
+//	event: activity
+//	id: https://other.example.com/activities/1
+//	data: {"@context":"https://www.w3.org/ns/activitystreams",...}
+//
+// The requests the authFn fails with an error are responded to with
+// http.StatusInternalServerError.
+func (s *InboxStream) Handler(authFn InboxStreamAuthFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := r.Context()
+		inboxIRI, authenticated, err := authFn(c, w, r)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		} else if !authenticated {
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		sub := s.Subscribe(inboxIRI)
+		defer sub.Close()
+		w.Header().Set(contentTypeHeader, sseContentType)
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+		for {
+			select {
+			case <-c.Done():
+				return
+			case <-after(s.clock, inboxStreamKeepAlive):
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			case a := <-sub.C:
+				if err := writeInboxEvent(c, w, a); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+// writeInboxEvent writes the activity as a Server-Sent Event.
+func writeInboxEvent(c context.Context, w http.ResponseWriter, a InboxActivity) error {
+	b, err := marshal(c, nil, a.Activity, false)
+	if err != nil {
+		return err
+	}
+	id := ""
+	if iri, err := GetId(a.Activity); err == nil {
+		id = iri.String()
+	}
+	_, err = fmt.Fprintf(w, "event: activity\nid: %s\ndata: %s\n\n", id, b)
+	return err
+}
@@ -0,0 +1,89 @@
+package pub
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestInboxStream(t *testing.T) {
+	ctx := context.Background()
+	const otherInboxIRI = "https://example.com/sam/inbox"
+	t.Run("PublishesToSubscribersOfInbox", func(t *testing.T) {
+		s := NewInboxStream(NewFakeClock(now()), 1)
+		mine := s.Subscribe(mustParse(testMyInboxIRI))
+		defer mine.Close()
+		other := s.Subscribe(mustParse(otherInboxIRI))
+		defer other.Close()
+		all := s.Subscribe(nil)
+		defer all.Close()
+		assertEqual(t, s.InboxAccepted(ctx, mustParse(testMyInboxIRI), newActivityWithId(testFederatedActivityIRI)), nil)
+		a := <-mine.C
+		assertEqual(t, a.Inbox.String(), testMyInboxIRI)
+		assertEqual(t, a.Time.Equal(now()), true)
+		a = <-all.C
+		assertEqual(t, a.Inbox.String(), testMyInboxIRI)
+		assertEqual(t, len(other.C), 0)
+	})
+	t.Run("DropsWhenBufferIsFull", func(t *testing.T) {
+		s := NewInboxStream(NewFakeClock(now()), 1)
+		sub := s.Subscribe(nil)
+		defer sub.Close()
+		assertEqual(t, s.InboxAccepted(ctx, mustParse(testMyInboxIRI), newActivityWithId(testFederatedActivityIRI)), nil)
+		assertEqual(t, s.InboxAccepted(ctx, mustParse(testMyInboxIRI), newActivityWithId(testFederatedActivityIRI2)), nil)
+		assertEqual(t, sub.Dropped(), int64(1))
+		id, err := GetId((<-sub.C).Activity)
+		assertEqual(t, err, nil)
+		assertEqual(t, id.String(), testFederatedActivityIRI)
+	})
+	t.Run("CloseUnsubscribes", func(t *testing.T) {
+		s := NewInboxStream(NewFakeClock(now()), 1)
+		sub := s.Subscribe(nil)
+		sub.Close()
+		sub.Close()
+		assertEqual(t, s.InboxAccepted(ctx, mustParse(testMyInboxIRI), newActivityWithId(testFederatedActivityIRI)), nil)
+		_, open := <-sub.C
+		assertEqual(t, open, false)
+	})
+	t.Run("StreamsServerSentEvents", func(t *testing.T) {
+		s := NewInboxStream(NewFakeClock(now()), 1)
+		server := httptest.NewServer(s.Handler(func(c context.Context, w http.ResponseWriter, r *http.Request) (*url.URL, bool, error) {
+			return mustParse(testMyInboxIRI), true, nil
+		}))
+		defer server.Close()
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		assertEqual(t, resp.Header.Get("Content-Type"), "text/event-stream")
+		assertEqual(t, s.InboxAccepted(ctx, mustParse(testMyInboxIRI), newActivityWithId(testFederatedActivityIRI)), nil)
+		br := bufio.NewReader(resp.Body)
+		var lines []string
+		for len(lines) < 3 {
+			line, err := br.ReadString('\n')
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines = append(lines, strings.TrimSuffix(line, "\n"))
+		}
+		assertEqual(t, lines[0], "event: activity")
+		assertEqual(t, lines[1], "id: "+testFederatedActivityIRI)
+		assertEqual(t, strings.HasPrefix(lines[2], "data: {"), true)
+	})
+	t.Run("HandlerRefusesUnauthenticated", func(t *testing.T) {
+		s := NewInboxStream(NewFakeClock(now()), 1)
+		h := s.Handler(func(c context.Context, w http.ResponseWriter, r *http.Request) (*url.URL, bool, error) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return nil, false, nil
+		})
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, testMyInboxIRI, nil))
+		assertEqual(t, w.Code, http.StatusUnauthorized)
+		assertEqual(t, len(s.subs), 0)
+	})
+}
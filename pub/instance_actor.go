@@ -0,0 +1,75 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net/url"
+)
+
+// AnonymousKeyResolver may be implemented by a CommonBehavior to resolve the key
+// signing the dereferences made on behalf of no actor of this server, such as
+// fetching the keys of peers to verify their signatures, or probing their
+// NodeInfo. Peers requiring authorized fetch reject them unsigned.
+//
+// The key is usually that of an instance actor representing the server itself,
+// which peers dereference to verify the signatures like any other actor.
+// NewInstanceActorTransport signs with it.
+type AnonymousKeyResolver interface {
+	// AnonymousDereferenceKey returns the key pair signing the dereference
+	// of the IRI, which must have a KeyId and a PrivateKey.
+	AnonymousDereferenceKey(c context.Context, iri *url.URL) (KeyPair, error)
+}
+
+// instanceActorKeys is an AnonymousKeyResolver of the current key of a
+// KeyProvider.
+type instanceActorKeys struct {
+	p KeyProvider
+}
+
+// InstanceActorKeys returns an AnonymousKeyResolver signing every anonymous
+// dereference with the current key of the instance actor's KeyProvider, such
+// as a NewKeyStoreProvider, so its keys may be rotated.
+func InstanceActorKeys(p KeyProvider) AnonymousKeyResolver {
+	return instanceActorKeys{p: p}
+}
+
+// AnonymousDereferenceKey returns the current key of the instance actor.
+func (k instanceActorKeys) AnonymousDereferenceKey(c context.Context, iri *url.URL) (KeyPair, error) {
+	return k.p.CurrentKey(c)
+}
+
+// NewInstanceActorTransport returns an HttpSigTransport making requests on
+// behalf of this server instead of one of its actors, signed with the keys
+// resolved by the AnonymousKeyResolver for each IRI. It signs with the
+// DefaultSignatureSchemes, and signs every GET request, since it is meant for
+// peers requiring authorized fetch.
+//
+// It is the Transport to give to a SignatureVerifier or a PeerProber, which
+// dereference without the context of an actor. It should not deliver
+// activities, which peers expect signed by their actors.
+func NewInstanceActorTransport(
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	keys AnonymousKeyResolver,
+	store SignerPreferenceStore) (*HttpSigTransport, error) {
+	h, err := NewHttpSigTransportWithSchemes(client, appAgent, clock, "", nil, store, DefaultSignatureSchemes...)
+	if err != nil {
+		return nil, err
+	}
+	h.keys.anonymous = keys
+	return h, nil
+}
+
+// anonymousKey returns the id and the private key signing the dereference of
+// the IRI on behalf of this server.
+func anonymousKey(c context.Context, r AnonymousKeyResolver, iri *url.URL) (string, crypto.PrivateKey, error) {
+	kp, err := r.AnonymousDereferenceKey(c, iri)
+	if err != nil {
+		return "", nil, err
+	} else if kp.KeyId == nil || kp.PrivateKey == nil {
+		return "", nil, fmt.Errorf("anonymous key pair for %s has no key id or private key", iri)
+	}
+	return kp.KeyId.String(), kp.PrivateKey, nil
+}
@@ -0,0 +1,73 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// anonymousKeyFunc is an AnonymousKeyResolver of a function.
+type anonymousKeyFunc func(c context.Context, iri *url.URL) (KeyPair, error)
+
+func (f anonymousKeyFunc) AnonymousDereferenceKey(c context.Context, iri *url.URL) (KeyPair, error) {
+	return f(c, iri)
+}
+
+func TestInstanceActorTransport(t *testing.T) {
+	ctx := context.Background()
+	instanceIRI := mustParse("https://example.com/actor")
+	t.Run("SignsDereferencesWithInstanceKey", func(t *testing.T) {
+		ks := newMemoryKeyStore()
+		clock := NewFakeClock(now())
+		_, err := RotateKeyPair(ctx, ks, instanceIRI, mustParse("https://example.com/actor#main-key"), clock)
+		assertEqual(t, err, nil)
+		var keyId string
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			p, err := parseSignature(req.Header)
+			assertEqual(t, err, nil)
+			keyId = p.keyId
+			return newResponse(http.StatusOK), nil
+		})
+		p := NewKeyStoreProvider(ks, instanceIRI, 0, clock)
+		tp, err := NewInstanceActorTransport(client, "test", clock, InstanceActorKeys(p), nil)
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, keyId, "https://example.com/actor#main-key")
+	})
+	t.Run("ResolvesKeyPerIRI", func(t *testing.T) {
+		ks := newMemoryKeyStore()
+		clock := NewFakeClock(now())
+		kp, err := RotateKeyPair(ctx, ks, instanceIRI, mustParse("https://example.com/actor#main-key"), clock)
+		assertEqual(t, err, nil)
+		var resolved []string
+		keys := anonymousKeyFunc(func(c context.Context, iri *url.URL) (KeyPair, error) {
+			resolved = append(resolved, iri.String())
+			return kp, nil
+		})
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			return newResponse(http.StatusOK), nil
+		})
+		tp, err := NewInstanceActorTransport(client, "test", clock, keys, nil)
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(resolved), 1)
+		assertEqual(t, resolved[0], testFederatedActorIRI)
+	})
+	t.Run("FailsWithoutKey", func(t *testing.T) {
+		keys := anonymousKeyFunc(func(c context.Context, iri *url.URL) (KeyPair, error) {
+			return KeyPair{}, fmt.Errorf("no instance actor")
+		})
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			t.Fatal("unsigned request sent")
+			return nil, nil
+		})
+		tp, err := NewInstanceActorTransport(client, "test", NewFakeClock(now()), keys, nil)
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,439 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// interactionPolicyProperty is the property holding who may interact
+	// with an object, as GoToSocial uses it. It is not part of the
+	// generated vocabulary.
+	interactionPolicyProperty = "interactionPolicy"
+	// canReplyProperty is both the rule of an interaction policy for
+	// replies, and the property listing who may reply to an object of
+	// FEP-5624.
+	canReplyProperty = "canReply"
+	// Rules of an interaction policy.
+	canLikeProperty     = "canLike"
+	canAnnounceProperty = "canAnnounce"
+	// Properties of a rule.
+	alwaysProperty           = "always"
+	approvalRequiredProperty = "approvalRequired"
+)
+
+// InteractionRule lists who may interact with an object in one way, by the ids
+// of actors, of collections of actors such as the followers of the author, or
+// of the Public collection.
+//
+// A rule listing no one permits only the authors of the object.
+type InteractionRule struct {
+	// Always may interact without approval.
+	Always []*url.URL
+	// ApprovalRequired may interact once the author approves it.
+	ApprovalRequired []*url.URL
+}
+
+// InteractionPolicy lists who may reply to, like and announce an object. A nil
+// rule is unset, and permits everyone.
+//
+// It is read from and written to the 'interactionPolicy' property, and the
+// CanReply rule also from and to the 'canReply' property of FEP-5624, so peers
+// supporting either know whom the author lets reply.
+type InteractionPolicy struct {
+	CanReply    *InteractionRule
+	CanLike     *InteractionRule
+	CanAnnounce *InteractionRule
+}
+
+// GetInteractionPolicy reads the interaction policy of an object, and returns
+// whether it has one. Without an 'interactionPolicy', the 'canReply' property
+// is read as the ids that may always reply.
+func GetInteractionPolicy(o vocab.Type) (InteractionPolicy, bool) {
+	u, ok := o.(unknownPropertieser)
+	if !ok {
+		return InteractionPolicy{}, false
+	}
+	props := u.GetUnknownProperties()
+	if m, ok := props[interactionPolicyProperty].(map[string]interface{}); ok {
+		return InteractionPolicy{
+			CanReply:    interactionRule(m[canReplyProperty]),
+			CanLike:     interactionRule(m[canLikeProperty]),
+			CanAnnounce: interactionRule(m[canAnnounceProperty]),
+		}, true
+	}
+	if v, ok := props[canReplyProperty]; ok {
+		return InteractionPolicy{
+			CanReply: &InteractionRule{Always: iriValues(v)},
+		}, true
+	}
+	return InteractionPolicy{}, false
+}
+
+// SetInteractionPolicy sets the 'interactionPolicy' and 'canReply' properties
+// of an object, omitting the rules that are unset. It does nothing if the
+// object cannot have properties outside of the generated vocabularies.
+func SetInteractionPolicy(o vocab.Type, p InteractionPolicy) {
+	u, ok := o.(unknownPropertieser)
+	if !ok {
+		return
+	}
+	m := make(map[string]interface{}, 3)
+	for name, r := range map[string]*InteractionRule{
+		canReplyProperty:    p.CanReply,
+		canLikeProperty:     p.CanLike,
+		canAnnounceProperty: p.CanAnnounce,
+	} {
+		if r != nil {
+			m[name] = interactionRuleValue(*r)
+		}
+	}
+	u.GetUnknownProperties()[interactionPolicyProperty] = m
+	if p.CanReply != nil {
+		u.GetUnknownProperties()[canReplyProperty] = stringValues(p.CanReply.Always)
+	} else {
+		delete(u.GetUnknownProperties(), canReplyProperty)
+	}
+}
+
+// interactionRule reads a rule of an 'interactionPolicy', or returns nil if it
+// is unset.
+func interactionRule(v interface{}) *InteractionRule {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return &InteractionRule{
+		Always:           iriValues(m[alwaysProperty]),
+		ApprovalRequired: iriValues(m[approvalRequiredProperty]),
+	}
+}
+
+// interactionRuleValue returns the value of a rule of an 'interactionPolicy'.
+func interactionRuleValue(r InteractionRule) map[string]interface{} {
+	m := map[string]interface{}{alwaysProperty: stringValues(r.Always)}
+	if len(r.ApprovalRequired) > 0 {
+		m[approvalRequiredProperty] = stringValues(r.ApprovalRequired)
+	}
+	return m
+}
+
+// iriValues reads the absolute IRIs of a property holding one or several of
+// them. Other values are skipped.
+func iriValues(v interface{}) []*url.URL {
+	var values []interface{}
+	switch v := v.(type) {
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	case []string:
+		for _, s := range v {
+			values = append(values, s)
+		}
+	}
+	var iris []*url.URL
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if iri, err := url.Parse(s); err == nil && iri.IsAbs() {
+			iris = append(iris, iri)
+		}
+	}
+	return iris
+}
+
+// stringValues returns the IRIs as the values of a property.
+func stringValues(iris []*url.URL) []interface{} {
+	values := make([]interface{}, len(iris))
+	for i, iri := range iris {
+		values[i] = iri.String()
+	}
+	return values
+}
+
+// InteractionPolicyProvider may be implemented by a SocialProtocol to set the
+// interaction policies of the objects clients Create, such as from the
+// settings of their authors, when the clients did not set one.
+type InteractionPolicyProvider interface {
+	// InteractionPolicy returns the interaction policy of the object
+	// created in the outbox, and whether it should have one.
+	InteractionPolicy(c context.Context, outboxIRI *url.URL, obj vocab.Type) (p InteractionPolicy, ok bool, err error)
+}
+
+// applyInteractionPolicies sets the interaction policies of the objects of a
+// Create activity which have none.
+func applyInteractionPolicies(c context.Context, p InteractionPolicyProvider, outboxIRI *url.URL, activity Activity) error {
+	if !streams.IsOrExtendsActivityStreamsCreate(activity) {
+		return nil
+	}
+	o, ok := activity.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return nil
+	}
+	op := o.GetActivityStreamsObject()
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil {
+			continue
+		} else if _, has := GetInteractionPolicy(t); has {
+			continue
+		}
+		policy, ok, err := p.InteractionPolicy(c, outboxIRI, t)
+		if err != nil {
+			return err
+		} else if ok {
+			SetInteractionPolicy(t, policy)
+		}
+	}
+	return nil
+}
+
+// InteractionDecision is whether an inbound interaction with an object of this
+// server is permitted by its interaction policy.
+type InteractionDecision int
+
+const (
+	// InteractionPermitted is an interaction the policy always permits.
+	InteractionPermitted InteractionDecision = iota
+	// InteractionPendingApproval is an interaction the policy permits once
+	// the author of the object approves it. The activity is accepted, and
+	// the application should not show the interaction until then.
+	InteractionPendingApproval
+	// InteractionRejected is an interaction the policy does not permit.
+	// The activity is refused with 403 Forbidden.
+	InteractionRejected
+)
+
+// String returns the name of the decision.
+func (d InteractionDecision) String() string {
+	switch d {
+	case InteractionPermitted:
+		return "permitted"
+	case InteractionPendingApproval:
+		return "pendingApproval"
+	case InteractionRejected:
+		return "rejected"
+	}
+	return "unknown"
+}
+
+// InteractionPolicyHook may be implemented by a FederatingProtocol to enforce
+// the interaction policies of the objects of this server on the activities
+// posted to the inbox: replies are Creates of objects 'inReplyTo' them, and
+// Likes and Announces have them as 'object'.
+//
+// Interactions with objects without a policy are not decided. Their authors
+// may always interact with them.
+//
+// BrokerConnector implements it by publishing the decisions.
+type InteractionPolicyHook interface {
+	// InteractionDecided is called with the decision on the interaction of
+	// the activity with the object, before its side effects.
+	InteractionDecided(c context.Context, inboxIRI *url.URL, activity Activity, object *url.URL, d InteractionDecision) error
+}
+
+// enforceInteractionPolicies decides the interactions of the activity posted
+// to the inbox with the objects of this server, if the FederatingProtocol is an
+// InteractionPolicyHook, and returns whether any is rejected.
+func (a *sideEffectActor) enforceInteractionPolicies(c context.Context, inboxIRI *url.URL, activity Activity) (rejected bool, err error) {
+	h, ok := a.s2s.(InteractionPolicyHook)
+	if !ok {
+		return false, nil
+	}
+	targets, rule := interactionTargets(activity)
+	if len(targets) == 0 {
+		return false, nil
+	}
+	actors := authorsOf(activity)
+	for _, target := range targets {
+		var d InteractionDecision
+		var decided bool
+		if d, decided, err = a.interactionDecision(c, target, rule, actors); err != nil {
+			return
+		} else if !decided {
+			continue
+		}
+		if err = h.InteractionDecided(c, inboxIRI, activity, target, d); err != nil {
+			return
+		}
+		rejected = rejected || d == InteractionRejected
+	}
+	return
+}
+
+// interactionTargets returns the ids of the objects the activity interacts
+// with, and the rule of their policies for the interaction.
+func interactionTargets(activity Activity) ([]*url.URL, func(InteractionPolicy) *InteractionRule) {
+	var targets []*url.URL
+	o, ok := activity.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return nil, nil
+	}
+	op := o.GetActivityStreamsObject()
+	switch {
+	case streams.IsOrExtendsActivityStreamsCreate(activity):
+		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+			r, ok := iter.GetType().(inReplyToer)
+			if !ok || r.GetActivityStreamsInReplyTo() == nil {
+				continue
+			}
+			irt := r.GetActivityStreamsInReplyTo()
+			for rIter := irt.Begin(); rIter != irt.End(); rIter = rIter.Next() {
+				if id, err := ToId(rIter); err == nil {
+					targets = append(targets, id)
+				}
+			}
+		}
+		return targets, func(p InteractionPolicy) *InteractionRule { return p.CanReply }
+	case streams.IsOrExtendsActivityStreamsLike(activity):
+		return objectIds(op), func(p InteractionPolicy) *InteractionRule { return p.CanLike }
+	case streams.IsOrExtendsActivityStreamsAnnounce(activity):
+		return objectIds(op), func(p InteractionPolicy) *InteractionRule { return p.CanAnnounce }
+	}
+	return nil, nil
+}
+
+// objectIds returns the ids of the objects.
+func objectIds(op vocab.ActivityStreamsObjectProperty) []*url.URL {
+	var ids []*url.URL
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if id, err := ToId(iter); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// interactionDecision decides the interaction of the actors with the object
+// by the rule of its policy, and returns whether the object is owned by this
+// server and has a policy to decide it. An unset rule permits everyone.
+func (a *sideEffectActor) interactionDecision(c context.Context, objId *url.URL, rule func(InteractionPolicy) *InteractionRule, actors []*url.URL) (InteractionDecision, bool, error) {
+	t, err := a.ownedObject(c, objId)
+	if err != nil || t == nil {
+		return InteractionPermitted, false, err
+	}
+	p, ok := GetInteractionPolicy(t)
+	if !ok {
+		return InteractionPermitted, false, nil
+	}
+	r := rule(p)
+	if r == nil {
+		return InteractionPermitted, true, nil
+	}
+	authors := authorsOf(t)
+	audience := &interactionAudience{db: a.db, authors: authors}
+	for _, actor := range actors {
+		for _, author := range authors {
+			if actor.String() == author.String() {
+				return InteractionPermitted, true, nil
+			}
+		}
+	}
+	if in, err := audience.includesAny(c, r.Always, actors); err != nil || in {
+		return InteractionPermitted, true, err
+	}
+	if in, err := audience.includesAny(c, r.ApprovalRequired, actors); err != nil || in {
+		return InteractionPendingApproval, true, err
+	}
+	return InteractionRejected, true, nil
+}
+
+// ownedObject returns the object, or nil if this server does not own it.
+func (a *sideEffectActor) ownedObject(c context.Context, id *url.URL) (vocab.Type, error) {
+	if err := a.db.Lock(c, id); err != nil {
+		return nil, err
+	}
+	defer a.db.Unlock(c, id)
+	if owns, err := a.db.Owns(c, id); err != nil || !owns {
+		return nil, err
+	}
+	return a.db.Get(c, id)
+}
+
+// interactionAudience matches actors against the ids of a rule, fetching the
+// followers and following collections of the authors of the object when a rule
+// lists them.
+type interactionAudience struct {
+	db      Database
+	authors []*url.URL
+	// collections are the followers and following collections of the
+	// authors owned by this server, once fetched.
+	collections []vocab.ActivityStreamsCollection
+	fetched     bool
+}
+
+// includesAny determines whether any of the actors is among the ids: the
+// Public collection, the actors themselves, or the followers and following
+// collections of the authors.
+func (i *interactionAudience) includesAny(c context.Context, ids, actors []*url.URL) (bool, error) {
+	for _, id := range ids {
+		if IsPublic(id.String()) {
+			return true, nil
+		}
+		for _, actor := range actors {
+			if id.String() == actor.String() {
+				return true, nil
+			}
+		}
+		cols, err := i.authorCollections(c)
+		if err != nil {
+			return false, err
+		}
+		for _, col := range cols {
+			colId, err := GetId(col)
+			if err != nil || colId.String() != id.String() {
+				continue
+			}
+			for _, actor := range actors {
+				if hasItem(col, actor) {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// authorCollections returns the followers and following collections of the
+// authors owned by this server.
+func (i *interactionAudience) authorCollections(c context.Context) ([]vocab.ActivityStreamsCollection, error) {
+	if i.fetched {
+		return i.collections, nil
+	}
+	for _, author := range i.authors {
+		cols, err := i.actorCollections(c, author)
+		if err != nil {
+			return nil, err
+		}
+		i.collections = append(i.collections, cols...)
+	}
+	i.fetched = true
+	return i.collections, nil
+}
+
+// actorCollections returns the followers and following collections of the
+// actor, if this server owns it.
+func (i *interactionAudience) actorCollections(c context.Context, actor *url.URL) ([]vocab.ActivityStreamsCollection, error) {
+	if err := i.db.Lock(c, actor); err != nil {
+		return nil, err
+	}
+	defer i.db.Unlock(c, actor)
+	if owns, err := i.db.Owns(c, actor); err != nil || !owns {
+		return nil, err
+	}
+	followers, err := i.db.Followers(c, actor)
+	if err != nil {
+		return nil, err
+	}
+	following, err := i.db.Following(c, actor)
+	if err != nil {
+		return nil, err
+	}
+	return []vocab.ActivityStreamsCollection{followers, following}, nil
+}
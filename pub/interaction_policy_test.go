@@ -0,0 +1,231 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// interactionDecision is a decision recorded by an interactionPolicyProtocol.
+type interactionDecision struct {
+	object   string
+	decision InteractionDecision
+}
+
+// interactionPolicyProtocol is a FederatingProtocol recording the decisions
+// of interaction policies.
+type interactionPolicyProtocol struct {
+	*MockFederatingProtocol
+	decisions []interactionDecision
+}
+
+func (p *interactionPolicyProtocol) InteractionDecided(c context.Context, inboxIRI *url.URL, activity Activity, object *url.URL, d InteractionDecision) error {
+	p.decisions = append(p.decisions, interactionDecision{object.String(), d})
+	return nil
+}
+
+func TestInteractionPolicy(t *testing.T) {
+	ctx := context.Background()
+	const followersIRI = "https://maybe.example.com/person/followers"
+	const followingIRI = "https://maybe.example.com/person/following"
+	policy := InteractionPolicy{
+		CanReply: &InteractionRule{
+			Always:           []*url.URL{mustParse(followersIRI)},
+			ApprovalRequired: []*url.URL{mustParse(PublicActivityPubIRI)},
+		},
+		CanLike: &InteractionRule{
+			Always: []*url.URL{mustParse(PublicActivityPubIRI)},
+		},
+		CanAnnounce: &InteractionRule{
+			Always: []*url.URL{mustParse(testFederatedActorIRI)},
+		},
+	}
+	newNote := func(policy InteractionPolicy) vocab.ActivityStreamsNote {
+		note := streams.NewActivityStreamsNote()
+		note.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+		attr := streams.NewActivityStreamsAttributedToProperty()
+		attr.AppendIRI(mustParse(testPersonIRI))
+		note.SetActivityStreamsAttributedTo(attr)
+		SetInteractionPolicy(note, policy)
+		return note
+	}
+	collection := func(id string, items ...string) vocab.ActivityStreamsCollection {
+		col := newItemsCollection(items...)
+		col.SetActivityStreamsId(newIdProperty(mustParse(id)))
+		return col
+	}
+	reply := func(actor string) Activity {
+		note := streams.NewActivityStreamsNote()
+		irt := streams.NewActivityStreamsInReplyToProperty()
+		irt.AppendIRI(mustParse(testNoteId1))
+		note.SetActivityStreamsInReplyTo(irt)
+		create := streams.NewActivityStreamsCreate()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(actor))
+		create.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		create.SetActivityStreamsObject(op)
+		return create
+	}
+	expectNote := func(db *MockDatabase, policy InteractionPolicy) {
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(newNote(policy), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+	}
+	expectAuthorCollections := func(db *MockDatabase) {
+		db.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		db.EXPECT().Owns(ctx, mustParse(testPersonIRI)).Return(true, nil)
+		db.EXPECT().Followers(ctx, mustParse(testPersonIRI)).Return(collection(followersIRI, testFederatedActorIRI), nil)
+		db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(collection(followingIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+	}
+	setupFn := func(ctl *gomock.Controller) (*MockDatabase, *interactionPolicyProtocol, *sideEffectActor) {
+		db := NewMockDatabase(ctl)
+		p := &interactionPolicyProtocol{MockFederatingProtocol: NewMockFederatingProtocol(ctl)}
+		return db, p, &sideEffectActor{db: db, s2s: p}
+	}
+	t.Run("RoundTripsPolicy", func(t *testing.T) {
+		m, err := Serialize(newNote(policy))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(m[canReplyProperty]), fmt.Sprintf("[%s]", followersIRI))
+		parsed, err := streams.ToType(ctx, m)
+		assertEqual(t, err, nil)
+		p, ok := GetInteractionPolicy(parsed)
+		assertEqual(t, ok, true)
+		assertEqual(t, fmt.Sprint(*p.CanReply), fmt.Sprint(*policy.CanReply))
+		assertEqual(t, fmt.Sprint(*p.CanLike), fmt.Sprint(*policy.CanLike))
+		assertEqual(t, fmt.Sprint(*p.CanAnnounce), fmt.Sprint(*policy.CanAnnounce))
+	})
+	t.Run("OmitsUnsetRules", func(t *testing.T) {
+		m, err := Serialize(newNote(InteractionPolicy{CanReply: &InteractionRule{}}))
+		assertEqual(t, err, nil)
+		ip := m[interactionPolicyProperty].(map[string]interface{})
+		_, hasLike := ip[canLikeProperty]
+		assertEqual(t, hasLike, false)
+		_, hasAnnounce := ip[canAnnounceProperty]
+		assertEqual(t, hasAnnounce, false)
+		parsed, err := streams.ToType(ctx, m)
+		assertEqual(t, err, nil)
+		p, ok := GetInteractionPolicy(parsed)
+		assertEqual(t, ok, true)
+		assertEqual(t, p.CanReply != nil, true)
+		assertEqual(t, len(p.CanReply.Always), 0)
+		assertEqual(t, p.CanLike == nil, true)
+		assertEqual(t, p.CanAnnounce == nil, true)
+	})
+	t.Run("ReadsCanReply", func(t *testing.T) {
+		note := streams.NewActivityStreamsNote()
+		note.GetUnknownProperties()[canReplyProperty] = PublicActivityPubIRI
+		p, ok := GetInteractionPolicy(note)
+		assertEqual(t, ok, true)
+		assertEqual(t, fmt.Sprint(p.CanReply.Always), "["+PublicActivityPubIRI+"]")
+		assertEqual(t, p.CanLike == nil, true)
+	})
+	t.Run("PermitsRepliesOfFollowers", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		expectNote(db, policy)
+		expectAuthorCollections(db)
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), reply(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, false)
+		assertEqual(t, fmt.Sprint(p.decisions), fmt.Sprint([]interactionDecision{{testNoteId1, InteractionPermitted}}))
+	})
+	t.Run("FlagsRepliesRequiringApproval", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		expectNote(db, policy)
+		expectAuthorCollections(db)
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), reply(testFederatedActorIRI2))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, false)
+		assertEqual(t, fmt.Sprint(p.decisions), fmt.Sprint([]interactionDecision{{testNoteId1, InteractionPendingApproval}}))
+	})
+	t.Run("RejectsAnnouncesOfOthers", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		expectNote(db, policy)
+		expectAuthorCollections(db)
+		announce := streams.NewActivityStreamsAnnounce()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(testFederatedActorIRI2))
+		announce.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		announce.SetActivityStreamsObject(op)
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), announce)
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, true)
+		assertEqual(t, fmt.Sprint(p.decisions), fmt.Sprint([]interactionDecision{{testNoteId1, InteractionRejected}}))
+	})
+	like := func(actor string) Activity {
+		like := streams.NewActivityStreamsLike()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(actor))
+		like.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		like.SetActivityStreamsObject(op)
+		return like
+	}
+	t.Run("PermitsWithUnsetRule", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		expectNote(db, InteractionPolicy{CanReply: policy.CanReply})
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), like(testFederatedActorIRI2))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, false)
+		assertEqual(t, fmt.Sprint(p.decisions), fmt.Sprint([]interactionDecision{{testNoteId1, InteractionPermitted}}))
+	})
+	t.Run("RejectsOthersWithEmptyRule", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		expectNote(db, InteractionPolicy{CanLike: &InteractionRule{}})
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), like(testFederatedActorIRI2))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, true)
+		assertEqual(t, fmt.Sprint(p.decisions), fmt.Sprint([]interactionDecision{{testNoteId1, InteractionRejected}}))
+	})
+	t.Run("PermitsAuthorWithEmptyRule", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		expectNote(db, InteractionPolicy{CanLike: &InteractionRule{}})
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), like(testPersonIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, false)
+		assertEqual(t, fmt.Sprint(p.decisions), fmt.Sprint([]interactionDecision{{testNoteId1, InteractionPermitted}}))
+	})
+	t.Run("SkipsObjectsOfPeers", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, p, a := setupFn(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), reply(testFederatedActorIRI2))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, false)
+		assertEqual(t, len(p.decisions), 0)
+	})
+	t.Run("DoesNotEnforceByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := &sideEffectActor{db: NewMockDatabase(ctl), s2s: NewMockFederatingProtocol(ctl)}
+		rejected, err := a.enforceInteractionPolicies(ctx, mustParse(testMyInboxIRI), reply(testFederatedActorIRI2))
+		assertEqual(t, err, nil)
+		assertEqual(t, rejected, false)
+	})
+}
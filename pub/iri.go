@@ -0,0 +1,132 @@
+package pub
+
+import (
+	"net/url"
+	"sync"
+)
+
+// defaultIRIInternerSize is the number of IRIs an actor keeps interned, enough
+// for the recipients of a large fan-out.
+const defaultIRIInternerSize = 1 << 16
+
+// IRI is an IRI held as its string, parsed into a url.URL at most once. Unlike
+// a url.URL, comparing and hashing an IRI does not allocate a new string each
+// time.
+//
+// An IRI is safe for concurrent use. It must not be copied after first use.
+type IRI struct {
+	s    string
+	once sync.Once
+	u    *url.URL
+	err  error
+}
+
+// NewIRI creates an IRI from its string, which is parsed on first use.
+func NewIRI(s string) *IRI {
+	return &IRI{s: s}
+}
+
+// newParsedIRI creates an IRI from its string and the url.URL it was parsed
+// into, which must not be modified afterwards.
+func newParsedIRI(s string, u *url.URL) *IRI {
+	i := &IRI{s: s, u: u}
+	i.once.Do(func() {})
+	return i
+}
+
+// String returns the IRI string.
+func (i *IRI) String() string {
+	return i.s
+}
+
+// URL returns the parsed IRI. The url.URL is shared by every user of the IRI and
+// must not be modified.
+func (i *IRI) URL() (*url.URL, error) {
+	i.once.Do(func() {
+		i.u, i.err = url.Parse(i.s)
+	})
+	return i.u, i.err
+}
+
+// IRIInterner shares one IRI per IRI string, so the same recipients seen while
+// addressing, deduplicating, and delivering many activities are parsed and
+// stringified once. It is safe for concurrent use.
+//
+// At most a number of IRIs are kept; once full, the interner starts over empty.
+// A nil IRIInterner interns nothing and returns new IRIs.
+type IRIInterner struct {
+	mu   sync.Mutex
+	size int
+	m    map[string]*IRI
+}
+
+// NewIRIInterner creates an IRIInterner keeping up to size IRIs.
+func NewIRIInterner(size int) *IRIInterner {
+	return &IRIInterner{
+		size: size,
+		m:    make(map[string]*IRI),
+	}
+}
+
+// Intern returns the IRI for the string.
+func (n *IRIInterner) Intern(s string) *IRI {
+	if n == nil {
+		return NewIRI(s)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if i, ok := n.m[s]; ok {
+		return i
+	}
+	i := NewIRI(s)
+	n.add(i)
+	return i
+}
+
+// InternURL returns the IRI for the url.URL, which must not be modified
+// afterwards.
+func (n *IRIInterner) InternURL(u *url.URL) *IRI {
+	s := u.String()
+	if n == nil {
+		return newParsedIRI(s, u)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if i, ok := n.m[s]; ok {
+		return i
+	}
+	i := newParsedIRI(s, u)
+	n.add(i)
+	return i
+}
+
+// InternURLs returns the IRIs for the url.URLs, which must not be modified
+// afterwards.
+func (n *IRIInterner) InternURLs(u []*url.URL) []*IRI {
+	iris := make([]*IRI, len(u))
+	for k, v := range u {
+		iris[k] = n.InternURL(v)
+	}
+	return iris
+}
+
+// add keeps the IRI, starting over if the interner is full. The lock must be
+// held.
+func (n *IRIInterner) add(i *IRI) {
+	if len(n.m) >= n.size {
+		n.m = make(map[string]*IRI)
+	}
+	n.m[i.s] = i
+}
+
+// iriURLs returns the parsed IRIs.
+func iriURLs(iris []*IRI) ([]*url.URL, error) {
+	u := make([]*url.URL, len(iris))
+	for k, i := range iris {
+		var err error
+		if u[k], err = i.URL(); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
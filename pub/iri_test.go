@@ -0,0 +1,49 @@
+package pub
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIRIInterner(t *testing.T) {
+	t.Run("SharesOneIRIPerString", func(t *testing.T) {
+		n := NewIRIInterner(10)
+		a := n.Intern(testFederatedActorIRI)
+		b := n.InternURL(mustParse(testFederatedActorIRI))
+		assertEqual(t, a, b)
+		u, err := a.URL()
+		assertEqual(t, err, nil)
+		assertEqual(t, u.String(), testFederatedActorIRI)
+	})
+	t.Run("KeepsTheParsedURL", func(t *testing.T) {
+		n := NewIRIInterner(10)
+		u := mustParse(testFederatedActorIRI)
+		got, err := n.InternURL(u).URL()
+		assertEqual(t, err, nil)
+		assertEqual(t, got, u)
+	})
+	t.Run("StartsOverWhenFull", func(t *testing.T) {
+		n := NewIRIInterner(1)
+		a := n.Intern(testFederatedActorIRI)
+		n.Intern(testFederatedActorIRI2)
+		assertEqual(t, n.Intern(testFederatedActorIRI) == a, false)
+	})
+	t.Run("NilInternsNothing", func(t *testing.T) {
+		var n *IRIInterner
+		a := n.Intern(testFederatedActorIRI)
+		assertEqual(t, n.Intern(testFederatedActorIRI) == a, false)
+		assertEqual(t, a.String(), testFederatedActorIRI)
+	})
+}
+
+func TestDedupeIRIs(t *testing.T) {
+	n := NewIRIInterner(10)
+	recipients := []*IRI{
+		n.Intern(testFederatedActorIRI),
+		n.Intern(testFederatedActorIRI2),
+		NewIRI(testFederatedActorIRI),
+		n.Intern(testMyInboxIRI),
+	}
+	out := dedupeIRIs(recipients, []*IRI{NewIRI(testMyInboxIRI)})
+	assertEqual(t, fmt.Sprint(out), fmt.Sprint([]*IRI{recipients[0], recipients[1]}))
+}
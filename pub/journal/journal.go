@@ -0,0 +1,194 @@
+// Package journal records the activities accepted into inboxes to a file, one
+// JSON object per line, and replays ranges of them again with pub.Replay, such
+// as after fixing a bug in an application callback.
+//
+// Applications record the journal by embedding a Recorder in their
+// pub.FederatingProtocol, or by calling its InboxAccepted from their own, such
+// as with pub.InboxAcceptedHooks along with their other hooks:
+//
+//	f, err := os.OpenFile("inbox.journal", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+//	s2s := &myFederatingProtocol{Recorder: journal.NewRecorder(f)}
+//
+// Replaying needs the Actor of the application, so the replay verb is added to
+// the command of the application, rather than being a command of its own:
+//
+//	if len(os.Args) > 1 && os.Args[1] == "replay" {
+//		os.Exit(journal.Main(ctx, actor, os.Args[2:], os.Stdout, os.Stderr))
+//	}
+//
+// Which replays the activities from the first id to the last one, inclusive:
+//
+//	myapp replay -journal inbox.journal -first https://example.com/a/1 -last https://example.com/a/9
+package journal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// line is an entry of a journal file.
+type line struct {
+	// Inbox is the IRI of the inbox the activity was accepted into.
+	Inbox string `json:"inbox"`
+	// Activity is the serialized activity.
+	Activity json.RawMessage `json:"activity"`
+}
+
+// Recorder writes the activities accepted into inboxes to a journal. It is
+// safe for concurrent use.
+type Recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// InboxAcceptedHook must be implemented by Recorder.
+var _ pub.InboxAcceptedHook = &Recorder{}
+
+// NewRecorder creates a Recorder writing to w, such as a file opened for
+// appending.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// InboxAccepted writes the activity accepted into the inbox to the journal, on
+// a line of its own.
+func (r *Recorder) InboxAccepted(c context.Context, inboxIRI *url.URL, a pub.Activity) error {
+	m, err := pub.Serialize(a)
+	if err != nil {
+		return err
+	}
+	activity, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(line{Inbox: inboxIRI.String(), Activity: activity})
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err = r.w.Write(append(b, '\n'))
+	return err
+}
+
+// File is a pub.ActivityJournal reading the journal file written by a
+// Recorder.
+type File struct {
+	path string
+}
+
+// ActivityJournal must be implemented by File.
+var _ pub.ActivityJournal = &File{}
+
+// NewFile creates a File reading the journal at the path.
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+// Between returns the entries from the one of the first activity id to the one
+// of the last activity id, inclusive, in the order they were recorded. Returns
+// an error if either activity is not in the journal.
+func (f *File) Between(c context.Context, first, last *url.URL) ([]pub.JournalEntry, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var entries []pub.JournalEntry
+	br := bufio.NewReader(file)
+	for n := 1; ; n++ {
+		b, err := br.ReadBytes('\n')
+		if err == io.EOF && len(b) == 0 {
+			break
+		} else if err != nil && err != io.EOF {
+			return nil, err
+		}
+		var l line
+		if err = json.Unmarshal(b, &l); err != nil {
+			return nil, fmt.Errorf("journal line %d: %s", n, err)
+		}
+		id, err := activityId(l.Activity)
+		if err != nil {
+			return nil, fmt.Errorf("journal line %d: %s", n, err)
+		}
+		if entries == nil && id != first.String() {
+			continue
+		}
+		inbox, err := url.Parse(l.Inbox)
+		if err != nil {
+			return nil, fmt.Errorf("journal line %d: %s", n, err)
+		}
+		entries = append(entries, pub.JournalEntry{Inbox: inbox, Activity: l.Activity})
+		if id == last.String() {
+			return entries, nil
+		}
+	}
+	if entries == nil {
+		return nil, fmt.Errorf("activity %s is not in the journal", first)
+	}
+	return nil, fmt.Errorf("activity %s is not in the journal after %s", last, first)
+}
+
+// activityId returns the id of the serialized activity.
+func activityId(b []byte) (string, error) {
+	var activity struct {
+		Id string `json:"id"`
+	}
+	err := json.Unmarshal(b, &activity)
+	return activity.Id, err
+}
+
+// Main runs the replay verb with its command line arguments, replaying the
+// journaled activities of the range with the actor, and returns the exit code
+// of the command: 0 on success, 1 if replaying failed, and 2 if the arguments
+// are invalid.
+func Main(c context.Context, actor pub.Actor, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	path := fs.String("journal", "", "Path of the journal file to replay activities from.")
+	first := fs.String("first", "", "Id of the first activity to replay.")
+	last := fs.String("last", "", "Id of the last activity to replay, which is the first one by default.")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if len(*path) == 0 || len(*first) == 0 {
+		fmt.Fprintln(stderr, "replay: -journal and -first are required")
+		fs.Usage()
+		return 2
+	} else if len(*last) == 0 {
+		*last = *first
+	}
+	firstIRI, err := url.Parse(*first)
+	if err != nil {
+		fmt.Fprintf(stderr, "replay: %s\n", err)
+		return 2
+	}
+	lastIRI, err := url.Parse(*last)
+	if err != nil {
+		fmt.Fprintf(stderr, "replay: %s\n", err)
+		return 2
+	}
+	journal := NewFile(*path)
+	n, err := pub.Replay(c, actor, journal, firstIRI, lastIRI)
+	fmt.Fprintf(stdout, "replayed %d activities\n", n)
+	if err != nil {
+		fmt.Fprintf(stderr, "replay: %s\n", err)
+		// Tell which activity failed, so the replay can resume from it.
+		if entries, bErr := journal.Between(c, firstIRI, lastIRI); bErr == nil && n < len(entries) {
+			if id, idErr := activityId(entries[n].Activity); idErr == nil {
+				fmt.Fprintf(stderr, "replay: resume with -first %s\n", id)
+			}
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,152 @@
+package journal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+)
+
+const testInboxIRI = "https://example.com/users/addison/inbox"
+
+func assertEqual(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// testActivityIRI returns the id of the i-th test activity.
+func testActivityIRI(i int) string {
+	return fmt.Sprintf("https://other.example.com/activities/%d", i)
+}
+
+// newTestActivity creates a Like with the id of the i-th test activity.
+func newTestActivity(i int) pub.Activity {
+	like := streams.NewActivityStreamsLike()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(testActivityIRI(i)))
+	like.SetActivityStreamsId(id)
+	return like
+}
+
+// replayActor is an Actor replaying activities until it fails on one.
+type replayActor struct {
+	pub.Actor
+	replayed []string
+	failOn   string
+}
+
+func (r *replayActor) ReplayInbox(c context.Context, inboxIRI *url.URL, activity pub.Activity) error {
+	id := activity.GetActivityStreamsId().Get().String()
+	if id == r.failOn {
+		return fmt.Errorf("callback failed")
+	}
+	r.replayed = append(r.replayed, id)
+	return nil
+}
+
+// writeJournal records the test activities 1 to n in a journal file, and
+// returns its path.
+func writeJournal(t *testing.T, dir string, n int) string {
+	t.Helper()
+	var b bytes.Buffer
+	r := NewRecorder(&b)
+	for i := 1; i <= n; i++ {
+		if err := r.InboxAccepted(context.Background(), mustParse(testInboxIRI), newTestActivity(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := filepath.Join(dir, "inbox.journal")
+	if err := ioutil.WriteFile(path, b.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFile(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := NewFile(writeJournal(t, dir, 4))
+	t.Run("ReturnsRange", func(t *testing.T) {
+		entries, err := f.Between(ctx, mustParse(testActivityIRI(2)), mustParse(testActivityIRI(3)))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(entries), 2)
+		for i, e := range entries {
+			assertEqual(t, e.Inbox.String(), testInboxIRI)
+			id, err := activityId(e.Activity)
+			assertEqual(t, err, nil)
+			assertEqual(t, id, testActivityIRI(i+2))
+		}
+	})
+	t.Run("ErrorIfFirstMissing", func(t *testing.T) {
+		_, err := f.Between(ctx, mustParse(testActivityIRI(5)), mustParse(testActivityIRI(5)))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("ErrorIfLastBeforeFirst", func(t *testing.T) {
+		_, err := f.Between(ctx, mustParse(testActivityIRI(3)), mustParse(testActivityIRI(2)))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
+
+func TestReplayVerb(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := writeJournal(t, dir, 4)
+	t.Run("ReplaysRange", func(t *testing.T) {
+		a := &replayActor{}
+		var stdout, stderr bytes.Buffer
+		code := Main(ctx, a, []string{"-journal", path, "-first", testActivityIRI(2), "-last", testActivityIRI(4)}, &stdout, &stderr)
+		assertEqual(t, code, 0)
+		assertEqual(t, strings.Join(a.replayed, " "), testActivityIRI(2)+" "+testActivityIRI(3)+" "+testActivityIRI(4))
+		assertEqual(t, stdout.String(), "replayed 3 activities\n")
+	})
+	t.Run("ReplaysOneByDefault", func(t *testing.T) {
+		a := &replayActor{}
+		var stdout, stderr bytes.Buffer
+		code := Main(ctx, a, []string{"-journal", path, "-first", testActivityIRI(1)}, &stdout, &stderr)
+		assertEqual(t, code, 0)
+		assertEqual(t, strings.Join(a.replayed, " "), testActivityIRI(1))
+	})
+	t.Run("TellsWhereToResume", func(t *testing.T) {
+		a := &replayActor{failOn: testActivityIRI(3)}
+		var stdout, stderr bytes.Buffer
+		code := Main(ctx, a, []string{"-journal", path, "-first", testActivityIRI(1), "-last", testActivityIRI(4)}, &stdout, &stderr)
+		assertEqual(t, code, 1)
+		assertEqual(t, stdout.String(), "replayed 2 activities\n")
+		assertEqual(t, strings.Contains(stderr.String(), "resume with -first "+testActivityIRI(3)), true)
+	})
+	t.Run("RequiresJournalAndFirst", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := Main(ctx, &replayActor{}, []string{"-journal", path}, &stdout, &stderr)
+		assertEqual(t, code, 2)
+	})
+}
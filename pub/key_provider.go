@@ -0,0 +1,139 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// KeyProvider provides the key pairs of an actor as they rotate, so an
+// HttpSigTransport signs its requests with the current key without being
+// created again.
+type KeyProvider interface {
+	// CurrentKey returns the key pair requests are signed with, which must
+	// have a KeyId and a PrivateKey.
+	CurrentKey(c context.Context) (KeyPair, error)
+	// PreviousKeys returns the key pairs the actor still publishes while
+	// peers learn of the current one, most recently replaced first.
+	PreviousKeys(c context.Context) ([]KeyPair, error)
+}
+
+// keyStoreProvider is a KeyProvider of the key pairs of an actor in a
+// KeyStore.
+type keyStoreProvider struct {
+	ks       KeyStore
+	actorIRI *url.URL
+	window   time.Duration
+	clock    Clock
+}
+
+// NewKeyStoreProvider returns a KeyProvider of the key pairs of the actor in
+// the KeyStore. Its previous keys are those of the history of the actor
+// replaced by RotateKeyPair less than the window ago.
+func NewKeyStoreProvider(ks KeyStore, actorIRI *url.URL, window time.Duration, clock Clock) KeyProvider {
+	return &keyStoreProvider{
+		ks:       ks,
+		actorIRI: actorIRI,
+		window:   window,
+		clock:    clock,
+	}
+}
+
+// CurrentKey returns the current key pair of the actor.
+func (k *keyStoreProvider) CurrentKey(c context.Context) (KeyPair, error) {
+	return k.ks.GetKeyPair(c, k.actorIRI)
+}
+
+// PreviousKeys returns the key pairs of the history of the actor that were
+// replaced within the window. A key pair is replaced when the next one is
+// created.
+func (k *keyStoreProvider) PreviousKeys(c context.Context) ([]KeyPair, error) {
+	current, err := k.ks.GetKeyPair(c, k.actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	history, err := k.ks.GetKeyHistory(c, k.actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	since := k.clock.Now().Add(-k.window)
+	replaced := current.Created
+	var previous []KeyPair
+	for _, kp := range history {
+		if replaced.Before(since) {
+			break
+		}
+		previous = append(previous, kp)
+		replaced = kp.Created
+	}
+	return previous, nil
+}
+
+// keyOptions holds the KeyProvider of a transport, which may be changed
+// concurrently with requests being made, or the AnonymousKeyResolver of an
+// instance actor transport.
+type keyOptions struct {
+	mu        sync.RWMutex
+	provider  KeyProvider
+	anonymous AnonymousKeyResolver
+}
+
+// SetKeyProvider signs the requests with the current key of the KeyProvider,
+// instead of the key given to NewHttpSigTransport, so rotating keys does not
+// require creating the transport again.
+func (h HttpSigTransport) SetKeyProvider(p KeyProvider) {
+	h.keys.mu.Lock()
+	defer h.keys.mu.Unlock()
+	h.keys.provider = p
+}
+
+// signingKey returns the id and the private key to sign a request to the IRI
+// with.
+func (h HttpSigTransport) signingKey(c context.Context, iri *url.URL) (string, crypto.PrivateKey, error) {
+	if h.keys.anonymous != nil {
+		return anonymousKey(c, h.keys.anonymous, iri)
+	}
+	h.keys.mu.RLock()
+	p := h.keys.provider
+	h.keys.mu.RUnlock()
+	if p == nil {
+		return h.pubKeyId, h.privKey, nil
+	}
+	kp, err := p.CurrentKey(c)
+	if err != nil {
+		return "", nil, err
+	} else if kp.KeyId == nil || kp.PrivateKey == nil {
+		return "", nil, fmt.Errorf("current key pair has no key id or private key")
+	}
+	return kp.KeyId.String(), kp.PrivateKey, nil
+}
+
+// NewPublicKeyProperty creates the 'publicKey' property of the actor owning the
+// keys of the KeyProvider, with its current public key first, followed by its
+// previous ones. Peers still verify the signatures made with the previous keys
+// until they refetch the actor.
+func NewPublicKeyProperty(c context.Context, owner *url.URL, p KeyProvider) (vocab.SecurityV1PublicKeyProperty, error) {
+	current, err := p.CurrentKey(c)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := p.PreviousKeys(c)
+	if err != nil {
+		return nil, err
+	}
+	prop := streams.NewSecurityV1PublicKeyProperty()
+	for _, kp := range append([]KeyPair{current}, previous...) {
+		pk, err := NewPublicKey(owner, kp)
+		if err != nil {
+			return nil, err
+		}
+		prop.AppendSecurityV1PublicKey(pk)
+	}
+	return prop, nil
+}
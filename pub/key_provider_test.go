@@ -0,0 +1,74 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	day := 24 * time.Hour
+	actorIRI := mustParse(testPersonIRI)
+	// setupFn returns a KeyStore whose first key pair was replaced nine days
+	// ago, and whose second one was replaced now.
+	setupFn := func() (ks *memoryKeyStore, clock *FakeClock) {
+		ks = newMemoryKeyStore()
+		clock = NewFakeClock(now())
+		for i, d := range []time.Duration{0, day, 9 * day} {
+			clock.Advance(d)
+			_, err := RotateKeyPair(ctx, ks, actorIRI, mustParse(fmt.Sprintf("%s#key-%d", testPersonIRI, i+1)), clock)
+			assertEqual(t, err, nil)
+		}
+		return
+	}
+	t.Run("PreviousKeysWithinWindow", func(t *testing.T) {
+		ks, clock := setupFn()
+		p := NewKeyStoreProvider(ks, actorIRI, 7*day, clock)
+		current, err := p.CurrentKey(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, current.KeyId.String(), testPersonIRI+"#key-3")
+		previous, err := p.PreviousKeys(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(previous), 1)
+		assertEqual(t, previous[0].KeyId.String(), testPersonIRI+"#key-2")
+		clock.Advance(8 * day)
+		previous, err = p.PreviousKeys(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(previous), 0)
+	})
+	t.Run("PublishesPublicKeys", func(t *testing.T) {
+		ks, clock := setupFn()
+		prop, err := NewPublicKeyProperty(ctx, actorIRI, NewKeyStoreProvider(ks, actorIRI, 7*day, clock))
+		assertEqual(t, err, nil)
+		assertEqual(t, prop.Len(), 2)
+		assertEqual(t, prop.At(0).Get().GetActivityStreamsId().Get().String(), testPersonIRI+"#key-3")
+		assertEqual(t, prop.At(1).Get().GetActivityStreamsId().Get().String(), testPersonIRI+"#key-2")
+	})
+	t.Run("TransportSignsWithRotatedKey", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		ks, clock := setupFn()
+		var keyIds []string
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			p, err := parseSignature(req.Header)
+			assertEqual(t, err, nil)
+			keyIds = append(keyIds, p.keyId)
+			return newResponse(http.StatusOK), nil
+		})
+		signer := NewRSASHA256Signer("(request-target)", "host", "date", "digest")
+		tp := NewHttpSigTransport(client, "test", clock, signer, signer, "unused", nil)
+		tp.SetKeyProvider(NewKeyStoreProvider(ks, actorIRI, 7*day, clock))
+		assertEqual(t, tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI)), nil)
+		_, err := RotateKeyPair(ctx, ks, actorIRI, mustParse(testPersonIRI+"#key-4"), clock)
+		assertEqual(t, err, nil)
+		assertEqual(t, tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI)), nil)
+		assertEqual(t, len(keyIds), 2)
+		assertEqual(t, keyIds[0], testPersonIRI+"#key-3")
+		assertEqual(t, keyIds[1], testPersonIRI+"#key-4")
+	})
+}
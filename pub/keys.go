@@ -0,0 +1,206 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// DefaultRSAKeyBits is the size of the RSA keys generated by
+	// GenerateKeyPair, which is widely supported by peer software.
+	DefaultRSAKeyBits = 2048
+	// PEM block types.
+	pemPrivateKeyType    = "PRIVATE KEY"
+	pemRSAPrivateKeyType = "RSA PRIVATE KEY"
+	pemECPrivateKeyType  = "EC PRIVATE KEY"
+	pemPublicKeyType     = "PUBLIC KEY"
+	pemRSAPublicKeyType  = "RSA PUBLIC KEY"
+)
+
+// KeyPair is a key pair an actor uses to sign requests with HTTP Signatures.
+type KeyPair struct {
+	// KeyId identifies the public key to peers, such as
+	// "https://example.com/users/alice#main-key".
+	KeyId *url.URL
+	// PrivateKey signs the requests.
+	PrivateKey crypto.PrivateKey
+	// PublicKey verifies the signatures, and is published on the actor.
+	PublicKey crypto.PublicKey
+	// Created is when the key pair was created.
+	Created time.Time
+}
+
+// KeyStore stores the key pairs of the actors on this server.
+//
+// An actor has one current key pair, which is used to sign its requests, and
+// a history of the key pairs it previously used. The history lets an
+// application keep verifying, or announce the rotation of, old keys.
+type KeyStore interface {
+	// GetKeyPair returns the current key pair of the actor.
+	GetKeyPair(c context.Context, actorIRI *url.URL) (KeyPair, error)
+	// SetKeyPair makes the key pair the current one of the actor. A
+	// previously current key pair must be kept in the actor's history.
+	SetKeyPair(c context.Context, actorIRI *url.URL, kp KeyPair) error
+	// GetKeyHistory returns the key pairs the actor previously used, most
+	// recently replaced first. It excludes the current key pair.
+	GetKeyHistory(c context.Context, actorIRI *url.URL) ([]KeyPair, error)
+}
+
+// GenerateKeyPair creates a new RSA key pair of DefaultRSAKeyBits.
+func GenerateKeyPair(keyId *url.URL, clock Clock) (KeyPair, error) {
+	k, err := rsa.GenerateKey(rand.Reader, DefaultRSAKeyBits)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPair{
+		KeyId:      keyId,
+		PrivateKey: k,
+		PublicKey:  k.Public(),
+		Created:    clock.Now(),
+	}, nil
+}
+
+// RotateKeyPair generates a new key pair for the actor and makes it the current
+// one, so the previous key pair becomes part of the actor's history.
+//
+// The application is responsible for publishing the new public key on the
+// actor, such as with an Update activity.
+func RotateKeyPair(c context.Context, ks KeyStore, actorIRI, keyId *url.URL, clock Clock) (KeyPair, error) {
+	kp, err := GenerateKeyPair(keyId, clock)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if err = ks.SetKeyPair(c, actorIRI, kp); err != nil {
+		return KeyPair{}, err
+	}
+	return kp, nil
+}
+
+// EncodePrivateKeyPEM encodes a private key as a PKCS #8 "PRIVATE KEY" PEM
+// block, suitable for storage.
+func EncodePrivateKeyPEM(k crypto.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemPrivateKeyType, Bytes: der}), nil
+}
+
+// DecodePrivateKeyPEM decodes a private key from the first PEM block. PKCS #8
+// "PRIVATE KEY", PKCS #1 "RSA PRIVATE KEY", and SEC 1 "EC PRIVATE KEY" blocks
+// are supported.
+func DecodePrivateKeyPEM(b []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("cannot decode private key: no PEM block found")
+	}
+	switch block.Type {
+	case pemPrivateKeyType:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case pemRSAPrivateKeyType:
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case pemECPrivateKeyType:
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("cannot decode private key: unsupported PEM block type %q", block.Type)
+	}
+}
+
+// EncodePublicKeyPEM encodes a public key as a PKIX "PUBLIC KEY" PEM block,
+// which is the format of the publicKeyPem property of actors.
+func EncodePublicKeyPEM(k crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemPublicKeyType, Bytes: der}), nil
+}
+
+// NewPublicKey creates the PublicKey of the key pair owned by the actor, to
+// publish in the 'publicKey' property of the actor.
+func NewPublicKey(owner *url.URL, kp KeyPair) (vocab.SecurityV1PublicKey, error) {
+	b, err := EncodePublicKeyPEM(kp.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	pk := streams.NewSecurityV1PublicKey()
+	pk.SetActivityStreamsId(newIdProperty(kp.KeyId))
+	o := streams.NewSecurityV1OwnerProperty()
+	o.Set(owner)
+	pk.SetSecurityV1Owner(o)
+	p := streams.NewSecurityV1PublicKeyPemProperty()
+	p.Set(string(b))
+	pk.SetSecurityV1PublicKeyPem(p)
+	return pk, nil
+}
+
+// DecodePublicKeyPEM decodes a public key from the first PEM block, such as
+// the publicKeyPem property of a peer's actor. PKIX "PUBLIC KEY" and PKCS #1
+// "RSA PUBLIC KEY" blocks are supported.
+func DecodePublicKeyPEM(b []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("cannot decode public key: no PEM block found")
+	}
+	switch block.Type {
+	case pemPublicKeyType:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case pemRSAPublicKeyType:
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("cannot decode public key: unsupported PEM block type %q", block.Type)
+	}
+}
+
+// KeyPairFromPEM creates a key pair from a private key PEM block, such as one
+// produced by EncodePrivateKeyPEM and kept in storage by a KeyStore.
+func KeyPairFromPEM(keyId *url.URL, privateKeyPEM []byte, created time.Time) (KeyPair, error) {
+	k, err := DecodePrivateKeyPEM(privateKeyPEM)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	signer, ok := k.(crypto.Signer)
+	if !ok {
+		return KeyPair{}, fmt.Errorf("cannot determine the public key of a %T", k)
+	}
+	return KeyPair{
+		KeyId:      keyId,
+		PrivateKey: k,
+		PublicKey:  signer.Public(),
+		Created:    created,
+	}, nil
+}
+
+// NewHttpSigTransportFromKeyStore returns a new HttpSigTransport signing requests
+// on behalf of the actor with its current key pair in the KeyStore. It is meant
+// to be used when implementing CommonBehavior's NewTransport.
+//
+// See NewHttpSigTransport for the other parameters.
+func NewHttpSigTransportFromKeyStore(
+	c context.Context,
+	ks KeyStore,
+	actorIRI *url.URL,
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	getSigner, postSigner httpsig.Signer) (*HttpSigTransport, error) {
+	kp, err := ks.GetKeyPair(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	if kp.KeyId == nil || kp.PrivateKey == nil {
+		return nil, fmt.Errorf("key pair of %s has no key id or private key", actorIRI.String())
+	}
+	return NewHttpSigTransport(client, appAgent, clock, getSigner, postSigner, kp.KeyId.String(), kp.PrivateKey), nil
+}
@@ -0,0 +1,149 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+// memoryKeyStore is a KeyStore that keeps the key pairs in memory.
+type memoryKeyStore struct {
+	current map[string]KeyPair
+	history map[string][]KeyPair
+}
+
+func newMemoryKeyStore() *memoryKeyStore {
+	return &memoryKeyStore{
+		current: make(map[string]KeyPair),
+		history: make(map[string][]KeyPair),
+	}
+}
+
+func (m *memoryKeyStore) GetKeyPair(c context.Context, actorIRI *url.URL) (KeyPair, error) {
+	kp, ok := m.current[actorIRI.String()]
+	if !ok {
+		return KeyPair{}, fmt.Errorf("no key pair for %s", actorIRI)
+	}
+	return kp, nil
+}
+
+func (m *memoryKeyStore) SetKeyPair(c context.Context, actorIRI *url.URL, kp KeyPair) error {
+	if old, ok := m.current[actorIRI.String()]; ok {
+		m.history[actorIRI.String()] = append([]KeyPair{old}, m.history[actorIRI.String()]...)
+	}
+	m.current[actorIRI.String()] = kp
+	return nil
+}
+
+func (m *memoryKeyStore) GetKeyHistory(c context.Context, actorIRI *url.URL) ([]KeyPair, error) {
+	return m.history[actorIRI.String()], nil
+}
+
+func TestKeyPEM(t *testing.T) {
+	k, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("PrivateKeyRoundTrips", func(t *testing.T) {
+		b, err := EncodePrivateKeyPEM(k)
+		assertEqual(t, err, nil)
+		actual, err := DecodePrivateKeyPEM(b)
+		assertEqual(t, err, nil)
+		assertEqual(t, actual.(*rsa.PrivateKey).D.Cmp(k.D), 0)
+	})
+	t.Run("DecodesPKCS1PrivateKey", func(t *testing.T) {
+		b := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)})
+		actual, err := DecodePrivateKeyPEM(b)
+		assertEqual(t, err, nil)
+		assertEqual(t, actual.(*rsa.PrivateKey).D.Cmp(k.D), 0)
+	})
+	t.Run("PublicKeyRoundTrips", func(t *testing.T) {
+		b, err := EncodePublicKeyPEM(k.Public())
+		assertEqual(t, err, nil)
+		actual, err := DecodePublicKeyPEM(b)
+		assertEqual(t, err, nil)
+		assertEqual(t, actual.(*rsa.PublicKey).N.Cmp(k.N), 0)
+	})
+	t.Run("DecodesPKCS1PublicKey", func(t *testing.T) {
+		b := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&k.PublicKey)})
+		actual, err := DecodePublicKeyPEM(b)
+		assertEqual(t, err, nil)
+		assertEqual(t, actual.(*rsa.PublicKey).N.Cmp(k.N), 0)
+	})
+	t.Run("ErrorsWithoutPEMBlock", func(t *testing.T) {
+		_, err := DecodePrivateKeyPEM([]byte("not a key"))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		_, err = DecodePublicKeyPEM([]byte("not a key"))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("KeyPairFromPEM", func(t *testing.T) {
+		b, err := EncodePrivateKeyPEM(k)
+		assertEqual(t, err, nil)
+		created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		kp, err := KeyPairFromPEM(mustParse(testPersonIRI+"#main-key"), b, created)
+		assertEqual(t, err, nil)
+		assertEqual(t, kp.KeyId.String(), testPersonIRI+"#main-key")
+		assertEqual(t, kp.PublicKey.(*rsa.PublicKey).N.Cmp(k.N), 0)
+		assertEqual(t, kp.Created, created)
+	})
+	t.Run("PublishesPublicKey", func(t *testing.T) {
+		keyId := mustParse(testPersonIRI + "#main-key")
+		pk, err := NewPublicKey(mustParse(testPersonIRI), KeyPair{KeyId: keyId, PublicKey: k.Public()})
+		assertEqual(t, err, nil)
+		person := streams.NewActivityStreamsPerson()
+		person.SetActivityStreamsId(newIdProperty(mustParse(testPersonIRI)))
+		pks := streams.NewSecurityV1PublicKeyProperty()
+		pks.AppendSecurityV1PublicKey(pk)
+		person.SetSecurityV1PublicKey(pks)
+		m, err := serialize(person)
+		assertEqual(t, err, nil)
+		b, err := json.Marshal(m)
+		assertEqual(t, err, nil)
+		assertEqual(t, strings.Contains(string(b), `"https://w3id.org/security/v1"`), true)
+		actual, owner, err := parsePublicKey(b, keyId)
+		assertEqual(t, err, nil)
+		assertEqual(t, owner.String(), testPersonIRI)
+		assertEqual(t, actual.(*rsa.PublicKey).N.Cmp(k.N), 0)
+	})
+}
+
+func TestRotateKeyPair(t *testing.T) {
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+	ks := newMemoryKeyStore()
+	actorIRI := mustParse(testPersonIRI)
+	first, err := RotateKeyPair(ctx, ks, actorIRI, mustParse(testPersonIRI+"#key-1"), clock)
+	assertEqual(t, err, nil)
+	second, err := RotateKeyPair(ctx, ks, actorIRI, mustParse(testPersonIRI+"#key-2"), clock)
+	assertEqual(t, err, nil)
+	assertEqual(t, second.Created, now)
+	current, err := ks.GetKeyPair(ctx, actorIRI)
+	assertEqual(t, err, nil)
+	assertEqual(t, current.KeyId.String(), testPersonIRI+"#key-2")
+	history, err := ks.GetKeyHistory(ctx, actorIRI)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(history), 1)
+	assertEqual(t, history[0].KeyId.String(), first.KeyId.String())
+	tp, err := NewHttpSigTransportFromKeyStore(ctx, ks, actorIRI, NewMockHttpClient(ctl), "test", clock, nil, nil)
+	assertEqual(t, err, nil)
+	assertEqual(t, tp.pubKeyId, testPersonIRI+"#key-2")
+}
@@ -0,0 +1,44 @@
+package pub
+
+import (
+	"context"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// undoLike removes the Like from the "likes" collections of its objects owned
+// by this server, recounting their items if MaintainLikes is set.
+func (w FederatingWrappedCallbacks) undoLike(c context.Context, like vocab.ActivityStreamsLike) error {
+	return w.removeFromObjects(c, like, func(t vocab.Type) vocab.Type {
+		if l, ok := t.(likeser); ok && l.GetActivityStreamsLikes() != nil {
+			return l.GetActivityStreamsLikes().GetType()
+		}
+		return nil
+	}, w.MaintainLikes)
+}
+
+// addTotalItems adds the delta to the 'totalItems' of a Collection or
+// OrderedCollection, never going below zero. The items of paged collections
+// are in their pages, so a missing 'totalItems' is only initialized from the
+// items the collection holds itself.
+func addTotalItems(t vocab.Type, delta int) {
+	ti, ok := t.(totalItemser)
+	if !ok {
+		return
+	}
+	var n int
+	if total := ti.GetActivityStreamsTotalItems(); total != nil && total.IsXMLSchemaNonNegativeInteger() {
+		n = total.Get() + delta
+	} else if i, ok := t.(itemser); ok && i.GetActivityStreamsItems() != nil {
+		n = i.GetActivityStreamsItems().Len()
+	} else if oi, ok := t.(orderedItemser); ok && oi.GetActivityStreamsOrderedItems() != nil {
+		n = oi.GetActivityStreamsOrderedItems().Len()
+	}
+	if n < 0 {
+		n = 0
+	}
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(n)
+	ti.SetActivityStreamsTotalItems(total)
+}
@@ -0,0 +1,158 @@
+package pub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// newLikeOf returns a Like of the object by the actor.
+func newLikeOf(id, actor, object string) vocab.ActivityStreamsLike {
+	l := streams.NewActivityStreamsLike()
+	l.SetActivityStreamsId(newIdProperty(mustParse(id)))
+	ap := streams.NewActivityStreamsActorProperty()
+	ap.AppendIRI(mustParse(actor))
+	l.SetActivityStreamsActor(ap)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(object))
+	l.SetActivityStreamsObject(op)
+	return l
+}
+
+// newItemsCollection returns a Collection of the IRIs.
+func newItemsCollection(ids ...string) vocab.ActivityStreamsCollection {
+	col := streams.NewActivityStreamsCollection()
+	items := streams.NewActivityStreamsItemsProperty()
+	for _, id := range ids {
+		items.AppendIRI(mustParse(id))
+	}
+	col.SetActivityStreamsItems(items)
+	return col
+}
+
+// newNoteWithLikes returns the testNoteId1 Note, whose likes are the
+// Collection or OrderedCollection, if any.
+func newNoteWithLikes(likes vocab.Type) vocab.ActivityStreamsNote {
+	n := streams.NewActivityStreamsNote()
+	n.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+	if likes != nil {
+		lp := streams.NewActivityStreamsLikesProperty()
+		if col, ok := likes.(vocab.ActivityStreamsCollection); ok {
+			lp.SetActivityStreamsCollection(col)
+		} else {
+			lp.SetActivityStreamsOrderedCollection(likes.(vocab.ActivityStreamsOrderedCollection))
+		}
+		n.SetActivityStreamsLikes(lp)
+	}
+	return n
+}
+
+// likesOf returns the ids of the likes of the Note.
+func likesOf(v vocab.Type) []string {
+	var ids []string
+	likes := v.(vocab.ActivityStreamsNote).GetActivityStreamsLikes()
+	if col := likes.GetActivityStreamsCollection(); col != nil {
+		for iter := col.GetActivityStreamsItems().Begin(); iter != nil; iter = iter.Next() {
+			ids = append(ids, iter.GetIRI().String())
+		}
+	} else if oc := likes.GetActivityStreamsOrderedCollection(); oc != nil {
+		for iter := oc.GetActivityStreamsOrderedItems().Begin(); iter != nil; iter = iter.Next() {
+			ids = append(ids, iter.GetIRI().String())
+		}
+	}
+	return ids
+}
+
+func TestUndoLikes(t *testing.T) {
+	ctx := context.Background()
+	const likeId = "https://other.example.com/like/1"
+	const otherLikeId = "https://other.example.com/like/2"
+	undoOf := func(like vocab.Type) vocab.ActivityStreamsUndo {
+		u := streams.NewActivityStreamsUndo()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(testFederatedActorIRI))
+		u.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendType(like)
+		u.SetActivityStreamsObject(op)
+		return u
+	}
+	expectStoredLike := func(db *MockDatabase) {
+		db.EXPECT().Lock(ctx, mustParse(likeId))
+		db.EXPECT().Exists(ctx, mustParse(likeId)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(likeId)).Return(newLikeOf(likeId, testFederatedActorIRI, testNoteId1), nil)
+		db.EXPECT().Unlock(ctx, mustParse(likeId))
+	}
+	expectRemoval := func(t *testing.T, db *MockDatabase) {
+		expectStoredLike(db)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(newNoteWithLikes(newItemsCollection(otherLikeId, likeId)), nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, len(likesOf(v)), 1)
+			assertEqual(t, likesOf(v)[0], otherLikeId)
+			likes := v.(vocab.ActivityStreamsNote).GetActivityStreamsLikes().GetActivityStreamsCollection()
+			assertEqual(t, likes.GetActivityStreamsTotalItems().Get(), 1)
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+	}
+	t.Run("RemovesEmbeddedLike", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectRemoval(t, db)
+		w := FederatingWrappedCallbacks{db: db, MaintainLikes: true}
+		assertEqual(t, w.undo(ctx, undoOf(newLikeOf(likeId, testFederatedActorIRI, testNoteId1))), nil)
+	})
+	t.Run("RemovesWithoutCountingByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectStoredLike(db)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(newNoteWithLikes(newItemsCollection(otherLikeId, likeId)), nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, len(likesOf(v)), 1)
+			likes := v.(vocab.ActivityStreamsNote).GetActivityStreamsLikes().GetActivityStreamsCollection()
+			assertEqual(t, likes.GetActivityStreamsTotalItems(), nil)
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		w := FederatingWrappedCallbacks{db: db}
+		assertEqual(t, w.undo(ctx, undoOf(newLikeOf(likeId, testFederatedActorIRI, testNoteId1))), nil)
+	})
+}
+
+func TestAddTotalItems(t *testing.T) {
+	withTotal := func(col vocab.ActivityStreamsCollection, n int) vocab.ActivityStreamsCollection {
+		total := streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(n)
+		col.SetActivityStreamsTotalItems(total)
+		return col
+	}
+	t.Run("AddsToPagedCollectionTotal", func(t *testing.T) {
+		col := withTotal(newItemsCollection("https://other.example.com/like/41"), 40)
+		first := streams.NewActivityStreamsFirstProperty()
+		first.SetIRI(mustParse("https://example.com/note/1/likes?page=1"))
+		col.SetActivityStreamsFirst(first)
+		addTotalItems(col, 1)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 41)
+		addTotalItems(col, -2)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 39)
+	})
+	t.Run("InitializesFromItems", func(t *testing.T) {
+		col := newItemsCollection("https://other.example.com/like/0", "https://other.example.com/like/1")
+		addTotalItems(col, 1)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 2)
+	})
+	t.Run("NeverNegative", func(t *testing.T) {
+		col := withTotal(streams.NewActivityStreamsCollection(), 0)
+		addTotalItems(col, -1)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 0)
+	})
+}
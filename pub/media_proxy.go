@@ -0,0 +1,283 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// mediaProxyAcceptHeaderValue is the Accept header value of requests
+	// for remote media.
+	mediaProxyAcceptHeaderValue = "image/*, video/*, audio/*"
+	// mediaProxyContentTypeSuffix is the suffix of the file holding the
+	// Content-Type of a cached media file.
+	mediaProxyContentTypeSuffix = ".content-type"
+	// MediaProxySignatureParam is the query parameter of requests to the
+	// media proxy holding the MediaProxySignature of the remote media IRI.
+	MediaProxySignatureParam = "sig"
+)
+
+// MediaProxyIRIFunc obtains the IRI of the remote media that a request to the
+// media proxy is for, such as from a query parameter.
+type MediaProxyIRIFunc func(r *http.Request) (*url.URL, error)
+
+// MediaProxyFunc serves remote media through this server, so clients never
+// request it from remote hosts directly.
+//
+// If an error is returned, then the calling function is responsible for writing
+// to the ResponseWriter as part of error handling, such as with a 502 Bad
+// Gateway. An error may also be returned after the response has begun being
+// written, if the remote host fails while the media is being streamed.
+type MediaProxyFunc func(c context.Context, w http.ResponseWriter, r *http.Request) error
+
+// MediaCache configures the disk cache of a media proxy.
+type MediaCache struct {
+	// Dir is the directory the media is written to and served from on
+	// later requests, which must exist. Empty disables the cache.
+	Dir string
+	// TTL is how long cached media is served before it is fetched again.
+	// Zero means it never expires.
+	TTL time.Duration
+	// MaxBytes limits the total size of the cached media, removing the
+	// oldest files when exceeded. Zero means no limit.
+	MaxBytes int64
+}
+
+// MediaProxySignature signs the IRI of remote media with the key, so that the
+// media proxy only serves the IRIs this server links to. Links to the proxy
+// carry it in their MediaProxySignatureParam query parameter.
+func MediaProxySignature(key []byte, iri *url.URL) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(iri.String()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// NewMediaProxyHandler creates a MediaProxyFunc that obtains remote media with
+// the Transport, so requests are signed and restricted to public hosts and a
+// maximum size just like any other request made by the Transport.
+//
+// Requests must carry the MediaProxySignature of the IRI with the key, or they
+// are forbidden, so that the proxy cannot be used to fetch arbitrary IRIs.
+//
+// Only image, video, and audio content is served, so that the proxy cannot be
+// used to serve arbitrary documents from this server's origin.
+func NewMediaProxyHandler(t Transport, clock Clock, key []byte, iriFn MediaProxyIRIFunc, cache MediaCache) MediaProxyFunc {
+	// Serializes removing the oldest cached files.
+	var pruneMu sync.Mutex
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) error {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return nil
+		}
+		iri, err := iriFn(r)
+		if err != nil || iri == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		sig := r.URL.Query().Get(MediaProxySignatureParam)
+		if !hmac.Equal([]byte(sig), []byte(MediaProxySignature(key, iri))) {
+			w.WriteHeader(http.StatusForbidden)
+			return nil
+		}
+		var cachePath string
+		if len(cache.Dir) > 0 {
+			sum := sha256.Sum256([]byte(iri.String()))
+			cachePath = filepath.Join(cache.Dir, hex.EncodeToString(sum[:]))
+			if served, err := serveCachedMedia(w, r, cachePath, cache.TTL, clock.Now()); err != nil || served {
+				return err
+			}
+		}
+		body, contentType, err := fetchMedia(c, t, iri)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		setMediaProxyHeaders(w, contentType)
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodHead {
+			return nil
+		}
+		if len(cachePath) == 0 {
+			_, err = io.Copy(w, body)
+			return err
+		}
+		if err = streamAndCacheMedia(w, body, contentType, cachePath, clock.Now()); err != nil || cache.MaxBytes <= 0 {
+			return err
+		}
+		pruneMu.Lock()
+		defer pruneMu.Unlock()
+		pruneMediaCache(cache.Dir, cache.MaxBytes)
+		return nil
+	}
+}
+
+// fetchMedia obtains remote media, streaming it if the Transport is able to.
+//
+// Returns an error if the remote content is not an image, video, or audio.
+func fetchMedia(c context.Context, t Transport, iri *url.URL) (body io.ReadCloser, contentType string, err error) {
+	if sf, ok := t.(streamFetcher); ok {
+		body, contentType, err = sf.FetchStream(c, iri, mediaProxyAcceptHeaderValue)
+	} else {
+		var b []byte
+		b, contentType, err = t.Fetch(c, iri, mediaProxyAcceptHeaderValue)
+		body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	if err != nil {
+		return
+	}
+	if !isProxiedMediaType(contentType) {
+		body.Close()
+		return nil, "", fmt.Errorf("cannot proxy media %s: unsupported content type %q", iri.String(), contentType)
+	}
+	return
+}
+
+// isProxiedMediaType determines whether content of the Content-Type may be
+// served by the media proxy.
+func isProxiedMediaType(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	// SVG images may contain scripts.
+	if mt == "image/svg+xml" {
+		return false
+	}
+	return strings.HasPrefix(mt, "image/") ||
+		strings.HasPrefix(mt, "video/") ||
+		strings.HasPrefix(mt, "audio/")
+}
+
+// setMediaProxyHeaders sets the headers of a proxied media response, preventing
+// browsers from interpreting the media as anything else.
+func setMediaProxyHeaders(w http.ResponseWriter, contentType string) {
+	w.Header().Set(contentTypeHeader, contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+}
+
+// serveCachedMedia serves media from the cache, if present and not older than
+// the TTL. Range requests are supported.
+func serveCachedMedia(w http.ResponseWriter, r *http.Request, path string, ttl time.Duration, now time.Time) (served bool, err error) {
+	contentType, err := ioutil.ReadFile(path + mediaProxyContentTypeSuffix)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+	if ttl > 0 && now.Sub(fi.ModTime()) > ttl {
+		return false, nil
+	}
+	setMediaProxyHeaders(w, string(contentType))
+	http.ServeContent(w, r, "", fi.ModTime(), f)
+	return true, nil
+}
+
+// streamAndCacheMedia writes the media to the response while also writing it
+// to the cache. The cache is only populated if the media was entirely
+// received, and writing to the cache never interrupts the response. The cached
+// file is timestamped now, for its TTL.
+func streamAndCacheMedia(w http.ResponseWriter, body io.Reader, contentType, path string, now time.Time) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		// Serve the media regardless.
+		_, err = io.Copy(w, body)
+		return err
+	}
+	cw := &cacheWriter{f: f}
+	_, err = io.Copy(w, io.TeeReader(body, cw))
+	closeErr := f.Close()
+	if err != nil || cw.err != nil || closeErr != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err = ioutil.WriteFile(path+mediaProxyContentTypeSuffix, []byte(contentType), 0644); err != nil {
+		os.Remove(f.Name())
+		return nil
+	}
+	if err = os.Chtimes(f.Name(), now, now); err != nil {
+		os.Remove(f.Name())
+		return nil
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		os.Remove(path + mediaProxyContentTypeSuffix)
+		return nil
+	}
+	return nil
+}
+
+// pruneMediaCache removes the oldest cached media until the cached media in the
+// directory is no larger than maxBytes. Failures are ignored, as they only
+// leave the cache larger.
+func pruneMediaCache(dir string, maxBytes int64) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	var media []os.FileInfo
+	var total int64
+	for _, fi := range infos {
+		name := fi.Name()
+		if fi.IsDir() || strings.HasSuffix(name, mediaProxyContentTypeSuffix) || strings.HasSuffix(name, ".tmp") {
+			continue
+		}
+		media = append(media, fi)
+		total += fi.Size()
+	}
+	sort.Slice(media, func(i, j int) bool {
+		return media[i].ModTime().Before(media[j].ModTime())
+	})
+	for _, fi := range media {
+		if total <= maxBytes {
+			return
+		}
+		path := filepath.Join(dir, fi.Name())
+		if os.Remove(path) == nil {
+			os.Remove(path + mediaProxyContentTypeSuffix)
+			total -= fi.Size()
+		}
+	}
+}
+
+// cacheWriter writes to a cache file, remembering the first error instead of
+// returning it so a failing cache does not interrupt the response.
+type cacheWriter struct {
+	f   *os.File
+	err error
+}
+
+// Write writes to the cache file unless a previous write failed.
+func (c *cacheWriter) Write(p []byte) (int, error) {
+	if c.err == nil {
+		_, c.err = c.f.Write(p)
+	}
+	return len(p), nil
+}
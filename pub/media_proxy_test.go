@@ -0,0 +1,172 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestMediaProxyHandler(t *testing.T) {
+	ctx := context.Background()
+	mediaIRI := "https://other.example.com/media/1.png"
+	media := []byte("not really a png")
+	iriFn := func(r *http.Request) (*url.URL, error) {
+		u := r.URL.Query().Get("url")
+		if len(u) == 0 {
+			return nil, fmt.Errorf("no url")
+		}
+		return url.Parse(u)
+	}
+	key := []byte("media proxy key")
+	newSignedRequest := func(method, iri, sig string) *http.Request {
+		return httptest.NewRequest(method, "https://example.com/proxy?url="+url.QueryEscape(iri)+"&sig="+sig, nil)
+	}
+	newRequest := func(method string) *http.Request {
+		return newSignedRequest(method, mediaIRI, MediaProxySignature(key, mustParse(mediaIRI)))
+	}
+	newClock := func(ctl *gomock.Controller, at *time.Time) *MockClock {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(func() time.Time {
+			return *at
+		}).AnyTimes()
+		return clock
+	}
+	newCacheDir := func(t *testing.T) string {
+		dir, err := ioutil.TempDir("", "mediaproxy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+	t.Run("ServesMedia", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Fetch(ctx, mustParse(mediaIRI), mediaProxyAcceptHeaderValue).Return(media, "image/png", nil)
+		h := NewMediaProxyHandler(tp, NewMockClock(ctl), key, iriFn, MediaCache{})
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, newRequest(http.MethodGet))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), "image/png")
+		assertEqual(t, resp.Header().Get("X-Content-Type-Options"), "nosniff")
+		assertByteEqual(t, resp.Body.Bytes(), media)
+	})
+	t.Run("RejectsNonMedia", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Fetch(ctx, mustParse(mediaIRI), mediaProxyAcceptHeaderValue).Return([]byte("<html></html>"), "text/html", nil)
+		h := NewMediaProxyHandler(tp, NewMockClock(ctl), key, iriFn, MediaCache{})
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, newRequest(http.MethodGet))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		assertEqual(t, resp.Body.Len(), 0)
+	})
+	t.Run("BadRequestWithoutIRI", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		h := NewMediaProxyHandler(tp, NewMockClock(ctl), key, iriFn, MediaCache{})
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, httptest.NewRequest(http.MethodGet, "https://example.com/proxy", nil))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("ForbidsUnsignedIRI", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		h := NewMediaProxyHandler(tp, NewMockClock(ctl), key, iriFn, MediaCache{})
+		other := "https://other.example.com/media/2.png"
+		for _, r := range []*http.Request{
+			newSignedRequest(http.MethodGet, mediaIRI, ""),
+			newSignedRequest(http.MethodGet, other, MediaProxySignature(key, mustParse(mediaIRI))),
+			newSignedRequest(http.MethodGet, mediaIRI, MediaProxySignature([]byte("other key"), mustParse(mediaIRI))),
+		} {
+			resp := httptest.NewRecorder()
+			err := h(ctx, resp, r)
+			assertEqual(t, err, nil)
+			assertEqual(t, resp.Code, http.StatusForbidden)
+		}
+	})
+	t.Run("ServesFromCache", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir := newCacheDir(t)
+		defer os.RemoveAll(dir)
+		at := now()
+		tp := NewMockTransport(ctl)
+		// Only fetched once.
+		tp.EXPECT().Fetch(ctx, mustParse(mediaIRI), mediaProxyAcceptHeaderValue).Return(media, "image/png", nil)
+		h := NewMediaProxyHandler(tp, newClock(ctl, &at), key, iriFn, MediaCache{Dir: dir})
+		for i := 0; i < 2; i++ {
+			resp := httptest.NewRecorder()
+			err := h(ctx, resp, newRequest(http.MethodGet))
+			assertEqual(t, err, nil)
+			assertEqual(t, resp.Code, http.StatusOK)
+			assertEqual(t, resp.Header().Get(contentTypeHeader), "image/png")
+			assertByteEqual(t, resp.Body.Bytes(), media)
+		}
+	})
+	t.Run("FetchesAgainAfterTTL", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir := newCacheDir(t)
+		defer os.RemoveAll(dir)
+		at := now()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Fetch(ctx, mustParse(mediaIRI), mediaProxyAcceptHeaderValue).Return(media, "image/png", nil).Times(2)
+		h := NewMediaProxyHandler(tp, newClock(ctl, &at), key, iriFn, MediaCache{Dir: dir, TTL: time.Hour})
+		for _, d := range []time.Duration{0, time.Minute, 2 * time.Hour} {
+			at = now().Add(d)
+			resp := httptest.NewRecorder()
+			err := h(ctx, resp, newRequest(http.MethodGet))
+			assertEqual(t, err, nil)
+			assertByteEqual(t, resp.Body.Bytes(), media)
+		}
+	})
+	t.Run("RemovesOldestOverMaxBytes", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		dir := newCacheDir(t)
+		defer os.RemoveAll(dir)
+		at := now()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Fetch(ctx, gomock.Any(), mediaProxyAcceptHeaderValue).Return(media, "image/png", nil).Times(3)
+		max := int64(2 * len(media))
+		h := NewMediaProxyHandler(tp, newClock(ctl, &at), key, iriFn, MediaCache{Dir: dir, MaxBytes: max})
+		for i := 1; i <= 3; i++ {
+			at = now().Add(time.Duration(i) * time.Minute)
+			iri := fmt.Sprintf("https://other.example.com/media/%d.png", i)
+			resp := httptest.NewRecorder()
+			err := h(ctx, resp, newSignedRequest(http.MethodGet, iri, MediaProxySignature(key, mustParse(iri))))
+			assertEqual(t, err, nil)
+		}
+		infos, err := ioutil.ReadDir(dir)
+		assertEqual(t, err, nil)
+		var total int64
+		for _, fi := range infos {
+			if filepath.Ext(fi.Name()) != mediaProxyContentTypeSuffix {
+				total += fi.Size()
+			}
+		}
+		assertEqual(t, total, max)
+		// The first media was removed, so it is fetched again.
+		tp.EXPECT().Fetch(ctx, mustParse(mediaIRI), mediaProxyAcceptHeaderValue).Return(media, "image/png", nil)
+		resp := httptest.NewRecorder()
+		err = h(ctx, resp, newRequest(http.MethodGet))
+		assertEqual(t, err, nil)
+	})
+}
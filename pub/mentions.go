@@ -0,0 +1,61 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// MentionNotifier may be implemented by a FederatingProtocol to deliver the
+// activities of the outbox to the actors their objects mention, even if they
+// are not otherwise addressed, so the mentions notify them across servers.
+//
+// Mentioned actors are those tagged with a Mention whose 'href' is their id,
+// as NewPost does with the Mentions of a Post.
+type MentionNotifier interface {
+	// NotifyMentions determines whether the actors mentioned are added to
+	// the recipients of the deliveries.
+	NotifyMentions(c context.Context) bool
+}
+
+// GetMentions reads the 'href' of the Mentions tagged on an object. Mentions
+// without an 'href' are skipped, and listed by ReadWarnings.
+func GetMentions(o vocab.Type) []*url.URL {
+	t, ok := o.(tagger)
+	if !ok || t.GetActivityStreamsTag() == nil {
+		return nil
+	}
+	var iris []*url.URL
+	tag := t.GetActivityStreamsTag()
+	for iter := tag.Begin(); iter != tag.End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsMention() {
+			continue
+		}
+		href := iter.GetActivityStreamsMention().GetActivityStreamsHref()
+		if href == nil || href.Get() == nil {
+			continue
+		}
+		iris = append(iris, href.Get())
+	}
+	return iris
+}
+
+// mentionedRecipients returns the actors mentioned by the activity and its
+// objects which are not already recipients, if the FederatingProtocol notifies
+// mentions.
+func (a *sideEffectActor) mentionedRecipients(c context.Context, activity Activity, recipients []*IRI) []*IRI {
+	if n, ok := a.s2s.(MentionNotifier); !ok || !n.NotifyMentions(c) {
+		return nil
+	}
+	mentioned := a.iris.InternURLs(GetMentions(activity))
+	if o, ok := activity.(objecter); ok && o.GetActivityStreamsObject() != nil {
+		op := o.GetActivityStreamsObject()
+		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+			if t := iter.GetType(); t != nil {
+				mentioned = append(mentioned, a.iris.InternURLs(GetMentions(t))...)
+			}
+		}
+	}
+	return dedupeIRIs(mentioned, recipients)
+}
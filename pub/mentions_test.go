@@ -0,0 +1,62 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// mentionNotifierProtocol is a FederatingProtocol notifying mentions.
+type mentionNotifierProtocol struct {
+	*MockFederatingProtocol
+}
+
+func (m *mentionNotifierProtocol) NotifyMentions(c context.Context) bool {
+	return true
+}
+
+func TestMentions(t *testing.T) {
+	ctx := context.Background()
+	mentioningCreate := func() vocab.ActivityStreamsCreate {
+		note := streams.NewActivityStreamsNote()
+		tag := streams.NewActivityStreamsTagProperty()
+		for _, iri := range []string{testFederatedActorIRI, testFederatedActorIRI2} {
+			m := streams.NewActivityStreamsMention()
+			href := streams.NewActivityStreamsHrefProperty()
+			href.Set(mustParse(iri))
+			m.SetActivityStreamsHref(href)
+			tag.AppendActivityStreamsMention(m)
+		}
+		tag.AppendActivityStreamsMention(streams.NewActivityStreamsMention())
+		note.SetActivityStreamsTag(tag)
+		create := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		create.SetActivityStreamsObject(op)
+		return create
+	}
+	t.Run("GetsMentionsWithHref", func(t *testing.T) {
+		op := mentioningCreate().GetActivityStreamsObject()
+		assertEqual(t, fmt.Sprint(GetMentions(op.At(0).GetType())),
+			fmt.Sprintf("[%s %s]", testFederatedActorIRI, testFederatedActorIRI2))
+	})
+	t.Run("AddsMentionedActorsNotAddressed", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := &sideEffectActor{s2s: &mentionNotifierProtocol{NewMockFederatingProtocol(ctl)}}
+		added := a.mentionedRecipients(ctx, mentioningCreate(), []*IRI{NewIRI(testFederatedActorIRI)})
+		assertEqual(t, len(added), 1)
+		assertEqual(t, added[0].String(), testFederatedActorIRI2)
+	})
+	t.Run("DoesNotAddMentionedActorsByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := &sideEffectActor{s2s: NewMockFederatingProtocol(ctl)}
+		added := a.mentionedRecipients(ctx, mentioningCreate(), []*IRI{})
+		assertEqual(t, len(added), 0)
+	})
+}
@@ -0,0 +1,168 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// DatabaseScanner may be implemented by a Database to list the ids of its
+// entries, so they can be migrated to another Database.
+type DatabaseScanner interface {
+	// Scan calls fn with the id of every entry in the database, in any
+	// order. Scanning stops at the first error returned by fn, which Scan
+	// returns.
+	Scan(c context.Context, fn func(id *url.URL) error) error
+}
+
+// MigrationReport summarizes a migration between Databases.
+type MigrationReport struct {
+	// Entries is the number of entries copied.
+	Entries int
+	// Inboxes is the number of actor inboxes copied.
+	Inboxes int
+	// Outboxes is the number of actor outboxes copied.
+	Outboxes int
+	// Mismatched holds the ids of the entries, inboxes, and outboxes that
+	// differ from the source when read back from the destination, such as
+	// when the destination does not store every property.
+	Mismatched []*url.URL
+}
+
+// Migrate copies every entry of the source Database to the destination, along
+// with the inboxes and outboxes of the actors among them, and verifies that each
+// copy reads back the same as the source. Entries are copied one at a time, so
+// databases of any size can be migrated.
+//
+// The source must implement DatabaseScanner. Inboxes and outboxes are copied as
+// the pages returned by GetInbox and GetOutbox. Entries already in the
+// destination are updated, so an interrupted migration may be run again.
+//
+// Neither database may be used by an Actor during the migration.
+func Migrate(c context.Context, src, dst Database) (MigrationReport, error) {
+	var r MigrationReport
+	scanner, ok := src.(DatabaseScanner)
+	if !ok {
+		return r, fmt.Errorf("source database of type %T cannot be scanned", src)
+	}
+	err := scanner.Scan(c, func(id *url.URL) error {
+		t, err := migrateEntry(c, src, dst, id, &r)
+		if err != nil {
+			return err
+		}
+		if i, ok := t.(inboxer); ok && i.GetActivityStreamsInbox() != nil {
+			inbox, err := ToId(i.GetActivityStreamsInbox())
+			if err != nil {
+				return err
+			}
+			if err = migrateBox(c, src, dst, inbox, true, &r); err != nil {
+				return err
+			}
+			r.Inboxes++
+		}
+		if o, ok := t.(outboxer); ok && o.GetActivityStreamsOutbox() != nil {
+			outbox, err := ToId(o.GetActivityStreamsOutbox())
+			if err != nil {
+				return err
+			}
+			if err = migrateBox(c, src, dst, outbox, false, &r); err != nil {
+				return err
+			}
+			r.Outboxes++
+		}
+		return nil
+	})
+	return r, err
+}
+
+// migrateEntry copies the entry from the source to the destination, verifies
+// it, and returns it.
+func migrateEntry(c context.Context, src, dst Database, id *url.URL, r *MigrationReport) (vocab.Type, error) {
+	if err := src.Lock(c, id); err != nil {
+		return nil, err
+	}
+	t, err := src.Get(c, id)
+	src.Unlock(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = dst.Lock(c, id); err != nil {
+		return nil, err
+	}
+	defer dst.Unlock(c, id)
+	exists, err := dst.Exists(c, id)
+	if err != nil {
+		return nil, err
+	} else if exists {
+		err = dst.Update(c, t)
+	} else {
+		err = dst.Create(c, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.Entries++
+	copied, err := dst.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if same, err := sameSerialization(t, copied); err != nil {
+		return nil, err
+	} else if !same {
+		r.Mismatched = append(r.Mismatched, id)
+	}
+	return t, nil
+}
+
+// migrateBox copies the inbox or outbox page from the source to the
+// destination, and verifies it.
+func migrateBox(c context.Context, src, dst Database, box *url.URL, inbox bool, r *MigrationReport) error {
+	get, set, getCopy := src.GetOutbox, dst.SetOutbox, dst.GetOutbox
+	if inbox {
+		get, set, getCopy = src.GetInbox, dst.SetInbox, dst.GetInbox
+	}
+	if err := src.Lock(c, box); err != nil {
+		return err
+	}
+	page, err := get(c, box)
+	src.Unlock(c, box)
+	if err != nil {
+		return err
+	}
+	if err = dst.Lock(c, box); err != nil {
+		return err
+	}
+	defer dst.Unlock(c, box)
+	if err = set(c, page); err != nil {
+		return err
+	}
+	copied, err := getCopy(c, box)
+	if err != nil {
+		return err
+	}
+	if same, err := sameSerialization(page, copied); err != nil {
+		return err
+	} else if !same {
+		r.Mismatched = append(r.Mismatched, box)
+	}
+	return nil
+}
+
+// sameSerialization determines whether the values serialize the same.
+func sameSerialization(a, b vocab.Type) (bool, error) {
+	if a == nil || b == nil {
+		return a == b, nil
+	}
+	am, err := a.Serialize()
+	if err != nil {
+		return false, err
+	}
+	bm, err := b.Serialize()
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(am, bm), nil
+}
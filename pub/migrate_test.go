@@ -0,0 +1,160 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// mapDatabase is a Database keeping its entries and boxes in maps.
+type mapDatabase struct {
+	Database
+	entries map[string]vocab.Type
+	boxes   map[string]vocab.ActivityStreamsOrderedCollectionPage
+	// lossy drops the content of entries when they are written.
+	lossy bool
+}
+
+func newMapDatabase() *mapDatabase {
+	return &mapDatabase{
+		entries: make(map[string]vocab.Type),
+		boxes:   make(map[string]vocab.ActivityStreamsOrderedCollectionPage),
+	}
+}
+
+func (m *mapDatabase) Lock(c context.Context, id *url.URL) error   { return nil }
+func (m *mapDatabase) Unlock(c context.Context, id *url.URL) error { return nil }
+
+func (m *mapDatabase) Scan(c context.Context, fn func(id *url.URL) error) error {
+	var ids []string
+	for id := range m.entries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if err := fn(mustParse(id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mapDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	_, ok := m.entries[id.String()]
+	return ok, nil
+}
+
+func (m *mapDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	t, ok := m.entries[id.String()]
+	if !ok {
+		return nil, fmt.Errorf("no entry %s", id)
+	}
+	return t, nil
+}
+
+func (m *mapDatabase) Create(c context.Context, t vocab.Type) error {
+	id, err := GetId(t)
+	if err != nil {
+		return err
+	}
+	if m.lossy {
+		if n, ok := t.(vocab.ActivityStreamsNote); ok {
+			lossy := streams.NewActivityStreamsNote()
+			lossy.SetActivityStreamsId(n.GetActivityStreamsId())
+			t = lossy
+		}
+	}
+	m.entries[id.String()] = t
+	return nil
+}
+
+func (m *mapDatabase) Update(c context.Context, t vocab.Type) error {
+	return m.Create(c, t)
+}
+
+func (m *mapDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return m.boxes[inboxIRI.String()], nil
+}
+
+func (m *mapDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.boxes[inbox.GetActivityStreamsId().Get().String()] = inbox
+	return nil
+}
+
+func (m *mapDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return m.GetInbox(c, outboxIRI)
+}
+
+func (m *mapDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return m.SetInbox(c, outbox)
+}
+
+// newBoxPage creates an ordered collection page of the box with the items.
+func newBoxPage(box string, items ...string) vocab.ActivityStreamsOrderedCollectionPage {
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(box))
+	page.SetActivityStreamsId(id)
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range items {
+		oi.AppendIRI(mustParse(item))
+	}
+	page.SetActivityStreamsOrderedItems(oi)
+	return page
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func() *mapDatabase {
+		setupData()
+		src := newMapDatabase()
+		person := streams.NewActivityStreamsPerson()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testPersonIRI))
+		person.SetActivityStreamsId(id)
+		inbox := streams.NewActivityStreamsInboxProperty()
+		inbox.SetIRI(mustParse(testMyInboxIRI))
+		person.SetActivityStreamsInbox(inbox)
+		outbox := streams.NewActivityStreamsOutboxProperty()
+		outbox.SetIRI(mustParse(testMyOutboxIRI))
+		person.SetActivityStreamsOutbox(outbox)
+		src.entries[testPersonIRI] = person
+		src.entries[testNoteId1] = testMyNote
+		src.boxes[testMyInboxIRI] = newBoxPage(testMyInboxIRI, testFederatedActivityIRI)
+		src.boxes[testMyOutboxIRI] = newBoxPage(testMyOutboxIRI, testNoteId1)
+		return src
+	}
+	t.Run("CopiesEntriesAndBoxes", func(t *testing.T) {
+		src, dst := setupFn(), newMapDatabase()
+		r, err := Migrate(ctx, src, dst)
+		assertEqual(t, err, nil)
+		assertEqual(t, r.Entries, 2)
+		assertEqual(t, r.Inboxes, 1)
+		assertEqual(t, r.Outboxes, 1)
+		assertEqual(t, len(r.Mismatched), 0)
+		assertEqual(t, dst.entries[testNoteId1], testMyNote)
+		assertEqual(t, dst.boxes[testMyInboxIRI], src.boxes[testMyInboxIRI])
+		assertEqual(t, dst.boxes[testMyOutboxIRI], src.boxes[testMyOutboxIRI])
+		// Running again updates the copies.
+		r, err = Migrate(ctx, src, dst)
+		assertEqual(t, err, nil)
+		assertEqual(t, r.Entries, 2)
+		assertEqual(t, len(dst.entries), 2)
+	})
+	t.Run("ReportsMismatches", func(t *testing.T) {
+		src, dst := setupFn(), newMapDatabase()
+		dst.lossy = true
+		r, err := Migrate(ctx, src, dst)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(r.Mismatched), "["+testNoteId1+"]")
+	})
+	t.Run("RequiresScanner", func(t *testing.T) {
+		_, err := Migrate(ctx, struct{ Database }{}, newMapDatabase())
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -50,6 +50,22 @@ func (mr *MockTransportMockRecorder) Dereference(c, iri interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dereference", reflect.TypeOf((*MockTransport)(nil).Dereference), c, iri)
 }
 
+// Fetch mocks base method
+func (m *MockTransport) Fetch(c context.Context, iri *url.URL, accept string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", c, iri, accept)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Fetch indicates an expected call of Fetch
+func (mr *MockTransportMockRecorder) Fetch(c, iri, accept interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockTransport)(nil).Fetch), c, iri, accept)
+}
+
 // Deliver mocks base method
 func (m *MockTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,137 @@
+package pub
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// NegativeCacheTTLs are how long failed GET requests are remembered, per kind of
+// failure. A zero duration does not remember the failure.
+type NegativeCacheTTLs struct {
+	// NotFound is how long an IRI responding with 404 Not Found is not
+	// fetched again.
+	NotFound time.Duration
+	// Gone is how long an IRI responding with 410 Gone is not fetched
+	// again.
+	Gone time.Duration
+	// Timeout is how long an IRI whose request timed out is not fetched
+	// again.
+	Timeout time.Duration
+}
+
+// DefaultNegativeCacheTTLs are short enough for peers to recover, while
+// preventing every inbound reply from fetching the same missing ancestor.
+var DefaultNegativeCacheTTLs = NegativeCacheTTLs{
+	NotFound: 5 * time.Minute,
+	Gone:     time.Hour,
+	Timeout:  time.Minute,
+}
+
+// ttlFor returns how long the failure is remembered, or zero if it is not.
+func (t NegativeCacheTTLs) ttlFor(err error) time.Duration {
+	if se, ok := err.(*StatusError); ok {
+		switch se.StatusCode {
+		case http.StatusNotFound:
+			return t.NotFound
+		case http.StatusGone:
+			return t.Gone
+		}
+		return 0
+	}
+	if isTimeout(err) {
+		return t.Timeout
+	}
+	return 0
+}
+
+// isTimeout determines whether the error is that of a request that timed out.
+func isTimeout(err error) bool {
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	if ue, ok := err.(*url.Error); ok {
+		err = ue.Err
+		if err == context.DeadlineExceeded {
+			return true
+		}
+	}
+	ne, ok := err.(net.Error)
+	return ok && ne.Timeout()
+}
+
+// negativeCacheSweepSize is the number of remembered failures above which the
+// expired ones are removed when another is remembered.
+const negativeCacheSweepSize = 1024
+
+// negativeCacheEntry is a remembered failure.
+type negativeCacheEntry struct {
+	err     error
+	expires time.Time
+}
+
+// negativeCache remembers the recent failures of GET requests per IRI, so they
+// are not retried until they expire. It is safe for concurrent use.
+type negativeCache struct {
+	mu      sync.Mutex
+	clock   Clock
+	ttls    NegativeCacheTTLs
+	entries map[string]negativeCacheEntry
+}
+
+// newNegativeCache creates an empty negativeCache.
+func newNegativeCache(clock Clock, ttls NegativeCacheTTLs) *negativeCache {
+	return &negativeCache{
+		clock:   clock,
+		ttls:    ttls,
+		entries: make(map[string]negativeCacheEntry),
+	}
+}
+
+// get returns the remembered failure of the IRI, if it has not expired.
+func (n *negativeCache) get(iri *url.URL) error {
+	k := iri.String()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	e, ok := n.entries[k]
+	if !ok {
+		return nil
+	}
+	if !n.clock.Now().Before(e.expires) {
+		delete(n.entries, k)
+		return nil
+	}
+	return e.err
+}
+
+// observe remembers the failure of the IRI, if its kind is remembered.
+func (n *negativeCache) observe(iri *url.URL, err error) {
+	ttl := n.ttls.ttlFor(err)
+	if ttl <= 0 {
+		return
+	}
+	now := n.clock.Now()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.entries) >= negativeCacheSweepSize {
+		for k, e := range n.entries {
+			if !now.Before(e.expires) {
+				delete(n.entries, k)
+			}
+		}
+	}
+	n.entries[iri.String()] = negativeCacheEntry{
+		err:     err,
+		expires: now.Add(ttl),
+	}
+}
+
+// forget removes the remembered failure of the IRI.
+func (n *negativeCache) forget(iri *url.URL) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.entries, iri.String())
+}
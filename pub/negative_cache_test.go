@@ -0,0 +1,102 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+// timeoutError is a net.Error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestHttpSigTransportNegativeCache(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller, now *time.Time, respond func() (*http.Response, error)) (tp *HttpSigTransport, requests *int) {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(func() time.Time { return *now }).AnyTimes()
+		requests = new(int)
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			*requests++
+			return respond()
+		})
+		tp = NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+		tp.SetNegativeCacheTTLs(DefaultNegativeCacheTTLs)
+		return
+	}
+	t.Run("RemembersNotFoundUntilExpired", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		tp, requests := setupFn(ctl, &now, func() (*http.Response, error) {
+			return newResponse(http.StatusNotFound), nil
+		})
+		for i := 0; i < 3; i++ {
+			_, err := tp.Dereference(ctx, mustParse(testNoteId1))
+			assertNotEqual(t, err, nil)
+		}
+		assertEqual(t, *requests, 1)
+		now = now.Add(DefaultNegativeCacheTTLs.NotFound)
+		_, err := tp.Dereference(ctx, mustParse(testNoteId1))
+		assertNotEqual(t, err, nil)
+		assertEqual(t, *requests, 2)
+	})
+	t.Run("RemembersTimeouts", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		tp, requests := setupFn(ctl, &now, func() (*http.Response, error) {
+			return nil, &url.Error{Op: "Get", URL: testNoteId1, Err: timeoutError{}}
+		})
+		for i := 0; i < 2; i++ {
+			_, err := tp.Dereference(ctx, mustParse(testNoteId1))
+			assertNotEqual(t, err, nil)
+		}
+		assertEqual(t, *requests, 1)
+	})
+	t.Run("DoesNotRememberOtherFailures", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		tp, requests := setupFn(ctl, &now, func() (*http.Response, error) {
+			return newResponse(http.StatusInternalServerError), nil
+		})
+		for i := 0; i < 2; i++ {
+			_, err := tp.Dereference(ctx, mustParse(testNoteId1))
+			assertNotEqual(t, err, nil)
+		}
+		assertEqual(t, *requests, 2)
+	})
+	t.Run("ForgetsFailure", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		tp, requests := setupFn(ctl, &now, func() (*http.Response, error) {
+			return newResponse(http.StatusGone), nil
+		})
+		_, err := tp.Dereference(ctx, mustParse(testNoteId1))
+		assertNotEqual(t, err, nil)
+		tp.ForgetFailure(mustParse(testNoteId1))
+		_, err = tp.Dereference(ctx, mustParse(testNoteId1))
+		assertNotEqual(t, err, nil)
+		assertEqual(t, *requests, 2)
+	})
+}
@@ -0,0 +1,172 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// nodeinfoWellKnownPath is the path of the document linking to a host's
+	// NodeInfo documents.
+	nodeinfoWellKnownPath = "/.well-known/nodeinfo"
+	// nodeinfoSchemaRelPrefix prefixes the rel of the links to NodeInfo
+	// documents, followed by the schema version.
+	nodeinfoSchemaRelPrefix = "http://nodeinfo.diaspora.software/ns/schema/"
+	// nodeinfoAcceptHeaderValue is the Accept header value of NodeInfo
+	// requests.
+	nodeinfoAcceptHeaderValue = "application/json"
+)
+
+// nodeinfoLinks is the document at a host's nodeinfoWellKnownPath.
+type nodeinfoLinks struct {
+	Links []struct {
+		Rel  string `json:"rel"`
+		Href string `json:"href"`
+	} `json:"links"`
+}
+
+// nodeinfoDocument holds the parts of a NodeInfo document describing a peer's
+// capabilities.
+type nodeinfoDocument struct {
+	Software struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"software"`
+	Protocols []string `json:"protocols"`
+}
+
+// PeerInfo describes the software a peer host runs, as published in its
+// NodeInfo.
+type PeerInfo struct {
+	// Host is the peer host, such as "example.com".
+	Host string
+	// SoftwareName is the lowercase name of the peer's software, such as
+	// "mastodon", or empty if unknown.
+	SoftwareName string
+	// SoftwareVersion is the version of the peer's software, if known.
+	SoftwareVersion string
+	// Protocols are the protocols the peer supports, such as
+	// "activitypub".
+	Protocols []string
+	// Fetched is when the NodeInfo was obtained.
+	Fetched time.Time
+}
+
+// IsSoftware determines whether the peer runs any of the named software, such
+// as "mastodon" or "pleroma", ignoring case.
+func (p PeerInfo) IsSoftware(names ...string) bool {
+	for _, n := range names {
+		if len(p.SoftwareName) > 0 && strings.EqualFold(p.SoftwareName, n) {
+			return true
+		}
+	}
+	return false
+}
+
+// peerInfoEntry is a cached probe of a peer host.
+type peerInfoEntry struct {
+	info    PeerInfo
+	err     error
+	expires time.Time
+}
+
+// PeerProber detects the software of the peer hosts this server federates with
+// from their NodeInfo, caching it for a time, so compatibility shims and
+// delivery policies may adapt to known peer behaviors. It is safe for
+// concurrent use.
+type PeerProber struct {
+	mu      sync.Mutex
+	t       Transport
+	clock   Clock
+	ttl     time.Duration
+	entries map[string]peerInfoEntry
+}
+
+// NewPeerProber creates a PeerProber obtaining NodeInfo documents with the
+// Transport, and caching the PeerInfo of each host for the duration of the ttl.
+// Failures to obtain a host's NodeInfo are cached as well, so unresponsive hosts
+// are not probed on every request.
+func NewPeerProber(t Transport, clock Clock, ttl time.Duration) *PeerProber {
+	return &PeerProber{
+		t:       t,
+		clock:   clock,
+		ttl:     ttl,
+		entries: make(map[string]peerInfoEntry),
+	}
+}
+
+// Probe returns the PeerInfo of the host, obtaining its NodeInfo unless it is
+// cached.
+//
+// The Transport is never used concurrently, so probes of uncached hosts are
+// made one at a time.
+func (p *PeerProber) Probe(c context.Context, host string) (PeerInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.entries[host]; ok && p.clock.Now().Before(e.expires) {
+		return e.info, e.err
+	}
+	info, err := p.fetch(c, host)
+	p.entries[host] = peerInfoEntry{
+		info:    info,
+		err:     err,
+		expires: p.clock.Now().Add(p.ttl),
+	}
+	return info, err
+}
+
+// Forget removes the cached PeerInfo of the host, such as after the peer is
+// known to have upgraded its software.
+func (p *PeerProber) Forget(host string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.entries, host)
+}
+
+// fetch obtains the PeerInfo of the host from the NodeInfo document of the
+// highest schema version it links to.
+func (p *PeerProber) fetch(c context.Context, host string) (PeerInfo, error) {
+	info := PeerInfo{Host: host, Fetched: p.clock.Now()}
+	wellKnown := &url.URL{Scheme: "https", Host: host, Path: nodeinfoWellKnownPath}
+	b, _, err := p.t.Fetch(c, wellKnown, nodeinfoAcceptHeaderValue)
+	if err != nil {
+		return info, err
+	}
+	var links nodeinfoLinks
+	if err = json.Unmarshal(b, &links); err != nil {
+		return info, err
+	}
+	var href, version string
+	for _, l := range links.Links {
+		if !strings.HasPrefix(l.Rel, nodeinfoSchemaRelPrefix) || len(l.Href) == 0 {
+			continue
+		}
+		if v := strings.TrimPrefix(l.Rel, nodeinfoSchemaRelPrefix); v > version {
+			href, version = l.Href, v
+		}
+	}
+	if len(href) == 0 {
+		return info, fmt.Errorf("host %s links to no nodeinfo", host)
+	}
+	iri, err := url.Parse(href)
+	if err != nil {
+		return info, err
+	}
+	b, _, err = p.t.Fetch(c, iri, nodeinfoAcceptHeaderValue)
+	if err != nil {
+		return info, err
+	}
+	var doc nodeinfoDocument
+	if err = json.Unmarshal(b, &doc); err != nil {
+		return info, err
+	}
+	info.SoftwareName = strings.ToLower(doc.Software.Name)
+	info.SoftwareVersion = doc.Software.Version
+	info.Protocols = doc.Protocols
+	return info, nil
+}
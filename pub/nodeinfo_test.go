@@ -0,0 +1,59 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestPeerProber(t *testing.T) {
+	ctx := context.Background()
+	wellKnown := []byte(`{"links":[
+		{"rel":"http://nodeinfo.diaspora.software/ns/schema/2.0","href":"https://other.example.com/nodeinfo/2.0"},
+		{"rel":"http://nodeinfo.diaspora.software/ns/schema/2.1","href":"https://other.example.com/nodeinfo/2.1"}
+	]}`)
+	doc := []byte(`{"version":"2.1","software":{"name":"Mastodon","version":"4.2.0"},"protocols":["activitypub"]}`)
+	t.Run("ProbesAndCaches", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+		tp := NewMockTransport(ctl)
+		gomock.InOrder(
+			tp.EXPECT().Fetch(ctx, mustParse("https://other.example.com/.well-known/nodeinfo"), nodeinfoAcceptHeaderValue).Return(wellKnown, "application/json", nil),
+			tp.EXPECT().Fetch(ctx, mustParse("https://other.example.com/nodeinfo/2.1"), nodeinfoAcceptHeaderValue).Return(doc, "application/json", nil),
+		)
+		p := NewPeerProber(tp, clock, time.Hour)
+		for i := 0; i < 2; i++ {
+			info, err := p.Probe(ctx, "other.example.com")
+			assertEqual(t, err, nil)
+			assertEqual(t, info.SoftwareName, "mastodon")
+			assertEqual(t, info.SoftwareVersion, "4.2.0")
+			assertEqual(t, fmt.Sprint(info.Protocols), "[activitypub]")
+			assertEqual(t, info.IsSoftware("pleroma", "Mastodon"), true)
+		}
+	})
+	t.Run("CachesFailures", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+		tp := NewMockTransport(ctl)
+		fetchErr := fmt.Errorf("not found")
+		tp.EXPECT().Fetch(ctx, mustParse("https://other.example.com/.well-known/nodeinfo"), nodeinfoAcceptHeaderValue).Return(nil, "", fetchErr).Times(2)
+		p := NewPeerProber(tp, clock, time.Hour)
+		_, err := p.Probe(ctx, "other.example.com")
+		assertEqual(t, err, fetchErr)
+		_, err = p.Probe(ctx, "other.example.com")
+		assertEqual(t, err, fetchErr)
+		// Probed again once expired.
+		now = now.Add(time.Hour)
+		_, err = p.Probe(ctx, "other.example.com")
+		assertEqual(t, err, fetchErr)
+	})
+}
@@ -0,0 +1,332 @@
+// Package oauth authenticates the requests of the Social API with OAuth 2.0
+// bearer tokens, so applications become client-to-server servers without
+// building the authentication of their clients themselves.
+//
+// Applications issue the tokens from their own authorization endpoints, with a
+// TokenIssuer such as MemoryTokens, and wrap their pub.SocialProtocol and
+// pub.CommonBehavior with an Authenticator validating them:
+//
+//	tokens := oauth.NewMemoryTokens(clock, 24*time.Hour)
+//	auth := &oauth.Authenticator{Tokens: tokens, Database: db, Clock: clock}
+//	actor := pub.NewSocialActor(auth.CommonBehavior(common), auth.SocialProtocol(c2s), db, clock)
+//
+// Tokens are sent in the Authorization header of the requests, as described in
+// RFC 6750:
+//
+//	Authorization: Bearer mF_9.B5f-4.1JqM
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+const (
+	// ScopeRead allows reading the inbox of the actor of a token.
+	ScopeRead = "read"
+	// ScopeWrite allows posting to the outbox of the actor of a token.
+	ScopeWrite = "write"
+	// authorizationHeader is the header carrying bearer tokens.
+	authorizationHeader = "Authorization"
+	// wwwAuthenticateHeader is the header challenging clients for tokens.
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	// bearerPrefix prefixes the bearer tokens in the Authorization header.
+	bearerPrefix = "Bearer "
+	// tokenBytes is the number of random bytes of the tokens issued by
+	// MemoryTokens.
+	tokenBytes = 32
+)
+
+// Token is an access token issued to a client acting as an actor.
+type Token struct {
+	// AccessToken is the opaque value sent by the client.
+	AccessToken string
+	// Actor is the IRI of the actor the client acts as.
+	Actor *url.URL
+	// Scopes are what the client is allowed to do, such as ScopeWrite.
+	Scopes []string
+	// Expires is when the token stops being valid. Zero never expires.
+	Expires time.Time
+}
+
+// HasScope returns whether the token was granted the scope.
+func (t Token) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired returns whether the token is no longer valid at the time.
+func (t Token) Expired(now time.Time) bool {
+	return !t.Expires.IsZero() && !now.Before(t.Expires)
+}
+
+// TokenIssuer issues tokens, from the authorization endpoints of the
+// application once the user approved a client.
+type TokenIssuer interface {
+	// IssueToken issues a token for the actor, granted the scopes.
+	IssueToken(c context.Context, actorIRI *url.URL, scopes []string) (Token, error)
+}
+
+// TokenValidator finds the tokens sent by clients.
+type TokenValidator interface {
+	// ValidateToken returns the token of the access token, and false if it
+	// is unknown or revoked. Expired tokens may be returned, the
+	// Authenticator refuses them.
+	ValidateToken(c context.Context, accessToken string) (t Token, ok bool, err error)
+}
+
+// MemoryTokens issues random tokens and keeps them in memory, which suits
+// tests and servers of one process. It is safe for concurrent use.
+type MemoryTokens struct {
+	clock  pub.Clock
+	ttl    time.Duration
+	mu     sync.Mutex
+	tokens map[string]Token
+}
+
+// TokenIssuer and TokenValidator must be implemented by MemoryTokens.
+var (
+	_ TokenIssuer    = &MemoryTokens{}
+	_ TokenValidator = &MemoryTokens{}
+)
+
+// NewMemoryTokens creates a MemoryTokens issuing tokens expiring after the
+// ttl, or never if it is zero.
+func NewMemoryTokens(clock pub.Clock, ttl time.Duration) *MemoryTokens {
+	return &MemoryTokens{
+		clock:  clock,
+		ttl:    ttl,
+		tokens: make(map[string]Token),
+	}
+}
+
+// IssueToken issues a random token for the actor.
+func (m *MemoryTokens) IssueToken(c context.Context, actorIRI *url.URL, scopes []string) (Token, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return Token{}, err
+	}
+	t := Token{
+		AccessToken: base64.RawURLEncoding.EncodeToString(b),
+		Actor:       actorIRI,
+		Scopes:      append([]string(nil), scopes...),
+	}
+	if m.ttl > 0 {
+		t.Expires = m.clock.Now().Add(m.ttl)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[t.AccessToken] = t
+	return t, nil
+}
+
+// ValidateToken returns the token issued with the access token, dropping it
+// once expired.
+func (m *MemoryTokens) ValidateToken(c context.Context, accessToken string) (Token, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[accessToken]
+	if ok && t.Expired(m.clock.Now()) {
+		delete(m.tokens, accessToken)
+		return Token{}, false, nil
+	}
+	return t, ok, nil
+}
+
+// RevokeToken invalidates the access token.
+func (m *MemoryTokens) RevokeToken(c context.Context, accessToken string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tokens, accessToken)
+	return nil
+}
+
+// BearerToken returns the bearer token of the Authorization header of the
+// request.
+func BearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get(authorizationHeader)
+	if len(h) < len(bearerPrefix) || !strings.EqualFold(h[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	t := strings.TrimSpace(h[len(bearerPrefix):])
+	return t, len(t) > 0
+}
+
+// Authenticator authenticates the requests of the Social API with the bearer
+// tokens of the Tokens, and authorizes them for the actors of the tokens.
+//
+// Requests without a valid token are responded to with 401 Unauthorized, and
+// those whose token does not allow them with 403 Forbidden, both challenging
+// the client as RFC 6750 describes.
+type Authenticator struct {
+	// Tokens validates the tokens.
+	Tokens TokenValidator
+	// Database finds the actors of the inboxes and outboxes.
+	Database pub.Database
+	// Clock expires the tokens.
+	Clock pub.Clock
+}
+
+// AuthenticatePostOutbox requires a token of the actor of the outbox, granted
+// ScopeWrite.
+func (a *Authenticator) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	t, ok, err := a.token(c, w, r)
+	if err != nil || !ok {
+		return false, err
+	}
+	authorized, err := a.AuthorizePostOutbox(c, t, boxIRI(r))
+	if err != nil {
+		return false, err
+	} else if !authorized {
+		challenge(w, http.StatusForbidden, "insufficient_scope")
+	}
+	return authorized, nil
+}
+
+// AuthorizePostOutbox returns whether the token allows posting to the outbox.
+func (a *Authenticator) AuthorizePostOutbox(c context.Context, t Token, outboxIRI *url.URL) (bool, error) {
+	if !t.HasScope(ScopeWrite) {
+		return false, nil
+	}
+	if err := a.Database.Lock(c, outboxIRI); err != nil {
+		return false, err
+	}
+	actorIRI, err := a.Database.ActorForOutbox(c, outboxIRI)
+	a.Database.Unlock(c, outboxIRI)
+	if err != nil {
+		return false, err
+	}
+	return sameIRI(actorIRI, t.Actor), nil
+}
+
+// AuthenticateGetInbox requires a token of the actor of the inbox, granted
+// ScopeRead.
+func (a *Authenticator) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	t, ok, err := a.token(c, w, r)
+	if err != nil || !ok {
+		return false, err
+	}
+	authorized, err := a.AuthorizeGetInbox(c, t, boxIRI(r))
+	if err != nil {
+		return false, err
+	} else if !authorized {
+		challenge(w, http.StatusForbidden, "insufficient_scope")
+	}
+	return authorized, nil
+}
+
+// AuthorizeGetInbox returns whether the token allows reading the inbox.
+func (a *Authenticator) AuthorizeGetInbox(c context.Context, t Token, inboxIRI *url.URL) (bool, error) {
+	if !t.HasScope(ScopeRead) {
+		return false, nil
+	}
+	if err := a.Database.Lock(c, inboxIRI); err != nil {
+		return false, err
+	}
+	actorIRI, err := a.Database.ActorForInbox(c, inboxIRI)
+	a.Database.Unlock(c, inboxIRI)
+	if err != nil {
+		return false, err
+	}
+	return sameIRI(actorIRI, t.Actor), nil
+}
+
+// SocialProtocol wraps the SocialProtocol, authenticating its POSTs to
+// outboxes with AuthenticatePostOutbox.
+func (a *Authenticator) SocialProtocol(p pub.SocialProtocol) pub.SocialProtocol {
+	return socialProtocol{SocialProtocol: p, a: a}
+}
+
+// CommonBehavior wraps the CommonBehavior, authenticating its GETs of inboxes
+// with AuthenticateGetInbox. Outboxes stay public, as their GetOutbox filters
+// what each requester may see.
+func (a *Authenticator) CommonBehavior(b pub.CommonBehavior) pub.CommonBehavior {
+	return commonBehavior{CommonBehavior: b, a: a}
+}
+
+// token returns the valid token of the request, responding with 401
+// Unauthorized if there is none.
+func (a *Authenticator) token(c context.Context, w http.ResponseWriter, r *http.Request) (Token, bool, error) {
+	accessToken, ok := BearerToken(r)
+	if !ok {
+		challenge(w, http.StatusUnauthorized, "")
+		return Token{}, false, nil
+	}
+	t, ok, err := a.ValidToken(c, accessToken)
+	if err != nil {
+		return Token{}, false, err
+	} else if !ok {
+		challenge(w, http.StatusUnauthorized, "invalid_token")
+		return Token{}, false, nil
+	}
+	return t, true, nil
+}
+
+// ValidToken returns the token of the access token, and false if it is
+// unknown, revoked, or expired.
+func (a *Authenticator) ValidToken(c context.Context, accessToken string) (Token, bool, error) {
+	t, ok, err := a.Tokens.ValidateToken(c, accessToken)
+	if err != nil || !ok || t.Expired(a.Clock.Now()) {
+		return Token{}, false, err
+	}
+	return t, true, nil
+}
+
+// socialProtocol is a SocialProtocol authenticated by an Authenticator.
+type socialProtocol struct {
+	pub.SocialProtocol
+	a *Authenticator
+}
+
+func (s socialProtocol) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return s.a.AuthenticatePostOutbox(c, w, r)
+}
+
+// commonBehavior is a CommonBehavior authenticated by an Authenticator.
+type commonBehavior struct {
+	pub.CommonBehavior
+	a *Authenticator
+}
+
+func (b commonBehavior) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return b.a.AuthenticateGetInbox(c, w, r)
+}
+
+// challenge responds with the status, challenging the client for a bearer
+// token. The error is omitted for requests without any token, as RFC 6750
+// recommends.
+func challenge(w http.ResponseWriter, status int, errorCode string) {
+	v := "Bearer"
+	if len(errorCode) > 0 {
+		v = fmt.Sprintf("Bearer error=%q", errorCode)
+	}
+	w.Header().Set(wwwAuthenticateHeader, v)
+	w.WriteHeader(status)
+}
+
+// boxIRI returns the IRI of the inbox or outbox of the request, as pub finds
+// it.
+func boxIRI(r *http.Request) *url.URL {
+	u := *r.URL
+	u.Host = r.Host
+	u.Scheme = "https"
+	return &u
+}
+
+// sameIRI returns whether the IRIs are equal.
+func sameIRI(a, b *url.URL) bool {
+	return a != nil && b != nil && a.String() == b.String()
+}
@@ -0,0 +1,154 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+const (
+	testActorIRI  = "https://example.com/users/addison"
+	testOtherIRI  = "https://example.com/users/sam"
+	testOutboxIRI = "https://example.com/users/addison/outbox"
+	testInboxIRI  = "https://example.com/users/addison/inbox"
+)
+
+func assertEqual(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// actorDatabase is a Database whose inbox and outbox belong to one actor.
+type actorDatabase struct {
+	pub.Database
+}
+
+func (actorDatabase) Lock(c context.Context, id *url.URL) error   { return nil }
+func (actorDatabase) Unlock(c context.Context, id *url.URL) error { return nil }
+func (actorDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	return mustParse(testActorIRI), nil
+}
+func (actorDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	return mustParse(testActorIRI), nil
+}
+
+func TestMemoryTokens(t *testing.T) {
+	ctx := context.Background()
+	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	m := NewMemoryTokens(clock, time.Hour)
+	tok, err := m.IssueToken(ctx, mustParse(testActorIRI), []string{ScopeWrite})
+	assertEqual(t, err, nil)
+	got, ok, err := m.ValidateToken(ctx, tok.AccessToken)
+	assertEqual(t, err, nil)
+	assertEqual(t, ok, true)
+	assertEqual(t, got.Actor.String(), testActorIRI)
+	assertEqual(t, got.HasScope(ScopeWrite), true)
+	assertEqual(t, got.HasScope(ScopeRead), false)
+	clock.Advance(time.Hour)
+	_, ok, err = m.ValidateToken(ctx, tok.AccessToken)
+	assertEqual(t, err, nil)
+	assertEqual(t, ok, false)
+	tok, _ = m.IssueToken(ctx, mustParse(testActorIRI), nil)
+	assertEqual(t, m.RevokeToken(ctx, tok.AccessToken), nil)
+	_, ok, _ = m.ValidateToken(ctx, tok.AccessToken)
+	assertEqual(t, ok, false)
+}
+
+func TestAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	tokens := NewMemoryTokens(clock, 0)
+	a := &Authenticator{Tokens: tokens, Database: actorDatabase{}, Clock: clock}
+	issue := func(actor string, scopes ...string) string {
+		tok, err := tokens.IssueToken(ctx, mustParse(actor), scopes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tok.AccessToken
+	}
+	request := func(method, iri, token string) *http.Request {
+		r := httptest.NewRequest(method, iri, nil)
+		if len(token) > 0 {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+	t.Run("PostOutboxWithWriteToken", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.AuthenticatePostOutbox(ctx, w, request(http.MethodPost, testOutboxIRI, issue(testActorIRI, ScopeWrite)))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, true)
+	})
+	t.Run("PostOutboxWithoutToken", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.AuthenticatePostOutbox(ctx, w, request(http.MethodPost, testOutboxIRI, ""))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, false)
+		assertEqual(t, w.Code, http.StatusUnauthorized)
+		assertEqual(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+	})
+	t.Run("PostOutboxWithUnknownToken", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.AuthenticatePostOutbox(ctx, w, request(http.MethodPost, testOutboxIRI, "unknown"))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, false)
+		assertEqual(t, w.Code, http.StatusUnauthorized)
+		assertEqual(t, w.Header().Get("WWW-Authenticate"), `Bearer error="invalid_token"`)
+	})
+	t.Run("PostOutboxWithReadToken", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.AuthenticatePostOutbox(ctx, w, request(http.MethodPost, testOutboxIRI, issue(testActorIRI, ScopeRead)))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, false)
+		assertEqual(t, w.Code, http.StatusForbidden)
+		assertEqual(t, w.Header().Get("WWW-Authenticate"), `Bearer error="insufficient_scope"`)
+	})
+	t.Run("PostOutboxOfOtherActor", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.AuthenticatePostOutbox(ctx, w, request(http.MethodPost, testOutboxIRI, issue(testOtherIRI, ScopeWrite)))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, false)
+		assertEqual(t, w.Code, http.StatusForbidden)
+	})
+	t.Run("GetInboxWithReadToken", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.CommonBehavior(nil).AuthenticateGetInbox(ctx, w, request(http.MethodGet, testInboxIRI, issue(testActorIRI, ScopeRead)))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, true)
+	})
+	t.Run("WrapsSocialProtocol", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok, err := a.SocialProtocol(nil).AuthenticatePostOutbox(ctx, w, request(http.MethodPost, testOutboxIRI, ""))
+		assertEqual(t, err, nil)
+		assertEqual(t, ok, false)
+		assertEqual(t, w.Code, http.StatusUnauthorized)
+	})
+}
+
+func TestBearerToken(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, testInboxIRI, nil)
+	_, ok := BearerToken(r)
+	assertEqual(t, ok, false)
+	r.Header.Set("Authorization", "bearer abc")
+	tok, ok := BearerToken(r)
+	assertEqual(t, ok, true)
+	assertEqual(t, tok, "abc")
+	r.Header.Set("Authorization", "Basic abc")
+	_, ok = BearerToken(r)
+	assertEqual(t, ok, false)
+}
@@ -0,0 +1,195 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// ObjectAccess determines whether the handlers of NewActivityStreamsHandler
+// serve a value to the actor requesting it.
+type ObjectAccess int
+
+const (
+	// ServeObject serves the value, responding 200 OK.
+	ServeObject ObjectAccess = iota
+	// ForbidObject responds 403 Forbidden, acknowledging the value exists.
+	ForbidObject
+	// HideObject responds 404 Not Found, as if the value did not exist.
+	HideObject
+)
+
+// ObjectAuthorizer may be implemented by a Database to decide which of its
+// values the handlers of NewActivityStreamsHandler serve to whom, such as to
+// keep followers-only posts and direct messages from being fetched by anyone
+// knowing their ids.
+//
+// The actor requesting a value is the one identified by WithSourceReader, once
+// the application authenticated the request. AuthorizeByAddressing implements
+// the usual rules from the addressing of the values.
+type ObjectAuthorizer interface {
+	// AuthorizeGetObject returns whether the value is served to the
+	// requester, which is nil if the request was not authenticated.
+	AuthorizeGetObject(c context.Context, requester *url.URL, t vocab.Type) (ObjectAccess, error)
+}
+
+// authorizeGetObject returns whether the value is served to the actor
+// requesting it, if the Database is an ObjectAuthorizer.
+func authorizeGetObject(c context.Context, db Database, t vocab.Type) (ObjectAccess, error) {
+	oa, ok := db.(ObjectAuthorizer)
+	if !ok {
+		return ServeObject, nil
+	}
+	requester, _ := c.Value(sourceReaderContextKey{}).(*url.URL)
+	return oa.AuthorizeGetObject(c, requester, t)
+}
+
+// AuthorizeByAddressing decides whether a value is served to the requester
+// from its addressing, for a Database implementing ObjectAuthorizer:
+//
+// Values addressed to the Public collection, values addressed to no one, such
+// as actors and collections, and Tombstones are served to everyone. Other
+// values are served to their authors, to the actors they are addressed to, and
+// to the followers of their authors on this server when they address the
+// followers collection.
+//
+// Anyone else is responded 403 Forbidden for values addressed to followers,
+// and 404 Not Found for direct messages, so their existence is not disclosed.
+func AuthorizeByAddressing(c context.Context, db Database, requester *url.URL, t vocab.Type) (ObjectAccess, error) {
+	if streams.IsOrExtendsActivityStreamsTombstone(t) {
+		return ServeObject, nil
+	}
+	addressed := addressees(t)
+	if len(addressed) == 0 {
+		return ServeObject, nil
+	}
+	for _, u := range addressed {
+		if IsPublic(u.String()) {
+			return ServeObject, nil
+		}
+	}
+	authors := authorsOf(t)
+	var followersOnly bool
+	for _, author := range authors {
+		followers, isAddressed, err := addressedFollowers(c, db, author, addressed)
+		if err != nil {
+			return HideObject, err
+		} else if !isAddressed {
+			continue
+		}
+		followersOnly = true
+		if requester != nil && hasItem(followers, requester) {
+			return ServeObject, nil
+		}
+	}
+	if requester != nil {
+		for _, u := range append(authors, addressed...) {
+			if u.String() == requester.String() {
+				return ServeObject, nil
+			}
+		}
+	}
+	if followersOnly {
+		return ForbidObject, nil
+	}
+	return HideObject, nil
+}
+
+// addressedFollowers returns the followers collection of the author, if this
+// server owns the author and the collection is among the addressees.
+func addressedFollowers(c context.Context, db Database, author *url.URL, addressed []*url.URL) (vocab.ActivityStreamsCollection, bool, error) {
+	if err := db.Lock(c, author); err != nil {
+		return nil, false, err
+	}
+	defer db.Unlock(c, author)
+	if owns, err := db.Owns(c, author); err != nil || !owns {
+		return nil, false, err
+	}
+	followers, err := db.Followers(c, author)
+	if err != nil {
+		return nil, false, err
+	}
+	id, err := GetId(followers)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, u := range addressed {
+		if u.String() == id.String() {
+			return followers, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// hasItem determines whether the id is among the items of the collection.
+func hasItem(col vocab.ActivityStreamsCollection, id *url.URL) bool {
+	items := col.GetActivityStreamsItems()
+	if items == nil {
+		return false
+	}
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		if item, err := ToId(iter); err == nil && item.String() == id.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// addressees returns the ids in the 'to', 'bto', 'cc', 'bcc' and 'audience'
+// of the value, without adding the properties it does not have.
+func addressees(t vocab.Type) []*url.URL {
+	var iris []*url.URL
+	add := func(ip IdProperty) {
+		if id, err := ToId(ip); err == nil {
+			iris = append(iris, id)
+		}
+	}
+	if v, ok := t.(toer); ok && v.GetActivityStreamsTo() != nil {
+		for iter := v.GetActivityStreamsTo().Begin(); iter != nil; iter = iter.Next() {
+			add(iter)
+		}
+	}
+	if v, ok := t.(btoer); ok && v.GetActivityStreamsBto() != nil {
+		for iter := v.GetActivityStreamsBto().Begin(); iter != nil; iter = iter.Next() {
+			add(iter)
+		}
+	}
+	if v, ok := t.(ccer); ok && v.GetActivityStreamsCc() != nil {
+		for iter := v.GetActivityStreamsCc().Begin(); iter != nil; iter = iter.Next() {
+			add(iter)
+		}
+	}
+	if v, ok := t.(bccer); ok && v.GetActivityStreamsBcc() != nil {
+		for iter := v.GetActivityStreamsBcc().Begin(); iter != nil; iter = iter.Next() {
+			add(iter)
+		}
+	}
+	if v, ok := t.(audiencer); ok && v.GetActivityStreamsAudience() != nil {
+		for iter := v.GetActivityStreamsAudience().Begin(); iter != nil; iter = iter.Next() {
+			add(iter)
+		}
+	}
+	return iris
+}
+
+// authorsOf returns the ids in the 'attributedTo' and 'actor' of the value.
+func authorsOf(t vocab.Type) []*url.URL {
+	var iris []*url.URL
+	if v, ok := t.(attributedToer); ok && v.GetActivityStreamsAttributedTo() != nil {
+		for iter := v.GetActivityStreamsAttributedTo().Begin(); iter != nil; iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				iris = append(iris, id)
+			}
+		}
+	}
+	if v, ok := t.(actorer); ok && v.GetActivityStreamsActor() != nil {
+		for iter := v.GetActivityStreamsActor().Begin(); iter != nil; iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				iris = append(iris, id)
+			}
+		}
+	}
+	return iris
+}
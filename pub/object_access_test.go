@@ -0,0 +1,87 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// addressingDatabase is a Database authorizing its values by their addressing.
+type addressingDatabase struct {
+	*MockDatabase
+}
+
+func (a *addressingDatabase) AuthorizeGetObject(c context.Context, requester *url.URL, t vocab.Type) (ObjectAccess, error) {
+	return AuthorizeByAddressing(c, a, requester, t)
+}
+
+func TestObjectAccess(t *testing.T) {
+	ctx := context.Background()
+	const followersIRI = "https://example.com/addison/followers"
+	newNote := func(to string) vocab.Type {
+		note := streams.NewActivityStreamsNote()
+		note.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+		attr := streams.NewActivityStreamsAttributedToProperty()
+		attr.AppendIRI(mustParse(testPersonIRI))
+		note.SetActivityStreamsAttributedTo(attr)
+		toProp := streams.NewActivityStreamsToProperty()
+		toProp.AppendIRI(mustParse(to))
+		note.SetActivityStreamsTo(toProp)
+		return note
+	}
+	followers := func() vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		col.SetActivityStreamsId(newIdProperty(mustParse(followersIRI)))
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI))
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	serve := func(c context.Context, v vocab.Type) int {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := &addressingDatabase{NewMockDatabase(ctl)}
+		clock := NewMockClock(ctl)
+		db.EXPECT().Lock(gomock.Any(), mustParse(testNoteId1))
+		db.EXPECT().Get(gomock.Any(), mustParse(testNoteId1)).Return(v, nil)
+		db.EXPECT().Unlock(gomock.Any(), mustParse(testNoteId1))
+		db.EXPECT().Lock(gomock.Any(), mustParse(testPersonIRI)).AnyTimes()
+		db.EXPECT().Owns(gomock.Any(), mustParse(testPersonIRI)).Return(true, nil).AnyTimes()
+		db.EXPECT().Followers(gomock.Any(), mustParse(testPersonIRI)).Return(followers(), nil).AnyTimes()
+		db.EXPECT().Unlock(gomock.Any(), mustParse(testPersonIRI)).AnyTimes()
+		clock.EXPECT().Now().Return(now()).AnyTimes()
+		authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			return false, nil
+		}
+		h := NewActivityStreamsHandler(authFn, db, clock)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, activityStreamsMediaTypes[0])
+		rec := httptest.NewRecorder()
+		_, err := h(c, rec, req)
+		assertEqual(t, err, nil)
+		return rec.Code
+	}
+	follower := WithSourceReader(ctx, mustParse(testFederatedActorIRI))
+	other := WithSourceReader(ctx, mustParse(testFederatedActorIRI2))
+	author := WithSourceReader(ctx, mustParse(testPersonIRI))
+	t.Run("ServesPublicToEveryone", func(t *testing.T) {
+		assertEqual(t, serve(ctx, newNote(PublicActivityPubIRI)), http.StatusOK)
+	})
+	t.Run("ServesFollowersOnlyToFollowers", func(t *testing.T) {
+		assertEqual(t, serve(follower, newNote(followersIRI)), http.StatusOK)
+		assertEqual(t, serve(author, newNote(followersIRI)), http.StatusOK)
+		assertEqual(t, serve(other, newNote(followersIRI)), http.StatusForbidden)
+		assertEqual(t, serve(ctx, newNote(followersIRI)), http.StatusForbidden)
+	})
+	t.Run("HidesDirectMessagesFromOthers", func(t *testing.T) {
+		assertEqual(t, serve(other, newNote(testFederatedActorIRI2)), http.StatusOK)
+		assertEqual(t, serve(follower, newNote(testFederatedActorIRI2)), http.StatusNotFound)
+		assertEqual(t, serve(ctx, newNote(testFederatedActorIRI2)), http.StatusNotFound)
+	})
+}
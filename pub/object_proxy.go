@@ -0,0 +1,91 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+)
+
+// objectProxyIdParam is the form parameter of the IRI requested from the
+// object proxy.
+const objectProxyIdParam = "id"
+
+// ObjectProxyAuthFunc authenticates a client request to the object proxy,
+// returning the IRI of the outbox of the actor the client acts as, whose
+// Transport fetches the object. As with the AuthenticatePostOutbox of a
+// SocialProtocol, it writes the response itself when authentication fails, and
+// must not write one when returning an error.
+type ObjectProxyAuthFunc func(c context.Context, w http.ResponseWriter, r *http.Request) (outboxIRI *url.URL, authenticated bool, err error)
+
+// ObjectProxyFunc serves the proxyUrl endpoint of the ActivityPub Social API:
+// clients POST the form-encoded 'id' of a remote object, which the server
+// fetches on their behalf, so they view content of hosts requiring signed
+// requests.
+//
+// If an error is returned, then the calling function is responsible for writing
+// to the ResponseWriter as part of error handling, such as with a 502 Bad
+// Gateway.
+type ObjectProxyFunc func(c context.Context, w http.ResponseWriter, r *http.Request) error
+
+// NewObjectProxyHandler creates an ObjectProxyFunc that dereferences the
+// requested IRIs with the Transport of the actor of the client, so requests are
+// signed by that actor, and are restricted just like any other request made by
+// the Transport.
+//
+// The objects are deserialized and serialized again, so only ActivityStreams
+// values that go-fed understands are served, normalized. Objects whose id is
+// not on the host of the requested IRI are refused, so hosts cannot serve
+// others' objects through the proxy.
+func NewObjectProxyHandler(common CommonBehavior, authFn ObjectProxyAuthFunc, clock Clock) ObjectProxyFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) error {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return nil
+		}
+		outboxIRI, authenticated, err := authFn(c, w, r)
+		if err != nil {
+			return err
+		} else if !authenticated {
+			return nil
+		}
+		iri, err := url.Parse(r.FormValue(objectProxyIdParam))
+		if err != nil || !iri.IsAbs() || (iri.Scheme != "https" && iri.Scheme != "http") {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		t, err := common.NewTransport(c, outboxIRI, goFedUserAgent())
+		if err != nil {
+			return err
+		}
+		b, err := t.Dereference(c, iri)
+		if err != nil {
+			return err
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal(b, &m); err != nil {
+			return err
+		}
+		v, err := streams.ToType(c, m)
+		if err != nil {
+			return err
+		}
+		id, err := GetId(v)
+		if err != nil {
+			return err
+		} else if id.Host != iri.Host {
+			return fmt.Errorf("proxied object %s has id on another host: %s", iri, id)
+		}
+		raw, err := marshal(c, nil, v, false)
+		if err != nil {
+			return err
+		}
+		addResponseHeaders(c, r, w.Header(), clock, raw)
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(raw)
+		return err
+	}
+}
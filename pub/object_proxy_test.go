@@ -0,0 +1,88 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestObjectProxyHandler(t *testing.T) {
+	setupData()
+	ctx := context.Background()
+	authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (*url.URL, bool, error) {
+		return mustParse(testMyOutboxIRI), true, nil
+	}
+	newRequest := func(method, id string) *http.Request {
+		r := httptest.NewRequest(method, "https://example.com/proxy", strings.NewReader(url.Values{"id": {id}}.Encode()))
+		r.Header.Set(contentTypeHeader, "application/x-www-form-urlencoded")
+		return r
+	}
+	setupFn := func(ctl *gomock.Controller) (*MockCommonBehavior, *MockTransport) {
+		common := NewMockCommonBehavior(ctl)
+		tp := NewMockTransport(ctl)
+		common.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		return common, tp
+	}
+	t.Run("ServesNormalizedObject", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		common, tp := setupFn(ctl)
+		tp.EXPECT().Dereference(ctx, mustParse(testNoteId1)).Return(mustSerializeToBytes(testFederatedNote), nil)
+		h := NewObjectProxyHandler(common, authFn, NewFakeClock(now()))
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, newRequest(http.MethodPost, testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), contentTypeHeaderValue)
+		assertByteEqual(t, resp.Body.Bytes(), mustSerializeToBytes(testFederatedNote))
+	})
+	t.Run("RefusesObjectOfOtherHost", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		common, tp := setupFn(ctl)
+		spoofed := "https://other.example.com/note/1"
+		tp.EXPECT().Dereference(ctx, mustParse(spoofed)).Return(mustSerializeToBytes(testFederatedNote), nil)
+		h := NewObjectProxyHandler(common, authFn, NewFakeClock(now()))
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, newRequest(http.MethodPost, spoofed))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		assertEqual(t, resp.Body.Len(), 0)
+	})
+	t.Run("BadRequestWithoutIRI", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		h := NewObjectProxyHandler(NewMockCommonBehavior(ctl), authFn, NewFakeClock(now()))
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, newRequest(http.MethodPost, ""))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("RefusesUnauthenticated", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		h := NewObjectProxyHandler(NewMockCommonBehavior(ctl), func(c context.Context, w http.ResponseWriter, r *http.Request) (*url.URL, bool, error) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return nil, false, nil
+		}, NewFakeClock(now()))
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, newRequest(http.MethodPost, testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		h := NewObjectProxyHandler(NewMockCommonBehavior(ctl), authFn, NewFakeClock(now()))
+		resp := httptest.NewRecorder()
+		err := h(ctx, resp, httptest.NewRequest(http.MethodGet, "https://example.com/proxy", nil))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusMethodNotAllowed)
+	})
+}
@@ -0,0 +1,76 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+)
+
+// resolveObjectIRIs validates the objects of an activity posted to an outbox
+// that the client referenced by IRI, instead of embedding them, such as the
+// note of a Like. Each must be in the Database, or else be served by its owner
+// with the same id. Otherwise the activity is rejected with ErrObjectNotFound.
+//
+// The objects of Create and Update activities must be embedded, so they are
+// not resolved.
+func (a *sideEffectActor) resolveObjectIRIs(c context.Context, outboxIRI *url.URL, activity Activity) error {
+	if streams.IsOrExtendsActivityStreamsCreate(activity) || streams.IsOrExtendsActivityStreamsUpdate(activity) {
+		return nil
+	}
+	o, ok := activity.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return nil
+	}
+	var t Transport
+	for iter := o.GetActivityStreamsObject().Begin(); iter != o.GetActivityStreamsObject().End(); iter = iter.Next() {
+		if !iter.IsIRI() {
+			continue
+		}
+		iri := iter.GetIRI()
+		exists, err := a.objectExists(c, iri)
+		if err != nil {
+			return err
+		} else if exists {
+			continue
+		}
+		if t == nil {
+			if t, err = a.common.NewTransport(c, outboxIRI, goFedUserAgent()); err != nil {
+				return err
+			}
+		}
+		if !dereferencesTo(c, t, iri) {
+			return ErrObjectNotFound
+		}
+	}
+	return nil
+}
+
+// objectExists determines whether the Database has the object.
+func (a *sideEffectActor) objectExists(c context.Context, iri *url.URL) (bool, error) {
+	if err := a.db.Lock(c, iri); err != nil {
+		return false, err
+	}
+	defer a.db.Unlock(c, iri)
+	return a.db.Exists(c, iri)
+}
+
+// dereferencesTo determines whether dereferencing the IRI obtains a value with
+// that id.
+func dereferencesTo(c context.Context, t Transport, iri *url.URL) bool {
+	b, err := t.Dereference(c, iri)
+	if err != nil {
+		return false
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+	v, err := streams.ToType(c, m)
+	if err != nil {
+		return false
+	}
+	id, err := GetId(v)
+	return err == nil && id.String() == iri.String()
+}
@@ -0,0 +1,76 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+func TestResolveObjectIRIs(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	like := func() Activity {
+		l := streams.NewActivityStreamsLike()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		l.SetActivityStreamsObject(op)
+		return l
+	}
+	setup := func(ctl *gomock.Controller, exists bool) (*sideEffectActor, *MockCommonBehavior) {
+		cb := NewMockCommonBehavior(ctl)
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(exists, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		return &sideEffectActor{common: cb, db: db}, cb
+	}
+	t.Run("AcceptsStoredObject", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _ := setup(ctl, true)
+		err := a.resolveObjectIRIs(ctx, mustParse(testMyOutboxIRI), like())
+		assertEqual(t, err, nil)
+	})
+	t.Run("DereferencesRemoteObject", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, cb := setup(ctl, false)
+		tp := NewMockTransport(ctl)
+		cb.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testNoteId1)).Return(mustSerializeToBytes(testFederatedNote), nil)
+		err := a.resolveObjectIRIs(ctx, mustParse(testMyOutboxIRI), like())
+		assertEqual(t, err, nil)
+	})
+	t.Run("RejectsUnknownObject", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, cb := setup(ctl, false)
+		tp := NewMockTransport(ctl)
+		cb.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testNoteId1)).Return(nil, fmt.Errorf("not found"))
+		err := a.resolveObjectIRIs(ctx, mustParse(testMyOutboxIRI), like())
+		assertEqual(t, err, ErrObjectNotFound)
+	})
+	t.Run("RejectsMismatchedId", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, cb := setup(ctl, false)
+		tp := NewMockTransport(ctl)
+		cb.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		other := streams.NewActivityStreamsNote()
+		other.SetActivityStreamsId(newIdProperty(mustParse(testNoteId2)))
+		tp.EXPECT().Dereference(ctx, mustParse(testNoteId1)).Return(mustSerializeToBytes(other), nil)
+		err := a.resolveObjectIRIs(ctx, mustParse(testMyOutboxIRI), like())
+		assertEqual(t, err, ErrObjectNotFound)
+	})
+	t.Run("IgnoresCreate", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := &sideEffectActor{common: NewMockCommonBehavior(ctl), db: NewMockDatabase(ctl)}
+		err := a.resolveObjectIRIs(ctx, mustParse(testMyOutboxIRI), testCreate)
+		assertEqual(t, err, nil)
+	})
+}
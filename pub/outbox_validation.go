@@ -0,0 +1,203 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// Violation is a problem with a value posted to an outbox, found before any of
+// its side effects.
+type Violation struct {
+	// Property is the name of the property at fault, such as "object".
+	Property string `json:"property"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// ValidationError is the error of a value posted to an outbox that has
+// Violations. PostOutbox responds to it with 422 Unprocessable Entity, and a
+// JSON body listing the violations:
+//
+//	{"error":"invalid Add","violations":[{"property":"target","message":"an Add requires a target"}]}
+type ValidationError struct {
+	// Type is the type of the value posted.
+	Type string
+	// Violations are the problems found.
+	Violations []Violation
+}
+
+// Error lists the violations.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Property + ": " + v.Message
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Type, strings.Join(msgs, "; "))
+}
+
+// OutboxValidator may be implemented by a SocialProtocol to check the values
+// posted to outboxes further than ValidateOutboxActivity does, such as the
+// length of their content.
+type OutboxValidator interface {
+	// ValidateOutbox returns the violations of the value posted to the
+	// outbox. The value is not modified.
+	ValidateOutbox(c context.Context, outboxIRI *url.URL, t vocab.Type) ([]Violation, error)
+}
+
+// outboxRequirements are the properties the side effects of activities posted
+// to an outbox require, by type.
+var outboxRequirements = map[string][]string{
+	"Create": {"object"},
+	"Update": {"object"},
+	"Delete": {"object"},
+	"Follow": {"object"},
+	"Add":    {"object", "target"},
+	"Remove": {"object", "target"},
+	"Like":   {"object"},
+	"Block":  {"object"},
+	"Undo":   {"object"},
+}
+
+// ValidateOutboxActivity returns the violations of the value posted to the
+// outbox of the actor:
+//
+//   - Create, Update, Delete, Follow, Like, Block and Undo activities require
+//     an 'object', and Add and Remove activities an 'object' and a 'target'.
+//   - The objects of a Create must be embedded, and those of an Update and a
+//     Delete must have ids.
+//   - The 'actor' of an activity must be the actor of the outbox, and so must
+//     the 'attributedTo' of the objects it creates, or of the object posted
+//     to be wrapped in a Create.
+//
+// Missing actors and authors are not violations, as the Create wrapping an
+// object copies them.
+func ValidateOutboxActivity(t vocab.Type, actorIRI *url.URL) []Violation {
+	var vs []Violation
+	if !streams.IsOrExtendsActivityStreamsActivity(t) {
+		return checkAttributedTo(vs, "attributedTo", t, actorIRI)
+	}
+	name := t.GetTypeName()
+	var op vocab.ActivityStreamsObjectProperty
+	if o, ok := t.(objecter); ok {
+		op = o.GetActivityStreamsObject()
+	}
+	for _, prop := range outboxRequirements[name] {
+		switch prop {
+		case "object":
+			if op == nil || op.Len() == 0 {
+				vs = append(vs, Violation{Property: "object", Message: fmt.Sprintf("%s requires an object", article(name))})
+			}
+		case "target":
+			if tg, ok := t.(targeter); !ok || tg.GetActivityStreamsTarget() == nil || tg.GetActivityStreamsTarget().Len() == 0 {
+				vs = append(vs, Violation{Property: "target", Message: fmt.Sprintf("%s requires a target", article(name))})
+			}
+		}
+	}
+	if a, ok := t.(actorer); ok && a.GetActivityStreamsActor() != nil && actorIRI != nil {
+		for iter := a.GetActivityStreamsActor().Begin(); iter != a.GetActivityStreamsActor().End(); iter = iter.Next() {
+			if id, err := ToId(iter); err != nil || id.String() != actorIRI.String() {
+				vs = append(vs, Violation{Property: "actor", Message: fmt.Sprintf("the actor must be %s", actorIRI)})
+				break
+			}
+		}
+	}
+	if op == nil {
+		return vs
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		switch name {
+		case "Create":
+			if iter.GetType() == nil {
+				vs = append(vs, Violation{Property: "object", Message: "the objects of a Create must be embedded"})
+				continue
+			}
+			vs = checkAttributedTo(vs, "object.attributedTo", iter.GetType(), actorIRI)
+		case "Update", "Delete":
+			if _, err := ToId(iter); err != nil {
+				vs = append(vs, Violation{Property: "object", Message: fmt.Sprintf("the objects of %s must have ids", article(name))})
+			}
+		}
+	}
+	return vs
+}
+
+// checkAttributedTo appends a violation for the property if the value is
+// attributed to another actor than the one.
+func checkAttributedTo(vs []Violation, property string, t vocab.Type, actorIRI *url.URL) []Violation {
+	at, ok := t.(attributedToer)
+	if !ok || at.GetActivityStreamsAttributedTo() == nil || actorIRI == nil {
+		return vs
+	}
+	for iter := at.GetActivityStreamsAttributedTo().Begin(); iter != at.GetActivityStreamsAttributedTo().End(); iter = iter.Next() {
+		if id, err := ToId(iter); err != nil || id.String() != actorIRI.String() {
+			return append(vs, Violation{Property: property, Message: fmt.Sprintf("the attributedTo must be %s", actorIRI)})
+		}
+	}
+	return vs
+}
+
+// article prefixes the type name with its indefinite article.
+func article(name string) string {
+	if len(name) > 0 && strings.ContainsRune("AEIOU", rune(name[0])) {
+		return "an " + name
+	}
+	return "a " + name
+}
+
+// outboxValidator is implemented by delegates validating the values posted to
+// outboxes before PostOutbox processes them.
+type outboxValidator interface {
+	validateOutbox(c context.Context, outboxIRI *url.URL, t vocab.Type) error
+}
+
+// validateOutbox returns a ValidationError if the value posted to the outbox
+// has violations, found by ValidateOutboxActivity and by the SocialProtocol if
+// it is an OutboxValidator.
+func (a *sideEffectActor) validateOutbox(c context.Context, outboxIRI *url.URL, t vocab.Type) error {
+	if err := a.db.Lock(c, outboxIRI); err != nil {
+		return err
+	}
+	actorIRI, err := a.db.ActorForOutbox(c, outboxIRI)
+	a.db.Unlock(c, outboxIRI)
+	if err != nil {
+		return err
+	}
+	vs := ValidateOutboxActivity(t, actorIRI)
+	if v, ok := a.c2s.(OutboxValidator); ok {
+		more, err := v.ValidateOutbox(c, outboxIRI, t)
+		if err != nil {
+			return err
+		}
+		vs = append(vs, more...)
+	}
+	if len(vs) > 0 {
+		return &ValidationError{Type: t.GetTypeName(), Violations: vs}
+	}
+	return nil
+}
+
+// writeValidationError responds to the value posted to an outbox with 422
+// Unprocessable Entity, listing its violations.
+func writeValidationError(w http.ResponseWriter, e *ValidationError) error {
+	b, err := json.Marshal(struct {
+		Error      string      `json:"error"`
+		Violations []Violation `json:"violations"`
+	}{
+		Error:      "invalid " + e.Type,
+		Violations: e.Violations,
+	})
+	if err != nil {
+		return err
+	}
+	w.Header().Set(contentTypeHeader, "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,128 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// lengthValidator is a SocialProtocol limiting the number of objects of the
+// activities posted to outboxes to one.
+type lengthValidator struct {
+	*MockSocialProtocol
+}
+
+func (lengthValidator) ValidateOutbox(c context.Context, outboxIRI *url.URL, t vocab.Type) ([]Violation, error) {
+	if o, ok := t.(objecter); ok && o.GetActivityStreamsObject() != nil && o.GetActivityStreamsObject().Len() > 1 {
+		return []Violation{{Property: "object", Message: "too many objects"}}, nil
+	}
+	return nil, nil
+}
+
+func TestValidateOutboxActivity(t *testing.T) {
+	actorIRI := mustParse(testPersonIRI)
+	withActor := func(a vocab.Type, actor string) {
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(actor))
+		a.(actorer).SetActivityStreamsActor(ap)
+	}
+	t.Run("AcceptsValidCreate", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		n := streams.NewActivityStreamsNote()
+		at := streams.NewActivityStreamsAttributedToProperty()
+		at.AppendIRI(actorIRI)
+		n.SetActivityStreamsAttributedTo(at)
+		op.AppendActivityStreamsNote(n)
+		c.SetActivityStreamsObject(op)
+		withActor(c, testPersonIRI)
+		assertEqual(t, len(ValidateOutboxActivity(c, actorIRI)), 0)
+	})
+	t.Run("RequiresObjectAndTarget", func(t *testing.T) {
+		vs := ValidateOutboxActivity(streams.NewActivityStreamsAdd(), actorIRI)
+		assertEqual(t, len(vs), 2)
+		assertEqual(t, vs[0], Violation{Property: "object", Message: "an Add requires an object"})
+		assertEqual(t, vs[1], Violation{Property: "target", Message: "an Add requires a target"})
+	})
+	t.Run("RequiresEmbeddedCreateObjects", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		c.SetActivityStreamsObject(op)
+		vs := ValidateOutboxActivity(c, actorIRI)
+		assertEqual(t, len(vs), 1)
+		assertEqual(t, vs[0].Property, "object")
+	})
+	t.Run("RejectsOtherActor", func(t *testing.T) {
+		l := streams.NewActivityStreamsLike()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		l.SetActivityStreamsObject(op)
+		withActor(l, testFederatedActorIRI)
+		vs := ValidateOutboxActivity(l, actorIRI)
+		assertEqual(t, len(vs), 1)
+		assertEqual(t, vs[0].Property, "actor")
+	})
+	t.Run("RejectsObjectAttributedToOtherActor", func(t *testing.T) {
+		n := streams.NewActivityStreamsNote()
+		at := streams.NewActivityStreamsAttributedToProperty()
+		at.AppendIRI(mustParse(testFederatedActorIRI))
+		n.SetActivityStreamsAttributedTo(at)
+		vs := ValidateOutboxActivity(n, actorIRI)
+		assertEqual(t, len(vs), 1)
+		assertEqual(t, vs[0].Property, "attributedTo")
+	})
+}
+
+func TestPostOutboxValidation(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) Actor {
+		c2s := NewMockSocialProtocol(ctl)
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(mustParse(testPersonIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		c2s.EXPECT().AuthenticatePostOutbox(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		c2s.EXPECT().PostOutboxRequestBodyHook(ctx, gomock.Any(), gomock.Any()).Return(ctx, nil)
+		return NewCustomActor(&sideEffectActor{c2s: lengthValidator{c2s}, db: db}, true, false, NewMockClock(ctl))
+	}
+	t.Run("RespondsUnprocessableEntity", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		handled, err := a.PostOutbox(ctx, resp, toAPRequest(toPostOutboxRequest(streams.NewActivityStreamsRemove())))
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusUnprocessableEntity)
+		assertEqual(t, resp.Header().Get("Content-Type"), "application/json")
+		var body struct {
+			Error      string
+			Violations []Violation
+		}
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &body), nil)
+		assertEqual(t, body.Error, "invalid Remove")
+		assertEqual(t, len(body.Violations), 2)
+	})
+	t.Run("IncludesSocialProtocolViolations", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := setupFn(ctl)
+		like := streams.NewActivityStreamsLike()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		op.AppendIRI(mustParse(testNoteId2))
+		like.SetActivityStreamsObject(op)
+		resp := httptest.NewRecorder()
+		_, err := a.PostOutbox(ctx, resp, toAPRequest(toPostOutboxRequest(like)))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnprocessableEntity)
+	})
+}
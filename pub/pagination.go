@@ -0,0 +1,201 @@
+package pub
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// DefaultPageSize is the number of items in the pages of a
+	// CollectionPaginator created with a zero page size.
+	DefaultPageSize = 20
+	// Query parameters of collection pages.
+	pageParam  = "page"
+	minIdParam = "min_id"
+	maxIdParam = "max_id"
+)
+
+// OrderedItemsCollection is an OrderedCollection or OrderedCollectionPage
+// holding every item of a collection, newest first, such as the inboxes and
+// outboxes of the Database.
+type OrderedItemsCollection interface {
+	vocab.Type
+	GetActivityStreamsOrderedItems() vocab.ActivityStreamsOrderedItemsProperty
+}
+
+// CollectionPaginator serves the items of a collection in pages, as peer
+// software such as Mastodon expects when it GETs inboxes, outboxes, and
+// followers collections.
+//
+// The collection itself, requested without query parameters, has no items but
+// links to its first and last pages. Its pages are requested with
+// "?page=true", and are navigated with the ids of items as cursors:
+// "&max_id=" selects the items older than an item, and "&min_id=" the items
+// newer than one. For example:
+//
+//	func (f *myFederating) GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+//	    inbox, err := f.db.GetInbox(c, inboxIRI)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return f.paginator.Page(inbox, r.URL.Query())
+//	}
+type CollectionPaginator struct {
+	pageSize int
+}
+
+// NewCollectionPaginator creates a CollectionPaginator serving pages of up to
+// pageSize items. Zero or negative sizes use the DefaultPageSize.
+func NewCollectionPaginator(pageSize int) *CollectionPaginator {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &CollectionPaginator{pageSize: pageSize}
+}
+
+// IsPageRequest determines whether the query requests a page of a collection,
+// rather than the collection itself.
+func IsPageRequest(query url.Values) bool {
+	return query.Get(pageParam) == "true"
+}
+
+// Collection returns the OrderedCollection of the full collection, with its
+// total number of items and links to its first and last pages, but without
+// its items.
+func (p *CollectionPaginator) Collection(full OrderedItemsCollection) (vocab.ActivityStreamsOrderedCollection, error) {
+	id, err := GetId(full)
+	if err != nil {
+		return nil, err
+	}
+	n := 0
+	if items := full.GetActivityStreamsOrderedItems(); items != nil {
+		n = items.Len()
+	}
+	oc := streams.NewActivityStreamsOrderedCollection()
+	oc.SetActivityStreamsId(newIdProperty(id))
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(n)
+	oc.SetActivityStreamsTotalItems(total)
+	first := streams.NewActivityStreamsFirstProperty()
+	first.SetIRI(pageIRI(id, "", ""))
+	oc.SetActivityStreamsFirst(first)
+	last := streams.NewActivityStreamsLastProperty()
+	last.SetIRI(pageIRI(id, minIdParam, ""))
+	oc.SetActivityStreamsLast(last)
+	return oc, nil
+}
+
+// Page returns the OrderedCollectionPage of the full collection selected by the
+// query, linked to the collection and to its neighbouring pages. Without a
+// cursor, it is the first page. Cursors that are not the id of an item of the
+// collection are an error.
+func (p *CollectionPaginator) Page(full OrderedItemsCollection, query url.Values) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	id, err := GetId(full)
+	if err != nil {
+		return nil, err
+	}
+	var ids []*url.URL
+	items := full.GetActivityStreamsOrderedItems()
+	if items != nil {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			itemId, err := ToId(iter)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, itemId)
+		}
+	}
+	// The page holds the items from start to end.
+	start, end := 0, p.pageSize
+	if maxId := query.Get(maxIdParam); len(maxId) > 0 {
+		i := indexOfId(ids, maxId)
+		if i < 0 {
+			return nil, fmt.Errorf("%s %q is not an item of %s", maxIdParam, maxId, id)
+		}
+		start, end = i+1, i+1+p.pageSize
+	} else if minId, ok := query[minIdParam]; ok {
+		// An empty min_id is the last page, of the oldest items.
+		i := len(ids)
+		if len(minId[0]) > 0 {
+			if i = indexOfId(ids, minId[0]); i < 0 {
+				return nil, fmt.Errorf("%s %q is not an item of %s", minIdParam, minId[0], id)
+			}
+		}
+		start, end = i-p.pageSize, i
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end > len(ids) {
+		end = len(ids)
+	}
+	if start > end {
+		start = end
+	}
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	self := pageIRI(id, "", "")
+	if q := query.Get(maxIdParam); len(q) > 0 {
+		self = pageIRI(id, maxIdParam, q)
+	} else if q, ok := query[minIdParam]; ok {
+		self = pageIRI(id, minIdParam, q[0])
+	}
+	page.SetActivityStreamsId(newIdProperty(self))
+	partOf := streams.NewActivityStreamsPartOfProperty()
+	partOf.SetIRI(id)
+	page.SetActivityStreamsPartOf(partOf)
+	startIndex := streams.NewActivityStreamsStartIndexProperty()
+	startIndex.Set(start)
+	page.SetActivityStreamsStartIndex(startIndex)
+	pageItems := streams.NewActivityStreamsOrderedItemsProperty()
+	for i := start; i < end; i++ {
+		if iter := items.At(i); iter.IsIRI() {
+			pageItems.AppendIRI(iter.GetIRI())
+		} else if err = pageItems.AppendType(iter.GetType()); err != nil {
+			return nil, err
+		}
+	}
+	page.SetActivityStreamsOrderedItems(pageItems)
+	if end < len(ids) && end > 0 {
+		next := streams.NewActivityStreamsNextProperty()
+		next.SetIRI(pageIRI(id, maxIdParam, ids[end-1].String()))
+		page.SetActivityStreamsNext(next)
+	}
+	if start > 0 && start < len(ids) {
+		prev := streams.NewActivityStreamsPrevProperty()
+		prev.SetIRI(pageIRI(id, minIdParam, ids[start].String()))
+		page.SetActivityStreamsPrev(prev)
+	}
+	return page, nil
+}
+
+// pageIRI returns the IRI of the page of the collection with the cursor, if the
+// param is not empty.
+func pageIRI(collection *url.URL, param, cursor string) *url.URL {
+	u := *collection
+	q := url.Values{pageParam: []string{"true"}}
+	if len(param) > 0 {
+		q.Set(param, cursor)
+	}
+	u.RawQuery = q.Encode()
+	return &u
+}
+
+// indexOfId returns the index of the id among the ids, or -1.
+func indexOfId(ids []*url.URL, id string) int {
+	for i, v := range ids {
+		if v.String() == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// newIdProperty returns an 'id' property of the IRI.
+func newIdProperty(iri *url.URL) vocab.ActivityStreamsIdProperty {
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(iri)
+	return id
+}
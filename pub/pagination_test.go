@@ -0,0 +1,83 @@
+package pub
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+)
+
+func TestCollectionPaginator(t *testing.T) {
+	full := streams.NewActivityStreamsOrderedCollection()
+	full.SetActivityStreamsId(newIdProperty(mustParse(testMyOutboxIRI)))
+	items := streams.NewActivityStreamsOrderedItemsProperty()
+	for i := 5; i > 0; i-- {
+		items.AppendIRI(mustParse(testNoteId1 + "/" + strconv.Itoa(i)))
+	}
+	full.SetActivityStreamsOrderedItems(items)
+	p := NewCollectionPaginator(2)
+	pageItems := func(q url.Values) (string, string, string) {
+		page, err := p.Page(full, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for iter := page.GetActivityStreamsOrderedItems().Begin(); iter != nil; iter = iter.Next() {
+			ids = append(ids, iter.GetIRI().String()[len(testNoteId1)+1:])
+		}
+		var next, prev string
+		if page.GetActivityStreamsNext() != nil {
+			next = page.GetActivityStreamsNext().GetIRI().Query().Get(maxIdParam)
+		}
+		if page.GetActivityStreamsPrev() != nil {
+			prev = page.GetActivityStreamsPrev().GetIRI().Query().Get(minIdParam)
+		}
+		assertEqual(t, page.GetActivityStreamsPartOf().GetIRI().String(), testMyOutboxIRI)
+		return fmt.Sprint(ids), next, prev
+	}
+	t.Run("Collection", func(t *testing.T) {
+		oc, err := p.Collection(full)
+		assertEqual(t, err, nil)
+		assertEqual(t, oc.GetActivityStreamsTotalItems().Get(), 5)
+		assertEqual(t, oc.GetActivityStreamsOrderedItems() == nil, true)
+		assertEqual(t, oc.GetActivityStreamsFirst().GetIRI().String(), testMyOutboxIRI+"?page=true")
+	})
+	t.Run("FirstPage", func(t *testing.T) {
+		ids, next, prev := pageItems(url.Values{pageParam: {"true"}})
+		assertEqual(t, ids, "[5 4]")
+		assertEqual(t, next, testNoteId1+"/4")
+		assertEqual(t, prev, "")
+	})
+	t.Run("FollowsNext", func(t *testing.T) {
+		ids, next, prev := pageItems(url.Values{pageParam: {"true"}, maxIdParam: {testNoteId1 + "/4"}})
+		assertEqual(t, ids, "[3 2]")
+		assertEqual(t, next, testNoteId1+"/2")
+		assertEqual(t, prev, testNoteId1+"/3")
+		ids, next, _ = pageItems(url.Values{pageParam: {"true"}, maxIdParam: {testNoteId1 + "/2"}})
+		assertEqual(t, ids, "[1]")
+		assertEqual(t, next, "")
+	})
+	t.Run("FollowsPrev", func(t *testing.T) {
+		ids, _, prev := pageItems(url.Values{pageParam: {"true"}, minIdParam: {testNoteId1 + "/2"}})
+		assertEqual(t, ids, "[4 3]")
+		assertEqual(t, prev, testNoteId1+"/4")
+		ids, _, prev = pageItems(url.Values{pageParam: {"true"}, minIdParam: {testNoteId1 + "/4"}})
+		assertEqual(t, ids, "[5]")
+		assertEqual(t, prev, "")
+	})
+	t.Run("LastPage", func(t *testing.T) {
+		ids, next, _ := pageItems(url.Values{pageParam: {"true"}, minIdParam: {""}})
+		assertEqual(t, ids, "[2 1]")
+		assertEqual(t, next, "")
+	})
+	t.Run("UnknownCursor", func(t *testing.T) {
+		_, err := p.Page(full, url.Values{pageParam: {"true"}, maxIdParam: {testNoteId2}})
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("IsPageRequest", func(t *testing.T) {
+		assertEqual(t, IsPageRequest(url.Values{pageParam: {"true"}}), true)
+		assertEqual(t, IsPageRequest(url.Values{}), false)
+	})
+}
@@ -0,0 +1,140 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// tagProperty is the ActivityStreams 'tag' property.
+const tagProperty = "tag"
+
+// ParseWarning describes data of an ActivityStreams value that the readers of
+// this package, such as GetEmojis or GetAlsoKnownAs, skip or coerce instead of
+// failing, so applications can log the interoperability issues of peers.
+type ParseWarning struct {
+	// Property is the name of the property holding the data, such as
+	// "tag".
+	Property string
+	// Reason describes what is wrong with the data.
+	Reason string
+	// Value is the data as it was received, such as a vocab.Type or a
+	// value decoded from JSON.
+	Value interface{}
+}
+
+// String describes the warning.
+func (w ParseWarning) String() string {
+	return fmt.Sprintf("%s: %s: %v", w.Property, w.Reason, w.Value)
+}
+
+// ParseWarnings collects the ParseWarnings of the values received while
+// serving a request. It is safe for concurrent use.
+type ParseWarnings struct {
+	mu       sync.Mutex
+	warnings []ParseWarning
+}
+
+// Warnings returns the warnings collected so far.
+func (p *ParseWarnings) Warnings() []ParseWarning {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]ParseWarning(nil), p.warnings...)
+}
+
+// add collects the warnings.
+func (p *ParseWarnings) add(w ...ParseWarning) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.warnings = append(p.warnings, w...)
+}
+
+// parseWarningsContextKey is the context key of the ParseWarnings collecting
+// the warnings of a request.
+type parseWarningsContextKey struct{}
+
+// WithParseWarnings returns a context collecting in p the ParseWarnings of the
+// activities posted to the inbox while serving a request with it.
+func WithParseWarnings(c context.Context, p *ParseWarnings) context.Context {
+	return context.WithValue(c, parseWarningsContextKey{}, p)
+}
+
+// collectParseWarnings collects the ParseWarnings of the value, if the context
+// has a ParseWarnings.
+func collectParseWarnings(c context.Context, o vocab.Type) {
+	if p, ok := c.Value(parseWarningsContextKey{}).(*ParseWarnings); ok && p != nil {
+		if w := ReadWarnings(o); len(w) > 0 {
+			p.add(w...)
+		}
+	}
+}
+
+// ReadWarnings lists the data of a value, and of the values embedded in its
+// 'object' property, that the readers of this package skip or coerce.
+func ReadWarnings(o vocab.Type) []ParseWarning {
+	var w []ParseWarning
+	if t, ok := o.(tagger); ok && t.GetActivityStreamsTag() != nil {
+		tag := t.GetActivityStreamsTag()
+		for iter := tag.Begin(); iter != tag.End(); iter = iter.Next() {
+			if iter.IsTootEmoji() {
+				if _, ok := ReadEmoji(iter.GetTootEmoji()); !ok {
+					w = append(w, ParseWarning{tagProperty, "malformed emoji skipped", iter.GetTootEmoji()})
+				}
+			} else if iter.IsActivityStreamsMention() {
+				if href := iter.GetActivityStreamsMention().GetActivityStreamsHref(); href == nil || href.Get() == nil {
+					w = append(w, ParseWarning{tagProperty, "mention without href skipped", iter.GetActivityStreamsMention()})
+				}
+			}
+		}
+	}
+	if u, ok := o.(unknownPropertieser); ok {
+		w = append(w, alsoKnownAsWarnings(u.GetUnknownProperties()[alsoKnownAsProperty])...)
+		if v, ok := u.GetUnknownProperties()[sourceProperty]; ok {
+			if _, ok := GetSource(o); !ok {
+				w = append(w, ParseWarning{sourceProperty, "source without content skipped", v})
+			}
+		}
+	}
+	if op, ok := o.(objecter); ok && op.GetActivityStreamsObject() != nil {
+		objects := op.GetActivityStreamsObject()
+		for iter := objects.Begin(); iter != objects.End(); iter = iter.Next() {
+			if t := iter.GetType(); t != nil {
+				w = append(w, ReadWarnings(t)...)
+			}
+		}
+	}
+	return w
+}
+
+// alsoKnownAsWarnings lists the values of an 'alsoKnownAs' property that
+// GetAlsoKnownAs skips.
+func alsoKnownAsWarnings(v interface{}) []ParseWarning {
+	var values []interface{}
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	case []string:
+		for _, s := range v {
+			values = append(values, s)
+		}
+	default:
+		return []ParseWarning{{alsoKnownAsProperty, "value that is not an IRI skipped", v}}
+	}
+	var w []ParseWarning
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			if iri, err := url.Parse(s); err == nil && iri.IsAbs() {
+				continue
+			}
+		}
+		w = append(w, ParseWarning{alsoKnownAsProperty, "value that is not an IRI skipped", v})
+	}
+	return w
+}
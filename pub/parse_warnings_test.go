@@ -0,0 +1,50 @@
+package pub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+)
+
+func TestParseWarnings(t *testing.T) {
+	malformedCreate := func() Activity {
+		note := streams.NewActivityStreamsNote()
+		tag := streams.NewActivityStreamsTagProperty()
+		emoji := streams.NewTootEmoji()
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString(":blobcat:")
+		emoji.SetActivityStreamsName(name)
+		tag.AppendTootEmoji(emoji)
+		tag.AppendActivityStreamsMention(streams.NewActivityStreamsMention())
+		note.SetActivityStreamsTag(tag)
+		note.GetUnknownProperties()[sourceProperty] = map[string]interface{}{sourceMediaTypeProperty: "text/markdown"}
+		create := streams.NewActivityStreamsCreate()
+		create.GetUnknownProperties()[alsoKnownAsProperty] = []interface{}{"https://old.example.com/person", 42.0, "not an iri"}
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		create.SetActivityStreamsObject(op)
+		return create
+	}
+	t.Run("ListsSkippedData", func(t *testing.T) {
+		w := ReadWarnings(malformedCreate())
+		assertEqual(t, len(w), 5)
+		assertEqual(t, w[0].Property, alsoKnownAsProperty)
+		assertEqual(t, w[0].Value, 42.0)
+		assertEqual(t, w[1].Property, alsoKnownAsProperty)
+		assertEqual(t, w[1].Value, "not an iri")
+		assertEqual(t, w[2].Reason, "malformed emoji skipped")
+		assertEqual(t, w[3].Reason, "mention without href skipped")
+		assertEqual(t, w[4].Property, sourceProperty)
+	})
+	t.Run("ListsNothingForWellFormedValues", func(t *testing.T) {
+		assertEqual(t, len(ReadWarnings(testCreate)), 0)
+	})
+	t.Run("CollectsInContext", func(t *testing.T) {
+		var p ParseWarnings
+		c := WithParseWarnings(context.Background(), &p)
+		collectParseWarnings(c, malformedCreate())
+		collectParseWarnings(context.Background(), malformedCreate())
+		assertEqual(t, len(p.Warnings()), 5)
+	})
+}
@@ -0,0 +1,154 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+)
+
+// Plugin contributes a drop-in federation feature, such as handling an
+// extension vocabulary or a moderation policy, to the applications enabling it
+// by name.
+//
+// Plugins register themselves with RegisterPlugin in the init function of their
+// package, so importing the package makes the plugin available:
+//
+//	import _ "example.com/federation/polls"
+//
+// Go plugins opened with the plugin package register themselves the same way.
+// Every field but the Name is optional.
+type Plugin struct {
+	// Name identifies the plugin.
+	Name string
+	// FederatingCallbacks returns the callbacks handling activities
+	// received from federating peers, compatible with
+	// streams.TypeResolver. They are appended to the other callbacks of the
+	// FederatingProtocol.
+	FederatingCallbacks func(c context.Context) []interface{}
+	// SocialCallbacks returns the callbacks handling activities submitted
+	// by clients, compatible with streams.TypeResolver. They are appended
+	// to the other callbacks of the SocialProtocol.
+	SocialCallbacks func(c context.Context) []interface{}
+	// Types handles the activities of the named types not handled by any
+	// callback, such as the types of an extension vocabulary.
+	Types map[string]func(c context.Context, a Activity) error
+	// Blocked is a policy determining whether the actors are blocked from
+	// interacting, in addition to the FederatingProtocol's own.
+	Blocked func(c context.Context, actorIRIs []*url.URL) (bool, error)
+}
+
+// plugins holds the registered plugins by name.
+var plugins = struct {
+	sync.RWMutex
+	m map[string]Plugin
+}{m: make(map[string]Plugin)}
+
+// RegisterPlugin makes the plugin available by its name. It panics if the name
+// is empty or already registered.
+func RegisterPlugin(p Plugin) {
+	if p.Name == "" {
+		panic("pub: RegisterPlugin plugin has no name")
+	}
+	plugins.Lock()
+	defer plugins.Unlock()
+	if _, ok := plugins.m[p.Name]; ok {
+		panic("pub: RegisterPlugin called twice for plugin " + p.Name)
+	}
+	plugins.m[p.Name] = p
+}
+
+// Plugins returns the names of the registered plugins, sorted.
+func Plugins() []string {
+	plugins.RLock()
+	defer plugins.RUnlock()
+	names := make([]string, 0, len(plugins.m))
+	for name := range plugins.m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PluginSet is a set of registered plugins enabled by an application, which its
+// FederatingProtocol and SocialProtocol call into. For example:
+//
+//	func (f *myFederating) Callbacks(c context.Context) (pub.FederatingWrappedCallbacks, []interface{}, error) {
+//	    return f.wrapped, f.plugins.FederatingCallbacks(c, f.other), nil
+//	}
+//
+//	func (f *myFederating) DefaultCallback(c context.Context, a pub.Activity) error {
+//	    if handled, err := f.plugins.DefaultCallback(c, a); handled {
+//	        return err
+//	    }
+//	    return nil
+//	}
+//
+// Plugins are called in the order they were enabled in.
+type PluginSet struct {
+	plugins []Plugin
+}
+
+// NewPluginSet enables the registered plugins by name. An error is returned if
+// one is not registered.
+func NewPluginSet(names ...string) (*PluginSet, error) {
+	plugins.RLock()
+	defer plugins.RUnlock()
+	s := &PluginSet{}
+	for _, name := range names {
+		p, ok := plugins.m[name]
+		if !ok {
+			return nil, fmt.Errorf("plugin %q is not registered", name)
+		}
+		s.plugins = append(s.plugins, p)
+	}
+	return s, nil
+}
+
+// FederatingCallbacks returns the other callbacks with the federating callbacks
+// of the plugins appended.
+func (s *PluginSet) FederatingCallbacks(c context.Context, other []interface{}) []interface{} {
+	for _, p := range s.plugins {
+		if p.FederatingCallbacks != nil {
+			other = append(other, p.FederatingCallbacks(c)...)
+		}
+	}
+	return other
+}
+
+// SocialCallbacks returns the other callbacks with the social callbacks of the
+// plugins appended.
+func (s *PluginSet) SocialCallbacks(c context.Context, other []interface{}) []interface{} {
+	for _, p := range s.plugins {
+		if p.SocialCallbacks != nil {
+			other = append(other, p.SocialCallbacks(c)...)
+		}
+	}
+	return other
+}
+
+// DefaultCallback handles the activity with the first plugin handling its type,
+// and returns whether one did.
+func (s *PluginSet) DefaultCallback(c context.Context, a Activity) (handled bool, err error) {
+	name := a.GetTypeName()
+	for _, p := range s.plugins {
+		if fn, ok := p.Types[name]; ok {
+			return true, fn(c, a)
+		}
+	}
+	return false, nil
+}
+
+// Blocked determines whether any plugin's policy blocks the actors.
+func (s *PluginSet) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	for _, p := range s.plugins {
+		if p.Blocked == nil {
+			continue
+		}
+		if blocked, err := p.Blocked(c, actorIRIs); err != nil || blocked {
+			return blocked, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,118 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// unregisterPlugin removes the registered plugin.
+func unregisterPlugin(name string) {
+	plugins.Lock()
+	defer plugins.Unlock()
+	delete(plugins.m, name)
+}
+
+func TestPlugins(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	var listened []string
+	RegisterPlugin(Plugin{
+		Name: "test-listens",
+		FederatingCallbacks: func(c context.Context) []interface{} {
+			return []interface{}{
+				func(c context.Context, a vocab.ActivityStreamsListen) error {
+					listened = append(listened, "federating")
+					return nil
+				},
+			}
+		},
+		Types: map[string]func(c context.Context, a Activity) error{
+			"Listen": func(c context.Context, a Activity) error {
+				listened = append(listened, "default")
+				return nil
+			},
+		},
+	})
+	defer unregisterPlugin("test-listens")
+	RegisterPlugin(Plugin{
+		Name: "test-policy",
+		Types: map[string]func(c context.Context, a Activity) error{
+			"Listen": func(c context.Context, a Activity) error {
+				return errors.New("shadowed")
+			},
+		},
+		Blocked: func(c context.Context, actorIRIs []*url.URL) (bool, error) {
+			for _, iri := range actorIRIs {
+				if iri.Host == "blocked.example.com" {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	})
+	defer unregisterPlugin("test-policy")
+	t.Run("ListsPlugins", func(t *testing.T) {
+		assertEqual(t, fmt.Sprint(Plugins()), "[test-listens test-policy]")
+	})
+	t.Run("PanicsOnDuplicate", func(t *testing.T) {
+		defer func() {
+			assertNotEqual(t, recover(), nil)
+		}()
+		RegisterPlugin(Plugin{Name: "test-policy"})
+	})
+	t.Run("RejectsUnregistered", func(t *testing.T) {
+		_, err := NewPluginSet("test-listens", "test-missing")
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("AppendsCallbacks", func(t *testing.T) {
+		listened = nil
+		s, err := NewPluginSet("test-listens")
+		assertEqual(t, err, nil)
+		other := s.FederatingCallbacks(ctx, []interface{}{
+			func(c context.Context, a vocab.ActivityStreamsNote) error {
+				return nil
+			},
+		})
+		assertEqual(t, len(other), 2)
+		assertEqual(t, len(s.SocialCallbacks(ctx, nil)), 0)
+		err = other[1].(func(context.Context, vocab.ActivityStreamsListen) error)(ctx, testListen)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(listened), "[federating]")
+	})
+	t.Run("HandlesTypesInOrder", func(t *testing.T) {
+		listened = nil
+		s, err := NewPluginSet("test-listens", "test-policy")
+		assertEqual(t, err, nil)
+		handled, err := s.DefaultCallback(ctx, testListen)
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(listened), "[default]")
+		s, err = NewPluginSet("test-policy")
+		assertEqual(t, err, nil)
+		handled, err = s.DefaultCallback(ctx, testListen)
+		assertEqual(t, handled, true)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("DoesNotHandleOtherTypes", func(t *testing.T) {
+		s, err := NewPluginSet("test-listens")
+		assertEqual(t, err, nil)
+		handled, err := s.DefaultCallback(ctx, testCreate)
+		assertEqual(t, handled, false)
+		assertEqual(t, err, nil)
+	})
+	t.Run("AppliesPolicies", func(t *testing.T) {
+		s, err := NewPluginSet("test-listens", "test-policy")
+		assertEqual(t, err, nil)
+		blocked, err := s.Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)})
+		assertEqual(t, blocked, false)
+		assertEqual(t, err, nil)
+		blocked, err = s.Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI), mustParse("https://blocked.example.com/mallory")})
+		assertEqual(t, blocked, true)
+		assertEqual(t, err, nil)
+	})
+}
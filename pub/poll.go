@@ -0,0 +1,237 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// PollOption is an option of a Question with the number of votes for it.
+type PollOption struct {
+	// Name is the 'name' of the option, which votes repeat.
+	Name string
+	// Votes is the 'totalItems' of the 'replies' of the option.
+	Votes int
+}
+
+// Poll describes a Question the way peers such as Mastodon federate polls:
+// its options are the 'oneOf' or 'anyOf' Notes with their votes counted in
+// the 'totalItems' of their 'replies', and it closes at its 'endTime', or
+// when it has a 'closed' property.
+type Poll struct {
+	// Multiple is whether voters may choose several options, of an 'anyOf'
+	// Question, instead of one of the options of a 'oneOf' Question.
+	Multiple bool
+	// Options are the options of the Question, in order.
+	Options []PollOption
+	// VotersCount is the number of actors who voted, from the
+	// "votersCount" of the Question.
+	VotersCount int
+	// EndTime is when the Question closes, or zero if it does not say.
+	EndTime time.Time
+	// Closed is whether the Question has been closed, by a 'closed'
+	// property other than false.
+	Closed bool
+	// ClosedAt is the time of the 'closed' property, or zero if it does
+	// not say.
+	ClosedAt time.Time
+}
+
+// pollOption is an ActivityStreams type that can be an option of a Question.
+type pollOption interface {
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+	GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty
+	SetActivityStreamsReplies(i vocab.ActivityStreamsRepliesProperty)
+}
+
+// PollOf returns the Poll of the Question. Options without a name are
+// ignored.
+func PollOf(q vocab.ActivityStreamsQuestion) Poll {
+	var p Poll
+	options, multiple := questionOptions(q)
+	p.Multiple = multiple
+	for _, o := range options {
+		name := optionName(o)
+		if len(name) == 0 {
+			continue
+		}
+		p.Options = append(p.Options, PollOption{Name: name, Votes: optionVotes(o)})
+	}
+	if vc := q.GetTootVotersCount(); vc != nil && vc.IsXMLSchemaNonNegativeInteger() {
+		p.VotersCount = vc.Get()
+	}
+	if end := q.GetActivityStreamsEndTime(); end != nil && end.IsXMLSchemaDateTime() {
+		p.EndTime = end.Get()
+	}
+	if closed := q.GetActivityStreamsClosed(); closed != nil {
+		for iter := closed.Begin(); iter != closed.End(); iter = iter.Next() {
+			if iter.IsXMLSchemaBoolean() && !iter.GetXMLSchemaBoolean() {
+				continue
+			}
+			p.Closed = true
+			if iter.IsXMLSchemaDateTime() {
+				p.ClosedAt = iter.GetXMLSchemaDateTime()
+			}
+		}
+	}
+	return p
+}
+
+// IsClosed determines whether the Poll no longer accepts votes at the time.
+func (p Poll) IsClosed(now time.Time) bool {
+	return p.Closed || (!p.EndTime.IsZero() && !now.Before(p.EndTime))
+}
+
+// HasOption determines whether the Poll has an option of the name.
+func (p Poll) HasOption(name string) bool {
+	for _, o := range p.Options {
+		if o.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// PollVote is a vote by a peer in a Question owned by this server: a Note
+// 'inReplyTo' the Question, whose 'name' is the option chosen.
+type PollVote struct {
+	// Question is the Question voted in.
+	Question vocab.ActivityStreamsQuestion
+	// Voter is the 'attributedTo' of the Note, or the 'actor' of the
+	// Create if it has none.
+	Voter *url.URL
+	// Choice is the name of the option chosen.
+	Choice string
+	// Note is the vote.
+	Note vocab.ActivityStreamsNote
+}
+
+// TallyVote counts a vote for the option of the Question in the 'totalItems'
+// of its 'replies', and counts a new voter in its "votersCount".
+//
+// Whether the voter is new, and whether they may vote for several options, is
+// for the application to determine from the votes it keeps: peers send each
+// option chosen in an 'anyOf' Question as its own vote.
+func TallyVote(q vocab.ActivityStreamsQuestion, choice string, newVoter bool) error {
+	options, _ := questionOptions(q)
+	var option pollOption
+	for _, o := range options {
+		if optionName(o) == choice {
+			option = o
+			break
+		}
+	}
+	if option == nil {
+		return fmt.Errorf("question has no option %q", choice)
+	}
+	replies := option.GetActivityStreamsReplies()
+	if replies == nil || !replies.IsActivityStreamsCollection() {
+		replies = streams.NewActivityStreamsRepliesProperty()
+		replies.SetActivityStreamsCollection(streams.NewActivityStreamsCollection())
+		option.SetActivityStreamsReplies(replies)
+	}
+	col := replies.GetActivityStreamsCollection()
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(optionVotes(option) + 1)
+	col.SetActivityStreamsTotalItems(total)
+	if newVoter {
+		vc := streams.NewTootVotersCountProperty()
+		vc.Set(PollOf(q).VotersCount + 1)
+		q.SetTootVotersCount(vc)
+	}
+	return nil
+}
+
+// questionOptions returns the options of the Question, and whether they are
+// its 'anyOf' options rather than its 'oneOf' ones.
+func questionOptions(q vocab.ActivityStreamsQuestion) (options []pollOption, multiple bool) {
+	if anyOf := q.GetActivityStreamsAnyOf(); anyOf != nil && anyOf.Len() > 0 {
+		for iter := anyOf.Begin(); iter != anyOf.End(); iter = iter.Next() {
+			if o, ok := iter.GetType().(pollOption); ok {
+				options = append(options, o)
+			}
+		}
+		return options, true
+	}
+	if oneOf := q.GetActivityStreamsOneOf(); oneOf != nil {
+		for iter := oneOf.Begin(); iter != oneOf.End(); iter = iter.Next() {
+			if o, ok := iter.GetType().(pollOption); ok {
+				options = append(options, o)
+			}
+		}
+	}
+	return options, false
+}
+
+// optionName returns the first 'name' of the option.
+func optionName(o pollOption) string {
+	if name := o.GetActivityStreamsName(); name != nil && name.Len() > 0 && name.At(0).IsXMLSchemaString() {
+		return name.At(0).GetXMLSchemaString()
+	}
+	return ""
+}
+
+// optionVotes returns the 'totalItems' of the 'replies' of the option.
+func optionVotes(o pollOption) int {
+	replies := o.GetActivityStreamsReplies()
+	if replies == nil || !replies.IsActivityStreamsCollection() {
+		return 0
+	}
+	total := replies.GetActivityStreamsCollection().GetActivityStreamsTotalItems()
+	if total == nil || !total.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+	return total.Get()
+}
+
+// vote calls Vote if the value is a vote in a Question owned by this server: a
+// Note naming an option of the Question it is 'inReplyTo'. Votes in closed
+// Questions are dropped. It returns whether the value is a vote, which is not
+// created in the Database.
+func (w FederatingWrappedCallbacks) vote(c context.Context, a vocab.ActivityStreamsCreate, t vocab.Type) (bool, error) {
+	note, ok := t.(vocab.ActivityStreamsNote)
+	if !ok || note.GetActivityStreamsInReplyTo() == nil || note.GetActivityStreamsInReplyTo().Len() != 1 {
+		return false, nil
+	}
+	choice := optionName(note)
+	if len(choice) == 0 {
+		return false, nil
+	}
+	qId, err := ToId(note.GetActivityStreamsInReplyTo().At(0))
+	if err != nil {
+		return false, nil
+	}
+	if err := w.db.Lock(c, qId); err != nil {
+		return false, err
+	}
+	defer w.db.Unlock(c, qId)
+	if owns, err := w.db.Owns(c, qId); err != nil || !owns {
+		return false, err
+	}
+	qt, err := w.db.Get(c, qId)
+	if err != nil {
+		return false, err
+	}
+	q, ok := qt.(vocab.ActivityStreamsQuestion)
+	if !ok {
+		return false, nil
+	}
+	poll := PollOf(q)
+	if !poll.HasOption(choice) {
+		return false, nil
+	} else if w.clock != nil && poll.IsClosed(w.clock.Now()) {
+		return true, nil
+	}
+	voters := authorsOf(note)
+	if len(voters) == 0 {
+		voters = authorsOf(a)
+	}
+	if len(voters) == 0 {
+		return true, fmt.Errorf("vote in %s has no voter", qId)
+	}
+	return true, w.Vote(c, a, PollVote{Question: q, Voter: voters[0], Choice: choice, Note: note})
+}
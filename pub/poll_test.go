@@ -0,0 +1,173 @@
+package pub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+const testQuestionId = "https://example.com/addison/question/1"
+
+// newQuestion returns the testQuestionId Question with 'oneOf' options of the
+// names, or 'anyOf' options if multiple.
+func newQuestion(multiple bool, names ...string) vocab.ActivityStreamsQuestion {
+	q := streams.NewActivityStreamsQuestion()
+	q.SetActivityStreamsId(newIdProperty(mustParse(testQuestionId)))
+	oneOf := streams.NewActivityStreamsOneOfProperty()
+	anyOf := streams.NewActivityStreamsAnyOfProperty()
+	for _, name := range names {
+		n := streams.NewActivityStreamsNote()
+		np := streams.NewActivityStreamsNameProperty()
+		np.AppendXMLSchemaString(name)
+		n.SetActivityStreamsName(np)
+		if multiple {
+			anyOf.AppendActivityStreamsNote(n)
+		} else {
+			oneOf.AppendActivityStreamsNote(n)
+		}
+	}
+	if multiple {
+		q.SetActivityStreamsAnyOf(anyOf)
+	} else {
+		q.SetActivityStreamsOneOf(oneOf)
+	}
+	return q
+}
+
+// newVote returns a Create of a Note by the actor naming the choice, in reply
+// to the testQuestionId Question.
+func newVote(actor, choice string) vocab.ActivityStreamsCreate {
+	n := streams.NewActivityStreamsNote()
+	n.SetActivityStreamsId(newIdProperty(mustParse("https://other.example.com/vote/1")))
+	np := streams.NewActivityStreamsNameProperty()
+	np.AppendXMLSchemaString(choice)
+	n.SetActivityStreamsName(np)
+	at := streams.NewActivityStreamsAttributedToProperty()
+	at.AppendIRI(mustParse(actor))
+	n.SetActivityStreamsAttributedTo(at)
+	irt := streams.NewActivityStreamsInReplyToProperty()
+	irt.AppendIRI(mustParse(testQuestionId))
+	n.SetActivityStreamsInReplyTo(irt)
+	c := streams.NewActivityStreamsCreate()
+	ap := streams.NewActivityStreamsActorProperty()
+	ap.AppendIRI(mustParse(actor))
+	c.SetActivityStreamsActor(ap)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(n)
+	c.SetActivityStreamsObject(op)
+	return c
+}
+
+func TestPollOf(t *testing.T) {
+	t.Run("ReadsOneOf", func(t *testing.T) {
+		q := newQuestion(false, "yes", "no")
+		assertEqual(t, TallyVote(q, "no", true), nil)
+		p := PollOf(q)
+		assertEqual(t, p.Multiple, false)
+		assertEqual(t, len(p.Options), 2)
+		assertEqual(t, p.Options[0], PollOption{Name: "yes"})
+		assertEqual(t, p.Options[1], PollOption{Name: "no", Votes: 1})
+		assertEqual(t, p.VotersCount, 1)
+		assertEqual(t, p.HasOption("no"), true)
+		assertEqual(t, p.HasOption("maybe"), false)
+	})
+	t.Run("ReadsAnyOf", func(t *testing.T) {
+		q := newQuestion(true, "red", "blue")
+		assertEqual(t, TallyVote(q, "red", true), nil)
+		assertEqual(t, TallyVote(q, "blue", false), nil)
+		assertEqual(t, TallyVote(q, "blue", true), nil)
+		p := PollOf(q)
+		assertEqual(t, p.Multiple, true)
+		assertEqual(t, p.Options[0].Votes, 1)
+		assertEqual(t, p.Options[1].Votes, 2)
+		assertEqual(t, p.VotersCount, 2)
+	})
+	t.Run("ClosesAtEndTime", func(t *testing.T) {
+		q := newQuestion(false, "yes", "no")
+		end := streams.NewActivityStreamsEndTimeProperty()
+		end.Set(now())
+		q.SetActivityStreamsEndTime(end)
+		p := PollOf(q)
+		assertEqual(t, p.IsClosed(now().Add(-time.Minute)), false)
+		assertEqual(t, p.IsClosed(now()), true)
+	})
+	t.Run("ClosesWhenClosed", func(t *testing.T) {
+		q := newQuestion(false, "yes", "no")
+		closed := streams.NewActivityStreamsClosedProperty()
+		closed.AppendXMLSchemaDateTime(now())
+		q.SetActivityStreamsClosed(closed)
+		p := PollOf(q)
+		assertEqual(t, p.IsClosed(now().Add(-time.Hour)), true)
+		assertEqual(t, p.ClosedAt.Equal(now()), true)
+	})
+	t.Run("OpenWhenClosedIsFalse", func(t *testing.T) {
+		q := newQuestion(false, "yes", "no")
+		closed := streams.NewActivityStreamsClosedProperty()
+		closed.AppendXMLSchemaBoolean(false)
+		q.SetActivityStreamsClosed(closed)
+		assertEqual(t, PollOf(q).IsClosed(now()), false)
+	})
+	t.Run("TallyErrorsForUnknownOption", func(t *testing.T) {
+		assertNotEqual(t, TallyVote(newQuestion(false, "yes"), "no", true), nil)
+	})
+}
+
+func TestFederatedVote(t *testing.T) {
+	ctx := context.Background()
+	setup := func(ctl *gomock.Controller, q vocab.ActivityStreamsQuestion) (*MockDatabase, *MockClock) {
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, mustParse(testQuestionId))
+		db.EXPECT().Owns(ctx, mustParse(testQuestionId)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testQuestionId)).Return(q, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testQuestionId))
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now()).AnyTimes()
+		return db, clock
+	}
+	t.Run("CallsVoteInsteadOfCreating", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, clock := setup(ctl, newQuestion(false, "yes", "no"))
+		var got PollVote
+		w := FederatingWrappedCallbacks{db: db, clock: clock, Vote: func(c context.Context, create vocab.ActivityStreamsCreate, v PollVote) error {
+			got = v
+			return TallyVote(v.Question, v.Choice, true)
+		}}
+		assertEqual(t, w.create(ctx, newVote(testFederatedActorIRI, "no")), nil)
+		assertEqual(t, got.Voter.String(), testFederatedActorIRI)
+		assertEqual(t, got.Choice, "no")
+		assertEqual(t, PollOf(got.Question).Options[1].Votes, 1)
+	})
+	t.Run("DropsVoteInClosedQuestion", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := newQuestion(false, "yes", "no")
+		end := streams.NewActivityStreamsEndTimeProperty()
+		end.Set(now().Add(-time.Hour))
+		q.SetActivityStreamsEndTime(end)
+		db, clock := setup(ctl, q)
+		w := FederatingWrappedCallbacks{db: db, clock: clock, Vote: func(c context.Context, create vocab.ActivityStreamsCreate, v PollVote) error {
+			t.Fatalf("vote in closed question")
+			return nil
+		}}
+		assertEqual(t, w.create(ctx, newVote(testFederatedActorIRI, "no")), nil)
+	})
+	t.Run("CreatesReplyNamingNoOption", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, clock := setup(ctl, newQuestion(false, "yes", "no"))
+		voteId := mustParse("https://other.example.com/vote/1")
+		db.EXPECT().Lock(ctx, voteId)
+		db.EXPECT().Create(ctx, gomock.Any())
+		db.EXPECT().Unlock(ctx, voteId)
+		w := FederatingWrappedCallbacks{db: db, clock: clock, Vote: func(c context.Context, create vocab.ActivityStreamsCreate, v PollVote) error {
+			t.Fatalf("reply counted as a vote")
+			return nil
+		}}
+		assertEqual(t, w.create(ctx, newVote(testFederatedActorIRI, "maybe")), nil)
+	})
+}
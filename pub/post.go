@@ -0,0 +1,165 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// Visibility determines whom a Post is addressed to.
+type Visibility int
+
+const (
+	// PublicVisibility addresses a post to the public, and copies the
+	// author's followers, so it appears in public timelines.
+	PublicVisibility Visibility = iota
+	// UnlistedVisibility addresses a post to the author's followers, and
+	// copies the public, so it is public but kept out of public timelines.
+	UnlistedVisibility
+	// FollowersVisibility addresses a post to the author's followers only.
+	FollowersVisibility
+	// DirectVisibility addresses a post to the mentioned actors only.
+	DirectVisibility
+)
+
+// PostAuthor identifies the actor submitting a Post.
+type PostAuthor struct {
+	// Actor is the IRI of the actor.
+	Actor *url.URL
+	// Outbox is the IRI of the actor's outbox.
+	Outbox *url.URL
+	// Followers is the IRI of the actor's followers collection.
+	Followers *url.URL
+}
+
+// Post is a note submitted by an application without building the
+// ActivityStreams types itself. SubmitPost builds the Note for it, addressed
+// and tagged the way peer software expects.
+type Post struct {
+	// Content is the HTML content of the note.
+	Content string
+	// Summary is the content warning of the note, shown instead of the
+	// content until the reader chooses to see it. Optional.
+	Summary string
+	// Visibility determines whom the note is addressed to.
+	Visibility Visibility
+	// InReplyTo is the IRI of the object the note replies to. Optional.
+	InReplyTo *url.URL
+	// Mentions are the IRIs of the actors mentioned in the content, who
+	// are addressed and tagged.
+	Mentions []*url.URL
+	// Attachments are the media attached to the note.
+	Attachments []Attachment
+	// Emojis are the custom emojis available to the note. Those whose
+	// :shortcode: appears in the content or summary are tagged.
+	Emojis []Emoji
+}
+
+// Note builds the Note of the post by the author, published at the time.
+//
+// Direct posts without mentions are an error, since they would be addressed to
+// no one.
+func (p Post) Note(author PostAuthor, published time.Time) (vocab.ActivityStreamsNote, error) {
+	if author.Actor == nil {
+		return nil, fmt.Errorf("post author has no actor IRI")
+	}
+	var to, cc []*url.URL
+	public := mustParsePublic()
+	switch p.Visibility {
+	case PublicVisibility:
+		to = []*url.URL{public}
+		cc = append([]*url.URL{author.Followers}, p.Mentions...)
+	case UnlistedVisibility:
+		to = []*url.URL{author.Followers}
+		cc = append([]*url.URL{public}, p.Mentions...)
+	case FollowersVisibility:
+		to = append([]*url.URL{author.Followers}, p.Mentions...)
+	case DirectVisibility:
+		if len(p.Mentions) == 0 {
+			return nil, fmt.Errorf("direct post mentions no one")
+		}
+		to = p.Mentions
+	default:
+		return nil, fmt.Errorf("unknown post visibility %d", p.Visibility)
+	}
+	if p.Visibility != DirectVisibility && author.Followers == nil {
+		return nil, fmt.Errorf("post author has no followers IRI")
+	}
+	n := streams.NewActivityStreamsNote()
+	attributedTo := streams.NewActivityStreamsAttributedToProperty()
+	attributedTo.AppendIRI(author.Actor)
+	n.SetActivityStreamsAttributedTo(attributedTo)
+	publishedProp := streams.NewActivityStreamsPublishedProperty()
+	publishedProp.Set(published)
+	n.SetActivityStreamsPublished(publishedProp)
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString(p.Content)
+	n.SetActivityStreamsContent(content)
+	if len(p.Summary) > 0 {
+		summary := streams.NewActivityStreamsSummaryProperty()
+		summary.AppendXMLSchemaString(p.Summary)
+		n.SetActivityStreamsSummary(summary)
+	}
+	if p.InReplyTo != nil {
+		inReplyTo := streams.NewActivityStreamsInReplyToProperty()
+		inReplyTo.AppendIRI(p.InReplyTo)
+		n.SetActivityStreamsInReplyTo(inReplyTo)
+	}
+	toProp := streams.NewActivityStreamsToProperty()
+	for _, iri := range to {
+		toProp.AppendIRI(iri)
+	}
+	n.SetActivityStreamsTo(toProp)
+	if len(cc) > 0 {
+		ccProp := streams.NewActivityStreamsCcProperty()
+		for _, iri := range cc {
+			ccProp.AppendIRI(iri)
+		}
+		n.SetActivityStreamsCc(ccProp)
+	}
+	if len(p.Mentions) > 0 {
+		tag := streams.NewActivityStreamsTagProperty()
+		for _, iri := range p.Mentions {
+			m := streams.NewActivityStreamsMention()
+			href := streams.NewActivityStreamsHrefProperty()
+			href.Set(iri)
+			m.SetActivityStreamsHref(href)
+			tag.AppendActivityStreamsMention(m)
+		}
+		n.SetActivityStreamsTag(tag)
+	}
+	if err := AppendAttachments(n, p.Attachments...); err != nil {
+		return nil, err
+	}
+	if err := TagEmojis(n, FindEmojis(p.Summary+" "+p.Content, p.Emojis)); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// SubmitPost builds the Note of the post by the author, published at the time
+// of the clock, and sends it from the author's outbox like the actor's Send:
+// it is wrapped in a Create, added to the outbox, and delivered.
+func SubmitPost(c context.Context, a FederatingActor, clock Clock, author PostAuthor, p Post) (Activity, error) {
+	if author.Outbox == nil {
+		return nil, fmt.Errorf("post author has no outbox IRI")
+	}
+	n, err := p.Note(author, clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	return a.Send(c, author.Outbox, n)
+}
+
+// mustParsePublic returns the IRI addressing the public.
+func mustParsePublic() *url.URL {
+	u, err := url.Parse(PublicActivityPubIRI)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
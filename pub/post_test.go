@@ -0,0 +1,109 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestPostNote(t *testing.T) {
+	published := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	author := PostAuthor{
+		Actor:     mustParse(testPersonIRI),
+		Outbox:    mustParse(testMyOutboxIRI),
+		Followers: mustParse(testPersonIRI + "/followers"),
+	}
+	mention := mustParse(testFederatedActorIRI)
+	// addressing returns the to and cc of the Note of the post.
+	addressing := func(t *testing.T, p Post) (to, cc string) {
+		n, err := p.Note(author, published)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := serialize(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fmt.Sprint(m["to"]), fmt.Sprint(m["cc"])
+	}
+	t.Run("Public", func(t *testing.T) {
+		to, cc := addressing(t, Post{Content: "Hi", Mentions: []*url.URL{mention}})
+		assertEqual(t, to, PublicActivityPubIRI)
+		assertEqual(t, cc, fmt.Sprint([]interface{}{testPersonIRI + "/followers", testFederatedActorIRI}))
+	})
+	t.Run("Unlisted", func(t *testing.T) {
+		to, cc := addressing(t, Post{Content: "Hi", Visibility: UnlistedVisibility})
+		assertEqual(t, to, testPersonIRI+"/followers")
+		assertEqual(t, cc, PublicActivityPubIRI)
+	})
+	t.Run("Followers", func(t *testing.T) {
+		to, cc := addressing(t, Post{Content: "Hi", Visibility: FollowersVisibility})
+		assertEqual(t, to, testPersonIRI+"/followers")
+		assertEqual(t, cc, "<nil>")
+	})
+	t.Run("Direct", func(t *testing.T) {
+		to, cc := addressing(t, Post{Content: "Hi", Visibility: DirectVisibility, Mentions: []*url.URL{mention}})
+		assertEqual(t, to, testFederatedActorIRI)
+		assertEqual(t, cc, "<nil>")
+		_, err := Post{Content: "Hi", Visibility: DirectVisibility}.Note(author, published)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("BuildsProperties", func(t *testing.T) {
+		n, err := Post{
+			Content:     "<p>Hi</p>",
+			Summary:     "greeting",
+			InReplyTo:   mustParse(testNoteId1),
+			Mentions:    []*url.URL{mention},
+			Attachments: []Attachment{{URL: mustParse("https://example.com/a.png"), MediaType: "image/png", Description: "A cat"}},
+		}.Note(author, published)
+		assertEqual(t, err, nil)
+		m, err := serialize(n)
+		assertEqual(t, err, nil)
+		b, err := json.Marshal(m)
+		assertEqual(t, err, nil)
+		var got struct {
+			Type         string
+			AttributedTo string
+			Published    string
+			Content      string
+			Summary      string
+			InReplyTo    string
+			Tag          map[string]string
+			Attachment   map[string]string
+		}
+		assertEqual(t, json.Unmarshal(b, &got), nil)
+		assertEqual(t, got.Type, "Note")
+		assertEqual(t, got.AttributedTo, testPersonIRI)
+		assertEqual(t, got.Published, "2020-01-02T03:04:05Z")
+		assertEqual(t, got.Content, "<p>Hi</p>")
+		assertEqual(t, got.Summary, "greeting")
+		assertEqual(t, got.InReplyTo, testNoteId1)
+		assertEqual(t, got.Tag["type"], "Mention")
+		assertEqual(t, got.Tag["href"], testFederatedActorIRI)
+		assertEqual(t, got.Attachment["type"], "Image")
+		assertEqual(t, got.Attachment["name"], "A cat")
+	})
+}
+
+func TestSubmitPost(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	a := &sendingActor{}
+	author := PostAuthor{
+		Actor:     mustParse(testPersonIRI),
+		Outbox:    mustParse(testMyOutboxIRI),
+		Followers: mustParse(testPersonIRI + "/followers"),
+	}
+	_, err := SubmitPost(context.Background(), a, NewFakeClock(now), author, Post{Content: "Hi"})
+	assertEqual(t, err, nil)
+	assertEqual(t, a.outbox, testMyOutboxIRI)
+	assertEqual(t, a.sent.GetTypeName(), "Note")
+	// The Note is wrapped in a Create with its addressing.
+	c, err := wrapInCreate(context.Background(), a.sent, author.Actor)
+	assertEqual(t, err, nil)
+	assertEqual(t, c.GetActivityStreamsTo().Len(), 1)
+	assertEqual(t, c.GetActivityStreamsCc().Len(), 1)
+	assertEqual(t, c.GetActivityStreamsBto() == nil, true)
+}
@@ -0,0 +1,235 @@
+package pub
+
+import (
+	"context"
+	"html"
+	"net/url"
+	"regexp"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+var (
+	// hrefRegexp matches the href attributes of the links in HTML content.
+	hrefRegexp = regexp.MustCompile(`<a\s[^>]*href\s*=\s*["']([^"']+)["']`)
+	// bareLinkRegexp matches the links written in plain text content.
+	bareLinkRegexp = regexp.MustCompile(`https?://[^\s<>"']+`)
+)
+
+// LinkPreview describes the web page a link in an object's content leads to, as
+// displayed in a card below the content.
+type LinkPreview struct {
+	// URL is the location of the page.
+	URL *url.URL
+	// Title is the title of the page.
+	Title string
+	// Description is a summary of the page. Optional.
+	Description string
+	// Image is the location of an image representing the page. Optional.
+	Image *url.URL
+}
+
+// PreviewFetcher may be implemented by a CommonBehavior to attach a preview of
+// the first link in the content of the objects its actors Create and Update.
+// The objects' 'preview' property is a Page describing the link.
+//
+// Objects already having a 'preview' are left unchanged.
+type PreviewFetcher interface {
+	// FetchPreview returns the preview of the web page, or nil if it has
+	// none. Errors are ignored, so that the objects are still posted
+	// without a preview.
+	FetchPreview(c context.Context, link *url.URL) (*LinkPreview, error)
+}
+
+// previewer is an ActivityStreams type with a 'preview' property.
+type previewer interface {
+	GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty
+	SetActivityStreamsPreview(i vocab.ActivityStreamsPreviewProperty)
+}
+
+// FirstLink returns the first link to a web page in the content, skipping the
+// links to the tags of the object, such as its mentions and hashtags.
+func FirstLink(content string, tags vocab.ActivityStreamsTagProperty) (*url.URL, bool) {
+	skip := make(map[string]bool)
+	if tags != nil {
+		for iter := tags.Begin(); iter != tags.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				skip[id.String()] = true
+			}
+			if h, ok := iter.GetType().(hrefer); ok && h.GetActivityStreamsHref() != nil && h.GetActivityStreamsHref().IsIRI() {
+				skip[h.GetActivityStreamsHref().GetIRI().String()] = true
+			}
+		}
+	}
+	var links []string
+	if m := hrefRegexp.FindAllStringSubmatch(content, -1); len(m) > 0 {
+		for _, v := range m {
+			links = append(links, html.UnescapeString(v[1]))
+		}
+	} else {
+		links = bareLinkRegexp.FindAllString(content, -1)
+	}
+	for _, l := range links {
+		u, err := url.Parse(l)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || len(u.Host) == 0 || skip[u.String()] {
+			continue
+		}
+		return u, true
+	}
+	return nil, false
+}
+
+// NewPreviewPage builds the Page of the preview, for an object's 'preview'
+// property.
+func NewPreviewPage(p LinkPreview) vocab.ActivityStreamsPage {
+	page := streams.NewActivityStreamsPage()
+	u := streams.NewActivityStreamsUrlProperty()
+	u.AppendIRI(p.URL)
+	page.SetActivityStreamsUrl(u)
+	if len(p.Title) > 0 {
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString(p.Title)
+		page.SetActivityStreamsName(name)
+	}
+	if len(p.Description) > 0 {
+		summary := streams.NewActivityStreamsSummaryProperty()
+		summary.AppendXMLSchemaString(p.Description)
+		page.SetActivityStreamsSummary(summary)
+	}
+	if p.Image != nil {
+		image := streams.NewActivityStreamsImageProperty()
+		image.AppendIRI(p.Image)
+		page.SetActivityStreamsImage(image)
+	}
+	return page
+}
+
+// GetLinkPreview reads the preview of a link of an object, such as a Note
+// received from a peer, from the first Page, Document, or Link of its
+// 'preview' property that has a url.
+func GetLinkPreview(o vocab.Type) (LinkPreview, bool) {
+	pv, ok := o.(previewer)
+	if !ok || pv.GetActivityStreamsPreview() == nil {
+		return LinkPreview{}, false
+	}
+	preview := pv.GetActivityStreamsPreview()
+	for iter := preview.Begin(); iter != preview.End(); iter = iter.Next() {
+		var p LinkPreview
+		switch {
+		case iter.IsActivityStreamsPage():
+			p = readPreviewObject(iter.GetActivityStreamsPage())
+		case iter.IsActivityStreamsDocument():
+			p = readPreviewObject(iter.GetActivityStreamsDocument())
+		case iter.IsActivityStreamsLink():
+			l := iter.GetActivityStreamsLink()
+			if l.GetActivityStreamsHref() != nil {
+				p.URL = l.GetActivityStreamsHref().GetIRI()
+			}
+			if name := l.GetActivityStreamsName(); name != nil && name.Len() > 0 && name.At(0).IsXMLSchemaString() {
+				p.Title = name.At(0).GetXMLSchemaString()
+			}
+		}
+		if p.URL != nil {
+			return p, true
+		}
+	}
+	return LinkPreview{}, false
+}
+
+// previewObject is an ActivityStreams type describing a linked web page.
+type previewObject interface {
+	GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+	GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty
+	GetActivityStreamsImage() vocab.ActivityStreamsImageProperty
+	GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty
+}
+
+// readPreviewObject reads the preview described by the object.
+func readPreviewObject(o previewObject) (p LinkPreview) {
+	if u := o.GetActivityStreamsUrl(); u != nil {
+		for iter := u.Begin(); iter != u.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				p.URL = id
+				break
+			}
+			if iter.IsActivityStreamsLink() && iter.GetActivityStreamsLink().GetActivityStreamsHref() != nil {
+				p.URL = iter.GetActivityStreamsLink().GetActivityStreamsHref().GetIRI()
+				break
+			}
+		}
+	}
+	if name := o.GetActivityStreamsName(); name != nil && name.Len() > 0 && name.At(0).IsXMLSchemaString() {
+		p.Title = name.At(0).GetXMLSchemaString()
+	}
+	if summary := o.GetActivityStreamsSummary(); summary != nil && summary.Len() > 0 && summary.At(0).IsXMLSchemaString() {
+		p.Description = summary.At(0).GetXMLSchemaString()
+	}
+	if image := o.GetActivityStreamsImage(); image != nil && image.Len() > 0 {
+		p.Image = previewImage(image.At(0))
+	} else if icon := o.GetActivityStreamsIcon(); icon != nil && icon.Len() > 0 {
+		p.Image = previewImage(icon.At(0))
+	}
+	return p
+}
+
+// previewImage returns the location of an image or icon, which is either its
+// IRI or the url of an embedded Image.
+func previewImage(iter interface {
+	IsIRI() bool
+	GetIRI() *url.URL
+	IsActivityStreamsImage() bool
+	GetActivityStreamsImage() vocab.ActivityStreamsImage
+}) *url.URL {
+	if iter.IsIRI() {
+		return iter.GetIRI()
+	} else if iter.IsActivityStreamsImage() {
+		if u := iter.GetActivityStreamsImage().GetActivityStreamsUrl(); u != nil && u.Len() > 0 && u.At(0).IsIRI() {
+			return u.At(0).GetIRI()
+		}
+	}
+	return nil
+}
+
+// attachPreviews sets the 'preview' of the objects of a Create or Update
+// activity without one to the preview of the first link in their content.
+func attachPreviews(c context.Context, f PreviewFetcher, activity Activity) {
+	if !streams.IsOrExtendsActivityStreamsCreate(activity) && !streams.IsOrExtendsActivityStreamsUpdate(activity) {
+		return
+	}
+	o, ok := activity.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return
+	}
+	op := o.GetActivityStreamsObject()
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		pv, isPreviewer := t.(previewer)
+		ct, isContenter := t.(contenter)
+		if !isPreviewer || !isContenter || pv.GetActivityStreamsPreview() != nil || ct.GetActivityStreamsContent() == nil {
+			continue
+		}
+		content := ct.GetActivityStreamsContent()
+		var tags vocab.ActivityStreamsTagProperty
+		if tg, ok := t.(tagger); ok {
+			tags = tg.GetActivityStreamsTag()
+		}
+		for ci := content.Begin(); ci != content.End(); ci = ci.Next() {
+			if !ci.IsXMLSchemaString() {
+				continue
+			}
+			link, ok := FirstLink(ci.GetXMLSchemaString(), tags)
+			if !ok {
+				continue
+			}
+			p, err := f.FetchPreview(c, link)
+			if err == nil && p != nil && p.URL != nil {
+				preview := streams.NewActivityStreamsPreviewProperty()
+				preview.AppendActivityStreamsPage(NewPreviewPage(*p))
+				pv.SetActivityStreamsPreview(preview)
+			}
+			break
+		}
+	}
+}
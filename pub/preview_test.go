@@ -0,0 +1,100 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// funcPreviewFetcher is a PreviewFetcher calling a function.
+type funcPreviewFetcher func(c context.Context, link *url.URL) (*LinkPreview, error)
+
+func (f funcPreviewFetcher) FetchPreview(c context.Context, link *url.URL) (*LinkPreview, error) {
+	return f(c, link)
+}
+
+func newContentCreate(content string, tags ...*url.URL) (vocab.ActivityStreamsCreate, vocab.ActivityStreamsNote) {
+	note := streams.NewActivityStreamsNote()
+	cp := streams.NewActivityStreamsContentProperty()
+	cp.AppendXMLSchemaString(content)
+	note.SetActivityStreamsContent(cp)
+	if len(tags) > 0 {
+		tp := streams.NewActivityStreamsTagProperty()
+		for _, iri := range tags {
+			m := streams.NewActivityStreamsMention()
+			href := streams.NewActivityStreamsHrefProperty()
+			href.Set(iri)
+			m.SetActivityStreamsHref(href)
+			tp.AppendActivityStreamsMention(m)
+		}
+		note.SetActivityStreamsTag(tp)
+	}
+	create := streams.NewActivityStreamsCreate()
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	create.SetActivityStreamsObject(op)
+	return create, note
+}
+
+func TestLinkPreview(t *testing.T) {
+	ctx := context.Background()
+	article := mustParse("https://news.example.com/article?id=1&lang=en")
+	t.Run("FirstLinkSkipsMentions", func(t *testing.T) {
+		_, note := newContentCreate("", mustParse(testFederatedActorIRI))
+		link, ok := FirstLink(`<p><a href="`+testFederatedActorIRI+`" class="mention">@dakota</a> see <a href="https://news.example.com/article?id=1&amp;lang=en">this</a></p>`,
+			note.GetActivityStreamsTag())
+		assertEqual(t, ok, true)
+		assertEqual(t, link.String(), article.String())
+	})
+	t.Run("FirstLinkInPlainText", func(t *testing.T) {
+		link, ok := FirstLink("see https://news.example.com/a and https://other.example.com/b", nil)
+		assertEqual(t, ok, true)
+		assertEqual(t, link.String(), "https://news.example.com/a")
+		_, ok = FirstLink("no links, mailto:someone@example.com", nil)
+		assertEqual(t, ok, false)
+	})
+	t.Run("AttachesPreview", func(t *testing.T) {
+		create, note := newContentCreate(`<p><a href="https://news.example.com/article?id=1&amp;lang=en">this</a></p>`)
+		var fetched *url.URL
+		attachPreviews(ctx, funcPreviewFetcher(func(c context.Context, link *url.URL) (*LinkPreview, error) {
+			fetched = link
+			return &LinkPreview{URL: link, Title: "Article", Description: "News", Image: mustParse("https://news.example.com/a.png")}, nil
+		}), create)
+		assertEqual(t, fetched.String(), article.String())
+		p, ok := GetLinkPreview(note)
+		assertEqual(t, ok, true)
+		assertEqual(t, p.URL.String(), article.String())
+		assertEqual(t, p.Title, "Article")
+		assertEqual(t, p.Description, "News")
+		assertEqual(t, p.Image.String(), "https://news.example.com/a.png")
+	})
+	t.Run("FetchErrorPostsWithoutPreview", func(t *testing.T) {
+		create, note := newContentCreate("https://news.example.com/a")
+		attachPreviews(ctx, funcPreviewFetcher(func(c context.Context, link *url.URL) (*LinkPreview, error) {
+			return nil, errors.New("timeout")
+		}), create)
+		assertEqual(t, note.GetActivityStreamsPreview() == nil, true)
+	})
+	t.Run("ReadsPeerPreview", func(t *testing.T) {
+		var m map[string]interface{}
+		err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://other.example.com/notes/1",
+  "type": "Note",
+  "preview": {"type": "Document", "name": "Article", "url": {"type": "Link", "href": "https://news.example.com/a"}, "icon": {"type": "Image", "url": "https://news.example.com/a.png"}}
+}`), &m)
+		assertEqual(t, err, nil)
+		note, err := streams.ToType(ctx, m)
+		assertEqual(t, err, nil)
+		p, ok := GetLinkPreview(note)
+		assertEqual(t, ok, true)
+		assertEqual(t, p.URL.String(), "https://news.example.com/a")
+		assertEqual(t, p.Title, "Article")
+		assertEqual(t, p.Image.String(), "https://news.example.com/a.png")
+	})
+}
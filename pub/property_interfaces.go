@@ -23,6 +23,7 @@ type targeter interface {
 // tagger is an ActivityStreams type with a 'tag' property
 type tagger interface {
 	GetActivityStreamsTag() vocab.ActivityStreamsTagProperty
+	SetActivityStreamsTag(i vocab.ActivityStreamsTagProperty)
 }
 
 // hrefer is an ActivityStreams type with a 'href' property
@@ -52,6 +53,12 @@ type updateder interface {
 	GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty
 }
 
+// updatedSetter is an ActivityStreams type whose 'updated' property can be set
+type updatedSetter interface {
+	updateder
+	SetActivityStreamsUpdated(i vocab.ActivityStreamsUpdatedProperty)
+}
+
 // toer is an ActivityStreams type with a 'to' property
 type toer interface {
 	GetActivityStreamsTo() vocab.ActivityStreamsToProperty
@@ -87,12 +94,29 @@ type inboxer interface {
 	GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
 }
 
+// outboxer is an ActivityStreams type with an 'outbox' property
+type outboxer interface {
+	GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty
+}
+
 // attributedToer is an ActivityStreams type with an 'attributedTo' property
 type attributedToer interface {
 	GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty
 	SetActivityStreamsAttributedTo(i vocab.ActivityStreamsAttributedToProperty)
 }
 
+// contenter is an ActivityStreams type with a 'content' property
+type contenter interface {
+	GetActivityStreamsContent() vocab.ActivityStreamsContentProperty
+	SetActivityStreamsContent(i vocab.ActivityStreamsContentProperty)
+}
+
+// totalItemser is an ActivityStreams type with a 'totalItems' property
+type totalItemser interface {
+	GetActivityStreamsTotalItems() vocab.ActivityStreamsTotalItemsProperty
+	SetActivityStreamsTotalItems(i vocab.ActivityStreamsTotalItemsProperty)
+}
+
 // likeser is an ActivityStreams type with a 'likes' property
 type likeser interface {
 	GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty
@@ -111,7 +135,35 @@ type actorer interface {
 	SetActivityStreamsActor(i vocab.ActivityStreamsActorProperty)
 }
 
+// unknownPropertieser is an ActivityStreams type with properties outside of
+// the generated vocabularies.
+type unknownPropertieser interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
 // appendIRIer is an ActivityStreams type that can Append IRIs.
 type appendIRIer interface {
 	AppendIRI(v *url.URL)
 }
+
+// attachmenter is an ActivityStreams type with an 'attachment' property
+type attachmenter interface {
+	GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty
+	SetActivityStreamsAttachment(i vocab.ActivityStreamsAttachmentProperty)
+}
+
+// attachmentType is an ActivityStreams type that can be a media attachment,
+// such as a Document or Image.
+type attachmentType interface {
+	vocab.Type
+	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+	GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+	GetTootBlurhash() vocab.TootBlurhashProperty
+	GetTootFocalPoint() vocab.TootFocalPointProperty
+	SetActivityStreamsMediaType(i vocab.ActivityStreamsMediaTypeProperty)
+	SetActivityStreamsName(i vocab.ActivityStreamsNameProperty)
+	SetActivityStreamsUrl(i vocab.ActivityStreamsUrlProperty)
+	SetTootBlurhash(i vocab.TootBlurhashProperty)
+	SetTootFocalPoint(i vocab.TootFocalPointProperty)
+}
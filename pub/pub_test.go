@@ -90,6 +90,9 @@ var (
 	testCreate2 vocab.ActivityStreamsCreate
 	// testCreateNoId is a test Create Activity without an 'id' set.
 	testCreateNoId vocab.ActivityStreamsCreate
+	// testArriveNoId is a test intransitive Arrive Activity without an 'id'
+	// set.
+	testArriveNoId vocab.ActivityStreamsArrive
 	// testOrderedCollectionUniqueElems is a collection with only unique
 	// ids.
 	testOrderedCollectionUniqueElems vocab.ActivityStreamsOrderedCollectionPage
@@ -221,6 +224,16 @@ func setupData() {
 		op.AppendActivityStreamsNote(testFederatedNote)
 		testCreateNoId.SetActivityStreamsObject(op)
 	}()
+	// testArriveNoId
+	func() {
+		testArriveNoId = streams.NewActivityStreamsArrive()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		testArriveNoId.SetActivityStreamsActor(actor)
+		location := streams.NewActivityStreamsLocationProperty()
+		location.AppendIRI(mustParse(testFederatedActorIRI2))
+		testArriveNoId.SetActivityStreamsLocation(location)
+	}()
 	// testOrderedCollectionUniqueElems and
 	// testOrderedCollectionUniqueElemsString
 	func() {
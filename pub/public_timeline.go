@@ -0,0 +1,241 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// DefaultTimelineMaxItems is the number of the newest items kept in a
+// PublicTimeline or FederatedTimeline, unless set otherwise with SetMaxItems.
+const DefaultTimelineMaxItems = 1000
+
+// PublicTimelinePolicy determines which of the Public activities posted to the
+// outboxes of this server's actors are kept out of the PublicTimeline, such as
+// those of silenced or unlisted actors, or activities other than Creates.
+type PublicTimelinePolicy interface {
+	// ExcludeFromPublicTimeline determines whether the activity posted to
+	// the outbox is not added to the timeline.
+	ExcludeFromPublicTimeline(c context.Context, outboxIRI *url.URL, activity Activity) bool
+}
+
+// PublicTimeline is a server-wide "local timeline": an OrderedCollection of the
+// activities posted to the outboxes of this server's actors that are addressed
+// to the Public collection, newest first. Only the newest items are kept, up to
+// DefaultTimelineMaxItems by default.
+//
+// It is stored in the Database as the value of its IRI, and is served in pages
+// by its Handler. Actors add their activities to it when their CommonBehavior
+// is a PublicTimelineProvider.
+type PublicTimeline struct {
+	timeline
+	policy PublicTimelinePolicy
+}
+
+// NewPublicTimeline creates a PublicTimeline at the IRI, stored in the Database
+// and served in pages of up to pageSize items. The policy may be nil, excluding
+// no Public activities.
+func NewPublicTimeline(iri *url.URL, db Database, pageSize int, policy PublicTimelinePolicy) *PublicTimeline {
+	return &PublicTimeline{
+		timeline: newTimeline(iri, db, pageSize),
+		policy:   policy,
+	}
+}
+
+// PublicTimelineProvider may be implemented by a CommonBehavior to add the
+// Public activities posted to the outboxes of its actors to a PublicTimeline.
+type PublicTimelineProvider interface {
+	// PublicTimeline returns the timeline of the context.
+	PublicTimeline(c context.Context) *PublicTimeline
+}
+
+// Add prepends the activity posted to the outbox to the timeline, unless it is
+// not addressed to the Public collection or the policy excludes it.
+func (p *PublicTimeline) Add(c context.Context, outboxIRI *url.URL, activity Activity) error {
+	if !isPublicActivity(activity) {
+		return nil
+	} else if p.policy != nil && p.policy.ExcludeFromPublicTimeline(c, outboxIRI, activity) {
+		return nil
+	}
+	id, err := GetId(activity)
+	if err != nil {
+		return err
+	}
+	return p.prepend(c, id)
+}
+
+// timeline is an OrderedCollection of activities, newest first, stored in the
+// Database as the value of its IRI and served in pages.
+type timeline struct {
+	iri       *url.URL
+	db        Database
+	paginator *CollectionPaginator
+	maxItems  int
+}
+
+// newTimeline creates a timeline at the IRI, served in pages of up to pageSize
+// items.
+func newTimeline(iri *url.URL, db Database, pageSize int) timeline {
+	return timeline{
+		iri:       iri,
+		db:        db,
+		paginator: NewCollectionPaginator(pageSize),
+		maxItems:  DefaultTimelineMaxItems,
+	}
+}
+
+// SetMaxItems sets the number of the newest items kept in the timeline, the
+// older ones being removed when adding more. Zero or less keeps every item. It
+// must be set before the timeline is used.
+func (p *timeline) SetMaxItems(n int) {
+	p.maxItems = n
+}
+
+// IRI returns the IRI of the timeline.
+func (p timeline) IRI() *url.URL {
+	return p.iri
+}
+
+// prepend adds the IRI to the front of the timeline, unless it is already in
+// it, and removes the items past the maximum from its end.
+func (p timeline) prepend(c context.Context, id *url.URL) error {
+	if err := p.db.Lock(c, p.iri); err != nil {
+		return err
+	}
+	defer p.db.Unlock(c, p.iri)
+	timeline, exists, err := p.get(c)
+	if err != nil {
+		return err
+	}
+	oi := timeline.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+		if itemId, err := ToId(iter); err == nil && itemId.String() == id.String() {
+			return nil
+		}
+	}
+	oi.PrependIRI(id)
+	for p.maxItems > 0 && oi.Len() > p.maxItems {
+		oi.Remove(oi.Len() - 1)
+	}
+	timeline.SetActivityStreamsOrderedItems(oi)
+	if exists {
+		return p.db.Update(c, timeline)
+	}
+	return p.db.Create(c, timeline)
+}
+
+// get returns the timeline in the Database, or a new empty one if there is none
+// yet. The lock of the timeline must be held.
+func (p timeline) get(c context.Context) (vocab.ActivityStreamsOrderedCollection, bool, error) {
+	exists, err := p.db.Exists(c, p.iri)
+	if err != nil {
+		return nil, false, err
+	} else if !exists {
+		oc := streams.NewActivityStreamsOrderedCollection()
+		oc.SetActivityStreamsId(newIdProperty(p.iri))
+		return oc, false, nil
+	}
+	t, err := p.db.Get(c, p.iri)
+	if err != nil {
+		return nil, false, err
+	}
+	oc, ok := t.(vocab.ActivityStreamsOrderedCollection)
+	if !ok {
+		return nil, false, fmt.Errorf("timeline %s is not an OrderedCollection: %T", p.iri, t)
+	}
+	return oc, true, nil
+}
+
+// Handler returns a HandlerFunc serving the timeline to the ActivityPub GET
+// requests of its IRI: the collection itself, or its pages when requested with
+// "?page=true".
+func (p timeline) Handler(authFn AuthenticateFunc, clock Clock) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if !isActivityPubGet(r) {
+			return
+		}
+		isASRequest = true
+		var shouldReturn bool
+		if shouldReturn, err = authFn(c, w, r); err != nil || shouldReturn {
+			return
+		}
+		if err = p.db.Lock(c, p.iri); err != nil {
+			return
+		}
+		timeline, _, err := p.get(c)
+		p.db.Unlock(c, p.iri)
+		if err != nil {
+			return
+		}
+		var t vocab.Type
+		if query := r.URL.Query(); IsPageRequest(query) {
+			t, err = p.paginator.Page(timeline, query)
+		} else {
+			t, err = p.paginator.Collection(timeline)
+		}
+		if err != nil {
+			return
+		}
+		raw, err := marshal(c, nil, t, true)
+		if err != nil {
+			return
+		}
+		addResponseHeaders(c, r, w.Header(), clock, raw)
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+		}
+		return
+	}
+}
+
+// addToPublicTimeline adds the activity posted to the outbox to the
+// PublicTimeline of the CommonBehavior, if it has one.
+func (a *sideEffectActor) addToPublicTimeline(c context.Context, outboxIRI *url.URL, activity Activity) error {
+	p, ok := a.common.(PublicTimelineProvider)
+	if !ok {
+		return nil
+	}
+	timeline := p.PublicTimeline(c)
+	if timeline == nil {
+		return nil
+	}
+	return timeline.Add(c, outboxIRI, activity)
+}
+
+// isPublicActivity determines whether the 'to', 'cc' or 'audience' of the
+// activity address the Public collection.
+func isPublicActivity(activity Activity) bool {
+	if to := activity.GetActivityStreamsTo(); to != nil {
+		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
+			if iter.IsIRI() && IsPublic(iter.GetIRI().String()) {
+				return true
+			}
+		}
+	}
+	if cc := activity.GetActivityStreamsCc(); cc != nil {
+		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+			if iter.IsIRI() && IsPublic(iter.GetIRI().String()) {
+				return true
+			}
+		}
+	}
+	if audience := activity.GetActivityStreamsAudience(); audience != nil {
+		for iter := audience.Begin(); iter != audience.End(); iter = iter.Next() {
+			if iter.IsIRI() && IsPublic(iter.GetIRI().String()) {
+				return true
+			}
+		}
+	}
+	return false
+}
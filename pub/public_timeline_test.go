@@ -0,0 +1,128 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// excludeOutbox is a PublicTimelinePolicy excluding the activities of an
+// outbox.
+type excludeOutbox string
+
+func (e excludeOutbox) ExcludeFromPublicTimeline(c context.Context, outboxIRI *url.URL, activity Activity) bool {
+	return outboxIRI.String() == string(e)
+}
+
+func TestPublicTimeline(t *testing.T) {
+	ctx := context.Background()
+	timelineIRI := mustParse("https://example.com/timelines/local")
+	newActivity := func(id string, public bool) Activity {
+		create := streams.NewActivityStreamsCreate()
+		create.SetActivityStreamsId(newIdProperty(mustParse(id)))
+		to := streams.NewActivityStreamsToProperty()
+		if public {
+			to.AppendIRI(mustParse(PublicActivityPubIRI))
+		} else {
+			to.AppendIRI(mustParse(testFederatedActorIRI))
+		}
+		create.SetActivityStreamsTo(to)
+		return create
+	}
+	newTimeline := func(ids ...string) vocab.ActivityStreamsOrderedCollection {
+		oc := streams.NewActivityStreamsOrderedCollection()
+		oc.SetActivityStreamsId(newIdProperty(timelineIRI))
+		oi := streams.NewActivityStreamsOrderedItemsProperty()
+		for _, id := range ids {
+			oi.AppendIRI(mustParse(id))
+		}
+		oc.SetActivityStreamsOrderedItems(oi)
+		return oc
+	}
+	t.Run("CreatesTimeline", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, timelineIRI),
+			db.EXPECT().Exists(ctx, timelineIRI).Return(false, nil),
+			db.EXPECT().Create(ctx, newTimeline(testNoteId1)),
+			db.EXPECT().Unlock(ctx, timelineIRI),
+		)
+		p := NewPublicTimeline(timelineIRI, db, 0, nil)
+		assertEqual(t, p.Add(ctx, mustParse(testMyOutboxIRI), newActivity(testNoteId1, true)), nil)
+	})
+	t.Run("PrependsToTimeline", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, timelineIRI),
+			db.EXPECT().Exists(ctx, timelineIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, timelineIRI).Return(newTimeline(testNoteId1), nil),
+			db.EXPECT().Update(ctx, newTimeline(testNoteId2, testNoteId1)),
+			db.EXPECT().Unlock(ctx, timelineIRI),
+		)
+		p := NewPublicTimeline(timelineIRI, db, 0, nil)
+		assertEqual(t, p.Add(ctx, mustParse(testMyOutboxIRI), newActivity(testNoteId2, true)), nil)
+	})
+	t.Run("RemovesItemsPastMax", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, timelineIRI),
+			db.EXPECT().Exists(ctx, timelineIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, timelineIRI).Return(newTimeline(testNoteId1), nil),
+			db.EXPECT().Update(ctx, newTimeline(testNoteId2)),
+			db.EXPECT().Unlock(ctx, timelineIRI),
+		)
+		p := NewPublicTimeline(timelineIRI, db, 0, nil)
+		p.SetMaxItems(1)
+		assertEqual(t, p.Add(ctx, mustParse(testMyOutboxIRI), newActivity(testNoteId2, true)), nil)
+	})
+	t.Run("IgnoresNonPublicActivities", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p := NewPublicTimeline(timelineIRI, NewMockDatabase(ctl), 0, nil)
+		assertEqual(t, p.Add(ctx, mustParse(testMyOutboxIRI), newActivity(testNoteId1, false)), nil)
+	})
+	t.Run("PolicyExcludes", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p := NewPublicTimeline(timelineIRI, NewMockDatabase(ctl), 0, excludeOutbox(testMyOutboxIRI))
+		assertEqual(t, p.Add(ctx, mustParse(testMyOutboxIRI), newActivity(testNoteId1, true)), nil)
+	})
+	t.Run("ServesPages", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		db.EXPECT().Lock(ctx, timelineIRI)
+		db.EXPECT().Exists(ctx, timelineIRI).Return(true, nil)
+		db.EXPECT().Get(ctx, timelineIRI).Return(newTimeline(testNoteId2, testNoteId1), nil)
+		db.EXPECT().Unlock(ctx, timelineIRI)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now()).AnyTimes()
+		p := NewPublicTimeline(timelineIRI, db, 1, nil)
+		h := p.Handler(func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			return false, nil
+		}, clock)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", timelineIRI.String()+"?page=true", nil))
+		isASRequest, err := h(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, isASRequest, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &m), nil)
+		assertEqual(t, m["type"], "OrderedCollectionPage")
+		assertEqual(t, m["orderedItems"], testNoteId2)
+	})
+}
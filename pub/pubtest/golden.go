@@ -0,0 +1,69 @@
+package pubtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update determines whether AssertGolden writes the golden files.
+var update = flag.Bool("pubtest.update", false, "write the golden files of pubtest.AssertGolden")
+
+// goldenHeaders are the response headers recorded in golden files. The others,
+// such as Date, vary between runs.
+var goldenHeaders = []string{"Content-Type", "Location"}
+
+// AssertGolden fails the test unless the recorded response matches the golden
+// file at the path. JSON bodies are compared indented, with their keys sorted,
+// so the golden files are readable and stable.
+//
+// When the tests are run with the -pubtest.update flag, the golden file is
+// written instead.
+func AssertGolden(t testing.TB, rec *httptest.ResponseRecorder, path string) {
+	t.Helper()
+	got := golden(rec)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s; run the tests with -pubtest.update to write it", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("response does not match %s:\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+// golden renders the recorded response as the contents of a golden file.
+func golden(rec *httptest.ResponseRecorder) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d\n", rec.Code)
+	for _, h := range goldenHeaders {
+		if v := rec.Header().Get(h); len(v) > 0 {
+			fmt.Fprintf(&b, "%s: %s\n", h, v)
+		}
+	}
+	b.WriteString("\n")
+	var v interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &v); err == nil {
+		// Maps are marshalled with sorted keys.
+		body, _ := json.MarshalIndent(v, "", "  ")
+		b.Write(body)
+		b.WriteString("\n")
+	} else {
+		b.Write(rec.Body.Bytes())
+	}
+	return b.Bytes()
+}
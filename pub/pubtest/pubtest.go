@@ -0,0 +1,69 @@
+// Package pubtest provides utilities for testing the delegates applications
+// implement for the pub package, by exercising an Actor's handlers.
+//
+// For example, a test of a FederatingProtocol accepting a signed Create:
+//
+//	func TestPostInbox(t *testing.T) {
+//	    key := pubtest.NewKey(t)
+//	    actor := pub.NewFederatingActor(common, federating, db, clock)
+//	    r := pubtest.SignedPost(t, "https://example.com/alex/inbox", create, "https://other.example.com/sam#main-key", key, now)
+//	    pubtest.AssertGolden(t, pubtest.Serve(actor, r), "testdata/post_inbox.golden")
+//	}
+//
+// Golden files are written, instead of compared, when the tests are run with
+// the -pubtest.update flag.
+package pubtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// Handler routes the requests to the inbox and outbox handlers of the actor.
+// The paths ending with "/inbox" are those of inboxes, and the paths ending
+// with "/outbox" those of outboxes.
+//
+// Requests not handled as ActivityPub requests are responded to with
+// http.StatusNotFound, and errors of the actor with
+// http.StatusInternalServerError.
+func Handler(actor pub.Actor) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var h func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/inbox") && r.Method == http.MethodPost:
+			h = actor.PostInbox
+		case strings.HasSuffix(r.URL.Path, "/inbox"):
+			h = actor.GetInbox
+		case strings.HasSuffix(r.URL.Path, "/outbox") && r.Method == http.MethodPost:
+			h = actor.PostOutbox
+		case strings.HasSuffix(r.URL.Path, "/outbox"):
+			h = actor.GetOutbox
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		if handled, err := h(r.Context(), w, r); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		} else if !handled {
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// Serve serves the request with the Handler of the actor, and returns the
+// recorded response.
+func Serve(actor pub.Actor, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	Handler(actor).ServeHTTP(w, r)
+	return w
+}
+
+// NewServer starts a server serving the Handler of the actor, which the caller
+// must close.
+func NewServer(actor pub.Actor) *httptest.Server {
+	return httptest.NewServer(Handler(actor))
+}
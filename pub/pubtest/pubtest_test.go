@@ -0,0 +1,121 @@
+package pubtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+const (
+	testInboxIRI  = "https://example.com/addison/inbox"
+	testOutboxIRI = "https://example.com/addison/outbox"
+	testActorIRI  = "https://other.example.com/dakota"
+	testKeyId     = testActorIRI + "#main-key"
+)
+
+var testNow = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+
+type fixedClock time.Time
+
+func (f fixedClock) Now() time.Time { return time.Time(f) }
+
+// actorTransport dereferences every IRI to the actor.
+type actorTransport []byte
+
+func (a actorTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	return a, nil
+}
+func (a actorTransport) Fetch(c context.Context, iri *url.URL, accept string) ([]byte, string, error) {
+	return a, "application/activity+json", nil
+}
+func (a actorTransport) Deliver(c context.Context, b []byte, to *url.URL) error { return nil }
+func (a actorTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return nil
+}
+
+// verifyingActor accepts the POSTs to its inbox signed by its peers, and
+// serves an empty inbox.
+type verifyingActor struct {
+	v *pub.SignatureVerifier
+}
+
+func (a *verifyingActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	if _, err := a.v.Verify(c, r); err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	return true, nil
+}
+
+func (a *verifyingActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	w.Header().Set("Content-Type", "application/activity+json")
+	w.Write([]byte(`{"type":"OrderedCollection","id":"` + testInboxIRI + `","totalItems":0}`))
+	return true, nil
+}
+
+func (a *verifyingActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return false, nil
+}
+
+func (a *verifyingActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return false, nil
+}
+
+func TestPubtest(t *testing.T) {
+	key := NewKey(t)
+	actor := &verifyingActor{
+		v: pub.NewSignatureVerifier(actorTransport(ActorJSON(t, testActorIRI, testKeyId, key)), fixedClock(testNow), time.Hour),
+	}
+	body := []byte(`{"type":"Create"}`)
+	t.Run("SignedPostVerifies", func(t *testing.T) {
+		rec := Serve(actor, SignedPost(t, testInboxIRI, body, testKeyId, key, testNow))
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected %d, got %d", http.StatusOK, rec.Code)
+		}
+	})
+	t.Run("UnsignedPostDoesNotVerify", func(t *testing.T) {
+		rec := Serve(actor, Post(testInboxIRI, body))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+	t.Run("UnhandledIsNotFound", func(t *testing.T) {
+		rec := Serve(actor, Get(testOutboxIRI))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("expected %d, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+	t.Run("GoldenResponse", func(t *testing.T) {
+		AssertGolden(t, Serve(actor, Get(testInboxIRI)), "testdata/get_inbox.golden")
+	})
+	t.Run("Server", func(t *testing.T) {
+		s := NewServer(actor)
+		defer s.Close()
+		resp, err := http.Get(s.URL + "/addison/inbox")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+	})
+}
+
+func TestGolden(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "application/activity+json")
+	rec.Header().Set("Date", "Wed, 01 Apr 2020 12:00:00 GMT")
+	rec.WriteHeader(http.StatusCreated)
+	rec.Write([]byte(`{"type":"Note","id":"https://example.com/note/1"}`))
+	want := "201\nContent-Type: application/activity+json\n\n{\n  \"id\": \"https://example.com/note/1\",\n  \"type\": \"Note\"\n}\n"
+	if got := string(golden(rec)); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
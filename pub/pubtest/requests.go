@@ -0,0 +1,86 @@
+package pubtest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// activityStreamsMediaType is the media type of the requests.
+const activityStreamsMediaType = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
+
+// Get returns an ActivityPub GET request of the IRI.
+func Get(iri string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, iri, nil)
+	r.Header.Set("Accept", activityStreamsMediaType)
+	return r
+}
+
+// Post returns an unsigned ActivityPub POST request of the body to the IRI.
+func Post(iri string, body []byte) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, iri, bytes.NewReader(body))
+	r.Header.Set("Content-Type", activityStreamsMediaType)
+	return r
+}
+
+// SignedPost returns an ActivityPub POST request of the body to the IRI, as
+// received from a peer signing its (request-target), Host, Date and Digest
+// headers with the key at the time.
+func SignedPost(t testing.TB, iri string, body []byte, keyId string, key crypto.PrivateKey, now time.Time) *http.Request {
+	t.Helper()
+	r := Post(iri, body)
+	h := sha256.Sum256(body)
+	r.Header.Set("Date", now.UTC().Format(http.TimeFormat))
+	r.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(h[:]))
+	signer := pub.NewRSASHA256Signer("(request-target)", "host", "date", "digest")
+	if err := signer.SignRequest(key, keyId, r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// NewKey generates an RSA key for signing requests.
+func NewKey(t testing.TB) *rsa.PrivateKey {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+// ActorJSON returns a Person with the public key of the key, as a Transport
+// dereferencing the actor or its keyId returns it.
+func ActorJSON(t testing.TB, actorIRI, keyId string, key *rsa.PrivateKey) []byte {
+	t.Helper()
+	pem, err := pub.EncodePublicKeyPEM(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(map[string]interface{}{
+		"@context": []string{"https://www.w3.org/ns/activitystreams", "https://w3id.org/security/v1"},
+		"id":       actorIRI,
+		"type":     "Person",
+		"inbox":    actorIRI + "/inbox",
+		"outbox":   actorIRI + "/outbox",
+		"publicKey": map[string]string{
+			"id":           keyId,
+			"owner":        actorIRI,
+			"publicKeyPem": string(pem),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,269 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// RateLimit limits the requests made to one host.
+type RateLimit struct {
+	// Rate is the number of requests per second, on average. Zero or
+	// negative rates are unlimited.
+	Rate float64
+	// Burst is the number of requests that may be made at once after the
+	// host was not requested for a while. It is at least one.
+	Burst int
+	// Concurrency is the number of requests that may be in flight at once.
+	// Zero or negative numbers are unlimited.
+	Concurrency int
+}
+
+// DefaultRateLimit lets a fan-out to a large instance proceed steadily, without
+// resembling an attack.
+var DefaultRateLimit = RateLimit{
+	Rate:        10,
+	Burst:       20,
+	Concurrency: 4,
+}
+
+// hostLimiterSweepSize is the number of limited hosts above which the idle ones
+// are removed when another host is requested.
+const hostLimiterSweepSize = 1024
+
+// hostLimiter is the token bucket and the concurrency slots of one host.
+type hostLimiter struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+	slots  chan struct{}
+}
+
+// refill adds the tokens accrued since the last refill. The lock of the
+// RateLimitedTransport must be held.
+func (l *hostLimiter) refill(now time.Time) {
+	l.tokens += now.Sub(l.last).Seconds() * l.limit.Rate
+	if max := float64(l.limit.burst()); l.tokens > max {
+		l.tokens = max
+	}
+	l.last = now
+}
+
+// burst returns the size of the token bucket.
+func (r RateLimit) burst() int {
+	if r.Burst < 1 {
+		return 1
+	}
+	return r.Burst
+}
+
+// RateLimitedTransport is a Transport limiting the rate and the concurrency of
+// the requests to each host, so a large fan-out does not overwhelm a peer and
+// have this server throttled or blocked by it. Requests wait for the limits of
+// their host, or until their context is done.
+//
+// Applications opt into rate limiting by wrapping the Transports returned by
+// their CommonBehavior's NewTransport. To share the limits among the actors of
+// the server, the Transports of every actor are wrapped with limiters sharing
+// one RateLimitedTransport's limits by calling its Wrap method.
+type RateLimitedTransport struct {
+	Transport
+	*rateLimits
+}
+
+// rateLimits are the limits of the hosts, which may be shared by several
+// RateLimitedTransports.
+type rateLimits struct {
+	clock Clock
+	mu    sync.Mutex
+	limit RateLimit
+	host  map[string]RateLimit
+	hosts map[string]*hostLimiter
+}
+
+// reportingDeliverer must be implemented by RateLimitedTransport.
+var _ reportingDeliverer = &RateLimitedTransport{}
+
+// ConditionalDereferencer must be implemented by RateLimitedTransport.
+var _ ConditionalDereferencer = &RateLimitedTransport{}
+
+// NewRateLimitedTransport wraps the Transport, limiting the requests to each
+// host to the limit.
+func NewRateLimitedTransport(t Transport, clock Clock, limit RateLimit) *RateLimitedTransport {
+	return &RateLimitedTransport{
+		Transport: t,
+		rateLimits: &rateLimits{
+			clock: clock,
+			limit: limit,
+			host:  make(map[string]RateLimit),
+			hosts: make(map[string]*hostLimiter),
+		},
+	}
+}
+
+// Wrap returns a RateLimitedTransport of another Transport, sharing the limits
+// of this one.
+func (t *RateLimitedTransport) Wrap(other Transport) *RateLimitedTransport {
+	return &RateLimitedTransport{
+		Transport:  other,
+		rateLimits: t.rateLimits,
+	}
+}
+
+// SetHostRateLimit sets the limit of the host, overriding the default one, such
+// as for a peer known to tolerate more requests.
+func (t *RateLimitedTransport) SetHostRateLimit(host string, limit RateLimit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.host[host] = limit
+	delete(t.hosts, host)
+}
+
+// acquire waits until a request to the host is allowed, or the context is done.
+// The returned limiter must be released once the request is done.
+func (t *rateLimits) acquire(c context.Context, host string) (*hostLimiter, error) {
+	for {
+		now := t.clock.Now()
+		t.mu.Lock()
+		l := t.limiter(host, now)
+		l.refill(now)
+		if l.limit.Rate <= 0 || l.tokens >= 1 {
+			if l.limit.Rate > 0 {
+				l.tokens--
+			}
+			slots := l.slots
+			t.mu.Unlock()
+			if slots == nil {
+				return l, nil
+			}
+			select {
+			case <-c.Done():
+				return nil, c.Err()
+			case slots <- struct{}{}:
+				return l, nil
+			}
+		}
+		wait := time.Duration((1 - l.tokens) / l.limit.Rate * float64(time.Second))
+		t.mu.Unlock()
+		select {
+		case <-c.Done():
+			return nil, c.Err()
+		case <-after(t.clock, wait):
+		}
+	}
+}
+
+// release ends a request allowed by the limiter.
+func (l *hostLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// limiter returns the limiter of the host, creating it with a full bucket if
+// needed. The lock must be held.
+func (t *rateLimits) limiter(host string, now time.Time) *hostLimiter {
+	if l, ok := t.hosts[host]; ok {
+		return l
+	}
+	if len(t.hosts) >= hostLimiterSweepSize {
+		for h, l := range t.hosts {
+			l.refill(now)
+			if l.tokens >= float64(l.limit.burst()) && len(l.slots) == 0 {
+				delete(t.hosts, h)
+			}
+		}
+	}
+	limit, ok := t.host[host]
+	if !ok {
+		limit = t.limit
+	}
+	l := &hostLimiter{
+		limit:  limit,
+		tokens: float64(limit.burst()),
+		last:   now,
+	}
+	if limit.Concurrency > 0 {
+		l.slots = make(chan struct{}, limit.Concurrency)
+	}
+	t.hosts[host] = l
+	return l
+}
+
+// Dereference waits for the limits of the IRI's host, then dereferences it with
+// the wrapped Transport.
+func (t *RateLimitedTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	l, err := t.acquire(c, iri.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer l.release()
+	return t.Transport.Dereference(c, iri)
+}
+
+// DereferenceConditional waits for the limits of the IRI's host, then
+// revalidates it with the wrapped Transport. If the wrapped Transport is not a
+// ConditionalDereferencer, the IRI is dereferenced, and no headers are
+// returned.
+func (t *RateLimitedTransport) DereferenceConditional(c context.Context, iri *url.URL, validators http.Header) (body []byte, header http.Header, notModified bool, err error) {
+	l, err := t.acquire(c, iri.Host)
+	if err != nil {
+		return
+	}
+	defer l.release()
+	if cd, ok := t.Transport.(ConditionalDereferencer); ok {
+		return cd.DereferenceConditional(c, iri, validators)
+	}
+	body, err = t.Transport.Dereference(c, iri)
+	return
+}
+
+// Fetch waits for the limits of the IRI's host, then fetches it with the
+// wrapped Transport.
+func (t *RateLimitedTransport) Fetch(c context.Context, iri *url.URL, accept string) (body []byte, contentType string, err error) {
+	l, err := t.acquire(c, iri.Host)
+	if err != nil {
+		return
+	}
+	defer l.release()
+	return t.Transport.Fetch(c, iri, accept)
+}
+
+// Deliver waits for the limits of the recipient's host, then delivers the
+// payload with the wrapped Transport.
+func (t *RateLimitedTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	l, err := t.acquire(c, to.Host)
+	if err != nil {
+		return err
+	}
+	defer l.release()
+	return t.Transport.Deliver(c, b, to)
+}
+
+// BatchDeliver delivers the payload to the recipients concurrently, each within
+// the limits of its host. Returns an error if any of the deliveries had an
+// error.
+func (t *RateLimitedTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return deliveryError(t.BatchDeliverWithReport(c, b, recipients))
+}
+
+// BatchDeliverWithReport is like BatchDeliver, but returns the outcome of the
+// delivery to each recipient, in the order of the recipients.
+func (t *RateLimitedTransport) BatchDeliverWithReport(c context.Context, b []byte, recipients []*url.URL) []DeliveryOutcome {
+	outcomes := make([]DeliveryOutcome, len(recipients))
+	var wg sync.WaitGroup
+	for i, recipient := range recipients {
+		wg.Add(1)
+		go func(i int, r *url.URL) {
+			defer wg.Done()
+			outcomes[i] = DeliveryOutcome{Inbox: r, Time: t.clock.Now()}
+			if err := t.Deliver(c, b, r); err != nil {
+				outcomes[i].fail(err)
+			}
+		}(i, recipient)
+	}
+	wg.Wait()
+	return outcomes
+}
@@ -0,0 +1,112 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// blockingTransport delivers once the delivery is released.
+type blockingTransport struct {
+	*MockTransport
+	started chan *url.URL
+	release chan struct{}
+}
+
+func (b *blockingTransport) Deliver(c context.Context, p []byte, to *url.URL) error {
+	b.started <- to
+	<-b.release
+	return nil
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	iri := mustParse(testFederatedActorIRI)
+	t.Run("WaitsForTokens", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewFakeClock(now)
+		tp := NewMockTransport(ctl)
+		rt := NewRateLimitedTransport(tp, clock, RateLimit{Rate: 1, Burst: 2})
+		tp.EXPECT().Dereference(gomock.Any(), iri).Return([]byte("{}"), nil).Times(3)
+		for i := 0; i < 2; i++ {
+			_, err := rt.Dereference(ctx, iri)
+			assertEqual(t, err, nil)
+		}
+		done := make(chan error)
+		go func() {
+			_, err := rt.Dereference(ctx, iri)
+			done <- err
+		}()
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		assertEqual(t, <-done, nil)
+	})
+	t.Run("LimitsHostsSeparately", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		rt := NewRateLimitedTransport(tp, NewFakeClock(now), RateLimit{Rate: 1})
+		other := mustParse("https://other.example.org/actor")
+		tp.EXPECT().Deliver(ctx, []byte("{}"), iri).Return(nil)
+		tp.EXPECT().Deliver(ctx, []byte("{}"), other).Return(nil)
+		assertEqual(t, rt.Deliver(ctx, []byte("{}"), iri), nil)
+		assertEqual(t, rt.Deliver(ctx, []byte("{}"), other), nil)
+	})
+	t.Run("StopsWaitingWhenDone", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		rt := NewRateLimitedTransport(tp, NewFakeClock(now), RateLimit{Rate: 1})
+		tp.EXPECT().Deliver(ctx, []byte("{}"), iri).Return(nil)
+		assertEqual(t, rt.Deliver(ctx, []byte("{}"), iri), nil)
+		c, cancel := context.WithCancel(ctx)
+		cancel()
+		assertEqual(t, rt.Deliver(c, []byte("{}"), iri), context.Canceled)
+	})
+	t.Run("CapsConcurrency", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := &blockingTransport{
+			MockTransport: NewMockTransport(ctl),
+			started:       make(chan *url.URL),
+			release:       make(chan struct{}),
+		}
+		rt := NewRateLimitedTransport(tp, NewFakeClock(now), RateLimit{Concurrency: 1})
+		other := mustParse("https://other.example.org/actor")
+		done := make(chan []DeliveryOutcome)
+		go func() {
+			done <- rt.BatchDeliverWithReport(ctx, []byte("{}"), []*url.URL{iri, mustParse(testFederatedActorIRI2), other})
+		}()
+		// One delivery to each host starts.
+		first, second := <-tp.started, <-tp.started
+		assertEqual(t, first.Host != second.Host, true)
+		select {
+		case to := <-tp.started:
+			t.Fatalf("concurrent delivery to %s", to)
+		case <-time.After(10 * time.Millisecond):
+		}
+		tp.release <- struct{}{}
+		tp.release <- struct{}{}
+		<-tp.started
+		tp.release <- struct{}{}
+		outcomes := <-done
+		assertEqual(t, len(outcomes), 3)
+		assertEqual(t, deliveryError(outcomes), nil)
+	})
+	t.Run("OverridesHostLimit", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		rt := NewRateLimitedTransport(tp, NewFakeClock(now), RateLimit{Rate: 1})
+		rt.Wrap(tp).SetHostRateLimit(iri.Host, RateLimit{})
+		tp.EXPECT().Deliver(ctx, []byte("{}"), iri).Return(nil).Times(3)
+		for i := 0; i < 3; i++ {
+			assertEqual(t, rt.Deliver(ctx, []byte("{}"), iri), nil)
+		}
+	})
+}
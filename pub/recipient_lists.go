@@ -0,0 +1,45 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+)
+
+// RecipientLists may be implemented by a FederatingProtocol whose actors
+// address activities to lists of actors defined by the application, such as
+// lists, circles or aspects, instead of to each of their members.
+//
+// The activities keep the IRI of the list in their 'to', 'cc' and other
+// addressing properties, and are delivered to the inboxes of its members. Like
+// a followers collection, peers see an opaque IRI and not who else received
+// the activity, so the application should not serve the members of a list to
+// anyone but its owner.
+type RecipientLists interface {
+	// ListMembers returns the ids of the members of the recipient, and
+	// whether the recipient is a list of the actor of the outbox. The
+	// members may be actors or collections of actors, on this server or
+	// on peers.
+	ListMembers(c context.Context, outboxIRI, recipient *url.URL) (members []*url.URL, isList bool, err error)
+}
+
+// expandRecipientLists replaces the recipients that are lists of the actor of
+// the outbox with their members, if the FederatingProtocol is RecipientLists.
+// The other recipients are kept, and are resolved to inboxes as usual.
+func (a *sideEffectActor) expandRecipientLists(c context.Context, outboxIRI *url.URL, r []*url.URL) ([]*url.URL, error) {
+	lists, ok := a.s2s.(RecipientLists)
+	if !ok {
+		return r, nil
+	}
+	expanded := make([]*url.URL, 0, len(r))
+	for _, u := range r {
+		members, isList, err := lists.ListMembers(c, outboxIRI, u)
+		if err != nil {
+			return nil, err
+		} else if !isList {
+			expanded = append(expanded, u)
+			continue
+		}
+		expanded = append(expanded, members...)
+	}
+	return expanded, nil
+}
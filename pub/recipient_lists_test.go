@@ -0,0 +1,47 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+const testListIRI = "https://example.com/addison/lists/close-friends"
+
+// recipientListsProtocol is a FederatingProtocol with a list of the two
+// federated actors.
+type recipientListsProtocol struct {
+	*MockFederatingProtocol
+}
+
+func (r *recipientListsProtocol) ListMembers(c context.Context, outboxIRI, recipient *url.URL) ([]*url.URL, bool, error) {
+	if recipient.String() != testListIRI {
+		return nil, false, nil
+	}
+	return []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)}, true, nil
+}
+
+func TestExpandRecipientLists(t *testing.T) {
+	ctx := context.Background()
+	r := []*url.URL{mustParse(testListIRI), mustParse(testFederatedActorIRI3)}
+	t.Run("ReplacesListsWithMembers", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := &sideEffectActor{s2s: &recipientListsProtocol{NewMockFederatingProtocol(ctl)}}
+		expanded, err := a.expandRecipientLists(ctx, mustParse(testMyOutboxIRI), r)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(expanded),
+			fmt.Sprintf("[%s %s %s]", testFederatedActorIRI, testFederatedActorIRI2, testFederatedActorIRI3))
+	})
+	t.Run("KeepsRecipientsByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := &sideEffectActor{s2s: NewMockFederatingProtocol(ctl)}
+		expanded, err := a.expandRecipientLists(ctx, mustParse(testMyOutboxIRI), r)
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(expanded), fmt.Sprint(r))
+	})
+}
@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// AudienceCache is a pub.AudienceCache whose entries expire after a time.
+type AudienceCache struct {
+	cl  *Client
+	ttl time.Duration
+}
+
+// pub.AudienceCache must be implemented by AudienceCache.
+var _ pub.AudienceCache = &AudienceCache{}
+
+// NewAudienceCache creates an AudienceCache whose entries expire after the ttl.
+func NewAudienceCache(cl *Client, ttl time.Duration) *AudienceCache {
+	return &AudienceCache{cl: cl, ttl: ttl}
+}
+
+// GetAudience returns the inboxes the collection resolved to at the version.
+func (a *AudienceCache) GetAudience(c context.Context, collectionIRI *url.URL, version string) ([]*url.URL, bool, error) {
+	b, err := redigo.Bytes(a.cl.do(c, "GET", a.cl.key("audience", collectionIRI.String(), version)))
+	if err == redigo.ErrNil {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	var s []string
+	if err = json.Unmarshal(b, &s); err != nil {
+		return nil, false, err
+	}
+	inboxes := make([]*url.URL, len(s))
+	for i, v := range s {
+		if inboxes[i], err = url.Parse(v); err != nil {
+			return nil, false, err
+		}
+	}
+	return inboxes, true, nil
+}
+
+// SetAudience caches the inboxes the collection resolved to at the version.
+func (a *AudienceCache) SetAudience(c context.Context, collectionIRI *url.URL, version string, inboxes []*url.URL) error {
+	s := make([]string, len(inboxes))
+	for i, v := range inboxes {
+		s[i] = v.String()
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = a.cl.do(c, "SET", a.cl.key("audience", collectionIRI.String(), version), b, "PX", milliseconds(a.ttl))
+	return err
+}
+
+// SerializationCache is a pub.SerializationCache whose entries expire after a
+// time. Failures to reach Redis are treated as cache misses.
+type SerializationCache struct {
+	cl  *Client
+	ttl time.Duration
+}
+
+// pub.SerializationCache must be implemented by SerializationCache.
+var _ pub.SerializationCache = &SerializationCache{}
+
+// NewSerializationCache creates a SerializationCache whose entries expire after
+// the ttl.
+func NewSerializationCache(cl *Client, ttl time.Duration) *SerializationCache {
+	return &SerializationCache{cl: cl, ttl: ttl}
+}
+
+// serializationKey returns the Redis key of the serialization key.
+func (s *SerializationCache) serializationKey(k pub.SerializationKey) string {
+	return s.cl.key("serialized", strconv.FormatBool(k.Served), k.Version, k.Id)
+}
+
+// GetSerialized returns the bytes saved for the key, if any.
+func (s *SerializationCache) GetSerialized(c context.Context, k pub.SerializationKey) ([]byte, bool) {
+	b, err := redigo.Bytes(s.cl.do(c, "GET", s.serializationKey(k)))
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// SetSerialized saves the bytes for the key.
+func (s *SerializationCache) SetSerialized(c context.Context, k pub.SerializationKey, b []byte) {
+	s.cl.do(c, "SET", s.serializationKey(k), b, "PX", milliseconds(s.ttl))
+}
+
+// milliseconds returns the duration in whole milliseconds, at least one.
+func milliseconds(d time.Duration) int64 {
+	if ms := int64(d / time.Millisecond); ms > 0 {
+		return ms
+	}
+	return 1
+}
@@ -0,0 +1,47 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+func TestAudienceCache(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer()
+	a := NewAudienceCache(s.newClient(), time.Minute)
+	_, found, err := a.GetAudience(ctx, mustParse(testCollectionIRI), "v1")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, false)
+	inboxes := []*url.URL{mustParse(testInboxIRI), mustParse(testInboxIRI2)}
+	assertEqual(t, a.SetAudience(ctx, mustParse(testCollectionIRI), "v1", inboxes), nil)
+	got, found, err := a.GetAudience(ctx, mustParse(testCollectionIRI), "v1")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, true)
+	assertEqual(t, fmt.Sprint(got), fmt.Sprint(inboxes))
+	_, found, err = a.GetAudience(ctx, mustParse(testCollectionIRI), "v2")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, false)
+	s.advance(time.Minute)
+	_, found, err = a.GetAudience(ctx, mustParse(testCollectionIRI), "v1")
+	assertEqual(t, err, nil)
+	assertEqual(t, found, false)
+}
+
+func TestSerializationCache(t *testing.T) {
+	ctx := context.Background()
+	sc := NewSerializationCache(newFakeServer().newClient(), time.Minute)
+	k := pub.SerializationKey{Id: testNoteIRI}
+	_, ok := sc.GetSerialized(ctx, k)
+	assertEqual(t, ok, false)
+	sc.SetSerialized(ctx, k, []byte("{}"))
+	b, ok := sc.GetSerialized(ctx, k)
+	assertEqual(t, ok, true)
+	assertEqual(t, string(b), "{}")
+	_, ok = sc.GetSerialized(ctx, pub.SerializationKey{Id: testNoteIRI, Served: true})
+	assertEqual(t, ok, false)
+}
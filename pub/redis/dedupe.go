@@ -0,0 +1,34 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// DedupeWindow remembers the IRIs seen by any instance of a clustered deployment
+// for a time, such as to drop an activity delivered to several instances at
+// once before it reaches the Database.
+type DedupeWindow struct {
+	cl     *Client
+	window time.Duration
+}
+
+// NewDedupeWindow creates a DedupeWindow remembering IRIs for the window.
+func NewDedupeWindow(cl *Client, window time.Duration) *DedupeWindow {
+	return &DedupeWindow{cl: cl, window: window}
+}
+
+// Seen records the IRI as seen, and determines whether it already was within
+// the window.
+func (d *DedupeWindow) Seen(c context.Context, iri *url.URL) (bool, error) {
+	_, err := redigo.String(d.cl.do(c, "SET", d.cl.key("seen", iri.String()), 1, "NX", "PX", milliseconds(d.window)))
+	if err == redigo.ErrNil {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return false, nil
+}
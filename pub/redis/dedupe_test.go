@@ -0,0 +1,23 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDedupeWindow(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer()
+	d := NewDedupeWindow(s.newClient(), time.Minute)
+	seen, err := d.Seen(ctx, mustParse(testNoteIRI))
+	assertEqual(t, err, nil)
+	assertEqual(t, seen, false)
+	seen, err = d.Seen(ctx, mustParse(testNoteIRI))
+	assertEqual(t, err, nil)
+	assertEqual(t, seen, true)
+	s.advance(time.Minute)
+	seen, err = d.Seen(ctx, mustParse(testNoteIRI))
+	assertEqual(t, err, nil)
+	assertEqual(t, seen, false)
+}
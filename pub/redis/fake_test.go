@@ -0,0 +1,273 @@
+package redis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// fakeServer holds the data of an in-memory Redis, implementing the commands
+// used by this package.
+type fakeServer struct {
+	mu       sync.Mutex
+	now      time.Time
+	strings  map[string][]byte
+	expires  map[string]time.Time
+	hashes   map[string]map[string][]byte
+	zsets    map[string]map[string]float64
+	versions map[string]int
+	// beforeExec is called once before the next transaction is run, such
+	// as to change the data as another instance would.
+	beforeExec func(s *fakeServer)
+}
+
+func newFakeServer() *fakeServer {
+	return &fakeServer{
+		now:      time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		strings:  make(map[string][]byte),
+		expires:  make(map[string]time.Time),
+		hashes:   make(map[string]map[string][]byte),
+		zsets:    make(map[string]map[string]float64),
+		versions: make(map[string]int),
+	}
+}
+
+// newClient returns a Client connected to the server.
+func (s *fakeServer) newClient() *Client {
+	return NewClient(&redigo.Pool{
+		Dial: func() (redigo.Conn, error) {
+			return &fakeConn{s: s}, nil
+		},
+	}, "test:")
+}
+
+// advance moves the time of the server forward.
+func (s *fakeServer) advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = s.now.Add(d)
+}
+
+// fakeConn is a connection to a fakeServer.
+type fakeConn struct {
+	s       *fakeServer
+	multi   bool
+	queued  [][]string
+	watched map[string]int
+}
+
+func (f *fakeConn) Close() error { return nil }
+func (f *fakeConn) Err() error   { return nil }
+func (f *fakeConn) Send(cmd string, args ...interface{}) error {
+	_, err := f.Do(cmd, args...)
+	return err
+}
+func (f *fakeConn) Flush() error                  { return nil }
+func (f *fakeConn) Receive() (interface{}, error) { return nil, nil }
+func (f *fakeConn) toStrings(args []interface{}) (s []string) {
+	for _, a := range args {
+		if b, ok := a.([]byte); ok {
+			s = append(s, string(b))
+		} else {
+			s = append(s, fmt.Sprint(a))
+		}
+	}
+	return
+}
+
+func (f *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	a := f.toStrings(args)
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+	switch strings.ToUpper(cmd) {
+	case "":
+		return nil, nil
+	case "MULTI":
+		f.multi = true
+		return "OK", nil
+	case "DISCARD":
+		f.multi, f.queued, f.watched = false, nil, nil
+		return "OK", nil
+	case "WATCH":
+		if f.watched == nil {
+			f.watched = make(map[string]int)
+		}
+		for _, k := range a {
+			f.watched[k] = f.s.versions[k]
+		}
+		return "OK", nil
+	case "UNWATCH":
+		f.watched = nil
+		return "OK", nil
+	case "EXEC":
+		queued, watched := f.queued, f.watched
+		f.multi, f.queued, f.watched = false, nil, nil
+		if fn := f.s.beforeExec; fn != nil {
+			f.s.beforeExec = nil
+			fn(f.s)
+		}
+		for k, v := range watched {
+			if f.s.versions[k] != v {
+				return nil, nil
+			}
+		}
+		replies := make([]interface{}, len(queued))
+		for i, q := range queued {
+			r, err := f.s.exec(q[0], q[1:])
+			if err != nil {
+				replies[i] = redigo.Error(err.Error())
+			} else {
+				replies[i] = r
+			}
+		}
+		return replies, nil
+	}
+	if f.multi {
+		f.queued = append(f.queued, append([]string{cmd}, a...))
+		return "QUEUED", nil
+	}
+	return f.s.exec(cmd, a)
+}
+
+// expire removes the key if it expired. The lock must be held.
+func (s *fakeServer) expire(k string) {
+	if e, ok := s.expires[k]; ok && !s.now.Before(e) {
+		delete(s.strings, k)
+		delete(s.expires, k)
+		s.versions[k]++
+	}
+}
+
+// exec runs a data command. The lock must be held.
+func (s *fakeServer) exec(cmd string, a []string) (interface{}, error) {
+	switch strings.ToUpper(cmd) {
+	case "GET":
+		s.expire(a[0])
+		if v, ok := s.strings[a[0]]; ok {
+			return v, nil
+		}
+		return nil, nil
+	case "SET":
+		k := a[0]
+		s.expire(k)
+		var nx bool
+		var px int64
+		for i := 2; i < len(a); i++ {
+			switch strings.ToUpper(a[i]) {
+			case "NX":
+				nx = true
+			case "PX":
+				i++
+				px, _ = strconv.ParseInt(a[i], 10, 64)
+			}
+		}
+		if _, ok := s.strings[k]; ok && nx {
+			return nil, nil
+		}
+		s.strings[k] = []byte(a[1])
+		delete(s.expires, k)
+		if px > 0 {
+			s.expires[k] = s.now.Add(time.Duration(px) * time.Millisecond)
+		}
+		s.versions[k]++
+		return "OK", nil
+	case "DEL":
+		n := int64(0)
+		for _, k := range a {
+			if _, ok := s.strings[k]; ok {
+				delete(s.strings, k)
+				delete(s.expires, k)
+				s.versions[k]++
+				n++
+			}
+		}
+		return n, nil
+	case "INCR":
+		n, _ := strconv.ParseInt(string(s.strings[a[0]]), 10, 64)
+		n++
+		s.strings[a[0]] = []byte(strconv.FormatInt(n, 10))
+		s.versions[a[0]]++
+		return n, nil
+	case "HSET":
+		h, ok := s.hashes[a[0]]
+		if !ok {
+			h = make(map[string][]byte)
+			s.hashes[a[0]] = h
+		}
+		h[a[1]] = []byte(a[2])
+		return int64(1), nil
+	case "HGET":
+		if v, ok := s.hashes[a[0]][a[1]]; ok {
+			return v, nil
+		}
+		return nil, nil
+	case "HDEL":
+		_, ok := s.hashes[a[0]][a[1]]
+		delete(s.hashes[a[0]], a[1])
+		if ok {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case "ZADD":
+		z, ok := s.zsets[a[0]]
+		if !ok {
+			z = make(map[string]float64)
+			s.zsets[a[0]] = z
+		}
+		score, _ := strconv.ParseFloat(a[1], 64)
+		z[a[2]] = score
+		s.versions[a[0]]++
+		return int64(1), nil
+	case "ZREM":
+		_, ok := s.zsets[a[0]][a[1]]
+		delete(s.zsets[a[0]], a[1])
+		if ok {
+			s.versions[a[0]]++
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case "ZSCORE":
+		if score, ok := s.zsets[a[0]][a[1]]; ok {
+			return []byte(strconv.FormatFloat(score, 'f', -1, 64)), nil
+		}
+		return nil, nil
+	case "ZRANGEBYSCORE":
+		max, exclusive := a[2], false
+		if strings.HasPrefix(max, "(") {
+			max, exclusive = max[1:], true
+		}
+		m, _ := strconv.ParseFloat(max, 64)
+		limit := math.MaxInt32
+		if len(a) > 5 && strings.ToUpper(a[3]) == "LIMIT" {
+			limit, _ = strconv.Atoi(a[5])
+		}
+		var members []string
+		for member, score := range s.zsets[a[0]] {
+			if score < m || (!exclusive && score == m) {
+				members = append(members, member)
+			}
+		}
+		z := s.zsets[a[0]]
+		sort.Slice(members, func(i, j int) bool {
+			if z[members[i]] != z[members[j]] {
+				return z[members[i]] < z[members[j]]
+			}
+			return members[i] < members[j]
+		})
+		if limit < len(members) {
+			members = members[:limit]
+		}
+		replies := make([]interface{}, len(members))
+		for i, member := range members {
+			replies[i] = []byte(member)
+		}
+		return replies, nil
+	}
+	return nil, fmt.Errorf("unsupported command %s", cmd)
+}
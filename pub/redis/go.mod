@@ -0,0 +1,16 @@
+module github.com/go-fed/activity/pub/redis
+
+go 1.21
+
+require (
+	github.com/go-fed/activity v0.4.0
+	github.com/gomodule/redigo v1.8.5
+)
+
+require (
+	github.com/go-fed/httpsig v0.1.0 // indirect
+	golang.org/x/crypto v0.0.0-20180527072434-ab813273cd59 // indirect
+	golang.org/x/sys v0.0.0-20180525142821-c11f84a56e43 // indirect
+)
+
+replace github.com/go-fed/activity => ../..
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// Locker holds the locks of IRIs across every instance of a clustered
+// deployment. Its Lock and Unlock methods have the signatures of those of
+// pub.Database, so a Database may defer to them.
+//
+// A lock expires after a time, so the locks of an instance that crashed are
+// eventually released.
+type Locker struct {
+	cl    *Client
+	clock pub.Clock
+	ttl   time.Duration
+	retry time.Duration
+	mu    sync.Mutex
+	// tokens are the values of the locks held by this Locker, so it only
+	// ever releases its own locks.
+	tokens map[string]string
+}
+
+// NewLocker creates a Locker whose locks expire after the ttl, and which tries
+// acquiring a held lock again after the retry duration. The waits between tries
+// end on the clock if it is a pub.TimerClock.
+func NewLocker(cl *Client, clock pub.Clock, ttl, retry time.Duration) *Locker {
+	return &Locker{
+		cl:     cl,
+		clock:  clock,
+		ttl:    ttl,
+		retry:  retry,
+		tokens: make(map[string]string),
+	}
+}
+
+// Lock waits until the lock of the IRI is acquired, or the context is done.
+func (l *Locker) Lock(c context.Context, id *url.URL) error {
+	var r [16]byte
+	if _, err := rand.Read(r[:]); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(r[:])
+	k := l.cl.key("lock", id.String())
+	for {
+		_, err := redigo.String(l.cl.do(c, "SET", k, token, "NX", "PX", milliseconds(l.ttl)))
+		if err == nil {
+			l.mu.Lock()
+			l.tokens[k] = token
+			l.mu.Unlock()
+			return nil
+		} else if err != redigo.ErrNil {
+			return err
+		}
+		select {
+		case <-c.Done():
+			return c.Err()
+		case <-after(l.clock, l.retry):
+		}
+	}
+}
+
+// systemClock is the Clock of the system time.
+type systemClock struct{}
+
+// Now returns the system time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// after returns a channel receiving the time once the duration has elapsed on
+// the clock.
+func after(clock pub.Clock, d time.Duration) <-chan time.Time {
+	if t, ok := clock.(pub.TimerClock); ok {
+		return t.After(d)
+	}
+	return time.After(d)
+}
+
+// Unlock releases the lock of the IRI, unless it expired and was acquired by
+// another holder since.
+func (l *Locker) Unlock(c context.Context, id *url.URL) error {
+	k := l.cl.key("lock", id.String())
+	l.mu.Lock()
+	token, ok := l.tokens[k]
+	delete(l.tokens, k)
+	l.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("lock of %s is not held", id.String())
+	}
+	conn, err := l.cl.conn(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err = conn.Do("WATCH", k); err != nil {
+		return err
+	}
+	held, err := redigo.String(conn.Do("GET", k))
+	if err == redigo.ErrNil || (err == nil && held != token) {
+		// The lock expired.
+		_, err = conn.Do("UNWATCH")
+		return err
+	} else if err != nil {
+		return err
+	}
+	if _, err = conn.Do("MULTI"); err != nil {
+		return err
+	}
+	conn.Do("DEL", k)
+	_, err = conn.Do("EXEC")
+	if err == redigo.ErrNil {
+		// The lock expired and was acquired by another holder.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+func TestLocker(t *testing.T) {
+	ctx := context.Background()
+	clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	t.Run("ExcludesOtherHolders", func(t *testing.T) {
+		s := newFakeServer()
+		l1 := NewLocker(s.newClient(), clock, time.Minute, time.Millisecond)
+		l2 := NewLocker(s.newClient(), clock, time.Minute, time.Millisecond)
+		assertEqual(t, l1.Lock(ctx, mustParse(testNoteIRI)), nil)
+		c, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assertEqual(t, l2.Lock(c, mustParse(testNoteIRI)), context.DeadlineExceeded)
+		assertEqual(t, l1.Unlock(ctx, mustParse(testNoteIRI)), nil)
+		assertEqual(t, l2.Lock(ctx, mustParse(testNoteIRI)), nil)
+	})
+	t.Run("RetriesOnClock", func(t *testing.T) {
+		s := newFakeServer()
+		clock := pub.NewFakeClock(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+		l1 := NewLocker(s.newClient(), clock, time.Minute, time.Second)
+		l2 := NewLocker(s.newClient(), clock, time.Minute, time.Second)
+		assertEqual(t, l1.Lock(ctx, mustParse(testNoteIRI)), nil)
+		locked := make(chan error)
+		go func() {
+			locked <- l2.Lock(ctx, mustParse(testNoteIRI))
+		}()
+		clock.BlockUntil(1)
+		assertEqual(t, l1.Unlock(ctx, mustParse(testNoteIRI)), nil)
+		clock.Advance(time.Second)
+		assertEqual(t, <-locked, nil)
+	})
+	t.Run("DoesNotReleaseAnotherHoldersLock", func(t *testing.T) {
+		s := newFakeServer()
+		l1 := NewLocker(s.newClient(), clock, time.Minute, time.Millisecond)
+		l2 := NewLocker(s.newClient(), clock, time.Minute, time.Millisecond)
+		assertEqual(t, l1.Lock(ctx, mustParse(testNoteIRI)), nil)
+		// The lock of l1 expires, and l2 acquires it.
+		s.advance(time.Minute)
+		assertEqual(t, l2.Lock(ctx, mustParse(testNoteIRI)), nil)
+		assertEqual(t, l1.Unlock(ctx, mustParse(testNoteIRI)), nil)
+		c, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assertEqual(t, NewLocker(s.newClient(), clock, time.Minute, time.Millisecond).Lock(c, mustParse(testNoteIRI)), context.DeadlineExceeded)
+	})
+	t.Run("UnlockRequiresHeldLock", func(t *testing.T) {
+		l := NewLocker(newFakeServer().newClient(), clock, time.Minute, time.Millisecond)
+		err := l.Unlock(ctx, mustParse(testNoteIRI))
+		assertEqual(t, err == nil, false)
+	})
+}
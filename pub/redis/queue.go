@@ -0,0 +1,235 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// queuedDelivery is a delivery stored in Redis.
+type queuedDelivery struct {
+	Inbox     string      `json:"inbox"`
+	Outbox    string      `json:"outbox,omitempty"`
+	Payload   []byte      `json:"payload"`
+	Header    http.Header `json:"header,omitempty"`
+	Attempts  int         `json:"attempts"`
+	NotBefore time.Time   `json:"notBefore"`
+	LastError string      `json:"lastError,omitempty"`
+}
+
+// DeliveryQueue is a pub.DeliveryQueue shared by every instance of a clustered
+// deployment. A delivery is dequeued by only one instance.
+//
+// Pending deliveries are kept in a sorted set scored by when they are due, in
+// flight deliveries in a sorted set scored by when they were dequeued, and the
+// deliveries themselves in a hash.
+type DeliveryQueue struct {
+	cl *Client
+}
+
+// pub.DeliveryQueue must be implemented by DeliveryQueue.
+var _ pub.DeliveryQueue = &DeliveryQueue{}
+
+// NewDeliveryQueue creates a DeliveryQueue.
+func NewDeliveryQueue(cl *Client) *DeliveryQueue {
+	return &DeliveryQueue{cl: cl}
+}
+
+// score returns the sorted set score of the time, in milliseconds.
+func score(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// Enqueue adds the delivery to the pending deliveries, assigning its Id.
+func (q *DeliveryQueue) Enqueue(c context.Context, d pub.QueuedDelivery) error {
+	id, err := redigo.Int64(q.cl.do(c, "INCR", q.cl.key("queue", "ids")))
+	if err != nil {
+		return err
+	}
+	d.Id = strconv.FormatInt(id, 10)
+	return q.requeue(c, d, false)
+}
+
+// Dequeue claims up to n due pending deliveries, and returns them.
+func (q *DeliveryQueue) Dequeue(c context.Context, now time.Time, n int) ([]pub.QueuedDelivery, error) {
+	ids, err := redigo.Strings(q.cl.do(c, "ZRANGEBYSCORE", q.cl.key("queue", "pending"), "-inf", score(now), "LIMIT", 0, n))
+	if err != nil {
+		return nil, err
+	}
+	conn, err := q.cl.conn(c)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	var due []pub.QueuedDelivery
+	for _, id := range ids {
+		b, claimed, err := q.claim(conn, id, now)
+		if err != nil {
+			return nil, err
+		} else if !claimed {
+			continue
+		}
+		d, err := toQueuedDelivery(id, b)
+		if err != nil {
+			return nil, err
+		}
+		due = append(due, d)
+	}
+	return due, nil
+}
+
+// claim moves the pending delivery to the in flight deliveries, and returns
+// it. Returns false if another instance claimed it since it was listed, in
+// which case it is left as is.
+func (q *DeliveryQueue) claim(conn redigo.Conn, id string, now time.Time) ([]byte, bool, error) {
+	pending := q.cl.key("queue", "pending")
+	for {
+		if _, err := conn.Do("WATCH", pending); err != nil {
+			return nil, false, err
+		}
+		_, err := redigo.Float64(conn.Do("ZSCORE", pending, id))
+		if err == redigo.ErrNil {
+			_, err = conn.Do("UNWATCH")
+			return nil, false, err
+		} else if err != nil {
+			return nil, false, err
+		}
+		if _, err = conn.Do("MULTI"); err != nil {
+			return nil, false, err
+		}
+		conn.Do("ZREM", pending, id)
+		conn.Do("ZADD", q.cl.key("queue", "inflight"), score(now), id)
+		conn.Do("HGET", q.cl.key("queue", "deliveries"), id)
+		replies, err := redigo.Values(conn.Do("EXEC"))
+		if err == redigo.ErrNil {
+			// The pending deliveries changed since they were
+			// watched, so whether it is still pending is checked
+			// again.
+			continue
+		} else if err != nil {
+			return nil, false, err
+		}
+		b, err := redigo.Bytes(replies[2], nil)
+		return b, err == nil, err
+	}
+}
+
+// MarkDelivered removes the in flight delivery.
+func (q *DeliveryQueue) MarkDelivered(c context.Context, d pub.QueuedDelivery) error {
+	conn, err := q.cl.conn(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err = conn.Do("MULTI"); err != nil {
+		return err
+	}
+	conn.Do("ZREM", q.cl.key("queue", "inflight"), d.Id)
+	conn.Do("HDEL", q.cl.key("queue", "deliveries"), d.Id)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// MarkFailed returns the in flight delivery to the pending deliveries, with its
+// new attempts.
+func (q *DeliveryQueue) MarkFailed(c context.Context, d pub.QueuedDelivery) error {
+	return q.requeue(c, d, true)
+}
+
+// RequeueInflight returns the deliveries dequeued before the time to the
+// pending deliveries, such as those of an instance that crashed. Returns the
+// number of deliveries requeued.
+func (q *DeliveryQueue) RequeueInflight(c context.Context, before time.Time) (int, error) {
+	ids, err := redigo.Strings(q.cl.do(c, "ZRANGEBYSCORE", q.cl.key("queue", "inflight"), "-inf", "("+strconv.FormatInt(score(before), 10)))
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, id := range ids {
+		b, err := redigo.Bytes(q.cl.do(c, "HGET", q.cl.key("queue", "deliveries"), id))
+		if err == redigo.ErrNil {
+			continue
+		} else if err != nil {
+			return n, err
+		}
+		d, err := toQueuedDelivery(id, b)
+		if err != nil {
+			return n, err
+		}
+		if err = q.requeue(c, d, true); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// requeue stores the delivery and adds it to the pending deliveries, removing
+// it from the in flight deliveries if it was.
+func (q *DeliveryQueue) requeue(c context.Context, d pub.QueuedDelivery, inflight bool) error {
+	var outbox string
+	if d.Outbox != nil {
+		outbox = d.Outbox.String()
+	}
+	b, err := json.Marshal(queuedDelivery{
+		Inbox:     d.Inbox.String(),
+		Outbox:    outbox,
+		Payload:   d.Payload,
+		Header:    d.Header,
+		Attempts:  d.Attempts,
+		NotBefore: d.NotBefore.UTC(),
+		LastError: d.LastError,
+	})
+	if err != nil {
+		return err
+	}
+	conn, err := q.cl.conn(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err = conn.Do("MULTI"); err != nil {
+		return err
+	}
+	conn.Do("HSET", q.cl.key("queue", "deliveries"), d.Id, b)
+	conn.Do("ZADD", q.cl.key("queue", "pending"), score(d.NotBefore), d.Id)
+	if inflight {
+		conn.Do("ZREM", q.cl.key("queue", "inflight"), d.Id)
+	}
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// toQueuedDelivery decodes a stored delivery.
+func toQueuedDelivery(id string, b []byte) (pub.QueuedDelivery, error) {
+	var s queuedDelivery
+	if err := json.Unmarshal(b, &s); err != nil {
+		return pub.QueuedDelivery{}, err
+	}
+	inbox, err := url.Parse(s.Inbox)
+	if err != nil {
+		return pub.QueuedDelivery{}, err
+	}
+	var outbox *url.URL
+	if s.Outbox != "" {
+		if outbox, err = url.Parse(s.Outbox); err != nil {
+			return pub.QueuedDelivery{}, err
+		}
+	}
+	return pub.QueuedDelivery{
+		Id:        id,
+		Inbox:     inbox,
+		Outbox:    outbox,
+		Payload:   s.Payload,
+		Header:    s.Header,
+		Attempts:  s.Attempts,
+		NotBefore: s.NotBefore,
+		LastError: s.LastError,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+func TestDeliveryQueue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	t.Run("DequeuesDueDeliveriesOnce", func(t *testing.T) {
+		q := NewDeliveryQueue(newFakeServer().newClient())
+		assertEqual(t, q.Enqueue(ctx, pub.QueuedDelivery{Inbox: mustParse(testInboxIRI), Payload: []byte("later"), NotBefore: now.Add(time.Hour)}), nil)
+		assertEqual(t, q.Enqueue(ctx, pub.QueuedDelivery{Inbox: mustParse(testInboxIRI2), Payload: []byte("second"), NotBefore: now}), nil)
+		assertEqual(t, q.Enqueue(ctx, pub.QueuedDelivery{Inbox: mustParse(testInboxIRI), Outbox: mustParse(testOutboxIRI), Payload: []byte("first"), NotBefore: now.Add(-time.Minute)}), nil)
+		due, err := q.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 2)
+		assertEqual(t, string(due[0].Payload), "first")
+		assertEqual(t, due[0].Inbox.String(), testInboxIRI)
+		assertEqual(t, due[0].Outbox.String(), testOutboxIRI)
+		assertEqual(t, string(due[1].Payload), "second")
+		due, err = q.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 0)
+	})
+	t.Run("SkipsDeliveriesClaimedByOthers", func(t *testing.T) {
+		s := newFakeServer()
+		q := NewDeliveryQueue(s.newClient())
+		assertEqual(t, q.Enqueue(ctx, pub.QueuedDelivery{Inbox: mustParse(testInboxIRI), Payload: []byte("a"), NotBefore: now}), nil)
+		// Another instance claims the delivery after it is listed.
+		s.beforeExec = func(s *fakeServer) {
+			s.exec("ZREM", []string{"test:queue:pending", "1"})
+			s.exec("ZADD", []string{"test:queue:inflight", "1", "1"})
+		}
+		due, err := q.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 0)
+		assertEqual(t, s.zsets["test:queue:inflight"]["1"], float64(1))
+	})
+	t.Run("MarksDeliveredAndFailed", func(t *testing.T) {
+		q := NewDeliveryQueue(newFakeServer().newClient())
+		for _, p := range []string{"a", "b"} {
+			assertEqual(t, q.Enqueue(ctx, pub.QueuedDelivery{Inbox: mustParse(testInboxIRI), Payload: []byte(p), NotBefore: now}), nil)
+		}
+		due, err := q.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 2)
+		assertEqual(t, q.MarkDelivered(ctx, due[0]), nil)
+		failed := due[1]
+		failed.Attempts++
+		failed.LastError = "unreachable"
+		failed.NotBefore = now.Add(time.Minute)
+		assertEqual(t, q.MarkFailed(ctx, failed), nil)
+		due, err = q.Dequeue(ctx, now.Add(time.Minute), 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 1)
+		assertEqual(t, string(due[0].Payload), "b")
+		assertEqual(t, due[0].Attempts, 1)
+		assertEqual(t, due[0].LastError, "unreachable")
+	})
+	t.Run("RequeuesInflightDeliveries", func(t *testing.T) {
+		q := NewDeliveryQueue(newFakeServer().newClient())
+		assertEqual(t, q.Enqueue(ctx, pub.QueuedDelivery{Inbox: mustParse(testInboxIRI), Payload: []byte("a"), NotBefore: now}), nil)
+		_, err := q.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		n, err := q.RequeueInflight(ctx, now)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 0)
+		n, err = q.RequeueInflight(ctx, now.Add(time.Minute))
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+		due, err := q.Dequeue(ctx, now, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 1)
+	})
+}
@@ -0,0 +1,56 @@
+// Package redis implements the delivery queue, caches, deduplication windows,
+// and locks used by the pub package with Redis, so that the instances of a
+// clustered deployment share them without writing adapters.
+//
+// Every value is stored under keys beginning with the Client's prefix, so
+// several applications may share one Redis database. Applications call the
+// Client's Migrate method when starting, so the values stored by an earlier
+// version of this package are upgraded.
+//
+// It is a module of its own, so applications using only the pub package do
+// not depend on Redis.
+package redis
+
+import (
+	"context"
+	"strings"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// Client sends the commands of the implementations in this package to Redis
+// through a connection pool.
+type Client struct {
+	pool   *redigo.Pool
+	prefix string
+}
+
+// NewClient creates a Client using connections from the pool, and storing
+// values under keys beginning with the prefix, such as "myapp:".
+func NewClient(pool *redigo.Pool, prefix string) *Client {
+	return &Client{
+		pool:   pool,
+		prefix: prefix,
+	}
+}
+
+// key returns the key of the parts, joined with colons.
+func (cl *Client) key(parts ...string) string {
+	return cl.prefix + strings.Join(parts, ":")
+}
+
+// do sends a single command on a pooled connection.
+func (cl *Client) do(c context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	conn, err := cl.pool.GetContext(c)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.Do(cmd, args...)
+}
+
+// conn returns a pooled connection, which must be closed, for commands that
+// must be sent on the same connection, such as transactions.
+func (cl *Client) conn(c context.Context) (redigo.Conn, error) {
+	return cl.pool.GetContext(c)
+}
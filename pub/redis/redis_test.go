@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"net/url"
+	"testing"
+)
+
+func assertEqual(t *testing.T, l, r interface{}) {
+	t.Helper()
+	if l != r {
+		t.Errorf("expected equal: %v != %v", l, r)
+	}
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+const (
+	testInboxIRI      = "https://other.example.com/dakota/inbox"
+	testInboxIRI2     = "https://other.example.com/addison/inbox"
+	testOutboxIRI     = "https://example.com/addison/outbox"
+	testCollectionIRI = "https://example.com/addison/followers"
+	testNoteIRI       = "https://example.com/note/1"
+)
@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// migration is a versioned change to the way this package stores its values.
+//
+// A migration interrupted before its version is recorded is applied again, so
+// its step must be safe to repeat.
+type migration struct {
+	version     int
+	description string
+	up          func(c context.Context, cl *Client) error
+}
+
+// migrations are the changes to the stored values, in increasing versions. New
+// migrations are appended, and released migrations are never changed.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "record the initial schema",
+		up:          func(c context.Context, cl *Client) error { return nil },
+	},
+}
+
+// schemaLockIRI identifies the lock held while migrating.
+var schemaLockIRI = &url.URL{Scheme: "urn", Opaque: "go-fed:schema"}
+
+const (
+	// schemaLockTTL is how long a migration may hold the lock before it
+	// expires.
+	schemaLockTTL = 10 * time.Minute
+	// schemaLockRetry is how often an instance waiting for another one
+	// migrating tries acquiring the lock again.
+	schemaLockRetry = time.Second
+)
+
+// SchemaVersion returns the version of the stored values, which is zero if no
+// migration was applied.
+func (cl *Client) SchemaVersion(c context.Context) (int, error) {
+	v, err := redigo.Int(cl.do(c, "GET", cl.key("schema", "version")))
+	if err == redigo.ErrNil {
+		return 0, nil
+	}
+	return v, err
+}
+
+// Migrate applies the migrations the stored values are missing, in order, and
+// returns the resulting version. Applications call it when starting, before
+// using the other types of this package.
+//
+// Instances of a clustered deployment may call it at once: one of them
+// migrates while the others wait. An error is returned if the values were
+// migrated by a newer version of this package, which they must not be used
+// with.
+func (cl *Client) Migrate(c context.Context) (int, error) {
+	l := NewLocker(cl, systemClock{}, schemaLockTTL, schemaLockRetry)
+	if err := l.Lock(c, schemaLockIRI); err != nil {
+		return 0, err
+	}
+	defer l.Unlock(c, schemaLockIRI)
+	v, err := cl.SchemaVersion(c)
+	if err != nil {
+		return 0, err
+	}
+	latest := migrations[len(migrations)-1].version
+	if v > latest {
+		return v, fmt.Errorf("schema version %d is newer than the latest known version %d", v, latest)
+	}
+	for _, m := range migrations {
+		if m.version <= v {
+			continue
+		}
+		if err = m.up(c, cl); err != nil {
+			return v, fmt.Errorf("migration %d (%s) failed: %s", m.version, m.description, err)
+		}
+		if _, err = cl.do(c, "SET", cl.key("schema", "version"), m.version); err != nil {
+			return v, err
+		}
+		v = m.version
+	}
+	return v, nil
+}
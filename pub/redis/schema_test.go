@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	defer func(m []migration) { migrations = m }(migrations)
+	var applied []int
+	step := func(v int) migration {
+		return migration{
+			version: v,
+			up: func(c context.Context, cl *Client) error {
+				applied = append(applied, v)
+				return nil
+			},
+		}
+	}
+	t.Run("AppliesMissingMigrationsOnce", func(t *testing.T) {
+		applied = nil
+		migrations = []migration{step(1), step(2)}
+		cl := newFakeServer().newClient()
+		v, err := cl.SchemaVersion(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, v, 0)
+		v, err = cl.Migrate(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, v, 2)
+		migrations = append(migrations, step(3))
+		v, err = cl.Migrate(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, v, 3)
+		assertEqual(t, fmt.Sprint(applied), "[1 2 3]")
+	})
+	t.Run("StopsAtFailure", func(t *testing.T) {
+		applied = nil
+		failing := step(2)
+		failing.up = func(c context.Context, cl *Client) error { return errors.New("failed") }
+		migrations = []migration{step(1), failing, step(3)}
+		cl := newFakeServer().newClient()
+		v, err := cl.Migrate(ctx)
+		assertEqual(t, err == nil, false)
+		assertEqual(t, v, 1)
+		v, err = cl.SchemaVersion(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, v, 1)
+		assertEqual(t, fmt.Sprint(applied), "[1]")
+	})
+	t.Run("RefusesNewerSchema", func(t *testing.T) {
+		applied = nil
+		migrations = []migration{step(1), step(2)}
+		cl := newFakeServer().newClient()
+		_, err := cl.Migrate(ctx)
+		assertEqual(t, err, nil)
+		migrations = migrations[:1]
+		v, err := cl.Migrate(ctx)
+		assertEqual(t, err == nil, false)
+		assertEqual(t, v, 2)
+	})
+}
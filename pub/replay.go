@@ -0,0 +1,125 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// ErrReplayUnsupported is returned when replaying activities with an Actor whose
+// delegate cannot replay them, such as one created by NewCustomActor.
+var ErrReplayUnsupported = errors.New("actor does not support replaying inbox activities")
+
+// JournalEntry is an activity recorded as accepted into an inbox.
+type JournalEntry struct {
+	// Inbox is the IRI of the inbox the activity was accepted into.
+	Inbox *url.URL
+	// Activity is the serialized activity.
+	Activity []byte
+}
+
+// ActivityJournal is an audit log of the activities accepted into inboxes, such
+// as one recorded by an InboxAcceptedHook.
+type ActivityJournal interface {
+	// Between returns the entries from the one of the first activity id to
+	// the one of the last activity id, inclusive, in the order they were
+	// accepted.
+	Between(c context.Context, first, last *url.URL) ([]JournalEntry, error)
+}
+
+// InboxReplayer is implemented by the Actors created by NewFederatingActor and
+// NewActor, and by DelegateActors able to replay inbox activities.
+type InboxReplayer interface {
+	// ReplayInbox triggers the side effects of an activity received in the
+	// inbox again, without adding it to the inbox a second time.
+	//
+	// The side effects of the library do not add duplicate items to the
+	// collections they modify, but application callbacks are called
+	// again.
+	ReplayInbox(c context.Context, inboxIRI *url.URL, activity Activity) error
+}
+
+// Replay triggers the side effects of the journaled activities from the first
+// activity id to the last again, such as after fixing a bug in an application
+// callback. Replaying stops at the first error, and the number of activities
+// replayed before it is returned, so the replay can resume from the activity
+// that failed.
+//
+// The InboxAcceptedHook is not called for replayed activities.
+//
+// The journal package records the activities to a file, and adds a replay verb
+// to the command of the application.
+func Replay(c context.Context, actor Actor, journal ActivityJournal, first, last *url.URL) (int, error) {
+	r, ok := actor.(InboxReplayer)
+	if !ok {
+		return 0, ErrReplayUnsupported
+	}
+	entries, err := journal.Between(c, first, last)
+	if err != nil {
+		return 0, err
+	}
+	for i, e := range entries {
+		var m map[string]interface{}
+		if err = json.Unmarshal(e.Activity, &m); err != nil {
+			return i, err
+		}
+		var t vocab.Type
+		if t, err = streams.ToType(c, m); err != nil {
+			return i, err
+		}
+		activity, ok := t.(Activity)
+		if !ok {
+			return i, fmt.Errorf("journaled value is not an Activity: %T", t)
+		}
+		if err = r.ReplayInbox(c, e.Inbox, activity); err != nil {
+			return i, err
+		}
+	}
+	return len(entries), nil
+}
+
+// replayContextKey is the context key marking side effects being replayed.
+type replayContextKey struct{}
+
+// withReplay marks the side effects triggered with the context as replayed.
+func withReplay(c context.Context) context.Context {
+	return context.WithValue(c, replayContextKey{}, true)
+}
+
+// isReplay determines whether the side effects triggered with the context are
+// replayed.
+func isReplay(c context.Context) bool {
+	r, _ := c.Value(replayContextKey{}).(bool)
+	return r
+}
+
+// prependItemIRI prepends the IRI to the items, unless side effects are replayed
+// and the items already contain it.
+func prependItemIRI(c context.Context, items vocab.ActivityStreamsItemsProperty, iri *url.URL) {
+	if isReplay(c) {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == iri.String() {
+				return
+			}
+		}
+	}
+	items.PrependIRI(iri)
+}
+
+// prependOrderedItemIRI prepends the IRI to the ordered items, unless side
+// effects are replayed and the ordered items already contain it.
+func prependOrderedItemIRI(c context.Context, items vocab.ActivityStreamsOrderedItemsProperty, iri *url.URL) {
+	if isReplay(c) {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == iri.String() {
+				return
+			}
+		}
+	}
+	items.PrependIRI(iri)
+}
@@ -0,0 +1,110 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+// sliceJournal is an ActivityJournal of entries in a slice.
+type sliceJournal []JournalEntry
+
+func (s sliceJournal) Between(c context.Context, first, last *url.URL) ([]JournalEntry, error) {
+	return s, nil
+}
+
+// replayingActor is an Actor recording the activities replayed.
+type replayingActor struct {
+	FederatingActor
+	replayed []string
+	failOn   string
+}
+
+func (r *replayingActor) ReplayInbox(c context.Context, inboxIRI *url.URL, a Activity) error {
+	id := a.GetActivityStreamsId().Get().String()
+	if id == r.failOn {
+		return errors.New("replay failed")
+	}
+	r.replayed = append(r.replayed, id)
+	return nil
+}
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	m, err := serialize(testListen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listen, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	journal := sliceJournal{
+		{Inbox: mustParse(testMyInboxIRI), Activity: listen},
+		{Inbox: mustParse(testMyInboxIRI), Activity: []byte(`{"@context":"https://www.w3.org/ns/activitystreams","id":"https://other.example.com/activity/2","type":"Listen"}`)},
+	}
+	first, last := mustParse(testFederatedActivityIRI), mustParse(testFederatedActivityIRI2)
+	t.Run("ReplaysInOrder", func(t *testing.T) {
+		a := &replayingActor{}
+		n, err := Replay(ctx, a, journal, first, last)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 2)
+		assertEqual(t, fmt.Sprint(a.replayed), "["+testFederatedActivityIRI+" "+testFederatedActivityIRI2+"]")
+	})
+	t.Run("StopsAtFailure", func(t *testing.T) {
+		a := &replayingActor{failOn: testFederatedActivityIRI2}
+		n, err := Replay(ctx, a, journal, first, last)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, n, 1)
+	})
+	t.Run("CustomActorUnsupported", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a := NewCustomActor(NewMockDelegateActor(ctl), false, true, NewMockClock(ctl))
+		_, err := Replay(ctx, a, journal, first, last)
+		assertEqual(t, err, ErrReplayUnsupported)
+	})
+	t.Run("DoesNotAddToInbox", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp := NewMockFederatingProtocol(ctl)
+		a := NewFederatingActor(NewMockCommonBehavior(ctl), fp, NewMockDatabase(ctl), NewMockClock(ctl))
+		fp.EXPECT().Callbacks(gomock.Any()).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(gomock.Any(), gomock.Any()).Return(nil)
+		n, err := Replay(ctx, a, journal[:1], first, first)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+	})
+}
+
+func TestPrependItemIRI(t *testing.T) {
+	ctx := context.Background()
+	items := streams.NewActivityStreamsItemsProperty()
+	items.AppendIRI(mustParse(testFederatedActorIRI))
+	oItems := streams.NewActivityStreamsOrderedItemsProperty()
+	oItems.AppendIRI(mustParse(testFederatedActorIRI))
+	t.Run("PrependsDuplicates", func(t *testing.T) {
+		prependItemIRI(ctx, items, mustParse(testFederatedActorIRI))
+		prependOrderedItemIRI(ctx, oItems, mustParse(testFederatedActorIRI))
+		assertEqual(t, items.Len(), 2)
+		assertEqual(t, oItems.Len(), 2)
+	})
+	t.Run("SkipsDuplicatesWhenReplaying", func(t *testing.T) {
+		c := withReplay(ctx)
+		prependItemIRI(c, items, mustParse(testFederatedActorIRI))
+		prependOrderedItemIRI(c, oItems, mustParse(testFederatedActorIRI))
+		assertEqual(t, items.Len(), 2)
+		assertEqual(t, oItems.Len(), 2)
+		prependItemIRI(c, items, mustParse(testFederatedActorIRI2))
+		prependOrderedItemIRI(c, oItems, mustParse(testFederatedActorIRI2))
+		assertEqual(t, items.Len(), 3)
+		assertEqual(t, oItems.Len(), 3)
+	})
+}
@@ -0,0 +1,152 @@
+package pub
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxInboxBodyBytes is the default limit of the size of the activities
+// POSTed to inboxes, both as received and once decompressed.
+const DefaultMaxInboxBodyBytes = 1 << 20
+
+// ErrRequestBodyTooLarge indicates a request body, or its decompressed content,
+// is larger than allowed. The inbox responds with 413 Request Entity Too Large.
+var ErrRequestBodyTooLarge = errors.New("request body is too large")
+
+// unsupportedEncodingError indicates a request body is encoded in a way that
+// cannot be decoded.
+type unsupportedEncodingError string
+
+// Error describes the unsupported encoding.
+func (e unsupportedEncodingError) Error() string {
+	return fmt.Sprintf("unsupported %s: %s", contentEncodingHeader, string(e))
+}
+
+const (
+	// The Content-Encoding header.
+	contentEncodingHeader = "Content-Encoding"
+	// The gzip content encoding, and its legacy alias.
+	gzipEncoding  = "gzip"
+	xGzipEncoding = "x-gzip"
+	// The identity content encoding.
+	identityEncoding = "identity"
+)
+
+// InboxBodyLimiter may be implemented by a DelegateActor to change the limit of
+// the size of the activities POSTed to inboxes, which is
+// DefaultMaxInboxBodyBytes otherwise. The limit applies to the body as
+// received and once decompressed, so small gzip bodies cannot expand without
+// bounds.
+//
+// The actors created with NewFederatingActor or NewActor defer to the
+// FederatingProtocol if it implements InboxBodyLimiter.
+type InboxBodyLimiter interface {
+	// MaxInboxBodyBytes returns the limit of the size of inbox bodies.
+	// Zero or less means unlimited.
+	MaxInboxBodyBytes(c context.Context) int64
+}
+
+// MaxInboxBodyBytes defers the limit of the size of inbox bodies to the
+// FederatingProtocol.
+func (a *sideEffectActor) MaxInboxBodyBytes(c context.Context) int64 {
+	if l, ok := a.s2s.(InboxBodyLimiter); ok {
+		return l.MaxInboxBodyBytes(c)
+	}
+	return DefaultMaxInboxBodyBytes
+}
+
+// maxInboxBodyBytes returns the limit of the size of inbox bodies of the
+// delegate.
+func (b *baseActor) maxInboxBodyBytes(c context.Context) int64 {
+	if l, ok := b.delegate.(InboxBodyLimiter); ok {
+		return l.MaxInboxBodyBytes(c)
+	}
+	return DefaultMaxInboxBodyBytes
+}
+
+// readLimited reads all of r, returning ErrRequestBodyTooLarge if it is larger
+// than max bytes. Zero or less means unlimited.
+func readLimited(r io.Reader, max int64) ([]byte, error) {
+	if max <= 0 {
+		return ioutil.ReadAll(r)
+	}
+	// Read one more byte than max to detect a body that is too large.
+	b, err := ioutil.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(b)) > max {
+		return nil, ErrRequestBodyTooLarge
+	}
+	return b, nil
+}
+
+// bufferBody reads the request body, up to max bytes, and replaces it with the
+// bytes read so it remains readable. Bodies sent chunked have an unknown
+// length until read, so their ContentLength is set to the bytes read.
+//
+// The bytes are as received, so still compressed if the body has a
+// Content-Encoding.
+func bufferBody(r *http.Request, max int64) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	b, err := readLimited(r.Body, max)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	r.TransferEncoding = nil
+	return b, nil
+}
+
+// decodeBody decodes a body of the Content-Encoding, which may be gzip or
+// identity, up to max bytes once decoded.
+func decodeBody(b []byte, encoding string, max int64) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "", identityEncoding:
+		return b, nil
+	case gzipEncoding, xGzipEncoding:
+		zr, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return readLimited(zr, max)
+	default:
+		return nil, unsupportedEncodingError(encoding)
+	}
+}
+
+// readInboxBody reads the body of a request POSTed to an inbox, decompressing
+// it if it is gzip encoded. The body as received remains readable.
+func readInboxBody(r *http.Request, max int64) ([]byte, error) {
+	b, err := bufferBody(r, max)
+	if err != nil {
+		return nil, err
+	}
+	return decodeBody(b, r.Header.Get(contentEncodingHeader), max)
+}
+
+// bodyErrorStatus returns the status code of the response to a request whose
+// body could not be read, or zero if the error is not the peer's fault.
+func bodyErrorStatus(err error) int {
+	if _, ok := err.(unsupportedEncodingError); ok {
+		return http.StatusUnsupportedMediaType
+	}
+	switch err {
+	case ErrRequestBodyTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case gzip.ErrHeader, gzip.ErrChecksum, io.ErrUnexpectedEOF:
+		return http.StatusBadRequest
+	}
+	return 0
+}
@@ -0,0 +1,152 @@
+package pub
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+// gzipped returns the gzip compressed bytes.
+func gzipped(t *testing.T, b []byte) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// chunkedRequest returns a POST request to the inbox as received by a server
+// when sent chunked, with an unknown ContentLength.
+func chunkedRequest(body []byte) *http.Request {
+	r := httptest.NewRequest("POST", testMyInboxIRI, ioutil.NopCloser(bytes.NewReader(body)))
+	r.ContentLength = -1
+	r.TransferEncoding = []string{"chunked"}
+	return r
+}
+
+func TestReadInboxBody(t *testing.T) {
+	body := []byte(`{"type":"Create"}`)
+	t.Run("ReadsChunkedBody", func(t *testing.T) {
+		r := chunkedRequest(body)
+		b, err := readInboxBody(r, 1024)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), string(body))
+		assertEqual(t, r.ContentLength, int64(len(body)))
+		again, err := ioutil.ReadAll(r.Body)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(again), string(body))
+	})
+	t.Run("DecompressesGzip", func(t *testing.T) {
+		r := chunkedRequest(gzipped(t, body))
+		r.Header.Set("Content-Encoding", "gzip")
+		b, err := readInboxBody(r, 1024)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), string(body))
+	})
+	t.Run("RejectsLargeBody", func(t *testing.T) {
+		_, err := readInboxBody(chunkedRequest(body), 4)
+		assertEqual(t, err, ErrRequestBodyTooLarge)
+		assertEqual(t, bodyErrorStatus(err), http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsDecompressionBomb", func(t *testing.T) {
+		r := chunkedRequest(gzipped(t, make([]byte, 1<<20)))
+		r.Header.Set("Content-Encoding", "gzip")
+		_, err := readInboxBody(r, 1<<16)
+		assertEqual(t, err, ErrRequestBodyTooLarge)
+	})
+	t.Run("RejectsUnsupportedEncoding", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Content-Encoding", "br")
+		_, err := readInboxBody(r, 1024)
+		assertEqual(t, bodyErrorStatus(err), http.StatusUnsupportedMediaType)
+	})
+	t.Run("RejectsCorruptGzip", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Content-Encoding", "gzip")
+		_, err := readInboxBody(r, 1024)
+		assertEqual(t, bodyErrorStatus(err), http.StatusBadRequest)
+	})
+}
+
+func TestVerifyDigest(t *testing.T) {
+	body := []byte(`{"type":"Create"}`)
+	compressed := gzipped(t, body)
+	signed := []string{"(request-target)", "date", "digest"}
+	t.Run("ChunkedBody", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Digest", digestHeaderValues(body, defaultDigestAlgorithms))
+		assertEqual(t, verifyDigest(r, signed, 1024), nil)
+	})
+	t.Run("DigestOfCompressedBytes", func(t *testing.T) {
+		r := chunkedRequest(compressed)
+		r.Header.Set("Content-Encoding", "gzip")
+		r.Header.Set("Digest", digestHeaderValues(compressed, defaultDigestAlgorithms))
+		assertEqual(t, verifyDigest(r, signed, 1024), nil)
+	})
+	t.Run("DigestOfDecompressedBytes", func(t *testing.T) {
+		r := chunkedRequest(compressed)
+		r.Header.Set("Content-Encoding", "gzip")
+		r.Header.Set("Digest", digestHeaderValues(body, defaultDigestAlgorithms))
+		assertEqual(t, verifyDigest(r, signed, 1024), nil)
+		// The body remains compressed for the inbox.
+		b, err := ioutil.ReadAll(r.Body)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), string(compressed))
+	})
+	t.Run("RejectsMismatch", func(t *testing.T) {
+		r := chunkedRequest(compressed)
+		r.Header.Set("Content-Encoding", "gzip")
+		r.Header.Set("Digest", digestHeaderValues([]byte("{}"), defaultDigestAlgorithms))
+		assertNotEqual(t, verifyDigest(r, signed, 1024), nil)
+	})
+	t.Run("RejectsLargeBody", func(t *testing.T) {
+		r := chunkedRequest(body)
+		r.Header.Set("Digest", digestHeaderValues(body, defaultDigestAlgorithms))
+		assertEqual(t, verifyDigest(r, signed, 4), ErrRequestBodyTooLarge)
+	})
+}
+
+func TestBaseActorPostInboxCompressed(t *testing.T) {
+	setupData()
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	delegate := NewMockDelegateActor(ctl)
+	a := NewCustomActor(delegate, false, true, NewMockClock(ctl))
+	t.Run("AcceptsGzip", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		raw, err := ioutil.ReadAll(toPostInboxRequest(testCreate).Body)
+		assertEqual(t, err, nil)
+		req := toAPRequest(chunkedRequest(gzipped(t, raw)))
+		req.Header.Set("Content-Encoding", "gzip")
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, toDeserializedForm(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
+		handled, err := a.PostInbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("RejectsDecompressionBomb", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		req := toAPRequest(chunkedRequest(gzipped(t, make([]byte, 2*DefaultMaxInboxBodyBytes))))
+		req.Header.Set("Content-Encoding", "gzip")
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(true, nil)
+		handled, err := a.PostInbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+}
@@ -0,0 +1,164 @@
+package pub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// redactedHeaderValue replaces the values of the headers that carry
+// credentials in a RequestLog.
+const redactedHeaderValue = "[redacted]"
+
+// redactedHeaders are the headers whose values are never given to a
+// RequestLogger.
+var redactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	"Signature",
+}
+
+// RequestLog describes a request made by an HttpSigTransport and its response.
+//
+// The values of headers carrying credentials, such as Signature and
+// Authorization, are redacted.
+type RequestLog struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the URL the request was sent to.
+	URL *url.URL
+	// RequestHeader holds the headers of the request.
+	RequestHeader http.Header
+	// RequestBytes is the size of the request body.
+	RequestBytes int64
+	// StatusCode is the status code of the response, or zero if no response
+	// was received.
+	StatusCode int
+	// ResponseHeader holds the headers of the response, if any.
+	ResponseHeader http.Header
+	// ResponseBytes is the number of bytes of the response body that were
+	// read, which is less than its size if it was not read entirely.
+	ResponseBytes int64
+	// Latency is the time taken to receive the response headers.
+	Latency time.Duration
+	// BodySample is the beginning of the response body, if body sampling
+	// is enabled.
+	BodySample []byte
+	// Err is the error sending the request, if any. An error status code
+	// is not an error.
+	Err error
+}
+
+// RequestLogger receives a RequestLog for every request made by an
+// HttpSigTransport. It is called once the response body is closed, or as soon
+// as the request fails, and may be called concurrently.
+type RequestLogger func(c context.Context, l RequestLog)
+
+// requestLogOptions holds the logging settings of a transport, which may be
+// changed concurrently with requests being made.
+type requestLogOptions struct {
+	mu          sync.RWMutex
+	logger      RequestLogger
+	sampleBytes int
+}
+
+// SetRequestLogger sets the RequestLogger called for every request. A nil
+// logger disables logging, which is the default.
+//
+// If sampleBytes is greater than zero, up to that many bytes of each response
+// body are kept in the RequestLog. Response bodies may contain private data,
+// so sampling should only be enabled while debugging.
+func (h HttpSigTransport) SetRequestLogger(logger RequestLogger, sampleBytes int) {
+	h.logOptions.mu.Lock()
+	defer h.logOptions.mu.Unlock()
+	h.logOptions.logger = logger
+	h.logOptions.sampleBytes = sampleBytes
+}
+
+// do sends a request with the HttpClient, logging it if a RequestLogger is
+// set. The requestBytes is the size of the request body.
+func (h HttpSigTransport) do(c context.Context, req *http.Request, requestBytes int64) (*http.Response, error) {
+	h.logOptions.mu.RLock()
+	logger, sampleBytes := h.logOptions.logger, h.logOptions.sampleBytes
+	h.logOptions.mu.RUnlock()
+	if logger == nil {
+		return h.client.Do(req)
+	}
+	start := h.clock.Now()
+	resp, err := h.client.Do(req)
+	l := RequestLog{
+		Method:        req.Method,
+		URL:           req.URL,
+		RequestHeader: redactHeader(req.Header),
+		RequestBytes:  requestBytes,
+		Latency:       h.clock.Now().Sub(start),
+	}
+	if err != nil {
+		l.Err = err
+		logger(c, l)
+		return nil, err
+	}
+	l.StatusCode = resp.StatusCode
+	l.ResponseHeader = redactHeader(resp.Header)
+	resp.Body = &loggedBody{
+		ReadCloser:  resp.Body,
+		c:           c,
+		log:         l,
+		logger:      logger,
+		sampleBytes: sampleBytes,
+	}
+	return resp, nil
+}
+
+// redactHeader copies the headers, replacing the values of the ones carrying
+// credentials.
+func redactHeader(h http.Header) http.Header {
+	r := make(http.Header, len(h))
+	for k, vs := range h {
+		r[k] = append([]string(nil), vs...)
+	}
+	for _, k := range redactedHeaders {
+		if _, ok := r[k]; ok {
+			r.Set(k, redactedHeaderValue)
+		}
+	}
+	return r
+}
+
+// loggedBody is a response body that counts and samples the bytes read from it,
+// and logs its request once closed.
+type loggedBody struct {
+	io.ReadCloser
+	c           context.Context
+	log         RequestLog
+	logger      RequestLogger
+	sampleBytes int
+	once        sync.Once
+}
+
+// Read reads from the body, counting and sampling the bytes read.
+func (l *loggedBody) Read(p []byte) (n int, err error) {
+	n, err = l.ReadCloser.Read(p)
+	l.log.ResponseBytes += int64(n)
+	if remaining := l.sampleBytes - len(l.log.BodySample); remaining > 0 && n > 0 {
+		if remaining > n {
+			remaining = n
+		}
+		l.log.BodySample = append(l.log.BodySample, p[:remaining]...)
+	}
+	return
+}
+
+// Close closes the body and logs its request.
+func (l *loggedBody) Close() error {
+	err := l.ReadCloser.Close()
+	l.once.Do(func() {
+		l.logger(l.c, l.log)
+	})
+	return err
+}
@@ -0,0 +1,125 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHttpSigTransportRequestLogger(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := []byte(`{"type":"Note"}`)
+	start := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	// setupFn returns a transport whose client responds with the status
+	// code and body, or the error, and whose clock advances by a second
+	// on each call.
+	setupFn := func(ctl *gomock.Controller, code int, clientErr error) *HttpSigTransport {
+		clock := NewMockClock(ctl)
+		now := start
+		clock.EXPECT().Now().DoAndReturn(func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}).AnyTimes()
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if clientErr != nil {
+				return nil, clientErr
+			}
+			resp := newResponse(code)
+			resp.Header = http.Header{contentTypeHeader: []string{"application/activity+json"}}
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			return resp, nil
+		})
+		return NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+	}
+	t.Run("LogsDereference", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl, http.StatusOK, nil)
+		var logs []RequestLog
+		tp.SetRequestLogger(func(c context.Context, l RequestLog) {
+			logs = append(logs, l)
+		}, 0)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(logs), 1)
+		assertEqual(t, logs[0].Method, http.MethodGet)
+		assertEqual(t, logs[0].URL.String(), testNoteId1)
+		assertEqual(t, logs[0].StatusCode, http.StatusOK)
+		assertEqual(t, logs[0].ResponseBytes, int64(len(body)))
+		assertEqual(t, logs[0].Latency, time.Second)
+		assertEqual(t, len(logs[0].BodySample), 0)
+		assertEqual(t, logs[0].RequestHeader.Get("Signature"), redactedHeaderValue)
+	})
+	t.Run("LogsDeliver", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl, http.StatusAccepted, nil)
+		var logs []RequestLog
+		tp.SetRequestLogger(func(c context.Context, l RequestLog) {
+			logs = append(logs, l)
+		}, 0)
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(logs), 1)
+		assertEqual(t, logs[0].Method, http.MethodPost)
+		assertEqual(t, logs[0].RequestBytes, int64(2))
+		assertEqual(t, logs[0].StatusCode, http.StatusAccepted)
+	})
+	t.Run("SamplesBody", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl, http.StatusOK, nil)
+		var logs []RequestLog
+		tp.SetRequestLogger(func(c context.Context, l RequestLog) {
+			logs = append(logs, l)
+		}, 5)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(logs), 1)
+		assertEqual(t, string(logs[0].BodySample), string(body[:5]))
+	})
+	t.Run("LogsErrors", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clientErr := fmt.Errorf("connection refused")
+		tp := setupFn(ctl, 0, clientErr)
+		var logs []RequestLog
+		tp.SetRequestLogger(func(c context.Context, l RequestLog) {
+			logs = append(logs, l)
+		}, 0)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, clientErr)
+		assertEqual(t, len(logs), 1)
+		assertEqual(t, logs[0].Err, clientErr)
+		assertEqual(t, logs[0].StatusCode, 0)
+	})
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := http.Header{
+		"Authorization": []string{"Bearer secret"},
+		"Signature":     []string{`keyId="a",signature="b"`},
+		"Date":          []string{"today"},
+	}
+	r := redactHeader(h)
+	assertEqual(t, r.Get("Authorization"), redactedHeaderValue)
+	assertEqual(t, r.Get("Signature"), redactedHeaderValue)
+	assertEqual(t, r.Get("Date"), "today")
+	assertEqual(t, h.Get("Authorization"), "Bearer secret")
+}
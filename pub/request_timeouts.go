@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// timeoutOptions holds the timeouts of a transport's requests, which may be
+// changed concurrently with requests being made.
+type timeoutOptions struct {
+	mu      sync.RWMutex
+	request time.Duration
+	batch   time.Duration
+}
+
+// get returns the timeout of each request and of batch deliveries.
+func (o *timeoutOptions) get() (request, batch time.Duration) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.request, o.batch
+}
+
+// SetRequestTimeout bounds the time taken by each request, from sending it to
+// closing its response body, such as the time a stalled peer may hold a
+// delivery. Zero or less means no timeout, which is the default. The deadline
+// of the context of a call still applies.
+func (h HttpSigTransport) SetRequestTimeout(d time.Duration) {
+	h.timeouts.mu.Lock()
+	defer h.timeouts.mu.Unlock()
+	h.timeouts.request = d
+}
+
+// SetBatchDeliverTimeout bounds the time taken by BatchDeliver and
+// BatchDeliverWithReport as a whole. The deliveries not done by then fail.
+// Zero or less means no timeout, which is the default.
+func (h HttpSigTransport) SetBatchDeliverTimeout(d time.Duration) {
+	h.timeouts.mu.Lock()
+	defer h.timeouts.mu.Unlock()
+	h.timeouts.batch = d
+}
+
+// batchContext returns the context of a batch delivery, with its timeout if
+// any. The CancelFunc must be called once the deliveries are done.
+func (h HttpSigTransport) batchContext(c context.Context) (context.Context, context.CancelFunc) {
+	if _, batch := h.timeouts.get(); batch > 0 {
+		return context.WithTimeout(c, batch)
+	}
+	return context.WithCancel(c)
+}
+
+// send sends a request with the context, bounded by the request timeout if
+// any, which lasts until the response body is closed.
+func (h HttpSigTransport) send(c context.Context, req *http.Request, requestBytes int64) (*http.Response, error) {
+	var cancel context.CancelFunc
+	if request, _ := h.timeouts.get(); request > 0 {
+		c, cancel = context.WithTimeout(c, request)
+	} else {
+		c, cancel = context.WithCancel(c)
+	}
+	resp, err := h.do(c, req.WithContext(c), requestBytes)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody is a response body releasing the context of its request
+// once closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context of its request.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
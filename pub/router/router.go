@@ -0,0 +1,141 @@
+// Package router exposes the endpoints of a pub.Actor and a pub.HandlerFunc as
+// routes in the path syntax of chi, echo and gin, so applications register them
+// with a loop instead of wiring each endpoint, and its content negotiation, by
+// hand.
+//
+// It depends on none of the routers: the handlers are plain http.Handlers, which
+// each router wraps its own way. For chi:
+//
+//	for _, rt := range routes.Chi() {
+//	    r.Method(rt.Method, rt.Pattern, rt.Handler)
+//	}
+//
+// For echo:
+//
+//	for _, rt := range routes.Echo() {
+//	    e.Add(rt.Method, rt.Pattern, echo.WrapHandler(rt.Handler))
+//	}
+//
+// For gin:
+//
+//	for _, rt := range routes.Gin() {
+//	    g.Handle(rt.Method, rt.Pattern, gin.WrapH(rt.Handler))
+//	}
+package router
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// UsernameParam is the name of the path parameter of the usernames of actors in
+// the route patterns.
+const UsernameParam = "username"
+
+// Route is an endpoint to register with a router.
+type Route struct {
+	// Method is the HTTP method of the requests of the endpoint.
+	Method string
+	// Pattern is the path of the endpoint, in the syntax of the router.
+	Pattern string
+	// Handler serves the requests of the endpoint.
+	Handler http.Handler
+}
+
+// Routes are the endpoints of the actors of this server, at paths like
+// "/users/{username}/inbox".
+//
+// Requests that are not ActivityPub requests, such as a browser visiting an
+// actor, are served by the Fallback, so web pages may share the paths of the
+// actors. The handlers find the actors by the IRIs of the requests, as pub
+// does, so the usernames need not be parsed.
+type Routes struct {
+	// Actor serves the inboxes and outboxes. Nil omits their routes.
+	Actor pub.Actor
+	// Handler serves the ActivityStreams representations of the actors
+	// and of their collections, such as a pub.NewActivityStreamsHandler.
+	// Nil omits their routes.
+	Handler pub.HandlerFunc
+	// ActorPrefix is the path of the actors before their usernames. Empty
+	// is "/users".
+	ActorPrefix string
+	// Collections are the names of the collections of the actors served by
+	// the Handler, such as "followers", "following" and "liked".
+	Collections []string
+	// SharedInbox is the path of the shared inbox, such as "/inbox". Empty
+	// omits it.
+	SharedInbox string
+	// Fallback serves the requests that are not ActivityPub requests. Nil
+	// responds with http.StatusNotFound.
+	Fallback http.Handler
+	// Error responds to the errors of the Actor and Handler. Nil responds
+	// with http.StatusInternalServerError.
+	Error func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Chi returns the routes, with the username parameter as "{username}".
+func (rs *Routes) Chi() []Route {
+	return rs.routes("{" + UsernameParam + "}")
+}
+
+// Echo returns the routes, with the username parameter as ":username".
+func (rs *Routes) Echo() []Route {
+	return rs.routes(":" + UsernameParam)
+}
+
+// Gin returns the routes, with the username parameter as ":username".
+func (rs *Routes) Gin() []Route {
+	return rs.routes(":" + UsernameParam)
+}
+
+// routes returns the routes, with the username path parameter.
+func (rs *Routes) routes(param string) []Route {
+	prefix := rs.ActorPrefix
+	if len(prefix) == 0 {
+		prefix = "/users"
+	}
+	actor := strings.TrimSuffix(prefix, "/") + "/" + param
+	var routes []Route
+	if rs.Handler != nil {
+		routes = append(routes, Route{http.MethodGet, actor, rs.serve(rs.Handler)})
+		for _, c := range rs.Collections {
+			routes = append(routes, Route{http.MethodGet, actor + "/" + c, rs.serve(rs.Handler)})
+		}
+	}
+	if rs.Actor != nil {
+		routes = append(routes,
+			Route{http.MethodPost, actor + "/inbox", rs.serve(rs.Actor.PostInbox)},
+			Route{http.MethodGet, actor + "/inbox", rs.serve(rs.Actor.GetInbox)},
+			Route{http.MethodPost, actor + "/outbox", rs.serve(rs.Actor.PostOutbox)},
+			Route{http.MethodGet, actor + "/outbox", rs.serve(rs.Actor.GetOutbox)},
+		)
+		if len(rs.SharedInbox) > 0 {
+			routes = append(routes, Route{http.MethodPost, rs.SharedInbox, rs.serve(rs.Actor.PostInbox)})
+		}
+	}
+	return routes
+}
+
+// serve returns a handler serving the ActivityPub requests with the function,
+// and the others with the Fallback.
+func (rs *Routes) serve(fn func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handled, err := fn(r.Context(), w, r)
+		if err != nil {
+			if rs.Error != nil {
+				rs.Error(w, r, err)
+			} else {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		} else if !handled {
+			if rs.Fallback != nil {
+				rs.Fallback.ServeHTTP(w, r)
+			} else {
+				http.NotFound(w, r)
+			}
+		}
+	})
+}
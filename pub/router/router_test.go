@@ -0,0 +1,104 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-fed/activity/pub"
+)
+
+func assertEqual(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// recordingActor is an Actor handling the ActivityPub requests by recording
+// which of its methods served them.
+type recordingActor struct {
+	pub.Actor
+	served string
+	err    error
+}
+
+func (a *recordingActor) handle(name string, w http.ResponseWriter, r *http.Request) (bool, error) {
+	if !pub.IsActivityPubRequest(r) {
+		return false, nil
+	}
+	a.served = name
+	return true, a.err
+}
+
+func (a *recordingActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.handle("PostInbox", w, r)
+}
+
+func (a *recordingActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.handle("GetInbox", w, r)
+}
+
+func (a *recordingActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.handle("PostOutbox", w, r)
+}
+
+func (a *recordingActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.handle("GetOutbox", w, r)
+}
+
+func TestRoutes(t *testing.T) {
+	actor := &recordingActor{}
+	rs := &Routes{
+		Actor: actor,
+		Handler: func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			return actor.handle("Handler", w, r)
+		},
+		Collections: []string{"followers"},
+		SharedInbox: "/inbox",
+		Fallback: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("web page"))
+		}),
+	}
+	t.Run("Patterns", func(t *testing.T) {
+		var chi, gin []string
+		for _, rt := range rs.Chi() {
+			chi = append(chi, rt.Method+" "+rt.Pattern)
+		}
+		for _, rt := range rs.Gin() {
+			gin = append(gin, rt.Method+" "+rt.Pattern)
+		}
+		assertEqual(t, fmt.Sprint(chi), "[GET /users/{username} GET /users/{username}/followers POST /users/{username}/inbox GET /users/{username}/inbox POST /users/{username}/outbox GET /users/{username}/outbox POST /inbox]")
+		assertEqual(t, fmt.Sprint(gin), "[GET /users/:username GET /users/:username/followers POST /users/:username/inbox GET /users/:username/inbox POST /users/:username/outbox GET /users/:username/outbox POST /inbox]")
+		assertEqual(t, len(rs.Echo()), len(rs.Gin()))
+	})
+	t.Run("ServesActivityPubRequests", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "https://example.com/users/addison/inbox", nil)
+		r.Header.Set("Content-Type", "application/activity+json")
+		resp := httptest.NewRecorder()
+		rs.Chi()[2].Handler.ServeHTTP(resp, r)
+		assertEqual(t, actor.served, "PostInbox")
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("FallsBackForOtherRequests", func(t *testing.T) {
+		actor.served = ""
+		r := httptest.NewRequest(http.MethodGet, "https://example.com/users/addison", nil)
+		r.Header.Set("Accept", "text/html")
+		resp := httptest.NewRecorder()
+		rs.Chi()[0].Handler.ServeHTTP(resp, r)
+		assertEqual(t, actor.served, "")
+		assertEqual(t, resp.Body.String(), "web page")
+	})
+	t.Run("RespondsToErrors", func(t *testing.T) {
+		actor.err = errors.New("database is down")
+		defer func() { actor.err = nil }()
+		r := httptest.NewRequest(http.MethodGet, "https://example.com/users/addison/outbox", nil)
+		r.Header.Set("Accept", "application/activity+json")
+		resp := httptest.NewRecorder()
+		rs.Chi()[5].Handler.ServeHTTP(resp, r)
+		assertEqual(t, resp.Code, http.StatusInternalServerError)
+	})
+}
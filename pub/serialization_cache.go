@@ -0,0 +1,153 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// SerializationKey identifies one revision of the JSON bytes of an
+// ActivityStreams value.
+type SerializationKey struct {
+	// Id is the id of the value.
+	Id string
+	// Type is the type name of the value, so a Tombstone replacing an
+	// object is not served the bytes of the object.
+	Type string
+	// Version is the 'updated' time of the value, or empty if it has none.
+	Version string
+	// Served is whether the bytes are served in responses to GET requests,
+	// which have every 'bto' and 'bcc' removed, including those of embedded
+	// values.
+	Served bool
+}
+
+// serializationKey returns the key of the value, or false if the value has no
+// id and cannot be cached.
+func serializationKey(t vocab.Type, served bool) (SerializationKey, bool) {
+	id, err := GetId(t)
+	if err != nil || id == nil {
+		return SerializationKey{}, false
+	}
+	k := SerializationKey{Id: id.String(), Type: t.GetTypeName(), Served: served}
+	if u, ok := t.(updateder); ok {
+		if upd := u.GetActivityStreamsUpdated(); upd != nil && upd.IsXMLSchemaDateTime() {
+			k.Version = upd.Get().UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return k, true
+}
+
+// SerializationCache memoizes the JSON bytes of ActivityStreams values, so that
+// a value delivered in several batches, or served many times, is serialized
+// once per revision.
+//
+// Values are keyed by their id, type, and 'updated' time, so applications must
+// only use a SerializationCache if their values set a new 'updated' time
+// whenever they are modified, or if they remove the cached bytes of modified
+// values. The Updates of clients applied by the library set a new 'updated'
+// time.
+//
+// A CommonBehavior may implement SerializationCache to memoize the payloads it
+// delivers, and one may be given to NewCachingActivityStreamsHandler to
+// memoize the responses it serves.
+type SerializationCache interface {
+	// GetSerialized returns the bytes saved for the key, if any.
+	GetSerialized(c context.Context, k SerializationKey) (b []byte, ok bool)
+	// SetSerialized saves the bytes for the key. The bytes must not be
+	// modified afterwards.
+	SetSerialized(c context.Context, k SerializationKey, b []byte)
+}
+
+// marshal serializes the value into JSON bytes, using the cache if it is not
+// nil.
+//
+// The served bytes must have been stripped of their sensitive fields.
+func marshal(c context.Context, cache SerializationCache, t vocab.Type, served bool) ([]byte, error) {
+	k, cacheable := serializationKey(t, served)
+	cacheable = cacheable && cache != nil
+	if cacheable {
+		if b, ok := cache.GetSerialized(c, k); ok {
+			return b, nil
+		}
+	}
+	m, err := serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if cacheable {
+		cache.SetSerialized(c, k, b)
+	}
+	return b, nil
+}
+
+// MemorySerializationCache is a SerializationCache keeping up to a number of
+// serialized values in memory, evicting the least recently saved ones. It is
+// safe for concurrent use.
+type MemorySerializationCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[SerializationKey][]byte
+	order   []SerializationKey
+}
+
+// SerializationCache must be implemented by MemorySerializationCache.
+var _ SerializationCache = &MemorySerializationCache{}
+
+// NewMemorySerializationCache creates a MemorySerializationCache keeping up to
+// size serialized values.
+func NewMemorySerializationCache(size int) *MemorySerializationCache {
+	return &MemorySerializationCache{
+		size:    size,
+		entries: make(map[SerializationKey][]byte, size),
+	}
+}
+
+// GetSerialized returns the bytes saved for the key, if any.
+func (m *MemorySerializationCache) GetSerialized(c context.Context, k SerializationKey) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.entries[k]
+	return b, ok
+}
+
+// SetSerialized saves the bytes for the key, evicting the least recently saved
+// value if the cache is full.
+func (m *MemorySerializationCache) SetSerialized(c context.Context, k SerializationKey, b []byte) {
+	if m.size <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[k]; !ok {
+		if len(m.order) >= m.size {
+			delete(m.entries, m.order[0])
+			m.order = m.order[1:]
+		}
+		m.order = append(m.order, k)
+	}
+	m.entries[k] = b
+}
+
+// Forget removes every revision saved for the id, such as after the value is
+// modified without changing its 'updated' time.
+func (m *MemorySerializationCache) Forget(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	order := m.order[:0]
+	for _, k := range m.order {
+		if k.Id == id {
+			delete(m.entries, k)
+		} else {
+			order = append(order, k)
+		}
+	}
+	m.order = order
+}
@@ -0,0 +1,127 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMarshalCached(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	t.Run("ServesCachedBytesPerRevision", func(t *testing.T) {
+		cache := NewMemorySerializationCache(10)
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testNoteId1))
+		note.SetActivityStreamsId(id)
+		b, err := marshal(ctx, cache, note, false)
+		assertEqual(t, err, nil)
+		k, _ := serializationKey(note, false)
+		cache.SetSerialized(ctx, k, []byte("cached"))
+		b, err = marshal(ctx, cache, note, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), "cached")
+		// Not shared with the served form.
+		b, err = marshal(ctx, cache, note, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b) == "cached", false)
+		// A new revision is serialized again.
+		upd := streams.NewActivityStreamsUpdatedProperty()
+		upd.Set(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+		note.SetActivityStreamsUpdated(upd)
+		b, err = marshal(ctx, cache, note, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b) == "cached", false)
+	})
+	t.Run("DoesNotCacheValuesWithoutId", func(t *testing.T) {
+		cache := NewMemorySerializationCache(10)
+		_, err := marshal(ctx, cache, testMyNoteNoId, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(cache.entries), 0)
+	})
+}
+
+func TestCachingHandler(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	t.Run("ServesTombstoneOfCachedValue", func(t *testing.T) {
+		cache := NewMemorySerializationCache(10)
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testNoteId1))
+		note.SetActivityStreamsId(id)
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("before deletion")
+		note.SetActivityStreamsContent(content)
+		upd := streams.NewActivityStreamsUpdatedProperty()
+		upd.Set(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+		note.SetActivityStreamsUpdated(upd)
+		serve := func(v vocab.Type) *httptest.ResponseRecorder {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			db := NewMockDatabase(ctl)
+			clock := NewMockClock(ctl)
+			db.EXPECT().Lock(gomock.Any(), gomock.Any())
+			db.EXPECT().Get(gomock.Any(), gomock.Any()).Return(v, nil)
+			db.EXPECT().Unlock(gomock.Any(), gomock.Any())
+			clock.EXPECT().Now().Return(now())
+			authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+				return false, nil
+			}
+			h := NewCachingActivityStreamsHandler(authFn, db, clock, cache)
+			req := httptest.NewRequest("GET", testNoteId1, nil)
+			req.Header.Set(acceptHeader, activityStreamsMediaTypes[0])
+			rec := httptest.NewRecorder()
+			_, err := h(ctx, rec, req)
+			assertEqual(t, err, nil)
+			return rec
+		}
+		rec := serve(note)
+		assertEqual(t, rec.Code, http.StatusOK)
+		assertEqual(t, strings.Contains(rec.Body.String(), "before deletion"), true)
+		rec = serve(toTombstone(note, mustParse(testNoteId1), now()))
+		assertEqual(t, rec.Code, http.StatusGone)
+		assertEqual(t, strings.Contains(rec.Body.String(), "before deletion"), false)
+		assertEqual(t, strings.Contains(rec.Body.String(), "Tombstone"), true)
+	})
+}
+
+func TestMemorySerializationCache(t *testing.T) {
+	ctx := context.Background()
+	a := SerializationKey{Id: testNoteId1}
+	a2 := SerializationKey{Id: testNoteId1, Version: "2020-01-02T03:04:05Z"}
+	b := SerializationKey{Id: testNoteId2}
+	t.Run("EvictsLeastRecentlySaved", func(t *testing.T) {
+		cache := NewMemorySerializationCache(2)
+		cache.SetSerialized(ctx, a, []byte("a"))
+		cache.SetSerialized(ctx, a2, []byte("a2"))
+		cache.SetSerialized(ctx, b, []byte("b"))
+		_, ok := cache.GetSerialized(ctx, a)
+		assertEqual(t, ok, false)
+		v, ok := cache.GetSerialized(ctx, b)
+		assertEqual(t, ok, true)
+		assertEqual(t, string(v), "b")
+	})
+	t.Run("ForgetsEveryRevision", func(t *testing.T) {
+		cache := NewMemorySerializationCache(3)
+		cache.SetSerialized(ctx, a, []byte("a"))
+		cache.SetSerialized(ctx, a2, []byte("a2"))
+		cache.SetSerialized(ctx, b, []byte("b"))
+		cache.Forget(testNoteId1)
+		_, ok := cache.GetSerialized(ctx, a)
+		assertEqual(t, ok, false)
+		_, ok = cache.GetSerialized(ctx, a2)
+		assertEqual(t, ok, false)
+		_, ok = cache.GetSerialized(ctx, b)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(cache.order), 1)
+	})
+}
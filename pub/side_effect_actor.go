@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
+	"log"
 	"net/http"
 	"net/url"
 )
@@ -26,6 +27,8 @@ type sideEffectActor struct {
 	c2s    SocialProtocol
 	db     Database
 	clock  Clock
+	// iris interns the IRIs of recipients. It may be nil.
+	iris *IRIInterner
 }
 
 // PostInboxRequestBodyHook defers to the delegate.
@@ -83,7 +86,11 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 		if iter.IsIRI() {
 			iris = append(iris, iter.GetIRI())
 		} else if t := iter.GetType(); t != nil {
-			iris = append(iris, activity.GetActivityStreamsId().Get())
+			var id *url.URL
+			if id, err = GetId(t); err != nil {
+				return
+			}
+			iris = append(iris, id)
 		} else {
 			err = fmt.Errorf("actor at index %d is missing an id", i)
 			return
@@ -91,6 +98,12 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 	}
 	// Determine if the actor(s) sending this request are blocked.
 	var blocked bool
+	if blocked, err = a.isBlockedSender(c, iris); err != nil {
+		return
+	} else if blocked {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
 	if blocked, err = a.s2s.Blocked(c, iris); err != nil {
 		return
 	} else if blocked {
@@ -105,37 +118,86 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 // request, adding the activity to the actor's inbox, and triggering side
 // effects based on the activity's type.
 func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	// The application may log the data of the activity that is skipped.
+	collectParseWarnings(c, activity)
+	// Activities from actors that are gone are treated as if their account
+	// was deleted.
+	if a.isFromGoneActor(c, activity) {
+		return nil
+	}
+	// Interactions the policies of their objects do not permit are refused
+	// before being stored.
+	if rejected, err := a.enforceInteractionPolicies(c, inboxIRI, activity); err != nil {
+		return err
+	} else if rejected {
+		return ErrInteractionRejected
+	}
 	isNew, err := a.addToInboxIfNew(c, inboxIRI, activity)
 	if err != nil {
 		return err
 	}
 	if isNew {
-		wrapped, other, err := a.s2s.Callbacks(c)
-		if err != nil {
-			return err
-		}
-		// Populate side channels.
-		wrapped.db = a.db
-		wrapped.inboxIRI = inboxIRI
-		wrapped.newTransport = a.common.NewTransport
-		wrapped.deliver = a.Deliver
-		wrapped.addNewIds = a.AddNewIds
-		res, err := streams.NewTypeResolver(wrapped.callbacks(other)...)
-		if err != nil {
+		if err = a.inboxSideEffects(c, inboxIRI, activity); err != nil {
 			return err
 		}
-		if err = res.Resolve(c, activity); err != nil && !streams.IsUnmatchedErr(err) {
-			return err
-		} else if streams.IsUnmatchedErr(err) {
-			err = a.s2s.DefaultCallback(c, activity)
-			if err != nil {
-				return err
+		// The activity is accepted regardless of the hook failing.
+		if h, ok := a.s2s.(InboxAcceptedHook); ok {
+			if err = h.InboxAccepted(c, inboxIRI, activity); err != nil {
+				log.Printf("pub: inbox accepted hook failed for an activity in %s: %s", inboxIRI, err)
 			}
 		}
 	}
 	return nil
 }
 
+// ReplayInbox triggers the side effects of the activity again, without adding
+// it to the inbox. Collections the side effects add to are not given duplicate
+// items.
+func (a *sideEffectActor) ReplayInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	return a.inboxSideEffects(withReplay(c), inboxIRI, activity)
+}
+
+// inboxSideEffects triggers the side effects of the activity received in the
+// inbox, based on its type.
+func (a *sideEffectActor) inboxSideEffects(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	wrapped, other, err := a.inboxCallbacks(c, inboxIRI)
+	if err != nil {
+		return err
+	}
+	res, err := streams.NewTypeResolver(wrapped.callbacks(other)...)
+	if err != nil {
+		return err
+	}
+	if err = res.Resolve(c, activity); err != nil && !streams.IsUnmatchedErr(err) {
+		return err
+	} else if streams.IsUnmatchedErr(err) {
+		return a.s2s.DefaultCallback(c, activity)
+	}
+	return nil
+}
+
+// inboxCallbacks returns the callbacks of the FederatingProtocol, with the side
+// channels of the wrapped ones populated for the inbox.
+func (a *sideEffectActor) inboxCallbacks(c context.Context, inboxIRI *url.URL) (wrapped FederatingWrappedCallbacks, other []interface{}, err error) {
+	wrapped, other, err = a.s2s.Callbacks(c)
+	if err != nil {
+		return
+	}
+	// Populate side channels.
+	wrapped.db = a.db
+	wrapped.inboxIRI = inboxIRI
+	wrapped.newTransport = a.common.NewTransport
+	wrapped.deliver = a.Deliver
+	wrapped.addNewIds = a.AddNewIds
+	wrapped.bridgeCompatibility = a.bridgeCompatibility(c)
+	wrapped.tombstone = a.TombstoneDeleted(c)
+	wrapped.clock = a.clock
+	if p, ok := a.s2s.(HandshakeEmbeddingPolicy); ok {
+		wrapped.handshakeEmbedding = p
+	}
+	return
+}
+
 // InboxForwarding implements the 3-part inbox forwarding algorithm specified in
 // the ActivityPub specification. Does not modify the Activity, but may send
 // outbound requests as a side effect.
@@ -171,6 +233,16 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 	a.db.Unlock(c, id.Get())
 	// Unlock by this point and in every branch above.
 	//
+	// The application may veto forwarding the activity.
+	opts := a.inboxForwardingOptions(c)
+	if opts.Veto != nil {
+		if veto, err := opts.Veto(c, inboxIRI, activity); err != nil {
+			return err
+		} else if veto {
+			return nil
+		}
+	}
+	//
 	// 2. The values of 'to', 'cc', or 'audience' are Collections owned by
 	//    this server.
 	var r []*url.URL
@@ -225,6 +297,15 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 		}
 		a.db.Unlock(c, iri)
 		// Unlock by this point and in every branch above.
+		//
+		// Let the application exclude its collections from forwarding.
+		if rules, ok := a.s2s.(InboxForwardingRules); ok {
+			if forwards, err := rules.ForwardsToCollection(c, iri, activity); err != nil {
+				return err
+			} else if !forwards {
+				continue
+			}
+		}
 		myIRIs = append(myIRIs, iri)
 	}
 	// Finally, load our IRIs to determine if they are a Collection or
@@ -272,8 +353,12 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 	// 3. The values of 'inReplyTo', 'object', 'target', or 'tag' are owned
 	//    by this server. This is only a boolean trigger: As soon as we get
 	//    a hit that we own something, then we should do inbox forwarding.
-	maxDepth := a.s2s.MaxInboxForwardingRecursionDepth(c)
-	ownsValue, err := a.hasInboxForwardingValues(c, inboxIRI, activity, maxDepth, 0)
+	maxDepth := opts.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = a.s2s.MaxInboxForwardingRecursionDepth(c)
+	}
+	budget := newDereferenceBudget(opts.MaxDereferences)
+	ownsValue, err := a.hasInboxForwardingValues(c, inboxIRI, activity, maxDepth, 0, budget)
 	if err != nil {
 		return err
 	}
@@ -324,6 +409,25 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
 	// TODO: Determine this if c2s is nil
 	deliverable = true
+	// Objects the client referenced by IRI must exist.
+	if err = a.resolveObjectIRIs(c, outboxIRI, activity); err != nil {
+		return
+	}
+	// Complete the content of the objects before the side effects save
+	// them.
+	if r, ok := a.c2s.(SourceRenderer); ok {
+		if err = renderSources(c, r, activity); err != nil {
+			return
+		}
+	}
+	if f, ok := a.common.(PreviewFetcher); ok {
+		attachPreviews(c, f, activity)
+	}
+	if p, ok := a.c2s.(InteractionPolicyProvider); ok {
+		if err = applyInteractionPolicies(c, p, outboxIRI, activity); err != nil {
+			return
+		}
+	}
 	if a.c2s != nil {
 		var wrapped SocialWrappedCallbacks
 		var other []interface{}
@@ -356,7 +460,10 @@ func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outbo
 			deliverable = !undeliverable
 		}
 	}
-	err = a.addToOutbox(c, outboxIRI, activity)
+	if err = a.addToOutbox(c, outboxIRI, activity); err != nil {
+		return
+	}
+	err = a.addToPublicTimeline(c, outboxIRI, activity)
 	return
 }
 
@@ -426,19 +533,71 @@ func (a *sideEffectActor) WrapInCreate(c context.Context, obj vocab.Type, outbox
 // deliverToRecipients will take a prepared Activity and send it to specific
 // recipients on behalf of an actor.
 func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL, activity Activity, recipients []*url.URL) error {
-	m, err := serialize(activity)
+	cache, _ := a.common.(SerializationCache)
+	b, err := marshal(c, cache, activity, false)
 	if err != nil {
 		return err
 	}
-	b, err := json.Marshal(m)
+	tp, err := a.common.NewTransport(c, boxIRI, goFedUserAgent())
 	if err != nil {
 		return err
 	}
-	tp, err := a.common.NewTransport(c, boxIRI, goFedUserAgent())
+	groups, err := a.embeddingGroups(c, activity, b, recipients)
 	if err != nil {
 		return err
 	}
-	return tp.BatchDeliver(c, b, recipients)
+	store, hasStore := a.common.(DeliveryReportStore)
+	retries, hasRetries := a.common.(DeliveryRetries)
+	persister, hasPersister := a.db.(DeliveryPersister)
+	if !hasStore && !hasRetries && !hasPersister {
+		for _, g := range groups {
+			if gErr := tp.BatchDeliver(c, g.payload, g.recipients); gErr != nil && err == nil {
+				err = gErr
+			}
+		}
+		return err
+	}
+	var id *url.URL
+	if hasStore || hasPersister {
+		if id, err = GetId(activity); err != nil {
+			return err
+		}
+	}
+	// Journal the deliveries, if the application resumes them.
+	if hasPersister {
+		if err = beforeDeliveries(c, persister, id, boxIRI, recipients); err != nil {
+			return err
+		}
+	}
+	var outcomes []DeliveryOutcome
+	groupOutcomes := make([][]DeliveryOutcome, len(groups))
+	for i, g := range groups {
+		groupOutcomes[i] = deliverWithReport(c, tp, a.clock, g.payload, g.recipients)
+		outcomes = append(outcomes, groupOutcomes[i]...)
+	}
+	if hasPersister {
+		if err = afterDeliveries(c, persister, id, boxIRI, outcomes); err != nil {
+			return err
+		}
+	}
+	// Persist the outcome for each recipient, if the application keeps
+	// them.
+	if hasStore {
+		if err = store.SetDeliveryOutcomes(c, id, outcomes); err != nil {
+			return err
+		}
+	}
+	// Failed deliveries are not an error once they are scheduled to be
+	// retried, with the payload they failed to receive.
+	if hasRetries {
+		for i, g := range groups {
+			if err = retries.DeliveryRetrier(c).Schedule(c, boxIRI, g.payload, groupOutcomes[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return deliveryError(outcomes)
 }
 
 // addToOutbox adds the activity to the outbox and creates the activity in the
@@ -526,8 +685,9 @@ func (a *sideEffectActor) addToInboxIfNew(c context.Context, inboxIRI *url.URL,
 // href and the ones on properties applicable to inbox forwarding.
 //
 // Recursion may be limited by providing a 'maxDepth' greater than zero. A
-// value of zero or a negative number will result in infinite recursion.
-func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, maxDepth, currDepth int) (bool, error) {
+// value of zero or a negative number will result in infinite recursion. The
+// IRIs are dereferenced to recur into them until the budget is spent.
+func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, maxDepth, currDepth int, budget *dereferenceBudget) (bool, error) {
 	// Stop recurring if we are exceeding the maximum depth and the maximum
 	// is a positive number.
 	if maxDepth > 0 && currDepth >= maxDepth {
@@ -576,6 +736,9 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 	}
 	// Recur Preparation: Try fetching the IRIs so we can recur into them.
 	for _, iri := range iris {
+		if !budget.spend() {
+			break
+		}
 		// Dereferencing the IRI.
 		tport, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
 		if err != nil {
@@ -601,7 +764,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 	}
 	// Recur.
 	for _, nextVal := range types {
-		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+1); err != nil {
+		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+1, budget); err != nil {
 			return false, err
 		} else if has {
 			return true, nil
@@ -616,6 +779,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 //
 // Only call if both the social and federated protocol are supported.
 func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activity Activity) (r []*url.URL, err error) {
+	var recipients []*IRI
 	// Get inboxes of recipients
 	if to := activity.GetActivityStreamsTo(); to != nil {
 		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
@@ -624,7 +788,7 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 			if err != nil {
 				return
 			}
-			r = append(r, val)
+			recipients = append(recipients, a.iris.InternURL(val))
 		}
 	}
 	if bto := activity.GetActivityStreamsBto(); bto != nil {
@@ -634,7 +798,7 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 			if err != nil {
 				return
 			}
-			r = append(r, val)
+			recipients = append(recipients, a.iris.InternURL(val))
 		}
 	}
 	if cc := activity.GetActivityStreamsCc(); cc != nil {
@@ -644,7 +808,7 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 			if err != nil {
 				return
 			}
-			r = append(r, val)
+			recipients = append(recipients, a.iris.InternURL(val))
 		}
 	}
 	if bcc := activity.GetActivityStreamsBcc(); bcc != nil {
@@ -654,7 +818,7 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 			if err != nil {
 				return
 			}
-			r = append(r, val)
+			recipients = append(recipients, a.iris.InternURL(val))
 		}
 	}
 	if audience := activity.GetActivityStreamsAudience(); audience != nil {
@@ -664,9 +828,10 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 			if err != nil {
 				return
 			}
-			r = append(r, val)
+			recipients = append(recipients, a.iris.InternURL(val))
 		}
 	}
+	recipients = append(recipients, a.mentionedRecipients(c, activity, recipients)...)
 	// 1. When an object is being delivered to the originating actor's
 	//    followers, a server MAY reduce the number of receiving actors
 	//    delivered to by identifying all followers which share the same
@@ -675,16 +840,25 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 	// 2. If an object is addressed to the Public special collection, a
 	//    server MAY deliver that object to all known sharedInbox endpoints
 	//    on the network.
-	r = filterURLs(r, IsPublic)
+	r, err = iriURLs(filterIRIs(recipients, IsPublic))
+	if err != nil {
+		return nil, err
+	}
+	// Lists of actors defined by the application are delivered to their
+	// members, while the activity keeps addressing the list.
+	r, err = a.expandRecipientLists(c, outboxIRI, r)
+	if err != nil {
+		return nil, err
+	}
 	t, err := a.common.NewTransport(c, outboxIRI, goFedUserAgent())
 	if err != nil {
 		return nil, err
 	}
-	receiverActors, err := a.resolveInboxes(c, t, r, 0, a.s2s.MaxDeliveryRecursionDepth(c))
+	targets, err := a.resolveInboxes(c, t, r, 0, a.s2s.MaxDeliveryRecursionDepth(c))
 	if err != nil {
 		return nil, err
 	}
-	targets, err := getInboxes(receiverActors)
+	targets, err = a.filterBlockedInboxes(c, targets)
 	if err != nil {
 		return nil, err
 	}
@@ -718,14 +892,17 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 	if err != nil {
 		return nil, err
 	}
-	r = dedupeIRIs(targets, []*url.URL{ignore})
+	r, err = iriURLs(dedupeIRIs(a.iris.InternURLs(targets), []*IRI{a.iris.InternURL(ignore)}))
+	if err != nil {
+		return nil, err
+	}
 	stripHiddenRecipients(activity)
 	return r, nil
 }
 
-// resolveInboxes takes a list of Actor id URIs and returns them as concrete
-// instances of actorObject. It attempts to apply recursively when it encounters
-// a target that is a Collection or OrderedCollection.
+// resolveInboxes takes a list of Actor id URIs and returns the IRIs of their
+// inboxes. It attempts to apply recursively when it encounters a target that is
+// a Collection or OrderedCollection.
 //
 // If maxDepth is zero or negative, then recursion is infinitely applied.
 //
@@ -733,11 +910,23 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 // dereference the collection, WITH the user's credentials.
 //
 // Note that this also applies to CollectionPage and OrderedCollectionPage.
-func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*url.URL, depth, maxDepth int) (actors []vocab.Type, err error) {
+//
+// If the FederatingProtocol is an AudienceCache, the inboxes each collection
+// resolves to are cached for the collection's current members.
+func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*url.URL, depth, maxDepth int) (inboxes []*url.URL, err error) {
 	if maxDepth > 0 && depth >= maxDepth {
 		return
 	}
+	cache, _ := a.s2s.(AudienceCache)
+	compat := a.bridgeCompatibility(c)
 	for _, u := range r {
+		// Blocked recipients are skipped without being dereferenced.
+		var blocked bool
+		if blocked, err = a.isBlockedRecipient(c, u); err != nil {
+			return
+		} else if blocked {
+			continue
+		}
 		var act vocab.Type
 		var more []*url.URL
 		// TODO: Determine if more logic is needed here for inaccessible
@@ -746,15 +935,41 @@ func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*ur
 		if err != nil {
 			return
 		}
-		var recurActors []vocab.Type
-		recurActors, err = a.resolveInboxes(c, t, more, depth+1, maxDepth)
+		if act != nil {
+			var inbox *url.URL
+			inbox, err = getDeliveryInbox(act, compat)
+			if err != nil {
+				return
+			}
+			inboxes = append(inboxes, inbox)
+			continue
+		}
+		// Only collections are cached, since they are the ones with
+		// members to dereference.
+		var version string
+		if cache != nil {
+			version = collectionVersion(more)
+			var cached []*url.URL
+			var found bool
+			cached, found, err = cache.GetAudience(c, u, version)
+			if err != nil {
+				return
+			} else if found {
+				inboxes = append(inboxes, cached...)
+				continue
+			}
+		}
+		var recurInboxes []*url.URL
+		recurInboxes, err = a.resolveInboxes(c, t, more, depth+1, maxDepth)
 		if err != nil {
 			return
 		}
-		if act != nil {
-			actors = append(actors, act)
+		if cache != nil {
+			if err = cache.SetAudience(c, u, version, recurInboxes); err != nil {
+				return
+			}
 		}
-		actors = append(actors, recurActors...)
+		inboxes = append(inboxes, recurInboxes...)
 	}
 	return
 }
@@ -344,6 +344,22 @@ func TestPostInbox(t *testing.T) {
 }
 
 // TestInboxForwarding ensures that the inbox forwarding logic is correct.
+// forwardingRulesProtocol is a FederatingProtocol whose InboxForwardingRules
+// only forward to the allowed collections.
+type forwardingRulesProtocol struct {
+	*MockFederatingProtocol
+	allowed []*url.URL
+}
+
+func (f *forwardingRulesProtocol) ForwardsToCollection(c context.Context, collectionIRI *url.URL, a Activity) (bool, error) {
+	for _, iri := range f.allowed {
+		if iri.String() == collectionIRI.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func TestInboxForwarding(t *testing.T) {
 	ctx := context.Background()
 	setupFn := func(ctl *gomock.Controller) (c *MockCommonBehavior, fp *MockFederatingProtocol, sp *MockSocialProtocol, db *MockDatabase, cl *MockClock, a DelegateActor) {
@@ -642,6 +658,67 @@ func TestInboxForwarding(t *testing.T) {
 		// Verify
 		assertEqual(t, err, nil)
 	})
+	t.Run("ForwardsOnlyToCollectionsAllowedByRules", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cm, fp, _, db, _, a := setupFn(ctl)
+		a.(*sideEffectActor).s2s = &forwardingRulesProtocol{
+			MockFederatingProtocol: fp,
+			allowed:                []*url.URL{mustParse(testAudienceIRI)},
+		}
+		input := mustAddTagIds(
+			mustAddAudienceIds(testListen))
+		tPort := NewMockTransport(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().Create(ctx, input).Return(nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
+			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
+			// hasInboxForwardingValues
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI)),
+			// after hasInboxForwardingValues
+			fp.EXPECT().FilterForwarding(
+				ctx,
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+				},
+				input,
+			).Return(
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+				},
+				nil,
+			),
+			// deliverToRecipients
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			tPort.EXPECT().BatchDeliver(
+				ctx,
+				mustSerializeToBytes(input),
+				[]*url.URL{
+					mustParse(testFederatedActorIRI3),
+					mustParse(testFederatedActorIRI4),
+				},
+			),
+			// Deferred
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+		)
+		// Run
+		err := a.InboxForwarding(ctx, mustParse(testMyInboxIRI), input)
+		// Verify
+		assertEqual(t, err, nil)
+	})
 	t.Run("ForwardsToRecipientsIfChainIsNested", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
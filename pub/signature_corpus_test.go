@@ -0,0 +1,176 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// signatureFixture is a signed request of the corpus in
+// testdata/signatures, in the form a peer implementation sends it.
+type signatureFixture struct {
+	Description string            `json:"description"`
+	Now         time.Time         `json:"now"`
+	Method      string            `json:"method"`
+	URL         string            `json:"url"`
+	Headers     map[string]string `json:"headers"`
+	Body        string            `json:"body"`
+	KeyDocument json.RawMessage   `json:"keyDocument"`
+	Owner       string            `json:"owner"`
+}
+
+// request returns the fixture as a request received by a server.
+func (f signatureFixture) request() *http.Request {
+	r := httptest.NewRequest(f.Method, f.URL, strings.NewReader(f.Body))
+	for k, v := range f.Headers {
+		if k == "Host" {
+			// Servers move the Host header to the request's Host.
+			r.Host = v
+			continue
+		}
+		r.Header.Set(k, v)
+	}
+	return r
+}
+
+func TestSignatureCorpus(t *testing.T) {
+	files, err := filepath.Glob("testdata/signatures/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no signature fixtures found")
+	}
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var f signatureFixture
+		if err = json.Unmarshal(b, &f); err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			tp := NewMockTransport(ctl)
+			tp.EXPECT().Dereference(gomock.Any(), gomock.Any()).Return([]byte(f.KeyDocument), nil).AnyTimes()
+			v := NewSignatureVerifier(tp, NewFakeClock(f.Now), time.Hour)
+			actor, err := v.Verify(context.Background(), f.request())
+			if err != nil {
+				t.Fatalf("%s: %s", f.Description, err)
+			}
+			assertEqual(t, actor.String(), f.Owner)
+			// A tampered request must not verify.
+			r := f.request()
+			r.URL.Path += "/other"
+			_, err = v.Verify(context.Background(), r)
+			assertNotEqual(t, err, nil)
+		})
+	}
+}
+
+// peerSignatureRules are the documented requirements of peers verifying the
+// HTTP Signatures of the requests they receive.
+var peerSignatureRules = []struct {
+	name string
+	// get and post are the headers a signature must cover.
+	get, post []string
+	// maxSkew is how far the Date may be from the peer's time.
+	maxSkew time.Duration
+}{
+	{"Mastodon", []string{"(request-target)", "host", "date"}, []string{"(request-target)", "host", "date", "digest"}, 12 * time.Hour},
+	{"GoToSocial", []string{"(request-target)", "host", "date"}, []string{"(request-target)", "host", "date", "digest"}, 30 * time.Second},
+	{"Pleroma", []string{"(request-target)", "date"}, []string{"(request-target)", "date", "digest"}, time.Hour},
+	{"Misskey", []string{"(request-target)", "date"}, []string{"(request-target)", "date", "digest"}, time.Hour},
+}
+
+// referenceVerify verifies the signature of the request as peers do, without
+// the code under test: the signing string is built from the covered headers,
+// the (request-target) being the lowercased method and the path and query.
+func referenceVerify(r *http.Request, pub *rsa.PublicKey) (covered []string, err error) {
+	params := map[string]string{}
+	for _, p := range strings.Split(r.Header.Get("Signature"), ",") {
+		kv := strings.SplitN(p, "=", 2)
+		params[kv[0]] = strings.Trim(kv[1], `"`)
+	}
+	covered = strings.Fields(params["headers"])
+	var lines []string
+	for _, h := range covered {
+		if h == "(request-target)" {
+			lines = append(lines, h+": "+strings.ToLower(r.Method)+" "+r.URL.RequestURI())
+		} else {
+			lines = append(lines, h+": "+r.Header.Get(h))
+		}
+	}
+	sig, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return covered, rsa.VerifyPKCS1v15(pub, crypto.SHA256, sum[:], sig)
+}
+
+func TestSignaturesVerifyWithPeers(t *testing.T) {
+	now := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sent []*http.Request
+	client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		sent = append(sent, req)
+		return newResponse(http.StatusOK), nil
+	})
+	tp := NewHttpSigTransport(client, "test", NewFakeClock(now),
+		NewRSASHA256Signer("(request-target)", "host", "date", "accept"),
+		NewRSASHA256Signer("(request-target)", "host", "date", "digest"),
+		testPersonIRI+"#main-key", privKey)
+	_, err = tp.Dereference(context.Background(), mustParse(testFederatedActorIRI+"?page=true"))
+	assertEqual(t, err, nil)
+	err = tp.Deliver(context.Background(), []byte(`{"type":"Create"}`), mustParse(testFederatedActorIRI+"/inbox"))
+	assertEqual(t, err, nil)
+	assertEqual(t, len(sent), 2)
+	for _, rules := range peerSignatureRules {
+		t.Run(rules.name, func(t *testing.T) {
+			for _, r := range sent {
+				covered, err := referenceVerify(r, &privKey.PublicKey)
+				if err != nil {
+					t.Fatalf("%s %s does not verify: %s", r.Method, r.URL, err)
+				}
+				required := rules.get
+				if r.Method == http.MethodPost {
+					required = rules.post
+				}
+				for _, h := range required {
+					if !containsFold(covered, h) {
+						t.Errorf("%s %s does not sign %s", r.Method, r.URL, h)
+					}
+				}
+				date, err := http.ParseTime(r.Header.Get("Date"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if d := now.Sub(date); d > rules.maxSkew || d < -rules.maxSkew {
+					t.Errorf("%s %s has a skewed Date", r.Method, r.URL)
+				}
+				if r.Method == http.MethodPost && r.Header.Get("Digest") != digestHeaderValues([]byte(`{"type":"Create"}`), defaultDigestAlgorithms) {
+					t.Errorf("POST %s has a wrong Digest", r.URL)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package pub
+
+import (
+	"crypto"
+	"fmt"
+	"strings"
+
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// SignatureAlgorithmRSASHA256 is the rsa-sha256 algorithm of HTTP
+	// Signatures, which most peers verify.
+	SignatureAlgorithmRSASHA256 = "rsa-sha256"
+	// SignatureAlgorithmHS2019 is the hs2019 algorithm of HTTP Signatures,
+	// which leaves the algorithm to the key. Signatures with RSA keys are
+	// made as rsa-sha256 ones, as peers verify them.
+	SignatureAlgorithmHS2019 = "hs2019"
+)
+
+var (
+	// defaultGetSignedHeaders are the headers signed in GET requests by a
+	// SignatureScheme without its own.
+	defaultGetSignedHeaders = []string{requestTarget, "host", "date"}
+	// defaultPostSignedHeaders are the headers signed in POST requests by a
+	// SignatureScheme without its own.
+	defaultPostSignedHeaders = []string{requestTarget, "host", "date", "digest"}
+)
+
+// DefaultSignatureSchemes are rsa-sha256 signatures, then hs2019 ones for the
+// peers rejecting them, of the default headers.
+var DefaultSignatureSchemes = []SignatureScheme{
+	{Algorithm: SignatureAlgorithmRSASHA256},
+	{Algorithm: SignatureAlgorithmHS2019},
+}
+
+// SignatureScheme is an algorithm of HTTP Signatures and the headers they
+// cover, which make a SignerConfig.
+type SignatureScheme struct {
+	// Algorithm is the algorithm of the signatures, such as
+	// SignatureAlgorithmRSASHA256, SignatureAlgorithmHS2019, or any other
+	// httpsig.Algorithm.
+	Algorithm string
+	// GetHeaders are the headers signed in GET requests. Empty is the
+	// (request-target), Host and Date headers. They must not include the
+	// Digest header.
+	GetHeaders []string
+	// PostHeaders are the headers signed in POST requests. Empty is the
+	// (request-target), Host, Date and Digest headers.
+	PostHeaders []string
+}
+
+// headers returns the headers signed in GET and POST requests.
+func (s SignatureScheme) headers() (get, post []string) {
+	get, post = s.GetHeaders, s.PostHeaders
+	if len(get) == 0 {
+		get = defaultGetSignedHeaders
+	}
+	if len(post) == 0 {
+		post = defaultPostSignedHeaders
+	}
+	return
+}
+
+// name returns the name of the SignerConfig of the scheme, which is its
+// algorithm followed by the headers of its POST requests.
+func (s SignatureScheme) name() string {
+	_, post := s.headers()
+	return strings.ToLower(s.Algorithm) + " " + strings.ToLower(strings.Join(post, " "))
+}
+
+// SignerConfig returns the SignerConfig making the signatures of the scheme.
+func (s SignatureScheme) SignerConfig() (SignerConfig, error) {
+	get, post := s.headers()
+	if containsFold(get, digestHeader) {
+		return SignerConfig{}, fmt.Errorf("signature scheme %q signs the %s header of GET requests", s.name(), digestHeader)
+	}
+	config := SignerConfig{Name: s.name()}
+	switch strings.ToLower(s.Algorithm) {
+	case SignatureAlgorithmRSASHA256:
+		config.GetSigner, config.PostSigner = NewRSASHA256Signer(get...), NewRSASHA256Signer(post...)
+	case SignatureAlgorithmHS2019:
+		config.GetSigner, config.PostSigner = NewHS2019Signer(get...), NewHS2019Signer(post...)
+	default:
+		// NewSigner falls back to its default algorithm when it does
+		// not support the one preferred.
+		alg := httpsig.Algorithm(s.Algorithm)
+		algs := []httpsig.Algorithm{alg}
+		var chosen httpsig.Algorithm
+		var err error
+		if config.GetSigner, chosen, err = httpsig.NewSigner(algs, get, httpsig.Signature); err != nil {
+			return SignerConfig{}, err
+		} else if chosen != alg {
+			return SignerConfig{}, fmt.Errorf("unsupported HTTP Signature algorithm %q", s.Algorithm)
+		}
+		if config.PostSigner, _, err = httpsig.NewSigner(algs, post, httpsig.Signature); err != nil {
+			return SignerConfig{}, err
+		}
+	}
+	return config, nil
+}
+
+// NewHttpSigTransportWithSchemes is like NewHttpSigTransport, but signs
+// requests with the SignatureSchemes in order of preference, such as
+// DefaultSignatureSchemes. A request a peer rejects with 401 Unauthorized is
+// sent again signed with the next scheme, and the scheme the peer accepted is
+// remembered in the store, as with SetSignerConfigs.
+func NewHttpSigTransportWithSchemes(
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	pubKeyId string,
+	privKey crypto.PrivateKey,
+	store SignerPreferenceStore,
+	schemes ...SignatureScheme) (*HttpSigTransport, error) {
+	if len(schemes) == 0 {
+		return nil, fmt.Errorf("at least one signature scheme is required")
+	}
+	configs := make([]SignerConfig, len(schemes))
+	for i, s := range schemes {
+		var err error
+		if configs[i], err = s.SignerConfig(); err != nil {
+			return nil, err
+		}
+	}
+	h := NewHttpSigTransport(client, appAgent, clock, configs[0].GetSigner, configs[0].PostSigner, pubKeyId, privKey)
+	if err := h.SetSignerConfigs(store, configs...); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
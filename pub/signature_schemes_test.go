@@ -0,0 +1,67 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSignatureSchemes(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("FallsBackToHS2019", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		var algorithms []string
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			p, err := parseSignature(req.Header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = verifySignature(req, p, privKey.Public()); err != nil {
+				t.Fatal(err)
+			}
+			algorithms = append(algorithms, p.algorithm)
+			if p.algorithm != SignatureAlgorithmHS2019 {
+				return newResponse(http.StatusUnauthorized), nil
+			}
+			return newResponse(http.StatusAccepted), nil
+		})
+		tp, err := NewHttpSigTransportWithSchemes(client, "test", clock, testPersonIRI+"#main-key", privKey, nil, DefaultSignatureSchemes...)
+		assertEqual(t, err, nil)
+		for i := 0; i < 2; i++ {
+			err = tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+			assertEqual(t, err, nil)
+		}
+		assertEqual(t, strings.Join(algorithms, " "), "rsa-sha256 hs2019 hs2019")
+	})
+	t.Run("SignsConfiguredHeaders", func(t *testing.T) {
+		config, err := SignatureScheme{
+			Algorithm:   SignatureAlgorithmHS2019,
+			PostHeaders: []string{"(request-target)", "date", "digest"},
+		}.SignerConfig()
+		assertEqual(t, err, nil)
+		assertEqual(t, config.Name, "hs2019 (request-target) date digest")
+	})
+	t.Run("RejectsDigestInGet", func(t *testing.T) {
+		_, err := SignatureScheme{
+			Algorithm:  SignatureAlgorithmRSASHA256,
+			GetHeaders: []string{"(request-target)", "digest"},
+		}.SignerConfig()
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsUnknownAlgorithm", func(t *testing.T) {
+		_, err := SignatureScheme{Algorithm: "rot13"}.SignerConfig()
+		assertNotEqual(t, err, nil)
+	})
+}
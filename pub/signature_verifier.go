@@ -0,0 +1,318 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultPublicKeyTTL is how long a SignatureVerifier caches the public
+	// keys it fetched.
+	DefaultPublicKeyTTL = time.Hour
+	// DefaultSignatureMaxSkew is how far the Date of a signed request may be
+	// from the current time, which is the window Mastodon accepts.
+	DefaultSignatureMaxSkew = 12 * time.Hour
+)
+
+// signatureKey is a public key cached by a SignatureVerifier.
+type signatureKey struct {
+	key     crypto.PublicKey
+	owner   *url.URL
+	expires time.Time
+}
+
+// SignatureVerifier verifies the HTTP Signatures of the requests peers POST to
+// inboxes, for the FederatingProtocol's AuthenticatePostInbox. It fetches the
+// public keys of the signing actors, and caches them. It is safe for concurrent
+// use.
+//
+// For example:
+//
+//	func (f *myFederating) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+//	    actor, err := f.verifier.Verify(c, r)
+//	    if err != nil {
+//	        w.WriteHeader(http.StatusUnauthorized)
+//	        return false, nil
+//	    }
+//	    // Keep the actor for authorization.
+//	    return true, nil
+//	}
+type SignatureVerifier struct {
+	mu      sync.Mutex
+	t       Transport
+	clock   Clock
+	ttl     time.Duration
+	maxSkew time.Duration
+	maxBody int64
+	keys    map[string]signatureKey
+}
+
+// NewSignatureVerifier creates a SignatureVerifier fetching the public keys with
+// the Transport, and caching them for the ttl.
+func NewSignatureVerifier(t Transport, clock Clock, ttl time.Duration) *SignatureVerifier {
+	return &SignatureVerifier{
+		t:       t,
+		clock:   clock,
+		ttl:     ttl,
+		maxSkew: DefaultSignatureMaxSkew,
+		maxBody: DefaultMaxInboxBodyBytes,
+		keys:    make(map[string]signatureKey),
+	}
+}
+
+// SetMaxSkew sets how far the Date of a signed request, and the creation time of
+// its signature, may be from the current time. Zero or negative durations do not
+// check them.
+func (v *SignatureVerifier) SetMaxSkew(d time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.maxSkew = d
+}
+
+// SetMaxBodyBytes sets the size of the largest request body whose Digest is
+// verified, as received. Larger requests fail with ErrRequestBodyTooLarge. Zero
+// or less means unlimited. The default is DefaultMaxInboxBodyBytes.
+func (v *SignatureVerifier) SetMaxBodyBytes(n int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.maxBody = n
+}
+
+// Verify verifies the HTTP Signature of the request, and the Digest of its body
+// if it has one, and returns the IRI of the actor owning the signing key.
+//
+// The signature must cover the (request-target) or the @request-target, the Host
+// header or the @authority, and the Date header or the (created), so that it
+// cannot be replayed to other targets or at other times.
+//
+// Requests with a body must sign their Digest header. It may hold digests of
+// several algorithms, each of which must match if it is SHA-256 or SHA-512, and
+// at least one of which must be. If the signature does not verify with a
+// cached key, the key is fetched again, in case it was rotated.
+//
+// The Digest of a compressed body may be of the bytes received or of the
+// decompressed bytes, as peers differ. Bodies sent chunked are read whole
+// before their Digest is computed.
+func (v *SignatureVerifier) Verify(c context.Context, r *http.Request) (*url.URL, error) {
+	p, err := parseSignature(r.Header)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCoverage(p.headers); err != nil {
+		return nil, err
+	}
+	if err = v.verifyDate(r.Header, p); err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	maxBody := v.maxBody
+	v.mu.Unlock()
+	if err = verifyDigest(r, p.headers, maxBody); err != nil {
+		return nil, err
+	}
+	keyId, err := url.Parse(p.keyId)
+	if err != nil {
+		return nil, err
+	}
+	k, cached, err := v.key(c, keyId)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifySignature(r, p, k.key); err != nil && cached {
+		v.Forget(keyId)
+		if k, _, err = v.key(c, keyId); err != nil {
+			return nil, err
+		}
+		err = verifySignature(r, p, k.key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return k.owner, nil
+}
+
+// Forget removes the cached public key.
+func (v *SignatureVerifier) Forget(keyId *url.URL) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.keys, keyId.String())
+}
+
+// checkCoverage ensures the signature covers the target, the host, and the
+// time of the request.
+func checkCoverage(signed []string) error {
+	for _, alternatives := range [][]string{
+		{requestTarget, "@request-target"},
+		{"host", "@authority"},
+		{dateHeader, createdTarget},
+	} {
+		if !containsFold(signed, alternatives[0]) && !containsFold(signed, alternatives[1]) {
+			return fmt.Errorf("signature covers neither the %s nor the %s", alternatives[0], alternatives[1])
+		}
+	}
+	return nil
+}
+
+// verifyDate verifies the Date header and the created time of the signature are
+// recent, if they are signed.
+func (v *SignatureVerifier) verifyDate(h http.Header, p signatureParams) error {
+	v.mu.Lock()
+	maxSkew := v.maxSkew
+	v.mu.Unlock()
+	if maxSkew <= 0 {
+		return nil
+	}
+	if containsFold(p.headers, dateHeader) {
+		date, err := http.ParseTime(h.Get(dateHeader))
+		if err != nil {
+			return err
+		}
+		if !v.recent(date, maxSkew) {
+			return fmt.Errorf("signed request date %s is outside of the accepted window", h.Get(dateHeader))
+		}
+	}
+	if containsFold(p.headers, createdTarget) {
+		created, err := strconv.ParseInt(p.created, 10, 64)
+		if err != nil {
+			return fmt.Errorf("malformed HTTP Signature created parameter %q", p.created)
+		}
+		if !v.recent(time.Unix(created, 0), maxSkew) {
+			return fmt.Errorf("signature created at %s is outside of the accepted window", time.Unix(created, 0).UTC())
+		}
+	}
+	return nil
+}
+
+// recent determines whether the time is at most maxSkew away from now.
+func (v *SignatureVerifier) recent(t time.Time, maxSkew time.Duration) bool {
+	skew := v.clock.Now().Sub(t)
+	return skew <= maxSkew && skew >= -maxSkew
+}
+
+// key returns the public key, from the cache if it was cached.
+func (v *SignatureVerifier) key(c context.Context, keyId *url.URL) (k signatureKey, cached bool, err error) {
+	now := v.clock.Now()
+	v.mu.Lock()
+	k, cached = v.keys[keyId.String()]
+	v.mu.Unlock()
+	if cached && now.Before(k.expires) {
+		return k, true, nil
+	}
+	b, err := v.t.Dereference(c, keyId)
+	if err != nil {
+		return k, false, err
+	}
+	if k.key, k.owner, err = parsePublicKey(b, keyId); err != nil {
+		return k, false, err
+	}
+	k.expires = now.Add(v.ttl)
+	v.mu.Lock()
+	v.keys[keyId.String()] = k
+	v.mu.Unlock()
+	return k, false, nil
+}
+
+// publicKeyJSON is a public key of the Security vocabulary.
+type publicKeyJSON struct {
+	Id           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// parsePublicKey parses the public key of the id, and its owner, from either a
+// key document or an actor document with a publicKey property.
+func parsePublicKey(b []byte, keyId *url.URL) (crypto.PublicKey, *url.URL, error) {
+	var doc struct {
+		publicKeyJSON
+		PublicKey json.RawMessage `json:"publicKey"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, nil, err
+	}
+	var pk publicKeyJSON
+	if len(doc.PublicKeyPem) > 0 {
+		pk = doc.publicKeyJSON
+	} else if len(doc.PublicKey) > 0 {
+		var keys []publicKeyJSON
+		if err := json.Unmarshal(doc.PublicKey, &keys); err != nil {
+			keys = make([]publicKeyJSON, 1)
+			if err = json.Unmarshal(doc.PublicKey, &keys[0]); err != nil {
+				return nil, nil, err
+			}
+		}
+		for _, k := range keys {
+			if k.Id == keyId.String() {
+				pk = k
+				if len(pk.Owner) == 0 {
+					pk.Owner = doc.Id
+				}
+				break
+			}
+		}
+	}
+	if len(pk.PublicKeyPem) == 0 {
+		return nil, nil, fmt.Errorf("no public key %s found", keyId)
+	} else if pk.Id != keyId.String() {
+		return nil, nil, fmt.Errorf("fetched public key %q instead of %s", pk.Id, keyId)
+	}
+	owner, err := url.Parse(pk.Owner)
+	if err != nil {
+		return nil, nil, err
+	} else if !owner.IsAbs() || owner.Host != keyId.Host {
+		return nil, nil, fmt.Errorf("public key %s has no owner on its host: %q", keyId, pk.Owner)
+	}
+	key, err := DecodePublicKeyPEM([]byte(pk.PublicKeyPem))
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, owner, nil
+}
+
+// verifyDigest verifies the Digest header matches the request's body, and is
+// signed, if the request has a body. The body, up to max bytes, remains
+// readable as received.
+func verifyDigest(r *http.Request, signed []string, max int64) error {
+	b, err := bufferBody(r, max)
+	if err != nil {
+		return err
+	} else if len(b) == 0 {
+		return nil
+	}
+	if !containsFold(signed, digestHeader) {
+		return fmt.Errorf("signature does not cover the %s header", digestHeader)
+	}
+	header := r.Header.Get(digestHeader)
+	matches, supported := checkDigests(header, b)
+	if !supported {
+		return fmt.Errorf("%s header has no supported digest", digestHeader)
+	} else if matches {
+		return nil
+	}
+	// Some peers digest the content of compressed bodies.
+	if enc := r.Header.Get(contentEncodingHeader); len(enc) > 0 {
+		if decoded, err := decodeBody(b, enc, max); err == nil {
+			if matches, _ = checkDigests(header, decoded); matches {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s header does not match the body", digestHeader)
+}
+
+// containsFold determines whether the strings contain s, ignoring case.
+func containsFold(strs []string, s string) bool {
+	for _, str := range strs {
+		if strings.EqualFold(str, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSignatureVerifier(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	keyId := testFederatedActorIRI + "#main-key"
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actorJSON := func(k *rsa.PrivateKey) []byte {
+		pem, err := EncodePublicKeyPEM(k.Public())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []byte(fmt.Sprintf(`{"id":%q,"type":"Person","publicKey":{"id":%q,"owner":%q,"publicKeyPem":%q}}`,
+			testFederatedActorIRI, keyId, testFederatedActorIRI, pem))
+	}
+	// newRequest returns a request to the inbox as received by a server,
+	// signed with the key.
+	newRequest := func(k *rsa.PrivateKey, body []byte, date time.Time) *http.Request {
+		r := httptest.NewRequest("POST", testMyInboxIRI, bytes.NewReader(body))
+		r.Header.Set("Date", date.Format(http.TimeFormat))
+		r.Header.Set("Digest", digestHeaderValues(body, defaultDigestAlgorithms))
+		signer := NewRSASHA256Signer("(request-target)", "date", "host", "digest")
+		if err := signer.SignRequest(k, keyId, r); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	// newGetRequest returns a GET request to the inbox signed with the key,
+	// covering the headers and created at the time.
+	newGetRequest := func(created time.Time, headers ...string) *http.Request {
+		r := httptest.NewRequest("GET", testMyInboxIRI, nil)
+		r.Header.Set("Date", now.Format(http.TimeFormat))
+		c := strconv.FormatInt(created.Unix(), 10)
+		str, err := signingStringForm(r, headers, c, RequestTargetWithQuery)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := sha256.Sum256([]byte(str))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, privKey, crypto.SHA256, h[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Signature", fmt.Sprintf(`keyId=%q,algorithm="hs2019",created=%s,headers=%q,signature=%q`,
+			keyId, c, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig)))
+		return r
+	}
+	setupFn := func(ctl *gomock.Controller) (tp *MockTransport, v *SignatureVerifier) {
+		tp = NewMockTransport(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now).AnyTimes()
+		v = NewSignatureVerifier(tp, cl, time.Hour)
+		return
+	}
+	t.Run("VerifiesAndCachesKey", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		tp.EXPECT().Dereference(ctx, mustParse(keyId)).Return(actorJSON(privKey), nil)
+		for i := 0; i < 2; i++ {
+			actor, err := v.Verify(ctx, newRequest(privKey, []byte(`{"type":"Create"}`), now))
+			assertEqual(t, err, nil)
+			assertEqual(t, actor.String(), testFederatedActorIRI)
+		}
+	})
+	t.Run("RefetchesRotatedKey", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		gomock.InOrder(
+			tp.EXPECT().Dereference(ctx, mustParse(keyId)).Return(actorJSON(privKey), nil),
+			tp.EXPECT().Dereference(ctx, mustParse(keyId)).Return(actorJSON(otherKey), nil),
+		)
+		_, err := v.Verify(ctx, newRequest(privKey, []byte("{}"), now))
+		assertEqual(t, err, nil)
+		actor, err := v.Verify(ctx, newRequest(otherKey, []byte("{}"), now))
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsWrongKey", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		tp.EXPECT().Dereference(ctx, mustParse(keyId)).Return(actorJSON(privKey), nil)
+		_, err := v.Verify(ctx, newRequest(otherKey, []byte("{}"), now))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsTamperedBody", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		r := newRequest(privKey, []byte(`{"type":"Create"}`), now)
+		r.Body = httptest.NewRequest("POST", testMyInboxIRI, bytes.NewReader([]byte(`{"type":"Delete"}`))).Body
+		_, err := v.Verify(ctx, r)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsStaleDate", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		_, err := v.Verify(ctx, newRequest(privKey, []byte("{}"), now.Add(-DefaultSignatureMaxSkew-time.Minute)))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("VerifiesCreated", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		tp.EXPECT().Dereference(ctx, mustParse(keyId)).Return(actorJSON(privKey), nil)
+		actor, err := v.Verify(ctx, newGetRequest(now, "(request-target)", "host", "(created)"))
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsStaleCreated", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		_, err := v.Verify(ctx, newGetRequest(now.Add(-DefaultSignatureMaxSkew-time.Minute), "(request-target)", "host", "(created)"))
+		assertNotEqual(t, err, nil)
+	})
+	for name, headers := range map[string][]string{
+		"RejectsUncoveredRequestTarget": {"host", "date"},
+		"RejectsUncoveredHost":          {"(request-target)", "date"},
+		"RejectsUncoveredTime":          {"(request-target)", "host"},
+		"RejectsDefaultHeaders":         nil,
+	} {
+		headers := headers
+		t.Run(name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			_, v := setupFn(ctl)
+			r := newGetRequest(now, headers...)
+			if headers == nil {
+				// Without a headers parameter, only the Date is covered.
+				r = newGetRequest(now, "date")
+				r.Header.Set("Signature", strings.Replace(r.Header.Get("Signature"), `headers="date",`, "", 1))
+			}
+			_, err := v.Verify(ctx, r)
+			assertNotEqual(t, err, nil)
+		})
+	}
+	t.Run("RejectsUnsigned", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		_, err := v.Verify(ctx, httptest.NewRequest("POST", testMyInboxIRI, bytes.NewReader([]byte("{}"))))
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestParsePublicKey(t *testing.T) {
+	keyId := mustParse("https://other.example.com/keys/1")
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pem, err := EncodePublicKeyPEM(privKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("KeyDocument", func(t *testing.T) {
+		_, owner, err := parsePublicKey([]byte(fmt.Sprintf(`{"id":%q,"owner":%q,"publicKeyPem":%q}`, keyId, testFederatedActorIRI, pem)), keyId)
+		assertEqual(t, err, nil)
+		assertEqual(t, owner.String(), testFederatedActorIRI)
+	})
+	t.Run("KeyArray", func(t *testing.T) {
+		_, owner, err := parsePublicKey([]byte(fmt.Sprintf(`{"id":%q,"publicKey":[{"id":"https://other.example.com/keys/0","publicKeyPem":""},{"id":%q,"publicKeyPem":%q}]}`, testFederatedActorIRI, keyId, pem)), keyId)
+		assertEqual(t, err, nil)
+		assertEqual(t, owner.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsOwnerOnOtherHost", func(t *testing.T) {
+		_, _, err := parsePublicKey([]byte(fmt.Sprintf(`{"id":%q,"owner":"https://evil.example.com/mallory","publicKeyPem":%q}`, keyId, pem)), keyId)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsMissingKey", func(t *testing.T) {
+		_, _, err := parsePublicKey([]byte(fmt.Sprintf(`{"id":%q,"type":"Person"}`, testFederatedActorIRI)), keyId)
+		assertNotEqual(t, err, nil)
+	})
+}
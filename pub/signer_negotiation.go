@@ -0,0 +1,208 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// defaultSignerConfigName is the name of the SignerConfig made of the
+	// signers given to NewHttpSigTransport.
+	defaultSignerConfigName = "default"
+)
+
+// SignerConfig is a named way of signing requests with HTTP Signatures, such as
+// with a particular algorithm and set of signed headers.
+//
+// Peers do not agree on how requests must be signed, so an HttpSigTransport may
+// try several of them until a peer accepts one.
+type SignerConfig struct {
+	// Name identifies the configuration in a SignerPreferenceStore, and
+	// must be unique among the configurations of a transport.
+	Name string
+	// GetSigner signs GET requests. It must not sign a Digest header.
+	GetSigner httpsig.Signer
+	// PostSigner signs POST requests.
+	PostSigner httpsig.Signer
+}
+
+// SignerPreferenceStore remembers, per peer host, the name of the SignerConfig
+// that the peer last accepted, so it is tried first on later requests.
+//
+// Persisting the preferences, such as in a database, avoids trying the
+// configurations that the peer rejects each time the server restarts.
+type SignerPreferenceStore interface {
+	// GetSignerPreference returns the name of the SignerConfig last
+	// accepted by the host, or an empty string if there is none.
+	GetSignerPreference(c context.Context, host string) (name string, err error)
+	// SetSignerPreference saves the name of the SignerConfig last accepted
+	// by the host.
+	SetSignerPreference(c context.Context, host, name string) error
+}
+
+// signerCandidate is a SignerConfig whose signers are guarded for concurrent
+// use, since an httpsig.Signer is not safe for it.
+type signerCandidate struct {
+	name       string
+	getSigner  httpsig.Signer
+	getMu      *sync.Mutex
+	postSigner httpsig.Signer
+	postMu     *sync.Mutex
+}
+
+// newSignerCandidate guards the signers of a SignerConfig.
+func newSignerCandidate(s SignerConfig) *signerCandidate {
+	return &signerCandidate{
+		name:       s.Name,
+		getSigner:  s.GetSigner,
+		getMu:      &sync.Mutex{},
+		postSigner: s.PostSigner,
+		postMu:     &sync.Mutex{},
+	}
+}
+
+// signGet signs a GET request with the current key of the transport.
+func (s *signerCandidate) signGet(c context.Context, h HttpSigTransport, r *http.Request) error {
+	keyId, key, err := h.signingKey(c, r.URL)
+	if err != nil {
+		return err
+	}
+	s.getMu.Lock()
+	defer s.getMu.Unlock()
+	return s.getSigner.SignRequest(key, keyId, r)
+}
+
+// signPost signs a POST request with the current key of the transport.
+func (s *signerCandidate) signPost(c context.Context, h HttpSigTransport, r *http.Request) error {
+	keyId, key, err := h.signingKey(c, r.URL)
+	if err != nil {
+		return err
+	}
+	s.postMu.Lock()
+	defer s.postMu.Unlock()
+	return s.postSigner.SignRequest(key, keyId, r)
+}
+
+// signerNegotiation holds the SignerConfigs of a transport and the memory of
+// which ones the peers accepted. It may be changed concurrently with requests
+// being made.
+type signerNegotiation struct {
+	mu         sync.RWMutex
+	candidates []*signerCandidate
+	store      SignerPreferenceStore
+	// memory holds the preferences when there is no store.
+	memory map[string]string
+}
+
+// newSignerNegotiation creates a signerNegotiation with a single, default
+// SignerConfig.
+func newSignerNegotiation(getSigner, postSigner httpsig.Signer) *signerNegotiation {
+	return &signerNegotiation{
+		candidates: []*signerCandidate{newSignerCandidate(SignerConfig{
+			Name:       defaultSignerConfigName,
+			GetSigner:  getSigner,
+			PostSigner: postSigner,
+		})},
+		memory: make(map[string]string),
+	}
+}
+
+// order returns the candidates to try for the host, with the one the host last
+// accepted first, as well as the name of that candidate.
+func (n *signerNegotiation) order(c context.Context, host string) (candidates []*signerCandidate, preferred string, err error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.store != nil {
+		preferred, err = n.store.GetSignerPreference(c, host)
+		if err != nil {
+			return
+		}
+	} else {
+		preferred = n.memory[host]
+	}
+	candidates = make([]*signerCandidate, 0, len(n.candidates))
+	for _, s := range n.candidates {
+		if s.name == preferred {
+			candidates = append(candidates, s)
+		}
+	}
+	for _, s := range n.candidates {
+		if s.name != preferred {
+			candidates = append(candidates, s)
+		}
+	}
+	return
+}
+
+// remember saves the name of the candidate the host accepted.
+func (n *signerNegotiation) remember(c context.Context, host, name string) error {
+	if n.store != nil {
+		return n.store.SetSignerPreference(c, host, name)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.memory[host] = name
+	return nil
+}
+
+// SetSignerConfigs replaces the signers given to NewHttpSigTransport with
+// several SignerConfigs, tried in order until a peer does not respond with 401
+// Unauthorized. The configuration each peer accepted is remembered in the
+// store, and tried first on later requests to that peer.
+//
+// If the store is nil, the preferences are only remembered by this transport.
+func (h HttpSigTransport) SetSignerConfigs(store SignerPreferenceStore, configs ...SignerConfig) error {
+	if len(configs) == 0 {
+		return fmt.Errorf("at least one signer config is required")
+	}
+	names := make(map[string]bool, len(configs))
+	candidates := make([]*signerCandidate, 0, len(configs))
+	for _, s := range configs {
+		if len(s.Name) == 0 {
+			return fmt.Errorf("signer config has no name")
+		} else if names[s.Name] {
+			return fmt.Errorf("signer config %q is not unique", s.Name)
+		} else if s.GetSigner == nil || s.PostSigner == nil {
+			return fmt.Errorf("signer config %q is missing a signer", s.Name)
+		}
+		names[s.Name] = true
+		candidates = append(candidates, newSignerCandidate(s))
+	}
+	h.signers.mu.Lock()
+	defer h.signers.mu.Unlock()
+	h.signers.candidates = candidates
+	h.signers.store = store
+	return nil
+}
+
+// negotiate sends a request signed by each candidate in turn, until the host
+// does not respond with 401 Unauthorized, and remembers the candidate it
+// accepted. The last response is returned, and its body must be closed.
+//
+// Failing to remember the accepted candidate does not fail the request, since
+// the peer already processed it.
+func (h HttpSigTransport) negotiate(c context.Context, host string, send func(s *signerCandidate) (*http.Response, error)) (*http.Response, error) {
+	candidates, preferred, err := h.signers.order(c, host)
+	if err != nil {
+		return nil, err
+	}
+	for i, s := range candidates {
+		resp, err := send(s)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized && i < len(candidates)-1 {
+			resp.Body.Close()
+			continue
+		}
+		if resp.StatusCode != http.StatusUnauthorized && s.name != preferred {
+			h.signers.remember(c, host, s.name)
+		}
+		return resp, nil
+	}
+	return nil, fmt.Errorf("no signer configs to sign a request to %s", host)
+}
@@ -332,6 +332,9 @@ func (w SocialWrappedCallbacks) update(c context.Context, a vocab.ActivityStream
 		if err != nil {
 			return err
 		}
+		// Set a new 'updated' time if the client did not, so that the
+		// serialized bytes cached for the former revision are not served.
+		bumpUpdated(t, newT, w.clock.Now())
 		if err = w.db.Update(c, newT); err != nil {
 			return err
 		}
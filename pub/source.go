@@ -0,0 +1,162 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// sourceProperty is the property holding the source an object's content
+	// was rendered from. It is not part of the generated vocabulary.
+	sourceProperty = "source"
+	// Properties of a source.
+	sourceContentProperty   = "content"
+	sourceMediaTypeProperty = "mediaType"
+)
+
+// Source is the source an object's content was rendered from, as written by its
+// author, such as Markdown with the MediaType "text/markdown". Clients editing
+// the object edit its source rather than its rendered content.
+type Source struct {
+	Content   string
+	MediaType string
+}
+
+// GetSource reads the 'source' property of an object, and returns whether it
+// has one.
+func GetSource(o vocab.Type) (Source, bool) {
+	u, ok := o.(unknownPropertieser)
+	if !ok {
+		return Source{}, false
+	}
+	m, ok := u.GetUnknownProperties()[sourceProperty].(map[string]interface{})
+	if !ok {
+		return Source{}, false
+	}
+	var s Source
+	s.Content, ok = m[sourceContentProperty].(string)
+	if !ok {
+		return Source{}, false
+	}
+	s.MediaType, _ = m[sourceMediaTypeProperty].(string)
+	return s, true
+}
+
+// SetSource sets the 'source' property of an object. It does nothing if the
+// object cannot have properties outside of the generated vocabularies.
+func SetSource(o vocab.Type, s Source) {
+	u, ok := o.(unknownPropertieser)
+	if !ok {
+		return
+	}
+	m := map[string]interface{}{sourceContentProperty: s.Content}
+	if len(s.MediaType) > 0 {
+		m[sourceMediaTypeProperty] = s.MediaType
+	}
+	u.GetUnknownProperties()[sourceProperty] = m
+}
+
+// SourceRenderer may be implemented by a SocialProtocol to render the source of
+// the objects clients Create and Update into their HTML 'content', which is
+// what peers display. The source is kept on the objects for clients to edit.
+type SourceRenderer interface {
+	// RenderSource returns the HTML content of the source. Sources of media
+	// types it does not render are returned as an error, failing the
+	// request.
+	RenderSource(c context.Context, s Source) (content string, err error)
+}
+
+// renderSources sets the 'content' of the objects with a 'source' of a Create or
+// Update activity to the source rendered by the SourceRenderer.
+func renderSources(c context.Context, r SourceRenderer, activity Activity) error {
+	if !streams.IsOrExtendsActivityStreamsCreate(activity) && !streams.IsOrExtendsActivityStreamsUpdate(activity) {
+		return nil
+	}
+	o, ok := activity.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return nil
+	}
+	op := o.GetActivityStreamsObject()
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil {
+			continue
+		}
+		s, ok := GetSource(t)
+		if !ok {
+			continue
+		}
+		ct, ok := t.(contenter)
+		if !ok {
+			continue
+		}
+		html, err := r.RenderSource(c, s)
+		if err != nil {
+			return err
+		}
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString(html)
+		ct.SetActivityStreamsContent(content)
+	}
+	return nil
+}
+
+// sourceReaderContextKey is the context key of the actor requesting served
+// values.
+type sourceReaderContextKey struct{}
+
+// WithSourceReader returns a context identifying the actor requesting the
+// ActivityStreams values served with it, once the application authenticated
+// the request. The 'source' of the objects attributed to the actor is served to
+// it, so its clients can edit them, and removed for everyone else.
+//
+// Without it, the 'source' of every object is removed. It also identifies the
+// owner of the collections restricted by a CollectionPrivacyDatabase.
+func WithSourceReader(c context.Context, actorIRI *url.URL) context.Context {
+	return context.WithValue(c, sourceReaderContextKey{}, actorIRI)
+}
+
+// clearSources removes the 'source' of the value, and recursively of every
+// 'object' property value, unless they are attributed to the actor requesting
+// them. It returns whether a 'source' was kept.
+func clearSources(c context.Context, obj vocab.Type) (kept bool) {
+	if obj == nil {
+		return false
+	}
+	reader, _ := c.Value(sourceReaderContextKey{}).(*url.URL)
+	if u, ok := obj.(unknownPropertieser); ok {
+		if _, has := u.GetUnknownProperties()[sourceProperty]; has {
+			if reader != nil && isAttributedTo(obj, reader) {
+				kept = true
+			} else {
+				delete(u.GetUnknownProperties(), sourceProperty)
+			}
+		}
+	}
+	if t, ok := obj.(objecter); ok {
+		if op := t.GetActivityStreamsObject(); op != nil {
+			for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+				kept = clearSources(c, iter.GetType()) || kept
+			}
+		}
+	}
+	return kept
+}
+
+// isAttributedTo determines whether the value is attributed to the actor.
+func isAttributedTo(obj vocab.Type, actor *url.URL) bool {
+	t, ok := obj.(attributedToer)
+	if !ok || t.GetActivityStreamsAttributedTo() == nil {
+		return false
+	}
+	attr := t.GetActivityStreamsAttributedTo()
+	for iter := attr.Begin(); iter != attr.End(); iter = iter.Next() {
+		if id, err := ToId(iter); err == nil && id.String() == actor.String() {
+			return true
+		}
+	}
+	return false
+}
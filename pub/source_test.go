@@ -0,0 +1,113 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// markdownRenderer renders Markdown sources as paragraphs.
+type markdownRenderer struct{}
+
+func (markdownRenderer) RenderSource(c context.Context, s Source) (string, error) {
+	if s.MediaType != "text/markdown" {
+		return "", errors.New("unsupported source")
+	}
+	return "<p>" + strings.Trim(s.Content, "*") + "</p>", nil
+}
+
+func newSourceNote() vocab.ActivityStreamsNote {
+	note := streams.NewActivityStreamsNote()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(testNoteId1))
+	note.SetActivityStreamsId(id)
+	attr := streams.NewActivityStreamsAttributedToProperty()
+	attr.AppendIRI(mustParse(testPersonIRI))
+	note.SetActivityStreamsAttributedTo(attr)
+	SetSource(note, Source{Content: "**hi**", MediaType: "text/markdown"})
+	return note
+}
+
+func TestSource(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	t.Run("SurvivesSerialization", func(t *testing.T) {
+		m, err := serialize(newSourceNote())
+		assertEqual(t, err, nil)
+		b, err := json.Marshal(m)
+		assertEqual(t, err, nil)
+		var raw map[string]interface{}
+		assertEqual(t, json.Unmarshal(b, &raw), nil)
+		parsed, err := streams.ToType(ctx, raw)
+		assertEqual(t, err, nil)
+		s, ok := GetSource(parsed)
+		assertEqual(t, ok, true)
+		assertEqual(t, s, Source{Content: "**hi**", MediaType: "text/markdown"})
+	})
+	t.Run("NoSource", func(t *testing.T) {
+		_, ok := GetSource(streams.NewActivityStreamsNote())
+		assertEqual(t, ok, false)
+	})
+	t.Run("RendersCreatedContent", func(t *testing.T) {
+		note := newSourceNote()
+		create := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		create.SetActivityStreamsObject(op)
+		assertEqual(t, renderSources(ctx, markdownRenderer{}, create), nil)
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(), "<p>hi</p>")
+		_, ok := GetSource(note)
+		assertEqual(t, ok, true)
+	})
+	t.Run("RendererErrorFails", func(t *testing.T) {
+		note := newSourceNote()
+		SetSource(note, Source{Content: "hi", MediaType: "text/x-unknown"})
+		update := streams.NewActivityStreamsUpdate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		update.SetActivityStreamsObject(op)
+		assertNotEqual(t, renderSources(ctx, markdownRenderer{}, update), nil)
+	})
+	t.Run("KeptForAuthorOnly", func(t *testing.T) {
+		note := newSourceNote()
+		assertEqual(t, clearSources(WithSourceReader(ctx, mustParse(testPersonIRI)), note), true)
+		_, ok := GetSource(note)
+		assertEqual(t, ok, true)
+		assertEqual(t, clearSources(WithSourceReader(ctx, mustParse(testFederatedActorIRI)), note), false)
+		_, ok = GetSource(note)
+		assertEqual(t, ok, false)
+	})
+	t.Run("HandlerServesSourceToAuthor", func(t *testing.T) {
+		cache := NewMemorySerializationCache(10)
+		serve := func(c context.Context) string {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			db := NewMockDatabase(ctl)
+			clock := NewMockClock(ctl)
+			db.EXPECT().Lock(gomock.Any(), gomock.Any())
+			db.EXPECT().Get(gomock.Any(), gomock.Any()).Return(newSourceNote(), nil)
+			db.EXPECT().Unlock(gomock.Any(), gomock.Any())
+			clock.EXPECT().Now().Return(now())
+			authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+				return false, nil
+			}
+			h := NewCachingActivityStreamsHandler(authFn, db, clock, cache)
+			req := httptest.NewRequest("GET", testNoteId1, nil)
+			req.Header.Set(acceptHeader, activityStreamsMediaTypes[0])
+			rec := httptest.NewRecorder()
+			_, err := h(c, rec, req)
+			assertEqual(t, err, nil)
+			return rec.Body.String()
+		}
+		assertEqual(t, strings.Contains(serve(WithSourceReader(ctx, mustParse(testPersonIRI))), `"source"`), true)
+		assertEqual(t, strings.Contains(serve(ctx), `"source"`), false)
+	})
+}
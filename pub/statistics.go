@@ -0,0 +1,188 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// statisticsDayFormat is the format of the days in StatisticsSnapshot.
+const statisticsDayFormat = "2006-01-02"
+
+// HostCount is the number of times a peer host was seen.
+type HostCount struct {
+	Host  string
+	Count int
+}
+
+// DeliveryCounts are the outcomes of deliveries to a peer host.
+type DeliveryCounts struct {
+	Succeeded int
+	Failed    int
+}
+
+// SuccessRate is the fraction of deliveries that succeeded, or 1 if there were
+// none.
+func (d DeliveryCounts) SuccessRate() float64 {
+	total := d.Succeeded + d.Failed
+	if total == 0 {
+		return 1
+	}
+	return float64(d.Succeeded) / float64(total)
+}
+
+// StatisticsSnapshot is a copy of the counts of a Statistics at one point in
+// time.
+type StatisticsSnapshot struct {
+	// LocalPosts is the number of Create activities posted to outboxes,
+	// suitable for the usage.localPosts of NodeInfo.
+	LocalPosts int
+	// PostsPerDay is the number of Create activities posted to outboxes,
+	// per UTC day formatted as "2006-01-02".
+	PostsPerDay map[string]int
+	// InboundByType is the number of activities received in inboxes, per
+	// type name.
+	InboundByType map[string]int
+	// PeerHosts is the number of activities received from, and deliveries
+	// made to, each peer host, most seen first.
+	PeerHosts []HostCount
+	// Deliveries are the outcomes of deliveries per peer host.
+	Deliveries map[string]DeliveryCounts
+}
+
+// TopPeerHosts returns up to n of the most seen peer hosts.
+func (s StatisticsSnapshot) TopPeerHosts(n int) []HostCount {
+	if n < len(s.PeerHosts) {
+		return s.PeerHosts[:n]
+	}
+	return s.PeerHosts
+}
+
+// DeliverySuccessRate is the fraction of deliveries to every peer host that
+// succeeded, or 1 if there were none.
+func (s StatisticsSnapshot) DeliverySuccessRate() float64 {
+	var total DeliveryCounts
+	for _, d := range s.Deliveries {
+		total.Succeeded += d.Succeeded
+		total.Failed += d.Failed
+	}
+	return total.SuccessRate()
+}
+
+// Statistics aggregates counts of an application's federation traffic, such as
+// posts per day, activities received per type, the most seen peer hosts, and
+// the delivery success rates, so applications do not each build the same
+// counters. It is safe for concurrent use.
+//
+// The counts are only kept in memory. Applications feed it by calling
+// RecordOutbound and RecordInbound, such as from the PostOutboxRequestBodyHook
+// and PostInboxRequestBodyHook, and by setting its RequestLogger on their
+// HttpSigTransports to record deliveries.
+type Statistics struct {
+	mu            sync.Mutex
+	clock         Clock
+	localPosts    int
+	postsPerDay   map[string]int
+	inboundByType map[string]int
+	peerHosts     map[string]int
+	deliveries    map[string]DeliveryCounts
+}
+
+// NewStatistics creates an empty Statistics.
+func NewStatistics(clock Clock) *Statistics {
+	return &Statistics{
+		clock:         clock,
+		postsPerDay:   make(map[string]int),
+		inboundByType: make(map[string]int),
+		peerHosts:     make(map[string]int),
+		deliveries:    make(map[string]DeliveryCounts),
+	}
+}
+
+// RecordOutbound records an activity posted to an outbox on this server. Only
+// Create activities count as posts.
+func (s *Statistics) RecordOutbound(c context.Context, t vocab.Type) {
+	if t.GetTypeName() != "Create" {
+		return
+	}
+	day := s.clock.Now().UTC().Format(statisticsDayFormat)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.localPosts++
+	s.postsPerDay[day]++
+}
+
+// RecordInbound records an activity received in an inbox on this server, from
+// the host of its id.
+func (s *Statistics) RecordInbound(c context.Context, t vocab.Type) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inboundByType[t.GetTypeName()]++
+	if id, err := GetId(t); err == nil && id != nil && len(id.Host) > 0 {
+		s.peerHosts[id.Host]++
+	}
+}
+
+// RecordDelivery records the outcome of a delivery to a peer inbox.
+func (s *Statistics) RecordDelivery(c context.Context, to *url.URL, succeeded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deliveries[to.Host]
+	if succeeded {
+		d.Succeeded++
+	} else {
+		d.Failed++
+	}
+	s.deliveries[to.Host] = d
+	s.peerHosts[to.Host]++
+}
+
+// RequestLogger returns a RequestLogger recording the outcome of every POST
+// request as a delivery. If next is not nil, it is also called with every
+// RequestLog.
+func (s *Statistics) RequestLogger(next RequestLogger) RequestLogger {
+	return func(c context.Context, l RequestLog) {
+		if l.Method == http.MethodPost && l.URL != nil {
+			s.RecordDelivery(c, l.URL, l.Err == nil && isSuccess(l.StatusCode))
+		}
+		if next != nil {
+			next(c, l)
+		}
+	}
+}
+
+// Snapshot copies the current counts.
+func (s *Statistics) Snapshot() StatisticsSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snap := StatisticsSnapshot{
+		LocalPosts:    s.localPosts,
+		PostsPerDay:   make(map[string]int, len(s.postsPerDay)),
+		InboundByType: make(map[string]int, len(s.inboundByType)),
+		PeerHosts:     make([]HostCount, 0, len(s.peerHosts)),
+		Deliveries:    make(map[string]DeliveryCounts, len(s.deliveries)),
+	}
+	for k, v := range s.postsPerDay {
+		snap.PostsPerDay[k] = v
+	}
+	for k, v := range s.inboundByType {
+		snap.InboundByType[k] = v
+	}
+	for k, v := range s.peerHosts {
+		snap.PeerHosts = append(snap.PeerHosts, HostCount{Host: k, Count: v})
+	}
+	sort.Slice(snap.PeerHosts, func(i, j int) bool {
+		if snap.PeerHosts[i].Count != snap.PeerHosts[j].Count {
+			return snap.PeerHosts[i].Count > snap.PeerHosts[j].Count
+		}
+		return snap.PeerHosts[i].Host < snap.PeerHosts[j].Host
+	})
+	for k, v := range s.deliveries {
+		snap.Deliveries[k] = v
+	}
+	return snap
+}
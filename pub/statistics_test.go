@@ -0,0 +1,43 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestStatistics(t *testing.T) {
+	ctx := context.Background()
+	setupData()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	s := NewStatistics(clock)
+	s.RecordOutbound(ctx, testCreate)
+	s.RecordOutbound(ctx, testListen)
+	now = now.Add(24 * time.Hour)
+	s.RecordOutbound(ctx, testCreate)
+	s.RecordInbound(ctx, testListen)
+	s.RecordInbound(ctx, testCreate)
+	logger := s.RequestLogger(nil)
+	logger(ctx, RequestLog{Method: http.MethodPost, URL: mustParse(testFederatedActorIRI + "/inbox"), StatusCode: http.StatusAccepted})
+	logger(ctx, RequestLog{Method: http.MethodPost, URL: mustParse(testFederatedActorIRI + "/inbox"), StatusCode: http.StatusInternalServerError})
+	logger(ctx, RequestLog{Method: http.MethodPost, URL: mustParse("https://third.example.com/inbox"), Err: fmt.Errorf("timeout")})
+	logger(ctx, RequestLog{Method: http.MethodGet, URL: mustParse(testNoteId1), StatusCode: http.StatusOK})
+	snap := s.Snapshot()
+	assertEqual(t, snap.LocalPosts, 2)
+	assertEqual(t, fmt.Sprint(snap.PostsPerDay), fmt.Sprint(map[string]int{"2020-01-02": 1, "2020-01-03": 1}))
+	assertEqual(t, fmt.Sprint(snap.InboundByType), fmt.Sprint(map[string]int{"Create": 1, "Listen": 1}))
+	assertEqual(t, snap.Deliveries["other.example.com"], DeliveryCounts{Succeeded: 1, Failed: 1})
+	assertEqual(t, snap.Deliveries["third.example.com"].SuccessRate(), 0.0)
+	assertEqual(t, snap.DeliverySuccessRate(), 1.0/3.0)
+	top := snap.TopPeerHosts(1)
+	assertEqual(t, len(top), 1)
+	assertEqual(t, top[0].Host, "other.example.com")
+}
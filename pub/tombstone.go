@@ -0,0 +1,54 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-fed/activity/streams"
+)
+
+// Tombstoner may be implemented by a DelegateActor to replace the federated
+// objects deleted by peers with Tombstones, instead of deleting them from the
+// Database, as the Social Protocol does for the objects deleted by clients.
+//
+// The handlers of NewActivityStreamsHandler respond to the requests of
+// Tombstones with 410 Gone and the Tombstone, so peers and clients learn the
+// objects were deleted instead of failing to find them. The actors created
+// with NewFederatingActor or NewActor defer to the FederatingProtocol if it
+// implements Tombstoner.
+type Tombstoner interface {
+	// TombstoneDeleted determines whether the federated objects deleted
+	// are replaced with Tombstones. Objects not in the Database are still
+	// deleted.
+	TombstoneDeleted(c context.Context) bool
+}
+
+// TombstoneDeleted defers to the FederatingProtocol whether the federated
+// objects deleted are replaced with Tombstones.
+func (a *sideEffectActor) TombstoneDeleted(c context.Context) bool {
+	if t, ok := a.s2s.(Tombstoner); ok {
+		return t.TombstoneDeleted(c)
+	}
+	return false
+}
+
+// deleteOrTombstone deletes the federated object from the Database, or
+// replaces it with a Tombstone if it is there and the actor tombstones deleted
+// objects. The lock for the id must be held.
+func (w FederatingWrappedCallbacks) deleteOrTombstone(c context.Context, id *url.URL) error {
+	if !w.tombstone {
+		return w.db.Delete(c, id)
+	}
+	if exists, err := w.db.Exists(c, id); err != nil {
+		return err
+	} else if !exists {
+		return w.db.Delete(c, id)
+	}
+	t, err := w.db.Get(c, id)
+	if err != nil {
+		return err
+	} else if streams.IsOrExtendsActivityStreamsTombstone(t) {
+		return nil
+	}
+	return w.db.Update(c, toTombstone(t, id, w.clock.Now()))
+}
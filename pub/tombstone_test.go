@@ -0,0 +1,64 @@
+package pub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+func TestTombstoneDeleted(t *testing.T) {
+	ctx := context.Background()
+	deleteNote := func() vocab.ActivityStreamsDelete {
+		del := streams.NewActivityStreamsDelete()
+		del.SetActivityStreamsId(newIdProperty(mustParse("https://example.com/delete/1")))
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		del.SetActivityStreamsObject(op)
+		return del
+	}
+	setupFn := func(ctl *gomock.Controller, tombstone bool) (db *MockDatabase, w FederatingWrappedCallbacks) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now()).AnyTimes()
+		w = FederatingWrappedCallbacks{db: db, tombstone: tombstone, clock: clock}
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		return
+	}
+	t.Run("DeletesByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, false)
+		db.EXPECT().Delete(ctx, mustParse(testNoteId1))
+		assertEqual(t, w.deleteFn(ctx, deleteNote()), nil)
+	})
+	t.Run("ReplacesWithTombstone", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true)
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testFederatedNote, nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			tomb, ok := v.(vocab.ActivityStreamsTombstone)
+			if !ok {
+				t.Fatalf("updated with %T, want a Tombstone", v)
+			}
+			assertEqual(t, tomb.GetActivityStreamsFormerType().At(0).GetXMLSchemaString(), "Note")
+			assertEqual(t, tomb.GetActivityStreamsDeleted().Get().Equal(now()), true)
+			return nil
+		})
+		assertEqual(t, w.deleteFn(ctx, deleteNote()), nil)
+	})
+	t.Run("DeletesIfNotInDatabase", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl, true)
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
+		db.EXPECT().Delete(ctx, mustParse(testNoteId1))
+		assertEqual(t, w.deleteFn(ctx, deleteNote()), nil)
+	})
+}
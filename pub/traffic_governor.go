@@ -0,0 +1,163 @@
+package pub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TrafficBudget limits the federation traffic of the whole server.
+type TrafficBudget struct {
+	// Concurrency is the number of requests that may be in flight at once,
+	// across every host. A request is in flight until its response body
+	// is closed. Zero or negative numbers are unlimited.
+	Concurrency int
+	// BytesPerSecond is the rate of the request and response bodies, on
+	// average. Zero or negative rates are unlimited.
+	BytesPerSecond float64
+	// BurstBytes is the number of bytes that may be transferred at once
+	// after a while without traffic. It is at least one second's worth of
+	// BytesPerSecond.
+	BurstBytes int64
+}
+
+// burst returns the size of the byte bucket.
+func (b TrafficBudget) burst() float64 {
+	if float64(b.BurstBytes) < b.BytesPerSecond {
+		return b.BytesPerSecond
+	}
+	return float64(b.BurstBytes)
+}
+
+// TrafficGovernor is an HttpClient keeping the requests of the whole server
+// within a TrafficBudget, so large fan-outs or backfills do not saturate the
+// uplink of small deployments. Requests wait for the budget, or until their
+// context is done.
+//
+// The budget is shared by every HttpSigTransport given the same
+// TrafficGovernor as their HttpClient, unlike a RateLimitedTransport, which
+// limits each host separately.
+//
+// A body larger than the burst is allowed once the bucket is not empty, and
+// the requests after it wait for the bucket to refill.
+type TrafficGovernor struct {
+	client HttpClient
+	clock  Clock
+	budget TrafficBudget
+	slots  chan struct{}
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// HttpClient must be implemented by TrafficGovernor.
+var _ HttpClient = &TrafficGovernor{}
+
+// NewTrafficGovernor wraps the HttpClient, keeping its requests within the
+// budget.
+func NewTrafficGovernor(client HttpClient, clock Clock, budget TrafficBudget) *TrafficGovernor {
+	g := &TrafficGovernor{
+		client: client,
+		clock:  clock,
+		budget: budget,
+		tokens: budget.burst(),
+		last:   clock.Now(),
+	}
+	if budget.Concurrency > 0 {
+		g.slots = make(chan struct{}, budget.Concurrency)
+	}
+	return g
+}
+
+// Do waits for a concurrency slot and for the bytes of the request body, then
+// sends the request with the wrapped HttpClient. The bytes of the response body
+// are waited for as it is read.
+func (g *TrafficGovernor) Do(req *http.Request) (*http.Response, error) {
+	c := req.Context()
+	if g.slots != nil {
+		select {
+		case <-c.Done():
+			return nil, c.Err()
+		case g.slots <- struct{}{}:
+		}
+	}
+	if req.ContentLength > 0 {
+		if err := g.take(c, req.ContentLength); err != nil {
+			g.release()
+			return nil, err
+		}
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		g.release()
+		return nil, err
+	}
+	resp.Body = &governedBody{ReadCloser: resp.Body, c: c, g: g}
+	return resp, nil
+}
+
+// release frees the concurrency slot of a request.
+func (g *TrafficGovernor) release() {
+	if g.slots != nil {
+		<-g.slots
+	}
+}
+
+// take waits until the bucket is not empty, or the context is done, then takes
+// n bytes from it.
+func (g *TrafficGovernor) take(c context.Context, n int64) error {
+	if g.budget.BytesPerSecond <= 0 {
+		return nil
+	}
+	for {
+		now := g.clock.Now()
+		g.mu.Lock()
+		g.tokens += now.Sub(g.last).Seconds() * g.budget.BytesPerSecond
+		if max := g.budget.burst(); g.tokens > max {
+			g.tokens = max
+		}
+		g.last = now
+		if g.tokens > 0 {
+			g.tokens -= float64(n)
+			g.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - g.tokens) / g.budget.BytesPerSecond * float64(time.Second))
+		g.mu.Unlock()
+		select {
+		case <-c.Done():
+			return c.Err()
+		case <-after(g.clock, wait):
+		}
+	}
+}
+
+// governedBody is a response body whose bytes are taken from the budget of a
+// TrafficGovernor as they are read. Closing it frees the concurrency slot of
+// its request.
+type governedBody struct {
+	io.ReadCloser
+	c    context.Context
+	g    *TrafficGovernor
+	once sync.Once
+}
+
+// Read reads from the body, then waits for the bytes read.
+func (b *governedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		if terr := b.g.take(b.c, int64(n)); terr != nil && err == nil {
+			err = terr
+		}
+	}
+	return n, err
+}
+
+// Close closes the body and frees the concurrency slot of its request.
+func (b *governedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.g.release)
+	return err
+}
@@ -0,0 +1,59 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTrafficGovernor(t *testing.T) {
+	client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK), nil
+	})
+	post := func(c context.Context, n int) *http.Request {
+		req, err := http.NewRequest("POST", testFederatedActorIRI, bytes.NewReader(make([]byte, n)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req.WithContext(c)
+	}
+	t.Run("LimitsConcurrency", func(t *testing.T) {
+		g := NewTrafficGovernor(client, NewFakeClock(now()), TrafficBudget{Concurrency: 1})
+		resp, err := g.Do(post(context.Background(), 1))
+		assertEqual(t, err, nil)
+		c, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = g.Do(post(c, 1))
+		assertEqual(t, err, context.Canceled)
+		resp.Body.Close()
+		resp, err = g.Do(post(context.Background(), 1))
+		assertEqual(t, err, nil)
+		resp.Body.Close()
+	})
+	t.Run("WaitsForBytes", func(t *testing.T) {
+		clock := NewFakeClock(now())
+		g := NewTrafficGovernor(client, clock, TrafficBudget{BytesPerSecond: 100})
+		// A body larger than the burst empties the bucket.
+		resp, err := g.Do(post(context.Background(), 150))
+		assertEqual(t, err, nil)
+		resp.Body.Close()
+		done := make(chan error, 1)
+		go func() {
+			resp, err := g.Do(post(context.Background(), 10))
+			if err == nil {
+				resp.Body.Close()
+			}
+			done <- err
+		}()
+		clock.BlockUntil(1)
+		select {
+		case <-done:
+			t.Fatalf("request sent before the bucket refilled")
+		default:
+		}
+		clock.Advance(time.Second)
+		assertEqual(t, <-done, nil)
+	})
+}
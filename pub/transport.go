@@ -4,10 +4,11 @@ import (
 	"bytes"
 	"context"
 	"crypto"
-	"crypto/sha256"
-	"encoding/base64"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"mime"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -17,9 +18,9 @@ import (
 )
 
 const (
-	// acceptHeaderValue is the Accept header value indicating that the
-	// response should contain an ActivityStreams object.
-	acceptHeaderValue = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
+	// acceptHeaderValue is the default Accept header value of a
+	// Dereference, preferring an ActivityStreams object over any other JSON.
+	acceptHeaderValue = "application/activity+json, application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"; q=0.9, application/json; q=0.5"
 )
 
 // isSuccess returns true if the HTTP status code is either OK, Created, or
@@ -37,40 +38,280 @@ func isSuccess(code int) bool {
 // requests if needed, and facilitating the traffic between this server and
 // another.
 //
-// The transport is exclusively used to issue requests on behalf of an actor,
-// and is never sending requests on behalf of the server in general.
+// The transport issues requests on behalf of an actor. Only the one of
+// NewInstanceActorTransport issues them on behalf of the server in general, for
+// dereferences made without the context of an actor.
 //
 // It may be reused multiple times, but never concurrently.
 type Transport interface {
 	// Dereference fetches the ActivityStreams object located at this IRI
 	// with a GET request.
 	Dereference(c context.Context, iri *url.URL) ([]byte, error)
+	// Fetch obtains any resource located at this IRI with a GET request,
+	// such as a media attachment or an HTML page, and returns its body and
+	// Content-Type. The accept is the Accept header value, where empty
+	// accepts anything.
+	Fetch(c context.Context, iri *url.URL, accept string) (body []byte, contentType string, err error)
 	// Deliver sends an ActivityStreams object.
 	Deliver(c context.Context, b []byte, to *url.URL) error
 	// BatchDeliver sends an ActivityStreams object to multiple recipients.
 	BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error
 }
 
+// GetSigning determines whether GET requests are signed with an HTTP
+// Signature.
+//
+// Some peers reject signed GET requests, while others require them.
+type GetSigning int
+
+const (
+	// SignGet signs every GET request. This is the default.
+	SignGet GetSigning = iota
+	// SignGetWithFallback signs GET requests, but when the peer responds
+	// with 401 Unauthorized the request is retried without a signature.
+	SignGetWithFallback
+	// DoNotSignGet never signs GET requests.
+	DoNotSignGet
+)
+
+// getSigningContextKey is the context key of a per-request GetSigning.
+type getSigningContextKey struct{}
+
+// WithGetSigning returns a context that determines whether the GET requests
+// made with it are signed, overriding the HttpSigTransport's settings.
+func WithGetSigning(c context.Context, s GetSigning) context.Context {
+	return context.WithValue(c, getSigningContextKey{}, s)
+}
+
+// dereferenceOptions holds the settings of a transport's GET requests, which may
+// be changed concurrently with requests being made.
+type dereferenceOptions struct {
+	mu                    sync.RWMutex
+	accept                string
+	signing               GetSigning
+	hostSigning           map[string]GetSigning
+	maxResponseBytes      int64
+	allowPrivateAddresses bool
+	rediscoverHTML        bool
+	goneActors            *GoneActors
+	negative              *negativeCache
+}
+
+// signingFor determines the GetSigning of a request to the host. A GetSigning
+// in the context takes precedence over the host's, which takes precedence
+// over the default.
+func (o *dereferenceOptions) signingFor(c context.Context, host string) GetSigning {
+	if s, ok := c.Value(getSigningContextKey{}).(GetSigning); ok {
+		return s
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if s, ok := o.hostSigning[host]; ok {
+		return s
+	}
+	return o.signing
+}
+
+// checkDestination ensures a GET request may be sent to the IRI, with the
+// restrictions of checkDestination.
+func (o *dereferenceOptions) checkDestination(iri *url.URL) error {
+	o.mu.RLock()
+	allowPrivate := o.allowPrivateAddresses
+	o.mu.RUnlock()
+	return checkDestination(iri, allowPrivate)
+}
+
+// checkDestination ensures a request may be sent to the IRI. Only http and
+// https IRIs are allowed. Unless private addresses are allowed, hosts that are
+// loopback, private, link-local, or unspecified IP addresses, as well as
+// "localhost", are rejected so peers cannot make this server request its own
+// internal services.
+//
+// Host names are not resolved, so the HttpClient must guard its connections
+// if host names resolving to private addresses are a concern, as the
+// ConnectionClient does.
+func checkDestination(iri *url.URL, allowPrivate bool) error {
+	if iri.Scheme != "http" && iri.Scheme != "https" {
+		return fmt.Errorf("cannot request %s: scheme must be http or https", iri.String())
+	}
+	if allowPrivate {
+		return nil
+	}
+	host := strings.ToLower(iri.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("cannot request %s: host is private", iri.String())
+	}
+	if ip := net.ParseIP(host); ip != nil && isPrivateIP(ip) {
+		return fmt.Errorf("cannot request %s: host is private", iri.String())
+	}
+	return nil
+}
+
+// limitBody limits a response body to the maximum number of bytes, returning
+// an error when reading past it.
+func (o *dereferenceOptions) limitBody(iri *url.URL, body io.ReadCloser) io.ReadCloser {
+	o.mu.RLock()
+	max := o.maxResponseBytes
+	o.mu.RUnlock()
+	if max <= 0 {
+		return body
+	}
+	return &limitedBody{ReadCloser: body, iri: iri, max: max, remaining: max}
+}
+
+// limitedBody is a response body that returns an error once more than a maximum
+// number of bytes is read from it.
+type limitedBody struct {
+	io.ReadCloser
+	iri       *url.URL
+	max       int64
+	remaining int64
+}
+
+// Read reads from the body, returning an error if the body is larger than the
+// maximum.
+func (l *limitedBody) Read(p []byte) (n int, err error) {
+	if l.remaining < 0 {
+		return 0, fmt.Errorf("GET request to %s failed: response is larger than %d bytes", l.iri.String(), l.max)
+	}
+	// Read one more byte than remaining to detect a body that is too
+	// large.
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err = l.ReadCloser.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		n += int(l.remaining)
+		err = fmt.Errorf("GET request to %s failed: response is larger than %d bytes", l.iri.String(), l.max)
+	}
+	return
+}
+
+// acceptValue returns the Accept header value of a request.
+func (o *dereferenceOptions) acceptValue() string {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.accept
+}
+
+// failures returns the negative cache of the transport, if any.
+func (o *dereferenceOptions) failures() *negativeCache {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.negative
+}
+
+// gone returns the GoneActors of the transport, if any.
+func (o *dereferenceOptions) gone() *GoneActors {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.goneActors
+}
+
+// privacyUserAgent is the User-Agent header value of requests in privacy mode,
+// which is the same for every application and version of go-fed.
+const privacyUserAgent = "go-fed"
+
+// requiredHeaders are the headers needed to deliver, dereference, and sign
+// requests, which are sent regardless of a header allow-list.
+var requiredHeaders = map[string]bool{
+	"Accept":       true,
+	"Content-Type": true,
+	"Date":         true,
+	"Digest":       true,
+	"Host":         true,
+	// Validators of conditional requests.
+	"If-None-Match":     true,
+	"If-Modified-Since": true,
+}
+
+// headerOptions holds the settings of the headers of a transport's requests,
+// which may be changed concurrently with requests being made.
+type headerOptions struct {
+	mu        sync.RWMutex
+	userAgent string
+	privacy   bool
+	allow     map[string]bool
+	extra     http.Header
+	digests   []DigestAlgorithm
+}
+
+// getUserAgent returns the User-Agent header value of requests.
+func (o *headerOptions) getUserAgent() string {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.userAgent
+}
+
+// apply adds the extra headers to a request, then removes or normalizes its
+// identifying headers. It must be called before the request is signed.
+func (o *headerOptions) apply(req *http.Request) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	for k, vs := range o.extra {
+		// Headers set by the transport take precedence.
+		if _, ok := req.Header[k]; ok {
+			continue
+		}
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	if o.privacy {
+		req.Header.Del("Accept-Charset")
+		req.Header.Set("User-Agent", privacyUserAgent)
+	}
+	if o.allow != nil {
+		for k := range req.Header {
+			if !requiredHeaders[k] && !o.allow[k] {
+				req.Header.Del(k)
+			}
+		}
+		// An empty User-Agent prevents the HttpClient from sending its
+		// own.
+		if _, ok := req.Header["User-Agent"]; !ok {
+			req.Header["User-Agent"] = []string{""}
+		}
+	}
+}
+
+// streamFetcher is a Transport that can obtain a resource without reading it
+// entirely into memory.
+type streamFetcher interface {
+	FetchStream(c context.Context, iri *url.URL, accept string) (body io.ReadCloser, contentType string, err error)
+}
+
 // Transport must be implemented by HttpSigTransport.
 var _ Transport = &HttpSigTransport{}
 
+// ConditionalDereferencer must be implemented by HttpSigTransport.
+var _ ConditionalDereferencer = &HttpSigTransport{}
+
+// streamFetcher must be implemented by HttpSigTransport.
+var _ streamFetcher = &HttpSigTransport{}
+
+// reportingDeliverer must be implemented by HttpSigTransport.
+var _ reportingDeliverer = &HttpSigTransport{}
+
 // HttpSigTransport makes a dereference call using HTTP signatures to
 // authenticate the request on behalf of a particular actor.
 //
-// No rate limiting is applied.
+// No rate limiting is applied, unless it is wrapped by a RateLimitedTransport.
 //
-// Only one request is tried per call.
+// Only one request is tried per call, unless several SignerConfigs are set or
+// a GET request falls back to not being signed.
 type HttpSigTransport struct {
-	client       HttpClient
-	appAgent     string
-	gofedAgent   string
-	clock        Clock
-	getSigner    httpsig.Signer
-	getSignerMu  *sync.Mutex
-	postSigner   httpsig.Signer
-	postSignerMu *sync.Mutex
-	pubKeyId     string
-	privKey      crypto.PrivateKey
+	client     HttpClient
+	clock      Clock
+	signers    *signerNegotiation
+	pubKeyId   string
+	privKey    crypto.PrivateKey
+	getOptions *dereferenceOptions
+	headers    *headerOptions
+	logOptions *requestLogOptions
+	timeouts   *timeoutOptions
+	keys       *keyOptions
 }
 
 // NewHttpSigTransport returns a new Transport.
@@ -81,13 +322,16 @@ type HttpSigTransport struct {
 // and an HTTP Signature signing algorithm.
 //
 // The client lets users issue requests through any HTTP client, including the
-// standard library's HTTP client.
+// standard library's HTTP client. A ConnectionClient tunes the persistent
+// connections to peers and reports their reuse.
 //
 // The appAgent uniquely identifies the calling application's requests, so peers
 // may aid debugging the requests incoming from this server. Note that the
 // agent string will also include one for go-fed, so at minimum peer servers can
 // reach out to the go-fed library to aid in notifying implementors of malformed
 // or unsupported requests.
+// SetUserAgent composes it differently, such as with a URL to contact the
+// operator of this server.
 func NewHttpSigTransport(
 	client HttpClient,
 	appAgent string,
@@ -96,134 +340,598 @@ func NewHttpSigTransport(
 	pubKeyId string,
 	privKey crypto.PrivateKey) *HttpSigTransport {
 	return &HttpSigTransport{
-		client:       client,
-		appAgent:     appAgent,
-		gofedAgent:   goFedUserAgent(),
-		clock:        clock,
-		getSigner:    getSigner,
-		getSignerMu:  &sync.Mutex{},
-		postSigner:   postSigner,
-		postSignerMu: &sync.Mutex{},
-		pubKeyId:     pubKeyId,
-		privKey:      privKey,
+		client:   client,
+		clock:    clock,
+		signers:  newSignerNegotiation(getSigner, postSigner),
+		pubKeyId: pubKeyId,
+		privKey:  privKey,
+		getOptions: &dereferenceOptions{
+			accept:           acceptHeaderValue,
+			signing:          SignGet,
+			hostSigning:      make(map[string]GetSigning),
+			maxResponseBytes: defaultMaxResponseBytes,
+		},
+		headers:    &headerOptions{userAgent: UserAgent{Application: appAgent}.String()},
+		logOptions: &requestLogOptions{},
+		timeouts:   &timeoutOptions{},
+		keys:       &keyOptions{},
+	}
+}
+
+// SetGetSigning determines whether GET requests to hosts without their own
+// setting are signed. By default, they are always signed.
+func (h HttpSigTransport) SetGetSigning(s GetSigning) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.signing = s
+}
+
+// SetHostGetSigning determines whether GET requests to the host, such as
+// "example.com", are signed.
+func (h HttpSigTransport) SetHostGetSigning(host string, s GetSigning) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.hostSigning[host] = s
+}
+
+// SetDereferenceAccept sets the Accept header value of the GET requests made by
+// Dereference. By default, the ActivityStreams media types are preferred over
+// plain JSON.
+func (h HttpSigTransport) SetDereferenceAccept(accept string) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.accept = accept
+}
+
+// SetMaxResponseBytes limits the size of the responses to GET requests, which
+// are rejected when larger. Zero or less means unlimited. The default is
+// 16 MiB.
+func (h HttpSigTransport) SetMaxResponseBytes(n int64) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.maxResponseBytes = n
+}
+
+// SetAllowPrivateAddresses determines whether GET requests may be sent to
+// loopback, private, or link-local addresses. By default they are not, which
+// protects the services internal to this server's network. Allowing them is
+// useful during development.
+//
+// Only the IRIs requested are checked, so the HttpClient must be a
+// ConnectionClient, or guard its connections and redirects alike, to also
+// reject host names resolving to private addresses and redirects to them.
+func (h HttpSigTransport) SetAllowPrivateAddresses(allow bool) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.allowPrivateAddresses = allow
+}
+
+// SetUserAgent sets the User-Agent header of requests, replacing the one made of
+// the appAgent and the go-fed version. It is not sent in privacy mode.
+func (h HttpSigTransport) SetUserAgent(u UserAgent) {
+	h.headers.mu.Lock()
+	defer h.headers.mu.Unlock()
+	h.headers.userAgent = u.String()
+}
+
+// SetPrivacyMode determines whether requests hide details that could identify
+// this server's software. In privacy mode, the User-Agent header is a generic
+// one without the appAgent or go-fed version, and no Accept-Charset header is
+// sent. It is disabled by default.
+func (h HttpSigTransport) SetPrivacyMode(enabled bool) {
+	h.headers.mu.Lock()
+	defer h.headers.mu.Unlock()
+	h.headers.privacy = enabled
+}
+
+// SetHeaderAllowList restricts the headers of requests to the ones named, plus
+// the Accept, Content-Type, Date, Digest, and Host headers needed to federate,
+// and the Signature header. An empty list removes the restriction.
+//
+// For example, allowing only "User-Agent" prevents the Accept-Charset and any
+// extra headers from being sent.
+func (h HttpSigTransport) SetHeaderAllowList(names ...string) {
+	h.headers.mu.Lock()
+	defer h.headers.mu.Unlock()
+	if len(names) == 0 {
+		h.headers.allow = nil
+		return
+	}
+	h.headers.allow = make(map[string]bool, len(names))
+	for _, n := range names {
+		h.headers.allow[http.CanonicalHeaderKey(n)] = true
 	}
 }
 
-// Dereference sends a GET request signed with an HTTP Signature to obtain an
-// ActivityStreams value.
+// SetExtraHeaders adds the headers to every request, unless the transport
+// already sets them. They are added before the request is signed, so a signer
+// may sign them.
+func (h HttpSigTransport) SetExtraHeaders(extra http.Header) {
+	h.headers.mu.Lock()
+	defer h.headers.mu.Unlock()
+	h.headers.extra = make(http.Header, len(extra))
+	for k, vs := range extra {
+		for _, v := range vs {
+			h.headers.extra.Add(k, v)
+		}
+	}
+}
+
+// SetRediscoverHTML determines whether Dereference falls back to
+// DereferenceRediscover when a peer responds with HTML instead of an
+// ActivityStreams value. It is disabled by default.
+func (h HttpSigTransport) SetRediscoverHTML(enabled bool) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.rediscoverHTML = enabled
+}
+
+// SetNegativeCacheTTLs remembers the GET requests that fail with 404 Not Found,
+// 410 Gone, or a timeout for the durations of the TTLs, returning the same
+// error without fetching the IRI again until they expire. It is disabled by
+// default, and DefaultNegativeCacheTTLs suit most applications.
+//
+// Setting the TTLs forgets every remembered failure.
+func (h HttpSigTransport) SetNegativeCacheTTLs(ttls NegativeCacheTTLs) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.negative = newNegativeCache(h.clock, ttls)
+}
+
+// ForgetFailure removes the remembered failure to fetch the IRI, such as when
+// a peer announces the value now exists.
+func (h HttpSigTransport) ForgetFailure(iri *url.URL) {
+	if n := h.getOptions.failures(); n != nil {
+		n.forget(iri)
+	}
+}
+
+// SetGoneActors records the actors whose IRIs the transport finds gone in the
+// GoneActors, and stops fetching them afterwards. GoneActors may be shared by
+// several transports.
+func (h HttpSigTransport) SetGoneActors(g *GoneActors) {
+	h.getOptions.mu.Lock()
+	defer h.getOptions.mu.Unlock()
+	h.getOptions.goneActors = g
+}
+
+// Dereference sends a GET request to obtain an ActivityStreams value.
+//
+// Whether the request is signed with an HTTP Signature is determined by the
+// GetSigning in the context, or else the one set for the host. GET requests
+// have no Digest header, so the getSigner must not sign one.
 func (h HttpSigTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
-	req, err := http.NewRequest("GET", iri.String(), nil)
+	h.getOptions.mu.RLock()
+	rediscover := h.getOptions.rediscoverHTML
+	h.getOptions.mu.RUnlock()
+	if rediscover {
+		b, _, err := h.DereferenceRediscover(c, iri)
+		return b, err
+	}
+	responseData, contentType, err := h.fetch(c, iri, h.getOptions.acceptValue())
 	if err != nil {
 		return nil, err
 	}
-	req.WithContext(c)
-	// req.Header.Add(acceptHeader, acceptHeaderValue)
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-	req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
-	req.Header.Add("host", iri.Host)
-	req.Header.Add("digest", "")
-	req.Header.Add("Accept", "application/activity+json; profile=\"https://www.w3.org/ns/activitystreams\"")
+	responseData, err = decodeJSONBody(contentType, responseData)
+	if err != nil {
+		return nil, fmt.Errorf("GET request to %s: %s", iri.String(), err)
+	}
+	return responseData, nil
+}
+
+// DereferenceConditional is like Dereference, but sends the validators of a
+// cached response, such as an If-None-Match header. If the peer responds with
+// 304 Not Modified, notModified is true and the body is nil. The headers of the
+// response are returned, so the caller may determine its freshness.
+//
+// HTML responses are not rediscovered.
+func (h HttpSigTransport) DereferenceConditional(c context.Context, iri *url.URL, validators http.Header) (body []byte, header http.Header, notModified bool, err error) {
+	if validators == nil {
+		validators = http.Header{}
+	}
+	resp, err := h.fetchResponse(withValidators(c, validators), iri, h.getOptions.acceptValue())
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp.Header, true, nil
+	}
+	body, err = ioutil.ReadAll(h.getOptions.limitBody(iri, resp.Body))
+	if err != nil {
+		return
+	}
+	body, err = decodeJSONBody(resp.Header.Get(contentTypeHeader), body)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("GET request to %s: %s", iri.String(), err)
+	}
+	return body, resp.Header, false, nil
+}
+
+// DereferenceRediscover is like Dereference, but when the peer responds with
+// HTML, such as some bridges and legacy servers do for actor IRIs, the IRI of
+// the ActivityStreams representation is discovered with WebFinger and
+// dereferenced instead. The IRI the value was obtained from is returned, so
+// the caller may use the corrected IRI from then on.
+//
+// Rediscovery is attempted at most once per call. The caller must still ensure
+// the obtained value is the one it expected, such as by checking its id.
+func (h HttpSigTransport) DereferenceRediscover(c context.Context, iri *url.URL) (body []byte, actual *url.URL, err error) {
+	accept := h.getOptions.acceptValue()
+	body, contentType, err := h.fetch(c, iri, accept)
+	if err != nil {
+		return
+	}
+	actual = iri
+	if isHTMLContentType(contentType) {
+		actual, err = h.rediscover(c, iri)
+		if err != nil {
+			return nil, nil, fmt.Errorf("GET request to %s returned HTML and cannot be rediscovered: %s", iri.String(), err)
+		}
+		body, contentType, err = h.fetch(c, actual, accept)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	body, err = decodeJSONBody(contentType, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GET request to %s: %s", actual.String(), err)
+	}
+	return
+}
+
+// Fetch sends a GET request to obtain any resource, such as a media attachment
+// or an HTML page for a link preview.
+//
+// It is signed, restricted to public hosts, and limited in size just like
+// Dereference.
+func (h HttpSigTransport) Fetch(c context.Context, iri *url.URL, accept string) (body []byte, contentType string, err error) {
+	if len(accept) == 0 {
+		accept = "*/*"
+	}
+	return h.fetch(c, iri, accept)
+}
+
+// FetchStream is like Fetch, but returns the body as it is received instead of
+// reading it entirely, which suits large media. The body must be closed, and
+// returns an error once it exceeds the maximum response size.
+func (h HttpSigTransport) FetchStream(c context.Context, iri *url.URL, accept string) (body io.ReadCloser, contentType string, err error) {
+	if len(accept) == 0 {
+		accept = "*/*"
+	}
+	resp, err := h.fetchResponse(c, iri, accept)
+	if err != nil {
+		return
+	}
+	return h.getOptions.limitBody(iri, resp.Body), resp.Header.Get(contentTypeHeader), nil
+}
 
-	h.getSignerMu.Lock()
-	err = h.getSigner.SignRequest(h.privKey, h.pubKeyId, req)
-	h.getSignerMu.Unlock()
+// fetch sends a GET request with the Accept header value and returns the body
+// and Content-Type of a successful response.
+func (h HttpSigTransport) fetch(c context.Context, iri *url.URL, accept string) (body []byte, contentType string, err error) {
+	resp, err := h.fetchResponse(c, iri, accept)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(h.getOptions.limitBody(iri, resp.Body))
 	if err != nil {
+		return
+	}
+	contentType = resp.Header.Get(contentTypeHeader)
+	return
+}
+
+// fetchResponse sends a GET request with the Accept header value, signing it
+// according to its GetSigning, and returns a successful response. The
+// response body must be closed.
+//
+// Recent failures to fetch the IRI are returned again instead, if the
+// transport has a negative cache.
+func (h HttpSigTransport) fetchResponse(c context.Context, iri *url.URL, accept string) (*http.Response, error) {
+	n := h.getOptions.failures()
+	if n == nil {
+		return h.requestResponse(c, iri, accept)
+	}
+	if err := n.get(iri); err != nil {
 		return nil, err
 	}
-	resp, err := h.client.Do(req)
+	resp, err := h.requestResponse(c, iri, accept)
 	if err != nil {
+		n.observe(iri, err)
+	}
+	return resp, err
+}
+
+// requestResponse sends the GET request of fetchResponse.
+func (h HttpSigTransport) requestResponse(c context.Context, iri *url.URL, accept string) (*http.Response, error) {
+	if err := h.getOptions.checkDestination(iri); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
+	// Never fetch from actors known to be gone again.
+	g := h.getOptions.gone()
+	if g != nil && g.IsGone(iri) {
+		return nil, &StatusError{Method: http.MethodGet, URL: iri, StatusCode: http.StatusGone, Status: http.StatusText(http.StatusGone)}
 	}
+	s := h.getOptions.signingFor(c, iri.Host)
+	var resp *http.Response
+	var err error
+	if s == DoNotSignGet {
+		resp, err = h.get(c, iri, accept, nil)
+	} else {
+		resp, err = h.negotiate(c, iri.Host, func(sc *signerCandidate) (*http.Response, error) {
+			return h.get(c, iri, accept, sc)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s == SignGetWithFallback && resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		resp, err = h.get(c, iri, accept, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode == http.StatusNotModified && validatorsFrom(c) != nil {
+		return resp, nil
+	} else if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		err = &StatusError{Method: http.MethodGet, URL: iri, StatusCode: resp.StatusCode, Status: resp.Status}
+		if resp.StatusCode == http.StatusGone && g != nil {
+			if perr := g.observe(c, iri); perr != nil {
+				return nil, perr
+			}
+		}
+		return nil, err
+	}
+	return resp, nil
+}
 
-	// fmt.Println("GET")
-	responseData, _ := ioutil.ReadAll(resp.Body)
-	responseText := string(responseData)
-	fmt.Println("GET request succeeded:", iri.String(), req.Header, resp.StatusCode, resp.Status, responseText)
+// StatusError is the error of a request that a peer responded to with an
+// unexpected status code.
+type StatusError struct {
+	// Method is the method of the request.
+	Method string
+	// URL is the requested IRI.
+	URL *url.URL
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Status is the status line of the response.
+	Status string
+	// Body is the beginning of the response body, if it was kept.
+	Body string
+}
 
-	return responseData, nil
-	// return ioutil.ReadAll(resp.Body)
+// Error describes the failed request.
+func (e *StatusError) Error() string {
+	if len(e.Body) > 0 {
+		return fmt.Sprintf("%s request to %s failed (%d): %s: %s", e.Method, e.URL.String(), e.StatusCode, e.Status, e.Body)
+	}
+	return fmt.Sprintf("%s request to %s failed (%d): %s", e.Method, e.URL.String(), e.StatusCode, e.Status)
 }
 
-// Deliver sends a POST request with an HTTP Signature.
-func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
-	byteCopy := make([]byte, len(b))
-	copy(byteCopy, b)
-	buf := bytes.NewBuffer(byteCopy)
-	req, err := http.NewRequest("POST", to.String(), buf)
-	if err != nil {
-		return err
+// IsGoneError determines whether the error is that of a request that a peer
+// responded to with 410 Gone, such as when fetching the key of a deleted actor.
+func IsGoneError(err error) bool {
+	se, ok := err.(*StatusError)
+	return ok && se.StatusCode == http.StatusGone
+}
+
+// isHTMLContentType determines whether a Content-Type header value is that of
+// an HTML page.
+func isHTMLContentType(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mt == "text/html" || mt == "application/xhtml+xml")
+}
+
+// utf8BOM is the byte order mark that some peers prefix UTF-8 payloads with.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// decodeJSONBody ensures a response body with the given Content-Type header
+// value is JSON, and returns it encoded as UTF-8.
+//
+// A missing Content-Type is tolerated since some peers omit it.
+func decodeJSONBody(contentType string, b []byte) ([]byte, error) {
+	if len(contentType) == 0 {
+		return bytes.TrimPrefix(b, utf8BOM), nil
 	}
-	req.WithContext(c)
-	// req.Header.Add(contentTypeHeader, contentTypeHeaderValue)
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-	req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
-	req.Header.Add("Host", to.Host)
-	req.Header.Add("Accept", "application/activity+json")
-	sum := sha256.Sum256(b)
-	req.Header.Add("Digest",
-		fmt.Sprintf("SHA-256=%s",
-			base64.StdEncoding.EncodeToString(sum[:])))
-	h.postSignerMu.Lock()
-	err = h.postSigner.SignRequest(h.privKey, h.pubKeyId, req)
-	h.postSignerMu.Unlock()
+	mt, params, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("invalid content type %q: %s", contentType, err)
 	}
-	resp, err := h.client.Do(req)
+	switch mt {
+	case "application/activity+json", "application/ld+json", "application/json":
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", contentType)
+	}
+	switch strings.ToLower(params["charset"]) {
+	case "", "utf-8", "utf8", "us-ascii":
+		return bytes.TrimPrefix(b, utf8BOM), nil
+	case "iso-8859-1", "latin1":
+		// Every ISO-8859-1 byte is the Unicode code point of the same
+		// value.
+		var buf bytes.Buffer
+		buf.Grow(len(b))
+		for _, c := range b {
+			buf.WriteRune(rune(c))
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported charset in content type %q", contentType)
+	}
+}
+
+// get sends a single GET request, signed with an HTTP Signature by the signer
+// candidate unless it is nil.
+func (h HttpSigTransport) get(c context.Context, iri *url.URL, accept string, s *signerCandidate) (*http.Response, error) {
+	req, err := http.NewRequest("GET", iri.String(), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
-	if !isSuccess(resp.StatusCode) {
-		responseData, _ := ioutil.ReadAll(resp.Body)
-		responseText := string(responseData)
-		return fmt.Errorf("POST request to %s failed (%d): %s", to.String(), resp.StatusCode, resp.Status, responseText, string(byteCopy), req.Header)
-	}
-	// responseData, _ := ioutil.ReadAll(resp.Body)
-	// responseText := string(responseData)
-	// fmt.Println("POST request to %s succeeded (%d): %s", to.String(), resp.StatusCode, resp.Status, responseText, string(byteCopy), req.Header)
-	// fmt.Println("POST")
-	return nil
+	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+	req.Header.Add("User-Agent", h.headers.getUserAgent())
+	req.Header.Add("host", iri.Host)
+	req.Header.Add(acceptHeader, accept)
+	for k, vs := range validatorsFrom(c) {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	h.headers.apply(req)
+	if s != nil {
+		if err = s.signGet(c, h, req); err != nil {
+			return nil, err
+		}
+	}
+	return h.send(c, req, 0)
+}
+
+// Deliver sends a POST request with an HTTP Signature.
+//
+// The payload is not copied and must not be modified until Deliver returns.
+func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	return h.deliver(c, b, digestHeaderValues(b, h.headers.getDigestAlgorithms()), to)
 }
 
 // BatchDeliver sends concurrent POST requests. Returns an error if any of the
 // requests had an error.
+//
+// Cancelling the context aborts the requests in flight, and the deliveries not
+// yet sent fail, as they do once the SetBatchDeliverTimeout elapses.
+//
+// Every request shares the same payload and Digest, so the payload is neither
+// copied nor hashed once per recipient. The payload must not be modified until
+// BatchDeliver returns.
 func (h HttpSigTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
-	fmt.Println(recipients)
+	return deliveryError(h.BatchDeliverWithReport(c, b, recipients))
+}
+
+// BatchDeliverWithReport is like BatchDeliver, but returns the outcome of the
+// request to each recipient, in the order of the recipients.
+func (h HttpSigTransport) BatchDeliverWithReport(c context.Context, b []byte, recipients []*url.URL) []DeliveryOutcome {
+	c, cancel := h.batchContext(c)
+	defer cancel()
+	digest := digestHeaderValues(b, h.headers.getDigestAlgorithms())
+	outcomes := make([]DeliveryOutcome, len(recipients))
 	var wg sync.WaitGroup
-	errCh := make(chan error, len(recipients))
-	for _, recipient := range recipients {
+	for i, recipient := range recipients {
 		wg.Add(1)
-		go func(r *url.URL) {
+		go func(i int, r *url.URL) {
 			defer wg.Done()
-			if err := h.Deliver(c, b, r); err != nil {
-				errCh <- err
+			outcomes[i] = DeliveryOutcome{Inbox: r, Time: h.clock.Now()}
+			// Deliveries not yet sent once the context is done are
+			// not sent at all.
+			err := c.Err()
+			if err == nil {
+				err = h.deliver(c, b, digest, r)
+			}
+			if err != nil {
+				outcomes[i].fail(err)
 			}
-		}(recipient)
+		}(i, recipient)
 	}
 	wg.Wait()
-	errs := make([]string, 0, len(recipients))
-outer:
-	for {
-		select {
-		case e := <-errCh:
-			errs = append(errs, e.Error())
-		default:
-			break outer
-		}
+	return outcomes
+}
+
+// deliver sends a POST request with an HTTP Signature, using an already
+// computed Digest header value for the payload. It is resent with each signer
+// candidate the recipient rejects.
+func (h HttpSigTransport) deliver(c context.Context, b []byte, digest string, to *url.URL) error {
+	resp, err := h.negotiate(c, to.Host, func(s *signerCandidate) (*http.Response, error) {
+		return h.post(c, b, digest, to, s)
+	})
+	if err != nil {
+		return err
 	}
-	if len(errs) > 0 {
-		return fmt.Errorf("batch deliver had at least one failure: %s", strings.Join(errs, "; "))
+	defer resp.Body.Close()
+	if !isSuccess(resp.StatusCode) {
+		buf := responseBufferPool.Get().(*bytes.Buffer)
+		defer func() {
+			buf.Reset()
+			responseBufferPool.Put(buf)
+		}()
+		buf.ReadFrom(io.LimitReader(resp.Body, maxErrorResponseBytes))
+		return &StatusError{Method: http.MethodPost, URL: to, StatusCode: resp.StatusCode, Status: resp.Status, Body: buf.String()}
 	}
 	return nil
 }
 
+// post sends a single POST request, signed with an HTTP Signature by the signer
+// candidate.
+func (h HttpSigTransport) post(c context.Context, b []byte, digest string, to *url.URL, s *signerCandidate) (*http.Response, error) {
+	// A bytes.Reader reads the shared payload without copying it, and lets
+	// the request body be rewound by the client if it needs to be resent.
+	req, err := http.NewRequest("POST", to.String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	// req.Header.Add(contentTypeHeader, contentTypeHeaderValue)
+	req.Header.Add("Accept-Charset", "utf-8")
+	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+	req.Header.Add("User-Agent", h.headers.getUserAgent())
+	req.Header.Add("Host", to.Host)
+	req.Header.Add("Accept", "application/activity+json")
+	req.Header.Add("Digest", digest)
+	h.headers.apply(req)
+	if err = s.signPost(c, h, req); err != nil {
+		return nil, err
+	}
+	return h.send(c, req, int64(len(b)))
+}
+
+const (
+	// defaultMaxResponseBytes is the default limit of the size of a
+	// response to a GET request.
+	defaultMaxResponseBytes = 16 << 20
+	// maxErrorResponseBytes limits how much of a peer's response to a
+	// failed delivery is kept for the returned error.
+	maxErrorResponseBytes = 4096
+)
+
+// responseBufferPool holds the buffers used to read peer responses to failed
+// deliveries, so a large fan-out to a misbehaving peer does not allocate one
+// buffer per recipient.
+var responseBufferPool = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+// privateIPNets are the IP networks that are not publicly routable, beyond the
+// loopback, link-local, and unspecified ones known to the net package.
+var privateIPNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{
+		"10.0.0.0/8",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"100.64.0.0/10",
+		"fc00::/7",
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}()
+
+// isPrivateIP determines whether the IP address is not publicly routable.
+func isPrivateIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return true
+	}
+	for _, n := range privateIPNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // HttpClient sends http requests, and is an abstraction only needed by the
 // HttpSigTransport. The standard library's Client satisfies this interface.
 type HttpClient interface {
@@ -0,0 +1,565 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+// httpClientFunc is an HttpClient that calls itself for every request.
+type httpClientFunc func(req *http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newResponse creates a response with the status code and an empty body.
+func newResponse(code int) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Status:     http.StatusText(code),
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+	}
+}
+
+func TestHttpSigTransportDereference(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newSigner := func(t *testing.T) httpsig.Signer {
+		s, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	// setupFn returns a transport whose client responds to signed requests
+	// with signedCode and to unsigned requests with unsignedCode, recording
+	// whether each request was signed.
+	setupFn := func(t *testing.T, ctl *gomock.Controller, signedCode, unsignedCode int) (tp *HttpSigTransport, signed *[]bool) {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		signed = &[]bool{}
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if len(req.Header.Get("Digest")) > 0 {
+				t.Fatalf("GET request has a Digest header")
+			}
+			isSigned := len(req.Header.Get("Signature")) > 0
+			*signed = append(*signed, isSigned)
+			if isSigned {
+				return newResponse(signedCode), nil
+			}
+			return newResponse(unsignedCode), nil
+		})
+		tp = NewHttpSigTransport(client, "test", clock, newSigner(t), newSigner(t), testPersonIRI+"#main-key", privKey)
+		return
+	}
+	t.Run("SignsByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, signed := setupFn(t, ctl, http.StatusOK, http.StatusUnauthorized)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*signed), fmt.Sprint([]bool{true}))
+	})
+	t.Run("DoesNotSignForHost", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, signed := setupFn(t, ctl, http.StatusUnauthorized, http.StatusOK)
+		tp.SetHostGetSigning(mustParse(testNoteId1).Host, DoNotSignGet)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*signed), fmt.Sprint([]bool{false}))
+	})
+	t.Run("ContextOverridesHost", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, signed := setupFn(t, ctl, http.StatusOK, http.StatusUnauthorized)
+		tp.SetHostGetSigning(mustParse(testNoteId1).Host, DoNotSignGet)
+		_, err := tp.Dereference(WithGetSigning(context.Background(), SignGet), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*signed), fmt.Sprint([]bool{true}))
+	})
+	t.Run("FallsBackToUnsignedOnUnauthorized", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, signed := setupFn(t, ctl, http.StatusUnauthorized, http.StatusOK)
+		tp.SetGetSigning(SignGetWithFallback)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*signed), fmt.Sprint([]bool{true, false}))
+	})
+	t.Run("SendsAcceptHeader", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		var accepts []string
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if len(req.Header.Get("Accept-Charset")) > 0 {
+				t.Fatalf("GET request has an Accept-Charset header")
+			}
+			accepts = append(accepts, req.Header.Get(acceptHeader))
+			return newResponse(http.StatusOK), nil
+		})
+		tp := NewHttpSigTransport(client, "test", clock, newSigner(t), newSigner(t), testPersonIRI+"#main-key", privKey)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		tp.SetDereferenceAccept("application/activity+json")
+		_, err = tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(accepts), fmt.Sprint([]string{acceptHeaderValue, "application/activity+json"}))
+	})
+	t.Run("DoesNotFallBackWhenSigned", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, signed := setupFn(t, ctl, http.StatusUnauthorized, http.StatusOK)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		assertEqual(t, fmt.Sprint(*signed), fmt.Sprint([]bool{true}))
+	})
+}
+
+func TestDecodeJSONBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		input       []byte
+		expected    string
+		isErr       bool
+	}{
+		{"No Content Type", "", []byte(`{}`), `{}`, false},
+		{"ActivityStreams", "application/activity+json", []byte(`{}`), `{}`, false},
+		{"JSON-LD With Profile", `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`, []byte(`{}`), `{}`, false},
+		{"JSON With Charset", "application/json; charset=UTF-8", []byte(`{}`), `{}`, false},
+		{"Byte Order Mark", "application/activity+json", []byte("\xEF\xBB\xBF{}"), `{}`, false},
+		{"Latin-1", "application/activity+json; charset=iso-8859-1", []byte("{\"name\":\"caf\xE9\"}"), `{"name":"café"}`, false},
+		{"HTML", "text/html; charset=utf-8", []byte(`<html></html>`), "", true},
+		{"Unsupported Charset", "application/activity+json; charset=utf-16", []byte(`{}`), "", true},
+		{"Invalid Content Type", "application/", []byte(`{}`), "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := decodeJSONBody(test.contentType, test.input)
+			if test.isErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			assertEqual(t, err, nil)
+			assertEqual(t, string(b), test.expected)
+		})
+	}
+}
+
+func TestHttpSigTransportFetch(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := []byte("<html></html>")
+	setupFn := func(ctl *gomock.Controller) (tp *HttpSigTransport, accepts *[]string) {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		accepts = &[]string{}
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			*accepts = append(*accepts, req.Header.Get(acceptHeader))
+			resp := newResponse(http.StatusOK)
+			resp.Header = http.Header{contentTypeHeader: []string{"text/html"}}
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			return resp, nil
+		})
+		tp = NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+		return
+	}
+	t.Run("ReturnsBodyAndContentType", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, accepts := setupFn(ctl)
+		b, contentType, err := tp.Fetch(context.Background(), mustParse("https://example.com/page"), "text/html")
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, body)
+		assertEqual(t, contentType, "text/html")
+		assertEqual(t, fmt.Sprint(*accepts), fmt.Sprint([]string{"text/html"}))
+	})
+	t.Run("AcceptsAnythingByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, accepts := setupFn(ctl)
+		_, _, err := tp.Fetch(context.Background(), mustParse("https://example.com/page"), "")
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*accepts), fmt.Sprint([]string{"*/*"}))
+	})
+	t.Run("RejectsLargeResponses", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, _ := setupFn(ctl)
+		tp.SetMaxResponseBytes(int64(len(body) - 1))
+		_, _, err := tp.Fetch(context.Background(), mustParse("https://example.com/page"), "")
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		tp.SetMaxResponseBytes(int64(len(body)))
+		_, _, err = tp.Fetch(context.Background(), mustParse("https://example.com/page"), "")
+		assertEqual(t, err, nil)
+	})
+	for _, iri := range []string{
+		"https://localhost/page",
+		"http://127.0.0.1:8080/page",
+		"http://10.1.2.3/page",
+		"http://192.168.1.1/page",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/page",
+		"http://[fd00::1]/page",
+		"file:///etc/passwd",
+	} {
+		t.Run("RejectsPrivateDestination "+iri, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			tp, accepts := setupFn(ctl)
+			_, _, err := tp.Fetch(context.Background(), mustParse(iri), "")
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			assertEqual(t, len(*accepts), 0)
+		})
+	}
+	t.Run("AllowsPrivateAddresses", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, _ := setupFn(ctl)
+		tp.SetAllowPrivateAddresses(true)
+		_, _, err := tp.Fetch(context.Background(), mustParse("http://127.0.0.1:8080/page"), "")
+		assertEqual(t, err, nil)
+	})
+}
+
+// memorySignerPreferenceStore is a SignerPreferenceStore that keeps the
+// preferences in memory.
+type memorySignerPreferenceStore map[string]string
+
+func (m memorySignerPreferenceStore) GetSignerPreference(c context.Context, host string) (string, error) {
+	return m[host], nil
+}
+
+func (m memorySignerPreferenceStore) SetSignerPreference(c context.Context, host, name string) error {
+	m[host] = name
+	return nil
+}
+
+func TestHttpSigTransportSignerNegotiation(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newConfig := func(t *testing.T, name string, headers ...string) SignerConfig {
+		getSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, headers, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, append(headers, "digest"), httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return SignerConfig{Name: name, GetSigner: getSigner, PostSigner: postSigner}
+	}
+	// setupFn returns a transport trying a "full" and then a "minimal"
+	// config, whose client only accepts requests signed by the "minimal"
+	// one, recording whether each request was accepted.
+	setupFn := func(t *testing.T, ctl *gomock.Controller, store SignerPreferenceStore) (tp *HttpSigTransport, accepted *[]bool) {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		accepted = &[]bool{}
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			isMinimal := !strings.Contains(req.Header.Get("Signature"), "(request-target)")
+			*accepted = append(*accepted, isMinimal)
+			if isMinimal {
+				return newResponse(http.StatusOK), nil
+			}
+			return newResponse(http.StatusUnauthorized), nil
+		})
+		full := newConfig(t, "full", "(request-target)", "date", "host")
+		tp = NewHttpSigTransport(client, "test", clock, full.GetSigner, full.PostSigner, testPersonIRI+"#main-key", privKey)
+		err := tp.SetSignerConfigs(store, full, newConfig(t, "minimal", "date"))
+		assertEqual(t, err, nil)
+		return
+	}
+	t.Run("RemembersAcceptedConfigForDeliver", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		store := memorySignerPreferenceStore{}
+		tp, accepted := setupFn(t, ctl, store)
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		err = tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*accepted), fmt.Sprint([]bool{false, true, true}))
+		assertEqual(t, store[mustParse(testFederatedActorIRI).Host], "minimal")
+	})
+	t.Run("RemembersAcceptedConfigForDereference", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, accepted := setupFn(t, ctl, nil)
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*accepted), fmt.Sprint([]bool{false, true, true}))
+	})
+	t.Run("UsesStoredPreference", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		store := memorySignerPreferenceStore{mustParse(testFederatedActorIRI).Host: "minimal"}
+		tp, accepted := setupFn(t, ctl, store)
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, fmt.Sprint(*accepted), fmt.Sprint([]bool{true}))
+	})
+	t.Run("RejectsDuplicateNames", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, _ := setupFn(t, ctl, nil)
+		c := newConfig(t, "same", "date")
+		err := tp.SetSignerConfigs(nil, c, c)
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
+
+func TestHttpSigTransportTimeouts(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// setupFn returns a transport whose requests to the stalled host only
+	// end once their context is done.
+	setupFn := func(ctl *gomock.Controller) *HttpSigTransport {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Host != "stalled.example.com" {
+				return newResponse(http.StatusOK), nil
+			}
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		})
+		return NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+	}
+	t.Run("PropagatesCancellation", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		c, cancel := context.WithCancel(context.Background())
+		go cancel()
+		err := tp.Deliver(c, []byte("{}"), mustParse("https://stalled.example.com/inbox"))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("TimesOutRequests", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		tp.SetRequestTimeout(10 * time.Millisecond)
+		_, err := tp.Dereference(context.Background(), mustParse("https://stalled.example.com/note"))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("TimesOutBatchDeliveries", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		tp.SetBatchDeliverTimeout(10 * time.Millisecond)
+		outcomes := tp.BatchDeliverWithReport(context.Background(), []byte("{}"), []*url.URL{
+			mustParse(testFederatedActorIRI),
+			mustParse("https://stalled.example.com/inbox"),
+		})
+		assertEqual(t, outcomes[0].Error, "")
+		assertNotEqual(t, outcomes[1].Error, "")
+	})
+}
+
+func TestHttpSigTransportHeaders(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// setupFn returns a transport recording the headers of each request.
+	setupFn := func(ctl *gomock.Controller) (tp *HttpSigTransport, headers *[]http.Header) {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		headers = &[]http.Header{}
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			*headers = append(*headers, req.Header)
+			return newResponse(http.StatusOK), nil
+		})
+		tp = NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+		return
+	}
+	t.Run("SendsDetailedUserAgentByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, headers := setupFn(ctl)
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, (*headers)[0].Get("User-Agent"), "test "+goFedUserAgent())
+		assertEqual(t, (*headers)[0].Get("Accept-Charset"), "utf-8")
+	})
+	t.Run("SendsConfiguredUserAgent", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, headers := setupFn(ctl)
+		tp.SetUserAgent(UserAgent{Application: "myapp/1.2", ContactURL: "https://example.com/about"})
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, (*headers)[0].Get("User-Agent"), "myapp/1.2 (go-fed/activity "+version+"; +https://example.com/about)")
+	})
+	t.Run("SendsDigestOfEachAlgorithm", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, headers := setupFn(ctl)
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, (*headers)[0].Get("Digest"), "SHA-256=RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o=")
+		assertNotEqual(t, tp.SetDigestAlgorithms(DigestAlgorithm("MD5")), nil)
+		assertEqual(t, tp.SetDigestAlgorithms(DigestSHA256, DigestSHA512), nil)
+		err = tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, (*headers)[1].Get("Digest"), "SHA-256=RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o=,"+
+			"SHA-512=J8dGcK23UHX60FjVzq97IMTneGyDuuijL2Jvl4KvNMmjPCBG72D9Knh403jin+yFGAa72aZ4ePOp8c2kgwdj/Q==")
+	})
+	t.Run("PrivacyModeNormalizesHeaders", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, headers := setupFn(ctl)
+		tp.SetPrivacyMode(true)
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, (*headers)[0].Get("User-Agent"), privacyUserAgent)
+		assertEqual(t, len((*headers)[0]["Accept-Charset"]), 0)
+		assertEqual(t, len((*headers)[0].Get("Signature")) > 0, true)
+	})
+	t.Run("AddsExtraHeaders", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, headers := setupFn(ctl)
+		tp.SetExtraHeaders(http.Header{"X-Contact": []string{"admin@example.com"}, "Date": []string{"ignored"}})
+		_, err := tp.Dereference(context.Background(), mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, (*headers)[0].Get("X-Contact"), "admin@example.com")
+		assertEqual(t, len((*headers)[0]["Date"]), 1)
+	})
+	t.Run("AllowListRemovesOtherHeaders", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, headers := setupFn(ctl)
+		tp.SetExtraHeaders(http.Header{"X-Contact": []string{"admin@example.com"}})
+		tp.SetHeaderAllowList("x-contact")
+		err := tp.Deliver(context.Background(), []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		h := (*headers)[0]
+		assertEqual(t, h.Get("X-Contact"), "admin@example.com")
+		assertEqual(t, h.Get("User-Agent"), "")
+		assertEqual(t, len(h["Accept-Charset"]), 0)
+		assertEqual(t, len(h.Get("Digest")) > 0, true)
+		assertEqual(t, len(h.Get("Signature")) > 0, true)
+	})
+}
+
+func TestHttpSigTransportDereferenceRediscover(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{"(request-target)", "date", "host"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlIRI := "https://other.example.com/@dakota"
+	// setupFn returns a transport whose client serves HTML at htmlIRI, the
+	// webfinger JRD, and an actor at testFederatedActorIRI.
+	setupFn := func(ctl *gomock.Controller, jrd string) (tp *HttpSigTransport, paths *[]string) {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+		paths = &[]string{}
+		client := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			*paths = append(*paths, req.URL.Path)
+			resp := newResponse(http.StatusOK)
+			switch req.URL.String() {
+			case htmlIRI:
+				resp.Header = http.Header{contentTypeHeader: []string{"text/html; charset=utf-8"}}
+				resp.Body = ioutil.NopCloser(strings.NewReader("<html></html>"))
+			case webfingerIRI(mustParse(htmlIRI), htmlIRI).String():
+				resp.Header = http.Header{contentTypeHeader: []string{"application/jrd+json"}}
+				resp.Body = ioutil.NopCloser(strings.NewReader(jrd))
+			case testFederatedActorIRI:
+				resp.Header = http.Header{contentTypeHeader: []string{"application/activity+json"}}
+				resp.Body = ioutil.NopCloser(strings.NewReader(`{"type":"Person"}`))
+			default:
+				return newResponse(http.StatusNotFound), nil
+			}
+			return resp, nil
+		})
+		tp = NewHttpSigTransport(client, "test", clock, signer, signer, testPersonIRI+"#main-key", privKey)
+		return
+	}
+	selfJRD := `{"subject":"acct:dakota@other.example.com","links":[{"rel":"http://webfinger.net/rel/profile-page","type":"text/html","href":"` + htmlIRI + `"},{"rel":"self","type":"application/activity+json","href":"` + testFederatedActorIRI + `"}]}`
+	t.Run("RediscoversActivityStreamsIRI", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, paths := setupFn(ctl, selfJRD)
+		b, actual, err := tp.DereferenceRediscover(context.Background(), mustParse(htmlIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), `{"type":"Person"}`)
+		assertEqual(t, actual.String(), testFederatedActorIRI)
+		assertEqual(t, fmt.Sprint(*paths), fmt.Sprint([]string{"/@dakota", webfingerPath, "/dakota"}))
+	})
+	t.Run("DereferenceDoesNotRediscoverByDefault", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, _ := setupFn(ctl, selfJRD)
+		_, err := tp.Dereference(context.Background(), mustParse(htmlIRI))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		tp.SetRediscoverHTML(true)
+		b, err := tp.Dereference(context.Background(), mustParse(htmlIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), `{"type":"Person"}`)
+	})
+	t.Run("DoesNotFollowSelfLinkToSameIRI", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, paths := setupFn(ctl, `{"links":[{"rel":"self","type":"application/activity+json","href":"`+htmlIRI+`"}]}`)
+		_, _, err := tp.DereferenceRediscover(context.Background(), mustParse(htmlIRI))
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		assertEqual(t, len(*paths), 2)
+	})
+}
@@ -0,0 +1,315 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// undoSideEffects reverses the changes the activities undone by the Undo made
+// to the collections of this server:
+//
+// An undone Follow of this actor removes its actors from the followers. An
+// undone Accept of a Follow by this actor removes its actors from the
+// following. An undone Like or Announce is removed from the "likes" or
+// "shares" collections of the objects owned by this server. An undone Block is
+// passed to the Unblock callback, if any, to lift it.
+//
+// Activities referenced by IRI are found in the Database, and ignored if it
+// does not have them. Each must have been by one of the actors of the Undo.
+//
+// The embedded copies of Likes and Announces are not trusted, as a peer could
+// embed the id of another actor's activity: the activity stored under the id
+// is undone instead, and only if it is of the same type and by one of the
+// actors of the Undo. Copies without a stored activity are ignored.
+func (w FederatingWrappedCallbacks) undoSideEffects(c context.Context, a vocab.ActivityStreamsUndo) error {
+	actors := make(map[string]bool)
+	if ap := a.GetActivityStreamsActor(); ap != nil {
+		for iter := ap.Begin(); iter != ap.End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			actors[id.String()] = true
+		}
+	}
+	op := a.GetActivityStreamsObject()
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil && iter.IsIRI() {
+			var err error
+			if t, err = w.getIfExists(c, iter.GetIRI()); err != nil {
+				return err
+			}
+		}
+		if t == nil {
+			continue
+		}
+		switch t.(type) {
+		case vocab.ActivityStreamsLike, vocab.ActivityStreamsAnnounce:
+			if iter.GetType() == nil {
+				break
+			}
+			stored, err := w.storedCopy(c, t)
+			if err != nil {
+				return err
+			} else if stored == nil || !hasActorIn(stored, actors) {
+				continue
+			}
+			t = stored
+		}
+		if !hasActorIn(t, actors) {
+			return fmt.Errorf("undo actor does not match the actor of the undone %s", t.GetTypeName())
+		}
+		var err error
+		switch v := t.(type) {
+		case vocab.ActivityStreamsFollow:
+			err = w.undoFollow(c, v)
+		case vocab.ActivityStreamsAccept:
+			err = w.undoAccept(c, v)
+		case vocab.ActivityStreamsLike:
+			err = w.undoLike(c, v)
+		case vocab.ActivityStreamsAnnounce:
+			err = w.undoAnnounce(c, v)
+		case vocab.ActivityStreamsBlock:
+			if w.Unblock != nil {
+				err = w.Unblock(c, v)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storedCopy returns the activity the Database holds under the id of the
+// embedded one, or nil if it holds none of the same type.
+func (w FederatingWrappedCallbacks) storedCopy(c context.Context, t vocab.Type) (vocab.Type, error) {
+	id, err := GetId(t)
+	if err != nil {
+		return nil, nil
+	}
+	stored, err := w.getIfExists(c, id)
+	if err != nil || stored == nil || stored.GetTypeName() != t.GetTypeName() {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// undoFollow removes the actors of the Follow from the followers of this
+// actor, if it is the one they followed.
+func (w FederatingWrappedCallbacks) undoFollow(c context.Context, follow vocab.ActivityStreamsFollow) error {
+	actorIRI, err := w.inboxActor(c)
+	if err != nil {
+		return err
+	}
+	if !hasObject(follow.GetActivityStreamsObject(), actorIRI) {
+		return nil
+	}
+	if err := w.db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer w.db.Unlock(c, actorIRI)
+	followers, err := w.db.Followers(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	if removeItems(followers.GetActivityStreamsItems(), actorIds(follow)) == 0 {
+		return nil
+	}
+	return w.db.Update(c, followers)
+}
+
+// undoAccept removes the actors of the Accept from the following of this
+// actor, if they accepted one of its Follows.
+func (w FederatingWrappedCallbacks) undoAccept(c context.Context, accept vocab.ActivityStreamsAccept) error {
+	actorIRI, err := w.inboxActor(c)
+	if err != nil {
+		return err
+	}
+	if follow, err := w.followBy(c, accept.GetActivityStreamsObject(), actorIRI); err != nil || follow == nil {
+		return err
+	}
+	return w.removeFollowing(c, actorIRI, actorIds(accept))
+}
+
+// followBy returns the first Follow by the actor among the objects, found in
+// the Database if referenced by IRI, or nil if there is none.
+func (w FederatingWrappedCallbacks) followBy(c context.Context, op vocab.ActivityStreamsObjectProperty, actorIRI *url.URL) (vocab.ActivityStreamsFollow, error) {
+	if op == nil {
+		return nil, nil
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil && iter.IsIRI() {
+			var err error
+			if t, err = w.getIfExists(c, iter.GetIRI()); err != nil {
+				return nil, err
+			}
+		}
+		if follow, ok := t.(vocab.ActivityStreamsFollow); ok && hasActorIn(follow, map[string]bool{actorIRI.String(): true}) {
+			return follow, nil
+		}
+	}
+	return nil, nil
+}
+
+// removeFollowing removes the ids from the following of the actor.
+func (w FederatingWrappedCallbacks) removeFollowing(c context.Context, actorIRI *url.URL, ids map[string]bool) error {
+	if err := w.db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer w.db.Unlock(c, actorIRI)
+	following, err := w.db.Following(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	if removeItems(following.GetActivityStreamsItems(), ids) == 0 {
+		return nil
+	}
+	return w.db.Update(c, following)
+}
+
+// undoAnnounce removes the Announce from the "shares" collections of its
+// objects owned by this server.
+func (w FederatingWrappedCallbacks) undoAnnounce(c context.Context, announce vocab.ActivityStreamsAnnounce) error {
+	return w.removeFromObjects(c, announce, func(t vocab.Type) vocab.Type {
+		if s, ok := t.(shareser); ok && s.GetActivityStreamsShares() != nil {
+			return s.GetActivityStreamsShares().GetType()
+		}
+		return nil
+	}, false)
+}
+
+// removeFromObjects removes the activity from a collection of each of its
+// objects owned by this server, as returned by the collection function, and
+// recounts their items if count is set.
+func (w FederatingWrappedCallbacks) removeFromObjects(c context.Context, a Activity, collection func(vocab.Type) vocab.Type, count bool) error {
+	id, err := GetId(a)
+	if err != nil {
+		return err
+	}
+	o, ok := a.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return nil
+	}
+	op := o.GetActivityStreamsObject()
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		objId, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		if err = w.removeFromObject(c, objId, id, collection, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeFromObject removes the id from a collection of the object, if this
+// server owns it.
+func (w FederatingWrappedCallbacks) removeFromObject(c context.Context, objId, id *url.URL, collection func(vocab.Type) vocab.Type, count bool) error {
+	if err := w.db.Lock(c, objId); err != nil {
+		return err
+	}
+	defer w.db.Unlock(c, objId)
+	if owns, err := w.db.Owns(c, objId); err != nil {
+		return err
+	} else if !owns {
+		return nil
+	}
+	t, err := w.db.Get(c, objId)
+	if err != nil {
+		return err
+	}
+	colT := collection(t)
+	if colT == nil {
+		return nil
+	}
+	ids := map[string]bool{id.String(): true}
+	removed := 0
+	if col, ok := colT.(itemser); ok {
+		removed = removeItems(col.GetActivityStreamsItems(), ids)
+	} else if oCol, ok := colT.(orderedItemser); ok {
+		removed = removeOrderedItems(oCol.GetActivityStreamsOrderedItems(), ids)
+	} else {
+		return fmt.Errorf("collection type is neither a Collection nor an OrderedCollection: %T", colT)
+	}
+	if removed == 0 {
+		return nil
+	}
+	if count {
+		addTotalItems(colT, -removed)
+	}
+	return w.db.Update(c, t)
+}
+
+// inboxActor returns the id of the actor of the inbox.
+func (w FederatingWrappedCallbacks) inboxActor(c context.Context) (*url.URL, error) {
+	if err := w.db.Lock(c, w.inboxIRI); err != nil {
+		return nil, err
+	}
+	defer w.db.Unlock(c, w.inboxIRI)
+	return w.db.ActorForInbox(c, w.inboxIRI)
+}
+
+// actorIds returns the set of the ids of the actors of the activity.
+func actorIds(a actorer) map[string]bool {
+	ids := make(map[string]bool)
+	if ap := a.GetActivityStreamsActor(); ap != nil {
+		for iter := ap.Begin(); iter != ap.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				ids[id.String()] = true
+			}
+		}
+	}
+	return ids
+}
+
+// hasObject determines whether the id is among the objects.
+func hasObject(op vocab.ActivityStreamsObjectProperty, id *url.URL) bool {
+	if op == nil {
+		return false
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if objId, err := ToId(iter); err == nil && objId.String() == id.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// removeItems removes the items whose ids are in the set, returning how many
+// were.
+func removeItems(items vocab.ActivityStreamsItemsProperty, ids map[string]bool) int {
+	if items == nil {
+		return 0
+	}
+	removed := 0
+	for i := items.Len() - 1; i >= 0; i-- {
+		if id, err := ToId(items.At(i)); err == nil && ids[id.String()] {
+			items.Remove(i)
+			removed++
+		}
+	}
+	return removed
+}
+
+// removeOrderedItems removes the ordered items whose ids are in the set,
+// returning how many were.
+func removeOrderedItems(oItems vocab.ActivityStreamsOrderedItemsProperty, ids map[string]bool) int {
+	if oItems == nil {
+		return 0
+	}
+	removed := 0
+	for i := oItems.Len() - 1; i >= 0; i-- {
+		if id, err := ToId(oItems.At(i)); err == nil && ids[id.String()] {
+			oItems.Remove(i)
+			removed++
+		}
+	}
+	return removed
+}
@@ -0,0 +1,189 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+func TestUndoSideEffects(t *testing.T) {
+	ctx := context.Background()
+	const followersIRI = "https://maybe.example.com/person/followers"
+	const followingIRI = "https://maybe.example.com/person/following"
+	activity := func(a Activity, id, actor string, objs ...string) {
+		a.SetActivityStreamsId(newIdProperty(mustParse(id)))
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(actor))
+		a.SetActivityStreamsActor(ap)
+		if o, ok := a.(interface {
+			SetActivityStreamsObject(vocab.ActivityStreamsObjectProperty)
+		}); ok && len(objs) > 0 {
+			op := streams.NewActivityStreamsObjectProperty()
+			for _, obj := range objs {
+				op.AppendIRI(mustParse(obj))
+			}
+			o.SetActivityStreamsObject(op)
+		}
+	}
+	undoOf := func(obj vocab.Type) vocab.ActivityStreamsUndo {
+		u := streams.NewActivityStreamsUndo()
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(mustParse(testFederatedActorIRI))
+		u.SetActivityStreamsActor(ap)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendType(obj)
+		u.SetActivityStreamsObject(op)
+		return u
+	}
+	collection := func(id string, items ...string) vocab.ActivityStreamsCollection {
+		col := newItemsCollection(items...)
+		col.SetActivityStreamsId(newIdProperty(mustParse(id)))
+		return col
+	}
+	expectStored := func(db *MockDatabase, id string, t vocab.Type) {
+		db.EXPECT().Lock(ctx, mustParse(id))
+		db.EXPECT().Exists(ctx, mustParse(id)).Return(t != nil, nil)
+		if t != nil {
+			db.EXPECT().Get(ctx, mustParse(id)).Return(t, nil)
+		}
+		db.EXPECT().Unlock(ctx, mustParse(id))
+	}
+	expectInboxActor := func(db *MockDatabase) {
+		db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testPersonIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+	}
+	t.Run("RemovesFollower", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		follow := streams.NewActivityStreamsFollow()
+		activity(follow, testFederatedActivityIRI, testFederatedActorIRI, testPersonIRI)
+		expectInboxActor(db)
+		db.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		db.EXPECT().Followers(ctx, mustParse(testPersonIRI)).Return(
+			collection(followersIRI, testFederatedActorIRI2, testFederatedActorIRI), nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, fmt.Sprint(itemIds(v)), "["+testFederatedActorIRI2+"]")
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertEqual(t, w.undoSideEffects(ctx, undoOf(follow)), nil)
+	})
+	t.Run("IgnoresFollowOfOthers", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		follow := streams.NewActivityStreamsFollow()
+		activity(follow, testFederatedActivityIRI, testFederatedActorIRI, testFederatedActorIRI2)
+		expectInboxActor(db)
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertEqual(t, w.undoSideEffects(ctx, undoOf(follow)), nil)
+	})
+	t.Run("RemovesFollowing", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		follow := streams.NewActivityStreamsFollow()
+		activity(follow, testNewActivityIRI, testPersonIRI, testFederatedActorIRI)
+		accept := streams.NewActivityStreamsAccept()
+		activity(accept, testFederatedActivityIRI, testFederatedActorIRI)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsFollow(follow)
+		accept.SetActivityStreamsObject(op)
+		expectInboxActor(db)
+		db.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(
+			collection(followingIRI, testFederatedActorIRI), nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			assertEqual(t, len(itemIds(v)), 0)
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertEqual(t, w.undoSideEffects(ctx, undoOf(accept)), nil)
+	})
+	t.Run("RemovesShare", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		announce := streams.NewActivityStreamsAnnounce()
+		activity(announce, testFederatedActivityIRI, testFederatedActorIRI, testNoteId1)
+		note := streams.NewActivityStreamsNote()
+		note.SetActivityStreamsId(newIdProperty(mustParse(testNoteId1)))
+		shares := streams.NewActivityStreamsSharesProperty()
+		shares.SetActivityStreamsOrderedCollection(streams.NewActivityStreamsOrderedCollection())
+		oItems := streams.NewActivityStreamsOrderedItemsProperty()
+		oItems.AppendIRI(mustParse(testFederatedActivityIRI))
+		oItems.AppendIRI(mustParse(testFederatedActivityIRI2))
+		shares.GetActivityStreamsOrderedCollection().SetActivityStreamsOrderedItems(oItems)
+		note.SetActivityStreamsShares(shares)
+		expectStored(db, testFederatedActivityIRI, announce)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(note, nil)
+		db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, v vocab.Type) error {
+			oc := v.(vocab.ActivityStreamsNote).GetActivityStreamsShares().GetActivityStreamsOrderedCollection()
+			assertEqual(t, oc.GetActivityStreamsOrderedItems().Len(), 1)
+			assertEqual(t, oc.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), testFederatedActivityIRI2)
+			return nil
+		})
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertEqual(t, w.undoSideEffects(ctx, undoOf(announce)), nil)
+	})
+	t.Run("IgnoresEmbeddedCopyOfOtherActorsLike", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		stored := streams.NewActivityStreamsLike()
+		activity(stored, testFederatedActivityIRI, testFederatedActorIRI2, testNoteId1)
+		forged := streams.NewActivityStreamsLike()
+		activity(forged, testFederatedActivityIRI, testFederatedActorIRI, testNoteId1)
+		expectStored(db, testFederatedActivityIRI, stored)
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertEqual(t, w.undoSideEffects(ctx, undoOf(forged)), nil)
+	})
+	t.Run("IgnoresEmbeddedCopyNotStored", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		announce := streams.NewActivityStreamsAnnounce()
+		activity(announce, testFederatedActivityIRI, testFederatedActorIRI, testNoteId1)
+		expectStored(db, testFederatedActivityIRI, nil)
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertEqual(t, w.undoSideEffects(ctx, undoOf(announce)), nil)
+	})
+	t.Run("ErrorIfStoredActivityOfOtherActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		like := streams.NewActivityStreamsLike()
+		activity(like, testFederatedActivityIRI, testFederatedActorIRI2, testNoteId1)
+		db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI))
+		db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testFederatedActivityIRI)).Return(like, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI))
+		u := undoOf(streams.NewActivityStreamsNote())
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActivityIRI))
+		u.SetActivityStreamsObject(op)
+		w := FederatingWrappedCallbacks{db: db, inboxIRI: mustParse(testMyInboxIRI)}
+		assertNotEqual(t, w.undoSideEffects(ctx, u), nil)
+	})
+}
+
+// itemIds returns the ids of the items of the Collection.
+func itemIds(v vocab.Type) []string {
+	var ids []string
+	items := v.(vocab.ActivityStreamsCollection).GetActivityStreamsItems()
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		ids = append(ids, iter.GetIRI().String())
+	}
+	return ids
+}
@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"mime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +28,14 @@ var (
 	// set. Can be returned by DelegateActor's PostInbox or PostOutbox so a
 	// Bad Request response is set.
 	ErrTargetRequired = errors.New("target property required on the provided activity")
+	// ErrObjectNotFound indicates an object of the activity is an IRI that
+	// is neither in the Database nor served by its owner. Can be returned
+	// by DelegateActor's PostOutbox so a Bad Request response is set.
+	ErrObjectNotFound = errors.New("object IRI of the provided activity not found")
+	// ErrInteractionRejected indicates the activity interacts with an
+	// object in a way its interaction policy does not permit. Can be
+	// returned by DelegateActor's PostInbox so a Forbidden response is set.
+	ErrInteractionRejected = errors.New("interaction not permitted by the interaction policy of the object")
 )
 
 // activityStreamsMediaTypes contains all of the accepted ActivityStreams media
@@ -130,6 +140,13 @@ const (
 	jsonLDContext = "@context"
 )
 
+// Serialize serializes the value into a map ready to be marshaled to JSON, with
+// the JSON-LD @context of the vocabularies it uses, as the library does for the
+// payloads it sends.
+func Serialize(a vocab.Type) (map[string]interface{}, error) {
+	return serialize(a)
+}
+
 // addJSONLDContext adds the context vocabularies contained within the type
 // into the JSON-LD @context field, and aliases them appropriately.
 //
@@ -165,8 +182,10 @@ func serialize(a vocab.Type) (m map[string]interface{}, e error) {
 		}
 		contextValue = append(arr, aliases)
 	}
-	// TODO: Update the context instead if it already exists
-	m[jsonLDContext] = contextValue
+	// Keep the vocabularies and terms of the contexts the value was
+	// deserialized with, which its unknown properties still hold, so its
+	// extension properties remain defined when relayed.
+	m[jsonLDContext] = extendContext(contextValue, originalContexts(m))
 	// Delete any existing `@context` in child maps.
 	var cleanFnRecur func(map[string]interface{})
 	cleanFnRecur = func(r map[string]interface{}) {
@@ -184,10 +203,18 @@ func serialize(a vocab.Type) (m map[string]interface{}, e error) {
 const (
 	// The Location header
 	locationHeader = "Location"
+	// The profile of the ActivityStreams JSON-LD media type.
+	activityStreamsProfile = "https://www.w3.org/ns/activitystreams"
 	// Contains the ActivityStreams Content-Type value.
-	contentTypeHeaderValue = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
+	contentTypeHeaderValue = "application/ld+json; profile=\"" + activityStreamsProfile + "\""
 	// The Date header.
 	dateHeader = "Date"
+	// The Vary header.
+	varyHeader = "Vary"
+	// The Cache-Control header.
+	cacheControlHeader = "Cache-Control"
+	// The Signature header of HTTP Signatures.
+	signatureHeader = "Signature"
 	// The Digest header.
 	digestHeader = "Digest"
 	// The delimiter used in the Digest header.
@@ -196,12 +223,89 @@ const (
 	sha256Digest = "SHA-256"
 )
 
-// addResponseHeaders sets headers needed in the HTTP response, such but not
-// limited to the Content-Type, Date, and Digest headers.
-func addResponseHeaders(h http.Header, c Clock, responseContent []byte) {
-	h.Set(contentTypeHeader, contentTypeHeaderValue)
+// responseContentTypeContextKey is the context key of a response Content-Type.
+type responseContentTypeContextKey struct{}
+
+// WithResponseContentType returns a context that determines the Content-Type of
+// the ActivityStreams responses served with it, such as
+// "application/activity+json" for clients that reject the JSON-LD media type.
+//
+// Without it, responses echo the ActivityStreams media type the client prefers
+// in its Accept header.
+func WithResponseContentType(c context.Context, contentType string) context.Context {
+	return context.WithValue(c, responseContentTypeContextKey{}, contentType)
+}
+
+// responseContentType determines the Content-Type of an ActivityStreams
+// response to the request. A Content-Type in the context takes precedence over
+// the ActivityStreams media type with the highest quality in the Accept header,
+// which takes precedence over the JSON-LD media type. Media types with a
+// quality of zero are not acceptable, so they are never chosen.
+func responseContentType(c context.Context, r *http.Request) string {
+	if ct, ok := c.Value(responseContentTypeContextKey{}).(string); ok && len(ct) > 0 {
+		return ct
+	}
+	contentType := contentTypeHeaderValue
+	best := 0.0
+	for _, accept := range r.Header[acceptHeader] {
+		for _, v := range strings.Split(accept, ",") {
+			mt, params, err := mime.ParseMediaType(v)
+			if err != nil {
+				continue
+			}
+			q := 1.0
+			if qv, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(qv, 64); err != nil {
+					continue
+				}
+			}
+			if q <= best {
+				continue
+			}
+			if mt == "application/activity+json" {
+				contentType, best = mt, q
+			} else if mt == "application/ld+json" && params["profile"] == activityStreamsProfile {
+				contentType, best = contentTypeHeaderValue, q
+			}
+		}
+	}
+	return contentType
+}
+
+// cacheControlContextKey is the context key of a response Cache-Control.
+type cacheControlContextKey struct{}
+
+// WithResponseCacheControl returns a context that determines the Cache-Control
+// header of the ActivityStreams responses served with it, such as "public,
+// max-age=300" for public objects, or "private, no-store" for collections
+// filtered for the requester.
+//
+// Without it, responses to requests with an HTTP Signature are "private", so
+// shared caches do not serve them to other requesters, and other responses
+// have no Cache-Control header.
+func WithResponseCacheControl(c context.Context, cacheControl string) context.Context {
+	return context.WithValue(c, cacheControlContextKey{}, cacheControl)
+}
+
+// addResponseHeaders sets headers needed in the HTTP response to the request,
+// such but not limited to the Content-Type, Date, and Digest headers.
+//
+// Responses vary by the Accept header, so caches do not serve ActivityStreams
+// to browsers, and by the Signature header if the request was signed.
+func addResponseHeaders(c context.Context, r *http.Request, h http.Header, clock Clock, responseContent []byte) {
+	h.Set(contentTypeHeader, responseContentType(c, r))
+	signed := len(r.Header.Get(signatureHeader)) > 0
+	h.Add(varyHeader, acceptHeader)
+	if signed {
+		h.Add(varyHeader, signatureHeader)
+	}
+	if cc, ok := c.Value(cacheControlContextKey{}).(string); ok && len(cc) > 0 {
+		h.Set(cacheControlHeader, cc)
+	} else if signed {
+		h.Set(cacheControlHeader, "private")
+	}
 	// RFC 7231 §7.1.1.2
-	h.Set(dateHeader, c.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+	h.Set(dateHeader, clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
 	// RFC 3230 and RFC 5843
 	var b bytes.Buffer
 	b.WriteString(sha256Digest)
@@ -211,6 +315,74 @@ func addResponseHeaders(h http.Header, c Clock, responseContent []byte) {
 	h.Set(digestHeader, b.String())
 }
 
+// originalContexts returns the '@context' of the serialized value and of the
+// values it embeds, as kept in their unknown properties when deserialized.
+func originalContexts(m map[string]interface{}) (ctxs []interface{}) {
+	if c, ok := m[jsonLDContext]; ok {
+		ctxs = append(ctxs, c)
+	}
+	for _, v := range m {
+		switch n := v.(type) {
+		case map[string]interface{}:
+			ctxs = append(ctxs, originalContexts(n)...)
+		case []interface{}:
+			for _, elem := range n {
+				if em, ok := elem.(map[string]interface{}); ok {
+					ctxs = append(ctxs, originalContexts(em)...)
+				}
+			}
+		}
+	}
+	return
+}
+
+// extendContext adds the vocabularies and term definitions of the original
+// contexts that the context lacks. The context is returned unchanged if it
+// lacks none.
+func extendContext(contextValue interface{}, originals []interface{}) interface{} {
+	var vocabs []interface{}
+	has := make(map[string]bool)
+	terms := make(map[string]interface{})
+	extended := false
+	var addFn func(c interface{}, original bool)
+	addFn = func(c interface{}, original bool) {
+		switch v := c.(type) {
+		case string:
+			if !has[v] {
+				has[v] = true
+				vocabs = append(vocabs, v)
+				extended = extended || original
+			}
+		case []interface{}:
+			for _, elem := range v {
+				addFn(elem, original)
+			}
+		case map[string]string:
+			for k, val := range v {
+				terms[k] = val
+			}
+		case map[string]interface{}:
+			for k, val := range v {
+				if _, ok := terms[k]; !ok {
+					terms[k] = val
+					extended = extended || original
+				}
+			}
+		}
+	}
+	addFn(contextValue, false)
+	for _, c := range originals {
+		addFn(c, true)
+	}
+	if !extended {
+		return contextValue
+	}
+	if len(terms) > 0 {
+		vocabs = append(vocabs, terms)
+	}
+	return vocabs
+}
+
 // IdProperty is a property that can readily have its id obtained
 type IdProperty interface {
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
@@ -318,11 +490,11 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 	actorProp.AppendIRI(actor)
 	c.SetActivityStreamsActor(actorProp)
 	// Published Property
-	if v, ok := o.(publisheder); ok {
+	if v, ok := o.(publisheder); ok && v.GetActivityStreamsPublished() != nil {
 		c.SetActivityStreamsPublished(v.GetActivityStreamsPublished())
 	}
 	// Copying over properties.
-	if v, ok := o.(toer); ok {
+	if v, ok := o.(toer); ok && v.GetActivityStreamsTo() != nil {
 		activityTo := streams.NewActivityStreamsToProperty()
 		to := v.GetActivityStreamsTo()
 		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
@@ -335,7 +507,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsTo(activityTo)
 	}
-	if v, ok := o.(btoer); ok {
+	if v, ok := o.(btoer); ok && v.GetActivityStreamsBto() != nil {
 		activityBto := streams.NewActivityStreamsBtoProperty()
 		bto := v.GetActivityStreamsBto()
 		for iter := bto.Begin(); iter != bto.End(); iter = iter.Next() {
@@ -348,7 +520,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsBto(activityBto)
 	}
-	if v, ok := o.(ccer); ok {
+	if v, ok := o.(ccer); ok && v.GetActivityStreamsCc() != nil {
 		activityCc := streams.NewActivityStreamsCcProperty()
 		cc := v.GetActivityStreamsCc()
 		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
@@ -361,7 +533,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsCc(activityCc)
 	}
-	if v, ok := o.(bccer); ok {
+	if v, ok := o.(bccer); ok && v.GetActivityStreamsBcc() != nil {
 		activityBcc := streams.NewActivityStreamsBccProperty()
 		bcc := v.GetActivityStreamsBcc()
 		for iter := bcc.Begin(); iter != bcc.End(); iter = iter.Next() {
@@ -374,7 +546,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsBcc(activityBcc)
 	}
-	if v, ok := o.(audiencer); ok {
+	if v, ok := o.(audiencer); ok && v.GetActivityStreamsAudience() != nil {
 		activityAudience := streams.NewActivityStreamsAudienceProperty()
 		aud := v.GetActivityStreamsAudience()
 		for iter := aud.Begin(); iter != aud.End(); iter = iter.Next() {
@@ -390,17 +562,15 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 	return
 }
 
-// filterURLs removes urls whose strings match the provided filter
-func filterURLs(u []*url.URL, fn func(s string) bool) []*url.URL {
-	i := 0
-	for i < len(u) {
-		if fn(u[i].String()) {
-			u = append(u[:i], u[i+1:]...)
-		} else {
-			i++
+// filterIRIs removes IRIs whose strings match the provided filter
+func filterIRIs(iris []*IRI, fn func(s string) bool) []*IRI {
+	out := iris[:0]
+	for _, i := range iris {
+		if !fn(i.String()) {
+			out = append(out, i)
 		}
 	}
-	return u
+	return out
 }
 
 const (
@@ -417,19 +587,6 @@ func IsPublic(s string) bool {
 	return s == PublicActivityPubIRI || s == publicJsonLD || s == publicJsonLDAS
 }
 
-// getInboxes extracts the 'inbox' IRIs from actor types.
-func getInboxes(t []vocab.Type) (u []*url.URL, err error) {
-	for _, elem := range t {
-		var iri *url.URL
-		iri, err = getInbox(elem)
-		if err != nil {
-			return
-		}
-		u = append(u, iri)
-	}
-	return
-}
-
 // getInbox extracts the 'inbox' IRI from an actor type.
 func getInbox(t vocab.Type) (u *url.URL, err error) {
 	ib, ok := t.(inboxer)
@@ -438,22 +595,24 @@ func getInbox(t vocab.Type) (u *url.URL, err error) {
 		return
 	}
 	inbox := ib.GetActivityStreamsInbox()
+	if inbox == nil {
+		err = fmt.Errorf("actor type %T has no inbox", t)
+		return
+	}
 	return ToId(inbox)
 }
 
 // dedupeIRIs will deduplicate final inbox IRIs. The ignore list is applied to
 // the final list.
-func dedupeIRIs(recipients, ignored []*url.URL) (out []*url.URL) {
-	ignoredMap := make(map[string]bool, len(ignored))
+func dedupeIRIs(recipients, ignored []*IRI) (out []*IRI) {
+	seen := make(map[string]bool, len(recipients)+len(ignored))
 	for _, elem := range ignored {
-		ignoredMap[elem.String()] = true
+		seen[elem.String()] = true
 	}
-	outMap := make(map[string]bool, len(recipients))
 	for _, k := range recipients {
-		kStr := k.String()
-		if !ignoredMap[kStr] && !outMap[kStr] {
+		if !seen[k.String()] {
 			out = append(out, k)
-			outMap[kStr] = true
+			seen[k.String()] = true
 		}
 	}
 	return
@@ -480,13 +639,22 @@ func stripHiddenRecipients(activity Activity) {
 
 // mustHaveActivityOriginMatchObjects ensures that the Host in the activity id
 // IRI matches all of the Hosts in the object id IRIs.
-func mustHaveActivityOriginMatchObjects(a Activity) error {
+//
+// Activities from the hosts allowed by the BridgeCompatibility are exempt.
+func mustHaveActivityOriginMatchObjects(a Activity, b BridgeCompatibility) error {
 	originIRI, err := GetId(a)
 	if err != nil {
 		return err
 	}
 	originHost := originIRI.Host
-	op := a.GetActivityStreamsObject()
+	if b.allowsOffOriginObjects(originHost) {
+		return nil
+	}
+	o, ok := a.(objecter)
+	if !ok {
+		return nil
+	}
+	op := o.GetActivityStreamsObject()
 	if op == nil || op.Len() == 0 {
 		return nil
 	}
@@ -502,266 +670,216 @@ func mustHaveActivityOriginMatchObjects(a Activity) error {
 	return nil
 }
 
+// recipients holds the ids found on one of the 'to', 'bto', 'cc', 'bcc', or
+// 'audience' properties of a value, along with a way to add more ids to that
+// same property.
+type recipients struct {
+	// ids are the ids on the property, in order.
+	ids []*url.URL
+	// keys are the string forms of ids, at the same indices.
+	keys []string
+	// appendIRI appends an id to the property.
+	appendIRI func(*url.URL)
+}
+
+// recipientsProperty is the part of a 'to', 'bto', 'cc', 'bcc', or 'audience'
+// property that normalizeRecipients uses.
+type recipientsProperty interface {
+	Len() int
+	AppendIRI(v *url.URL)
+}
+
+// recipientProperty knows how to obtain one kind of recipient property from
+// an ActivityStreams value.
+type recipientProperty struct {
+	// name is the name of the property.
+	name string
+	// property returns the property of the value, setting an empty one on
+	// the value if it was not set, and a function returning its element at
+	// an index. Returns false if the value cannot have the property.
+	property func(t vocab.Type) (p recipientsProperty, at func(i int) IdProperty, ok bool)
+}
+
+// recipientProperties lists every recipient property that normalizeRecipients
+// keeps consistent between an activity and its objects.
+var recipientProperties = []recipientProperty{
+	{"to", func(t vocab.Type) (recipientsProperty, func(int) IdProperty, bool) {
+		v, ok := t.(toer)
+		if !ok {
+			return nil, nil, false
+		}
+		p := v.GetActivityStreamsTo()
+		if p == nil {
+			p = streams.NewActivityStreamsToProperty()
+			v.SetActivityStreamsTo(p)
+		}
+		return p, func(i int) IdProperty { return p.At(i) }, true
+	}},
+	{"bto", func(t vocab.Type) (recipientsProperty, func(int) IdProperty, bool) {
+		v, ok := t.(btoer)
+		if !ok {
+			return nil, nil, false
+		}
+		p := v.GetActivityStreamsBto()
+		if p == nil {
+			p = streams.NewActivityStreamsBtoProperty()
+			v.SetActivityStreamsBto(p)
+		}
+		return p, func(i int) IdProperty { return p.At(i) }, true
+	}},
+	{"cc", func(t vocab.Type) (recipientsProperty, func(int) IdProperty, bool) {
+		v, ok := t.(ccer)
+		if !ok {
+			return nil, nil, false
+		}
+		p := v.GetActivityStreamsCc()
+		if p == nil {
+			p = streams.NewActivityStreamsCcProperty()
+			v.SetActivityStreamsCc(p)
+		}
+		return p, func(i int) IdProperty { return p.At(i) }, true
+	}},
+	{"bcc", func(t vocab.Type) (recipientsProperty, func(int) IdProperty, bool) {
+		v, ok := t.(bccer)
+		if !ok {
+			return nil, nil, false
+		}
+		p := v.GetActivityStreamsBcc()
+		if p == nil {
+			p = streams.NewActivityStreamsBccProperty()
+			v.SetActivityStreamsBcc(p)
+		}
+		return p, func(i int) IdProperty { return p.At(i) }, true
+	}},
+	{"audience", func(t vocab.Type) (recipientsProperty, func(int) IdProperty, bool) {
+		v, ok := t.(audiencer)
+		if !ok {
+			return nil, nil, false
+		}
+		p := v.GetActivityStreamsAudience()
+		if p == nil {
+			p = streams.NewActivityStreamsAudienceProperty()
+			v.SetActivityStreamsAudience(p)
+		}
+		return p, func(i int) IdProperty { return p.At(i) }, true
+	}},
+}
+
+// recipientsOf returns the recipients on one kind of recipient property of the
+// value, creating an empty property on the value if it was not set. Returns
+// false if the value cannot have the property.
+func recipientsOf(t vocab.Type, rp recipientProperty) (r recipients, ok bool, err error) {
+	p, at, ok := rp.property(t)
+	if !ok {
+		return
+	}
+	n := p.Len()
+	r.ids = make([]*url.URL, 0, n)
+	r.keys = make([]string, 0, n)
+	r.appendIRI = p.AppendIRI
+	for i := 0; i < n; i++ {
+		var id *url.URL
+		if id, err = ToId(at(i)); err != nil {
+			return
+		}
+		r.ids = append(r.ids, id)
+		r.keys = append(r.keys, id.String())
+	}
+	return
+}
+
 // normalizeRecipients ensures the activity and object have the same 'to',
 // 'bto', 'cc', 'bcc', and 'audience' properties. Copy the Activity's recipients
 // to objects, and the objects to the activity, but does NOT copy objects'
 // recipients to each other.
+//
+// Each recipient property is handled in a single pass over the objects, so the
+// string form of every id is computed only once and recipients shared by
+// several objects are only added to the activity once.
 func normalizeRecipients(a vocab.ActivityStreamsCreate) error {
-	// Phase 0: Acquire all recipients on the activity.
-	//
-	// Obtain the actorTo map
-	actorToMap := make(map[string]*url.URL)
-	actorTo := a.GetActivityStreamsTo()
-	if actorTo == nil {
-		actorTo = streams.NewActivityStreamsToProperty()
-		a.SetActivityStreamsTo(actorTo)
-	}
-	for iter := actorTo.Begin(); iter != actorTo.End(); iter = iter.Next() {
-		id, err := ToId(iter)
-		if err != nil {
-			return err
-		}
-		actorToMap[id.String()] = id
-	}
-	// Obtain the actorBto map
-	actorBtoMap := make(map[string]*url.URL)
-	actorBto := a.GetActivityStreamsBto()
-	if actorBto == nil {
-		actorBto = streams.NewActivityStreamsBtoProperty()
-		a.SetActivityStreamsBto(actorBto)
+	o := a.GetActivityStreamsObject()
+	objs := make([]vocab.Type, 0, o.Len())
+	for iter := o.Begin(); iter != o.End(); iter = iter.Next() {
+		objs = append(objs, iter.GetType())
 	}
-	for iter := actorBto.Begin(); iter != actorBto.End(); iter = iter.Next() {
-		id, err := ToId(iter)
-		if err != nil {
+	for _, rp := range recipientProperties {
+		if err := normalizeRecipientProperty(a, objs, rp); err != nil {
 			return err
 		}
-		actorBtoMap[id.String()] = id
 	}
-	// Obtain the actorCc map
-	actorCcMap := make(map[string]*url.URL)
-	actorCc := a.GetActivityStreamsCc()
-	if actorCc == nil {
-		actorCc = streams.NewActivityStreamsCcProperty()
-		a.SetActivityStreamsCc(actorCc)
+	return nil
+}
+
+// normalizeRecipientProperty applies normalizeRecipients for a single kind of
+// recipient property.
+func normalizeRecipientProperty(a vocab.ActivityStreamsCreate, objs []vocab.Type, rp recipientProperty) error {
+	// Acquire all recipients on the activity, once each.
+	act, _, err := recipientsOf(a, rp)
+	if err != nil {
+		return err
 	}
-	for iter := actorCc.Begin(); iter != actorCc.End(); iter = iter.Next() {
-		id, err := ToId(iter)
-		if err != nil {
-			return err
+	seen := make(map[string]bool, len(act.ids))
+	unique := make([]int, 0, len(act.ids))
+	for i, k := range act.keys {
+		if !seen[k] {
+			seen[k] = true
+			unique = append(unique, i)
 		}
-		actorCcMap[id.String()] = id
-	}
-	// Obtain the actorBcc map
-	actorBccMap := make(map[string]*url.URL)
-	actorBcc := a.GetActivityStreamsBcc()
-	if actorBcc == nil {
-		actorBcc = streams.NewActivityStreamsBccProperty()
-		a.SetActivityStreamsBcc(actorBcc)
 	}
-	for iter := actorBcc.Begin(); iter != actorBcc.End(); iter = iter.Next() {
-		id, err := ToId(iter)
+	// Recipients found on objects but not on the activity, in the order
+	// they were found.
+	var missing []*url.URL
+	for i, obj := range objs {
+		r, ok, err := recipientsOf(obj, rp)
 		if err != nil {
 			return err
-		}
-		actorBccMap[id.String()] = id
-	}
-	// Obtain the actorAudience map
-	actorAudienceMap := make(map[string]*url.URL)
-	actorAudience := a.GetActivityStreamsAudience()
-	if actorAudience == nil {
-		actorAudience = streams.NewActivityStreamsAudienceProperty()
-		a.SetActivityStreamsAudience(actorAudience)
-	}
-	for iter := actorAudience.Begin(); iter != actorAudience.End(); iter = iter.Next() {
-		id, err := ToId(iter)
-		if err != nil {
-			return err
-		}
-		actorAudienceMap[id.String()] = id
-	}
-	// Obtain the objects maps for each recipient type.
-	o := a.GetActivityStreamsObject()
-	objsTo := make([]map[string]*url.URL, o.Len())
-	objsBto := make([]map[string]*url.URL, o.Len())
-	objsCc := make([]map[string]*url.URL, o.Len())
-	objsBcc := make([]map[string]*url.URL, o.Len())
-	objsAudience := make([]map[string]*url.URL, o.Len())
-	for i := 0; i < o.Len(); i++ {
-		iter := o.At(i)
-		// Phase 1: Acquire all existing recipients on the object.
-		//
-		// Object to
-		objsTo[i] = make(map[string]*url.URL)
-		var oTo vocab.ActivityStreamsToProperty
-		if tr, ok := iter.GetType().(toer); !ok {
-			return fmt.Errorf("the Create object at %d has no 'to' property", i)
-		} else {
-			oTo = tr.GetActivityStreamsTo()
-			if oTo == nil {
-				oTo = streams.NewActivityStreamsToProperty()
-				tr.SetActivityStreamsTo(oTo)
+		} else if !ok {
+			return fmt.Errorf("the Create object at %d has no '%s' property", i, rp.name)
+		}
+		// Note every recipient on the object, and collect the ones that
+		// the activity does not yet have.
+		objSeen := make(map[string]bool, len(r.ids)+len(unique))
+		for j, id := range r.ids {
+			k := r.keys[j]
+			objSeen[k] = true
+			if !seen[k] {
+				seen[k] = true
+				missing = append(missing, id)
 			}
 		}
-		for iter := oTo.Begin(); iter != oTo.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
-			}
-			objsTo[i][id.String()] = id
-		}
-		// Object bto
-		objsBto[i] = make(map[string]*url.URL)
-		var oBto vocab.ActivityStreamsBtoProperty
-		if tr, ok := iter.GetType().(btoer); !ok {
-			return fmt.Errorf("the Create object at %d has no 'bto' property", i)
-		} else {
-			oBto = tr.GetActivityStreamsBto()
-			if oBto == nil {
-				oBto = streams.NewActivityStreamsBtoProperty()
-				tr.SetActivityStreamsBto(oBto)
-			}
-		}
-		for iter := oBto.Begin(); iter != oBto.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
-			}
-			objsBto[i][id.String()] = id
-		}
-		// Object cc
-		objsCc[i] = make(map[string]*url.URL)
-		var oCc vocab.ActivityStreamsCcProperty
-		if tr, ok := iter.GetType().(ccer); !ok {
-			return fmt.Errorf("the Create object at %d has no 'cc' property", i)
-		} else {
-			oCc = tr.GetActivityStreamsCc()
-			if oCc == nil {
-				oCc = streams.NewActivityStreamsCcProperty()
-				tr.SetActivityStreamsCc(oCc)
-			}
-		}
-		for iter := oCc.Begin(); iter != oCc.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
-			}
-			objsCc[i][id.String()] = id
-		}
-		// Object bcc
-		objsBcc[i] = make(map[string]*url.URL)
-		var oBcc vocab.ActivityStreamsBccProperty
-		if tr, ok := iter.GetType().(bccer); !ok {
-			return fmt.Errorf("the Create object at %d has no 'bcc' property", i)
-		} else {
-			oBcc = tr.GetActivityStreamsBcc()
-			if oBcc == nil {
-				oBcc = streams.NewActivityStreamsBccProperty()
-				tr.SetActivityStreamsBcc(oBcc)
-			}
-		}
-		for iter := oBcc.Begin(); iter != oBcc.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
-			}
-			objsBcc[i][id.String()] = id
-		}
-		// Object audience
-		objsAudience[i] = make(map[string]*url.URL)
-		var oAudience vocab.ActivityStreamsAudienceProperty
-		if tr, ok := iter.GetType().(audiencer); !ok {
-			return fmt.Errorf("the Create object at %d has no 'audience' property", i)
-		} else {
-			oAudience = tr.GetActivityStreamsAudience()
-			if oAudience == nil {
-				oAudience = streams.NewActivityStreamsAudienceProperty()
-				tr.SetActivityStreamsAudience(oAudience)
-			}
-		}
-		for iter := oAudience.Begin(); iter != oAudience.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
-			}
-			objsAudience[i][id.String()] = id
-		}
-		// Phase 2: Apply missing recipients to the object from the
-		// activity.
-		//
-		// Activity to -> Object to
-		for k, v := range actorToMap {
-			if _, ok := objsTo[i][k]; !ok {
-				oTo.AppendIRI(v)
-			}
-		}
-		// Activity bto -> Object bto
-		for k, v := range actorBtoMap {
-			if _, ok := objsBto[i][k]; !ok {
-				oBto.AppendIRI(v)
-			}
-		}
-		// Activity cc -> Object cc
-		for k, v := range actorCcMap {
-			if _, ok := objsCc[i][k]; !ok {
-				oCc.AppendIRI(v)
-			}
-		}
-		// Activity bcc -> Object bcc
-		for k, v := range actorBccMap {
-			if _, ok := objsBcc[i][k]; !ok {
-				oBcc.AppendIRI(v)
-			}
-		}
-		// Activity audience -> Object audience
-		for k, v := range actorAudienceMap {
-			if _, ok := objsAudience[i][k]; !ok {
-				oAudience.AppendIRI(v)
-			}
-		}
-	}
-	// Phase 3: Apply missing recipients to the activity from the objects.
-	//
-	// Object to -> Activity to
-	for i := 0; i < len(objsTo); i++ {
-		for k, v := range objsTo[i] {
-			if _, ok := actorToMap[k]; !ok {
-				actorTo.AppendIRI(v)
+		// Apply missing recipients to the object from the activity.
+		for _, j := range unique {
+			if !objSeen[act.keys[j]] {
+				r.appendIRI(act.ids[j])
 			}
 		}
 	}
-	// Object bto -> Activity bto
-	for i := 0; i < len(objsBto); i++ {
-		for k, v := range objsBto[i] {
-			if _, ok := actorBtoMap[k]; !ok {
-				actorBto.AppendIRI(v)
-			}
-		}
+	// Apply missing recipients to the activity from the objects.
+	for _, id := range missing {
+		act.appendIRI(id)
 	}
-	// Object cc -> Activity cc
-	for i := 0; i < len(objsCc); i++ {
-		for k, v := range objsCc[i] {
-			if _, ok := actorCcMap[k]; !ok {
-				actorCc.AppendIRI(v)
-			}
-		}
+	return nil
+}
+
+// bumpUpdated sets the 'updated' time of the new revision of a value to now,
+// unless it was changed from the one of the former revision.
+func bumpUpdated(former, t vocab.Type, now time.Time) {
+	u, ok := t.(updatedSetter)
+	if !ok {
+		return
 	}
-	// Object bcc -> Activity bcc
-	for i := 0; i < len(objsBcc); i++ {
-		for k, v := range objsBcc[i] {
-			if _, ok := actorBccMap[k]; !ok {
-				actorBcc.AppendIRI(v)
-			}
+	var prev time.Time
+	if f, ok := former.(updateder); ok {
+		if upd := f.GetActivityStreamsUpdated(); upd != nil && upd.IsXMLSchemaDateTime() {
+			prev = upd.Get()
 		}
 	}
-	// Object audience -> Activity audience
-	for i := 0; i < len(objsAudience); i++ {
-		for k, v := range objsAudience[i] {
-			if _, ok := actorAudienceMap[k]; !ok {
-				actorAudience.AppendIRI(v)
-			}
-		}
+	if upd := u.GetActivityStreamsUpdated(); upd != nil && upd.IsXMLSchemaDateTime() && !upd.Get().Equal(prev) {
+		return
 	}
-	return nil
+	upd := streams.NewActivityStreamsUpdatedProperty()
+	upd.Set(now)
+	u.SetActivityStreamsUpdated(upd)
 }
 
 // toTombstone creates a Tombstone object for the given ActivityStreams value.
@@ -812,7 +930,9 @@ func mustHaveActivityActorsMatchObjectActors(c context.Context,
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		t := iter.GetType()
-		if t == nil && iter.IsIRI() {
+		if t != nil {
+			// The object is embedded.
+		} else if iter.IsIRI() {
 			// Attempt to dereference the IRI instead
 			tport, err := newTransport(c, boxIRI, goFedUserAgent())
 			if err != nil {
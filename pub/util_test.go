@@ -1,7 +1,18 @@
 package pub
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
 )
 
 func TestHeaderIsActivityPubMediaType(t *testing.T) {
@@ -74,3 +85,276 @@ func TestHeaderIsActivityPubMediaType(t *testing.T) {
 		})
 	}
 }
+
+func TestResponseContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		override string
+		expected string
+	}{
+		{
+			"Plain Type",
+			"application/activity+json",
+			"",
+			"application/activity+json",
+		},
+		{
+			"With Profile",
+			"application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"",
+			"",
+			contentTypeHeaderValue,
+		},
+		{
+			"Highest Quality",
+			"application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"; q=0.9, application/activity+json",
+			"",
+			"application/activity+json",
+		},
+		{
+			"First Of Equal Quality",
+			"application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\", application/activity+json",
+			"",
+			contentTypeHeaderValue,
+		},
+		{
+			"Not Acceptable",
+			"application/activity+json; q=0",
+			"",
+			contentTypeHeaderValue,
+		},
+		{
+			"Missing Profile",
+			"application/ld+json, text/html",
+			"",
+			contentTypeHeaderValue,
+		},
+		{
+			"Overridden",
+			"application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"",
+			"application/activity+json",
+			"application/activity+json",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := context.Background()
+			if len(test.override) > 0 {
+				c = WithResponseContentType(c, test.override)
+			}
+			r := httptest.NewRequest("GET", testPersonIRI, nil)
+			r.Header.Set(acceptHeader, test.accept)
+			if actual := responseContentType(c, r); actual != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAddResponseHeaders(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(now()).AnyTimes()
+	t.Run("VariesByAccept", func(t *testing.T) {
+		r := toAPRequest(httptest.NewRequest("GET", testPersonIRI, nil))
+		h := make(http.Header)
+		addResponseHeaders(context.Background(), r, h, clock, []byte("{}"))
+		assertEqual(t, fmt.Sprint(h[varyHeader]), "[Accept]")
+		assertEqual(t, h.Get(cacheControlHeader), "")
+	})
+	t.Run("SignedRequestsArePrivate", func(t *testing.T) {
+		r := toAPRequest(httptest.NewRequest("GET", testPersonIRI, nil))
+		r.Header.Set(signatureHeader, "keyId=\"https://example.com/key\"")
+		h := make(http.Header)
+		addResponseHeaders(context.Background(), r, h, clock, []byte("{}"))
+		assertEqual(t, fmt.Sprint(h[varyHeader]), "[Accept Signature]")
+		assertEqual(t, h.Get(cacheControlHeader), "private")
+	})
+	t.Run("CacheControlFromContext", func(t *testing.T) {
+		r := toAPRequest(httptest.NewRequest("GET", testPersonIRI, nil))
+		r.Header.Set(signatureHeader, "keyId=\"https://example.com/key\"")
+		h := make(http.Header)
+		c := WithResponseCacheControl(context.Background(), "public, max-age=300")
+		addResponseHeaders(c, r, h, clock, []byte("{}"))
+		assertEqual(t, h.Get(cacheControlHeader), "public, max-age=300")
+	})
+}
+
+func TestSerializeKeepsOriginalContext(t *testing.T) {
+	ctx := context.Background()
+	t.Run("KeepsExtensionTerms", func(t *testing.T) {
+		const in = `{"@context":["https://www.w3.org/ns/activitystreams",{"sensitive":"as:sensitive"}],"type":"Create","object":{"@context":{"toot":"http://joinmastodon.org/ns#","blurhash":"toot:blurhash"},"type":"Note","sensitive":true,"blurhash":"UBL_:rOpGG-;"}}`
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(in), &m); err != nil {
+			t.Fatal(err)
+		}
+		v, err := streams.ToType(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := Serialize(v)
+		assertEqual(t, err, nil)
+		b, err := json.Marshal(s)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), `{"@context":["https://www.w3.org/ns/activitystreams",{"blurhash":"toot:blurhash","sensitive":"as:sensitive","toot":"http://joinmastodon.org/ns#"}],"object":{"blurhash":"UBL_:rOpGG-;","sensitive":true,"type":"Note"},"type":"Create"}`)
+	})
+	t.Run("UnchangedWithoutExtensions", func(t *testing.T) {
+		s, err := Serialize(streams.NewActivityStreamsNote())
+		assertEqual(t, err, nil)
+		assertEqual(t, s[jsonLDContext], "https://www.w3.org/ns/activitystreams")
+	})
+}
+
+func TestNormalizeRecipients(t *testing.T) {
+	t.Run("CopiesActivityRecipientsToObjects", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testToIRI))
+		c.SetActivityStreamsTo(to)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(streams.NewActivityStreamsNote())
+		op.AppendActivityStreamsNote(streams.NewActivityStreamsNote())
+		c.SetActivityStreamsObject(op)
+		if err := normalizeRecipients(c); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for i := 0; i < op.Len(); i++ {
+			objTo := op.At(i).GetActivityStreamsNote().GetActivityStreamsTo()
+			assertEqual(t, objTo.Len(), 1)
+			assertEqual(t, objTo.At(0).GetIRI().String(), testToIRI)
+		}
+	})
+	t.Run("CopiesRepeatedActivityRecipientOnce", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testToIRI))
+		to.AppendIRI(mustParse(testToIRI))
+		c.SetActivityStreamsTo(to)
+		n := streams.NewActivityStreamsNote()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(n)
+		c.SetActivityStreamsObject(op)
+		if err := normalizeRecipients(c); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assertEqual(t, n.GetActivityStreamsTo().Len(), 1)
+		assertEqual(t, c.GetActivityStreamsTo().Len(), 2)
+	})
+	t.Run("CopiesObjectRecipientsToActivityOnce", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		for i := 0; i < 2; i++ {
+			n := streams.NewActivityStreamsNote()
+			cc := streams.NewActivityStreamsCcProperty()
+			cc.AppendIRI(mustParse(testCcIRI))
+			n.SetActivityStreamsCc(cc)
+			op.AppendActivityStreamsNote(n)
+		}
+		c.SetActivityStreamsObject(op)
+		if err := normalizeRecipients(c); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		cc := c.GetActivityStreamsCc()
+		assertEqual(t, cc.Len(), 1)
+		assertEqual(t, cc.At(0).GetIRI().String(), testCcIRI)
+	})
+	t.Run("DoesNotCopyBetweenObjects", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		n1 := streams.NewActivityStreamsNote()
+		aud := streams.NewActivityStreamsAudienceProperty()
+		aud.AppendIRI(mustParse(testAudienceIRI))
+		n1.SetActivityStreamsAudience(aud)
+		op.AppendActivityStreamsNote(n1)
+		n2 := streams.NewActivityStreamsNote()
+		op.AppendActivityStreamsNote(n2)
+		c.SetActivityStreamsObject(op)
+		if err := normalizeRecipients(c); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assertEqual(t, c.GetActivityStreamsAudience().Len(), 1)
+		assertEqual(t, n1.GetActivityStreamsAudience().Len(), 1)
+		assertEqual(t, n2.GetActivityStreamsAudience().Len(), 0)
+	})
+	t.Run("ErrorIfObjectHasNoRecipientProperty", func(t *testing.T) {
+		c := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		c.SetActivityStreamsObject(op)
+		if err := normalizeRecipients(c); err == nil {
+			t.Fatalf("expected error")
+		}
+	})
+}
+
+// BenchmarkNormalizeRecipients measures normalizing a Create with a large
+// audience spread across the activity and its objects.
+func TestBumpUpdated(t *testing.T) {
+	before := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	newNote := func(updated time.Time) vocab.ActivityStreamsNote {
+		n := streams.NewActivityStreamsNote()
+		if !updated.IsZero() {
+			upd := streams.NewActivityStreamsUpdatedProperty()
+			upd.Set(updated)
+			n.SetActivityStreamsUpdated(upd)
+		}
+		return n
+	}
+	t.Run("SetsNowIfUnchanged", func(t *testing.T) {
+		n := newNote(before)
+		bumpUpdated(newNote(before), n, now())
+		assertEqual(t, n.GetActivityStreamsUpdated().Get().Equal(now()), true)
+	})
+	t.Run("SetsNowIfUnset", func(t *testing.T) {
+		n := newNote(time.Time{})
+		bumpUpdated(newNote(time.Time{}), n, now())
+		assertEqual(t, n.GetActivityStreamsUpdated().Get().Equal(now()), true)
+	})
+	t.Run("KeepsTimeSetByClient", func(t *testing.T) {
+		later := before.Add(time.Hour)
+		n := newNote(later)
+		bumpUpdated(newNote(before), n, now())
+		assertEqual(t, n.GetActivityStreamsUpdated().Get().Equal(later), true)
+	})
+}
+
+func BenchmarkNormalizeRecipients(b *testing.B) {
+	const nRecipients = 10000
+	const nObjects = 4
+	iris := make([]*url.URL, nRecipients)
+	for i := range iris {
+		iris[i] = mustParse(fmt.Sprintf("https://example.com/users/%d", i))
+	}
+	newCreate := func() vocab.ActivityStreamsCreate {
+		c := streams.NewActivityStreamsCreate()
+		to := streams.NewActivityStreamsToProperty()
+		cc := streams.NewActivityStreamsCcProperty()
+		for i := 0; i < nRecipients/2; i++ {
+			to.AppendIRI(iris[i])
+		}
+		c.SetActivityStreamsTo(to)
+		c.SetActivityStreamsCc(cc)
+		op := streams.NewActivityStreamsObjectProperty()
+		for j := 0; j < nObjects; j++ {
+			n := streams.NewActivityStreamsNote()
+			objCc := streams.NewActivityStreamsCcProperty()
+			for i := nRecipients / 2; i < nRecipients; i++ {
+				objCc.AppendIRI(iris[i])
+			}
+			n.SetActivityStreamsCc(objCc)
+			op.AppendActivityStreamsNote(n)
+		}
+		c.SetActivityStreamsObject(op)
+		return c
+	}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		c := newCreate()
+		b.StartTimer()
+		if err := normalizeRecipients(c); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
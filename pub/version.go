@@ -2,14 +2,52 @@ package pub
 
 import (
 	"fmt"
+	"strings"
 )
 
 const (
 	// Version string, used in the User-Agent
 	version = "v1.0.0"
+	// libraryName is the name of this library in the User-Agent.
+	libraryName = "go-fed/activity"
 )
 
 // goFedUserAgent returns the user agent string for the go-fed library.
 func goFedUserAgent() string {
-	return fmt.Sprintf("(go-fed/activity %s)", version)
+	return fmt.Sprintf("(%s %s)", libraryName, version)
+}
+
+// UserAgent composes the User-Agent header of the requests of an
+// HttpSigTransport, identifying the application making them and how to contact
+// the operator of the server, as some peers require of the software fetching
+// from them.
+type UserAgent struct {
+	// Application is the name and version of the application, such as
+	// "myapp/1.2".
+	Application string
+	// ContactURL is a page where the operators of peers can reach the
+	// operator of this server, such as "https://example.com/about".
+	ContactURL string
+	// OmitLibrary leaves the name and version of go-fed out.
+	OmitLibrary bool
+}
+
+// String composes the User-Agent header value, such as
+// "myapp/1.2 (go-fed/activity v1.0.0; +https://example.com/about)".
+func (u UserAgent) String() string {
+	var comment []string
+	if !u.OmitLibrary {
+		comment = append(comment, libraryName+" "+version)
+	}
+	if len(u.ContactURL) > 0 {
+		comment = append(comment, "+"+u.ContactURL)
+	}
+	if len(comment) == 0 {
+		return u.Application
+	}
+	s := "(" + strings.Join(comment, "; ") + ")"
+	if len(u.Application) > 0 {
+		s = u.Application + " " + s
+	}
+	return s
 }
@@ -0,0 +1,89 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+)
+
+const (
+	// webfingerPath is the path of the WebFinger endpoint, as specified by
+	// RFC 7033.
+	webfingerPath = "/.well-known/webfinger"
+	// webfingerAcceptHeaderValue is the Accept header value of WebFinger
+	// requests.
+	webfingerAcceptHeaderValue = "application/jrd+json, application/json"
+)
+
+// webfingerJRD is a JSON Resource Descriptor returned by a WebFinger endpoint.
+type webfingerJRD struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []webfingerLink `json:"links,omitempty"`
+}
+
+// webfingerLink is a link of a JSON Resource Descriptor.
+type webfingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href,omitempty"`
+}
+
+// activityStreamsSelf returns the href of the "self" link to an ActivityStreams
+// representation, if any.
+func (j webfingerJRD) activityStreamsSelf() (string, bool) {
+	for _, l := range j.Links {
+		if l.Rel != "self" || len(l.Href) == 0 {
+			continue
+		}
+		mt, _, err := mime.ParseMediaType(l.Type)
+		if err != nil {
+			continue
+		}
+		if mt == "application/activity+json" || mt == "application/ld+json" {
+			return l.Href, true
+		}
+	}
+	return "", false
+}
+
+// webfingerIRI returns the IRI of the WebFinger query for the resource on the
+// host of the IRI.
+func webfingerIRI(iri *url.URL, resource string) *url.URL {
+	return &url.URL{
+		Scheme:   iri.Scheme,
+		Host:     iri.Host,
+		Path:     webfingerPath,
+		RawQuery: url.Values{"resource": []string{resource}}.Encode(),
+	}
+}
+
+// rediscover queries the WebFinger endpoint of the IRI's host for the IRI
+// itself, returning the IRI of its ActivityStreams representation.
+//
+// Returns an error if none is found, or if it is the same IRI, so a peer cannot
+// make the transport loop.
+func (h HttpSigTransport) rediscover(c context.Context, iri *url.URL) (*url.URL, error) {
+	b, _, err := h.fetch(c, webfingerIRI(iri, iri.String()), webfingerAcceptHeaderValue)
+	if err != nil {
+		return nil, err
+	}
+	var jrd webfingerJRD
+	if err = json.Unmarshal(b, &jrd); err != nil {
+		return nil, err
+	}
+	href, ok := jrd.activityStreamsSelf()
+	if !ok {
+		return nil, fmt.Errorf("webfinger for %s has no activitystreams self link", iri.String())
+	}
+	actual, err := url.Parse(href)
+	if err != nil {
+		return nil, err
+	}
+	if actual.String() == iri.String() {
+		return nil, fmt.Errorf("webfinger for %s links to itself", iri.String())
+	}
+	return actual, nil
+}
@@ -0,0 +1,151 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// Handler serves the WebFinger endpoint of the actors of this server, looking
+// them up in the Database. It answers queries for the handles of the domain,
+// and for the actor IRIs themselves.
+//
+// It is registered at the WebFinger Path:
+//
+//	mux.Handle(webfinger.Path, &webfinger.Handler{
+//	    Domain: "example.com",
+//	    DB:     db,
+//	    ActorIRI: func(user string) *url.URL {
+//	        return &url.URL{Scheme: "https", Host: "example.com", Path: "/users/" + user}
+//	    },
+//	    User: func(actor *url.URL) (string, bool) {
+//	        return strings.TrimPrefix(actor.Path, "/users/"), strings.HasPrefix(actor.Path, "/users/")
+//	    },
+//	})
+type Handler struct {
+	// Domain is the domain of the handles of the actors.
+	Domain string
+	// DB determines whether the actors exist, and provides their profile
+	// pages.
+	DB pub.Database
+	// ActorIRI returns the IRI of the actor of the user.
+	ActorIRI func(user string) *url.URL
+	// User returns the user of the actor IRI, if it is the IRI of an
+	// actor. Optional: without it, queries for actor IRIs are not found.
+	User func(actorIRI *url.URL) (user string, ok bool)
+}
+
+// ServeHTTP responds to the query with the JRD of the actor, or with
+// http.StatusNotFound if the resource is not one of its actors.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	resource := r.URL.Query().Get("resource")
+	if len(resource) == 0 {
+		http.Error(w, "missing resource", http.StatusBadRequest)
+		return
+	}
+	jrd, found, err := h.lookup(r.Context(), resource)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !found {
+		http.NotFound(w, r)
+		return
+	}
+	b, err := json.Marshal(jrd)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	// WebFinger is queried by browsers of other origins.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		w.Write(b)
+	}
+}
+
+// lookup returns the JRD of the resource, which is either a handle or an actor
+// IRI, and whether it is one of an existing actor.
+func (h *Handler) lookup(c context.Context, resource string) (jrd JRD, found bool, err error) {
+	var user string
+	if strings.HasPrefix(resource, "acct:") || !strings.Contains(resource, ":") {
+		handle, err := ParseHandle(resource)
+		if err != nil || !strings.EqualFold(handle.Domain, h.Domain) {
+			return jrd, false, nil
+		}
+		user = handle.User
+	} else {
+		iri, err := url.Parse(resource)
+		if err != nil || h.User == nil {
+			return jrd, false, nil
+		}
+		var ok bool
+		if user, ok = h.User(iri); !ok {
+			return jrd, false, nil
+		}
+	}
+	actor := h.ActorIRI(user)
+	if actor == nil {
+		return jrd, false, nil
+	}
+	exists, err := h.DB.Exists(c, actor)
+	if err != nil || !exists {
+		return jrd, false, err
+	}
+	jrd = JRD{
+		Subject: Handle{User: user, Domain: h.Domain}.Resource(),
+		Aliases: []string{actor.String()},
+		Links: []Link{{
+			Rel:  "self",
+			Type: activityStreamsType,
+			Href: actor.String(),
+		}},
+	}
+	if page := h.profilePage(c, actor); page != nil && page.String() != actor.String() {
+		jrd.Aliases = append(jrd.Aliases, page.String())
+		jrd.Links = append(jrd.Links, Link{
+			Rel:  profilePageRel,
+			Type: "text/html",
+			Href: page.String(),
+		})
+	}
+	return jrd, true, nil
+}
+
+// urler is an actor with a url property, linking to its profile page.
+type urler interface {
+	GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+}
+
+// profilePage returns the IRI of the first url of the actor, if any.
+func (h *Handler) profilePage(c context.Context, actor *url.URL) *url.URL {
+	if err := h.DB.Lock(c, actor); err != nil {
+		return nil
+	}
+	t, err := h.DB.Get(c, actor)
+	h.DB.Unlock(c, actor)
+	if err != nil {
+		return nil
+	}
+	u, ok := t.(urler)
+	if !ok || u.GetActivityStreamsUrl() == nil {
+		return nil
+	}
+	for iter := u.GetActivityStreamsUrl().Begin(); iter != u.GetActivityStreamsUrl().End(); iter = iter.Next() {
+		if iter.IsIRI() {
+			return iter.GetIRI()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// Query returns the IRI of the WebFinger query for the handle, on the handle's
+// domain.
+func Query(h Handle) *url.URL {
+	return &url.URL{
+		Scheme:   "https",
+		Host:     h.Domain,
+		Path:     Path,
+		RawQuery: url.Values{"resource": []string{h.Resource()}}.Encode(),
+	}
+}
+
+// Resolve queries the WebFinger endpoint of the handle's domain with the
+// Transport, and returns the IRI of the handle's actor.
+func Resolve(c context.Context, t pub.Transport, handle string) (*url.URL, error) {
+	h, err := ParseHandle(handle)
+	if err != nil {
+		return nil, err
+	}
+	jrd, err := Lookup(c, t, h)
+	if err != nil {
+		return nil, err
+	}
+	href, ok := jrd.ActorIRI()
+	if !ok {
+		return nil, fmt.Errorf("webfinger response for %s has no actor link", h)
+	}
+	actor, err := url.Parse(href)
+	if err != nil {
+		return nil, err
+	} else if !actor.IsAbs() {
+		return nil, fmt.Errorf("webfinger response for %s has a relative actor link: %q", h, href)
+	}
+	return actor, nil
+}
+
+// Lookup queries the WebFinger endpoint of the handle's domain with the
+// Transport, and returns the JRD of the handle.
+func Lookup(c context.Context, t pub.Transport, h Handle) (JRD, error) {
+	var jrd JRD
+	b, _, err := t.Fetch(c, Query(h), ContentType+", application/json")
+	if err != nil {
+		return jrd, err
+	}
+	if err = json.Unmarshal(b, &jrd); err != nil {
+		return jrd, err
+	}
+	return jrd, nil
+}
@@ -0,0 +1,85 @@
+// Package webfinger discovers actors by their handles with WebFinger, as
+// specified by RFC 7033 and used by the fediverse: a Handler serves the
+// WebFinger endpoint of this server's actors, and Resolve finds the actor IRI
+// of a peer's handle.
+//
+// Handles have the form "user@domain", optionally prefixed by "@" or "acct:".
+package webfinger
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+const (
+	// Path is the path of the WebFinger endpoint.
+	Path = "/.well-known/webfinger"
+	// ContentType is the media type of WebFinger responses.
+	ContentType = "application/jrd+json"
+	// activityStreamsType is the media type of the links to actors.
+	activityStreamsType = "application/activity+json"
+	// profilePageRel is the relation of the links to the web page of an
+	// actor.
+	profilePageRel = "http://webfinger.net/rel/profile-page"
+)
+
+// JRD is a JSON Resource Descriptor, the response of a WebFinger endpoint.
+type JRD struct {
+	Subject string   `json:"subject"`
+	Aliases []string `json:"aliases,omitempty"`
+	Links   []Link   `json:"links,omitempty"`
+}
+
+// Link is a link of a JSON Resource Descriptor.
+type Link struct {
+	Rel      string `json:"rel"`
+	Type     string `json:"type,omitempty"`
+	Href     string `json:"href,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
+// ActorIRI returns the href of the "self" link to the ActivityStreams
+// representation of the subject, if any.
+func (j JRD) ActorIRI() (string, bool) {
+	for _, l := range j.Links {
+		if l.Rel != "self" || len(l.Href) == 0 {
+			continue
+		}
+		mt, _, err := mime.ParseMediaType(l.Type)
+		if err != nil {
+			continue
+		}
+		if mt == activityStreamsType || mt == "application/ld+json" {
+			return l.Href, true
+		}
+	}
+	return "", false
+}
+
+// Handle is the user and domain of an actor's handle.
+type Handle struct {
+	User   string
+	Domain string
+}
+
+// ParseHandle parses a handle of the form "user@domain", optionally prefixed by
+// "@" or "acct:".
+func ParseHandle(s string) (Handle, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "acct:"), "@")
+	i := strings.LastIndexByte(s, '@')
+	if i <= 0 || i == len(s)-1 {
+		return Handle{}, fmt.Errorf("invalid handle %q", s)
+	}
+	return Handle{User: s[:i], Domain: strings.ToLower(s[i+1:])}, nil
+}
+
+// String returns the "user@domain" form of the handle.
+func (h Handle) String() string {
+	return h.User + "@" + h.Domain
+}
+
+// Resource returns the "acct:" URI of the handle, which WebFinger queries.
+func (h Handle) Resource() string {
+	return "acct:" + h.String()
+}
@@ -0,0 +1,157 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	testActorIRI = "https://example.com/users/addison"
+	testPageIRI  = "https://example.com/@addison"
+)
+
+// actorDatabase is a Database holding the actors.
+type actorDatabase struct {
+	pub.Database
+	actors map[string]vocab.Type
+}
+
+func (a actorDatabase) Lock(c context.Context, id *url.URL) error   { return nil }
+func (a actorDatabase) Unlock(c context.Context, id *url.URL) error { return nil }
+func (a actorDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	_, ok := a.actors[id.String()]
+	return ok, nil
+}
+func (a actorDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	t, ok := a.actors[id.String()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return t, nil
+}
+
+// jrdTransport fetches the JRD, recording the IRI fetched.
+type jrdTransport struct {
+	pub.Transport
+	body    string
+	fetched *url.URL
+}
+
+func (j *jrdTransport) Fetch(c context.Context, iri *url.URL, accept string) ([]byte, string, error) {
+	j.fetched = iri
+	return []byte(j.body), ContentType, nil
+}
+
+func assertEqual(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func testHandler() *Handler {
+	person := streams.NewActivityStreamsPerson()
+	u := streams.NewActivityStreamsUrlProperty()
+	page, _ := url.Parse(testPageIRI)
+	u.AppendIRI(page)
+	person.SetActivityStreamsUrl(u)
+	return &Handler{
+		Domain: "example.com",
+		DB:     actorDatabase{actors: map[string]vocab.Type{testActorIRI: person}},
+		ActorIRI: func(user string) *url.URL {
+			return &url.URL{Scheme: "https", Host: "example.com", Path: "/users/" + user}
+		},
+		User: func(actor *url.URL) (string, bool) {
+			return strings.TrimPrefix(actor.Path, "/users/"), actor.Host == "example.com" && strings.HasPrefix(actor.Path, "/users/")
+		},
+	}
+}
+
+func TestParseHandle(t *testing.T) {
+	for _, s := range []string{"addison@Example.com", "@addison@example.com", "acct:addison@example.com"} {
+		h, err := ParseHandle(s)
+		assertEqual(t, err, nil)
+		assertEqual(t, h, Handle{User: "addison", Domain: "example.com"})
+	}
+	for _, s := range []string{"addison", "@addison", "addison@", "@example.com"} {
+		if _, err := ParseHandle(s); err == nil {
+			t.Errorf("expected an error parsing %q", s)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	serve := func(resource string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, Path+"?"+url.Values{"resource": []string{resource}}.Encode(), nil)
+		testHandler().ServeHTTP(rec, r)
+		return rec
+	}
+	t.Run("ServesHandle", func(t *testing.T) {
+		rec := serve("acct:addison@example.com")
+		assertEqual(t, rec.Code, http.StatusOK)
+		assertEqual(t, rec.Header().Get("Content-Type"), ContentType)
+		var jrd JRD
+		if err := json.Unmarshal(rec.Body.Bytes(), &jrd); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, jrd.Subject, "acct:addison@example.com")
+		actor, ok := jrd.ActorIRI()
+		assertEqual(t, ok, true)
+		assertEqual(t, actor, testActorIRI)
+		assertEqual(t, len(jrd.Links), 2)
+		assertEqual(t, jrd.Links[1].Href, testPageIRI)
+	})
+	t.Run("ServesActorIRI", func(t *testing.T) {
+		rec := serve(testActorIRI)
+		assertEqual(t, rec.Code, http.StatusOK)
+		assertEqual(t, strings.Contains(rec.Body.String(), `"subject":"acct:addison@example.com"`), true)
+	})
+	t.Run("UnknownUserNotFound", func(t *testing.T) {
+		assertEqual(t, serve("acct:dakota@example.com").Code, http.StatusNotFound)
+	})
+	t.Run("OtherDomainNotFound", func(t *testing.T) {
+		assertEqual(t, serve("acct:addison@other.example.com").Code, http.StatusNotFound)
+	})
+	t.Run("MissingResourceBadRequest", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		testHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+		assertEqual(t, rec.Code, http.StatusBadRequest)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ResolvesSelfLink", func(t *testing.T) {
+		tr := &jrdTransport{body: `{"subject":"acct:dakota@other.example.com","links":[` +
+			`{"rel":"http://webfinger.net/rel/profile-page","type":"text/html","href":"https://other.example.com/@dakota"},` +
+			`{"rel":"self","type":"application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"","href":"https://other.example.com/users/dakota"}]}`}
+		actor, err := Resolve(ctx, tr, "@dakota@other.example.com")
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), "https://other.example.com/users/dakota")
+		assertEqual(t, tr.fetched.String(), "https://other.example.com/.well-known/webfinger?resource=acct%3Adakota%40other.example.com")
+	})
+	t.Run("RoundTripsHandler", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		testHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Query(Handle{"addison", "example.com"}).String(), nil))
+		actor, err := Resolve(ctx, &jrdTransport{body: rec.Body.String()}, "addison@example.com")
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testActorIRI)
+	})
+	t.Run("NoSelfLink", func(t *testing.T) {
+		_, err := Resolve(ctx, &jrdTransport{body: `{"subject":"acct:dakota@other.example.com"}`}, "dakota@other.example.com")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,258 @@
+// Package wellknown serves the /.well-known endpoints of a federating server,
+// WebFinger, NodeInfo and host-meta, behind one Handler, so applications do not
+// register each of them in their routers.
+package wellknown
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	// Prefix is the path prefix of the well-known endpoints.
+	Prefix = "/.well-known/"
+	// WebFingerPath is the path of the WebFinger endpoint.
+	WebFingerPath = Prefix + "webfinger"
+	// NodeInfoPath is the path of the document linking to the NodeInfo
+	// document.
+	NodeInfoPath = Prefix + "nodeinfo"
+	// HostMetaPath is the path of the host-meta document of RFC 6415.
+	HostMetaPath = Prefix + "host-meta"
+	// DefaultNodeInfoDocumentPath is the path of the NodeInfo document
+	// unless the Handler sets another.
+	DefaultNodeInfoDocumentPath = "/nodeinfo/2.1"
+	// nodeinfoSchema is the rel of the links to NodeInfo 2.1 documents, and
+	// the profile of their media type.
+	nodeinfoSchema = "http://nodeinfo.diaspora.software/ns/schema/2.1"
+	// nodeinfoVersion is the version of the NodeInfo documents.
+	nodeinfoVersion = "2.1"
+)
+
+// NodeInfoUsers are the usage statistics of the users of a server.
+type NodeInfoUsers struct {
+	Total          int `json:"total"`
+	ActiveMonth    int `json:"activeMonth"`
+	ActiveHalfyear int `json:"activeHalfyear"`
+}
+
+// NodeInfo describes the software of this server and its usage, as published in
+// its NodeInfo 2.1 document. The protocols are always "activitypub".
+type NodeInfo struct {
+	// SoftwareName is the name of the software, which must be made of
+	// lowercase letters, digits and dashes, such as "myapp".
+	SoftwareName string
+	// SoftwareVersion is the version of the software.
+	SoftwareVersion string
+	// Repository is the IRI of the source code of the software. Optional.
+	Repository string
+	// Homepage is the IRI of the homepage of the software. Optional.
+	Homepage string
+	// OpenRegistrations is whether new users may sign up.
+	OpenRegistrations bool
+	// Users are the statistics of the users.
+	Users NodeInfoUsers
+	// LocalPosts is the number of posts of the users, such as the
+	// LocalPosts of a pub.StatisticsSnapshot.
+	LocalPosts int
+	// Metadata is free form information about the server. Optional.
+	Metadata map[string]interface{}
+}
+
+// NodeInfoFunc returns the current NodeInfo of this server.
+type NodeInfoFunc func(c context.Context) (NodeInfo, error)
+
+// Handler serves the well-known endpoints of this server. Each endpoint is
+// enabled by its field, and responds with http.StatusNotFound otherwise, as do
+// the paths the Handler does not know.
+//
+// Register mounts every enabled endpoint on a ServeMux at once:
+//
+//	h := &wellknown.Handler{
+//	    Host:      "example.com",
+//	    WebFinger: &webfinger.Handler{ ... },
+//	    NodeInfo:  func(c context.Context) (wellknown.NodeInfo, error) { ... },
+//	    HostMeta:  true,
+//	}
+//	h.Register(mux)
+//
+// Routers matching paths some other way may send every request to Prefix and to
+// the NodeInfo document path to the Handler instead.
+type Handler struct {
+	// Host is the host of this server, such as "example.com", to which the
+	// NodeInfo and host-meta documents link.
+	Host string
+	// WebFinger serves the WebFinger endpoint, such as a
+	// *webfinger.Handler. Nil disables it.
+	WebFinger http.Handler
+	// NodeInfo provides the NodeInfo document. Nil disables it.
+	NodeInfo NodeInfoFunc
+	// NodeInfoDocumentPath is the path of the NodeInfo document. Empty is
+	// DefaultNodeInfoDocumentPath.
+	NodeInfoDocumentPath string
+	// HostMeta enables the host-meta document, linking to the WebFinger
+	// endpoint for peers discovering it the legacy way. It requires
+	// WebFinger.
+	HostMeta bool
+	// Extra serves other well-known endpoints, by path, such as
+	// "/.well-known/security.txt". Their paths must start with Prefix.
+	Extra map[string]http.Handler
+}
+
+// nodeinfoDocumentPath returns the path of the NodeInfo document.
+func (h *Handler) nodeinfoDocumentPath() string {
+	if len(h.NodeInfoDocumentPath) > 0 {
+		return h.NodeInfoDocumentPath
+	}
+	return DefaultNodeInfoDocumentPath
+}
+
+// Paths returns the paths of the enabled endpoints.
+func (h *Handler) Paths() []string {
+	var paths []string
+	if h.WebFinger != nil {
+		paths = append(paths, WebFingerPath)
+		if h.HostMeta {
+			paths = append(paths, HostMetaPath)
+		}
+	}
+	if h.NodeInfo != nil {
+		paths = append(paths, NodeInfoPath, h.nodeinfoDocumentPath())
+	}
+	var extra []string
+	for path := range h.Extra {
+		extra = append(extra, path)
+	}
+	sort.Strings(extra)
+	return append(paths, extra...)
+}
+
+// Register mounts the enabled endpoints on the ServeMux.
+func (h *Handler) Register(mux *http.ServeMux) {
+	for _, path := range h.Paths() {
+		mux.Handle(path, h)
+	}
+}
+
+// ServeHTTP serves the endpoint of the request path.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	if e, ok := h.Extra[path]; ok {
+		e.ServeHTTP(w, r)
+		return
+	}
+	switch {
+	case path == WebFingerPath && h.WebFinger != nil:
+		h.WebFinger.ServeHTTP(w, r)
+	case path == HostMetaPath && h.WebFinger != nil && h.HostMeta:
+		h.serveHostMeta(w, r)
+	case path == NodeInfoPath && h.NodeInfo != nil:
+		h.serveNodeInfoLinks(w, r)
+	case path == h.nodeinfoDocumentPath() && h.NodeInfo != nil:
+		h.serveNodeInfo(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// allowGet responds with http.StatusMethodNotAllowed unless the request is a
+// GET or HEAD request, returning whether it is.
+func allowGet(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
+
+// write responds with the body of the content type.
+func write(w http.ResponseWriter, r *http.Request, contentType string, b []byte) {
+	w.Header().Set("Content-Type", contentType)
+	// Well-known documents are queried by browsers of other origins.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		w.Write(b)
+	}
+}
+
+// writeJSON responds with the value as JSON of the content type.
+func writeJSON(w http.ResponseWriter, r *http.Request, contentType string, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	write(w, r, contentType, b)
+}
+
+// serveHostMeta responds with the XRD linking to the WebFinger endpoint.
+func (h *Handler) serveHostMeta(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	b := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<XRD xmlns="http://docs.oasis-open.org/ns/xri/xrd-1.0">
+  <Link rel="lrdd" template="https://%s%s?resource={uri}"/>
+</XRD>
+`, h.Host, WebFingerPath)
+	write(w, r, "application/xrd+xml; charset=utf-8", []byte(b))
+}
+
+// serveNodeInfoLinks responds with the links to the NodeInfo document.
+func (h *Handler) serveNodeInfoLinks(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	writeJSON(w, r, "application/json", map[string]interface{}{
+		"links": []map[string]string{{
+			"rel":  nodeinfoSchema,
+			"href": "https://" + h.Host + h.nodeinfoDocumentPath(),
+		}},
+	})
+}
+
+// serveNodeInfo responds with the NodeInfo document.
+func (h *Handler) serveNodeInfo(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	n, err := h.NodeInfo(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	software := map[string]string{
+		"name":    strings.ToLower(n.SoftwareName),
+		"version": n.SoftwareVersion,
+	}
+	if len(n.Repository) > 0 {
+		software["repository"] = n.Repository
+	}
+	if len(n.Homepage) > 0 {
+		software["homepage"] = n.Homepage
+	}
+	metadata := n.Metadata
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	writeJSON(w, r, `application/json; profile="`+nodeinfoSchema+`#"`, map[string]interface{}{
+		"version":   nodeinfoVersion,
+		"software":  software,
+		"protocols": []string{"activitypub"},
+		"services": map[string][]string{
+			"inbound":  {},
+			"outbound": {},
+		},
+		"openRegistrations": n.OpenRegistrations,
+		"usage": map[string]interface{}{
+			"users":      n.Users,
+			"localPosts": n.LocalPosts,
+		},
+		"metadata": metadata,
+	})
+}
@@ -0,0 +1,95 @@
+package wellknown
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func assertEqual(t *testing.T, got, want interface{}) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// textHandler responds with the text.
+type textHandler string
+
+func (s textHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(s))
+}
+
+func TestHandler(t *testing.T) {
+	h := &Handler{
+		Host:      "example.com",
+		WebFinger: textHandler("webfinger"),
+		NodeInfo: func(c context.Context) (NodeInfo, error) {
+			return NodeInfo{
+				SoftwareName:    "MyApp",
+				SoftwareVersion: "1.2",
+				Users:           NodeInfoUsers{Total: 3},
+				LocalPosts:      5,
+			}, nil
+		},
+		HostMeta: true,
+		Extra:    map[string]http.Handler{Prefix + "security.txt": textHandler("contact")},
+	}
+	mux := http.NewServeMux()
+	h.Register(mux)
+	get := func(path string) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "https://example.com"+path, nil))
+		return resp
+	}
+	t.Run("RegistersEnabledPaths", func(t *testing.T) {
+		assertEqual(t, fmt.Sprint(h.Paths()), "[/.well-known/webfinger /.well-known/host-meta /.well-known/nodeinfo /nodeinfo/2.1 /.well-known/security.txt]")
+	})
+	t.Run("WebFinger", func(t *testing.T) {
+		assertEqual(t, get(WebFingerPath+"?resource=acct:a@example.com").Body.String(), "webfinger")
+	})
+	t.Run("HostMeta", func(t *testing.T) {
+		resp := get(HostMetaPath)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, strings.Contains(resp.Body.String(), `template="https://example.com/.well-known/webfinger?resource={uri}"`), true)
+	})
+	t.Run("NodeInfo", func(t *testing.T) {
+		var links struct {
+			Links []struct{ Rel, Href string }
+		}
+		assertEqual(t, json.Unmarshal(get(NodeInfoPath).Body.Bytes(), &links), nil)
+		assertEqual(t, len(links.Links), 1)
+		assertEqual(t, links.Links[0].Rel, nodeinfoSchema)
+		assertEqual(t, links.Links[0].Href, "https://example.com/nodeinfo/2.1")
+		var doc struct {
+			Version  string
+			Software struct{ Name, Version string }
+			Usage    struct {
+				Users      NodeInfoUsers
+				LocalPosts int
+			}
+		}
+		assertEqual(t, json.Unmarshal(get(DefaultNodeInfoDocumentPath).Body.Bytes(), &doc), nil)
+		assertEqual(t, doc.Version, "2.1")
+		assertEqual(t, doc.Software.Name, "myapp")
+		assertEqual(t, doc.Usage.Users.Total, 3)
+		assertEqual(t, doc.Usage.LocalPosts, 5)
+	})
+	t.Run("Extra", func(t *testing.T) {
+		assertEqual(t, get(Prefix+"security.txt").Body.String(), "contact")
+	})
+	t.Run("DisabledEndpointsNotFound", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		(&Handler{Host: "example.com"}).ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "https://example.com"+NodeInfoPath, nil))
+		assertEqual(t, resp.Code, http.StatusNotFound)
+	})
+	t.Run("RejectsPost", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		mux.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "https://example.com"+NodeInfoPath, nil))
+		assertEqual(t, resp.Code, http.StatusMethodNotAllowed)
+	})
+}
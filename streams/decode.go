@@ -0,0 +1,245 @@
+package streams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// Decode reads a JSON ActivityStreams value from the reader and resolves it into
+// a Type, like ToType does for a decoded map.
+//
+// The 'items' and 'orderedItems' of collections are read one at a time, and
+// each is resolved into a Type before the next is read, so a large collection
+// is never held in memory both as a generic map and as Types. This requires
+// the '@context' to come before them, as it does in the values served by
+// go-fed and by peer software. Otherwise, or if an item cannot be resolved to
+// a known type, the value is resolved with ToType.
+func Decode(c context.Context, r io.Reader) (vocab.Type, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+	m := make(map[string]interface{})
+	items := make(map[string]*decodedItems)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		k, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected JSON token %v", tok)
+		}
+		rawContext, hasContext := m["@context"]
+		if (k == "items" || k == "orderedItems") && hasContext {
+			d, err := decodeItems(c, dec, rawContext)
+			if err != nil {
+				return nil, err
+			}
+			if d.fallback == nil {
+				items[k] = d
+				continue
+			}
+			m[k] = d.fallback
+			continue
+		}
+		var v interface{}
+		if err = dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		m[k] = v
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	t, err := ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	for k, d := range items {
+		if err = d.set(t, k); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// decodedItems are the items of a collection read by Decode, resolved to Types
+// or IRIs in order. If an item could not be resolved, fallback holds every item
+// as decoded from JSON instead.
+type decodedItems struct {
+	values   []interface{}
+	fallback interface{}
+}
+
+// decodeItems reads the value of an 'items' or 'orderedItems' property,
+// resolving each item with the '@context' of the collection.
+func decodeItems(c context.Context, dec *json.Decoder, rawContext interface{}) (*decodedItems, error) {
+	d := &decodedItems{}
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		// A single item, which is not worth streaming.
+		var v interface{}
+		if delim == '{' {
+			m := make(map[string]interface{})
+			for dec.More() {
+				kt, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				var mv interface{}
+				if err = dec.Decode(&mv); err != nil {
+					return nil, err
+				}
+				m[fmt.Sprint(kt)] = mv
+			}
+			if err = expectDelim(dec, '}'); err != nil {
+				return nil, err
+			}
+			v = m
+		} else {
+			v = tok
+		}
+		d.fallback = v
+		return d, nil
+	}
+	var raw []interface{}
+	for dec.More() {
+		var v interface{}
+		if err = dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		if raw != nil {
+			raw = append(raw, v)
+			continue
+		}
+		resolved, ok := resolveItem(c, v, rawContext)
+		if ok {
+			d.values = append(d.values, resolved)
+			continue
+		}
+		// Fall back to the decoded JSON of every item, serializing
+		// those already resolved again.
+		if raw, err = d.serialize(); err != nil {
+			return nil, err
+		}
+		raw = append(raw, v)
+		d.values = nil
+	}
+	if err = expectDelim(dec, ']'); err != nil {
+		return nil, err
+	}
+	if raw != nil {
+		d.fallback = raw
+	}
+	return d, nil
+}
+
+// resolveItem resolves a decoded item into a Type or an IRI.
+func resolveItem(c context.Context, v interface{}, rawContext interface{}) (interface{}, bool) {
+	switch item := v.(type) {
+	case string:
+		u, err := url.Parse(item)
+		if err != nil || len(u.Scheme) == 0 {
+			return nil, false
+		}
+		return u, true
+	case map[string]interface{}:
+		_, hadContext := item["@context"]
+		if !hadContext {
+			item["@context"] = rawContext
+		}
+		t, err := ToType(c, item)
+		if err != nil {
+			return nil, false
+		}
+		if u, ok := t.(interface {
+			GetUnknownProperties() map[string]interface{}
+		}); ok && !hadContext {
+			delete(u.GetUnknownProperties(), "@context")
+		}
+		return t, true
+	}
+	return nil, false
+}
+
+// serialize returns the resolved items serialized again.
+func (d *decodedItems) serialize() ([]interface{}, error) {
+	raw := make([]interface{}, 0, len(d.values)+1)
+	for _, v := range d.values {
+		if u, ok := v.(*url.URL); ok {
+			raw = append(raw, u.String())
+		} else if s, err := v.(vocab.Type).Serialize(); err == nil {
+			raw = append(raw, s)
+		} else {
+			return nil, err
+		}
+	}
+	return raw, nil
+}
+
+// set sets the resolved items as the property k of the value. Values without
+// the property keep them as an unknown property, as ToType does.
+func (d *decodedItems) set(t vocab.Type, k string) error {
+	orderedItemser, hasOrderedItems := t.(interface {
+		SetActivityStreamsOrderedItems(vocab.ActivityStreamsOrderedItemsProperty)
+	})
+	itemser, hasItems := t.(interface {
+		SetActivityStreamsItems(vocab.ActivityStreamsItemsProperty)
+	})
+	switch {
+	case k == "orderedItems" && hasOrderedItems:
+		p := NewActivityStreamsOrderedItemsProperty()
+		for _, v := range d.values {
+			if u, ok := v.(*url.URL); ok {
+				p.AppendIRI(u)
+			} else if err := p.AppendType(v.(vocab.Type)); err != nil {
+				return err
+			}
+		}
+		orderedItemser.SetActivityStreamsOrderedItems(p)
+	case k == "items" && hasItems:
+		p := NewActivityStreamsItemsProperty()
+		for _, v := range d.values {
+			if u, ok := v.(*url.URL); ok {
+				p.AppendIRI(u)
+			} else if err := p.AppendType(v.(vocab.Type)); err != nil {
+				return err
+			}
+		}
+		itemser.SetActivityStreamsItems(p)
+	default:
+		u, ok := t.(interface {
+			GetUnknownProperties() map[string]interface{}
+		})
+		if !ok {
+			return nil
+		}
+		raw, err := d.serialize()
+		if err != nil {
+			return err
+		}
+		u.GetUnknownProperties()[k] = raw
+	}
+	return nil
+}
+
+// expectDelim reads the delimiter from the decoder.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %q in JSON, found %v", delim, tok)
+	}
+	return nil
+}
@@ -9,6 +9,9 @@ var ActivityStreamsActivityName string = "Activity"
 // ActivityStreamsAddName is the string literal of the name for the Add type in the ActivityStreams vocabulary.
 var ActivityStreamsAddName string = "Add"
 
+// VcardAddressName is the string literal of the name for the Address type in the Vcard vocabulary.
+var VcardAddressName string = "Address"
+
 // ActivityStreamsAnnounceName is the string literal of the name for the Announce type in the ActivityStreams vocabulary.
 var ActivityStreamsAnnounceName string = "Announce"
 
@@ -27,12 +30,18 @@ var ActivityStreamsAudioName string = "Audio"
 // ActivityStreamsBlockName is the string literal of the name for the Block type in the ActivityStreams vocabulary.
 var ActivityStreamsBlockName string = "Block"
 
+// ForgeFedBranchName is the string literal of the name for the Branch type in the ForgeFed vocabulary.
+var ForgeFedBranchName string = "Branch"
+
 // ActivityStreamsCollectionName is the string literal of the name for the Collection type in the ActivityStreams vocabulary.
 var ActivityStreamsCollectionName string = "Collection"
 
 // ActivityStreamsCollectionPageName is the string literal of the name for the CollectionPage type in the ActivityStreams vocabulary.
 var ActivityStreamsCollectionPageName string = "CollectionPage"
 
+// ForgeFedCommitName is the string literal of the name for the Commit type in the ForgeFed vocabulary.
+var ForgeFedCommitName string = "Commit"
+
 // ActivityStreamsCreateName is the string literal of the name for the Create type in the ActivityStreams vocabulary.
 var ActivityStreamsCreateName string = "Create"
 
@@ -45,6 +54,9 @@ var ActivityStreamsDislikeName string = "Dislike"
 // ActivityStreamsDocumentName is the string literal of the name for the Document type in the ActivityStreams vocabulary.
 var ActivityStreamsDocumentName string = "Document"
 
+// TootEmojiName is the string literal of the name for the Emoji type in the Toot vocabulary.
+var TootEmojiName string = "Emoji"
+
 // ActivityStreamsEventName is the string literal of the name for the Event type in the ActivityStreams vocabulary.
 var ActivityStreamsEventName string = "Event"
 
@@ -57,6 +69,9 @@ var ActivityStreamsFollowName string = "Follow"
 // ActivityStreamsGroupName is the string literal of the name for the Group type in the ActivityStreams vocabulary.
 var ActivityStreamsGroupName string = "Group"
 
+// VcardHomeName is the string literal of the name for the Home type in the Vcard vocabulary.
+var VcardHomeName string = "Home"
+
 // ActivityStreamsIgnoreName is the string literal of the name for the Ignore type in the ActivityStreams vocabulary.
 var ActivityStreamsIgnoreName string = "Ignore"
 
@@ -120,6 +135,12 @@ var ActivityStreamsPlaceName string = "Place"
 // ActivityStreamsProfileName is the string literal of the name for the Profile type in the ActivityStreams vocabulary.
 var ActivityStreamsProfileName string = "Profile"
 
+// SecurityV1PublicKeyName is the string literal of the name for the PublicKey type in the SecurityV1 vocabulary.
+var SecurityV1PublicKeyName string = "PublicKey"
+
+// ForgeFedPushName is the string literal of the name for the Push type in the ForgeFed vocabulary.
+var ForgeFedPushName string = "Push"
+
 // ActivityStreamsQuestionName is the string literal of the name for the Question type in the ActivityStreams vocabulary.
 var ActivityStreamsQuestionName string = "Question"
 
@@ -135,6 +156,9 @@ var ActivityStreamsRelationshipName string = "Relationship"
 // ActivityStreamsRemoveName is the string literal of the name for the Remove type in the ActivityStreams vocabulary.
 var ActivityStreamsRemoveName string = "Remove"
 
+// ForgeFedRepositoryName is the string literal of the name for the Repository type in the ForgeFed vocabulary.
+var ForgeFedRepositoryName string = "Repository"
+
 // ActivityStreamsServiceName is the string literal of the name for the Service type in the ActivityStreams vocabulary.
 var ActivityStreamsServiceName string = "Service"
 
@@ -144,6 +168,12 @@ var ActivityStreamsTentativeAcceptName string = "TentativeAccept"
 // ActivityStreamsTentativeRejectName is the string literal of the name for the TentativeReject type in the ActivityStreams vocabulary.
 var ActivityStreamsTentativeRejectName string = "TentativeReject"
 
+// ForgeFedTicketName is the string literal of the name for the Ticket type in the ForgeFed vocabulary.
+var ForgeFedTicketName string = "Ticket"
+
+// ForgeFedTicketDependencyName is the string literal of the name for the TicketDependency type in the ForgeFed vocabulary.
+var ForgeFedTicketDependencyName string = "TicketDependency"
+
 // ActivityStreamsTombstoneName is the string literal of the name for the Tombstone type in the ActivityStreams vocabulary.
 var ActivityStreamsTombstoneName string = "Tombstone"
 
@@ -174,6 +204,9 @@ var ActivityStreamsAltitudePropertyName string = "altitude"
 // ActivityStreamsAnyOfPropertyName is the string literal of the name for the anyOf property in the ActivityStreams vocabulary.
 var ActivityStreamsAnyOfPropertyName string = "anyOf"
 
+// ForgeFedAssignedToPropertyName is the string literal of the name for the assignedTo property in the ForgeFed vocabulary.
+var ForgeFedAssignedToPropertyName string = "assignedTo"
+
 // ActivityStreamsAttachmentPropertyName is the string literal of the name for the attachment property in the ActivityStreams vocabulary.
 var ActivityStreamsAttachmentPropertyName string = "attachment"
 
@@ -186,6 +219,12 @@ var ActivityStreamsAudiencePropertyName string = "audience"
 // ActivityStreamsBccPropertyName is the string literal of the name for the bcc property in the ActivityStreams vocabulary.
 var ActivityStreamsBccPropertyName string = "bcc"
 
+// VcardBdayPropertyName is the string literal of the name for the bday property in the Vcard vocabulary.
+var VcardBdayPropertyName string = "bday"
+
+// TootBlurhashPropertyName is the string literal of the name for the blurhash property in the Toot vocabulary.
+var TootBlurhashPropertyName string = "blurhash"
+
 // ActivityStreamsBtoPropertyName is the string literal of the name for the bto property in the ActivityStreams vocabulary.
 var ActivityStreamsBtoPropertyName string = "bto"
 
@@ -195,6 +234,12 @@ var ActivityStreamsCcPropertyName string = "cc"
 // ActivityStreamsClosedPropertyName is the string literal of the name for the closed property in the ActivityStreams vocabulary.
 var ActivityStreamsClosedPropertyName string = "closed"
 
+// ForgeFedCommittedPropertyName is the string literal of the name for the committed property in the ForgeFed vocabulary.
+var ForgeFedCommittedPropertyName string = "committed"
+
+// ForgeFedCommittedByPropertyName is the string literal of the name for the committedBy property in the ForgeFed vocabulary.
+var ForgeFedCommittedByPropertyName string = "committedBy"
+
 // ActivityStreamsContentPropertyName is the string literal of the name for the content property in the ActivityStreams vocabulary.
 var ActivityStreamsContentPropertyName string = "content"
 
@@ -204,36 +249,84 @@ var ActivityStreamsContentPropertyMapName string = "contentMap"
 // ActivityStreamsContextPropertyName is the string literal of the name for the context property in the ActivityStreams vocabulary.
 var ActivityStreamsContextPropertyName string = "context"
 
+// VcardCountryNamePropertyName is the string literal of the name for the country-name property in the Vcard vocabulary.
+var VcardCountryNamePropertyName string = "country-name"
+
 // ActivityStreamsCurrentPropertyName is the string literal of the name for the current property in the ActivityStreams vocabulary.
 var ActivityStreamsCurrentPropertyName string = "current"
 
 // ActivityStreamsDeletedPropertyName is the string literal of the name for the deleted property in the ActivityStreams vocabulary.
 var ActivityStreamsDeletedPropertyName string = "deleted"
 
+// ForgeFedDependantsPropertyName is the string literal of the name for the dependants property in the ForgeFed vocabulary.
+var ForgeFedDependantsPropertyName string = "dependants"
+
+// ForgeFedDependedByPropertyName is the string literal of the name for the dependedBy property in the ForgeFed vocabulary.
+var ForgeFedDependedByPropertyName string = "dependedBy"
+
+// ForgeFedDependenciesPropertyName is the string literal of the name for the dependencies property in the ForgeFed vocabulary.
+var ForgeFedDependenciesPropertyName string = "dependencies"
+
+// ForgeFedDependsOnPropertyName is the string literal of the name for the dependsOn property in the ForgeFed vocabulary.
+var ForgeFedDependsOnPropertyName string = "dependsOn"
+
 // ActivityStreamsDescribesPropertyName is the string literal of the name for the describes property in the ActivityStreams vocabulary.
 var ActivityStreamsDescribesPropertyName string = "describes"
 
+// ForgeFedDescriptionPropertyName is the string literal of the name for the description property in the ForgeFed vocabulary.
+var ForgeFedDescriptionPropertyName string = "description"
+
+// TootDiscoverablePropertyName is the string literal of the name for the discoverable property in the Toot vocabulary.
+var TootDiscoverablePropertyName string = "discoverable"
+
 // ActivityStreamsDurationPropertyName is the string literal of the name for the duration property in the ActivityStreams vocabulary.
 var ActivityStreamsDurationPropertyName string = "duration"
 
+// ForgeFedEarlyItemsPropertyName is the string literal of the name for the earlyItems property in the ForgeFed vocabulary.
+var ForgeFedEarlyItemsPropertyName string = "earlyItems"
+
 // ActivityStreamsEndTimePropertyName is the string literal of the name for the endTime property in the ActivityStreams vocabulary.
 var ActivityStreamsEndTimePropertyName string = "endTime"
 
+// TootFeaturedPropertyName is the string literal of the name for the featured property in the Toot vocabulary.
+var TootFeaturedPropertyName string = "featured"
+
+// ForgeFedFilesAddedPropertyName is the string literal of the name for the filesAdded property in the ForgeFed vocabulary.
+var ForgeFedFilesAddedPropertyName string = "filesAdded"
+
+// ForgeFedFilesModifiedPropertyName is the string literal of the name for the filesModified property in the ForgeFed vocabulary.
+var ForgeFedFilesModifiedPropertyName string = "filesModified"
+
+// ForgeFedFilesRemovedPropertyName is the string literal of the name for the filesRemoved property in the ForgeFed vocabulary.
+var ForgeFedFilesRemovedPropertyName string = "filesRemoved"
+
 // ActivityStreamsFirstPropertyName is the string literal of the name for the first property in the ActivityStreams vocabulary.
 var ActivityStreamsFirstPropertyName string = "first"
 
+// TootFocalPointPropertyName is the string literal of the name for the focalPoint property in the Toot vocabulary.
+var TootFocalPointPropertyName string = "focalPoint"
+
 // ActivityStreamsFollowersPropertyName is the string literal of the name for the followers property in the ActivityStreams vocabulary.
 var ActivityStreamsFollowersPropertyName string = "followers"
 
 // ActivityStreamsFollowingPropertyName is the string literal of the name for the following property in the ActivityStreams vocabulary.
 var ActivityStreamsFollowingPropertyName string = "following"
 
+// ForgeFedForksPropertyName is the string literal of the name for the forks property in the ForgeFed vocabulary.
+var ForgeFedForksPropertyName string = "forks"
+
 // ActivityStreamsFormerTypePropertyName is the string literal of the name for the formerType property in the ActivityStreams vocabulary.
 var ActivityStreamsFormerTypePropertyName string = "formerType"
 
 // ActivityStreamsGeneratorPropertyName is the string literal of the name for the generator property in the ActivityStreams vocabulary.
 var ActivityStreamsGeneratorPropertyName string = "generator"
 
+// VcardHasAddressPropertyName is the string literal of the name for the hasAddress property in the Vcard vocabulary.
+var VcardHasAddressPropertyName string = "hasAddress"
+
+// ForgeFedHashPropertyName is the string literal of the name for the hash property in the ForgeFed vocabulary.
+var ForgeFedHashPropertyName string = "hash"
+
 // ActivityStreamsHeightPropertyName is the string literal of the name for the height property in the ActivityStreams vocabulary.
 var ActivityStreamsHeightPropertyName string = "height"
 
@@ -261,6 +354,9 @@ var ActivityStreamsInboxPropertyName string = "inbox"
 // ActivityStreamsInstrumentPropertyName is the string literal of the name for the instrument property in the ActivityStreams vocabulary.
 var ActivityStreamsInstrumentPropertyName string = "instrument"
 
+// ForgeFedIsResolvedPropertyName is the string literal of the name for the isResolved property in the ForgeFed vocabulary.
+var ForgeFedIsResolvedPropertyName string = "isResolved"
+
 // ActivityStreamsItemsPropertyName is the string literal of the name for the items property in the ActivityStreams vocabulary.
 var ActivityStreamsItemsPropertyName string = "items"
 
@@ -276,6 +372,9 @@ var ActivityStreamsLikedPropertyName string = "liked"
 // ActivityStreamsLikesPropertyName is the string literal of the name for the likes property in the ActivityStreams vocabulary.
 var ActivityStreamsLikesPropertyName string = "likes"
 
+// VcardLocalityPropertyName is the string literal of the name for the locality property in the Vcard vocabulary.
+var VcardLocalityPropertyName string = "locality"
+
 // ActivityStreamsLocationPropertyName is the string literal of the name for the location property in the ActivityStreams vocabulary.
 var ActivityStreamsLocationPropertyName string = "location"
 
@@ -309,9 +408,15 @@ var ActivityStreamsOriginPropertyName string = "origin"
 // ActivityStreamsOutboxPropertyName is the string literal of the name for the outbox property in the ActivityStreams vocabulary.
 var ActivityStreamsOutboxPropertyName string = "outbox"
 
+// SecurityV1OwnerPropertyName is the string literal of the name for the owner property in the SecurityV1 vocabulary.
+var SecurityV1OwnerPropertyName string = "owner"
+
 // ActivityStreamsPartOfPropertyName is the string literal of the name for the partOf property in the ActivityStreams vocabulary.
 var ActivityStreamsPartOfPropertyName string = "partOf"
 
+// VcardPostalCodePropertyName is the string literal of the name for the postal-code property in the Vcard vocabulary.
+var VcardPostalCodePropertyName string = "postal-code"
+
 // ActivityStreamsPreferredUsernamePropertyName is the string literal of the name for the preferredUsername property in the ActivityStreams vocabulary.
 var ActivityStreamsPreferredUsernamePropertyName string = "preferredUsername"
 
@@ -324,12 +429,24 @@ var ActivityStreamsPrevPropertyName string = "prev"
 // ActivityStreamsPreviewPropertyName is the string literal of the name for the preview property in the ActivityStreams vocabulary.
 var ActivityStreamsPreviewPropertyName string = "preview"
 
+// SecurityV1PublicKeyPropertyName is the string literal of the name for the publicKey property in the SecurityV1 vocabulary.
+var SecurityV1PublicKeyPropertyName string = "publicKey"
+
+// SecurityV1PublicKeyPemPropertyName is the string literal of the name for the publicKeyPem property in the SecurityV1 vocabulary.
+var SecurityV1PublicKeyPemPropertyName string = "publicKeyPem"
+
 // ActivityStreamsPublishedPropertyName is the string literal of the name for the published property in the ActivityStreams vocabulary.
 var ActivityStreamsPublishedPropertyName string = "published"
 
 // ActivityStreamsRadiusPropertyName is the string literal of the name for the radius property in the ActivityStreams vocabulary.
 var ActivityStreamsRadiusPropertyName string = "radius"
 
+// ForgeFedRefPropertyName is the string literal of the name for the ref property in the ForgeFed vocabulary.
+var ForgeFedRefPropertyName string = "ref"
+
+// VcardRegionPropertyName is the string literal of the name for the region property in the Vcard vocabulary.
+var VcardRegionPropertyName string = "region"
+
 // ActivityStreamsRelPropertyName is the string literal of the name for the rel property in the ActivityStreams vocabulary.
 var ActivityStreamsRelPropertyName string = "rel"
 
@@ -339,6 +456,12 @@ var ActivityStreamsRelationshipPropertyName string = "relationship"
 // ActivityStreamsRepliesPropertyName is the string literal of the name for the replies property in the ActivityStreams vocabulary.
 var ActivityStreamsRepliesPropertyName string = "replies"
 
+// ForgeFedResolvedPropertyName is the string literal of the name for the resolved property in the ForgeFed vocabulary.
+var ForgeFedResolvedPropertyName string = "resolved"
+
+// ForgeFedResolvedByPropertyName is the string literal of the name for the resolvedBy property in the ForgeFed vocabulary.
+var ForgeFedResolvedByPropertyName string = "resolvedBy"
+
 // ActivityStreamsResultPropertyName is the string literal of the name for the result property in the ActivityStreams vocabulary.
 var ActivityStreamsResultPropertyName string = "result"
 
@@ -354,6 +477,9 @@ var ActivityStreamsStartTimePropertyName string = "startTime"
 // ActivityStreamsStreamsPropertyName is the string literal of the name for the streams property in the ActivityStreams vocabulary.
 var ActivityStreamsStreamsPropertyName string = "streams"
 
+// VcardStreetAddressPropertyName is the string literal of the name for the street-address property in the Vcard vocabulary.
+var VcardStreetAddressPropertyName string = "street-address"
+
 // ActivityStreamsSubjectPropertyName is the string literal of the name for the subject property in the ActivityStreams vocabulary.
 var ActivityStreamsSubjectPropertyName string = "subject"
 
@@ -369,12 +495,21 @@ var ActivityStreamsTagPropertyName string = "tag"
 // ActivityStreamsTargetPropertyName is the string literal of the name for the target property in the ActivityStreams vocabulary.
 var ActivityStreamsTargetPropertyName string = "target"
 
+// ForgeFedTeamPropertyName is the string literal of the name for the team property in the ForgeFed vocabulary.
+var ForgeFedTeamPropertyName string = "team"
+
+// ForgeFedTicketsTrackedByPropertyName is the string literal of the name for the ticketsTrackedBy property in the ForgeFed vocabulary.
+var ForgeFedTicketsTrackedByPropertyName string = "ticketsTrackedBy"
+
 // ActivityStreamsToPropertyName is the string literal of the name for the to property in the ActivityStreams vocabulary.
 var ActivityStreamsToPropertyName string = "to"
 
 // ActivityStreamsTotalItemsPropertyName is the string literal of the name for the totalItems property in the ActivityStreams vocabulary.
 var ActivityStreamsTotalItemsPropertyName string = "totalItems"
 
+// ForgeFedTracksTicketsForPropertyName is the string literal of the name for the tracksTicketsFor property in the ForgeFed vocabulary.
+var ForgeFedTracksTicketsForPropertyName string = "tracksTicketsFor"
+
 // ActivityStreamsTypePropertyName is the string literal of the name for the type property in the ActivityStreams vocabulary.
 var ActivityStreamsTypePropertyName string = "type"
 
@@ -387,5 +522,8 @@ var ActivityStreamsUpdatedPropertyName string = "updated"
 // ActivityStreamsUrlPropertyName is the string literal of the name for the url property in the ActivityStreams vocabulary.
 var ActivityStreamsUrlPropertyName string = "url"
 
+// TootVotersCountPropertyName is the string literal of the name for the votersCount property in the Toot vocabulary.
+var TootVotersCountPropertyName string = "votersCount"
+
 // ActivityStreamsWidthPropertyName is the string literal of the name for the width property in the ActivityStreams vocabulary.
 var ActivityStreamsWidthPropertyName string = "width"
@@ -127,6 +127,52 @@ import (
 	typeupdate "github.com/go-fed/activity/streams/impl/activitystreams/type_update"
 	typevideo "github.com/go-fed/activity/streams/impl/activitystreams/type_video"
 	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
+	propertyassignedto "github.com/go-fed/activity/streams/impl/forgefed/property_assignedto"
+	propertycommitted "github.com/go-fed/activity/streams/impl/forgefed/property_committed"
+	propertycommittedby "github.com/go-fed/activity/streams/impl/forgefed/property_committedby"
+	propertydependants "github.com/go-fed/activity/streams/impl/forgefed/property_dependants"
+	propertydependedby "github.com/go-fed/activity/streams/impl/forgefed/property_dependedby"
+	propertydependencies "github.com/go-fed/activity/streams/impl/forgefed/property_dependencies"
+	propertydependson "github.com/go-fed/activity/streams/impl/forgefed/property_dependson"
+	propertydescription "github.com/go-fed/activity/streams/impl/forgefed/property_description"
+	propertyearlyitems "github.com/go-fed/activity/streams/impl/forgefed/property_earlyitems"
+	propertyfilesadded "github.com/go-fed/activity/streams/impl/forgefed/property_filesadded"
+	propertyfilesmodified "github.com/go-fed/activity/streams/impl/forgefed/property_filesmodified"
+	propertyfilesremoved "github.com/go-fed/activity/streams/impl/forgefed/property_filesremoved"
+	propertyforks "github.com/go-fed/activity/streams/impl/forgefed/property_forks"
+	propertyhash "github.com/go-fed/activity/streams/impl/forgefed/property_hash"
+	propertyisresolved "github.com/go-fed/activity/streams/impl/forgefed/property_isresolved"
+	propertyref "github.com/go-fed/activity/streams/impl/forgefed/property_ref"
+	propertyresolved "github.com/go-fed/activity/streams/impl/forgefed/property_resolved"
+	propertyresolvedby "github.com/go-fed/activity/streams/impl/forgefed/property_resolvedby"
+	propertyteam "github.com/go-fed/activity/streams/impl/forgefed/property_team"
+	propertyticketstrackedby "github.com/go-fed/activity/streams/impl/forgefed/property_ticketstrackedby"
+	propertytracksticketsfor "github.com/go-fed/activity/streams/impl/forgefed/property_tracksticketsfor"
+	typebranch "github.com/go-fed/activity/streams/impl/forgefed/type_branch"
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	propertyowner "github.com/go-fed/activity/streams/impl/securityv1/property_owner"
+	propertypublickey "github.com/go-fed/activity/streams/impl/securityv1/property_publickey"
+	propertypublickeypem "github.com/go-fed/activity/streams/impl/securityv1/property_publickeypem"
+	typepublickey "github.com/go-fed/activity/streams/impl/securityv1/type_publickey"
+	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
+	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfocalpoint "github.com/go-fed/activity/streams/impl/toot/property_focalpoint"
+	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	propertybday "github.com/go-fed/activity/streams/impl/vcard/property_bday"
+	propertycountryname "github.com/go-fed/activity/streams/impl/vcard/property_country-name"
+	propertyhasaddress "github.com/go-fed/activity/streams/impl/vcard/property_hasaddress"
+	propertylocality "github.com/go-fed/activity/streams/impl/vcard/property_locality"
+	propertypostalcode "github.com/go-fed/activity/streams/impl/vcard/property_postal-code"
+	propertyregion "github.com/go-fed/activity/streams/impl/vcard/property_region"
+	propertystreetaddress "github.com/go-fed/activity/streams/impl/vcard/property_street-address"
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
 )
 
 var mgr *Manager
@@ -268,6 +314,52 @@ func init() {
 	typeupdate.SetManager(mgr)
 	typevideo.SetManager(mgr)
 	typeview.SetManager(mgr)
+	propertyassignedto.SetManager(mgr)
+	propertycommitted.SetManager(mgr)
+	propertycommittedby.SetManager(mgr)
+	propertydependants.SetManager(mgr)
+	propertydependedby.SetManager(mgr)
+	propertydependencies.SetManager(mgr)
+	propertydependson.SetManager(mgr)
+	propertydescription.SetManager(mgr)
+	propertyearlyitems.SetManager(mgr)
+	propertyfilesadded.SetManager(mgr)
+	propertyfilesmodified.SetManager(mgr)
+	propertyfilesremoved.SetManager(mgr)
+	propertyforks.SetManager(mgr)
+	propertyhash.SetManager(mgr)
+	propertyisresolved.SetManager(mgr)
+	propertyref.SetManager(mgr)
+	propertyresolved.SetManager(mgr)
+	propertyresolvedby.SetManager(mgr)
+	propertyteam.SetManager(mgr)
+	propertyticketstrackedby.SetManager(mgr)
+	propertytracksticketsfor.SetManager(mgr)
+	typebranch.SetManager(mgr)
+	typecommit.SetManager(mgr)
+	typepush.SetManager(mgr)
+	typerepository.SetManager(mgr)
+	typeticket.SetManager(mgr)
+	typeticketdependency.SetManager(mgr)
+	propertyowner.SetManager(mgr)
+	propertypublickey.SetManager(mgr)
+	propertypublickeypem.SetManager(mgr)
+	typepublickey.SetManager(mgr)
+	propertyblurhash.SetManager(mgr)
+	propertydiscoverable.SetManager(mgr)
+	propertyfeatured.SetManager(mgr)
+	propertyfocalpoint.SetManager(mgr)
+	propertyvoterscount.SetManager(mgr)
+	typeemoji.SetManager(mgr)
+	propertybday.SetManager(mgr)
+	propertycountryname.SetManager(mgr)
+	propertyhasaddress.SetManager(mgr)
+	propertylocality.SetManager(mgr)
+	propertypostalcode.SetManager(mgr)
+	propertyregion.SetManager(mgr)
+	propertystreetaddress.SetManager(mgr)
+	typeaddress.SetManager(mgr)
+	typehome.SetManager(mgr)
 	typeaccept.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeactivity.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeadd.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
@@ -322,4 +414,14 @@ func init() {
 	typeupdate.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typevideo.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeview.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typebranch.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typecommit.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typepush.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typerepository.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typeticket.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typeticketdependency.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typepublickey.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typeemoji.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typeaddress.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typehome.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
 	"strings"
 )
 
@@ -35,6 +36,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsAdd) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.VcardAddress) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsAnnounce) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsApplication) error:
@@ -47,10 +50,14 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedBranch) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCollection) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCollectionPage) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedCommit) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCreate) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDelete) error:
@@ -59,6 +66,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDocument) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.TootEmoji) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsEvent) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsFlag) error:
@@ -67,6 +76,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsGroup) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.VcardHome) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsIgnore) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsImage) error:
@@ -109,6 +120,10 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsProfile) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.SecurityV1PublicKey) error:
+			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedPush) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsQuestion) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRead) error:
@@ -119,12 +134,18 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRemove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedRepository) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsService) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicket) error:
+			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicketDependency) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTombstone) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTravel) error:
@@ -148,6 +169,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 func toAliasMap(i interface{}) (m map[string]string) {
 	m = make(map[string]string)
 	toHttpHttpsFn := func(s string) (ok bool, http, https string) {
+		// Vocabularies such as vcard are commonly referred to with a trailing '#'.
+		s = strings.TrimSuffix(s, "#")
 		if strings.HasPrefix(s, "http://") {
 			ok = true
 			http = s
@@ -162,7 +185,6 @@ func toAliasMap(i interface{}) (m map[string]string) {
 	switch v := i.(type) {
 	case string:
 		// Single entry, no alias.
-
 		if ok, http, https := toHttpHttpsFn(v); ok {
 			m[http] = ""
 			m[https] = ""
@@ -171,21 +193,27 @@ func toAliasMap(i interface{}) (m map[string]string) {
 		}
 	case []interface{}:
 		// Recursively apply.
-
 		for _, elem := range v {
 			r := toAliasMap(elem)
 			for k, val := range r {
+				// A vocabulary used without an alias takes precedence over any alias for it.
+				if existing, ok := m[k]; ok && existing == "" {
+					continue
+				}
 				m[k] = val
 			}
 		}
 	case map[string]interface{}:
 		// Map any aliases.
-
 		for k, val := range v {
-			// Only handle string aliases.
-			switch conc := val.(type) {
-			case string:
-				m[k] = conc
+			// Only handle string aliases of vocabularies.
+			conc, ok := val.(string)
+			if !ok {
+				continue
+			}
+			if ok, http, https := toHttpHttpsFn(conc); ok {
+				m[http] = k
+				m[https] = k
 			}
 		}
 	}
@@ -198,8 +226,12 @@ func toAliasMap(i interface{}) (m map[string]string) {
 // ActivityStream objects whose type matches its interface. Returns an error
 // if the ActivityStreams type does not match callbackers or is not a type
 // handled by the generated code. If multiple types are present, it will check
-// each one in order and apply only the first one. It returns an unhandled
-// error for a multi-typed object if none of the types were able to be handled.
+// the most specific known type first, falling back to less specific ones if
+// no callback accepts it, and apply only the first one that succeeds. Equally
+// specific types are checked in the order given. The value passed to the
+// callback retains all of the types in its 'type' property. It returns an
+// unhandled error for a multi-typed object if none of the types were able to
+// be handled.
 func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{}) error {
 	typeValue, ok := m["type"]
 	if !ok {
@@ -210,16 +242,44 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 		return fmt.Errorf("cannot determine ActivityStreams type: '@context' is missing")
 	}
 	aliasMap := toAliasMap(rawContext)
+	ActivityStreamsAlias, ok := aliasMap["https://www.w3.org/ns/activitystreams"]
+	if !ok {
+		ActivityStreamsAlias, _ = aliasMap["http://www.w3.org/ns/activitystreams"]
+	}
+	if len(ActivityStreamsAlias) > 0 {
+		ActivityStreamsAlias += ":"
+	}
+	VcardAlias, ok := aliasMap["https://www.w3.org/2006/vcard/ns"]
+	if !ok {
+		VcardAlias, _ = aliasMap["http://www.w3.org/2006/vcard/ns"]
+	}
+	if len(VcardAlias) > 0 {
+		VcardAlias += ":"
+	}
+	ForgeFedAlias, ok := aliasMap["https://forgefed.org/ns"]
+	if !ok {
+		ForgeFedAlias, _ = aliasMap["http://forgefed.org/ns"]
+	}
+	if len(ForgeFedAlias) > 0 {
+		ForgeFedAlias += ":"
+	}
+	TootAlias, ok := aliasMap["https://joinmastodon.org/ns"]
+	if !ok {
+		TootAlias, _ = aliasMap["http://joinmastodon.org/ns"]
+	}
+	if len(TootAlias) > 0 {
+		TootAlias += ":"
+	}
+	SecurityV1Alias, ok := aliasMap["https://w3id.org/security/v1"]
+	if !ok {
+		SecurityV1Alias, _ = aliasMap["http://w3id.org/security/v1"]
+	}
+	if len(SecurityV1Alias) > 0 {
+		SecurityV1Alias += ":"
+	}
+
 	// Begin: Private lambda to handle a single string "type" value. Makes code generation easier.
 	handleFn := func(typeString string) error {
-		ActivityStreamsAlias, ok := aliasMap["https://www.w3.org/ns/activitystreams"]
-		if !ok {
-			ActivityStreamsAlias, _ = aliasMap["http://www.w3.org/ns/activitystreams"]
-		}
-		if len(ActivityStreamsAlias) > 0 {
-			ActivityStreamsAlias += ":"
-		}
-
 		if typeString == ActivityStreamsAlias+"Accept" {
 			v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -253,6 +313,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == VcardAlias+"Address" {
+			v, err := mgr.DeserializeAddressVcard()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.VcardAddress) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Announce" {
 			v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -319,6 +390,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Branch" {
+			v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedBranch) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Collection" {
 			v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -341,6 +423,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Commit" {
+			v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedCommit) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Create" {
 			v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -385,6 +478,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == TootAlias+"Emoji" {
+			v, err := mgr.DeserializeEmojiToot()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.TootEmoji) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Event" {
 			v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -429,6 +533,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == VcardAlias+"Home" {
+			v, err := mgr.DeserializeHomeVcard()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.VcardHome) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Ignore" {
 			v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -660,6 +775,28 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == SecurityV1Alias+"PublicKey" {
+			v, err := mgr.DeserializePublicKeySecurityV1()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.SecurityV1PublicKey) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Push" {
+			v, err := mgr.DeserializePushForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedPush) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Question" {
 			v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -715,6 +852,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Repository" {
+			v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedRepository) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Service" {
 			v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -748,6 +896,28 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Ticket" {
+			v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedTicket) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"TicketDependency" {
+			v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedTicketDependency) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Tombstone" {
 			v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -819,22 +989,170 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 		}
 	}
 	// End: Private lambda
+	// Begin: Private lambda to determine how specific a single string "type" value is. Unknown types are the least specific.
+	specificityFn := func(typeString string) int {
+		if typeString == ActivityStreamsAlias+"Accept" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Activity" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Add" {
+			return 2
+		} else if typeString == VcardAlias+"Address" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Announce" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Application" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Arrive" {
+			return 3
+		} else if typeString == ActivityStreamsAlias+"Article" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Audio" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Block" {
+			return 3
+		} else if typeString == ForgeFedAlias+"Branch" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Collection" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"CollectionPage" {
+			return 2
+		} else if typeString == ForgeFedAlias+"Commit" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Create" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Delete" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Dislike" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Document" {
+			return 1
+		} else if typeString == TootAlias+"Emoji" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Event" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Flag" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Follow" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Group" {
+			return 1
+		} else if typeString == VcardAlias+"Home" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Ignore" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Image" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"IntransitiveActivity" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Invite" {
+			return 3
+		} else if typeString == ActivityStreamsAlias+"Join" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Leave" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Like" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Link" {
+			return 0
+		} else if typeString == ActivityStreamsAlias+"Listen" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Mention" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Move" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Note" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Object" {
+			return 0
+		} else if typeString == ActivityStreamsAlias+"Offer" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"OrderedCollection" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"OrderedCollectionPage" {
+			return 4
+		} else if typeString == ActivityStreamsAlias+"Organization" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Page" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Person" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Place" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Profile" {
+			return 1
+		} else if typeString == SecurityV1Alias+"PublicKey" {
+			return 1
+		} else if typeString == ForgeFedAlias+"Push" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Question" {
+			return 3
+		} else if typeString == ActivityStreamsAlias+"Read" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Reject" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Relationship" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Remove" {
+			return 2
+		} else if typeString == ForgeFedAlias+"Repository" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Service" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"TentativeAccept" {
+			return 3
+		} else if typeString == ActivityStreamsAlias+"TentativeReject" {
+			return 3
+		} else if typeString == ForgeFedAlias+"Ticket" {
+			return 1
+		} else if typeString == ForgeFedAlias+"TicketDependency" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Tombstone" {
+			return 1
+		} else if typeString == ActivityStreamsAlias+"Travel" {
+			return 3
+		} else if typeString == ActivityStreamsAlias+"Undo" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Update" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"Video" {
+			return 2
+		} else if typeString == ActivityStreamsAlias+"View" {
+			return 2
+		} else {
+			return -1
+		}
+	}
+	// End: Private lambda
 	if typeStr, ok := typeValue.(string); ok {
 		return handleFn(typeStr)
 	} else if typeIArr, ok := typeValue.([]interface{}); ok {
+		typeStrs := make([]string, 0, len(typeIArr))
 		for _, typeI := range typeIArr {
 			if typeStr, ok := typeI.(string); ok {
-				if err := handleFn(typeStr); err == nil {
-					return nil
-				} else if err == ErrUnhandledType {
-					// Keep trying other types: only if all fail do we return this error.
-					continue
-				} else {
-					return err
-				}
+				typeStrs = append(typeStrs, typeStr)
 			}
 		}
-		return ErrUnhandledType
+		// Try the most specific types first, keeping the given order of equally specific types.
+		sort.SliceStable(typeStrs, func(i, j int) bool {
+			return specificityFn(typeStrs[i]) > specificityFn(typeStrs[j])
+		})
+		err := ErrUnhandledType
+		for _, typeStr := range typeStrs {
+			if e := handleFn(typeStr); e == nil {
+				return nil
+			} else if e == ErrUnhandledType {
+				// Keep trying other types: only if all fail do we return this error.
+				continue
+			} else if e == ErrNoCallbackMatch {
+				// Keep trying less specific types, which may have a matching callback.
+				err = e
+				continue
+			} else {
+				return e
+			}
+		}
+		return err
 	} else {
 		return ErrUnhandledType
 	}
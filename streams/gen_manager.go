@@ -127,6 +127,52 @@ import (
 	typeupdate "github.com/go-fed/activity/streams/impl/activitystreams/type_update"
 	typevideo "github.com/go-fed/activity/streams/impl/activitystreams/type_video"
 	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
+	propertyassignedto "github.com/go-fed/activity/streams/impl/forgefed/property_assignedto"
+	propertycommitted "github.com/go-fed/activity/streams/impl/forgefed/property_committed"
+	propertycommittedby "github.com/go-fed/activity/streams/impl/forgefed/property_committedby"
+	propertydependants "github.com/go-fed/activity/streams/impl/forgefed/property_dependants"
+	propertydependedby "github.com/go-fed/activity/streams/impl/forgefed/property_dependedby"
+	propertydependencies "github.com/go-fed/activity/streams/impl/forgefed/property_dependencies"
+	propertydependson "github.com/go-fed/activity/streams/impl/forgefed/property_dependson"
+	propertydescription "github.com/go-fed/activity/streams/impl/forgefed/property_description"
+	propertyearlyitems "github.com/go-fed/activity/streams/impl/forgefed/property_earlyitems"
+	propertyfilesadded "github.com/go-fed/activity/streams/impl/forgefed/property_filesadded"
+	propertyfilesmodified "github.com/go-fed/activity/streams/impl/forgefed/property_filesmodified"
+	propertyfilesremoved "github.com/go-fed/activity/streams/impl/forgefed/property_filesremoved"
+	propertyforks "github.com/go-fed/activity/streams/impl/forgefed/property_forks"
+	propertyhash "github.com/go-fed/activity/streams/impl/forgefed/property_hash"
+	propertyisresolved "github.com/go-fed/activity/streams/impl/forgefed/property_isresolved"
+	propertyref "github.com/go-fed/activity/streams/impl/forgefed/property_ref"
+	propertyresolved "github.com/go-fed/activity/streams/impl/forgefed/property_resolved"
+	propertyresolvedby "github.com/go-fed/activity/streams/impl/forgefed/property_resolvedby"
+	propertyteam "github.com/go-fed/activity/streams/impl/forgefed/property_team"
+	propertyticketstrackedby "github.com/go-fed/activity/streams/impl/forgefed/property_ticketstrackedby"
+	propertytracksticketsfor "github.com/go-fed/activity/streams/impl/forgefed/property_tracksticketsfor"
+	typebranch "github.com/go-fed/activity/streams/impl/forgefed/type_branch"
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	propertyowner "github.com/go-fed/activity/streams/impl/securityv1/property_owner"
+	propertypublickey "github.com/go-fed/activity/streams/impl/securityv1/property_publickey"
+	propertypublickeypem "github.com/go-fed/activity/streams/impl/securityv1/property_publickeypem"
+	typepublickey "github.com/go-fed/activity/streams/impl/securityv1/type_publickey"
+	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
+	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfocalpoint "github.com/go-fed/activity/streams/impl/toot/property_focalpoint"
+	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	propertybday "github.com/go-fed/activity/streams/impl/vcard/property_bday"
+	propertycountryname "github.com/go-fed/activity/streams/impl/vcard/property_country-name"
+	propertyhasaddress "github.com/go-fed/activity/streams/impl/vcard/property_hasaddress"
+	propertylocality "github.com/go-fed/activity/streams/impl/vcard/property_locality"
+	propertypostalcode "github.com/go-fed/activity/streams/impl/vcard/property_postal-code"
+	propertyregion "github.com/go-fed/activity/streams/impl/vcard/property_region"
+	propertystreetaddress "github.com/go-fed/activity/streams/impl/vcard/property_street-address"
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -201,6 +247,18 @@ func (this Manager) DeserializeAddActivityStreams() func(map[string]interface{},
 	}
 }
 
+// DeserializeAddressVcard returns the deserialization method for the
+// "VcardAddress" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeAddressVcard() func(map[string]interface{}, map[string]string) (vocab.VcardAddress, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardAddress, error) {
+		i, err := typeaddress.DeserializeAddress(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeAltitudePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAltitudeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -279,6 +337,19 @@ func (this Manager) DeserializeArticleActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeAssignedToPropertyForgeFed returns the deserialization method for
+// the "ForgeFedAssignedToProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeAssignedToPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedAssignedToProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedAssignedToProperty, error) {
+		i, err := propertyassignedto.DeserializeAssignedToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeAttachmentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAttachmentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -344,6 +415,18 @@ func (this Manager) DeserializeBccPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeBdayPropertyVcard returns the deserialization method for the
+// "VcardBdayProperty" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeBdayPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardBdayProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardBdayProperty, error) {
+		i, err := propertybday.DeserializeBdayProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeBlockActivityStreams returns the deserialization method for the
 // "ActivityStreamsBlock" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -357,6 +440,30 @@ func (this Manager) DeserializeBlockActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeBlurhashPropertyToot returns the deserialization method for the
+// "TootBlurhashProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeBlurhashPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootBlurhashProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootBlurhashProperty, error) {
+		i, err := propertyblurhash.DeserializeBlurhashProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeBranchForgeFed returns the deserialization method for the
+// "ForgeFedBranch" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeBranchForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedBranch, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedBranch, error) {
+		i, err := typebranch.DeserializeBranch(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeBtoPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsBtoProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -422,6 +529,44 @@ func (this Manager) DeserializeCollectionPageActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeCommitForgeFed returns the deserialization method for the
+// "ForgeFedCommit" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeCommitForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommit, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommit, error) {
+		i, err := typecommit.DeserializeCommit(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCommittedByPropertyForgeFed returns the deserialization method for
+// the "ForgeFedCommittedByProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeCommittedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommittedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommittedByProperty, error) {
+		i, err := propertycommittedby.DeserializeCommittedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCommittedPropertyForgeFed returns the deserialization method for the
+// "ForgeFedCommittedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeCommittedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommittedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommittedProperty, error) {
+		i, err := propertycommitted.DeserializeCommittedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeContentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsContentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -448,6 +593,18 @@ func (this Manager) DeserializeContextPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeCountryNamePropertyVcard returns the deserialization method for the
+// "VcardCountryNameProperty" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeCountryNamePropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardCountryNameProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardCountryNameProperty, error) {
+		i, err := propertycountryname.DeserializeCountryNameProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeCreateActivityStreams returns the deserialization method for the
 // "ActivityStreamsCreate" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -500,6 +657,58 @@ func (this Manager) DeserializeDeletedPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeDependantsPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDependantsProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDependantsPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependantsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependantsProperty, error) {
+		i, err := propertydependants.DeserializeDependantsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDependedByPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDependedByProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDependedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependedByProperty, error) {
+		i, err := propertydependedby.DeserializeDependedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDependenciesPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDependenciesProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeDependenciesPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependenciesProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependenciesProperty, error) {
+		i, err := propertydependencies.DeserializeDependenciesProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDependsOnPropertyForgeFed returns the deserialization method for the
+// "ForgeFedDependsOnProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDependsOnPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependsOnProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependsOnProperty, error) {
+		i, err := propertydependson.DeserializeDependsOnProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeDescribesPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsDescribesProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -513,6 +722,31 @@ func (this Manager) DeserializeDescribesPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeDescriptionPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDescriptionProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDescriptionPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDescriptionProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDescriptionProperty, error) {
+		i, err := propertydescription.DeserializeDescriptionProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDiscoverablePropertyToot returns the deserialization method for the
+// "TootDiscoverableProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeDiscoverablePropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootDiscoverableProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootDiscoverableProperty, error) {
+		i, err := propertydiscoverable.DeserializeDiscoverableProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeDislikeActivityStreams returns the deserialization method for the
 // "ActivityStreamsDislike" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -552,6 +786,31 @@ func (this Manager) DeserializeDurationPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeEarlyItemsPropertyForgeFed returns the deserialization method for
+// the "ForgeFedEarlyItemsProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeEarlyItemsPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+		i, err := propertyearlyitems.DeserializeEarlyItemsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeEmojiToot returns the deserialization method for the "TootEmoji"
+// non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeEmojiToot() func(map[string]interface{}, map[string]string) (vocab.TootEmoji, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootEmoji, error) {
+		i, err := typeemoji.DeserializeEmoji(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeEndTimePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsEndTimeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -578,6 +837,57 @@ func (this Manager) DeserializeEventActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeFeaturedPropertyToot returns the deserialization method for the
+// "TootFeaturedProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeFeaturedPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFeaturedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootFeaturedProperty, error) {
+		i, err := propertyfeatured.DeserializeFeaturedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFilesAddedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedFilesAddedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeFilesAddedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+		i, err := propertyfilesadded.DeserializeFilesAddedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFilesModifiedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedFilesModifiedProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeFilesModifiedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedFilesModifiedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesModifiedProperty, error) {
+		i, err := propertyfilesmodified.DeserializeFilesModifiedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFilesRemovedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedFilesRemovedProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeFilesRemovedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedFilesRemovedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesRemovedProperty, error) {
+		i, err := propertyfilesremoved.DeserializeFilesRemovedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFirstPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsFirstProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -604,6 +914,18 @@ func (this Manager) DeserializeFlagActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeFocalPointPropertyToot returns the deserialization method for the
+// "TootFocalPointProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeFocalPointPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFocalPointProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootFocalPointProperty, error) {
+		i, err := propertyfocalpoint.DeserializeFocalPointProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFollowActivityStreams returns the deserialization method for the
 // "ActivityStreamsFollow" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -643,6 +965,18 @@ func (this Manager) DeserializeFollowingPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeForksPropertyForgeFed returns the deserialization method for the
+// "ForgeFedForksProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeForksPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedForksProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedForksProperty, error) {
+		i, err := propertyforks.DeserializeForksProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFormerTypePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsFormerTypeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -682,6 +1016,30 @@ func (this Manager) DeserializeGroupActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeHasAddressPropertyVcard returns the deserialization method for the
+// "VcardHasAddressProperty" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeHasAddressPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardHasAddressProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardHasAddressProperty, error) {
+		i, err := propertyhasaddress.DeserializeHasAddressProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeHashPropertyForgeFed returns the deserialization method for the
+// "ForgeFedHashProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeHashPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedHashProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedHashProperty, error) {
+		i, err := propertyhash.DeserializeHashProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeHeightPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsHeightProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -695,6 +1053,18 @@ func (this Manager) DeserializeHeightPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeHomeVcard returns the deserialization method for the "VcardHome"
+// non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeHomeVcard() func(map[string]interface{}, map[string]string) (vocab.VcardHome, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardHome, error) {
+		i, err := typehome.DeserializeHome(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeHrefPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsHrefProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -851,6 +1221,19 @@ func (this Manager) DeserializeInviteActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeIsResolvedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedIsResolvedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeIsResolvedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedIsResolvedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedIsResolvedProperty, error) {
+		i, err := propertyisresolved.DeserializeIsResolvedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeItemsPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsItemsProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -981,6 +1364,18 @@ func (this Manager) DeserializeListenActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeLocalityPropertyVcard returns the deserialization method for the
+// "VcardLocalityProperty" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeLocalityPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardLocalityProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardLocalityProperty, error) {
+		i, err := propertylocality.DeserializeLocalityProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeLocationPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsLocationProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1215,6 +1610,19 @@ func (this Manager) DeserializeOutboxPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeOwnerPropertySecurityV1 returns the deserialization method for the
+// "SecurityV1OwnerProperty" non-functional property in the vocabulary
+// "SecurityV1"
+func (this Manager) DeserializeOwnerPropertySecurityV1() func(map[string]interface{}, map[string]string) (vocab.SecurityV1OwnerProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SecurityV1OwnerProperty, error) {
+		i, err := propertyowner.DeserializeOwnerProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePageActivityStreams returns the deserialization method for the
 // "ActivityStreamsPage" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1267,6 +1675,18 @@ func (this Manager) DeserializePlaceActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializePostalCodePropertyVcard returns the deserialization method for the
+// "VcardPostalCodeProperty" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializePostalCodePropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardPostalCodeProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardPostalCodeProperty, error) {
+		i, err := propertypostalcode.DeserializePostalCodeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePreferredUsernamePropertyActivityStreams returns the deserialization
 // method for the "ActivityStreamsPreferredUsernameProperty" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -1319,6 +1739,44 @@ func (this Manager) DeserializeProfileActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializePublicKeyPemPropertySecurityV1 returns the deserialization method
+// for the "SecurityV1PublicKeyPemProperty" non-functional property in the
+// vocabulary "SecurityV1"
+func (this Manager) DeserializePublicKeyPemPropertySecurityV1() func(map[string]interface{}, map[string]string) (vocab.SecurityV1PublicKeyPemProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SecurityV1PublicKeyPemProperty, error) {
+		i, err := propertypublickeypem.DeserializePublicKeyPemProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializePublicKeyPropertySecurityV1 returns the deserialization method for
+// the "SecurityV1PublicKeyProperty" non-functional property in the vocabulary
+// "SecurityV1"
+func (this Manager) DeserializePublicKeyPropertySecurityV1() func(map[string]interface{}, map[string]string) (vocab.SecurityV1PublicKeyProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SecurityV1PublicKeyProperty, error) {
+		i, err := propertypublickey.DeserializePublicKeyProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializePublicKeySecurityV1 returns the deserialization method for the
+// "SecurityV1PublicKey" non-functional property in the vocabulary "SecurityV1"
+func (this Manager) DeserializePublicKeySecurityV1() func(map[string]interface{}, map[string]string) (vocab.SecurityV1PublicKey, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SecurityV1PublicKey, error) {
+		i, err := typepublickey.DeserializePublicKey(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePublishedPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsPublishedProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1332,6 +1790,18 @@ func (this Manager) DeserializePublishedPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializePushForgeFed returns the deserialization method for the
+// "ForgeFedPush" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializePushForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedPush, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedPush, error) {
+		i, err := typepush.DeserializePush(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeQuestionActivityStreams returns the deserialization method for the
 // "ActivityStreamsQuestion" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1371,6 +1841,30 @@ func (this Manager) DeserializeReadActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeRefPropertyForgeFed returns the deserialization method for the
+// "ForgeFedRefProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeRefPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRefProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedRefProperty, error) {
+		i, err := propertyref.DeserializeRefProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeRegionPropertyVcard returns the deserialization method for the
+// "VcardRegionProperty" non-functional property in the vocabulary "Vcard"
+func (this Manager) DeserializeRegionPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardRegionProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardRegionProperty, error) {
+		i, err := propertyregion.DeserializeRegionProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeRejectActivityStreams returns the deserialization method for the
 // "ActivityStreamsReject" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1449,6 +1943,44 @@ func (this Manager) DeserializeRepliesPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeRepositoryForgeFed returns the deserialization method for the
+// "ForgeFedRepository" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeRepositoryForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRepository, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedRepository, error) {
+		i, err := typerepository.DeserializeRepository(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeResolvedByPropertyForgeFed returns the deserialization method for
+// the "ForgeFedResolvedByProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeResolvedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedResolvedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedResolvedByProperty, error) {
+		i, err := propertyresolvedby.DeserializeResolvedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeResolvedPropertyForgeFed returns the deserialization method for the
+// "ForgeFedResolvedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeResolvedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedResolvedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedResolvedProperty, error) {
+		i, err := propertyresolved.DeserializeResolvedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeResultPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsResultProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1527,6 +2059,19 @@ func (this Manager) DeserializeStreamsPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeStreetAddressPropertyVcard returns the deserialization method for
+// the "VcardStreetAddressProperty" non-functional property in the vocabulary
+// "Vcard"
+func (this Manager) DeserializeStreetAddressPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardStreetAddressProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.VcardStreetAddressProperty, error) {
+		i, err := propertystreetaddress.DeserializeStreetAddressProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeSubjectPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsSubjectProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1579,6 +2124,18 @@ func (this Manager) DeserializeTargetPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeTeamPropertyForgeFed returns the deserialization method for the
+// "ForgeFedTeamProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeTeamPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTeamProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTeamProperty, error) {
+		i, err := propertyteam.DeserializeTeamProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeTentativeAcceptActivityStreams returns the deserialization method
 // for the "ActivityStreamsTentativeAccept" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1605,6 +2162,44 @@ func (this Manager) DeserializeTentativeRejectActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeTicketDependencyForgeFed returns the deserialization method for the
+// "ForgeFedTicketDependency" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeTicketDependencyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketDependency, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicketDependency, error) {
+		i, err := typeticketdependency.DeserializeTicketDependency(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeTicketForgeFed returns the deserialization method for the
+// "ForgeFedTicket" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeTicketForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicket, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicket, error) {
+		i, err := typeticket.DeserializeTicket(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeTicketsTrackedByPropertyForgeFed returns the deserialization method
+// for the "ForgeFedTicketsTrackedByProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeTicketsTrackedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error) {
+		i, err := propertyticketstrackedby.DeserializeTicketsTrackedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeToPropertyActivityStreams returns the deserialization method for the
 // "ActivityStreamsToProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1644,6 +2239,19 @@ func (this Manager) DeserializeTotalItemsPropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeTracksTicketsForPropertyForgeFed returns the deserialization method
+// for the "ForgeFedTracksTicketsForProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeTracksTicketsForPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error) {
+		i, err := propertytracksticketsfor.DeserializeTracksTicketsForProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeTravelActivityStreams returns the deserialization method for the
 // "ActivityStreamsTravel" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1761,6 +2369,18 @@ func (this Manager) DeserializeViewActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeVotersCountPropertyToot returns the deserialization method for the
+// "TootVotersCountProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeVotersCountPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootVotersCountProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootVotersCountProperty, error) {
+		i, err := propertyvoterscount.DeserializeVotersCountProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeWidthPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsWidthProperty" non-functional property in the
 // vocabulary "ActivityStreams"
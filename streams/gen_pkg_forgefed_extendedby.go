@@ -0,0 +1,53 @@
+package streams
+
+import (
+	typebranch "github.com/go-fed/activity/streams/impl/forgefed/type_branch"
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// ForgeFedBranchIsExtendedBy returns true if the other's type extends from
+// Branch. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ForgeFedBranchIsExtendedBy(other vocab.Type) bool {
+	return typebranch.BranchIsExtendedBy(other)
+}
+
+// ForgeFedCommitIsExtendedBy returns true if the other's type extends from
+// Commit. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ForgeFedCommitIsExtendedBy(other vocab.Type) bool {
+	return typecommit.CommitIsExtendedBy(other)
+}
+
+// ForgeFedPushIsExtendedBy returns true if the other's type extends from Push.
+// Note that it returns false if the types are the same; see the "IsOrExtends"
+// variant instead.
+func ForgeFedPushIsExtendedBy(other vocab.Type) bool {
+	return typepush.PushIsExtendedBy(other)
+}
+
+// ForgeFedRepositoryIsExtendedBy returns true if the other's type extends from
+// Repository. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ForgeFedRepositoryIsExtendedBy(other vocab.Type) bool {
+	return typerepository.RepositoryIsExtendedBy(other)
+}
+
+// ForgeFedTicketIsExtendedBy returns true if the other's type extends from
+// Ticket. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ForgeFedTicketIsExtendedBy(other vocab.Type) bool {
+	return typeticket.TicketIsExtendedBy(other)
+}
+
+// ForgeFedTicketDependencyIsExtendedBy returns true if the other's type extends
+// from TicketDependency. Note that it returns false if the types are the
+// same; see the "IsOrExtends" variant instead.
+func ForgeFedTicketDependencyIsExtendedBy(other vocab.Type) bool {
+	return typeticketdependency.TicketDependencyIsExtendedBy(other)
+}
@@ -0,0 +1,41 @@
+package streams
+
+import (
+	typebranch "github.com/go-fed/activity/streams/impl/forgefed/type_branch"
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewForgeFedBranch creates a new ForgeFedBranch
+func NewForgeFedBranch() vocab.ForgeFedBranch {
+	return typebranch.NewForgeFedBranch()
+}
+
+// NewForgeFedCommit creates a new ForgeFedCommit
+func NewForgeFedCommit() vocab.ForgeFedCommit {
+	return typecommit.NewForgeFedCommit()
+}
+
+// NewForgeFedPush creates a new ForgeFedPush
+func NewForgeFedPush() vocab.ForgeFedPush {
+	return typepush.NewForgeFedPush()
+}
+
+// NewForgeFedRepository creates a new ForgeFedRepository
+func NewForgeFedRepository() vocab.ForgeFedRepository {
+	return typerepository.NewForgeFedRepository()
+}
+
+// NewForgeFedTicket creates a new ForgeFedTicket
+func NewForgeFedTicket() vocab.ForgeFedTicket {
+	return typeticket.NewForgeFedTicket()
+}
+
+// NewForgeFedTicketDependency creates a new ForgeFedTicketDependency
+func NewForgeFedTicketDependency() vocab.ForgeFedTicketDependency {
+	return typeticketdependency.NewForgeFedTicketDependency()
+}
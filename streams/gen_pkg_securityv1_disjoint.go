@@ -0,0 +1,12 @@
+package streams
+
+import (
+	typepublickey "github.com/go-fed/activity/streams/impl/securityv1/type_publickey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// SecurityV1PublicKeyIsDisjointWith returns true if PublicKey is disjoint with
+// the other's type.
+func SecurityV1PublicKeyIsDisjointWith(other vocab.Type) bool {
+	return typepublickey.PublicKeyIsDisjointWith(other)
+}
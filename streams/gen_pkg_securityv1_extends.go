@@ -0,0 +1,12 @@
+package streams
+
+import (
+	typepublickey "github.com/go-fed/activity/streams/impl/securityv1/type_publickey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// SecurityV1SecurityV1PublicKeyExtends returns true if PublicKey extends from the
+// other's type.
+func SecurityV1SecurityV1PublicKeyExtends(other vocab.Type) bool {
+	return typepublickey.SecurityV1PublicKeyExtends(other)
+}
@@ -0,0 +1,12 @@
+package streams
+
+import (
+	typepublickey "github.com/go-fed/activity/streams/impl/securityv1/type_publickey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// IsOrExtendsSecurityV1PublicKey returns true if the other provided type is the
+// PublicKey type or extends from the PublicKey type.
+func IsOrExtendsSecurityV1PublicKey(other vocab.Type) bool {
+	return typepublickey.IsOrExtendsPublicKey(other)
+}
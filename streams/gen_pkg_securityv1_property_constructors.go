@@ -0,0 +1,25 @@
+package streams
+
+import (
+	propertyowner "github.com/go-fed/activity/streams/impl/securityv1/property_owner"
+	propertypublickey "github.com/go-fed/activity/streams/impl/securityv1/property_publickey"
+	propertypublickeypem "github.com/go-fed/activity/streams/impl/securityv1/property_publickeypem"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewSecurityV1SecurityV1OwnerProperty creates a new SecurityV1OwnerProperty
+func NewSecurityV1OwnerProperty() vocab.SecurityV1OwnerProperty {
+	return propertyowner.NewSecurityV1OwnerProperty()
+}
+
+// NewSecurityV1SecurityV1PublicKeyProperty creates a new
+// SecurityV1PublicKeyProperty
+func NewSecurityV1PublicKeyProperty() vocab.SecurityV1PublicKeyProperty {
+	return propertypublickey.NewSecurityV1PublicKeyProperty()
+}
+
+// NewSecurityV1SecurityV1PublicKeyPemProperty creates a new
+// SecurityV1PublicKeyPemProperty
+func NewSecurityV1PublicKeyPemProperty() vocab.SecurityV1PublicKeyPemProperty {
+	return propertypublickeypem.NewSecurityV1PublicKeyPemProperty()
+}
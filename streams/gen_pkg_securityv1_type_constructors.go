@@ -0,0 +1,11 @@
+package streams
+
+import (
+	typepublickey "github.com/go-fed/activity/streams/impl/securityv1/type_publickey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewSecurityV1PublicKey creates a new SecurityV1PublicKey
+func NewSecurityV1PublicKey() vocab.SecurityV1PublicKey {
+	return typepublickey.NewSecurityV1PublicKey()
+}
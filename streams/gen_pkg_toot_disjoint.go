@@ -0,0 +1,11 @@
+package streams
+
+import (
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// TootEmojiIsDisjointWith returns true if Emoji is disjoint with the other's type.
+func TootEmojiIsDisjointWith(other vocab.Type) bool {
+	return typeemoji.EmojiIsDisjointWith(other)
+}
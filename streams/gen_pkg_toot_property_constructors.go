@@ -0,0 +1,35 @@
+package streams
+
+import (
+	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
+	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfocalpoint "github.com/go-fed/activity/streams/impl/toot/property_focalpoint"
+	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewTootTootBlurhashProperty creates a new TootBlurhashProperty
+func NewTootBlurhashProperty() vocab.TootBlurhashProperty {
+	return propertyblurhash.NewTootBlurhashProperty()
+}
+
+// NewTootTootDiscoverableProperty creates a new TootDiscoverableProperty
+func NewTootDiscoverableProperty() vocab.TootDiscoverableProperty {
+	return propertydiscoverable.NewTootDiscoverableProperty()
+}
+
+// NewTootTootFeaturedProperty creates a new TootFeaturedProperty
+func NewTootFeaturedProperty() vocab.TootFeaturedProperty {
+	return propertyfeatured.NewTootFeaturedProperty()
+}
+
+// NewTootTootFocalPointProperty creates a new TootFocalPointProperty
+func NewTootFocalPointProperty() vocab.TootFocalPointProperty {
+	return propertyfocalpoint.NewTootFocalPointProperty()
+}
+
+// NewTootTootVotersCountProperty creates a new TootVotersCountProperty
+func NewTootVotersCountProperty() vocab.TootVotersCountProperty {
+	return propertyvoterscount.NewTootVotersCountProperty()
+}
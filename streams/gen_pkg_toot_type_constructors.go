@@ -0,0 +1,11 @@
+package streams
+
+import (
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewTootEmoji creates a new TootEmoji
+func NewTootEmoji() vocab.TootEmoji {
+	return typeemoji.NewTootEmoji()
+}
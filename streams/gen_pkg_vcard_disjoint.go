@@ -0,0 +1,18 @@
+package streams
+
+import (
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// VcardAddressIsDisjointWith returns true if Address is disjoint with the other's
+// type.
+func VcardAddressIsDisjointWith(other vocab.Type) bool {
+	return typeaddress.AddressIsDisjointWith(other)
+}
+
+// VcardHomeIsDisjointWith returns true if Home is disjoint with the other's type.
+func VcardHomeIsDisjointWith(other vocab.Type) bool {
+	return typehome.HomeIsDisjointWith(other)
+}
@@ -0,0 +1,21 @@
+package streams
+
+import (
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// VcardAddressIsExtendedBy returns true if the other's type extends from Address.
+// Note that it returns false if the types are the same; see the "IsOrExtends"
+// variant instead.
+func VcardAddressIsExtendedBy(other vocab.Type) bool {
+	return typeaddress.AddressIsExtendedBy(other)
+}
+
+// VcardHomeIsExtendedBy returns true if the other's type extends from Home. Note
+// that it returns false if the types are the same; see the "IsOrExtends"
+// variant instead.
+func VcardHomeIsExtendedBy(other vocab.Type) bool {
+	return typehome.HomeIsExtendedBy(other)
+}
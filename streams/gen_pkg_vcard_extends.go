@@ -0,0 +1,17 @@
+package streams
+
+import (
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// VcardVcardAddressExtends returns true if Address extends from the other's type.
+func VcardVcardAddressExtends(other vocab.Type) bool {
+	return typeaddress.VcardAddressExtends(other)
+}
+
+// VcardVcardHomeExtends returns true if Home extends from the other's type.
+func VcardVcardHomeExtends(other vocab.Type) bool {
+	return typehome.VcardHomeExtends(other)
+}
@@ -0,0 +1,19 @@
+package streams
+
+import (
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// IsOrExtendsVcardAddress returns true if the other provided type is the Address
+// type or extends from the Address type.
+func IsOrExtendsVcardAddress(other vocab.Type) bool {
+	return typeaddress.IsOrExtendsAddress(other)
+}
+
+// IsOrExtendsVcardHome returns true if the other provided type is the Home type
+// or extends from the Home type.
+func IsOrExtendsVcardHome(other vocab.Type) bool {
+	return typehome.IsOrExtendsHome(other)
+}
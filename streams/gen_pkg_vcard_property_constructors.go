@@ -0,0 +1,47 @@
+package streams
+
+import (
+	propertybday "github.com/go-fed/activity/streams/impl/vcard/property_bday"
+	propertycountryname "github.com/go-fed/activity/streams/impl/vcard/property_country-name"
+	propertyhasaddress "github.com/go-fed/activity/streams/impl/vcard/property_hasaddress"
+	propertylocality "github.com/go-fed/activity/streams/impl/vcard/property_locality"
+	propertypostalcode "github.com/go-fed/activity/streams/impl/vcard/property_postal-code"
+	propertyregion "github.com/go-fed/activity/streams/impl/vcard/property_region"
+	propertystreetaddress "github.com/go-fed/activity/streams/impl/vcard/property_street-address"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewVcardVcardBdayProperty creates a new VcardBdayProperty
+func NewVcardBdayProperty() vocab.VcardBdayProperty {
+	return propertybday.NewVcardBdayProperty()
+}
+
+// NewVcardVcardCountryNameProperty creates a new VcardCountryNameProperty
+func NewVcardCountryNameProperty() vocab.VcardCountryNameProperty {
+	return propertycountryname.NewVcardCountryNameProperty()
+}
+
+// NewVcardVcardHasAddressProperty creates a new VcardHasAddressProperty
+func NewVcardHasAddressProperty() vocab.VcardHasAddressProperty {
+	return propertyhasaddress.NewVcardHasAddressProperty()
+}
+
+// NewVcardVcardLocalityProperty creates a new VcardLocalityProperty
+func NewVcardLocalityProperty() vocab.VcardLocalityProperty {
+	return propertylocality.NewVcardLocalityProperty()
+}
+
+// NewVcardVcardPostalCodeProperty creates a new VcardPostalCodeProperty
+func NewVcardPostalCodeProperty() vocab.VcardPostalCodeProperty {
+	return propertypostalcode.NewVcardPostalCodeProperty()
+}
+
+// NewVcardVcardRegionProperty creates a new VcardRegionProperty
+func NewVcardRegionProperty() vocab.VcardRegionProperty {
+	return propertyregion.NewVcardRegionProperty()
+}
+
+// NewVcardVcardStreetAddressProperty creates a new VcardStreetAddressProperty
+func NewVcardStreetAddressProperty() vocab.VcardStreetAddressProperty {
+	return propertystreetaddress.NewVcardStreetAddressProperty()
+}
@@ -0,0 +1,17 @@
+package streams
+
+import (
+	typeaddress "github.com/go-fed/activity/streams/impl/vcard/type_address"
+	typehome "github.com/go-fed/activity/streams/impl/vcard/type_home"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewVcardAddress creates a new VcardAddress
+func NewVcardAddress() vocab.VcardAddress {
+	return typeaddress.NewVcardAddress()
+}
+
+// NewVcardHome creates a new VcardHome
+func NewVcardHome() vocab.VcardHome {
+	return typehome.NewVcardHome()
+}
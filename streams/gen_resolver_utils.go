@@ -56,6 +56,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsAdd) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.VcardAddress) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsAnnounce) error {
 		t = i
 		return nil
@@ -74,12 +77,18 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsBlock) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedBranch) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsCollection) error {
 		t = i
 		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsCollectionPage) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedCommit) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsCreate) error {
 		t = i
 		return nil
@@ -92,6 +101,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsDocument) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.TootEmoji) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsEvent) error {
 		t = i
 		return nil
@@ -104,6 +116,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsGroup) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.VcardHome) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsIgnore) error {
 		t = i
 		return nil
@@ -167,6 +182,12 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsProfile) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.SecurityV1PublicKey) error {
+		t = i
+		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedPush) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsQuestion) error {
 		t = i
 		return nil
@@ -182,6 +203,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsRemove) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedRepository) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsService) error {
 		t = i
 		return nil
@@ -191,6 +215,12 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsTentativeReject) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedTicket) error {
+		t = i
+		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedTicketDependency) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsTombstone) error {
 		t = i
 		return nil
@@ -33,6 +33,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsAdd) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.VcardAddress) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsAnnounce) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsApplication) (bool, error):
@@ -45,10 +47,14 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsBlock) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ForgeFedBranch) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsCollection) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsCollectionPage) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ForgeFedCommit) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsCreate) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsDelete) (bool, error):
@@ -57,6 +63,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsDocument) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.TootEmoji) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsEvent) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsFlag) (bool, error):
@@ -65,6 +73,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsGroup) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.VcardHome) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsIgnore) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsImage) (bool, error):
@@ -107,6 +117,10 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsProfile) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.SecurityV1PublicKey) (bool, error):
+		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ForgeFedPush) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsQuestion) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsRead) (bool, error):
@@ -117,12 +131,18 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsRemove) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ForgeFedRepository) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsService) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsTentativeAccept) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsTentativeReject) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ForgeFedTicket) (bool, error):
+		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ForgeFedTicketDependency) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsTombstone) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsTravel) (bool, error):
@@ -187,6 +207,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "http://www.w3.org/2006/vcard/ns" && o.GetTypeName() == "Address" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.VcardAddress) (bool, error)); ok {
+			if v, ok := o.(vocab.VcardAddress); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Announce" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsAnnounce) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsAnnounce); ok {
@@ -253,6 +284,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Branch" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ForgeFedBranch) (bool, error)); ok {
+			if v, ok := o.(vocab.ForgeFedBranch); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Collection" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsCollection) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsCollection); ok {
@@ -275,6 +317,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Commit" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ForgeFedCommit) (bool, error)); ok {
+			if v, ok := o.(vocab.ForgeFedCommit); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Create" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsCreate) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsCreate); ok {
@@ -319,6 +372,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "http://joinmastodon.org/ns" && o.GetTypeName() == "Emoji" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.TootEmoji) (bool, error)); ok {
+			if v, ok := o.(vocab.TootEmoji); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Event" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsEvent) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsEvent); ok {
@@ -363,6 +427,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "http://www.w3.org/2006/vcard/ns" && o.GetTypeName() == "Home" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.VcardHome) (bool, error)); ok {
+			if v, ok := o.(vocab.VcardHome); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Ignore" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsIgnore) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsIgnore); ok {
@@ -594,6 +669,28 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://w3id.org/security/v1" && o.GetTypeName() == "PublicKey" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.SecurityV1PublicKey) (bool, error)); ok {
+			if v, ok := o.(vocab.SecurityV1PublicKey); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
+	} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Push" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ForgeFedPush) (bool, error)); ok {
+			if v, ok := o.(vocab.ForgeFedPush); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Question" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsQuestion) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsQuestion); ok {
@@ -649,6 +746,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Repository" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ForgeFedRepository) (bool, error)); ok {
+			if v, ok := o.(vocab.ForgeFedRepository); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Service" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsService) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsService); ok {
@@ -682,6 +790,28 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Ticket" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ForgeFedTicket) (bool, error)); ok {
+			if v, ok := o.(vocab.ForgeFedTicket); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
+	} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "TicketDependency" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ForgeFedTicketDependency) (bool, error)); ok {
+			if v, ok := o.(vocab.ForgeFedTicketDependency); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Tombstone" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsTombstone) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsTombstone); ok {
@@ -32,6 +32,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsAdd) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.VcardAddress) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsAnnounce) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsApplication) error:
@@ -44,10 +46,14 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedBranch) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCollection) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCollectionPage) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedCommit) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCreate) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDelete) error:
@@ -56,6 +62,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDocument) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.TootEmoji) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsEvent) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsFlag) error:
@@ -64,6 +72,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsGroup) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.VcardHome) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsIgnore) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsImage) error:
@@ -106,6 +116,10 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsProfile) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.SecurityV1PublicKey) error:
+			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedPush) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsQuestion) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRead) error:
@@ -116,12 +130,18 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRemove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedRepository) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsService) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicket) error:
+			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicketDependency) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTombstone) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTravel) error:
@@ -176,6 +196,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "http://www.w3.org/2006/vcard/ns" && o.GetTypeName() == "Address" {
+			if fn, ok := i.(func(context.Context, vocab.VcardAddress) error); ok {
+				if v, ok := o.(vocab.VcardAddress); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Announce" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsAnnounce) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsAnnounce); ok {
@@ -230,6 +259,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Branch" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedBranch) error); ok {
+				if v, ok := o.(vocab.ForgeFedBranch); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Collection" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsCollection) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsCollection); ok {
@@ -248,6 +286,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Commit" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedCommit) error); ok {
+				if v, ok := o.(vocab.ForgeFedCommit); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Create" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsCreate) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsCreate); ok {
@@ -284,6 +331,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "http://joinmastodon.org/ns" && o.GetTypeName() == "Emoji" {
+			if fn, ok := i.(func(context.Context, vocab.TootEmoji) error); ok {
+				if v, ok := o.(vocab.TootEmoji); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Event" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsEvent) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsEvent); ok {
@@ -320,6 +376,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "http://www.w3.org/2006/vcard/ns" && o.GetTypeName() == "Home" {
+			if fn, ok := i.(func(context.Context, vocab.VcardHome) error); ok {
+				if v, ok := o.(vocab.VcardHome); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Ignore" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsIgnore) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsIgnore); ok {
@@ -509,6 +574,24 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://w3id.org/security/v1" && o.GetTypeName() == "PublicKey" {
+			if fn, ok := i.(func(context.Context, vocab.SecurityV1PublicKey) error); ok {
+				if v, ok := o.(vocab.SecurityV1PublicKey); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Push" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedPush) error); ok {
+				if v, ok := o.(vocab.ForgeFedPush); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Question" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsQuestion) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsQuestion); ok {
@@ -554,6 +637,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Repository" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedRepository) error); ok {
+				if v, ok := o.(vocab.ForgeFedRepository); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Service" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsService) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsService); ok {
@@ -581,6 +673,24 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Ticket" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedTicket) error); ok {
+				if v, ok := o.(vocab.ForgeFedTicket); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "TicketDependency" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedTicketDependency) error); ok {
+				if v, ok := o.(vocab.ForgeFedTicketDependency); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Tombstone" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsTombstone) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsTombstone); ok {
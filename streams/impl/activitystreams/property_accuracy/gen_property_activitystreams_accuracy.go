@@ -30,6 +30,10 @@ func DeserializeAccuracyProperty(m map[string]interface{}, aliasMap map[string]s
 		propName = fmt.Sprintf("%s:%s", alias, "accuracy")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["accuracy"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsAccuracyProperty) LessThan(o vocab.ActivityStreamsAccu
 	}
 }
 
-// Name returns the name of this property: "accuracy".
+// Name returns the name of this property: "accuracy", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsAccuracyProperty) Name() string {
-	return "accuracy"
+	if len(this.alias) > 0 {
+		return this.alias + ":accuracy"
+	} else {
+		return "accuracy"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
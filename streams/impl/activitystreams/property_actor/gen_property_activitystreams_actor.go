@@ -17,22 +17,27 @@ type ActivityStreamsActorPropertyIterator struct {
 	activitystreamsAcceptMember                vocab.ActivityStreamsAccept
 	activitystreamsActivityMember              vocab.ActivityStreamsActivity
 	activitystreamsAddMember                   vocab.ActivityStreamsAdd
+	vcardAddressMember                         vocab.VcardAddress
 	activitystreamsAnnounceMember              vocab.ActivityStreamsAnnounce
 	activitystreamsApplicationMember           vocab.ActivityStreamsApplication
 	activitystreamsArriveMember                vocab.ActivityStreamsArrive
 	activitystreamsArticleMember               vocab.ActivityStreamsArticle
 	activitystreamsAudioMember                 vocab.ActivityStreamsAudio
 	activitystreamsBlockMember                 vocab.ActivityStreamsBlock
+	forgefedBranchMember                       vocab.ForgeFedBranch
 	activitystreamsCollectionMember            vocab.ActivityStreamsCollection
 	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
+	forgefedCommitMember                       vocab.ForgeFedCommit
 	activitystreamsCreateMember                vocab.ActivityStreamsCreate
 	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	tootEmojiMember                            vocab.TootEmoji
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
 	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	vcardHomeMember                            vocab.VcardHome
 	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
 	activitystreamsImageMember                 vocab.ActivityStreamsImage
 	activitystreamsIntransitiveActivityMember  vocab.ActivityStreamsIntransitiveActivity
@@ -52,14 +57,19 @@ type ActivityStreamsActorPropertyIterator struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	securityv1PublicKeyMember                  vocab.SecurityV1PublicKey
+	forgefedPushMember                         vocab.ForgeFedPush
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
 	activitystreamsRejectMember                vocab.ActivityStreamsReject
 	activitystreamsRelationshipMember          vocab.ActivityStreamsRelationship
 	activitystreamsRemoveMember                vocab.ActivityStreamsRemove
+	forgefedRepositoryMember                   vocab.ForgeFedRepository
 	activitystreamsServiceMember               vocab.ActivityStreamsService
 	activitystreamsTentativeAcceptMember       vocab.ActivityStreamsTentativeAccept
 	activitystreamsTentativeRejectMember       vocab.ActivityStreamsTentativeReject
+	forgefedTicketMember                       vocab.ForgeFedTicket
+	forgefedTicketDependencyMember             vocab.ForgeFedTicketDependency
 	activitystreamsTombstoneMember             vocab.ActivityStreamsTombstone
 	activitystreamsTravelMember                vocab.ActivityStreamsTravel
 	activitystreamsUndoMember                  vocab.ActivityStreamsUndo
@@ -99,6 +109,7 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
+
 		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsObjectMember: v,
@@ -129,6 +140,12 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                    alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeAddressVcard()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:              alias,
+				vcardAddressMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsAnnounceMember: v,
@@ -165,6 +182,12 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                      alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:                alias,
+				forgefedBranchMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsCollectionMember: v,
@@ -177,6 +200,12 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                               alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:                alias,
+				forgefedCommitMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsCreateMember: v,
@@ -201,6 +230,12 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                         alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:           alias,
+				tootEmojiMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsEventMember: v,
@@ -225,6 +260,12 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                      alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeHomeVcard()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:           alias,
+				vcardHomeMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsIgnoreMember: v,
@@ -339,6 +380,18 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                        alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializePublicKeySecurityV1()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:                     alias,
+				securityv1PublicKeyMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:              alias,
+				forgefedPushMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsQuestionMember: v,
@@ -369,6 +422,12 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                       alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:                    alias,
+				forgefedRepositoryMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsServiceMember: v,
@@ -387,6 +446,18 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 				alias:                                alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:                alias,
+				forgefedTicketMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsActorPropertyIterator{
+				alias:                          alias,
+				forgefedTicketDependencyMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsActorPropertyIterator{
 				activitystreamsTombstoneMember: v,
@@ -810,12 +881,63 @@ func (this ActivityStreamsActorPropertyIterator) GetActivityStreamsView() vocab.
 	return this.activitystreamsViewMember
 }
 
+// GetForgeFedBranch returns the value of this property. When IsForgeFedBranch
+// returns false, GetForgeFedBranch will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetForgeFedBranch() vocab.ForgeFedBranch {
+	return this.forgefedBranchMember
+}
+
+// GetForgeFedCommit returns the value of this property. When IsForgeFedCommit
+// returns false, GetForgeFedCommit will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetForgeFedCommit() vocab.ForgeFedCommit {
+	return this.forgefedCommitMember
+}
+
+// GetForgeFedPush returns the value of this property. When IsForgeFedPush returns
+// false, GetForgeFedPush will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetForgeFedPush() vocab.ForgeFedPush {
+	return this.forgefedPushMember
+}
+
+// GetForgeFedRepository returns the value of this property. When
+// IsForgeFedRepository returns false, GetForgeFedRepository will return an
+// arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetForgeFedRepository() vocab.ForgeFedRepository {
+	return this.forgefedRepositoryMember
+}
+
+// GetForgeFedTicket returns the value of this property. When IsForgeFedTicket
+// returns false, GetForgeFedTicket will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetForgeFedTicket() vocab.ForgeFedTicket {
+	return this.forgefedTicketMember
+}
+
+// GetForgeFedTicketDependency returns the value of this property. When
+// IsForgeFedTicketDependency returns false, GetForgeFedTicketDependency will
+// return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetForgeFedTicketDependency() vocab.ForgeFedTicketDependency {
+	return this.forgefedTicketDependencyMember
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsActorPropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
+// GetSecurityV1PublicKey returns the value of this property. When
+// IsSecurityV1PublicKey returns false, GetSecurityV1PublicKey will return an
+// arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetSecurityV1PublicKey() vocab.SecurityV1PublicKey {
+	return this.securityv1PublicKeyMember
+}
+
+// GetTootEmoji returns the value of this property. When IsTootEmoji returns
+// false, GetTootEmoji will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetTootEmoji() vocab.TootEmoji {
+	return this.tootEmojiMember
+}
+
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
 func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
@@ -834,6 +956,9 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsAdd() {
 		return this.GetActivityStreamsAdd()
 	}
+	if this.IsVcardAddress() {
+		return this.GetVcardAddress()
+	}
 	if this.IsActivityStreamsAnnounce() {
 		return this.GetActivityStreamsAnnounce()
 	}
@@ -852,12 +977,18 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsBlock() {
 		return this.GetActivityStreamsBlock()
 	}
+	if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch()
+	}
 	if this.IsActivityStreamsCollection() {
 		return this.GetActivityStreamsCollection()
 	}
 	if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage()
 	}
+	if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit()
+	}
 	if this.IsActivityStreamsCreate() {
 		return this.GetActivityStreamsCreate()
 	}
@@ -870,6 +1001,9 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument()
 	}
+	if this.IsTootEmoji() {
+		return this.GetTootEmoji()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -882,6 +1016,9 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup()
 	}
+	if this.IsVcardHome() {
+		return this.GetVcardHome()
+	}
 	if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore()
 	}
@@ -939,6 +1076,12 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey()
+	}
+	if this.IsForgeFedPush() {
+		return this.GetForgeFedPush()
+	}
 	if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion()
 	}
@@ -954,6 +1097,9 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsRemove() {
 		return this.GetActivityStreamsRemove()
 	}
+	if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository()
+	}
 	if this.IsActivityStreamsService() {
 		return this.GetActivityStreamsService()
 	}
@@ -963,6 +1109,12 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsTentativeReject() {
 		return this.GetActivityStreamsTentativeReject()
 	}
+	if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket()
+	}
+	if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency()
+	}
 	if this.IsActivityStreamsTombstone() {
 		return this.GetActivityStreamsTombstone()
 	}
@@ -985,6 +1137,18 @@ func (this ActivityStreamsActorPropertyIterator) GetType() vocab.Type {
 	return nil
 }
 
+// GetVcardAddress returns the value of this property. When IsVcardAddress returns
+// false, GetVcardAddress will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetVcardAddress() vocab.VcardAddress {
+	return this.vcardAddressMember
+}
+
+// GetVcardHome returns the value of this property. When IsVcardHome returns
+// false, GetVcardHome will return an arbitrary value.
+func (this ActivityStreamsActorPropertyIterator) GetVcardHome() vocab.VcardHome {
+	return this.vcardHomeMember
+}
+
 // HasAny returns true if any of the different values is set.
 func (this ActivityStreamsActorPropertyIterator) HasAny() bool {
 	return this.IsActivityStreamsObject() ||
@@ -992,22 +1156,27 @@ func (this ActivityStreamsActorPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsAccept() ||
 		this.IsActivityStreamsActivity() ||
 		this.IsActivityStreamsAdd() ||
+		this.IsVcardAddress() ||
 		this.IsActivityStreamsAnnounce() ||
 		this.IsActivityStreamsApplication() ||
 		this.IsActivityStreamsArrive() ||
 		this.IsActivityStreamsArticle() ||
 		this.IsActivityStreamsAudio() ||
 		this.IsActivityStreamsBlock() ||
+		this.IsForgeFedBranch() ||
 		this.IsActivityStreamsCollection() ||
 		this.IsActivityStreamsCollectionPage() ||
+		this.IsForgeFedCommit() ||
 		this.IsActivityStreamsCreate() ||
 		this.IsActivityStreamsDelete() ||
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
+		this.IsTootEmoji() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
 		this.IsActivityStreamsGroup() ||
+		this.IsVcardHome() ||
 		this.IsActivityStreamsIgnore() ||
 		this.IsActivityStreamsImage() ||
 		this.IsActivityStreamsIntransitiveActivity() ||
@@ -1027,14 +1196,19 @@ func (this ActivityStreamsActorPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsSecurityV1PublicKey() ||
+		this.IsForgeFedPush() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
 		this.IsActivityStreamsReject() ||
 		this.IsActivityStreamsRelationship() ||
 		this.IsActivityStreamsRemove() ||
+		this.IsForgeFedRepository() ||
 		this.IsActivityStreamsService() ||
 		this.IsActivityStreamsTentativeAccept() ||
 		this.IsActivityStreamsTentativeReject() ||
+		this.IsForgeFedTicket() ||
+		this.IsForgeFedTicketDependency() ||
 		this.IsActivityStreamsTombstone() ||
 		this.IsActivityStreamsTravel() ||
 		this.IsActivityStreamsUndo() ||
@@ -1427,12 +1601,80 @@ func (this ActivityStreamsActorPropertyIterator) IsActivityStreamsView() bool {
 	return this.activitystreamsViewMember != nil
 }
 
+// IsForgeFedBranch returns true if this property has a type of "Branch". When
+// true, use the GetForgeFedBranch and SetForgeFedBranch methods to access and
+// set this property.
+func (this ActivityStreamsActorPropertyIterator) IsForgeFedBranch() bool {
+	return this.forgefedBranchMember != nil
+}
+
+// IsForgeFedCommit returns true if this property has a type of "Commit". When
+// true, use the GetForgeFedCommit and SetForgeFedCommit methods to access and
+// set this property.
+func (this ActivityStreamsActorPropertyIterator) IsForgeFedCommit() bool {
+	return this.forgefedCommitMember != nil
+}
+
+// IsForgeFedPush returns true if this property has a type of "Push". When true,
+// use the GetForgeFedPush and SetForgeFedPush methods to access and set this
+// property.
+func (this ActivityStreamsActorPropertyIterator) IsForgeFedPush() bool {
+	return this.forgefedPushMember != nil
+}
+
+// IsForgeFedRepository returns true if this property has a type of "Repository".
+// When true, use the GetForgeFedRepository and SetForgeFedRepository methods
+// to access and set this property.
+func (this ActivityStreamsActorPropertyIterator) IsForgeFedRepository() bool {
+	return this.forgefedRepositoryMember != nil
+}
+
+// IsForgeFedTicket returns true if this property has a type of "Ticket". When
+// true, use the GetForgeFedTicket and SetForgeFedTicket methods to access and
+// set this property.
+func (this ActivityStreamsActorPropertyIterator) IsForgeFedTicket() bool {
+	return this.forgefedTicketMember != nil
+}
+
+// IsForgeFedTicketDependency returns true if this property has a type of
+// "TicketDependency". When true, use the GetForgeFedTicketDependency and
+// SetForgeFedTicketDependency methods to access and set this property.
+func (this ActivityStreamsActorPropertyIterator) IsForgeFedTicketDependency() bool {
+	return this.forgefedTicketDependencyMember != nil
+}
+
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
 func (this ActivityStreamsActorPropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
+// IsSecurityV1PublicKey returns true if this property has a type of "PublicKey".
+// When true, use the GetSecurityV1PublicKey and SetSecurityV1PublicKey
+// methods to access and set this property.
+func (this ActivityStreamsActorPropertyIterator) IsSecurityV1PublicKey() bool {
+	return this.securityv1PublicKeyMember != nil
+}
+
+// IsTootEmoji returns true if this property has a type of "Emoji". When true, use
+// the GetTootEmoji and SetTootEmoji methods to access and set this property.
+func (this ActivityStreamsActorPropertyIterator) IsTootEmoji() bool {
+	return this.tootEmojiMember != nil
+}
+
+// IsVcardAddress returns true if this property has a type of "Address". When
+// true, use the GetVcardAddress and SetVcardAddress methods to access and set
+// this property.
+func (this ActivityStreamsActorPropertyIterator) IsVcardAddress() bool {
+	return this.vcardAddressMember != nil
+}
+
+// IsVcardHome returns true if this property has a type of "Home". When true, use
+// the GetVcardHome and SetVcardHome methods to access and set this property.
+func (this ActivityStreamsActorPropertyIterator) IsVcardHome() bool {
+	return this.vcardHomeMember != nil
+}
+
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
@@ -1449,6 +1691,8 @@ func (this ActivityStreamsActorPropertyIterator) JSONLDContext() map[string]stri
 		child = this.GetActivityStreamsActivity().JSONLDContext()
 	} else if this.IsActivityStreamsAdd() {
 		child = this.GetActivityStreamsAdd().JSONLDContext()
+	} else if this.IsVcardAddress() {
+		child = this.GetVcardAddress().JSONLDContext()
 	} else if this.IsActivityStreamsAnnounce() {
 		child = this.GetActivityStreamsAnnounce().JSONLDContext()
 	} else if this.IsActivityStreamsApplication() {
@@ -1461,10 +1705,14 @@ func (this ActivityStreamsActorPropertyIterator) JSONLDContext() map[string]stri
 		child = this.GetActivityStreamsAudio().JSONLDContext()
 	} else if this.IsActivityStreamsBlock() {
 		child = this.GetActivityStreamsBlock().JSONLDContext()
+	} else if this.IsForgeFedBranch() {
+		child = this.GetForgeFedBranch().JSONLDContext()
 	} else if this.IsActivityStreamsCollection() {
 		child = this.GetActivityStreamsCollection().JSONLDContext()
 	} else if this.IsActivityStreamsCollectionPage() {
 		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
+	} else if this.IsForgeFedCommit() {
+		child = this.GetForgeFedCommit().JSONLDContext()
 	} else if this.IsActivityStreamsCreate() {
 		child = this.GetActivityStreamsCreate().JSONLDContext()
 	} else if this.IsActivityStreamsDelete() {
@@ -1473,6 +1721,8 @@ func (this ActivityStreamsActorPropertyIterator) JSONLDContext() map[string]stri
 		child = this.GetActivityStreamsDislike().JSONLDContext()
 	} else if this.IsActivityStreamsDocument() {
 		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsTootEmoji() {
+		child = this.GetTootEmoji().JSONLDContext()
 	} else if this.IsActivityStreamsEvent() {
 		child = this.GetActivityStreamsEvent().JSONLDContext()
 	} else if this.IsActivityStreamsFlag() {
@@ -1481,6 +1731,8 @@ func (this ActivityStreamsActorPropertyIterator) JSONLDContext() map[string]stri
 		child = this.GetActivityStreamsFollow().JSONLDContext()
 	} else if this.IsActivityStreamsGroup() {
 		child = this.GetActivityStreamsGroup().JSONLDContext()
+	} else if this.IsVcardHome() {
+		child = this.GetVcardHome().JSONLDContext()
 	} else if this.IsActivityStreamsIgnore() {
 		child = this.GetActivityStreamsIgnore().JSONLDContext()
 	} else if this.IsActivityStreamsImage() {
@@ -1519,6 +1771,10 @@ func (this ActivityStreamsActorPropertyIterator) JSONLDContext() map[string]stri
 		child = this.GetActivityStreamsPlace().JSONLDContext()
 	} else if this.IsActivityStreamsProfile() {
 		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsSecurityV1PublicKey() {
+		child = this.GetSecurityV1PublicKey().JSONLDContext()
+	} else if this.IsForgeFedPush() {
+		child = this.GetForgeFedPush().JSONLDContext()
 	} else if this.IsActivityStreamsQuestion() {
 		child = this.GetActivityStreamsQuestion().JSONLDContext()
 	} else if this.IsActivityStreamsRead() {
@@ -1529,12 +1785,18 @@ func (this ActivityStreamsActorPropertyIterator) JSONLDContext() map[string]stri
 		child = this.GetActivityStreamsRelationship().JSONLDContext()
 	} else if this.IsActivityStreamsRemove() {
 		child = this.GetActivityStreamsRemove().JSONLDContext()
+	} else if this.IsForgeFedRepository() {
+		child = this.GetForgeFedRepository().JSONLDContext()
 	} else if this.IsActivityStreamsService() {
 		child = this.GetActivityStreamsService().JSONLDContext()
 	} else if this.IsActivityStreamsTentativeAccept() {
 		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
 	} else if this.IsActivityStreamsTentativeReject() {
 		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
+	} else if this.IsForgeFedTicket() {
+		child = this.GetForgeFedTicket().JSONLDContext()
+	} else if this.IsForgeFedTicketDependency() {
+		child = this.GetForgeFedTicketDependency().JSONLDContext()
 	} else if this.IsActivityStreamsTombstone() {
 		child = this.GetActivityStreamsTombstone().JSONLDContext()
 	} else if this.IsActivityStreamsTravel() {
@@ -1578,153 +1840,183 @@ func (this ActivityStreamsActorPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsAdd() {
 		return 4
 	}
-	if this.IsActivityStreamsAnnounce() {
+	if this.IsVcardAddress() {
 		return 5
 	}
-	if this.IsActivityStreamsApplication() {
+	if this.IsActivityStreamsAnnounce() {
 		return 6
 	}
-	if this.IsActivityStreamsArrive() {
+	if this.IsActivityStreamsApplication() {
 		return 7
 	}
-	if this.IsActivityStreamsArticle() {
+	if this.IsActivityStreamsArrive() {
 		return 8
 	}
-	if this.IsActivityStreamsAudio() {
+	if this.IsActivityStreamsArticle() {
 		return 9
 	}
-	if this.IsActivityStreamsBlock() {
+	if this.IsActivityStreamsAudio() {
 		return 10
 	}
-	if this.IsActivityStreamsCollection() {
+	if this.IsActivityStreamsBlock() {
 		return 11
 	}
-	if this.IsActivityStreamsCollectionPage() {
+	if this.IsForgeFedBranch() {
 		return 12
 	}
-	if this.IsActivityStreamsCreate() {
+	if this.IsActivityStreamsCollection() {
 		return 13
 	}
-	if this.IsActivityStreamsDelete() {
+	if this.IsActivityStreamsCollectionPage() {
 		return 14
 	}
-	if this.IsActivityStreamsDislike() {
+	if this.IsForgeFedCommit() {
 		return 15
 	}
-	if this.IsActivityStreamsDocument() {
+	if this.IsActivityStreamsCreate() {
 		return 16
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsActivityStreamsDelete() {
 		return 17
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsDislike() {
 		return 18
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsDocument() {
 		return 19
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsTootEmoji() {
 		return 20
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsActivityStreamsEvent() {
 		return 21
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsActivityStreamsFlag() {
 		return 22
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsFollow() {
 		return 23
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsGroup() {
 		return 24
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsVcardHome() {
 		return 25
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsIgnore() {
 		return 26
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsImage() {
 		return 27
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 28
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsInvite() {
 		return 29
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsJoin() {
 		return 30
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsLeave() {
 		return 31
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsLike() {
 		return 32
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsListen() {
 		return 33
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsMention() {
 		return 34
 	}
-	if this.IsActivityStreamsOrganization() {
+	if this.IsActivityStreamsMove() {
 		return 35
 	}
-	if this.IsActivityStreamsPage() {
+	if this.IsActivityStreamsNote() {
 		return 36
 	}
-	if this.IsActivityStreamsPerson() {
+	if this.IsActivityStreamsOffer() {
 		return 37
 	}
-	if this.IsActivityStreamsPlace() {
+	if this.IsActivityStreamsOrderedCollection() {
 		return 38
 	}
-	if this.IsActivityStreamsProfile() {
+	if this.IsActivityStreamsOrderedCollectionPage() {
 		return 39
 	}
-	if this.IsActivityStreamsQuestion() {
+	if this.IsActivityStreamsOrganization() {
 		return 40
 	}
-	if this.IsActivityStreamsRead() {
+	if this.IsActivityStreamsPage() {
 		return 41
 	}
-	if this.IsActivityStreamsReject() {
+	if this.IsActivityStreamsPerson() {
 		return 42
 	}
-	if this.IsActivityStreamsRelationship() {
+	if this.IsActivityStreamsPlace() {
 		return 43
 	}
-	if this.IsActivityStreamsRemove() {
+	if this.IsActivityStreamsProfile() {
 		return 44
 	}
-	if this.IsActivityStreamsService() {
+	if this.IsSecurityV1PublicKey() {
 		return 45
 	}
-	if this.IsActivityStreamsTentativeAccept() {
+	if this.IsForgeFedPush() {
 		return 46
 	}
-	if this.IsActivityStreamsTentativeReject() {
+	if this.IsActivityStreamsQuestion() {
 		return 47
 	}
-	if this.IsActivityStreamsTombstone() {
+	if this.IsActivityStreamsRead() {
 		return 48
 	}
-	if this.IsActivityStreamsTravel() {
+	if this.IsActivityStreamsReject() {
 		return 49
 	}
-	if this.IsActivityStreamsUndo() {
+	if this.IsActivityStreamsRelationship() {
 		return 50
 	}
-	if this.IsActivityStreamsUpdate() {
+	if this.IsActivityStreamsRemove() {
 		return 51
 	}
-	if this.IsActivityStreamsVideo() {
+	if this.IsForgeFedRepository() {
 		return 52
 	}
-	if this.IsActivityStreamsView() {
+	if this.IsActivityStreamsService() {
 		return 53
 	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return 54
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return 55
+	}
+	if this.IsForgeFedTicket() {
+		return 56
+	}
+	if this.IsForgeFedTicketDependency() {
+		return 57
+	}
+	if this.IsActivityStreamsTombstone() {
+		return 58
+	}
+	if this.IsActivityStreamsTravel() {
+		return 59
+	}
+	if this.IsActivityStreamsUndo() {
+		return 60
+	}
+	if this.IsActivityStreamsUpdate() {
+		return 61
+	}
+	if this.IsActivityStreamsVideo() {
+		return 62
+	}
+	if this.IsActivityStreamsView() {
+		return 63
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -1752,6 +2044,8 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 		return this.GetActivityStreamsActivity().LessThan(o.GetActivityStreamsActivity())
 	} else if this.IsActivityStreamsAdd() {
 		return this.GetActivityStreamsAdd().LessThan(o.GetActivityStreamsAdd())
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().LessThan(o.GetVcardAddress())
 	} else if this.IsActivityStreamsAnnounce() {
 		return this.GetActivityStreamsAnnounce().LessThan(o.GetActivityStreamsAnnounce())
 	} else if this.IsActivityStreamsApplication() {
@@ -1764,10 +2058,14 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 		return this.GetActivityStreamsAudio().LessThan(o.GetActivityStreamsAudio())
 	} else if this.IsActivityStreamsBlock() {
 		return this.GetActivityStreamsBlock().LessThan(o.GetActivityStreamsBlock())
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().LessThan(o.GetForgeFedBranch())
 	} else if this.IsActivityStreamsCollection() {
 		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
 	} else if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
 	} else if this.IsActivityStreamsCreate() {
 		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
 	} else if this.IsActivityStreamsDelete() {
@@ -1776,6 +2074,8 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
 	} else if this.IsActivityStreamsFlag() {
@@ -1784,6 +2084,8 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().LessThan(o.GetVcardHome())
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
 	} else if this.IsActivityStreamsImage() {
@@ -1822,6 +2124,10 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey().LessThan(o.GetSecurityV1PublicKey())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
 	} else if this.IsActivityStreamsRead() {
@@ -1832,12 +2138,18 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
 	} else if this.IsActivityStreamsRemove() {
 		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
 	} else if this.IsActivityStreamsService() {
 		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
 	} else if this.IsActivityStreamsTentativeAccept() {
 		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
 	} else if this.IsActivityStreamsTentativeReject() {
 		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
 	} else if this.IsActivityStreamsTombstone() {
 		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
 	} else if this.IsActivityStreamsTravel() {
@@ -1856,9 +2168,14 @@ func (this ActivityStreamsActorPropertyIterator) LessThan(o vocab.ActivityStream
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsActor".
+// Name returns the name of this property: "ActivityStreamsActor", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsActorPropertyIterator) Name() string {
-	return "ActivityStreamsActor"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsActor"
+	} else {
+		return "ActivityStreamsActor"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -2257,12 +2574,68 @@ func (this *ActivityStreamsActorPropertyIterator) SetActivityStreamsView(v vocab
 	this.activitystreamsViewMember = v
 }
 
+// SetForgeFedBranch sets the value of this property. Calling IsForgeFedBranch
+// afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetForgeFedBranch(v vocab.ForgeFedBranch) {
+	this.clear()
+	this.forgefedBranchMember = v
+}
+
+// SetForgeFedCommit sets the value of this property. Calling IsForgeFedCommit
+// afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.clear()
+	this.forgefedCommitMember = v
+}
+
+// SetForgeFedPush sets the value of this property. Calling IsForgeFedPush
+// afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetForgeFedPush(v vocab.ForgeFedPush) {
+	this.clear()
+	this.forgefedPushMember = v
+}
+
+// SetForgeFedRepository sets the value of this property. Calling
+// IsForgeFedRepository afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.clear()
+	this.forgefedRepositoryMember = v
+}
+
+// SetForgeFedTicket sets the value of this property. Calling IsForgeFedTicket
+// afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.clear()
+	this.forgefedTicketMember = v
+}
+
+// SetForgeFedTicketDependency sets the value of this property. Calling
+// IsForgeFedTicketDependency afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.clear()
+	this.forgefedTicketDependencyMember = v
+}
+
 // SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
 func (this *ActivityStreamsActorPropertyIterator) SetIRI(v *url.URL) {
 	this.clear()
 	this.iri = v
 }
 
+// SetSecurityV1PublicKey sets the value of this property. Calling
+// IsSecurityV1PublicKey afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.clear()
+	this.securityv1PublicKeyMember = v
+}
+
+// SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
+// returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetTootEmoji(v vocab.TootEmoji) {
+	this.clear()
+	this.tootEmojiMember = v
+}
+
 // SetType attempts to set the property for the arbitrary type. Returns an error
 // if it is not a valid type to set on this property.
 func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
@@ -2286,6 +2659,10 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsAdd(v)
 		return nil
 	}
+	if v, ok := t.(vocab.VcardAddress); ok {
+		this.SetVcardAddress(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsAnnounce); ok {
 		this.SetActivityStreamsAnnounce(v)
 		return nil
@@ -2310,6 +2687,10 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsBlock(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedBranch); ok {
+		this.SetForgeFedBranch(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsCollection); ok {
 		this.SetActivityStreamsCollection(v)
 		return nil
@@ -2318,6 +2699,10 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsCollectionPage(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedCommit); ok {
+		this.SetForgeFedCommit(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsCreate); ok {
 		this.SetActivityStreamsCreate(v)
 		return nil
@@ -2334,6 +2719,10 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsDocument(v)
 		return nil
 	}
+	if v, ok := t.(vocab.TootEmoji); ok {
+		this.SetTootEmoji(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2350,6 +2739,10 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsGroup(v)
 		return nil
 	}
+	if v, ok := t.(vocab.VcardHome); ok {
+		this.SetVcardHome(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsIgnore); ok {
 		this.SetActivityStreamsIgnore(v)
 		return nil
@@ -2426,6 +2819,14 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.SecurityV1PublicKey); ok {
+		this.SetSecurityV1PublicKey(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedPush); ok {
+		this.SetForgeFedPush(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
 		this.SetActivityStreamsQuestion(v)
 		return nil
@@ -2446,6 +2847,10 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsRemove(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedRepository); ok {
+		this.SetForgeFedRepository(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsService); ok {
 		this.SetActivityStreamsService(v)
 		return nil
@@ -2458,6 +2863,14 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsTentativeReject(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedTicket); ok {
+		this.SetForgeFedTicket(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedTicketDependency); ok {
+		this.SetForgeFedTicketDependency(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsTombstone); ok {
 		this.SetActivityStreamsTombstone(v)
 		return nil
@@ -2486,6 +2899,20 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 	return fmt.Errorf("illegal type to set on ActivityStreamsActor property: %T", t)
 }
 
+// SetVcardAddress sets the value of this property. Calling IsVcardAddress
+// afterwards returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetVcardAddress(v vocab.VcardAddress) {
+	this.clear()
+	this.vcardAddressMember = v
+}
+
+// SetVcardHome sets the value of this property. Calling IsVcardHome afterwards
+// returns true.
+func (this *ActivityStreamsActorPropertyIterator) SetVcardHome(v vocab.VcardHome) {
+	this.clear()
+	this.vcardHomeMember = v
+}
+
 // clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ActivityStreamsActorPropertyIterator) clear() {
@@ -2494,22 +2921,27 @@ func (this *ActivityStreamsActorPropertyIterator) clear() {
 	this.activitystreamsAcceptMember = nil
 	this.activitystreamsActivityMember = nil
 	this.activitystreamsAddMember = nil
+	this.vcardAddressMember = nil
 	this.activitystreamsAnnounceMember = nil
 	this.activitystreamsApplicationMember = nil
 	this.activitystreamsArriveMember = nil
 	this.activitystreamsArticleMember = nil
 	this.activitystreamsAudioMember = nil
 	this.activitystreamsBlockMember = nil
+	this.forgefedBranchMember = nil
 	this.activitystreamsCollectionMember = nil
 	this.activitystreamsCollectionPageMember = nil
+	this.forgefedCommitMember = nil
 	this.activitystreamsCreateMember = nil
 	this.activitystreamsDeleteMember = nil
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
+	this.tootEmojiMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
 	this.activitystreamsGroupMember = nil
+	this.vcardHomeMember = nil
 	this.activitystreamsIgnoreMember = nil
 	this.activitystreamsImageMember = nil
 	this.activitystreamsIntransitiveActivityMember = nil
@@ -2529,14 +2961,19 @@ func (this *ActivityStreamsActorPropertyIterator) clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.securityv1PublicKeyMember = nil
+	this.forgefedPushMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
 	this.activitystreamsRejectMember = nil
 	this.activitystreamsRelationshipMember = nil
 	this.activitystreamsRemoveMember = nil
+	this.forgefedRepositoryMember = nil
 	this.activitystreamsServiceMember = nil
 	this.activitystreamsTentativeAcceptMember = nil
 	this.activitystreamsTentativeRejectMember = nil
+	this.forgefedTicketMember = nil
+	this.forgefedTicketDependencyMember = nil
 	this.activitystreamsTombstoneMember = nil
 	this.activitystreamsTravelMember = nil
 	this.activitystreamsUndoMember = nil
@@ -2562,6 +2999,8 @@ func (this ActivityStreamsActorPropertyIterator) serialize() (interface{}, error
 		return this.GetActivityStreamsActivity().Serialize()
 	} else if this.IsActivityStreamsAdd() {
 		return this.GetActivityStreamsAdd().Serialize()
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().Serialize()
 	} else if this.IsActivityStreamsAnnounce() {
 		return this.GetActivityStreamsAnnounce().Serialize()
 	} else if this.IsActivityStreamsApplication() {
@@ -2574,10 +3013,14 @@ func (this ActivityStreamsActorPropertyIterator) serialize() (interface{}, error
 		return this.GetActivityStreamsAudio().Serialize()
 	} else if this.IsActivityStreamsBlock() {
 		return this.GetActivityStreamsBlock().Serialize()
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().Serialize()
 	} else if this.IsActivityStreamsCollection() {
 		return this.GetActivityStreamsCollection().Serialize()
 	} else if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage().Serialize()
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Serialize()
 	} else if this.IsActivityStreamsCreate() {
 		return this.GetActivityStreamsCreate().Serialize()
 	} else if this.IsActivityStreamsDelete() {
@@ -2586,6 +3029,8 @@ func (this ActivityStreamsActorPropertyIterator) serialize() (interface{}, error
 		return this.GetActivityStreamsDislike().Serialize()
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2594,6 +3039,8 @@ func (this ActivityStreamsActorPropertyIterator) serialize() (interface{}, error
 		return this.GetActivityStreamsFollow().Serialize()
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().Serialize()
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().Serialize()
 	} else if this.IsActivityStreamsImage() {
@@ -2632,6 +3079,10 @@ func (this ActivityStreamsActorPropertyIterator) serialize() (interface{}, error
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey().Serialize()
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().Serialize()
 	} else if this.IsActivityStreamsRead() {
@@ -2642,12 +3093,18 @@ func (this ActivityStreamsActorPropertyIterator) serialize() (interface{}, error
 		return this.GetActivityStreamsRelationship().Serialize()
 	} else if this.IsActivityStreamsRemove() {
 		return this.GetActivityStreamsRemove().Serialize()
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Serialize()
 	} else if this.IsActivityStreamsService() {
 		return this.GetActivityStreamsService().Serialize()
 	} else if this.IsActivityStreamsTentativeAccept() {
 		return this.GetActivityStreamsTentativeAccept().Serialize()
 	} else if this.IsActivityStreamsTentativeReject() {
 		return this.GetActivityStreamsTentativeReject().Serialize()
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Serialize()
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Serialize()
 	} else if this.IsActivityStreamsTombstone() {
 		return this.GetActivityStreamsTombstone().Serialize()
 	} else if this.IsActivityStreamsTravel() {
@@ -2685,6 +3142,10 @@ func DeserializeActorProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "actor")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["actor"]
+	}
 
 	if ok {
 		this := &ActivityStreamsActorProperty{
@@ -3326,16 +3787,105 @@ func (this *ActivityStreamsActorProperty) AppendActivityStreamsView(v vocab.Acti
 	})
 }
 
-// AppendIRI appends an IRI value to the back of a list of the property "actor"
-func (this *ActivityStreamsActorProperty) AppendIRI(v *url.URL) {
+// AppendForgeFedBranch appends a Branch value to the back of a list of the
+// property "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendForgeFedBranch(v vocab.ForgeFedBranch) {
 	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedCommit appends a Commit value to the back of a list of the
+// property "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedPush appends a Push value to the back of a list of the property
+// "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendForgeFedPush(v vocab.ForgeFedPush) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              this.Len(),
+		parent:             this,
+	})
+}
+
+// AppendForgeFedRepository appends a Repository value to the back of a list of
+// the property "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    this.Len(),
+		parent:                   this,
+	})
+}
+
+// AppendForgeFedTicket appends a Ticket value to the back of a list of the
+// property "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedTicketDependency appends a TicketDependency value to the back of
+// a list of the property "actor". Invalidates iterators that are traversing
+// using Prev.
+func (this *ActivityStreamsActorProperty) AppendForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          this.Len(),
+		parent:                         this,
+	})
+}
+
+// AppendIRI appends an IRI value to the back of a list of the property "actor"
+func (this *ActivityStreamsActorProperty) AppendIRI(v *url.URL) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  this.Len(),
 		parent: this,
 	})
 }
 
+// AppendSecurityV1PublicKey appends a PublicKey value to the back of a list of
+// the property "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	})
+}
+
+// AppendTootEmoji appends a Emoji value to the back of a list of the property
+// "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendTootEmoji(v vocab.TootEmoji) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:           this.alias,
+		myIdx:           this.Len(),
+		parent:          this,
+		tootEmojiMember: v,
+	})
+}
+
 // PrependType prepends an arbitrary type value to the front of a list of the
 // property "actor". Invalidates iterators that are traversing using Prev.
 // Returns an error if the type is not a valid one to set for this property.
@@ -3352,6 +3902,28 @@ func (this *ActivityStreamsActorProperty) AppendType(t vocab.Type) error {
 	return nil
 }
 
+// AppendVcardAddress appends a Address value to the back of a list of the
+// property "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendVcardAddress(v vocab.VcardAddress) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:              this.alias,
+		myIdx:              this.Len(),
+		parent:             this,
+		vcardAddressMember: v,
+	})
+}
+
+// AppendVcardHome appends a Home value to the back of a list of the property
+// "actor". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsActorProperty) AppendVcardHome(v vocab.VcardHome) {
+	this.properties = append(this.properties, &ActivityStreamsActorPropertyIterator{
+		alias:           this.alias,
+		myIdx:           this.Len(),
+		parent:          this,
+		vcardHomeMember: v,
+	})
+}
+
 // At returns the property value for the specified index. Panics if the index is
 // out of bounds.
 func (this ActivityStreamsActorProperty) At(index int) vocab.ActivityStreamsActorPropertyIterator {
@@ -4299,6 +4871,108 @@ func (this *ActivityStreamsActorProperty) InsertActivityStreamsView(idx int, v v
 	}
 }
 
+// InsertForgeFedBranch inserts a Branch value at the specified index for a
+// property "actor". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedCommit inserts a Commit value at the specified index for a
+// property "actor". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedPush inserts a Push value at the specified index for a property
+// "actor". Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertForgeFedPush(idx int, v vocab.ForgeFedPush) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              idx,
+		parent:             this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedRepository inserts a Repository value at the specified index for
+// a property "actor". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedTicket inserts a Ticket value at the specified index for a
+// property "actor". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedTicketDependency inserts a TicketDependency value at the
+// specified index for a property "actor". Existing elements at that index and
+// higher are shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // Insert inserts an IRI value at the specified index for a property "actor".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -4316,6 +4990,40 @@ func (this *ActivityStreamsActorProperty) InsertIRI(idx int, v *url.URL) {
 	}
 }
 
+// InsertSecurityV1PublicKey inserts a PublicKey value at the specified index for
+// a property "actor". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertSecurityV1PublicKey(idx int, v vocab.SecurityV1PublicKey) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertTootEmoji inserts a Emoji value at the specified index for a property
+// "actor". Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertTootEmoji(idx int, v vocab.TootEmoji) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		tootEmojiMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependType prepends an arbitrary type value to the front of a list of the
 // property "actor". Invalidates all iterators. Returns an error if the type
 // is not a valid one to set for this property.
@@ -4337,6 +5045,40 @@ func (this *ActivityStreamsActorProperty) InsertType(idx int, t vocab.Type) erro
 	return nil
 }
 
+// InsertVcardAddress inserts a Address value at the specified index for a
+// property "actor". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertVcardAddress(idx int, v vocab.VcardAddress) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:              this.alias,
+		myIdx:              idx,
+		parent:             this,
+		vcardAddressMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertVcardHome inserts a Home value at the specified index for a property
+// "actor". Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) InsertVcardHome(idx int, v vocab.VcardHome) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		vcardHomeMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
@@ -4398,198 +5140,238 @@ func (this ActivityStreamsActorProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetActivityStreamsAdd()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 5 {
+			lhs := this.properties[i].GetVcardAddress()
+			rhs := this.properties[j].GetVcardAddress()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 6 {
 			lhs := this.properties[i].GetActivityStreamsAnnounce()
 			rhs := this.properties[j].GetActivityStreamsAnnounce()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 6 {
+		} else if idx1 == 7 {
 			lhs := this.properties[i].GetActivityStreamsApplication()
 			rhs := this.properties[j].GetActivityStreamsApplication()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 7 {
+		} else if idx1 == 8 {
 			lhs := this.properties[i].GetActivityStreamsArrive()
 			rhs := this.properties[j].GetActivityStreamsArrive()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 8 {
+		} else if idx1 == 9 {
 			lhs := this.properties[i].GetActivityStreamsArticle()
 			rhs := this.properties[j].GetActivityStreamsArticle()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 9 {
+		} else if idx1 == 10 {
 			lhs := this.properties[i].GetActivityStreamsAudio()
 			rhs := this.properties[j].GetActivityStreamsAudio()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 10 {
+		} else if idx1 == 11 {
 			lhs := this.properties[i].GetActivityStreamsBlock()
 			rhs := this.properties[j].GetActivityStreamsBlock()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 11 {
+		} else if idx1 == 12 {
+			lhs := this.properties[i].GetForgeFedBranch()
+			rhs := this.properties[j].GetForgeFedBranch()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 13 {
 			lhs := this.properties[i].GetActivityStreamsCollection()
 			rhs := this.properties[j].GetActivityStreamsCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 12 {
+		} else if idx1 == 14 {
 			lhs := this.properties[i].GetActivityStreamsCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 13 {
+		} else if idx1 == 15 {
+			lhs := this.properties[i].GetForgeFedCommit()
+			rhs := this.properties[j].GetForgeFedCommit()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 16 {
 			lhs := this.properties[i].GetActivityStreamsCreate()
 			rhs := this.properties[j].GetActivityStreamsCreate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 14 {
+		} else if idx1 == 17 {
 			lhs := this.properties[i].GetActivityStreamsDelete()
 			rhs := this.properties[j].GetActivityStreamsDelete()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 15 {
+		} else if idx1 == 18 {
 			lhs := this.properties[i].GetActivityStreamsDislike()
 			rhs := this.properties[j].GetActivityStreamsDislike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 16 {
+		} else if idx1 == 19 {
 			lhs := this.properties[i].GetActivityStreamsDocument()
 			rhs := this.properties[j].GetActivityStreamsDocument()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 17 {
+		} else if idx1 == 20 {
+			lhs := this.properties[i].GetTootEmoji()
+			rhs := this.properties[j].GetTootEmoji()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 21 {
 			lhs := this.properties[i].GetActivityStreamsEvent()
 			rhs := this.properties[j].GetActivityStreamsEvent()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 18 {
+		} else if idx1 == 22 {
 			lhs := this.properties[i].GetActivityStreamsFlag()
 			rhs := this.properties[j].GetActivityStreamsFlag()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 19 {
+		} else if idx1 == 23 {
 			lhs := this.properties[i].GetActivityStreamsFollow()
 			rhs := this.properties[j].GetActivityStreamsFollow()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 20 {
+		} else if idx1 == 24 {
 			lhs := this.properties[i].GetActivityStreamsGroup()
 			rhs := this.properties[j].GetActivityStreamsGroup()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 21 {
+		} else if idx1 == 25 {
+			lhs := this.properties[i].GetVcardHome()
+			rhs := this.properties[j].GetVcardHome()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 26 {
 			lhs := this.properties[i].GetActivityStreamsIgnore()
 			rhs := this.properties[j].GetActivityStreamsIgnore()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 22 {
+		} else if idx1 == 27 {
 			lhs := this.properties[i].GetActivityStreamsImage()
 			rhs := this.properties[j].GetActivityStreamsImage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 23 {
+		} else if idx1 == 28 {
 			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
 			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 24 {
+		} else if idx1 == 29 {
 			lhs := this.properties[i].GetActivityStreamsInvite()
 			rhs := this.properties[j].GetActivityStreamsInvite()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 25 {
+		} else if idx1 == 30 {
 			lhs := this.properties[i].GetActivityStreamsJoin()
 			rhs := this.properties[j].GetActivityStreamsJoin()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 26 {
+		} else if idx1 == 31 {
 			lhs := this.properties[i].GetActivityStreamsLeave()
 			rhs := this.properties[j].GetActivityStreamsLeave()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 27 {
+		} else if idx1 == 32 {
 			lhs := this.properties[i].GetActivityStreamsLike()
 			rhs := this.properties[j].GetActivityStreamsLike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 28 {
+		} else if idx1 == 33 {
 			lhs := this.properties[i].GetActivityStreamsListen()
 			rhs := this.properties[j].GetActivityStreamsListen()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 29 {
+		} else if idx1 == 34 {
 			lhs := this.properties[i].GetActivityStreamsMention()
 			rhs := this.properties[j].GetActivityStreamsMention()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 30 {
+		} else if idx1 == 35 {
 			lhs := this.properties[i].GetActivityStreamsMove()
 			rhs := this.properties[j].GetActivityStreamsMove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 31 {
+		} else if idx1 == 36 {
 			lhs := this.properties[i].GetActivityStreamsNote()
 			rhs := this.properties[j].GetActivityStreamsNote()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 32 {
+		} else if idx1 == 37 {
 			lhs := this.properties[i].GetActivityStreamsOffer()
 			rhs := this.properties[j].GetActivityStreamsOffer()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 33 {
+		} else if idx1 == 38 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 34 {
+		} else if idx1 == 39 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 35 {
+		} else if idx1 == 40 {
 			lhs := this.properties[i].GetActivityStreamsOrganization()
 			rhs := this.properties[j].GetActivityStreamsOrganization()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 36 {
+		} else if idx1 == 41 {
 			lhs := this.properties[i].GetActivityStreamsPage()
 			rhs := this.properties[j].GetActivityStreamsPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 37 {
+		} else if idx1 == 42 {
 			lhs := this.properties[i].GetActivityStreamsPerson()
 			rhs := this.properties[j].GetActivityStreamsPerson()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 38 {
+		} else if idx1 == 43 {
 			lhs := this.properties[i].GetActivityStreamsPlace()
 			rhs := this.properties[j].GetActivityStreamsPlace()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 39 {
+		} else if idx1 == 44 {
 			lhs := this.properties[i].GetActivityStreamsProfile()
 			rhs := this.properties[j].GetActivityStreamsProfile()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 40 {
+		} else if idx1 == 45 {
+			lhs := this.properties[i].GetSecurityV1PublicKey()
+			rhs := this.properties[j].GetSecurityV1PublicKey()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 46 {
+			lhs := this.properties[i].GetForgeFedPush()
+			rhs := this.properties[j].GetForgeFedPush()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 47 {
 			lhs := this.properties[i].GetActivityStreamsQuestion()
 			rhs := this.properties[j].GetActivityStreamsQuestion()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 41 {
+		} else if idx1 == 48 {
 			lhs := this.properties[i].GetActivityStreamsRead()
 			rhs := this.properties[j].GetActivityStreamsRead()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 42 {
+		} else if idx1 == 49 {
 			lhs := this.properties[i].GetActivityStreamsReject()
 			rhs := this.properties[j].GetActivityStreamsReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 43 {
+		} else if idx1 == 50 {
 			lhs := this.properties[i].GetActivityStreamsRelationship()
 			rhs := this.properties[j].GetActivityStreamsRelationship()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 44 {
+		} else if idx1 == 51 {
 			lhs := this.properties[i].GetActivityStreamsRemove()
 			rhs := this.properties[j].GetActivityStreamsRemove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 45 {
+		} else if idx1 == 52 {
+			lhs := this.properties[i].GetForgeFedRepository()
+			rhs := this.properties[j].GetForgeFedRepository()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 53 {
 			lhs := this.properties[i].GetActivityStreamsService()
 			rhs := this.properties[j].GetActivityStreamsService()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 46 {
+		} else if idx1 == 54 {
 			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
 			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 47 {
+		} else if idx1 == 55 {
 			lhs := this.properties[i].GetActivityStreamsTentativeReject()
 			rhs := this.properties[j].GetActivityStreamsTentativeReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 48 {
+		} else if idx1 == 56 {
+			lhs := this.properties[i].GetForgeFedTicket()
+			rhs := this.properties[j].GetForgeFedTicket()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 57 {
+			lhs := this.properties[i].GetForgeFedTicketDependency()
+			rhs := this.properties[j].GetForgeFedTicketDependency()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 58 {
 			lhs := this.properties[i].GetActivityStreamsTombstone()
 			rhs := this.properties[j].GetActivityStreamsTombstone()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 49 {
+		} else if idx1 == 59 {
 			lhs := this.properties[i].GetActivityStreamsTravel()
 			rhs := this.properties[j].GetActivityStreamsTravel()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 50 {
+		} else if idx1 == 60 {
 			lhs := this.properties[i].GetActivityStreamsUndo()
 			rhs := this.properties[j].GetActivityStreamsUndo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 51 {
+		} else if idx1 == 61 {
 			lhs := this.properties[i].GetActivityStreamsUpdate()
 			rhs := this.properties[j].GetActivityStreamsUpdate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 52 {
+		} else if idx1 == 62 {
 			lhs := this.properties[i].GetActivityStreamsVideo()
 			rhs := this.properties[j].GetActivityStreamsVideo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 53 {
+		} else if idx1 == 63 {
 			lhs := this.properties[i].GetActivityStreamsView()
 			rhs := this.properties[j].GetActivityStreamsView()
 			return lhs.LessThan(rhs)
@@ -4623,9 +5405,14 @@ func (this ActivityStreamsActorProperty) LessThan(o vocab.ActivityStreamsActorPr
 	return l1 < l2
 }
 
-// Name returns the name of this property: "actor".
+// Name returns the name of this property: "actor", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsActorProperty) Name() string {
-	return "actor"
+	if len(this.alias) > 0 {
+		return this.alias + ":actor"
+	} else {
+		return "actor"
+	}
 }
 
 // PrependActivityStreamsAccept prepends a Accept value to the front of a list of
@@ -5386,6 +6173,90 @@ func (this *ActivityStreamsActorProperty) PrependActivityStreamsView(v vocab.Act
 	}
 }
 
+// PrependForgeFedBranch prepends a Branch value to the front of a list of the
+// property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependForgeFedBranch(v vocab.ForgeFedBranch) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedCommit prepends a Commit value to the front of a list of the
+// property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedPush prepends a Push value to the front of a list of the
+// property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependForgeFedPush(v vocab.ForgeFedPush) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              0,
+		parent:             this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedRepository prepends a Repository value to the front of a list of
+// the property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    0,
+		parent:                   this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedTicket prepends a Ticket value to the front of a list of the
+// property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedTicketDependency prepends a TicketDependency value to the front
+// of a list of the property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          0,
+		parent:                         this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependIRI prepends an IRI value to the front of a list of the property "actor".
 func (this *ActivityStreamsActorProperty) PrependIRI(v *url.URL) {
 	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
@@ -5399,6 +6270,34 @@ func (this *ActivityStreamsActorProperty) PrependIRI(v *url.URL) {
 	}
 }
 
+// PrependSecurityV1PublicKey prepends a PublicKey value to the front of a list of
+// the property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependTootEmoji prepends a Emoji value to the front of a list of the property
+// "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependTootEmoji(v vocab.TootEmoji) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:           this.alias,
+		myIdx:           0,
+		parent:          this,
+		tootEmojiMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependType prepends an arbitrary type value to the front of a list of the
 // property "actor". Invalidates all iterators. Returns an error if the type
 // is not a valid one to set for this property.
@@ -5418,6 +6317,34 @@ func (this *ActivityStreamsActorProperty) PrependType(t vocab.Type) error {
 	return nil
 }
 
+// PrependVcardAddress prepends a Address value to the front of a list of the
+// property "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependVcardAddress(v vocab.VcardAddress) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:              this.alias,
+		myIdx:              0,
+		parent:             this,
+		vcardAddressMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependVcardHome prepends a Home value to the front of a list of the property
+// "actor". Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) PrependVcardHome(v vocab.VcardHome) {
+	this.properties = append([]*ActivityStreamsActorPropertyIterator{{
+		alias:           this.alias,
+		myIdx:           0,
+		parent:          this,
+		vcardHomeMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // Remove deletes an element at the specified index from a list of the property
 // "actor", regardless of its type. Panics if the index is out of bounds.
 // Invalidates all iterators.
@@ -6153,6 +7080,83 @@ func (this *ActivityStreamsActorProperty) SetActivityStreamsView(idx int, v voca
 	}
 }
 
+// SetForgeFedBranch sets a Branch value to be at the specified index for the
+// property "actor". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsActorProperty) SetForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedCommit sets a Commit value to be at the specified index for the
+// property "actor". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsActorProperty) SetForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedPush sets a Push value to be at the specified index for the property
+// "actor". Panics if the index is out of bounds. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) SetForgeFedPush(idx int, v vocab.ForgeFedPush) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              idx,
+		parent:             this,
+	}
+}
+
+// SetForgeFedRepository sets a Repository value to be at the specified index for
+// the property "actor". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsActorProperty) SetForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+}
+
+// SetForgeFedTicket sets a Ticket value to be at the specified index for the
+// property "actor". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsActorProperty) SetForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedTicketDependency sets a TicketDependency value to be at the
+// specified index for the property "actor". Panics if the index is out of
+// bounds. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) SetForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+}
+
 // SetIRI sets an IRI value to be at the specified index for the property "actor".
 // Panics if the index is out of bounds.
 func (this *ActivityStreamsActorProperty) SetIRI(idx int, v *url.URL) {
@@ -6165,6 +7169,31 @@ func (this *ActivityStreamsActorProperty) SetIRI(idx int, v *url.URL) {
 	}
 }
 
+// SetSecurityV1PublicKey sets a PublicKey value to be at the specified index for
+// the property "actor". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsActorProperty) SetSecurityV1PublicKey(idx int, v vocab.SecurityV1PublicKey) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}
+}
+
+// SetTootEmoji sets a Emoji value to be at the specified index for the property
+// "actor". Panics if the index is out of bounds. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) SetTootEmoji(idx int, v vocab.TootEmoji) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		tootEmojiMember: v,
+	}
+}
+
 // SetType sets an arbitrary type value to the specified index of the property
 // "actor". Invalidates all iterators. Returns an error if the type is not a
 // valid one to set for this property. Panics if the index is out of bounds.
@@ -6181,6 +7210,31 @@ func (this *ActivityStreamsActorProperty) SetType(idx int, t vocab.Type) error {
 	return nil
 }
 
+// SetVcardAddress sets a Address value to be at the specified index for the
+// property "actor". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsActorProperty) SetVcardAddress(idx int, v vocab.VcardAddress) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:              this.alias,
+		myIdx:              idx,
+		parent:             this,
+		vcardAddressMember: v,
+	}
+}
+
+// SetVcardHome sets a Home value to be at the specified index for the property
+// "actor". Panics if the index is out of bounds. Invalidates all iterators.
+func (this *ActivityStreamsActorProperty) SetVcardHome(idx int, v vocab.VcardHome) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsActorPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		vcardHomeMember: v,
+	}
+}
+
 // Swap swaps the location of values at two indices for the "actor" property.
 func (this ActivityStreamsActorProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
@@ -30,6 +30,10 @@ func DeserializeAltitudeProperty(m map[string]interface{}, aliasMap map[string]s
 		propName = fmt.Sprintf("%s:%s", alias, "altitude")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["altitude"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsAltitudeProperty) LessThan(o vocab.ActivityStreamsAlti
 	}
 }
 
-// Name returns the name of this property: "altitude".
+// Name returns the name of this property: "altitude", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsAltitudeProperty) Name() string {
-	return "altitude"
+	if len(this.alias) > 0 {
+		return this.alias + ":altitude"
+	} else {
+		return "altitude"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -19,6 +19,9 @@ type privateManager interface {
 	// the "ActivityStreamsAdd" non-functional property in the vocabulary
 	// "ActivityStreams"
 	DeserializeAddActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAdd, error)
+	// DeserializeAddressVcard returns the deserialization method for the
+	// "VcardAddress" non-functional property in the vocabulary "Vcard"
+	DeserializeAddressVcard() func(map[string]interface{}, map[string]string) (vocab.VcardAddress, error)
 	// DeserializeAnnounceActivityStreams returns the deserialization method
 	// for the "ActivityStreamsAnnounce" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -43,6 +46,10 @@ type privateManager interface {
 	// the "ActivityStreamsBlock" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeBlockActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBlock, error)
+	// DeserializeBranchForgeFed returns the deserialization method for the
+	// "ForgeFedBranch" non-functional property in the vocabulary
+	// "ForgeFed"
+	DeserializeBranchForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedBranch, error)
 	// DeserializeCollectionActivityStreams returns the deserialization method
 	// for the "ActivityStreamsCollection" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -51,6 +58,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsCollectionPage" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeCollectionPageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollectionPage, error)
+	// DeserializeCommitForgeFed returns the deserialization method for the
+	// "ForgeFedCommit" non-functional property in the vocabulary
+	// "ForgeFed"
+	DeserializeCommitForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommit, error)
 	// DeserializeCreateActivityStreams returns the deserialization method for
 	// the "ActivityStreamsCreate" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -67,6 +78,9 @@ type privateManager interface {
 	// for the "ActivityStreamsDocument" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeDocumentActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDocument, error)
+	// DeserializeEmojiToot returns the deserialization method for the
+	// "TootEmoji" non-functional property in the vocabulary "Toot"
+	DeserializeEmojiToot() func(map[string]interface{}, map[string]string) (vocab.TootEmoji, error)
 	// DeserializeEventActivityStreams returns the deserialization method for
 	// the "ActivityStreamsEvent" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -83,6 +97,9 @@ type privateManager interface {
 	// the "ActivityStreamsGroup" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeGroupActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGroup, error)
+	// DeserializeHomeVcard returns the deserialization method for the
+	// "VcardHome" non-functional property in the vocabulary "Vcard"
+	DeserializeHomeVcard() func(map[string]interface{}, map[string]string) (vocab.VcardHome, error)
 	// DeserializeIgnoreActivityStreams returns the deserialization method for
 	// the "ActivityStreamsIgnore" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -169,6 +186,13 @@ type privateManager interface {
 	// for the "ActivityStreamsProfile" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeProfileActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsProfile, error)
+	// DeserializePublicKeySecurityV1 returns the deserialization method for
+	// the "SecurityV1PublicKey" non-functional property in the vocabulary
+	// "SecurityV1"
+	DeserializePublicKeySecurityV1() func(map[string]interface{}, map[string]string) (vocab.SecurityV1PublicKey, error)
+	// DeserializePushForgeFed returns the deserialization method for the
+	// "ForgeFedPush" non-functional property in the vocabulary "ForgeFed"
+	DeserializePushForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedPush, error)
 	// DeserializeQuestionActivityStreams returns the deserialization method
 	// for the "ActivityStreamsQuestion" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -189,6 +213,10 @@ type privateManager interface {
 	// the "ActivityStreamsRemove" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeRemoveActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRemove, error)
+	// DeserializeRepositoryForgeFed returns the deserialization method for
+	// the "ForgeFedRepository" non-functional property in the vocabulary
+	// "ForgeFed"
+	DeserializeRepositoryForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRepository, error)
 	// DeserializeServiceActivityStreams returns the deserialization method
 	// for the "ActivityStreamsService" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -201,6 +229,14 @@ type privateManager interface {
 	// method for the "ActivityStreamsTentativeReject" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeTentativeRejectActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeReject, error)
+	// DeserializeTicketDependencyForgeFed returns the deserialization method
+	// for the "ForgeFedTicketDependency" non-functional property in the
+	// vocabulary "ForgeFed"
+	DeserializeTicketDependencyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketDependency, error)
+	// DeserializeTicketForgeFed returns the deserialization method for the
+	// "ForgeFedTicket" non-functional property in the vocabulary
+	// "ForgeFed"
+	DeserializeTicketForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicket, error)
 	// DeserializeTombstoneActivityStreams returns the deserialization method
 	// for the "ActivityStreamsTombstone" non-functional property in the
 	// vocabulary "ActivityStreams"
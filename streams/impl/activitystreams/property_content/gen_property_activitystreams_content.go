@@ -199,12 +199,21 @@ func (this ActivityStreamsContentPropertyIterator) LessThan(o vocab.ActivityStre
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsContent".
+// Name returns the name of this property: "ActivityStreamsContent", prefixed by
+// the alias of its vocabulary if it has one.
 func (this ActivityStreamsContentPropertyIterator) Name() string {
 	if this.IsRDFLangString() {
-		return "ActivityStreamsContentMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":ActivityStreamsContentMap"
+		} else {
+			return "ActivityStreamsContentMap"
+		}
 	} else {
-		return "ActivityStreamsContent"
+		if len(this.alias) > 0 {
+			return this.alias + ":ActivityStreamsContent"
+		} else {
+			return "ActivityStreamsContent"
+		}
 	}
 }
 
@@ -305,6 +314,10 @@ func DeserializeContentProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "content")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["content"]
+	}
 	if !ok {
 		// Attempt to find the map instead.
 		i, ok = m[propName+"Map"]
@@ -536,12 +549,21 @@ func (this ActivityStreamsContentProperty) LessThan(o vocab.ActivityStreamsConte
 	return l1 < l2
 }
 
-// Name returns the name of this property: "content".
+// Name returns the name of this property: "content", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsContentProperty) Name() string {
 	if this.Len() == 1 && this.At(0).IsRDFLangString() {
-		return "contentMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":contentMap"
+		} else {
+			return "contentMap"
+		}
 	} else {
-		return "content"
+		if len(this.alias) > 0 {
+			return this.alias + ":content"
+		} else {
+			return "content"
+		}
 	}
 }
 
@@ -34,6 +34,10 @@ func DeserializeCurrentProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "current")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["current"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeCurrentProperty(m map[string]interface{}, aliasMap map[string]st
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsCurrentProperty{
 					activitystreamsCollectionPageMember: v,
@@ -271,9 +276,14 @@ func (this ActivityStreamsCurrentProperty) LessThan(o vocab.ActivityStreamsCurre
 	return false
 }
 
-// Name returns the name of this property: "current".
+// Name returns the name of this property: "current", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsCurrentProperty) Name() string {
-	return "current"
+	if len(this.alias) > 0 {
+		return this.alias + ":current"
+	} else {
+		return "current"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -31,6 +31,10 @@ func DeserializeDeletedProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "deleted")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["deleted"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsDeletedProperty) LessThan(o vocab.ActivityStreamsDelet
 	}
 }
 
-// Name returns the name of this property: "deleted".
+// Name returns the name of this property: "deleted", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsDeletedProperty) Name() string {
-	return "deleted"
+	if len(this.alias) > 0 {
+		return this.alias + ":deleted"
+	} else {
+		return "deleted"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
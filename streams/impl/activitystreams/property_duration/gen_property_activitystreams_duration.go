@@ -31,6 +31,10 @@ func DeserializeDurationProperty(m map[string]interface{}, aliasMap map[string]s
 		propName = fmt.Sprintf("%s:%s", alias, "duration")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["duration"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsDurationProperty) LessThan(o vocab.ActivityStreamsDura
 	}
 }
 
-// Name returns the name of this property: "duration".
+// Name returns the name of this property: "duration", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsDurationProperty) Name() string {
-	return "duration"
+	if len(this.alias) > 0 {
+		return this.alias + ":duration"
+	} else {
+		return "duration"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -31,6 +31,10 @@ func DeserializeEndTimeProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "endTime")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["endTime"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsEndTimeProperty) LessThan(o vocab.ActivityStreamsEndTi
 	}
 }
 
-// Name returns the name of this property: "endTime".
+// Name returns the name of this property: "endTime", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsEndTimeProperty) Name() string {
-	return "endTime"
+	if len(this.alias) > 0 {
+		return this.alias + ":endTime"
+	} else {
+		return "endTime"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
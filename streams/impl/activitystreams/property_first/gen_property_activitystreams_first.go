@@ -34,6 +34,10 @@ func DeserializeFirstProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "first")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["first"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeFirstProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsFirstProperty{
 					activitystreamsCollectionPageMember: v,
@@ -271,9 +276,14 @@ func (this ActivityStreamsFirstProperty) LessThan(o vocab.ActivityStreamsFirstPr
 	return false
 }
 
-// Name returns the name of this property: "first".
+// Name returns the name of this property: "first", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsFirstProperty) Name() string {
-	return "first"
+	if len(this.alias) > 0 {
+		return this.alias + ":first"
+	} else {
+		return "first"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -34,6 +34,10 @@ func DeserializeFollowersProperty(m map[string]interface{}, aliasMap map[string]
 		propName = fmt.Sprintf("%s:%s", alias, "followers")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["followers"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeFollowersProperty(m map[string]interface{}, aliasMap map[string]
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsFollowersProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -272,9 +277,14 @@ func (this ActivityStreamsFollowersProperty) LessThan(o vocab.ActivityStreamsFol
 	return false
 }
 
-// Name returns the name of this property: "followers".
+// Name returns the name of this property: "followers", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsFollowersProperty) Name() string {
-	return "followers"
+	if len(this.alias) > 0 {
+		return this.alias + ":followers"
+	} else {
+		return "followers"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -34,6 +34,10 @@ func DeserializeFollowingProperty(m map[string]interface{}, aliasMap map[string]
 		propName = fmt.Sprintf("%s:%s", alias, "following")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["following"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeFollowingProperty(m map[string]interface{}, aliasMap map[string]
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsFollowingProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -272,9 +277,14 @@ func (this ActivityStreamsFollowingProperty) LessThan(o vocab.ActivityStreamsFol
 	return false
 }
 
-// Name returns the name of this property: "following".
+// Name returns the name of this property: "following", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsFollowingProperty) Name() string {
-	return "following"
+	if len(this.alias) > 0 {
+		return this.alias + ":following"
+	} else {
+		return "following"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
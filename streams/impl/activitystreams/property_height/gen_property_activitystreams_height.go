@@ -30,6 +30,10 @@ func DeserializeHeightProperty(m map[string]interface{}, aliasMap map[string]str
 		propName = fmt.Sprintf("%s:%s", alias, "height")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["height"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsHeightProperty) LessThan(o vocab.ActivityStreamsHeight
 	}
 }
 
-// Name returns the name of this property: "height".
+// Name returns the name of this property: "height", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsHeightProperty) Name() string {
-	return "height"
+	if len(this.alias) > 0 {
+		return this.alias + ":height"
+	} else {
+		return "height"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -28,6 +28,10 @@ func DeserializeHrefProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "href")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["href"]
+	}
 
 	if ok {
 		if v, err := anyuri.DeserializeAnyURI(i); err == nil {
@@ -144,9 +148,14 @@ func (this ActivityStreamsHrefProperty) LessThan(o vocab.ActivityStreamsHrefProp
 	}
 }
 
-// Name returns the name of this property: "href".
+// Name returns the name of this property: "href", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsHrefProperty) Name() string {
-	return "href"
+	if len(this.alias) > 0 {
+		return this.alias + ":href"
+	} else {
+		return "href"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
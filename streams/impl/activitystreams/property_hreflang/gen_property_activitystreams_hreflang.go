@@ -30,6 +30,10 @@ func DeserializeHreflangProperty(m map[string]interface{}, aliasMap map[string]s
 		propName = fmt.Sprintf("%s:%s", alias, "hreflang")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["hreflang"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsHreflangProperty) LessThan(o vocab.ActivityStreamsHref
 	}
 }
 
-// Name returns the name of this property: "hreflang".
+// Name returns the name of this property: "hreflang", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsHreflangProperty) Name() string {
-	return "hreflang"
+	if len(this.alias) > 0 {
+		return this.alias + ":hreflang"
+	} else {
+		return "hreflang"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
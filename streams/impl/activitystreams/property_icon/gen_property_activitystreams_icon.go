@@ -48,6 +48,7 @@ func deserializeActivityStreamsIconPropertyIterator(i interface{}, aliasMap map[
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
+
 		if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsIconPropertyIterator{
 				activitystreamsImageMember: v,
@@ -219,9 +220,14 @@ func (this ActivityStreamsIconPropertyIterator) LessThan(o vocab.ActivityStreams
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsIcon".
+// Name returns the name of this property: "ActivityStreamsIcon", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsIconPropertyIterator) Name() string {
-	return "ActivityStreamsIcon"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsIcon"
+	} else {
+		return "ActivityStreamsIcon"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -334,6 +340,10 @@ func DeserializeIconProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "icon")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["icon"]
+	}
 
 	if ok {
 		this := &ActivityStreamsIconProperty{
@@ -629,9 +639,14 @@ func (this ActivityStreamsIconProperty) LessThan(o vocab.ActivityStreamsIconProp
 	return l1 < l2
 }
 
-// Name returns the name of this property: "icon".
+// Name returns the name of this property: "icon", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsIconProperty) Name() string {
-	return "icon"
+	if len(this.alias) > 0 {
+		return this.alias + ":icon"
+	} else {
+		return "icon"
+	}
 }
 
 // PrependActivityStreamsImage prepends a Image value to the front of a list of
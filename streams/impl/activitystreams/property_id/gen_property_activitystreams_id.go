@@ -28,6 +28,10 @@ func DeserializeIdProperty(m map[string]interface{}, aliasMap map[string]string)
 		propName = fmt.Sprintf("%s:%s", alias, "id")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["id"]
+	}
 
 	if ok {
 		if v, err := anyuri.DeserializeAnyURI(i); err == nil {
@@ -144,9 +148,14 @@ func (this ActivityStreamsIdProperty) LessThan(o vocab.ActivityStreamsIdProperty
 	}
 }
 
-// Name returns the name of this property: "id".
+// Name returns the name of this property: "id", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsIdProperty) Name() string {
-	return "id"
+	if len(this.alias) > 0 {
+		return this.alias + ":id"
+	} else {
+		return "id"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
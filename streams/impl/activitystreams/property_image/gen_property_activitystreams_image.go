@@ -48,6 +48,7 @@ func deserializeActivityStreamsImagePropertyIterator(i interface{}, aliasMap map
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
+
 		if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsImagePropertyIterator{
 				activitystreamsImageMember: v,
@@ -219,9 +220,14 @@ func (this ActivityStreamsImagePropertyIterator) LessThan(o vocab.ActivityStream
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsImage".
+// Name returns the name of this property: "ActivityStreamsImage", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsImagePropertyIterator) Name() string {
-	return "ActivityStreamsImage"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsImage"
+	} else {
+		return "ActivityStreamsImage"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -334,6 +340,10 @@ func DeserializeImageProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "image")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["image"]
+	}
 
 	if ok {
 		this := &ActivityStreamsImageProperty{
@@ -629,9 +639,14 @@ func (this ActivityStreamsImageProperty) LessThan(o vocab.ActivityStreamsImagePr
 	return l1 < l2
 }
 
-// Name returns the name of this property: "image".
+// Name returns the name of this property: "image", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsImageProperty) Name() string {
-	return "image"
+	if len(this.alias) > 0 {
+		return this.alias + ":image"
+	} else {
+		return "image"
+	}
 }
 
 // PrependActivityStreamsImage prepends a Image value to the front of a list of
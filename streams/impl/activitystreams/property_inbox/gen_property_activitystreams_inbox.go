@@ -32,6 +32,10 @@ func DeserializeInboxProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "inbox")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["inbox"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -47,6 +51,7 @@ func DeserializeInboxProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsInboxProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -206,9 +211,14 @@ func (this ActivityStreamsInboxProperty) LessThan(o vocab.ActivityStreamsInboxPr
 	return false
 }
 
-// Name returns the name of this property: "inbox".
+// Name returns the name of this property: "inbox", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsInboxProperty) Name() string {
-	return "inbox"
+	if len(this.alias) > 0 {
+		return this.alias + ":inbox"
+	} else {
+		return "inbox"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -34,6 +34,10 @@ func DeserializeLastProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "last")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["last"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeLastProperty(m map[string]interface{}, aliasMap map[string]strin
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsLastProperty{
 					activitystreamsCollectionPageMember: v,
@@ -271,9 +276,14 @@ func (this ActivityStreamsLastProperty) LessThan(o vocab.ActivityStreamsLastProp
 	return false
 }
 
-// Name returns the name of this property: "last".
+// Name returns the name of this property: "last", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsLastProperty) Name() string {
-	return "last"
+	if len(this.alias) > 0 {
+		return this.alias + ":last"
+	} else {
+		return "last"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -30,6 +30,10 @@ func DeserializeLatitudeProperty(m map[string]interface{}, aliasMap map[string]s
 		propName = fmt.Sprintf("%s:%s", alias, "latitude")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["latitude"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsLatitudeProperty) LessThan(o vocab.ActivityStreamsLati
 	}
 }
 
-// Name returns the name of this property: "latitude".
+// Name returns the name of this property: "latitude", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsLatitudeProperty) Name() string {
-	return "latitude"
+	if len(this.alias) > 0 {
+		return this.alias + ":latitude"
+	} else {
+		return "latitude"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -34,6 +34,10 @@ func DeserializeLikedProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "liked")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["liked"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeLikedProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsLikedProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -272,9 +277,14 @@ func (this ActivityStreamsLikedProperty) LessThan(o vocab.ActivityStreamsLikedPr
 	return false
 }
 
-// Name returns the name of this property: "liked".
+// Name returns the name of this property: "liked", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsLikedProperty) Name() string {
-	return "liked"
+	if len(this.alias) > 0 {
+		return this.alias + ":liked"
+	} else {
+		return "liked"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
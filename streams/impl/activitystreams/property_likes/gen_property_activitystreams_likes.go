@@ -34,6 +34,10 @@ func DeserializeLikesProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "likes")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["likes"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeLikesProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsLikesProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -272,9 +277,14 @@ func (this ActivityStreamsLikesProperty) LessThan(o vocab.ActivityStreamsLikesPr
 	return false
 }
 
-// Name returns the name of this property: "likes".
+// Name returns the name of this property: "likes", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsLikesProperty) Name() string {
-	return "likes"
+	if len(this.alias) > 0 {
+		return this.alias + ":likes"
+	} else {
+		return "likes"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -30,6 +30,10 @@ func DeserializeLongitudeProperty(m map[string]interface{}, aliasMap map[string]
 		propName = fmt.Sprintf("%s:%s", alias, "longitude")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["longitude"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsLongitudeProperty) LessThan(o vocab.ActivityStreamsLon
 	}
 }
 
-// Name returns the name of this property: "longitude".
+// Name returns the name of this property: "longitude", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsLongitudeProperty) Name() string {
-	return "longitude"
+	if len(this.alias) > 0 {
+		return this.alias + ":longitude"
+	} else {
+		return "longitude"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -30,6 +30,10 @@ func DeserializeMediaTypeProperty(m map[string]interface{}, aliasMap map[string]
 		propName = fmt.Sprintf("%s:%s", alias, "mediaType")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["mediaType"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsMediaTypeProperty) LessThan(o vocab.ActivityStreamsMed
 	}
 }
 
-// Name returns the name of this property: "mediaType".
+// Name returns the name of this property: "mediaType", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsMediaTypeProperty) Name() string {
-	return "mediaType"
+	if len(this.alias) > 0 {
+		return this.alias + ":mediaType"
+	} else {
+		return "mediaType"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
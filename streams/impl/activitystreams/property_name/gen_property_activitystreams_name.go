@@ -199,12 +199,21 @@ func (this ActivityStreamsNamePropertyIterator) LessThan(o vocab.ActivityStreams
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsName".
+// Name returns the name of this property: "ActivityStreamsName", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsNamePropertyIterator) Name() string {
 	if this.IsRDFLangString() {
-		return "ActivityStreamsNameMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":ActivityStreamsNameMap"
+		} else {
+			return "ActivityStreamsNameMap"
+		}
 	} else {
-		return "ActivityStreamsName"
+		if len(this.alias) > 0 {
+			return this.alias + ":ActivityStreamsName"
+		} else {
+			return "ActivityStreamsName"
+		}
 	}
 }
 
@@ -305,6 +314,10 @@ func DeserializeNameProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "name")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["name"]
+	}
 	if !ok {
 		// Attempt to find the map instead.
 		i, ok = m[propName+"Map"]
@@ -536,12 +549,21 @@ func (this ActivityStreamsNameProperty) LessThan(o vocab.ActivityStreamsNameProp
 	return l1 < l2
 }
 
-// Name returns the name of this property: "name".
+// Name returns the name of this property: "name", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsNameProperty) Name() string {
 	if this.Len() == 1 && this.At(0).IsRDFLangString() {
-		return "nameMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":nameMap"
+		} else {
+			return "nameMap"
+		}
 	} else {
-		return "name"
+		if len(this.alias) > 0 {
+			return this.alias + ":name"
+		} else {
+			return "name"
+		}
 	}
 }
 
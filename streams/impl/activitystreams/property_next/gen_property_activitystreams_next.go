@@ -34,6 +34,10 @@ func DeserializeNextProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "next")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["next"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeNextProperty(m map[string]interface{}, aliasMap map[string]strin
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsNextProperty{
 					activitystreamsCollectionPageMember: v,
@@ -271,9 +276,14 @@ func (this ActivityStreamsNextProperty) LessThan(o vocab.ActivityStreamsNextProp
 	return false
 }
 
-// Name returns the name of this property: "next".
+// Name returns the name of this property: "next", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsNextProperty) Name() string {
-	return "next"
+	if len(this.alias) > 0 {
+		return this.alias + ":next"
+	} else {
+		return "next"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
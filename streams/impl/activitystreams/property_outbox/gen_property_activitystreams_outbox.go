@@ -32,6 +32,10 @@ func DeserializeOutboxProperty(m map[string]interface{}, aliasMap map[string]str
 		propName = fmt.Sprintf("%s:%s", alias, "outbox")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["outbox"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -47,6 +51,7 @@ func DeserializeOutboxProperty(m map[string]interface{}, aliasMap map[string]str
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsOutboxProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -206,9 +211,14 @@ func (this ActivityStreamsOutboxProperty) LessThan(o vocab.ActivityStreamsOutbox
 	return false
 }
 
-// Name returns the name of this property: "outbox".
+// Name returns the name of this property: "outbox", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsOutboxProperty) Name() string {
-	return "outbox"
+	if len(this.alias) > 0 {
+		return this.alias + ":outbox"
+	} else {
+		return "outbox"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
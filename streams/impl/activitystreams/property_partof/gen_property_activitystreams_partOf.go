@@ -36,6 +36,10 @@ func DeserializePartOfProperty(m map[string]interface{}, aliasMap map[string]str
 		propName = fmt.Sprintf("%s:%s", alias, "partOf")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["partOf"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -51,6 +55,7 @@ func DeserializePartOfProperty(m map[string]interface{}, aliasMap map[string]str
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsPartOfProperty{
 					activitystreamsLinkMember: v,
@@ -338,9 +343,14 @@ func (this ActivityStreamsPartOfProperty) LessThan(o vocab.ActivityStreamsPartOf
 	return false
 }
 
-// Name returns the name of this property: "partOf".
+// Name returns the name of this property: "partOf", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsPartOfProperty) Name() string {
-	return "partOf"
+	if len(this.alias) > 0 {
+		return this.alias + ":partOf"
+	} else {
+		return "partOf"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -37,6 +37,10 @@ func DeserializePreferredUsernameProperty(m map[string]interface{}, aliasMap map
 		propName = fmt.Sprintf("%s:%s", alias, "preferredUsername")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["preferredUsername"]
+	}
 	if !ok {
 		// Attempt to find the map instead.
 		i, ok = m[propName+"Map"]
@@ -222,12 +226,21 @@ func (this ActivityStreamsPreferredUsernameProperty) LessThan(o vocab.ActivitySt
 	return false
 }
 
-// Name returns the name of this property: "preferredUsername".
+// Name returns the name of this property: "preferredUsername", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsPreferredUsernameProperty) Name() string {
 	if this.IsRDFLangString() {
-		return "preferredUsernameMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":preferredUsernameMap"
+		} else {
+			return "preferredUsernameMap"
+		}
 	} else {
-		return "preferredUsername"
+		if len(this.alias) > 0 {
+			return this.alias + ":preferredUsername"
+		} else {
+			return "preferredUsername"
+		}
 	}
 }
 
@@ -34,6 +34,10 @@ func DeserializePrevProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "prev")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["prev"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializePrevProperty(m map[string]interface{}, aliasMap map[string]strin
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsPrevProperty{
 					activitystreamsCollectionPageMember: v,
@@ -271,9 +276,14 @@ func (this ActivityStreamsPrevProperty) LessThan(o vocab.ActivityStreamsPrevProp
 	return false
 }
 
-// Name returns the name of this property: "prev".
+// Name returns the name of this property: "prev", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsPrevProperty) Name() string {
-	return "prev"
+	if len(this.alias) > 0 {
+		return this.alias + ":prev"
+	} else {
+		return "prev"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -31,6 +31,10 @@ func DeserializePublishedProperty(m map[string]interface{}, aliasMap map[string]
 		propName = fmt.Sprintf("%s:%s", alias, "published")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["published"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsPublishedProperty) LessThan(o vocab.ActivityStreamsPub
 	}
 }
 
-// Name returns the name of this property: "published".
+// Name returns the name of this property: "published", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsPublishedProperty) Name() string {
-	return "published"
+	if len(this.alias) > 0 {
+		return this.alias + ":published"
+	} else {
+		return "published"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -30,6 +30,10 @@ func DeserializeRadiusProperty(m map[string]interface{}, aliasMap map[string]str
 		propName = fmt.Sprintf("%s:%s", alias, "radius")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["radius"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -163,9 +167,14 @@ func (this ActivityStreamsRadiusProperty) LessThan(o vocab.ActivityStreamsRadius
 	}
 }
 
-// Name returns the name of this property: "radius".
+// Name returns the name of this property: "radius", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsRadiusProperty) Name() string {
-	return "radius"
+	if len(this.alias) > 0 {
+		return this.alias + ":radius"
+	} else {
+		return "radius"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -147,9 +147,14 @@ func (this ActivityStreamsRelPropertyIterator) LessThan(o vocab.ActivityStreamsR
 	}
 }
 
-// Name returns the name of this property: "ActivityStreamsRel".
+// Name returns the name of this property: "ActivityStreamsRel", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsRelPropertyIterator) Name() string {
-	return "ActivityStreamsRel"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsRel"
+	} else {
+		return "ActivityStreamsRel"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -225,6 +230,10 @@ func DeserializeRelProperty(m map[string]interface{}, aliasMap map[string]string
 		propName = fmt.Sprintf("%s:%s", alias, "rel")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["rel"]
+	}
 
 	if ok {
 		this := &ActivityStreamsRelProperty{
@@ -421,9 +430,14 @@ func (this ActivityStreamsRelProperty) LessThan(o vocab.ActivityStreamsRelProper
 	return l1 < l2
 }
 
-// Name returns the name of this property: "rel".
+// Name returns the name of this property: "rel", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsRelProperty) Name() string {
-	return "rel"
+	if len(this.alias) > 0 {
+		return this.alias + ":rel"
+	} else {
+		return "rel"
+	}
 }
 
 // PrependIRI prepends an IRI value to the front of a list of the property "rel".
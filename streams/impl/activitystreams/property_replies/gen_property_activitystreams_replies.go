@@ -34,6 +34,10 @@ func DeserializeRepliesProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "replies")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["replies"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeRepliesProperty(m map[string]interface{}, aliasMap map[string]st
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsRepliesProperty{
 					activitystreamsCollectionMember: v,
@@ -272,9 +277,14 @@ func (this ActivityStreamsRepliesProperty) LessThan(o vocab.ActivityStreamsRepli
 	return false
 }
 
-// Name returns the name of this property: "replies".
+// Name returns the name of this property: "replies", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsRepliesProperty) Name() string {
-	return "replies"
+	if len(this.alias) > 0 {
+		return this.alias + ":replies"
+	} else {
+		return "replies"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -34,6 +34,10 @@ func DeserializeSharesProperty(m map[string]interface{}, aliasMap map[string]str
 		propName = fmt.Sprintf("%s:%s", alias, "shares")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["shares"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -49,6 +53,7 @@ func DeserializeSharesProperty(m map[string]interface{}, aliasMap map[string]str
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &ActivityStreamsSharesProperty{
 					activitystreamsOrderedCollectionMember: v,
@@ -272,9 +277,14 @@ func (this ActivityStreamsSharesProperty) LessThan(o vocab.ActivityStreamsShares
 	return false
 }
 
-// Name returns the name of this property: "shares".
+// Name returns the name of this property: "shares", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsSharesProperty) Name() string {
-	return "shares"
+	if len(this.alias) > 0 {
+		return this.alias + ":shares"
+	} else {
+		return "shares"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
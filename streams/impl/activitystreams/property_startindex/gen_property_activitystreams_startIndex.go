@@ -30,6 +30,10 @@ func DeserializeStartIndexProperty(m map[string]interface{}, aliasMap map[string
 		propName = fmt.Sprintf("%s:%s", alias, "startIndex")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["startIndex"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsStartIndexProperty) LessThan(o vocab.ActivityStreamsSt
 	}
 }
 
-// Name returns the name of this property: "startIndex".
+// Name returns the name of this property: "startIndex", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsStartIndexProperty) Name() string {
-	return "startIndex"
+	if len(this.alias) > 0 {
+		return this.alias + ":startIndex"
+	} else {
+		return "startIndex"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
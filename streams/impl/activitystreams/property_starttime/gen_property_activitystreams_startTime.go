@@ -31,6 +31,10 @@ func DeserializeStartTimeProperty(m map[string]interface{}, aliasMap map[string]
 		propName = fmt.Sprintf("%s:%s", alias, "startTime")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["startTime"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsStartTimeProperty) LessThan(o vocab.ActivityStreamsSta
 	}
 }
 
-// Name returns the name of this property: "startTime".
+// Name returns the name of this property: "startTime", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsStartTimeProperty) Name() string {
-	return "startTime"
+	if len(this.alias) > 0 {
+		return this.alias + ":startTime"
+	} else {
+		return "startTime"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -49,6 +49,7 @@ func deserializeActivityStreamsStreamsPropertyIterator(i interface{}, aliasMap m
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
+
 		if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsStreamsPropertyIterator{
 				activitystreamsOrderedCollectionMember: v,
@@ -254,9 +255,14 @@ func (this ActivityStreamsStreamsPropertyIterator) LessThan(o vocab.ActivityStre
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsStreams".
+// Name returns the name of this property: "ActivityStreamsStreams", prefixed by
+// the alias of its vocabulary if it has one.
 func (this ActivityStreamsStreamsPropertyIterator) Name() string {
-	return "ActivityStreamsStreams"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsStreams"
+	} else {
+		return "ActivityStreamsStreams"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -383,6 +389,10 @@ func DeserializeStreamsProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "streams")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["streams"]
+	}
 
 	if ok {
 		this := &ActivityStreamsStreamsProperty{
@@ -714,9 +724,14 @@ func (this ActivityStreamsStreamsProperty) LessThan(o vocab.ActivityStreamsStrea
 	return l1 < l2
 }
 
-// Name returns the name of this property: "streams".
+// Name returns the name of this property: "streams", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsStreamsProperty) Name() string {
-	return "streams"
+	if len(this.alias) > 0 {
+		return this.alias + ":streams"
+	} else {
+		return "streams"
+	}
 }
 
 // PrependActivityStreamsCollection prepends a Collection value to the front of a
@@ -199,12 +199,21 @@ func (this ActivityStreamsSummaryPropertyIterator) LessThan(o vocab.ActivityStre
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsSummary".
+// Name returns the name of this property: "ActivityStreamsSummary", prefixed by
+// the alias of its vocabulary if it has one.
 func (this ActivityStreamsSummaryPropertyIterator) Name() string {
 	if this.IsRDFLangString() {
-		return "ActivityStreamsSummaryMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":ActivityStreamsSummaryMap"
+		} else {
+			return "ActivityStreamsSummaryMap"
+		}
 	} else {
-		return "ActivityStreamsSummary"
+		if len(this.alias) > 0 {
+			return this.alias + ":ActivityStreamsSummary"
+		} else {
+			return "ActivityStreamsSummary"
+		}
 	}
 }
 
@@ -305,6 +314,10 @@ func DeserializeSummaryProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "summary")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["summary"]
+	}
 	if !ok {
 		// Attempt to find the map instead.
 		i, ok = m[propName+"Map"]
@@ -536,12 +549,21 @@ func (this ActivityStreamsSummaryProperty) LessThan(o vocab.ActivityStreamsSumma
 	return l1 < l2
 }
 
-// Name returns the name of this property: "summary".
+// Name returns the name of this property: "summary", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsSummaryProperty) Name() string {
 	if this.Len() == 1 && this.At(0).IsRDFLangString() {
-		return "summaryMap"
+		if len(this.alias) > 0 {
+			return this.alias + ":summaryMap"
+		} else {
+			return "summaryMap"
+		}
 	} else {
-		return "summary"
+		if len(this.alias) > 0 {
+			return this.alias + ":summary"
+		} else {
+			return "summary"
+		}
 	}
 }
 
@@ -30,6 +30,10 @@ func DeserializeTotalItemsProperty(m map[string]interface{}, aliasMap map[string
 		propName = fmt.Sprintf("%s:%s", alias, "totalItems")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["totalItems"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsTotalItemsProperty) LessThan(o vocab.ActivityStreamsTo
 	}
 }
 
-// Name returns the name of this property: "totalItems".
+// Name returns the name of this property: "totalItems", prefixed by the alias of
+// its vocabulary if it has one.
 func (this ActivityStreamsTotalItemsProperty) Name() string {
-	return "totalItems"
+	if len(this.alias) > 0 {
+		return this.alias + ":totalItems"
+	} else {
+		return "totalItems"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -154,9 +154,14 @@ func (this ActivityStreamsTypePropertyIterator) LessThan(o vocab.ActivityStreams
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsType".
+// Name returns the name of this property: "ActivityStreamsType", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsTypePropertyIterator) Name() string {
-	return "ActivityStreamsType"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsType"
+	} else {
+		return "ActivityStreamsType"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -238,6 +243,10 @@ func DeserializeTypeProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "type")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["type"]
+	}
 
 	if ok {
 		this := &ActivityStreamsTypeProperty{
@@ -466,9 +475,14 @@ func (this ActivityStreamsTypeProperty) LessThan(o vocab.ActivityStreamsTypeProp
 	return l1 < l2
 }
 
-// Name returns the name of this property: "type".
+// Name returns the name of this property: "type", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsTypeProperty) Name() string {
-	return "type"
+	if len(this.alias) > 0 {
+		return this.alias + ":type"
+	} else {
+		return "type"
+	}
 }
 
 // PrependIRI prepends an IRI value to the front of a list of the property "type".
@@ -34,6 +34,10 @@ func DeserializeUnitsProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "units")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["units"]
+	}
 
 	if ok {
 		if v, err := string1.DeserializeString(i); err == nil {
@@ -169,9 +173,14 @@ func (this ActivityStreamsUnitsProperty) LessThan(o vocab.ActivityStreamsUnitsPr
 	return false
 }
 
-// Name returns the name of this property: "units".
+// Name returns the name of this property: "units", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsUnitsProperty) Name() string {
-	return "units"
+	if len(this.alias) > 0 {
+		return this.alias + ":units"
+	} else {
+		return "units"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -31,6 +31,10 @@ func DeserializeUpdatedProperty(m map[string]interface{}, aliasMap map[string]st
 		propName = fmt.Sprintf("%s:%s", alias, "updated")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["updated"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsUpdatedProperty) LessThan(o vocab.ActivityStreamsUpdat
 	}
 }
 
-// Name returns the name of this property: "updated".
+// Name returns the name of this property: "updated", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsUpdatedProperty) Name() string {
-	return "updated"
+	if len(this.alias) > 0 {
+		return this.alias + ":updated"
+	} else {
+		return "updated"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -35,6 +35,7 @@ func deserializeActivityStreamsUrlPropertyIterator(i interface{}, aliasMap map[s
 		alias = a
 	}
 	if m, ok := i.(map[string]interface{}); ok {
+
 		if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsUrlPropertyIterator{
 				activitystreamsLinkMember: v,
@@ -198,9 +199,14 @@ func (this ActivityStreamsUrlPropertyIterator) LessThan(o vocab.ActivityStreamsU
 	return false
 }
 
-// Name returns the name of this property: "ActivityStreamsUrl".
+// Name returns the name of this property: "ActivityStreamsUrl", prefixed by the
+// alias of its vocabulary if it has one.
 func (this ActivityStreamsUrlPropertyIterator) Name() string {
-	return "ActivityStreamsUrl"
+	if len(this.alias) > 0 {
+		return this.alias + ":ActivityStreamsUrl"
+	} else {
+		return "ActivityStreamsUrl"
+	}
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
@@ -306,6 +312,10 @@ func DeserializeUrlProperty(m map[string]interface{}, aliasMap map[string]string
 		propName = fmt.Sprintf("%s:%s", alias, "url")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["url"]
+	}
 
 	if ok {
 		this := &ActivityStreamsUrlProperty{
@@ -601,9 +611,14 @@ func (this ActivityStreamsUrlProperty) LessThan(o vocab.ActivityStreamsUrlProper
 	return l1 < l2
 }
 
-// Name returns the name of this property: "url".
+// Name returns the name of this property: "url", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsUrlProperty) Name() string {
-	return "url"
+	if len(this.alias) > 0 {
+		return this.alias + ":url"
+	} else {
+		return "url"
+	}
 }
 
 // PrependActivityStreamsLink prepends a Link value to the front of a list of the
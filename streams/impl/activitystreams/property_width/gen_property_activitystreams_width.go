@@ -30,6 +30,10 @@ func DeserializeWidthProperty(m map[string]interface{}, aliasMap map[string]stri
 		propName = fmt.Sprintf("%s:%s", alias, "width")
 	}
 	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["width"]
+	}
 
 	if ok {
 		if s, ok := i.(string); ok {
@@ -164,9 +168,14 @@ func (this ActivityStreamsWidthProperty) LessThan(o vocab.ActivityStreamsWidthPr
 	}
 }
 
-// Name returns the name of this property: "width".
+// Name returns the name of this property: "width", prefixed by the alias of its
+// vocabulary if it has one.
 func (this ActivityStreamsWidthProperty) Name() string {
-	return "width"
+	if len(this.alias) > 0 {
+		return this.alias + ":width"
+	} else {
+		return "width"
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -52,6 +52,7 @@ type ActivityStreamsAdd struct {
 	ActivityStreamsAttributedTo vocab.ActivityStreamsAttributedToProperty
 	ActivityStreamsAudience     vocab.ActivityStreamsAudienceProperty
 	ActivityStreamsBcc          vocab.ActivityStreamsBccProperty
+	VcardBday                   vocab.VcardBdayProperty
 	ActivityStreamsBto          vocab.ActivityStreamsBtoProperty
 	ActivityStreamsCc           vocab.ActivityStreamsCcProperty
 	ActivityStreamsContent      vocab.ActivityStreamsContentProperty
@@ -59,6 +60,7 @@ type ActivityStreamsAdd struct {
 	ActivityStreamsDuration     vocab.ActivityStreamsDurationProperty
 	ActivityStreamsEndTime      vocab.ActivityStreamsEndTimeProperty
 	ActivityStreamsGenerator    vocab.ActivityStreamsGeneratorProperty
+	VcardHasAddress             vocab.VcardHasAddressProperty
 	ActivityStreamsIcon         vocab.ActivityStreamsIconProperty
 	ActivityStreamsId           vocab.ActivityStreamsIdProperty
 	ActivityStreamsImage        vocab.ActivityStreamsImageProperty
@@ -79,7 +81,9 @@ type ActivityStreamsAdd struct {
 	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
 	ActivityStreamsTag          vocab.ActivityStreamsTagProperty
 	ActivityStreamsTarget       vocab.ActivityStreamsTargetProperty
+	ForgeFedTicketsTrackedBy    vocab.ForgeFedTicketsTrackedByProperty
 	ActivityStreamsTo           vocab.ActivityStreamsToProperty
+	ForgeFedTracksTicketsFor    vocab.ForgeFedTracksTicketsForProperty
 	ActivityStreamsType         vocab.ActivityStreamsTypeProperty
 	ActivityStreamsUpdated      vocab.ActivityStreamsUpdatedProperty
 	ActivityStreamsUrl          vocab.ActivityStreamsUrlProperty
@@ -132,7 +136,12 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 		alias:   alias,
 		unknown: make(map[string]interface{}),
 	}
-	if typeValue, ok := m["type"]; !ok {
+	typeValue, ok := m["type"]
+	if !ok {
+		// Some implementations use the JSON-LD keyword instead.
+		typeValue, ok = m["@type"]
+	}
+	if !ok {
 		return nil, fmt.Errorf("no \"type\" property in map")
 	} else if typeString, ok := typeValue.(string); ok {
 		typeName := strings.TrimPrefix(typeString, aliasPrefix)
@@ -186,6 +195,11 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 	} else if p != nil {
 		this.ActivityStreamsBcc = p
 	}
+	if p, err := mgr.DeserializeBdayPropertyVcard()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.VcardBday = p
+	}
 	if p, err := mgr.DeserializeBtoPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -221,6 +235,11 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 	} else if p != nil {
 		this.ActivityStreamsGenerator = p
 	}
+	if p, err := mgr.DeserializeHasAddressPropertyVcard()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.VcardHasAddress = p
+	}
 	if p, err := mgr.DeserializeIconPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -321,11 +340,21 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 	} else if p != nil {
 		this.ActivityStreamsTarget = p
 	}
+	if p, err := mgr.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTicketsTrackedBy = p
+	}
 	if p, err := mgr.DeserializeToPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
 		this.ActivityStreamsTo = p
 	}
+	if p, err := mgr.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTracksTicketsFor = p
+	}
 	if p, err := mgr.DeserializeTypePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -346,86 +375,178 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "actor" {
+		if k == "@type" {
+			continue
+		} else if k == "actor" {
+			continue
+		} else if this.ActivityStreamsActor != nil && k == this.ActivityStreamsActor.Name() {
 			continue
 		} else if k == "altitude" {
 			continue
+		} else if this.ActivityStreamsAltitude != nil && k == this.ActivityStreamsAltitude.Name() {
+			continue
 		} else if k == "attachment" {
 			continue
+		} else if this.ActivityStreamsAttachment != nil && k == this.ActivityStreamsAttachment.Name() {
+			continue
 		} else if k == "attributedTo" {
 			continue
+		} else if this.ActivityStreamsAttributedTo != nil && k == this.ActivityStreamsAttributedTo.Name() {
+			continue
 		} else if k == "audience" {
 			continue
+		} else if this.ActivityStreamsAudience != nil && k == this.ActivityStreamsAudience.Name() {
+			continue
 		} else if k == "bcc" {
 			continue
+		} else if this.ActivityStreamsBcc != nil && k == this.ActivityStreamsBcc.Name() {
+			continue
+		} else if k == "bday" {
+			continue
+		} else if this.VcardBday != nil && k == this.VcardBday.Name() {
+			continue
 		} else if k == "bto" {
 			continue
+		} else if this.ActivityStreamsBto != nil && k == this.ActivityStreamsBto.Name() {
+			continue
 		} else if k == "cc" {
 			continue
+		} else if this.ActivityStreamsCc != nil && k == this.ActivityStreamsCc.Name() {
+			continue
 		} else if k == "content" {
 			continue
+		} else if this.ActivityStreamsContent != nil && k == this.ActivityStreamsContent.Name() {
+			continue
 		} else if k == "contentMap" {
 			continue
 		} else if k == "context" {
 			continue
+		} else if this.ActivityStreamsContext != nil && k == this.ActivityStreamsContext.Name() {
+			continue
 		} else if k == "duration" {
 			continue
+		} else if this.ActivityStreamsDuration != nil && k == this.ActivityStreamsDuration.Name() {
+			continue
 		} else if k == "endTime" {
 			continue
+		} else if this.ActivityStreamsEndTime != nil && k == this.ActivityStreamsEndTime.Name() {
+			continue
 		} else if k == "generator" {
 			continue
+		} else if this.ActivityStreamsGenerator != nil && k == this.ActivityStreamsGenerator.Name() {
+			continue
+		} else if k == "hasAddress" {
+			continue
+		} else if this.VcardHasAddress != nil && k == this.VcardHasAddress.Name() {
+			continue
 		} else if k == "icon" {
 			continue
+		} else if this.ActivityStreamsIcon != nil && k == this.ActivityStreamsIcon.Name() {
+			continue
 		} else if k == "id" {
 			continue
+		} else if this.ActivityStreamsId != nil && k == this.ActivityStreamsId.Name() {
+			continue
 		} else if k == "image" {
 			continue
+		} else if this.ActivityStreamsImage != nil && k == this.ActivityStreamsImage.Name() {
+			continue
 		} else if k == "inReplyTo" {
 			continue
+		} else if this.ActivityStreamsInReplyTo != nil && k == this.ActivityStreamsInReplyTo.Name() {
+			continue
 		} else if k == "instrument" {
 			continue
+		} else if this.ActivityStreamsInstrument != nil && k == this.ActivityStreamsInstrument.Name() {
+			continue
 		} else if k == "likes" {
 			continue
+		} else if this.ActivityStreamsLikes != nil && k == this.ActivityStreamsLikes.Name() {
+			continue
 		} else if k == "location" {
 			continue
+		} else if this.ActivityStreamsLocation != nil && k == this.ActivityStreamsLocation.Name() {
+			continue
 		} else if k == "mediaType" {
 			continue
+		} else if this.ActivityStreamsMediaType != nil && k == this.ActivityStreamsMediaType.Name() {
+			continue
 		} else if k == "name" {
 			continue
+		} else if this.ActivityStreamsName != nil && k == this.ActivityStreamsName.Name() {
+			continue
 		} else if k == "nameMap" {
 			continue
 		} else if k == "object" {
 			continue
+		} else if this.ActivityStreamsObject != nil && k == this.ActivityStreamsObject.Name() {
+			continue
 		} else if k == "origin" {
 			continue
+		} else if this.ActivityStreamsOrigin != nil && k == this.ActivityStreamsOrigin.Name() {
+			continue
 		} else if k == "preview" {
 			continue
+		} else if this.ActivityStreamsPreview != nil && k == this.ActivityStreamsPreview.Name() {
+			continue
 		} else if k == "published" {
 			continue
+		} else if this.ActivityStreamsPublished != nil && k == this.ActivityStreamsPublished.Name() {
+			continue
 		} else if k == "replies" {
 			continue
+		} else if this.ActivityStreamsReplies != nil && k == this.ActivityStreamsReplies.Name() {
+			continue
 		} else if k == "result" {
 			continue
+		} else if this.ActivityStreamsResult != nil && k == this.ActivityStreamsResult.Name() {
+			continue
 		} else if k == "shares" {
 			continue
+		} else if this.ActivityStreamsShares != nil && k == this.ActivityStreamsShares.Name() {
+			continue
 		} else if k == "startTime" {
 			continue
+		} else if this.ActivityStreamsStartTime != nil && k == this.ActivityStreamsStartTime.Name() {
+			continue
 		} else if k == "summary" {
 			continue
+		} else if this.ActivityStreamsSummary != nil && k == this.ActivityStreamsSummary.Name() {
+			continue
 		} else if k == "summaryMap" {
 			continue
 		} else if k == "tag" {
 			continue
+		} else if this.ActivityStreamsTag != nil && k == this.ActivityStreamsTag.Name() {
+			continue
 		} else if k == "target" {
 			continue
+		} else if this.ActivityStreamsTarget != nil && k == this.ActivityStreamsTarget.Name() {
+			continue
+		} else if k == "ticketsTrackedBy" {
+			continue
+		} else if this.ForgeFedTicketsTrackedBy != nil && k == this.ForgeFedTicketsTrackedBy.Name() {
+			continue
 		} else if k == "to" {
 			continue
+		} else if this.ActivityStreamsTo != nil && k == this.ActivityStreamsTo.Name() {
+			continue
+		} else if k == "tracksTicketsFor" {
+			continue
+		} else if this.ForgeFedTracksTicketsFor != nil && k == this.ForgeFedTracksTicketsFor.Name() {
+			continue
 		} else if k == "type" {
 			continue
+		} else if this.ActivityStreamsType != nil && k == this.ActivityStreamsType.Name() {
+			continue
 		} else if k == "updated" {
 			continue
+		} else if this.ActivityStreamsUpdated != nil && k == this.ActivityStreamsUpdated.Name() {
+			continue
 		} else if k == "url" {
 			continue
+		} else if this.ActivityStreamsUrl != nil && k == this.ActivityStreamsUrl.Name() {
+			continue
 		} // End: Code that ensures a property name is unknown
 
 		this.unknown[k] = v
@@ -674,6 +795,18 @@ func (this ActivityStreamsAdd) GetActivityStreamsUrl() vocab.ActivityStreamsUrlP
 	return this.ActivityStreamsUrl
 }
 
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
 // GetTypeName returns the name of this type.
 func (this ActivityStreamsAdd) GetTypeName() string {
 	return "Add"
@@ -689,6 +822,17 @@ func (this ActivityStreamsAdd) GetUnknownProperties() map[string]interface{} {
 	return this.unknown
 }
 
+// GetVcardBday returns the "bday" property if it exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetVcardBday() vocab.VcardBdayProperty {
+	return this.VcardBday
+}
+
+// GetVcardHasAddress returns the "hasAddress" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetVcardHasAddress() vocab.VcardHasAddressProperty {
+	return this.VcardHasAddress
+}
+
 // IsExtending returns true if the Add type extends from the other type.
 func (this ActivityStreamsAdd) IsExtending(other vocab.Type) bool {
 	return ActivityStreamsAddExtends(other)
@@ -705,6 +849,7 @@ func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
+	m = this.helperJSONLDContext(this.VcardBday, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
@@ -712,6 +857,7 @@ func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
+	m = this.helperJSONLDContext(this.VcardHasAddress, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsId, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
@@ -732,7 +878,9 @@ func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTarget, m)
+	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
+	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsType, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
@@ -828,6 +976,20 @@ func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "bday"
+	if lhs, rhs := this.VcardBday, o.GetVcardBday(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "bto"
 	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -926,6 +1088,20 @@ func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "hasAddress"
+	if lhs, rhs := this.VcardHasAddress, o.GetVcardHasAddress(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "icon"
 	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1206,6 +1382,20 @@ func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "ticketsTrackedBy"
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1220,6 +1410,20 @@ func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "tracksTicketsFor"
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "type"
 	if lhs, rhs := this.ActivityStreamsType, o.GetActivityStreamsType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1333,6 +1537,14 @@ func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsBcc.Name()] = i
 		}
 	}
+	// Maybe serialize property "bday"
+	if this.VcardBday != nil {
+		if i, err := this.VcardBday.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.VcardBday.Name()] = i
+		}
+	}
 	// Maybe serialize property "bto"
 	if this.ActivityStreamsBto != nil {
 		if i, err := this.ActivityStreamsBto.Serialize(); err != nil {
@@ -1389,6 +1601,14 @@ func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsGenerator.Name()] = i
 		}
 	}
+	// Maybe serialize property "hasAddress"
+	if this.VcardHasAddress != nil {
+		if i, err := this.VcardHasAddress.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.VcardHasAddress.Name()] = i
+		}
+	}
 	// Maybe serialize property "icon"
 	if this.ActivityStreamsIcon != nil {
 		if i, err := this.ActivityStreamsIcon.Serialize(); err != nil {
@@ -1549,6 +1769,14 @@ func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsTarget.Name()] = i
 		}
 	}
+	// Maybe serialize property "ticketsTrackedBy"
+	if this.ForgeFedTicketsTrackedBy != nil {
+		if i, err := this.ForgeFedTicketsTrackedBy.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTicketsTrackedBy.Name()] = i
+		}
+	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
 		if i, err := this.ActivityStreamsTo.Serialize(); err != nil {
@@ -1557,6 +1785,14 @@ func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsTo.Name()] = i
 		}
 	}
+	// Maybe serialize property "tracksTicketsFor"
+	if this.ForgeFedTracksTicketsFor != nil {
+		if i, err := this.ForgeFedTracksTicketsFor.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTracksTicketsFor.Name()] = i
+		}
+	}
 	// Maybe serialize property "type"
 	if this.ActivityStreamsType != nil {
 		if i, err := this.ActivityStreamsType.Serialize(); err != nil {
@@ -1780,6 +2016,26 @@ func (this *ActivityStreamsAdd) SetActivityStreamsUrl(i vocab.ActivityStreamsUrl
 	this.ActivityStreamsUrl = i
 }
 
+// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+func (this *ActivityStreamsAdd) SetForgeFedTicketsTrackedBy(i vocab.ForgeFedTicketsTrackedByProperty) {
+	this.ForgeFedTicketsTrackedBy = i
+}
+
+// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+func (this *ActivityStreamsAdd) SetForgeFedTracksTicketsFor(i vocab.ForgeFedTracksTicketsForProperty) {
+	this.ForgeFedTracksTicketsFor = i
+}
+
+// SetVcardBday sets the "bday" property.
+func (this *ActivityStreamsAdd) SetVcardBday(i vocab.VcardBdayProperty) {
+	this.VcardBday = i
+}
+
+// SetVcardHasAddress sets the "hasAddress" property.
+func (this *ActivityStreamsAdd) SetVcardHasAddress(i vocab.VcardHasAddressProperty) {
+	this.VcardHasAddress = i
+}
+
 // VocabularyURI returns the vocabulary's URI as a string.
 func (this ActivityStreamsAdd) VocabularyURI() string {
 	return "https://www.w3.org/ns/activitystreams"
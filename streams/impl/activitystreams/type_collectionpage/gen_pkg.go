@@ -30,6 +30,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsBccProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
 	DeserializeBccPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBccProperty, error)
+	// DeserializeBdayPropertyVcard returns the deserialization method for the
+	// "VcardBdayProperty" non-functional property in the vocabulary
+	// "Vcard"
+	DeserializeBdayPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardBdayProperty, error)
 	// DeserializeBtoPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsBtoProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
@@ -66,6 +70,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsGeneratorProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeGeneratorPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGeneratorProperty, error)
+	// DeserializeHasAddressPropertyVcard returns the deserialization method
+	// for the "VcardHasAddressProperty" non-functional property in the
+	// vocabulary "Vcard"
+	DeserializeHasAddressPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardHasAddressProperty, error)
 	// DeserializeIconPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsIconProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -150,6 +158,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsTagProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
 	DeserializeTagPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTagProperty, error)
+	// DeserializeTicketsTrackedByPropertyForgeFed returns the deserialization
+	// method for the "ForgeFedTicketsTrackedByProperty" non-functional
+	// property in the vocabulary "ForgeFed"
+	DeserializeTicketsTrackedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error)
 	// DeserializeToPropertyActivityStreams returns the deserialization method
 	// for the "ActivityStreamsToProperty" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -158,6 +170,10 @@ type privateManager interface {
 	// deserialization method for the "ActivityStreamsTotalItemsProperty"
 	// non-functional property in the vocabulary "ActivityStreams"
 	DeserializeTotalItemsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error)
+	// DeserializeTracksTicketsForPropertyForgeFed returns the deserialization
+	// method for the "ForgeFedTracksTicketsForProperty" non-functional
+	// property in the vocabulary "ForgeFed"
+	DeserializeTracksTicketsForPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error)
 	// DeserializeTypePropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsTypeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
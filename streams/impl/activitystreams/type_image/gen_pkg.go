@@ -30,6 +30,14 @@ type privateManager interface {
 	// method for the "ActivityStreamsBccProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
 	DeserializeBccPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBccProperty, error)
+	// DeserializeBdayPropertyVcard returns the deserialization method for the
+	// "VcardBdayProperty" non-functional property in the vocabulary
+	// "Vcard"
+	DeserializeBdayPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardBdayProperty, error)
+	// DeserializeBlurhashPropertyToot returns the deserialization method for
+	// the "TootBlurhashProperty" non-functional property in the
+	// vocabulary "Toot"
+	DeserializeBlurhashPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootBlurhashProperty, error)
 	// DeserializeBtoPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsBtoProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
@@ -54,10 +62,18 @@ type privateManager interface {
 	// method for the "ActivityStreamsEndTimeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeEndTimePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error)
+	// DeserializeFocalPointPropertyToot returns the deserialization method
+	// for the "TootFocalPointProperty" non-functional property in the
+	// vocabulary "Toot"
+	DeserializeFocalPointPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFocalPointProperty, error)
 	// DeserializeGeneratorPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsGeneratorProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeGeneratorPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGeneratorProperty, error)
+	// DeserializeHasAddressPropertyVcard returns the deserialization method
+	// for the "VcardHasAddressProperty" non-functional property in the
+	// vocabulary "Vcard"
+	DeserializeHasAddressPropertyVcard() func(map[string]interface{}, map[string]string) (vocab.VcardHasAddressProperty, error)
 	// DeserializeHeightPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsHeightProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -126,10 +142,18 @@ type privateManager interface {
 	// method for the "ActivityStreamsTagProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
 	DeserializeTagPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTagProperty, error)
+	// DeserializeTicketsTrackedByPropertyForgeFed returns the deserialization
+	// method for the "ForgeFedTicketsTrackedByProperty" non-functional
+	// property in the vocabulary "ForgeFed"
+	DeserializeTicketsTrackedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error)
 	// DeserializeToPropertyActivityStreams returns the deserialization method
 	// for the "ActivityStreamsToProperty" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsToProperty, error)
+	// DeserializeTracksTicketsForPropertyForgeFed returns the deserialization
+	// method for the "ForgeFedTracksTicketsForProperty" non-functional
+	// property in the vocabulary "ForgeFed"
+	DeserializeTracksTicketsForPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error)
 	// DeserializeTypePropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsTypeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -19,15 +19,19 @@ type ActivityStreamsOrganization struct {
 	ActivityStreamsAttributedTo      vocab.ActivityStreamsAttributedToProperty
 	ActivityStreamsAudience          vocab.ActivityStreamsAudienceProperty
 	ActivityStreamsBcc               vocab.ActivityStreamsBccProperty
+	VcardBday                        vocab.VcardBdayProperty
 	ActivityStreamsBto               vocab.ActivityStreamsBtoProperty
 	ActivityStreamsCc                vocab.ActivityStreamsCcProperty
 	ActivityStreamsContent           vocab.ActivityStreamsContentProperty
 	ActivityStreamsContext           vocab.ActivityStreamsContextProperty
+	TootDiscoverable                 vocab.TootDiscoverableProperty
 	ActivityStreamsDuration          vocab.ActivityStreamsDurationProperty
 	ActivityStreamsEndTime           vocab.ActivityStreamsEndTimeProperty
+	TootFeatured                     vocab.TootFeaturedProperty
 	ActivityStreamsFollowers         vocab.ActivityStreamsFollowersProperty
 	ActivityStreamsFollowing         vocab.ActivityStreamsFollowingProperty
 	ActivityStreamsGenerator         vocab.ActivityStreamsGeneratorProperty
+	VcardHasAddress                  vocab.VcardHasAddressProperty
 	ActivityStreamsIcon              vocab.ActivityStreamsIconProperty
 	ActivityStreamsId                vocab.ActivityStreamsIdProperty
 	ActivityStreamsImage             vocab.ActivityStreamsImageProperty
@@ -42,6 +46,7 @@ type ActivityStreamsOrganization struct {
 	ActivityStreamsOutbox            vocab.ActivityStreamsOutboxProperty
 	ActivityStreamsPreferredUsername vocab.ActivityStreamsPreferredUsernameProperty
 	ActivityStreamsPreview           vocab.ActivityStreamsPreviewProperty
+	SecurityV1PublicKey              vocab.SecurityV1PublicKeyProperty
 	ActivityStreamsPublished         vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies           vocab.ActivityStreamsRepliesProperty
 	ActivityStreamsShares            vocab.ActivityStreamsSharesProperty
@@ -49,7 +54,9 @@ type ActivityStreamsOrganization struct {
 	ActivityStreamsStreams           vocab.ActivityStreamsStreamsProperty
 	ActivityStreamsSummary           vocab.ActivityStreamsSummaryProperty
 	ActivityStreamsTag               vocab.ActivityStreamsTagProperty
+	ForgeFedTicketsTrackedBy         vocab.ForgeFedTicketsTrackedByProperty
 	ActivityStreamsTo                vocab.ActivityStreamsToProperty
+	ForgeFedTracksTicketsFor         vocab.ForgeFedTracksTicketsForProperty
 	ActivityStreamsType              vocab.ActivityStreamsTypeProperty
 	ActivityStreamsUpdated           vocab.ActivityStreamsUpdatedProperty
 	ActivityStreamsUrl               vocab.ActivityStreamsUrlProperty
@@ -82,7 +89,12 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 		alias:   alias,
 		unknown: make(map[string]interface{}),
 	}
-	if typeValue, ok := m["type"]; !ok {
+	typeValue, ok := m["type"]
+	if !ok {
+		// Some implementations use the JSON-LD keyword instead.
+		typeValue, ok = m["@type"]
+	}
+	if !ok {
 		return nil, fmt.Errorf("no \"type\" property in map")
 	} else if typeString, ok := typeValue.(string); ok {
 		typeName := strings.TrimPrefix(typeString, aliasPrefix)
@@ -131,6 +143,11 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	} else if p != nil {
 		this.ActivityStreamsBcc = p
 	}
+	if p, err := mgr.DeserializeBdayPropertyVcard()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.VcardBday = p
+	}
 	if p, err := mgr.DeserializeBtoPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -151,6 +168,11 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	} else if p != nil {
 		this.ActivityStreamsContext = p
 	}
+	if p, err := mgr.DeserializeDiscoverablePropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootDiscoverable = p
+	}
 	if p, err := mgr.DeserializeDurationPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -161,6 +183,11 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	} else if p != nil {
 		this.ActivityStreamsEndTime = p
 	}
+	if p, err := mgr.DeserializeFeaturedPropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootFeatured = p
+	}
 	if p, err := mgr.DeserializeFollowersPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -176,6 +203,11 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	} else if p != nil {
 		this.ActivityStreamsGenerator = p
 	}
+	if p, err := mgr.DeserializeHasAddressPropertyVcard()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.VcardHasAddress = p
+	}
 	if p, err := mgr.DeserializeIconPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -246,6 +278,11 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	} else if p != nil {
 		this.ActivityStreamsPreview = p
 	}
+	if p, err := mgr.DeserializePublicKeyPropertySecurityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.SecurityV1PublicKey = p
+	}
 	if p, err := mgr.DeserializePublishedPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -281,11 +318,21 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	} else if p != nil {
 		this.ActivityStreamsTag = p
 	}
+	if p, err := mgr.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTicketsTrackedBy = p
+	}
 	if p, err := mgr.DeserializeToPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
 		this.ActivityStreamsTo = p
 	}
+	if p, err := mgr.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTracksTicketsFor = p
+	}
 	if p, err := mgr.DeserializeTypePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -306,92 +353,200 @@ func DeserializeOrganization(m map[string]interface{}, aliasMap map[string]strin
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "altitude" {
+		if k == "@type" {
+			continue
+		} else if k == "altitude" {
+			continue
+		} else if this.ActivityStreamsAltitude != nil && k == this.ActivityStreamsAltitude.Name() {
 			continue
 		} else if k == "attachment" {
 			continue
+		} else if this.ActivityStreamsAttachment != nil && k == this.ActivityStreamsAttachment.Name() {
+			continue
 		} else if k == "attributedTo" {
 			continue
+		} else if this.ActivityStreamsAttributedTo != nil && k == this.ActivityStreamsAttributedTo.Name() {
+			continue
 		} else if k == "audience" {
 			continue
+		} else if this.ActivityStreamsAudience != nil && k == this.ActivityStreamsAudience.Name() {
+			continue
 		} else if k == "bcc" {
 			continue
+		} else if this.ActivityStreamsBcc != nil && k == this.ActivityStreamsBcc.Name() {
+			continue
+		} else if k == "bday" {
+			continue
+		} else if this.VcardBday != nil && k == this.VcardBday.Name() {
+			continue
 		} else if k == "bto" {
 			continue
+		} else if this.ActivityStreamsBto != nil && k == this.ActivityStreamsBto.Name() {
+			continue
 		} else if k == "cc" {
 			continue
+		} else if this.ActivityStreamsCc != nil && k == this.ActivityStreamsCc.Name() {
+			continue
 		} else if k == "content" {
 			continue
+		} else if this.ActivityStreamsContent != nil && k == this.ActivityStreamsContent.Name() {
+			continue
 		} else if k == "contentMap" {
 			continue
 		} else if k == "context" {
 			continue
+		} else if this.ActivityStreamsContext != nil && k == this.ActivityStreamsContext.Name() {
+			continue
+		} else if k == "discoverable" {
+			continue
+		} else if this.TootDiscoverable != nil && k == this.TootDiscoverable.Name() {
+			continue
 		} else if k == "duration" {
 			continue
+		} else if this.ActivityStreamsDuration != nil && k == this.ActivityStreamsDuration.Name() {
+			continue
 		} else if k == "endTime" {
 			continue
+		} else if this.ActivityStreamsEndTime != nil && k == this.ActivityStreamsEndTime.Name() {
+			continue
+		} else if k == "featured" {
+			continue
+		} else if this.TootFeatured != nil && k == this.TootFeatured.Name() {
+			continue
 		} else if k == "followers" {
 			continue
+		} else if this.ActivityStreamsFollowers != nil && k == this.ActivityStreamsFollowers.Name() {
+			continue
 		} else if k == "following" {
 			continue
+		} else if this.ActivityStreamsFollowing != nil && k == this.ActivityStreamsFollowing.Name() {
+			continue
 		} else if k == "generator" {
 			continue
+		} else if this.ActivityStreamsGenerator != nil && k == this.ActivityStreamsGenerator.Name() {
+			continue
+		} else if k == "hasAddress" {
+			continue
+		} else if this.VcardHasAddress != nil && k == this.VcardHasAddress.Name() {
+			continue
 		} else if k == "icon" {
 			continue
+		} else if this.ActivityStreamsIcon != nil && k == this.ActivityStreamsIcon.Name() {
+			continue
 		} else if k == "id" {
 			continue
+		} else if this.ActivityStreamsId != nil && k == this.ActivityStreamsId.Name() {
+			continue
 		} else if k == "image" {
 			continue
+		} else if this.ActivityStreamsImage != nil && k == this.ActivityStreamsImage.Name() {
+			continue
 		} else if k == "inReplyTo" {
 			continue
+		} else if this.ActivityStreamsInReplyTo != nil && k == this.ActivityStreamsInReplyTo.Name() {
+			continue
 		} else if k == "inbox" {
 			continue
+		} else if this.ActivityStreamsInbox != nil && k == this.ActivityStreamsInbox.Name() {
+			continue
 		} else if k == "liked" {
 			continue
+		} else if this.ActivityStreamsLiked != nil && k == this.ActivityStreamsLiked.Name() {
+			continue
 		} else if k == "likes" {
 			continue
+		} else if this.ActivityStreamsLikes != nil && k == this.ActivityStreamsLikes.Name() {
+			continue
 		} else if k == "location" {
 			continue
+		} else if this.ActivityStreamsLocation != nil && k == this.ActivityStreamsLocation.Name() {
+			continue
 		} else if k == "mediaType" {
 			continue
+		} else if this.ActivityStreamsMediaType != nil && k == this.ActivityStreamsMediaType.Name() {
+			continue
 		} else if k == "name" {
 			continue
+		} else if this.ActivityStreamsName != nil && k == this.ActivityStreamsName.Name() {
+			continue
 		} else if k == "nameMap" {
 			continue
 		} else if k == "object" {
 			continue
+		} else if this.ActivityStreamsObject != nil && k == this.ActivityStreamsObject.Name() {
+			continue
 		} else if k == "outbox" {
 			continue
+		} else if this.ActivityStreamsOutbox != nil && k == this.ActivityStreamsOutbox.Name() {
+			continue
 		} else if k == "preferredUsername" {
 			continue
+		} else if this.ActivityStreamsPreferredUsername != nil && k == this.ActivityStreamsPreferredUsername.Name() {
+			continue
 		} else if k == "preferredUsernameMap" {
 			continue
 		} else if k == "preview" {
 			continue
+		} else if this.ActivityStreamsPreview != nil && k == this.ActivityStreamsPreview.Name() {
+			continue
+		} else if k == "publicKey" {
+			continue
+		} else if this.SecurityV1PublicKey != nil && k == this.SecurityV1PublicKey.Name() {
+			continue
 		} else if k == "published" {
 			continue
+		} else if this.ActivityStreamsPublished != nil && k == this.ActivityStreamsPublished.Name() {
+			continue
 		} else if k == "replies" {
 			continue
+		} else if this.ActivityStreamsReplies != nil && k == this.ActivityStreamsReplies.Name() {
+			continue
 		} else if k == "shares" {
 			continue
+		} else if this.ActivityStreamsShares != nil && k == this.ActivityStreamsShares.Name() {
+			continue
 		} else if k == "startTime" {
 			continue
+		} else if this.ActivityStreamsStartTime != nil && k == this.ActivityStreamsStartTime.Name() {
+			continue
 		} else if k == "streams" {
 			continue
+		} else if this.ActivityStreamsStreams != nil && k == this.ActivityStreamsStreams.Name() {
+			continue
 		} else if k == "summary" {
 			continue
+		} else if this.ActivityStreamsSummary != nil && k == this.ActivityStreamsSummary.Name() {
+			continue
 		} else if k == "summaryMap" {
 			continue
 		} else if k == "tag" {
 			continue
+		} else if this.ActivityStreamsTag != nil && k == this.ActivityStreamsTag.Name() {
+			continue
+		} else if k == "ticketsTrackedBy" {
+			continue
+		} else if this.ForgeFedTicketsTrackedBy != nil && k == this.ForgeFedTicketsTrackedBy.Name() {
+			continue
 		} else if k == "to" {
 			continue
+		} else if this.ActivityStreamsTo != nil && k == this.ActivityStreamsTo.Name() {
+			continue
+		} else if k == "tracksTicketsFor" {
+			continue
+		} else if this.ForgeFedTracksTicketsFor != nil && k == this.ForgeFedTracksTicketsFor.Name() {
+			continue
 		} else if k == "type" {
 			continue
+		} else if this.ActivityStreamsType != nil && k == this.ActivityStreamsType.Name() {
+			continue
 		} else if k == "updated" {
 			continue
+		} else if this.ActivityStreamsUpdated != nil && k == this.ActivityStreamsUpdated.Name() {
+			continue
 		} else if k == "url" {
 			continue
+		} else if this.ActivityStreamsUrl != nil && k == this.ActivityStreamsUrl.Name() {
+			continue
 		} // End: Code that ensures a property name is unknown
 
 		this.unknown[k] = v
@@ -672,6 +827,35 @@ func (this ActivityStreamsOrganization) GetActivityStreamsUrl() vocab.ActivitySt
 	return this.ActivityStreamsUrl
 }
 
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsOrganization) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsOrganization) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
+// GetSecurityV1PublicKey returns the "publicKey" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsOrganization) GetSecurityV1PublicKey() vocab.SecurityV1PublicKeyProperty {
+	return this.SecurityV1PublicKey
+}
+
+// GetTootDiscoverable returns the "discoverable" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsOrganization) GetTootDiscoverable() vocab.TootDiscoverableProperty {
+	return this.TootDiscoverable
+}
+
+// GetTootFeatured returns the "featured" property if it exists, and nil otherwise.
+func (this ActivityStreamsOrganization) GetTootFeatured() vocab.TootFeaturedProperty {
+	return this.TootFeatured
+}
+
 // GetTypeName returns the name of this type.
 func (this ActivityStreamsOrganization) GetTypeName() string {
 	return "Organization"
@@ -687,6 +871,17 @@ func (this ActivityStreamsOrganization) GetUnknownProperties() map[string]interf
 	return this.unknown
 }
 
+// GetVcardBday returns the "bday" property if it exists, and nil otherwise.
+func (this ActivityStreamsOrganization) GetVcardBday() vocab.VcardBdayProperty {
+	return this.VcardBday
+}
+
+// GetVcardHasAddress returns the "hasAddress" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsOrganization) GetVcardHasAddress() vocab.VcardHasAddressProperty {
+	return this.VcardHasAddress
+}
+
 // IsExtending returns true if the Organization type extends from the other type.
 func (this ActivityStreamsOrganization) IsExtending(other vocab.Type) bool {
 	return ActivityStreamsOrganizationExtends(other)
@@ -702,15 +897,19 @@ func (this ActivityStreamsOrganization) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
+	m = this.helperJSONLDContext(this.VcardBday, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
+	m = this.helperJSONLDContext(this.TootDiscoverable, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
+	m = this.helperJSONLDContext(this.TootFeatured, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsFollowers, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsFollowing, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
+	m = this.helperJSONLDContext(this.VcardHasAddress, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsId, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
@@ -725,6 +924,7 @@ func (this ActivityStreamsOrganization) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsOutbox, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPreferredUsername, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
+	m = this.helperJSONLDContext(this.SecurityV1PublicKey, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
@@ -732,7 +932,9 @@ func (this ActivityStreamsOrganization) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsStreams, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
+	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
+	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsType, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
@@ -814,6 +1016,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "bday"
+	if lhs, rhs := this.VcardBday, o.GetVcardBday(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "bto"
 	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -870,6 +1086,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "discoverable"
+	if lhs, rhs := this.TootDiscoverable, o.GetTootDiscoverable(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "duration"
 	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -898,6 +1128,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "featured"
+	if lhs, rhs := this.TootFeatured, o.GetTootFeatured(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "followers"
 	if lhs, rhs := this.ActivityStreamsFollowers, o.GetActivityStreamsFollowers(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -940,6 +1184,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "hasAddress"
+	if lhs, rhs := this.VcardHasAddress, o.GetVcardHasAddress(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "icon"
 	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1136,6 +1394,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "publicKey"
+	if lhs, rhs := this.SecurityV1PublicKey, o.GetSecurityV1PublicKey(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "published"
 	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1234,6 +1506,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "ticketsTrackedBy"
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1248,6 +1534,20 @@ func (this ActivityStreamsOrganization) LessThan(o vocab.ActivityStreamsOrganiza
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "tracksTicketsFor"
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "type"
 	if lhs, rhs := this.ActivityStreamsType, o.GetActivityStreamsType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1353,6 +1653,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsBcc.Name()] = i
 		}
 	}
+	// Maybe serialize property "bday"
+	if this.VcardBday != nil {
+		if i, err := this.VcardBday.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.VcardBday.Name()] = i
+		}
+	}
 	// Maybe serialize property "bto"
 	if this.ActivityStreamsBto != nil {
 		if i, err := this.ActivityStreamsBto.Serialize(); err != nil {
@@ -1385,6 +1693,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsContext.Name()] = i
 		}
 	}
+	// Maybe serialize property "discoverable"
+	if this.TootDiscoverable != nil {
+		if i, err := this.TootDiscoverable.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootDiscoverable.Name()] = i
+		}
+	}
 	// Maybe serialize property "duration"
 	if this.ActivityStreamsDuration != nil {
 		if i, err := this.ActivityStreamsDuration.Serialize(); err != nil {
@@ -1401,6 +1717,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsEndTime.Name()] = i
 		}
 	}
+	// Maybe serialize property "featured"
+	if this.TootFeatured != nil {
+		if i, err := this.TootFeatured.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootFeatured.Name()] = i
+		}
+	}
 	// Maybe serialize property "followers"
 	if this.ActivityStreamsFollowers != nil {
 		if i, err := this.ActivityStreamsFollowers.Serialize(); err != nil {
@@ -1425,6 +1749,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsGenerator.Name()] = i
 		}
 	}
+	// Maybe serialize property "hasAddress"
+	if this.VcardHasAddress != nil {
+		if i, err := this.VcardHasAddress.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.VcardHasAddress.Name()] = i
+		}
+	}
 	// Maybe serialize property "icon"
 	if this.ActivityStreamsIcon != nil {
 		if i, err := this.ActivityStreamsIcon.Serialize(); err != nil {
@@ -1537,6 +1869,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsPreview.Name()] = i
 		}
 	}
+	// Maybe serialize property "publicKey"
+	if this.SecurityV1PublicKey != nil {
+		if i, err := this.SecurityV1PublicKey.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.SecurityV1PublicKey.Name()] = i
+		}
+	}
 	// Maybe serialize property "published"
 	if this.ActivityStreamsPublished != nil {
 		if i, err := this.ActivityStreamsPublished.Serialize(); err != nil {
@@ -1593,6 +1933,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsTag.Name()] = i
 		}
 	}
+	// Maybe serialize property "ticketsTrackedBy"
+	if this.ForgeFedTicketsTrackedBy != nil {
+		if i, err := this.ForgeFedTicketsTrackedBy.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTicketsTrackedBy.Name()] = i
+		}
+	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
 		if i, err := this.ActivityStreamsTo.Serialize(); err != nil {
@@ -1601,6 +1949,14 @@ func (this ActivityStreamsOrganization) Serialize() (map[string]interface{}, err
 			m[this.ActivityStreamsTo.Name()] = i
 		}
 	}
+	// Maybe serialize property "tracksTicketsFor"
+	if this.ForgeFedTracksTicketsFor != nil {
+		if i, err := this.ForgeFedTracksTicketsFor.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTracksTicketsFor.Name()] = i
+		}
+	}
 	// Maybe serialize property "type"
 	if this.ActivityStreamsType != nil {
 		if i, err := this.ActivityStreamsType.Serialize(); err != nil {
@@ -1834,6 +2190,41 @@ func (this *ActivityStreamsOrganization) SetActivityStreamsUrl(i vocab.ActivityS
 	this.ActivityStreamsUrl = i
 }
 
+// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+func (this *ActivityStreamsOrganization) SetForgeFedTicketsTrackedBy(i vocab.ForgeFedTicketsTrackedByProperty) {
+	this.ForgeFedTicketsTrackedBy = i
+}
+
+// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+func (this *ActivityStreamsOrganization) SetForgeFedTracksTicketsFor(i vocab.ForgeFedTracksTicketsForProperty) {
+	this.ForgeFedTracksTicketsFor = i
+}
+
+// SetSecurityV1PublicKey sets the "publicKey" property.
+func (this *ActivityStreamsOrganization) SetSecurityV1PublicKey(i vocab.SecurityV1PublicKeyProperty) {
+	this.SecurityV1PublicKey = i
+}
+
+// SetTootDiscoverable sets the "discoverable" property.
+func (this *ActivityStreamsOrganization) SetTootDiscoverable(i vocab.TootDiscoverableProperty) {
+	this.TootDiscoverable = i
+}
+
+// SetTootFeatured sets the "featured" property.
+func (this *ActivityStreamsOrganization) SetTootFeatured(i vocab.TootFeaturedProperty) {
+	this.TootFeatured = i
+}
+
+// SetVcardBday sets the "bday" property.
+func (this *ActivityStreamsOrganization) SetVcardBday(i vocab.VcardBdayProperty) {
+	this.VcardBday = i
+}
+
+// SetVcardHasAddress sets the "hasAddress" property.
+func (this *ActivityStreamsOrganization) SetVcardHasAddress(i vocab.VcardHasAddressProperty) {
+	this.VcardHasAddress = i
+}
+
 // VocabularyURI returns the vocabulary's URI as a string.
 func (this ActivityStreamsOrganization) VocabularyURI() string {
 	return "https://www.w3.org/ns/activitystreams"
@@ -0,0 +1,15 @@
+// Package propertycommitted contains the implementation for the committed
+// property. All applications are strongly encouraged to use the interface
+// instead of this concrete definition. The interfaces allow applications to
+// consume only the types and properties needed and be independent of the
+// go-fed implementation if another alternative implementation is created.
+// This package is code-generated and subject to the same license as the
+// go-fed tool used to generate it.
+//
+// This package is independent of other types' and properties' implementations
+// by having a Manager injected into it to act as a factory for the concrete
+// implementations. The implementations have been generated into their own
+// separate subpackages for each vocabulary.
+//
+// Strongly consider using the interfaces instead of this package.
+package propertycommitted
@@ -0,0 +1,3026 @@
+package propertydescription
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// ForgeFedDescriptionProperty is the functional property "description". It is
+// permitted to be one of multiple value types. At most, one type of value can
+// be present, or none at all. Setting a value will clear the other types of
+// values so that only one of the 'Is' methods will return true. It is
+// possible to clear all values, so that this property is empty.
+type ForgeFedDescriptionProperty struct {
+	activitystreamsObjectMember                vocab.ActivityStreamsObject
+	activitystreamsAcceptMember                vocab.ActivityStreamsAccept
+	activitystreamsActivityMember              vocab.ActivityStreamsActivity
+	activitystreamsAddMember                   vocab.ActivityStreamsAdd
+	vcardAddressMember                         vocab.VcardAddress
+	activitystreamsAnnounceMember              vocab.ActivityStreamsAnnounce
+	activitystreamsApplicationMember           vocab.ActivityStreamsApplication
+	activitystreamsArriveMember                vocab.ActivityStreamsArrive
+	activitystreamsArticleMember               vocab.ActivityStreamsArticle
+	activitystreamsAudioMember                 vocab.ActivityStreamsAudio
+	activitystreamsBlockMember                 vocab.ActivityStreamsBlock
+	forgefedBranchMember                       vocab.ForgeFedBranch
+	activitystreamsCollectionMember            vocab.ActivityStreamsCollection
+	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
+	forgefedCommitMember                       vocab.ForgeFedCommit
+	activitystreamsCreateMember                vocab.ActivityStreamsCreate
+	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
+	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
+	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	tootEmojiMember                            vocab.TootEmoji
+	activitystreamsEventMember                 vocab.ActivityStreamsEvent
+	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
+	activitystreamsFollowMember                vocab.ActivityStreamsFollow
+	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	vcardHomeMember                            vocab.VcardHome
+	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
+	activitystreamsImageMember                 vocab.ActivityStreamsImage
+	activitystreamsIntransitiveActivityMember  vocab.ActivityStreamsIntransitiveActivity
+	activitystreamsInviteMember                vocab.ActivityStreamsInvite
+	activitystreamsJoinMember                  vocab.ActivityStreamsJoin
+	activitystreamsLeaveMember                 vocab.ActivityStreamsLeave
+	activitystreamsLikeMember                  vocab.ActivityStreamsLike
+	activitystreamsListenMember                vocab.ActivityStreamsListen
+	activitystreamsMoveMember                  vocab.ActivityStreamsMove
+	activitystreamsNoteMember                  vocab.ActivityStreamsNote
+	activitystreamsOfferMember                 vocab.ActivityStreamsOffer
+	activitystreamsOrderedCollectionMember     vocab.ActivityStreamsOrderedCollection
+	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
+	activitystreamsOrganizationMember          vocab.ActivityStreamsOrganization
+	activitystreamsPageMember                  vocab.ActivityStreamsPage
+	activitystreamsPersonMember                vocab.ActivityStreamsPerson
+	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
+	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	securityv1PublicKeyMember                  vocab.SecurityV1PublicKey
+	forgefedPushMember                         vocab.ForgeFedPush
+	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
+	activitystreamsReadMember                  vocab.ActivityStreamsRead
+	activitystreamsRejectMember                vocab.ActivityStreamsReject
+	activitystreamsRelationshipMember          vocab.ActivityStreamsRelationship
+	activitystreamsRemoveMember                vocab.ActivityStreamsRemove
+	forgefedRepositoryMember                   vocab.ForgeFedRepository
+	activitystreamsServiceMember               vocab.ActivityStreamsService
+	activitystreamsTentativeAcceptMember       vocab.ActivityStreamsTentativeAccept
+	activitystreamsTentativeRejectMember       vocab.ActivityStreamsTentativeReject
+	forgefedTicketMember                       vocab.ForgeFedTicket
+	forgefedTicketDependencyMember             vocab.ForgeFedTicketDependency
+	activitystreamsTombstoneMember             vocab.ActivityStreamsTombstone
+	activitystreamsTravelMember                vocab.ActivityStreamsTravel
+	activitystreamsUndoMember                  vocab.ActivityStreamsUndo
+	activitystreamsUpdateMember                vocab.ActivityStreamsUpdate
+	activitystreamsVideoMember                 vocab.ActivityStreamsVideo
+	activitystreamsViewMember                  vocab.ActivityStreamsView
+	unknown                                    interface{}
+	iri                                        *url.URL
+	alias                                      string
+}
+
+// DeserializeDescriptionProperty creates a "description" property from an
+// interface representation that has been unmarshalled from a text or binary
+// format.
+func DeserializeDescriptionProperty(m map[string]interface{}, aliasMap map[string]string) (*ForgeFedDescriptionProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+	}
+	propName := "description"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "description")
+	}
+	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["description"]
+	}
+
+	if ok {
+		if s, ok := i.(string); ok {
+			u, err := url.Parse(s)
+			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+			if err == nil && len(u.Scheme) > 0 {
+				this := &ForgeFedDescriptionProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
+		if m, ok := i.(map[string]interface{}); ok {
+
+			if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsObjectMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsAcceptMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsActivityMember: v,
+					alias:                         alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsAddMember: v,
+					alias:                    alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeAddressVcard()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:              alias,
+					vcardAddressMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsAnnounceMember: v,
+					alias:                         alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsApplicationMember: v,
+					alias:                            alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsArriveMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsArticleMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsAudioMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsBlockMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:                alias,
+					forgefedBranchMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsCollectionMember: v,
+					alias:                           alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsCollectionPageMember: v,
+					alias:                               alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:                alias,
+					forgefedCommitMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsCreateMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsDeleteMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsDislikeMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsDocumentMember: v,
+					alias:                         alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:           alias,
+					tootEmojiMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsEventMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsFlagMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsFollowMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsGroupMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeHomeVcard()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:           alias,
+					vcardHomeMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsIgnoreMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsImageMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsIntransitiveActivityMember: v,
+					alias: alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsInviteMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsJoinMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsLeaveMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsLikeMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsListenMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsMoveMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsNoteMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsOfferMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsOrderedCollectionMember: v,
+					alias:                                  alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsOrderedCollectionPageMember: v,
+					alias: alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsOrganizationMember: v,
+					alias:                             alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsPageMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsPersonMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsPlaceMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsProfileMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializePublicKeySecurityV1()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:                     alias,
+					securityv1PublicKeyMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:              alias,
+					forgefedPushMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsQuestionMember: v,
+					alias:                         alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsReadMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsRejectMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsRelationshipMember: v,
+					alias:                             alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsRemoveMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:                    alias,
+					forgefedRepositoryMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsServiceMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsTentativeAcceptMember: v,
+					alias:                                alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsTentativeRejectMember: v,
+					alias:                                alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:                alias,
+					forgefedTicketMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					alias:                          alias,
+					forgefedTicketDependencyMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsTombstoneMember: v,
+					alias:                          alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsTravelMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsUndoMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsUpdateMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsVideoMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+				this := &ForgeFedDescriptionProperty{
+					activitystreamsViewMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		this := &ForgeFedDescriptionProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewForgeFedDescriptionProperty creates a new description property.
+func NewForgeFedDescriptionProperty() *ForgeFedDescriptionProperty {
+	return &ForgeFedDescriptionProperty{alias: "ff"}
+}
+
+// Clear ensures no value of this property is set. Calling HasAny or any of the
+// 'Is' methods afterwards will return false.
+func (this *ForgeFedDescriptionProperty) Clear() {
+	this.activitystreamsObjectMember = nil
+	this.activitystreamsAcceptMember = nil
+	this.activitystreamsActivityMember = nil
+	this.activitystreamsAddMember = nil
+	this.vcardAddressMember = nil
+	this.activitystreamsAnnounceMember = nil
+	this.activitystreamsApplicationMember = nil
+	this.activitystreamsArriveMember = nil
+	this.activitystreamsArticleMember = nil
+	this.activitystreamsAudioMember = nil
+	this.activitystreamsBlockMember = nil
+	this.forgefedBranchMember = nil
+	this.activitystreamsCollectionMember = nil
+	this.activitystreamsCollectionPageMember = nil
+	this.forgefedCommitMember = nil
+	this.activitystreamsCreateMember = nil
+	this.activitystreamsDeleteMember = nil
+	this.activitystreamsDislikeMember = nil
+	this.activitystreamsDocumentMember = nil
+	this.tootEmojiMember = nil
+	this.activitystreamsEventMember = nil
+	this.activitystreamsFlagMember = nil
+	this.activitystreamsFollowMember = nil
+	this.activitystreamsGroupMember = nil
+	this.vcardHomeMember = nil
+	this.activitystreamsIgnoreMember = nil
+	this.activitystreamsImageMember = nil
+	this.activitystreamsIntransitiveActivityMember = nil
+	this.activitystreamsInviteMember = nil
+	this.activitystreamsJoinMember = nil
+	this.activitystreamsLeaveMember = nil
+	this.activitystreamsLikeMember = nil
+	this.activitystreamsListenMember = nil
+	this.activitystreamsMoveMember = nil
+	this.activitystreamsNoteMember = nil
+	this.activitystreamsOfferMember = nil
+	this.activitystreamsOrderedCollectionMember = nil
+	this.activitystreamsOrderedCollectionPageMember = nil
+	this.activitystreamsOrganizationMember = nil
+	this.activitystreamsPageMember = nil
+	this.activitystreamsPersonMember = nil
+	this.activitystreamsPlaceMember = nil
+	this.activitystreamsProfileMember = nil
+	this.securityv1PublicKeyMember = nil
+	this.forgefedPushMember = nil
+	this.activitystreamsQuestionMember = nil
+	this.activitystreamsReadMember = nil
+	this.activitystreamsRejectMember = nil
+	this.activitystreamsRelationshipMember = nil
+	this.activitystreamsRemoveMember = nil
+	this.forgefedRepositoryMember = nil
+	this.activitystreamsServiceMember = nil
+	this.activitystreamsTentativeAcceptMember = nil
+	this.activitystreamsTentativeRejectMember = nil
+	this.forgefedTicketMember = nil
+	this.forgefedTicketDependencyMember = nil
+	this.activitystreamsTombstoneMember = nil
+	this.activitystreamsTravelMember = nil
+	this.activitystreamsUndoMember = nil
+	this.activitystreamsUpdateMember = nil
+	this.activitystreamsVideoMember = nil
+	this.activitystreamsViewMember = nil
+	this.unknown = nil
+	this.iri = nil
+}
+
+// GetActivityStreamsAccept returns the value of this property. When
+// IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+	return this.activitystreamsAcceptMember
+}
+
+// GetActivityStreamsActivity returns the value of this property. When
+// IsActivityStreamsActivity returns false, GetActivityStreamsActivity will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+	return this.activitystreamsActivityMember
+}
+
+// GetActivityStreamsAdd returns the value of this property. When
+// IsActivityStreamsAdd returns false, GetActivityStreamsAdd will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+	return this.activitystreamsAddMember
+}
+
+// GetActivityStreamsAnnounce returns the value of this property. When
+// IsActivityStreamsAnnounce returns false, GetActivityStreamsAnnounce will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+	return this.activitystreamsAnnounceMember
+}
+
+// GetActivityStreamsApplication returns the value of this property. When
+// IsActivityStreamsApplication returns false, GetActivityStreamsApplication
+// will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+	return this.activitystreamsApplicationMember
+}
+
+// GetActivityStreamsArrive returns the value of this property. When
+// IsActivityStreamsArrive returns false, GetActivityStreamsArrive will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+	return this.activitystreamsArriveMember
+}
+
+// GetActivityStreamsArticle returns the value of this property. When
+// IsActivityStreamsArticle returns false, GetActivityStreamsArticle will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+	return this.activitystreamsArticleMember
+}
+
+// GetActivityStreamsAudio returns the value of this property. When
+// IsActivityStreamsAudio returns false, GetActivityStreamsAudio will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+	return this.activitystreamsAudioMember
+}
+
+// GetActivityStreamsBlock returns the value of this property. When
+// IsActivityStreamsBlock returns false, GetActivityStreamsBlock will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+	return this.activitystreamsBlockMember
+}
+
+// GetActivityStreamsCollection returns the value of this property. When
+// IsActivityStreamsCollection returns false, GetActivityStreamsCollection
+// will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	return this.activitystreamsCollectionMember
+}
+
+// GetActivityStreamsCollectionPage returns the value of this property. When
+// IsActivityStreamsCollectionPage returns false,
+// GetActivityStreamsCollectionPage will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	return this.activitystreamsCollectionPageMember
+}
+
+// GetActivityStreamsCreate returns the value of this property. When
+// IsActivityStreamsCreate returns false, GetActivityStreamsCreate will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+	return this.activitystreamsCreateMember
+}
+
+// GetActivityStreamsDelete returns the value of this property. When
+// IsActivityStreamsDelete returns false, GetActivityStreamsDelete will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+	return this.activitystreamsDeleteMember
+}
+
+// GetActivityStreamsDislike returns the value of this property. When
+// IsActivityStreamsDislike returns false, GetActivityStreamsDislike will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+	return this.activitystreamsDislikeMember
+}
+
+// GetActivityStreamsDocument returns the value of this property. When
+// IsActivityStreamsDocument returns false, GetActivityStreamsDocument will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+	return this.activitystreamsDocumentMember
+}
+
+// GetActivityStreamsEvent returns the value of this property. When
+// IsActivityStreamsEvent returns false, GetActivityStreamsEvent will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+	return this.activitystreamsEventMember
+}
+
+// GetActivityStreamsFlag returns the value of this property. When
+// IsActivityStreamsFlag returns false, GetActivityStreamsFlag will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+	return this.activitystreamsFlagMember
+}
+
+// GetActivityStreamsFollow returns the value of this property. When
+// IsActivityStreamsFollow returns false, GetActivityStreamsFollow will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+	return this.activitystreamsFollowMember
+}
+
+// GetActivityStreamsGroup returns the value of this property. When
+// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+	return this.activitystreamsGroupMember
+}
+
+// GetActivityStreamsIgnore returns the value of this property. When
+// IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+	return this.activitystreamsIgnoreMember
+}
+
+// GetActivityStreamsImage returns the value of this property. When
+// IsActivityStreamsImage returns false, GetActivityStreamsImage will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+	return this.activitystreamsImageMember
+}
+
+// GetActivityStreamsIntransitiveActivity returns the value of this property. When
+// IsActivityStreamsIntransitiveActivity returns false,
+// GetActivityStreamsIntransitiveActivity will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+	return this.activitystreamsIntransitiveActivityMember
+}
+
+// GetActivityStreamsInvite returns the value of this property. When
+// IsActivityStreamsInvite returns false, GetActivityStreamsInvite will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+	return this.activitystreamsInviteMember
+}
+
+// GetActivityStreamsJoin returns the value of this property. When
+// IsActivityStreamsJoin returns false, GetActivityStreamsJoin will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+	return this.activitystreamsJoinMember
+}
+
+// GetActivityStreamsLeave returns the value of this property. When
+// IsActivityStreamsLeave returns false, GetActivityStreamsLeave will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+	return this.activitystreamsLeaveMember
+}
+
+// GetActivityStreamsLike returns the value of this property. When
+// IsActivityStreamsLike returns false, GetActivityStreamsLike will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+	return this.activitystreamsLikeMember
+}
+
+// GetActivityStreamsListen returns the value of this property. When
+// IsActivityStreamsListen returns false, GetActivityStreamsListen will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+	return this.activitystreamsListenMember
+}
+
+// GetActivityStreamsMove returns the value of this property. When
+// IsActivityStreamsMove returns false, GetActivityStreamsMove will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+	return this.activitystreamsMoveMember
+}
+
+// GetActivityStreamsNote returns the value of this property. When
+// IsActivityStreamsNote returns false, GetActivityStreamsNote will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+	return this.activitystreamsNoteMember
+}
+
+// GetActivityStreamsObject returns the value of this property. When
+// IsActivityStreamsObject returns false, GetActivityStreamsObject will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+	return this.activitystreamsObjectMember
+}
+
+// GetActivityStreamsOffer returns the value of this property. When
+// IsActivityStreamsOffer returns false, GetActivityStreamsOffer will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+	return this.activitystreamsOfferMember
+}
+
+// GetActivityStreamsOrderedCollection returns the value of this property. When
+// IsActivityStreamsOrderedCollection returns false,
+// GetActivityStreamsOrderedCollection will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	return this.activitystreamsOrderedCollectionMember
+}
+
+// GetActivityStreamsOrderedCollectionPage returns the value of this property.
+// When IsActivityStreamsOrderedCollectionPage returns false,
+// GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	return this.activitystreamsOrderedCollectionPageMember
+}
+
+// GetActivityStreamsOrganization returns the value of this property. When
+// IsActivityStreamsOrganization returns false, GetActivityStreamsOrganization
+// will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+	return this.activitystreamsOrganizationMember
+}
+
+// GetActivityStreamsPage returns the value of this property. When
+// IsActivityStreamsPage returns false, GetActivityStreamsPage will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+	return this.activitystreamsPageMember
+}
+
+// GetActivityStreamsPerson returns the value of this property. When
+// IsActivityStreamsPerson returns false, GetActivityStreamsPerson will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+	return this.activitystreamsPersonMember
+}
+
+// GetActivityStreamsPlace returns the value of this property. When
+// IsActivityStreamsPlace returns false, GetActivityStreamsPlace will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+	return this.activitystreamsPlaceMember
+}
+
+// GetActivityStreamsProfile returns the value of this property. When
+// IsActivityStreamsProfile returns false, GetActivityStreamsProfile will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+	return this.activitystreamsProfileMember
+}
+
+// GetActivityStreamsQuestion returns the value of this property. When
+// IsActivityStreamsQuestion returns false, GetActivityStreamsQuestion will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+	return this.activitystreamsQuestionMember
+}
+
+// GetActivityStreamsRead returns the value of this property. When
+// IsActivityStreamsRead returns false, GetActivityStreamsRead will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+	return this.activitystreamsReadMember
+}
+
+// GetActivityStreamsReject returns the value of this property. When
+// IsActivityStreamsReject returns false, GetActivityStreamsReject will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+	return this.activitystreamsRejectMember
+}
+
+// GetActivityStreamsRelationship returns the value of this property. When
+// IsActivityStreamsRelationship returns false, GetActivityStreamsRelationship
+// will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+	return this.activitystreamsRelationshipMember
+}
+
+// GetActivityStreamsRemove returns the value of this property. When
+// IsActivityStreamsRemove returns false, GetActivityStreamsRemove will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+	return this.activitystreamsRemoveMember
+}
+
+// GetActivityStreamsService returns the value of this property. When
+// IsActivityStreamsService returns false, GetActivityStreamsService will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsService() vocab.ActivityStreamsService {
+	return this.activitystreamsServiceMember
+}
+
+// GetActivityStreamsTentativeAccept returns the value of this property. When
+// IsActivityStreamsTentativeAccept returns false,
+// GetActivityStreamsTentativeAccept will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+	return this.activitystreamsTentativeAcceptMember
+}
+
+// GetActivityStreamsTentativeReject returns the value of this property. When
+// IsActivityStreamsTentativeReject returns false,
+// GetActivityStreamsTentativeReject will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+	return this.activitystreamsTentativeRejectMember
+}
+
+// GetActivityStreamsTombstone returns the value of this property. When
+// IsActivityStreamsTombstone returns false, GetActivityStreamsTombstone will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+	return this.activitystreamsTombstoneMember
+}
+
+// GetActivityStreamsTravel returns the value of this property. When
+// IsActivityStreamsTravel returns false, GetActivityStreamsTravel will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+	return this.activitystreamsTravelMember
+}
+
+// GetActivityStreamsUndo returns the value of this property. When
+// IsActivityStreamsUndo returns false, GetActivityStreamsUndo will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+	return this.activitystreamsUndoMember
+}
+
+// GetActivityStreamsUpdate returns the value of this property. When
+// IsActivityStreamsUpdate returns false, GetActivityStreamsUpdate will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+	return this.activitystreamsUpdateMember
+}
+
+// GetActivityStreamsVideo returns the value of this property. When
+// IsActivityStreamsVideo returns false, GetActivityStreamsVideo will return
+// an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+	return this.activitystreamsVideoMember
+}
+
+// GetActivityStreamsView returns the value of this property. When
+// IsActivityStreamsView returns false, GetActivityStreamsView will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetActivityStreamsView() vocab.ActivityStreamsView {
+	return this.activitystreamsViewMember
+}
+
+// GetForgeFedBranch returns the value of this property. When IsForgeFedBranch
+// returns false, GetForgeFedBranch will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetForgeFedBranch() vocab.ForgeFedBranch {
+	return this.forgefedBranchMember
+}
+
+// GetForgeFedCommit returns the value of this property. When IsForgeFedCommit
+// returns false, GetForgeFedCommit will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetForgeFedCommit() vocab.ForgeFedCommit {
+	return this.forgefedCommitMember
+}
+
+// GetForgeFedPush returns the value of this property. When IsForgeFedPush returns
+// false, GetForgeFedPush will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetForgeFedPush() vocab.ForgeFedPush {
+	return this.forgefedPushMember
+}
+
+// GetForgeFedRepository returns the value of this property. When
+// IsForgeFedRepository returns false, GetForgeFedRepository will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetForgeFedRepository() vocab.ForgeFedRepository {
+	return this.forgefedRepositoryMember
+}
+
+// GetForgeFedTicket returns the value of this property. When IsForgeFedTicket
+// returns false, GetForgeFedTicket will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetForgeFedTicket() vocab.ForgeFedTicket {
+	return this.forgefedTicketMember
+}
+
+// GetForgeFedTicketDependency returns the value of this property. When
+// IsForgeFedTicketDependency returns false, GetForgeFedTicketDependency will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetForgeFedTicketDependency() vocab.ForgeFedTicketDependency {
+	return this.forgefedTicketDependencyMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetIRI() *url.URL {
+	return this.iri
+}
+
+// GetSecurityV1PublicKey returns the value of this property. When
+// IsSecurityV1PublicKey returns false, GetSecurityV1PublicKey will return an
+// arbitrary value.
+func (this ForgeFedDescriptionProperty) GetSecurityV1PublicKey() vocab.SecurityV1PublicKey {
+	return this.securityv1PublicKeyMember
+}
+
+// GetTootEmoji returns the value of this property. When IsTootEmoji returns
+// false, GetTootEmoji will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetTootEmoji() vocab.TootEmoji {
+	return this.tootEmojiMember
+}
+
+// GetType returns the value in this property as a Type. Returns nil if the value
+// is not an ActivityStreams type, such as an IRI or another value.
+func (this ForgeFedDescriptionProperty) GetType() vocab.Type {
+	if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject()
+	}
+	if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept()
+	}
+	if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity()
+	}
+	if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd()
+	}
+	if this.IsVcardAddress() {
+		return this.GetVcardAddress()
+	}
+	if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce()
+	}
+	if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication()
+	}
+	if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive()
+	}
+	if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle()
+	}
+	if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio()
+	}
+	if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock()
+	}
+	if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch()
+	}
+	if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection()
+	}
+	if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage()
+	}
+	if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit()
+	}
+	if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate()
+	}
+	if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete()
+	}
+	if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike()
+	}
+	if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument()
+	}
+	if this.IsTootEmoji() {
+		return this.GetTootEmoji()
+	}
+	if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent()
+	}
+	if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag()
+	}
+	if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow()
+	}
+	if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup()
+	}
+	if this.IsVcardHome() {
+		return this.GetVcardHome()
+	}
+	if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore()
+	}
+	if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage()
+	}
+	if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity()
+	}
+	if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite()
+	}
+	if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin()
+	}
+	if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave()
+	}
+	if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike()
+	}
+	if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen()
+	}
+	if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove()
+	}
+	if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote()
+	}
+	if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer()
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection()
+	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage()
+	}
+	if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization()
+	}
+	if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage()
+	}
+	if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson()
+	}
+	if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace()
+	}
+	if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile()
+	}
+	if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey()
+	}
+	if this.IsForgeFedPush() {
+		return this.GetForgeFedPush()
+	}
+	if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion()
+	}
+	if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead()
+	}
+	if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject()
+	}
+	if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship()
+	}
+	if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove()
+	}
+	if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository()
+	}
+	if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService()
+	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept()
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject()
+	}
+	if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket()
+	}
+	if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency()
+	}
+	if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone()
+	}
+	if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel()
+	}
+	if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo()
+	}
+	if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate()
+	}
+	if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo()
+	}
+	if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView()
+	}
+
+	return nil
+}
+
+// GetVcardAddress returns the value of this property. When IsVcardAddress returns
+// false, GetVcardAddress will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetVcardAddress() vocab.VcardAddress {
+	return this.vcardAddressMember
+}
+
+// GetVcardHome returns the value of this property. When IsVcardHome returns
+// false, GetVcardHome will return an arbitrary value.
+func (this ForgeFedDescriptionProperty) GetVcardHome() vocab.VcardHome {
+	return this.vcardHomeMember
+}
+
+// HasAny returns true if any of the different values is set.
+func (this ForgeFedDescriptionProperty) HasAny() bool {
+	return this.IsActivityStreamsObject() ||
+		this.IsActivityStreamsAccept() ||
+		this.IsActivityStreamsActivity() ||
+		this.IsActivityStreamsAdd() ||
+		this.IsVcardAddress() ||
+		this.IsActivityStreamsAnnounce() ||
+		this.IsActivityStreamsApplication() ||
+		this.IsActivityStreamsArrive() ||
+		this.IsActivityStreamsArticle() ||
+		this.IsActivityStreamsAudio() ||
+		this.IsActivityStreamsBlock() ||
+		this.IsForgeFedBranch() ||
+		this.IsActivityStreamsCollection() ||
+		this.IsActivityStreamsCollectionPage() ||
+		this.IsForgeFedCommit() ||
+		this.IsActivityStreamsCreate() ||
+		this.IsActivityStreamsDelete() ||
+		this.IsActivityStreamsDislike() ||
+		this.IsActivityStreamsDocument() ||
+		this.IsTootEmoji() ||
+		this.IsActivityStreamsEvent() ||
+		this.IsActivityStreamsFlag() ||
+		this.IsActivityStreamsFollow() ||
+		this.IsActivityStreamsGroup() ||
+		this.IsVcardHome() ||
+		this.IsActivityStreamsIgnore() ||
+		this.IsActivityStreamsImage() ||
+		this.IsActivityStreamsIntransitiveActivity() ||
+		this.IsActivityStreamsInvite() ||
+		this.IsActivityStreamsJoin() ||
+		this.IsActivityStreamsLeave() ||
+		this.IsActivityStreamsLike() ||
+		this.IsActivityStreamsListen() ||
+		this.IsActivityStreamsMove() ||
+		this.IsActivityStreamsNote() ||
+		this.IsActivityStreamsOffer() ||
+		this.IsActivityStreamsOrderedCollection() ||
+		this.IsActivityStreamsOrderedCollectionPage() ||
+		this.IsActivityStreamsOrganization() ||
+		this.IsActivityStreamsPage() ||
+		this.IsActivityStreamsPerson() ||
+		this.IsActivityStreamsPlace() ||
+		this.IsActivityStreamsProfile() ||
+		this.IsSecurityV1PublicKey() ||
+		this.IsForgeFedPush() ||
+		this.IsActivityStreamsQuestion() ||
+		this.IsActivityStreamsRead() ||
+		this.IsActivityStreamsReject() ||
+		this.IsActivityStreamsRelationship() ||
+		this.IsActivityStreamsRemove() ||
+		this.IsForgeFedRepository() ||
+		this.IsActivityStreamsService() ||
+		this.IsActivityStreamsTentativeAccept() ||
+		this.IsActivityStreamsTentativeReject() ||
+		this.IsForgeFedTicket() ||
+		this.IsForgeFedTicketDependency() ||
+		this.IsActivityStreamsTombstone() ||
+		this.IsActivityStreamsTravel() ||
+		this.IsActivityStreamsUndo() ||
+		this.IsActivityStreamsUpdate() ||
+		this.IsActivityStreamsVideo() ||
+		this.IsActivityStreamsView() ||
+		this.iri != nil
+}
+
+// IsActivityStreamsAccept returns true if this property has a type of "Accept".
+// When true, use the GetActivityStreamsAccept and SetActivityStreamsAccept
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsAccept() bool {
+	return this.activitystreamsAcceptMember != nil
+}
+
+// IsActivityStreamsActivity returns true if this property has a type of
+// "Activity". When true, use the GetActivityStreamsActivity and
+// SetActivityStreamsActivity methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsActivity() bool {
+	return this.activitystreamsActivityMember != nil
+}
+
+// IsActivityStreamsAdd returns true if this property has a type of "Add". When
+// true, use the GetActivityStreamsAdd and SetActivityStreamsAdd methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsAdd() bool {
+	return this.activitystreamsAddMember != nil
+}
+
+// IsActivityStreamsAnnounce returns true if this property has a type of
+// "Announce". When true, use the GetActivityStreamsAnnounce and
+// SetActivityStreamsAnnounce methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsAnnounce() bool {
+	return this.activitystreamsAnnounceMember != nil
+}
+
+// IsActivityStreamsApplication returns true if this property has a type of
+// "Application". When true, use the GetActivityStreamsApplication and
+// SetActivityStreamsApplication methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsApplication() bool {
+	return this.activitystreamsApplicationMember != nil
+}
+
+// IsActivityStreamsArrive returns true if this property has a type of "Arrive".
+// When true, use the GetActivityStreamsArrive and SetActivityStreamsArrive
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsArrive() bool {
+	return this.activitystreamsArriveMember != nil
+}
+
+// IsActivityStreamsArticle returns true if this property has a type of "Article".
+// When true, use the GetActivityStreamsArticle and SetActivityStreamsArticle
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsArticle() bool {
+	return this.activitystreamsArticleMember != nil
+}
+
+// IsActivityStreamsAudio returns true if this property has a type of "Audio".
+// When true, use the GetActivityStreamsAudio and SetActivityStreamsAudio
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsAudio() bool {
+	return this.activitystreamsAudioMember != nil
+}
+
+// IsActivityStreamsBlock returns true if this property has a type of "Block".
+// When true, use the GetActivityStreamsBlock and SetActivityStreamsBlock
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsBlock() bool {
+	return this.activitystreamsBlockMember != nil
+}
+
+// IsActivityStreamsCollection returns true if this property has a type of
+// "Collection". When true, use the GetActivityStreamsCollection and
+// SetActivityStreamsCollection methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsCollection() bool {
+	return this.activitystreamsCollectionMember != nil
+}
+
+// IsActivityStreamsCollectionPage returns true if this property has a type of
+// "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
+// SetActivityStreamsCollectionPage methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsCollectionPage() bool {
+	return this.activitystreamsCollectionPageMember != nil
+}
+
+// IsActivityStreamsCreate returns true if this property has a type of "Create".
+// When true, use the GetActivityStreamsCreate and SetActivityStreamsCreate
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsCreate() bool {
+	return this.activitystreamsCreateMember != nil
+}
+
+// IsActivityStreamsDelete returns true if this property has a type of "Delete".
+// When true, use the GetActivityStreamsDelete and SetActivityStreamsDelete
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsDelete() bool {
+	return this.activitystreamsDeleteMember != nil
+}
+
+// IsActivityStreamsDislike returns true if this property has a type of "Dislike".
+// When true, use the GetActivityStreamsDislike and SetActivityStreamsDislike
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsDislike() bool {
+	return this.activitystreamsDislikeMember != nil
+}
+
+// IsActivityStreamsDocument returns true if this property has a type of
+// "Document". When true, use the GetActivityStreamsDocument and
+// SetActivityStreamsDocument methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsDocument() bool {
+	return this.activitystreamsDocumentMember != nil
+}
+
+// IsActivityStreamsEvent returns true if this property has a type of "Event".
+// When true, use the GetActivityStreamsEvent and SetActivityStreamsEvent
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsEvent() bool {
+	return this.activitystreamsEventMember != nil
+}
+
+// IsActivityStreamsFlag returns true if this property has a type of "Flag". When
+// true, use the GetActivityStreamsFlag and SetActivityStreamsFlag methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsFlag() bool {
+	return this.activitystreamsFlagMember != nil
+}
+
+// IsActivityStreamsFollow returns true if this property has a type of "Follow".
+// When true, use the GetActivityStreamsFollow and SetActivityStreamsFollow
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsFollow() bool {
+	return this.activitystreamsFollowMember != nil
+}
+
+// IsActivityStreamsGroup returns true if this property has a type of "Group".
+// When true, use the GetActivityStreamsGroup and SetActivityStreamsGroup
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsGroup() bool {
+	return this.activitystreamsGroupMember != nil
+}
+
+// IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
+// When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsIgnore() bool {
+	return this.activitystreamsIgnoreMember != nil
+}
+
+// IsActivityStreamsImage returns true if this property has a type of "Image".
+// When true, use the GetActivityStreamsImage and SetActivityStreamsImage
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsImage() bool {
+	return this.activitystreamsImageMember != nil
+}
+
+// IsActivityStreamsIntransitiveActivity returns true if this property has a type
+// of "IntransitiveActivity". When true, use the
+// GetActivityStreamsIntransitiveActivity and
+// SetActivityStreamsIntransitiveActivity methods to access and set this
+// property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsIntransitiveActivity() bool {
+	return this.activitystreamsIntransitiveActivityMember != nil
+}
+
+// IsActivityStreamsInvite returns true if this property has a type of "Invite".
+// When true, use the GetActivityStreamsInvite and SetActivityStreamsInvite
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsInvite() bool {
+	return this.activitystreamsInviteMember != nil
+}
+
+// IsActivityStreamsJoin returns true if this property has a type of "Join". When
+// true, use the GetActivityStreamsJoin and SetActivityStreamsJoin methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsJoin() bool {
+	return this.activitystreamsJoinMember != nil
+}
+
+// IsActivityStreamsLeave returns true if this property has a type of "Leave".
+// When true, use the GetActivityStreamsLeave and SetActivityStreamsLeave
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsLeave() bool {
+	return this.activitystreamsLeaveMember != nil
+}
+
+// IsActivityStreamsLike returns true if this property has a type of "Like". When
+// true, use the GetActivityStreamsLike and SetActivityStreamsLike methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsLike() bool {
+	return this.activitystreamsLikeMember != nil
+}
+
+// IsActivityStreamsListen returns true if this property has a type of "Listen".
+// When true, use the GetActivityStreamsListen and SetActivityStreamsListen
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsListen() bool {
+	return this.activitystreamsListenMember != nil
+}
+
+// IsActivityStreamsMove returns true if this property has a type of "Move". When
+// true, use the GetActivityStreamsMove and SetActivityStreamsMove methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsMove() bool {
+	return this.activitystreamsMoveMember != nil
+}
+
+// IsActivityStreamsNote returns true if this property has a type of "Note". When
+// true, use the GetActivityStreamsNote and SetActivityStreamsNote methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsNote() bool {
+	return this.activitystreamsNoteMember != nil
+}
+
+// IsActivityStreamsObject returns true if this property has a type of "Object".
+// When true, use the GetActivityStreamsObject and SetActivityStreamsObject
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsObject() bool {
+	return this.activitystreamsObjectMember != nil
+}
+
+// IsActivityStreamsOffer returns true if this property has a type of "Offer".
+// When true, use the GetActivityStreamsOffer and SetActivityStreamsOffer
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsOffer() bool {
+	return this.activitystreamsOfferMember != nil
+}
+
+// IsActivityStreamsOrderedCollection returns true if this property has a type of
+// "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
+// and SetActivityStreamsOrderedCollection methods to access and set this
+// property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsOrderedCollection() bool {
+	return this.activitystreamsOrderedCollectionMember != nil
+}
+
+// IsActivityStreamsOrderedCollectionPage returns true if this property has a type
+// of "OrderedCollectionPage". When true, use the
+// GetActivityStreamsOrderedCollectionPage and
+// SetActivityStreamsOrderedCollectionPage methods to access and set this
+// property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsOrderedCollectionPage() bool {
+	return this.activitystreamsOrderedCollectionPageMember != nil
+}
+
+// IsActivityStreamsOrganization returns true if this property has a type of
+// "Organization". When true, use the GetActivityStreamsOrganization and
+// SetActivityStreamsOrganization methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsOrganization() bool {
+	return this.activitystreamsOrganizationMember != nil
+}
+
+// IsActivityStreamsPage returns true if this property has a type of "Page". When
+// true, use the GetActivityStreamsPage and SetActivityStreamsPage methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsPage() bool {
+	return this.activitystreamsPageMember != nil
+}
+
+// IsActivityStreamsPerson returns true if this property has a type of "Person".
+// When true, use the GetActivityStreamsPerson and SetActivityStreamsPerson
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsPerson() bool {
+	return this.activitystreamsPersonMember != nil
+}
+
+// IsActivityStreamsPlace returns true if this property has a type of "Place".
+// When true, use the GetActivityStreamsPlace and SetActivityStreamsPlace
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsPlace() bool {
+	return this.activitystreamsPlaceMember != nil
+}
+
+// IsActivityStreamsProfile returns true if this property has a type of "Profile".
+// When true, use the GetActivityStreamsProfile and SetActivityStreamsProfile
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsProfile() bool {
+	return this.activitystreamsProfileMember != nil
+}
+
+// IsActivityStreamsQuestion returns true if this property has a type of
+// "Question". When true, use the GetActivityStreamsQuestion and
+// SetActivityStreamsQuestion methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsQuestion() bool {
+	return this.activitystreamsQuestionMember != nil
+}
+
+// IsActivityStreamsRead returns true if this property has a type of "Read". When
+// true, use the GetActivityStreamsRead and SetActivityStreamsRead methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsRead() bool {
+	return this.activitystreamsReadMember != nil
+}
+
+// IsActivityStreamsReject returns true if this property has a type of "Reject".
+// When true, use the GetActivityStreamsReject and SetActivityStreamsReject
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsReject() bool {
+	return this.activitystreamsRejectMember != nil
+}
+
+// IsActivityStreamsRelationship returns true if this property has a type of
+// "Relationship". When true, use the GetActivityStreamsRelationship and
+// SetActivityStreamsRelationship methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsRelationship() bool {
+	return this.activitystreamsRelationshipMember != nil
+}
+
+// IsActivityStreamsRemove returns true if this property has a type of "Remove".
+// When true, use the GetActivityStreamsRemove and SetActivityStreamsRemove
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsRemove() bool {
+	return this.activitystreamsRemoveMember != nil
+}
+
+// IsActivityStreamsService returns true if this property has a type of "Service".
+// When true, use the GetActivityStreamsService and SetActivityStreamsService
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsService() bool {
+	return this.activitystreamsServiceMember != nil
+}
+
+// IsActivityStreamsTentativeAccept returns true if this property has a type of
+// "TentativeAccept". When true, use the GetActivityStreamsTentativeAccept and
+// SetActivityStreamsTentativeAccept methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsTentativeAccept() bool {
+	return this.activitystreamsTentativeAcceptMember != nil
+}
+
+// IsActivityStreamsTentativeReject returns true if this property has a type of
+// "TentativeReject". When true, use the GetActivityStreamsTentativeReject and
+// SetActivityStreamsTentativeReject methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsTentativeReject() bool {
+	return this.activitystreamsTentativeRejectMember != nil
+}
+
+// IsActivityStreamsTombstone returns true if this property has a type of
+// "Tombstone". When true, use the GetActivityStreamsTombstone and
+// SetActivityStreamsTombstone methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsTombstone() bool {
+	return this.activitystreamsTombstoneMember != nil
+}
+
+// IsActivityStreamsTravel returns true if this property has a type of "Travel".
+// When true, use the GetActivityStreamsTravel and SetActivityStreamsTravel
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsTravel() bool {
+	return this.activitystreamsTravelMember != nil
+}
+
+// IsActivityStreamsUndo returns true if this property has a type of "Undo". When
+// true, use the GetActivityStreamsUndo and SetActivityStreamsUndo methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsUndo() bool {
+	return this.activitystreamsUndoMember != nil
+}
+
+// IsActivityStreamsUpdate returns true if this property has a type of "Update".
+// When true, use the GetActivityStreamsUpdate and SetActivityStreamsUpdate
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsUpdate() bool {
+	return this.activitystreamsUpdateMember != nil
+}
+
+// IsActivityStreamsVideo returns true if this property has a type of "Video".
+// When true, use the GetActivityStreamsVideo and SetActivityStreamsVideo
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsVideo() bool {
+	return this.activitystreamsVideoMember != nil
+}
+
+// IsActivityStreamsView returns true if this property has a type of "View". When
+// true, use the GetActivityStreamsView and SetActivityStreamsView methods to
+// access and set this property.
+func (this ForgeFedDescriptionProperty) IsActivityStreamsView() bool {
+	return this.activitystreamsViewMember != nil
+}
+
+// IsForgeFedBranch returns true if this property has a type of "Branch". When
+// true, use the GetForgeFedBranch and SetForgeFedBranch methods to access and
+// set this property.
+func (this ForgeFedDescriptionProperty) IsForgeFedBranch() bool {
+	return this.forgefedBranchMember != nil
+}
+
+// IsForgeFedCommit returns true if this property has a type of "Commit". When
+// true, use the GetForgeFedCommit and SetForgeFedCommit methods to access and
+// set this property.
+func (this ForgeFedDescriptionProperty) IsForgeFedCommit() bool {
+	return this.forgefedCommitMember != nil
+}
+
+// IsForgeFedPush returns true if this property has a type of "Push". When true,
+// use the GetForgeFedPush and SetForgeFedPush methods to access and set this
+// property.
+func (this ForgeFedDescriptionProperty) IsForgeFedPush() bool {
+	return this.forgefedPushMember != nil
+}
+
+// IsForgeFedRepository returns true if this property has a type of "Repository".
+// When true, use the GetForgeFedRepository and SetForgeFedRepository methods
+// to access and set this property.
+func (this ForgeFedDescriptionProperty) IsForgeFedRepository() bool {
+	return this.forgefedRepositoryMember != nil
+}
+
+// IsForgeFedTicket returns true if this property has a type of "Ticket". When
+// true, use the GetForgeFedTicket and SetForgeFedTicket methods to access and
+// set this property.
+func (this ForgeFedDescriptionProperty) IsForgeFedTicket() bool {
+	return this.forgefedTicketMember != nil
+}
+
+// IsForgeFedTicketDependency returns true if this property has a type of
+// "TicketDependency". When true, use the GetForgeFedTicketDependency and
+// SetForgeFedTicketDependency methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsForgeFedTicketDependency() bool {
+	return this.forgefedTicketDependencyMember != nil
+}
+
+// IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
+// to access and set this property
+func (this ForgeFedDescriptionProperty) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsSecurityV1PublicKey returns true if this property has a type of "PublicKey".
+// When true, use the GetSecurityV1PublicKey and SetSecurityV1PublicKey
+// methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsSecurityV1PublicKey() bool {
+	return this.securityv1PublicKeyMember != nil
+}
+
+// IsTootEmoji returns true if this property has a type of "Emoji". When true, use
+// the GetTootEmoji and SetTootEmoji methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsTootEmoji() bool {
+	return this.tootEmojiMember != nil
+}
+
+// IsVcardAddress returns true if this property has a type of "Address". When
+// true, use the GetVcardAddress and SetVcardAddress methods to access and set
+// this property.
+func (this ForgeFedDescriptionProperty) IsVcardAddress() bool {
+	return this.vcardAddressMember != nil
+}
+
+// IsVcardHome returns true if this property has a type of "Home". When true, use
+// the GetVcardHome and SetVcardHome methods to access and set this property.
+func (this ForgeFedDescriptionProperty) IsVcardHome() bool {
+	return this.vcardHomeMember != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ForgeFedDescriptionProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	var child map[string]string
+	if this.IsActivityStreamsObject() {
+		child = this.GetActivityStreamsObject().JSONLDContext()
+	} else if this.IsActivityStreamsAccept() {
+		child = this.GetActivityStreamsAccept().JSONLDContext()
+	} else if this.IsActivityStreamsActivity() {
+		child = this.GetActivityStreamsActivity().JSONLDContext()
+	} else if this.IsActivityStreamsAdd() {
+		child = this.GetActivityStreamsAdd().JSONLDContext()
+	} else if this.IsVcardAddress() {
+		child = this.GetVcardAddress().JSONLDContext()
+	} else if this.IsActivityStreamsAnnounce() {
+		child = this.GetActivityStreamsAnnounce().JSONLDContext()
+	} else if this.IsActivityStreamsApplication() {
+		child = this.GetActivityStreamsApplication().JSONLDContext()
+	} else if this.IsActivityStreamsArrive() {
+		child = this.GetActivityStreamsArrive().JSONLDContext()
+	} else if this.IsActivityStreamsArticle() {
+		child = this.GetActivityStreamsArticle().JSONLDContext()
+	} else if this.IsActivityStreamsAudio() {
+		child = this.GetActivityStreamsAudio().JSONLDContext()
+	} else if this.IsActivityStreamsBlock() {
+		child = this.GetActivityStreamsBlock().JSONLDContext()
+	} else if this.IsForgeFedBranch() {
+		child = this.GetForgeFedBranch().JSONLDContext()
+	} else if this.IsActivityStreamsCollection() {
+		child = this.GetActivityStreamsCollection().JSONLDContext()
+	} else if this.IsActivityStreamsCollectionPage() {
+		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
+	} else if this.IsForgeFedCommit() {
+		child = this.GetForgeFedCommit().JSONLDContext()
+	} else if this.IsActivityStreamsCreate() {
+		child = this.GetActivityStreamsCreate().JSONLDContext()
+	} else if this.IsActivityStreamsDelete() {
+		child = this.GetActivityStreamsDelete().JSONLDContext()
+	} else if this.IsActivityStreamsDislike() {
+		child = this.GetActivityStreamsDislike().JSONLDContext()
+	} else if this.IsActivityStreamsDocument() {
+		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsTootEmoji() {
+		child = this.GetTootEmoji().JSONLDContext()
+	} else if this.IsActivityStreamsEvent() {
+		child = this.GetActivityStreamsEvent().JSONLDContext()
+	} else if this.IsActivityStreamsFlag() {
+		child = this.GetActivityStreamsFlag().JSONLDContext()
+	} else if this.IsActivityStreamsFollow() {
+		child = this.GetActivityStreamsFollow().JSONLDContext()
+	} else if this.IsActivityStreamsGroup() {
+		child = this.GetActivityStreamsGroup().JSONLDContext()
+	} else if this.IsVcardHome() {
+		child = this.GetVcardHome().JSONLDContext()
+	} else if this.IsActivityStreamsIgnore() {
+		child = this.GetActivityStreamsIgnore().JSONLDContext()
+	} else if this.IsActivityStreamsImage() {
+		child = this.GetActivityStreamsImage().JSONLDContext()
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		child = this.GetActivityStreamsIntransitiveActivity().JSONLDContext()
+	} else if this.IsActivityStreamsInvite() {
+		child = this.GetActivityStreamsInvite().JSONLDContext()
+	} else if this.IsActivityStreamsJoin() {
+		child = this.GetActivityStreamsJoin().JSONLDContext()
+	} else if this.IsActivityStreamsLeave() {
+		child = this.GetActivityStreamsLeave().JSONLDContext()
+	} else if this.IsActivityStreamsLike() {
+		child = this.GetActivityStreamsLike().JSONLDContext()
+	} else if this.IsActivityStreamsListen() {
+		child = this.GetActivityStreamsListen().JSONLDContext()
+	} else if this.IsActivityStreamsMove() {
+		child = this.GetActivityStreamsMove().JSONLDContext()
+	} else if this.IsActivityStreamsNote() {
+		child = this.GetActivityStreamsNote().JSONLDContext()
+	} else if this.IsActivityStreamsOffer() {
+		child = this.GetActivityStreamsOffer().JSONLDContext()
+	} else if this.IsActivityStreamsOrderedCollection() {
+		child = this.GetActivityStreamsOrderedCollection().JSONLDContext()
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		child = this.GetActivityStreamsOrderedCollectionPage().JSONLDContext()
+	} else if this.IsActivityStreamsOrganization() {
+		child = this.GetActivityStreamsOrganization().JSONLDContext()
+	} else if this.IsActivityStreamsPage() {
+		child = this.GetActivityStreamsPage().JSONLDContext()
+	} else if this.IsActivityStreamsPerson() {
+		child = this.GetActivityStreamsPerson().JSONLDContext()
+	} else if this.IsActivityStreamsPlace() {
+		child = this.GetActivityStreamsPlace().JSONLDContext()
+	} else if this.IsActivityStreamsProfile() {
+		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsSecurityV1PublicKey() {
+		child = this.GetSecurityV1PublicKey().JSONLDContext()
+	} else if this.IsForgeFedPush() {
+		child = this.GetForgeFedPush().JSONLDContext()
+	} else if this.IsActivityStreamsQuestion() {
+		child = this.GetActivityStreamsQuestion().JSONLDContext()
+	} else if this.IsActivityStreamsRead() {
+		child = this.GetActivityStreamsRead().JSONLDContext()
+	} else if this.IsActivityStreamsReject() {
+		child = this.GetActivityStreamsReject().JSONLDContext()
+	} else if this.IsActivityStreamsRelationship() {
+		child = this.GetActivityStreamsRelationship().JSONLDContext()
+	} else if this.IsActivityStreamsRemove() {
+		child = this.GetActivityStreamsRemove().JSONLDContext()
+	} else if this.IsForgeFedRepository() {
+		child = this.GetForgeFedRepository().JSONLDContext()
+	} else if this.IsActivityStreamsService() {
+		child = this.GetActivityStreamsService().JSONLDContext()
+	} else if this.IsActivityStreamsTentativeAccept() {
+		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
+	} else if this.IsActivityStreamsTentativeReject() {
+		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
+	} else if this.IsForgeFedTicket() {
+		child = this.GetForgeFedTicket().JSONLDContext()
+	} else if this.IsForgeFedTicketDependency() {
+		child = this.GetForgeFedTicketDependency().JSONLDContext()
+	} else if this.IsActivityStreamsTombstone() {
+		child = this.GetActivityStreamsTombstone().JSONLDContext()
+	} else if this.IsActivityStreamsTravel() {
+		child = this.GetActivityStreamsTravel().JSONLDContext()
+	} else if this.IsActivityStreamsUndo() {
+		child = this.GetActivityStreamsUndo().JSONLDContext()
+	} else if this.IsActivityStreamsUpdate() {
+		child = this.GetActivityStreamsUpdate().JSONLDContext()
+	} else if this.IsActivityStreamsVideo() {
+		child = this.GetActivityStreamsVideo().JSONLDContext()
+	} else if this.IsActivityStreamsView() {
+		child = this.GetActivityStreamsView().JSONLDContext()
+	}
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this ForgeFedDescriptionProperty) KindIndex() int {
+	if this.IsActivityStreamsObject() {
+		return 0
+	}
+	if this.IsActivityStreamsAccept() {
+		return 1
+	}
+	if this.IsActivityStreamsActivity() {
+		return 2
+	}
+	if this.IsActivityStreamsAdd() {
+		return 3
+	}
+	if this.IsVcardAddress() {
+		return 4
+	}
+	if this.IsActivityStreamsAnnounce() {
+		return 5
+	}
+	if this.IsActivityStreamsApplication() {
+		return 6
+	}
+	if this.IsActivityStreamsArrive() {
+		return 7
+	}
+	if this.IsActivityStreamsArticle() {
+		return 8
+	}
+	if this.IsActivityStreamsAudio() {
+		return 9
+	}
+	if this.IsActivityStreamsBlock() {
+		return 10
+	}
+	if this.IsForgeFedBranch() {
+		return 11
+	}
+	if this.IsActivityStreamsCollection() {
+		return 12
+	}
+	if this.IsActivityStreamsCollectionPage() {
+		return 13
+	}
+	if this.IsForgeFedCommit() {
+		return 14
+	}
+	if this.IsActivityStreamsCreate() {
+		return 15
+	}
+	if this.IsActivityStreamsDelete() {
+		return 16
+	}
+	if this.IsActivityStreamsDislike() {
+		return 17
+	}
+	if this.IsActivityStreamsDocument() {
+		return 18
+	}
+	if this.IsTootEmoji() {
+		return 19
+	}
+	if this.IsActivityStreamsEvent() {
+		return 20
+	}
+	if this.IsActivityStreamsFlag() {
+		return 21
+	}
+	if this.IsActivityStreamsFollow() {
+		return 22
+	}
+	if this.IsActivityStreamsGroup() {
+		return 23
+	}
+	if this.IsVcardHome() {
+		return 24
+	}
+	if this.IsActivityStreamsIgnore() {
+		return 25
+	}
+	if this.IsActivityStreamsImage() {
+		return 26
+	}
+	if this.IsActivityStreamsIntransitiveActivity() {
+		return 27
+	}
+	if this.IsActivityStreamsInvite() {
+		return 28
+	}
+	if this.IsActivityStreamsJoin() {
+		return 29
+	}
+	if this.IsActivityStreamsLeave() {
+		return 30
+	}
+	if this.IsActivityStreamsLike() {
+		return 31
+	}
+	if this.IsActivityStreamsListen() {
+		return 32
+	}
+	if this.IsActivityStreamsMove() {
+		return 33
+	}
+	if this.IsActivityStreamsNote() {
+		return 34
+	}
+	if this.IsActivityStreamsOffer() {
+		return 35
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return 36
+	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return 37
+	}
+	if this.IsActivityStreamsOrganization() {
+		return 38
+	}
+	if this.IsActivityStreamsPage() {
+		return 39
+	}
+	if this.IsActivityStreamsPerson() {
+		return 40
+	}
+	if this.IsActivityStreamsPlace() {
+		return 41
+	}
+	if this.IsActivityStreamsProfile() {
+		return 42
+	}
+	if this.IsSecurityV1PublicKey() {
+		return 43
+	}
+	if this.IsForgeFedPush() {
+		return 44
+	}
+	if this.IsActivityStreamsQuestion() {
+		return 45
+	}
+	if this.IsActivityStreamsRead() {
+		return 46
+	}
+	if this.IsActivityStreamsReject() {
+		return 47
+	}
+	if this.IsActivityStreamsRelationship() {
+		return 48
+	}
+	if this.IsActivityStreamsRemove() {
+		return 49
+	}
+	if this.IsForgeFedRepository() {
+		return 50
+	}
+	if this.IsActivityStreamsService() {
+		return 51
+	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return 52
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return 53
+	}
+	if this.IsForgeFedTicket() {
+		return 54
+	}
+	if this.IsForgeFedTicketDependency() {
+		return 55
+	}
+	if this.IsActivityStreamsTombstone() {
+		return 56
+	}
+	if this.IsActivityStreamsTravel() {
+		return 57
+	}
+	if this.IsActivityStreamsUndo() {
+		return 58
+	}
+	if this.IsActivityStreamsUpdate() {
+		return 59
+	}
+	if this.IsActivityStreamsVideo() {
+		return 60
+	}
+	if this.IsActivityStreamsView() {
+		return 61
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ForgeFedDescriptionProperty) LessThan(o vocab.ForgeFedDescriptionProperty) bool {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return true
+	} else if idx1 > idx2 {
+		return false
+	} else if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().LessThan(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().LessThan(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().LessThan(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().LessThan(o.GetActivityStreamsAdd())
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().LessThan(o.GetVcardAddress())
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().LessThan(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().LessThan(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().LessThan(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().LessThan(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().LessThan(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().LessThan(o.GetActivityStreamsBlock())
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().LessThan(o.GetForgeFedBranch())
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().LessThan(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().LessThan(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().LessThan(o.GetVcardHome())
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().LessThan(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().LessThan(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().LessThan(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().LessThan(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().LessThan(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().LessThan(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().LessThan(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().LessThan(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().LessThan(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().LessThan(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().LessThan(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().LessThan(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().LessThan(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().LessThan(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().LessThan(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey().LessThan(o.GetSecurityV1PublicKey())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().LessThan(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().LessThan(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().LessThan(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().LessThan(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().LessThan(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().LessThan(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().LessThan(o.GetActivityStreamsView())
+	} else if this.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	}
+	return false
+}
+
+// Name returns the name of this property: "description", prefixed by the alias of
+// its vocabulary if it has one.
+func (this ForgeFedDescriptionProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":description"
+	} else {
+		return "description"
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ForgeFedDescriptionProperty) Serialize() (interface{}, error) {
+	if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Serialize()
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Serialize()
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Serialize()
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Serialize()
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().Serialize()
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Serialize()
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Serialize()
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Serialize()
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Serialize()
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Serialize()
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Serialize()
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().Serialize()
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Serialize()
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Serialize()
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Serialize()
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Serialize()
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Serialize()
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Serialize()
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Serialize()
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Serialize()
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Serialize()
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Serialize()
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().Serialize()
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Serialize()
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Serialize()
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Serialize()
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Serialize()
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Serialize()
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Serialize()
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Serialize()
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Serialize()
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Serialize()
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Serialize()
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Serialize()
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Serialize()
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Serialize()
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Serialize()
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Serialize()
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Serialize()
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Serialize()
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey().Serialize()
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Serialize()
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Serialize()
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Serialize()
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Serialize()
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Serialize()
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Serialize()
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Serialize()
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Serialize()
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Serialize()
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Serialize()
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Serialize()
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Serialize()
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Serialize()
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Serialize()
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Serialize()
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Serialize()
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Serialize()
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// SetActivityStreamsAccept sets the value of this property. Calling
+// IsActivityStreamsAccept afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
+	this.Clear()
+	this.activitystreamsAcceptMember = v
+}
+
+// SetActivityStreamsActivity sets the value of this property. Calling
+// IsActivityStreamsActivity afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
+	this.Clear()
+	this.activitystreamsActivityMember = v
+}
+
+// SetActivityStreamsAdd sets the value of this property. Calling
+// IsActivityStreamsAdd afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
+	this.Clear()
+	this.activitystreamsAddMember = v
+}
+
+// SetActivityStreamsAnnounce sets the value of this property. Calling
+// IsActivityStreamsAnnounce afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
+	this.Clear()
+	this.activitystreamsAnnounceMember = v
+}
+
+// SetActivityStreamsApplication sets the value of this property. Calling
+// IsActivityStreamsApplication afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.Clear()
+	this.activitystreamsApplicationMember = v
+}
+
+// SetActivityStreamsArrive sets the value of this property. Calling
+// IsActivityStreamsArrive afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
+	this.Clear()
+	this.activitystreamsArriveMember = v
+}
+
+// SetActivityStreamsArticle sets the value of this property. Calling
+// IsActivityStreamsArticle afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
+	this.Clear()
+	this.activitystreamsArticleMember = v
+}
+
+// SetActivityStreamsAudio sets the value of this property. Calling
+// IsActivityStreamsAudio afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
+	this.Clear()
+	this.activitystreamsAudioMember = v
+}
+
+// SetActivityStreamsBlock sets the value of this property. Calling
+// IsActivityStreamsBlock afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
+	this.Clear()
+	this.activitystreamsBlockMember = v
+}
+
+// SetActivityStreamsCollection sets the value of this property. Calling
+// IsActivityStreamsCollection afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
+	this.Clear()
+	this.activitystreamsCollectionMember = v
+}
+
+// SetActivityStreamsCollectionPage sets the value of this property. Calling
+// IsActivityStreamsCollectionPage afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
+	this.Clear()
+	this.activitystreamsCollectionPageMember = v
+}
+
+// SetActivityStreamsCreate sets the value of this property. Calling
+// IsActivityStreamsCreate afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
+	this.Clear()
+	this.activitystreamsCreateMember = v
+}
+
+// SetActivityStreamsDelete sets the value of this property. Calling
+// IsActivityStreamsDelete afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
+	this.Clear()
+	this.activitystreamsDeleteMember = v
+}
+
+// SetActivityStreamsDislike sets the value of this property. Calling
+// IsActivityStreamsDislike afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
+	this.Clear()
+	this.activitystreamsDislikeMember = v
+}
+
+// SetActivityStreamsDocument sets the value of this property. Calling
+// IsActivityStreamsDocument afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
+	this.Clear()
+	this.activitystreamsDocumentMember = v
+}
+
+// SetActivityStreamsEvent sets the value of this property. Calling
+// IsActivityStreamsEvent afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
+	this.Clear()
+	this.activitystreamsEventMember = v
+}
+
+// SetActivityStreamsFlag sets the value of this property. Calling
+// IsActivityStreamsFlag afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
+	this.Clear()
+	this.activitystreamsFlagMember = v
+}
+
+// SetActivityStreamsFollow sets the value of this property. Calling
+// IsActivityStreamsFollow afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
+	this.Clear()
+	this.activitystreamsFollowMember = v
+}
+
+// SetActivityStreamsGroup sets the value of this property. Calling
+// IsActivityStreamsGroup afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.Clear()
+	this.activitystreamsGroupMember = v
+}
+
+// SetActivityStreamsIgnore sets the value of this property. Calling
+// IsActivityStreamsIgnore afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
+	this.Clear()
+	this.activitystreamsIgnoreMember = v
+}
+
+// SetActivityStreamsImage sets the value of this property. Calling
+// IsActivityStreamsImage afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsImage(v vocab.ActivityStreamsImage) {
+	this.Clear()
+	this.activitystreamsImageMember = v
+}
+
+// SetActivityStreamsIntransitiveActivity sets the value of this property. Calling
+// IsActivityStreamsIntransitiveActivity afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
+	this.Clear()
+	this.activitystreamsIntransitiveActivityMember = v
+}
+
+// SetActivityStreamsInvite sets the value of this property. Calling
+// IsActivityStreamsInvite afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
+	this.Clear()
+	this.activitystreamsInviteMember = v
+}
+
+// SetActivityStreamsJoin sets the value of this property. Calling
+// IsActivityStreamsJoin afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
+	this.Clear()
+	this.activitystreamsJoinMember = v
+}
+
+// SetActivityStreamsLeave sets the value of this property. Calling
+// IsActivityStreamsLeave afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
+	this.Clear()
+	this.activitystreamsLeaveMember = v
+}
+
+// SetActivityStreamsLike sets the value of this property. Calling
+// IsActivityStreamsLike afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsLike(v vocab.ActivityStreamsLike) {
+	this.Clear()
+	this.activitystreamsLikeMember = v
+}
+
+// SetActivityStreamsListen sets the value of this property. Calling
+// IsActivityStreamsListen afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsListen(v vocab.ActivityStreamsListen) {
+	this.Clear()
+	this.activitystreamsListenMember = v
+}
+
+// SetActivityStreamsMove sets the value of this property. Calling
+// IsActivityStreamsMove afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsMove(v vocab.ActivityStreamsMove) {
+	this.Clear()
+	this.activitystreamsMoveMember = v
+}
+
+// SetActivityStreamsNote sets the value of this property. Calling
+// IsActivityStreamsNote afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsNote(v vocab.ActivityStreamsNote) {
+	this.Clear()
+	this.activitystreamsNoteMember = v
+}
+
+// SetActivityStreamsObject sets the value of this property. Calling
+// IsActivityStreamsObject afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsObject(v vocab.ActivityStreamsObject) {
+	this.Clear()
+	this.activitystreamsObjectMember = v
+}
+
+// SetActivityStreamsOffer sets the value of this property. Calling
+// IsActivityStreamsOffer afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
+	this.Clear()
+	this.activitystreamsOfferMember = v
+}
+
+// SetActivityStreamsOrderedCollection sets the value of this property. Calling
+// IsActivityStreamsOrderedCollection afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
+	this.Clear()
+	this.activitystreamsOrderedCollectionMember = v
+}
+
+// SetActivityStreamsOrderedCollectionPage sets the value of this property.
+// Calling IsActivityStreamsOrderedCollectionPage afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
+	this.Clear()
+	this.activitystreamsOrderedCollectionPageMember = v
+}
+
+// SetActivityStreamsOrganization sets the value of this property. Calling
+// IsActivityStreamsOrganization afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.Clear()
+	this.activitystreamsOrganizationMember = v
+}
+
+// SetActivityStreamsPage sets the value of this property. Calling
+// IsActivityStreamsPage afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsPage(v vocab.ActivityStreamsPage) {
+	this.Clear()
+	this.activitystreamsPageMember = v
+}
+
+// SetActivityStreamsPerson sets the value of this property. Calling
+// IsActivityStreamsPerson afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.Clear()
+	this.activitystreamsPersonMember = v
+}
+
+// SetActivityStreamsPlace sets the value of this property. Calling
+// IsActivityStreamsPlace afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
+	this.Clear()
+	this.activitystreamsPlaceMember = v
+}
+
+// SetActivityStreamsProfile sets the value of this property. Calling
+// IsActivityStreamsProfile afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
+	this.Clear()
+	this.activitystreamsProfileMember = v
+}
+
+// SetActivityStreamsQuestion sets the value of this property. Calling
+// IsActivityStreamsQuestion afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
+	this.Clear()
+	this.activitystreamsQuestionMember = v
+}
+
+// SetActivityStreamsRead sets the value of this property. Calling
+// IsActivityStreamsRead afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsRead(v vocab.ActivityStreamsRead) {
+	this.Clear()
+	this.activitystreamsReadMember = v
+}
+
+// SetActivityStreamsReject sets the value of this property. Calling
+// IsActivityStreamsReject afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsReject(v vocab.ActivityStreamsReject) {
+	this.Clear()
+	this.activitystreamsRejectMember = v
+}
+
+// SetActivityStreamsRelationship sets the value of this property. Calling
+// IsActivityStreamsRelationship afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
+	this.Clear()
+	this.activitystreamsRelationshipMember = v
+}
+
+// SetActivityStreamsRemove sets the value of this property. Calling
+// IsActivityStreamsRemove afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
+	this.Clear()
+	this.activitystreamsRemoveMember = v
+}
+
+// SetActivityStreamsService sets the value of this property. Calling
+// IsActivityStreamsService afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.Clear()
+	this.activitystreamsServiceMember = v
+}
+
+// SetActivityStreamsTentativeAccept sets the value of this property. Calling
+// IsActivityStreamsTentativeAccept afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
+	this.Clear()
+	this.activitystreamsTentativeAcceptMember = v
+}
+
+// SetActivityStreamsTentativeReject sets the value of this property. Calling
+// IsActivityStreamsTentativeReject afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
+	this.Clear()
+	this.activitystreamsTentativeRejectMember = v
+}
+
+// SetActivityStreamsTombstone sets the value of this property. Calling
+// IsActivityStreamsTombstone afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
+	this.Clear()
+	this.activitystreamsTombstoneMember = v
+}
+
+// SetActivityStreamsTravel sets the value of this property. Calling
+// IsActivityStreamsTravel afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
+	this.Clear()
+	this.activitystreamsTravelMember = v
+}
+
+// SetActivityStreamsUndo sets the value of this property. Calling
+// IsActivityStreamsUndo afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
+	this.Clear()
+	this.activitystreamsUndoMember = v
+}
+
+// SetActivityStreamsUpdate sets the value of this property. Calling
+// IsActivityStreamsUpdate afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
+	this.Clear()
+	this.activitystreamsUpdateMember = v
+}
+
+// SetActivityStreamsVideo sets the value of this property. Calling
+// IsActivityStreamsVideo afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
+	this.Clear()
+	this.activitystreamsVideoMember = v
+}
+
+// SetActivityStreamsView sets the value of this property. Calling
+// IsActivityStreamsView afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetActivityStreamsView(v vocab.ActivityStreamsView) {
+	this.Clear()
+	this.activitystreamsViewMember = v
+}
+
+// SetForgeFedBranch sets the value of this property. Calling IsForgeFedBranch
+// afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetForgeFedBranch(v vocab.ForgeFedBranch) {
+	this.Clear()
+	this.forgefedBranchMember = v
+}
+
+// SetForgeFedCommit sets the value of this property. Calling IsForgeFedCommit
+// afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.Clear()
+	this.forgefedCommitMember = v
+}
+
+// SetForgeFedPush sets the value of this property. Calling IsForgeFedPush
+// afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetForgeFedPush(v vocab.ForgeFedPush) {
+	this.Clear()
+	this.forgefedPushMember = v
+}
+
+// SetForgeFedRepository sets the value of this property. Calling
+// IsForgeFedRepository afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.Clear()
+	this.forgefedRepositoryMember = v
+}
+
+// SetForgeFedTicket sets the value of this property. Calling IsForgeFedTicket
+// afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.Clear()
+	this.forgefedTicketMember = v
+}
+
+// SetForgeFedTicketDependency sets the value of this property. Calling
+// IsForgeFedTicketDependency afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.Clear()
+	this.forgefedTicketDependencyMember = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.iri = v
+}
+
+// SetSecurityV1PublicKey sets the value of this property. Calling
+// IsSecurityV1PublicKey afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.Clear()
+	this.securityv1PublicKeyMember = v
+}
+
+// SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
+// returns true.
+func (this *ForgeFedDescriptionProperty) SetTootEmoji(v vocab.TootEmoji) {
+	this.Clear()
+	this.tootEmojiMember = v
+}
+
+// SetType attempts to set the property for the arbitrary type. Returns an error
+// if it is not a valid type to set on this property.
+func (this *ForgeFedDescriptionProperty) SetType(t vocab.Type) error {
+	if v, ok := t.(vocab.ActivityStreamsObject); ok {
+		this.SetActivityStreamsObject(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAccept); ok {
+		this.SetActivityStreamsAccept(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsActivity); ok {
+		this.SetActivityStreamsActivity(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAdd); ok {
+		this.SetActivityStreamsAdd(v)
+		return nil
+	}
+	if v, ok := t.(vocab.VcardAddress); ok {
+		this.SetVcardAddress(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAnnounce); ok {
+		this.SetActivityStreamsAnnounce(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsApplication); ok {
+		this.SetActivityStreamsApplication(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsArrive); ok {
+		this.SetActivityStreamsArrive(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsArticle); ok {
+		this.SetActivityStreamsArticle(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAudio); ok {
+		this.SetActivityStreamsAudio(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsBlock); ok {
+		this.SetActivityStreamsBlock(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedBranch); ok {
+		this.SetForgeFedBranch(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsCollection); ok {
+		this.SetActivityStreamsCollection(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsCollectionPage); ok {
+		this.SetActivityStreamsCollectionPage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedCommit); ok {
+		this.SetForgeFedCommit(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsCreate); ok {
+		this.SetActivityStreamsCreate(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsDelete); ok {
+		this.SetActivityStreamsDelete(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsDislike); ok {
+		this.SetActivityStreamsDislike(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsDocument); ok {
+		this.SetActivityStreamsDocument(v)
+		return nil
+	}
+	if v, ok := t.(vocab.TootEmoji); ok {
+		this.SetTootEmoji(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
+		this.SetActivityStreamsEvent(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsFlag); ok {
+		this.SetActivityStreamsFlag(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsFollow); ok {
+		this.SetActivityStreamsFollow(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsGroup); ok {
+		this.SetActivityStreamsGroup(v)
+		return nil
+	}
+	if v, ok := t.(vocab.VcardHome); ok {
+		this.SetVcardHome(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsIgnore); ok {
+		this.SetActivityStreamsIgnore(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsImage); ok {
+		this.SetActivityStreamsImage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsIntransitiveActivity); ok {
+		this.SetActivityStreamsIntransitiveActivity(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsInvite); ok {
+		this.SetActivityStreamsInvite(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsJoin); ok {
+		this.SetActivityStreamsJoin(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsLeave); ok {
+		this.SetActivityStreamsLeave(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsLike); ok {
+		this.SetActivityStreamsLike(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsListen); ok {
+		this.SetActivityStreamsListen(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsMove); ok {
+		this.SetActivityStreamsMove(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsNote); ok {
+		this.SetActivityStreamsNote(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOffer); ok {
+		this.SetActivityStreamsOffer(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrderedCollection); ok {
+		this.SetActivityStreamsOrderedCollection(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrderedCollectionPage); ok {
+		this.SetActivityStreamsOrderedCollectionPage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrganization); ok {
+		this.SetActivityStreamsOrganization(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPage); ok {
+		this.SetActivityStreamsPage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPerson); ok {
+		this.SetActivityStreamsPerson(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPlace); ok {
+		this.SetActivityStreamsPlace(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsProfile); ok {
+		this.SetActivityStreamsProfile(v)
+		return nil
+	}
+	if v, ok := t.(vocab.SecurityV1PublicKey); ok {
+		this.SetSecurityV1PublicKey(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedPush); ok {
+		this.SetForgeFedPush(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
+		this.SetActivityStreamsQuestion(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsRead); ok {
+		this.SetActivityStreamsRead(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsReject); ok {
+		this.SetActivityStreamsReject(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsRelationship); ok {
+		this.SetActivityStreamsRelationship(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsRemove); ok {
+		this.SetActivityStreamsRemove(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedRepository); ok {
+		this.SetForgeFedRepository(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsService); ok {
+		this.SetActivityStreamsService(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTentativeAccept); ok {
+		this.SetActivityStreamsTentativeAccept(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTentativeReject); ok {
+		this.SetActivityStreamsTentativeReject(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedTicket); ok {
+		this.SetForgeFedTicket(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedTicketDependency); ok {
+		this.SetForgeFedTicketDependency(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTombstone); ok {
+		this.SetActivityStreamsTombstone(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTravel); ok {
+		this.SetActivityStreamsTravel(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsUndo); ok {
+		this.SetActivityStreamsUndo(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsUpdate); ok {
+		this.SetActivityStreamsUpdate(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsVideo); ok {
+		this.SetActivityStreamsVideo(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsView); ok {
+		this.SetActivityStreamsView(v)
+		return nil
+	}
+
+	return fmt.Errorf("illegal type to set on description property: %T", t)
+}
+
+// SetVcardAddress sets the value of this property. Calling IsVcardAddress
+// afterwards returns true.
+func (this *ForgeFedDescriptionProperty) SetVcardAddress(v vocab.VcardAddress) {
+	this.Clear()
+	this.vcardAddressMember = v
+}
+
+// SetVcardHome sets the value of this property. Calling IsVcardHome afterwards
+// returns true.
+func (this *ForgeFedDescriptionProperty) SetVcardHome(v vocab.VcardHome) {
+	this.Clear()
+	this.vcardHomeMember = v
+}
@@ -0,0 +1,7258 @@
+package propertyearlyitems
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// ForgeFedEarlyItemsPropertyIterator is an iterator for a property. It is
+// permitted to be one of multiple value types. At most, one type of value can
+// be present, or none at all. Setting a value will clear the other types of
+// values so that only one of the 'Is' methods will return true. It is
+// possible to clear all values, so that this property is empty.
+type ForgeFedEarlyItemsPropertyIterator struct {
+	activitystreamsObjectMember                vocab.ActivityStreamsObject
+	activitystreamsLinkMember                  vocab.ActivityStreamsLink
+	activitystreamsAcceptMember                vocab.ActivityStreamsAccept
+	activitystreamsActivityMember              vocab.ActivityStreamsActivity
+	activitystreamsAddMember                   vocab.ActivityStreamsAdd
+	vcardAddressMember                         vocab.VcardAddress
+	activitystreamsAnnounceMember              vocab.ActivityStreamsAnnounce
+	activitystreamsApplicationMember           vocab.ActivityStreamsApplication
+	activitystreamsArriveMember                vocab.ActivityStreamsArrive
+	activitystreamsArticleMember               vocab.ActivityStreamsArticle
+	activitystreamsAudioMember                 vocab.ActivityStreamsAudio
+	activitystreamsBlockMember                 vocab.ActivityStreamsBlock
+	forgefedBranchMember                       vocab.ForgeFedBranch
+	activitystreamsCollectionMember            vocab.ActivityStreamsCollection
+	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
+	forgefedCommitMember                       vocab.ForgeFedCommit
+	activitystreamsCreateMember                vocab.ActivityStreamsCreate
+	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
+	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
+	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	tootEmojiMember                            vocab.TootEmoji
+	activitystreamsEventMember                 vocab.ActivityStreamsEvent
+	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
+	activitystreamsFollowMember                vocab.ActivityStreamsFollow
+	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	vcardHomeMember                            vocab.VcardHome
+	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
+	activitystreamsImageMember                 vocab.ActivityStreamsImage
+	activitystreamsIntransitiveActivityMember  vocab.ActivityStreamsIntransitiveActivity
+	activitystreamsInviteMember                vocab.ActivityStreamsInvite
+	activitystreamsJoinMember                  vocab.ActivityStreamsJoin
+	activitystreamsLeaveMember                 vocab.ActivityStreamsLeave
+	activitystreamsLikeMember                  vocab.ActivityStreamsLike
+	activitystreamsListenMember                vocab.ActivityStreamsListen
+	activitystreamsMentionMember               vocab.ActivityStreamsMention
+	activitystreamsMoveMember                  vocab.ActivityStreamsMove
+	activitystreamsNoteMember                  vocab.ActivityStreamsNote
+	activitystreamsOfferMember                 vocab.ActivityStreamsOffer
+	activitystreamsOrderedCollectionMember     vocab.ActivityStreamsOrderedCollection
+	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
+	activitystreamsOrganizationMember          vocab.ActivityStreamsOrganization
+	activitystreamsPageMember                  vocab.ActivityStreamsPage
+	activitystreamsPersonMember                vocab.ActivityStreamsPerson
+	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
+	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	securityv1PublicKeyMember                  vocab.SecurityV1PublicKey
+	forgefedPushMember                         vocab.ForgeFedPush
+	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
+	activitystreamsReadMember                  vocab.ActivityStreamsRead
+	activitystreamsRejectMember                vocab.ActivityStreamsReject
+	activitystreamsRelationshipMember          vocab.ActivityStreamsRelationship
+	activitystreamsRemoveMember                vocab.ActivityStreamsRemove
+	forgefedRepositoryMember                   vocab.ForgeFedRepository
+	activitystreamsServiceMember               vocab.ActivityStreamsService
+	activitystreamsTentativeAcceptMember       vocab.ActivityStreamsTentativeAccept
+	activitystreamsTentativeRejectMember       vocab.ActivityStreamsTentativeReject
+	forgefedTicketMember                       vocab.ForgeFedTicket
+	forgefedTicketDependencyMember             vocab.ForgeFedTicketDependency
+	activitystreamsTombstoneMember             vocab.ActivityStreamsTombstone
+	activitystreamsTravelMember                vocab.ActivityStreamsTravel
+	activitystreamsUndoMember                  vocab.ActivityStreamsUndo
+	activitystreamsUpdateMember                vocab.ActivityStreamsUpdate
+	activitystreamsVideoMember                 vocab.ActivityStreamsVideo
+	activitystreamsViewMember                  vocab.ActivityStreamsView
+	unknown                                    interface{}
+	iri                                        *url.URL
+	alias                                      string
+	myIdx                                      int
+	parent                                     vocab.ForgeFedEarlyItemsProperty
+}
+
+// NewForgeFedEarlyItemsPropertyIterator creates a new ForgeFedEarlyItems property.
+func NewForgeFedEarlyItemsPropertyIterator() *ForgeFedEarlyItemsPropertyIterator {
+	return &ForgeFedEarlyItemsPropertyIterator{alias: "ff"}
+}
+
+// deserializeForgeFedEarlyItemsPropertyIterator creates an iterator from an
+// element that has been unmarshalled from a text or binary format.
+func deserializeForgeFedEarlyItemsPropertyIterator(i interface{}, aliasMap map[string]string) (*ForgeFedEarlyItemsPropertyIterator, error) {
+	alias := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+	}
+	if s, ok := i.(string); ok {
+		u, err := url.Parse(s)
+		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+		if err == nil && len(u.Scheme) > 0 {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias: alias,
+				iri:   u,
+			}
+			return this, nil
+		}
+	}
+	if m, ok := i.(map[string]interface{}); ok {
+
+		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsObjectMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsLinkMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsAcceptMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsActivityMember: v,
+				alias:                         alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsAddMember: v,
+				alias:                    alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeAddressVcard()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:              alias,
+				vcardAddressMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsAnnounceMember: v,
+				alias:                         alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsApplicationMember: v,
+				alias:                            alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsArriveMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsArticleMember: v,
+				alias:                        alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsAudioMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsBlockMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:                alias,
+				forgefedBranchMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsCollectionMember: v,
+				alias:                           alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsCollectionPageMember: v,
+				alias:                               alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:                alias,
+				forgefedCommitMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsCreateMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsDeleteMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsDislikeMember: v,
+				alias:                        alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsDocumentMember: v,
+				alias:                         alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:           alias,
+				tootEmojiMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsEventMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsFlagMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsFollowMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsGroupMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeHomeVcard()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:           alias,
+				vcardHomeMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsIgnoreMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsImageMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsIntransitiveActivityMember: v,
+				alias: alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsInviteMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsJoinMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsLeaveMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsLikeMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsListenMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsMentionMember: v,
+				alias:                        alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsMoveMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsNoteMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsOfferMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsOrderedCollectionMember: v,
+				alias:                                  alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsOrderedCollectionPageMember: v,
+				alias: alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsOrganizationMember: v,
+				alias:                             alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsPageMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsPersonMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsPlaceMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsProfileMember: v,
+				alias:                        alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePublicKeySecurityV1()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:                     alias,
+				securityv1PublicKeyMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:              alias,
+				forgefedPushMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsQuestionMember: v,
+				alias:                         alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsReadMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsRejectMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsRelationshipMember: v,
+				alias:                             alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsRemoveMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:                    alias,
+				forgefedRepositoryMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsServiceMember: v,
+				alias:                        alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsTentativeAcceptMember: v,
+				alias:                                alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsTentativeRejectMember: v,
+				alias:                                alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:                alias,
+				forgefedTicketMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				alias:                          alias,
+				forgefedTicketDependencyMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsTombstoneMember: v,
+				alias:                          alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsTravelMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsUndoMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsUpdateMember: v,
+				alias:                       alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsVideoMember: v,
+				alias:                      alias,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+			this := &ForgeFedEarlyItemsPropertyIterator{
+				activitystreamsViewMember: v,
+				alias:                     alias,
+			}
+			return this, nil
+		}
+	}
+	this := &ForgeFedEarlyItemsPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
+}
+
+// GetActivityStreamsAccept returns the value of this property. When
+// IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+	return this.activitystreamsAcceptMember
+}
+
+// GetActivityStreamsActivity returns the value of this property. When
+// IsActivityStreamsActivity returns false, GetActivityStreamsActivity will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+	return this.activitystreamsActivityMember
+}
+
+// GetActivityStreamsAdd returns the value of this property. When
+// IsActivityStreamsAdd returns false, GetActivityStreamsAdd will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+	return this.activitystreamsAddMember
+}
+
+// GetActivityStreamsAnnounce returns the value of this property. When
+// IsActivityStreamsAnnounce returns false, GetActivityStreamsAnnounce will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+	return this.activitystreamsAnnounceMember
+}
+
+// GetActivityStreamsApplication returns the value of this property. When
+// IsActivityStreamsApplication returns false, GetActivityStreamsApplication
+// will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+	return this.activitystreamsApplicationMember
+}
+
+// GetActivityStreamsArrive returns the value of this property. When
+// IsActivityStreamsArrive returns false, GetActivityStreamsArrive will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+	return this.activitystreamsArriveMember
+}
+
+// GetActivityStreamsArticle returns the value of this property. When
+// IsActivityStreamsArticle returns false, GetActivityStreamsArticle will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+	return this.activitystreamsArticleMember
+}
+
+// GetActivityStreamsAudio returns the value of this property. When
+// IsActivityStreamsAudio returns false, GetActivityStreamsAudio will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+	return this.activitystreamsAudioMember
+}
+
+// GetActivityStreamsBlock returns the value of this property. When
+// IsActivityStreamsBlock returns false, GetActivityStreamsBlock will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+	return this.activitystreamsBlockMember
+}
+
+// GetActivityStreamsCollection returns the value of this property. When
+// IsActivityStreamsCollection returns false, GetActivityStreamsCollection
+// will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	return this.activitystreamsCollectionMember
+}
+
+// GetActivityStreamsCollectionPage returns the value of this property. When
+// IsActivityStreamsCollectionPage returns false,
+// GetActivityStreamsCollectionPage will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	return this.activitystreamsCollectionPageMember
+}
+
+// GetActivityStreamsCreate returns the value of this property. When
+// IsActivityStreamsCreate returns false, GetActivityStreamsCreate will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+	return this.activitystreamsCreateMember
+}
+
+// GetActivityStreamsDelete returns the value of this property. When
+// IsActivityStreamsDelete returns false, GetActivityStreamsDelete will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+	return this.activitystreamsDeleteMember
+}
+
+// GetActivityStreamsDislike returns the value of this property. When
+// IsActivityStreamsDislike returns false, GetActivityStreamsDislike will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+	return this.activitystreamsDislikeMember
+}
+
+// GetActivityStreamsDocument returns the value of this property. When
+// IsActivityStreamsDocument returns false, GetActivityStreamsDocument will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+	return this.activitystreamsDocumentMember
+}
+
+// GetActivityStreamsEvent returns the value of this property. When
+// IsActivityStreamsEvent returns false, GetActivityStreamsEvent will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+	return this.activitystreamsEventMember
+}
+
+// GetActivityStreamsFlag returns the value of this property. When
+// IsActivityStreamsFlag returns false, GetActivityStreamsFlag will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+	return this.activitystreamsFlagMember
+}
+
+// GetActivityStreamsFollow returns the value of this property. When
+// IsActivityStreamsFollow returns false, GetActivityStreamsFollow will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+	return this.activitystreamsFollowMember
+}
+
+// GetActivityStreamsGroup returns the value of this property. When
+// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+	return this.activitystreamsGroupMember
+}
+
+// GetActivityStreamsIgnore returns the value of this property. When
+// IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+	return this.activitystreamsIgnoreMember
+}
+
+// GetActivityStreamsImage returns the value of this property. When
+// IsActivityStreamsImage returns false, GetActivityStreamsImage will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+	return this.activitystreamsImageMember
+}
+
+// GetActivityStreamsIntransitiveActivity returns the value of this property. When
+// IsActivityStreamsIntransitiveActivity returns false,
+// GetActivityStreamsIntransitiveActivity will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+	return this.activitystreamsIntransitiveActivityMember
+}
+
+// GetActivityStreamsInvite returns the value of this property. When
+// IsActivityStreamsInvite returns false, GetActivityStreamsInvite will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+	return this.activitystreamsInviteMember
+}
+
+// GetActivityStreamsJoin returns the value of this property. When
+// IsActivityStreamsJoin returns false, GetActivityStreamsJoin will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+	return this.activitystreamsJoinMember
+}
+
+// GetActivityStreamsLeave returns the value of this property. When
+// IsActivityStreamsLeave returns false, GetActivityStreamsLeave will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+	return this.activitystreamsLeaveMember
+}
+
+// GetActivityStreamsLike returns the value of this property. When
+// IsActivityStreamsLike returns false, GetActivityStreamsLike will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+	return this.activitystreamsLikeMember
+}
+
+// GetActivityStreamsLink returns the value of this property. When
+// IsActivityStreamsLink returns false, GetActivityStreamsLink will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+	return this.activitystreamsLinkMember
+}
+
+// GetActivityStreamsListen returns the value of this property. When
+// IsActivityStreamsListen returns false, GetActivityStreamsListen will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+	return this.activitystreamsListenMember
+}
+
+// GetActivityStreamsMention returns the value of this property. When
+// IsActivityStreamsMention returns false, GetActivityStreamsMention will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+	return this.activitystreamsMentionMember
+}
+
+// GetActivityStreamsMove returns the value of this property. When
+// IsActivityStreamsMove returns false, GetActivityStreamsMove will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+	return this.activitystreamsMoveMember
+}
+
+// GetActivityStreamsNote returns the value of this property. When
+// IsActivityStreamsNote returns false, GetActivityStreamsNote will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+	return this.activitystreamsNoteMember
+}
+
+// GetActivityStreamsObject returns the value of this property. When
+// IsActivityStreamsObject returns false, GetActivityStreamsObject will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+	return this.activitystreamsObjectMember
+}
+
+// GetActivityStreamsOffer returns the value of this property. When
+// IsActivityStreamsOffer returns false, GetActivityStreamsOffer will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+	return this.activitystreamsOfferMember
+}
+
+// GetActivityStreamsOrderedCollection returns the value of this property. When
+// IsActivityStreamsOrderedCollection returns false,
+// GetActivityStreamsOrderedCollection will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	return this.activitystreamsOrderedCollectionMember
+}
+
+// GetActivityStreamsOrderedCollectionPage returns the value of this property.
+// When IsActivityStreamsOrderedCollectionPage returns false,
+// GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	return this.activitystreamsOrderedCollectionPageMember
+}
+
+// GetActivityStreamsOrganization returns the value of this property. When
+// IsActivityStreamsOrganization returns false, GetActivityStreamsOrganization
+// will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+	return this.activitystreamsOrganizationMember
+}
+
+// GetActivityStreamsPage returns the value of this property. When
+// IsActivityStreamsPage returns false, GetActivityStreamsPage will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+	return this.activitystreamsPageMember
+}
+
+// GetActivityStreamsPerson returns the value of this property. When
+// IsActivityStreamsPerson returns false, GetActivityStreamsPerson will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+	return this.activitystreamsPersonMember
+}
+
+// GetActivityStreamsPlace returns the value of this property. When
+// IsActivityStreamsPlace returns false, GetActivityStreamsPlace will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+	return this.activitystreamsPlaceMember
+}
+
+// GetActivityStreamsProfile returns the value of this property. When
+// IsActivityStreamsProfile returns false, GetActivityStreamsProfile will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+	return this.activitystreamsProfileMember
+}
+
+// GetActivityStreamsQuestion returns the value of this property. When
+// IsActivityStreamsQuestion returns false, GetActivityStreamsQuestion will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+	return this.activitystreamsQuestionMember
+}
+
+// GetActivityStreamsRead returns the value of this property. When
+// IsActivityStreamsRead returns false, GetActivityStreamsRead will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+	return this.activitystreamsReadMember
+}
+
+// GetActivityStreamsReject returns the value of this property. When
+// IsActivityStreamsReject returns false, GetActivityStreamsReject will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+	return this.activitystreamsRejectMember
+}
+
+// GetActivityStreamsRelationship returns the value of this property. When
+// IsActivityStreamsRelationship returns false, GetActivityStreamsRelationship
+// will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+	return this.activitystreamsRelationshipMember
+}
+
+// GetActivityStreamsRemove returns the value of this property. When
+// IsActivityStreamsRemove returns false, GetActivityStreamsRemove will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+	return this.activitystreamsRemoveMember
+}
+
+// GetActivityStreamsService returns the value of this property. When
+// IsActivityStreamsService returns false, GetActivityStreamsService will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsService() vocab.ActivityStreamsService {
+	return this.activitystreamsServiceMember
+}
+
+// GetActivityStreamsTentativeAccept returns the value of this property. When
+// IsActivityStreamsTentativeAccept returns false,
+// GetActivityStreamsTentativeAccept will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+	return this.activitystreamsTentativeAcceptMember
+}
+
+// GetActivityStreamsTentativeReject returns the value of this property. When
+// IsActivityStreamsTentativeReject returns false,
+// GetActivityStreamsTentativeReject will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+	return this.activitystreamsTentativeRejectMember
+}
+
+// GetActivityStreamsTombstone returns the value of this property. When
+// IsActivityStreamsTombstone returns false, GetActivityStreamsTombstone will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+	return this.activitystreamsTombstoneMember
+}
+
+// GetActivityStreamsTravel returns the value of this property. When
+// IsActivityStreamsTravel returns false, GetActivityStreamsTravel will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+	return this.activitystreamsTravelMember
+}
+
+// GetActivityStreamsUndo returns the value of this property. When
+// IsActivityStreamsUndo returns false, GetActivityStreamsUndo will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+	return this.activitystreamsUndoMember
+}
+
+// GetActivityStreamsUpdate returns the value of this property. When
+// IsActivityStreamsUpdate returns false, GetActivityStreamsUpdate will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+	return this.activitystreamsUpdateMember
+}
+
+// GetActivityStreamsVideo returns the value of this property. When
+// IsActivityStreamsVideo returns false, GetActivityStreamsVideo will return
+// an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+	return this.activitystreamsVideoMember
+}
+
+// GetActivityStreamsView returns the value of this property. When
+// IsActivityStreamsView returns false, GetActivityStreamsView will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetActivityStreamsView() vocab.ActivityStreamsView {
+	return this.activitystreamsViewMember
+}
+
+// GetForgeFedBranch returns the value of this property. When IsForgeFedBranch
+// returns false, GetForgeFedBranch will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetForgeFedBranch() vocab.ForgeFedBranch {
+	return this.forgefedBranchMember
+}
+
+// GetForgeFedCommit returns the value of this property. When IsForgeFedCommit
+// returns false, GetForgeFedCommit will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetForgeFedCommit() vocab.ForgeFedCommit {
+	return this.forgefedCommitMember
+}
+
+// GetForgeFedPush returns the value of this property. When IsForgeFedPush returns
+// false, GetForgeFedPush will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetForgeFedPush() vocab.ForgeFedPush {
+	return this.forgefedPushMember
+}
+
+// GetForgeFedRepository returns the value of this property. When
+// IsForgeFedRepository returns false, GetForgeFedRepository will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetForgeFedRepository() vocab.ForgeFedRepository {
+	return this.forgefedRepositoryMember
+}
+
+// GetForgeFedTicket returns the value of this property. When IsForgeFedTicket
+// returns false, GetForgeFedTicket will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetForgeFedTicket() vocab.ForgeFedTicket {
+	return this.forgefedTicketMember
+}
+
+// GetForgeFedTicketDependency returns the value of this property. When
+// IsForgeFedTicketDependency returns false, GetForgeFedTicketDependency will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetForgeFedTicketDependency() vocab.ForgeFedTicketDependency {
+	return this.forgefedTicketDependencyMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetIRI() *url.URL {
+	return this.iri
+}
+
+// GetSecurityV1PublicKey returns the value of this property. When
+// IsSecurityV1PublicKey returns false, GetSecurityV1PublicKey will return an
+// arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetSecurityV1PublicKey() vocab.SecurityV1PublicKey {
+	return this.securityv1PublicKeyMember
+}
+
+// GetTootEmoji returns the value of this property. When IsTootEmoji returns
+// false, GetTootEmoji will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetTootEmoji() vocab.TootEmoji {
+	return this.tootEmojiMember
+}
+
+// GetType returns the value in this property as a Type. Returns nil if the value
+// is not an ActivityStreams type, such as an IRI or another value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetType() vocab.Type {
+	if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject()
+	}
+	if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink()
+	}
+	if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept()
+	}
+	if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity()
+	}
+	if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd()
+	}
+	if this.IsVcardAddress() {
+		return this.GetVcardAddress()
+	}
+	if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce()
+	}
+	if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication()
+	}
+	if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive()
+	}
+	if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle()
+	}
+	if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio()
+	}
+	if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock()
+	}
+	if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch()
+	}
+	if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection()
+	}
+	if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage()
+	}
+	if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit()
+	}
+	if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate()
+	}
+	if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete()
+	}
+	if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike()
+	}
+	if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument()
+	}
+	if this.IsTootEmoji() {
+		return this.GetTootEmoji()
+	}
+	if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent()
+	}
+	if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag()
+	}
+	if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow()
+	}
+	if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup()
+	}
+	if this.IsVcardHome() {
+		return this.GetVcardHome()
+	}
+	if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore()
+	}
+	if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage()
+	}
+	if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity()
+	}
+	if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite()
+	}
+	if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin()
+	}
+	if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave()
+	}
+	if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike()
+	}
+	if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen()
+	}
+	if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention()
+	}
+	if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove()
+	}
+	if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote()
+	}
+	if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer()
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection()
+	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage()
+	}
+	if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization()
+	}
+	if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage()
+	}
+	if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson()
+	}
+	if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace()
+	}
+	if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile()
+	}
+	if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey()
+	}
+	if this.IsForgeFedPush() {
+		return this.GetForgeFedPush()
+	}
+	if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion()
+	}
+	if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead()
+	}
+	if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject()
+	}
+	if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship()
+	}
+	if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove()
+	}
+	if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository()
+	}
+	if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService()
+	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept()
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject()
+	}
+	if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket()
+	}
+	if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency()
+	}
+	if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone()
+	}
+	if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel()
+	}
+	if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo()
+	}
+	if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate()
+	}
+	if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo()
+	}
+	if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView()
+	}
+
+	return nil
+}
+
+// GetVcardAddress returns the value of this property. When IsVcardAddress returns
+// false, GetVcardAddress will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetVcardAddress() vocab.VcardAddress {
+	return this.vcardAddressMember
+}
+
+// GetVcardHome returns the value of this property. When IsVcardHome returns
+// false, GetVcardHome will return an arbitrary value.
+func (this ForgeFedEarlyItemsPropertyIterator) GetVcardHome() vocab.VcardHome {
+	return this.vcardHomeMember
+}
+
+// HasAny returns true if any of the different values is set.
+func (this ForgeFedEarlyItemsPropertyIterator) HasAny() bool {
+	return this.IsActivityStreamsObject() ||
+		this.IsActivityStreamsLink() ||
+		this.IsActivityStreamsAccept() ||
+		this.IsActivityStreamsActivity() ||
+		this.IsActivityStreamsAdd() ||
+		this.IsVcardAddress() ||
+		this.IsActivityStreamsAnnounce() ||
+		this.IsActivityStreamsApplication() ||
+		this.IsActivityStreamsArrive() ||
+		this.IsActivityStreamsArticle() ||
+		this.IsActivityStreamsAudio() ||
+		this.IsActivityStreamsBlock() ||
+		this.IsForgeFedBranch() ||
+		this.IsActivityStreamsCollection() ||
+		this.IsActivityStreamsCollectionPage() ||
+		this.IsForgeFedCommit() ||
+		this.IsActivityStreamsCreate() ||
+		this.IsActivityStreamsDelete() ||
+		this.IsActivityStreamsDislike() ||
+		this.IsActivityStreamsDocument() ||
+		this.IsTootEmoji() ||
+		this.IsActivityStreamsEvent() ||
+		this.IsActivityStreamsFlag() ||
+		this.IsActivityStreamsFollow() ||
+		this.IsActivityStreamsGroup() ||
+		this.IsVcardHome() ||
+		this.IsActivityStreamsIgnore() ||
+		this.IsActivityStreamsImage() ||
+		this.IsActivityStreamsIntransitiveActivity() ||
+		this.IsActivityStreamsInvite() ||
+		this.IsActivityStreamsJoin() ||
+		this.IsActivityStreamsLeave() ||
+		this.IsActivityStreamsLike() ||
+		this.IsActivityStreamsListen() ||
+		this.IsActivityStreamsMention() ||
+		this.IsActivityStreamsMove() ||
+		this.IsActivityStreamsNote() ||
+		this.IsActivityStreamsOffer() ||
+		this.IsActivityStreamsOrderedCollection() ||
+		this.IsActivityStreamsOrderedCollectionPage() ||
+		this.IsActivityStreamsOrganization() ||
+		this.IsActivityStreamsPage() ||
+		this.IsActivityStreamsPerson() ||
+		this.IsActivityStreamsPlace() ||
+		this.IsActivityStreamsProfile() ||
+		this.IsSecurityV1PublicKey() ||
+		this.IsForgeFedPush() ||
+		this.IsActivityStreamsQuestion() ||
+		this.IsActivityStreamsRead() ||
+		this.IsActivityStreamsReject() ||
+		this.IsActivityStreamsRelationship() ||
+		this.IsActivityStreamsRemove() ||
+		this.IsForgeFedRepository() ||
+		this.IsActivityStreamsService() ||
+		this.IsActivityStreamsTentativeAccept() ||
+		this.IsActivityStreamsTentativeReject() ||
+		this.IsForgeFedTicket() ||
+		this.IsForgeFedTicketDependency() ||
+		this.IsActivityStreamsTombstone() ||
+		this.IsActivityStreamsTravel() ||
+		this.IsActivityStreamsUndo() ||
+		this.IsActivityStreamsUpdate() ||
+		this.IsActivityStreamsVideo() ||
+		this.IsActivityStreamsView() ||
+		this.iri != nil
+}
+
+// IsActivityStreamsAccept returns true if this property has a type of "Accept".
+// When true, use the GetActivityStreamsAccept and SetActivityStreamsAccept
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsAccept() bool {
+	return this.activitystreamsAcceptMember != nil
+}
+
+// IsActivityStreamsActivity returns true if this property has a type of
+// "Activity". When true, use the GetActivityStreamsActivity and
+// SetActivityStreamsActivity methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsActivity() bool {
+	return this.activitystreamsActivityMember != nil
+}
+
+// IsActivityStreamsAdd returns true if this property has a type of "Add". When
+// true, use the GetActivityStreamsAdd and SetActivityStreamsAdd methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsAdd() bool {
+	return this.activitystreamsAddMember != nil
+}
+
+// IsActivityStreamsAnnounce returns true if this property has a type of
+// "Announce". When true, use the GetActivityStreamsAnnounce and
+// SetActivityStreamsAnnounce methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsAnnounce() bool {
+	return this.activitystreamsAnnounceMember != nil
+}
+
+// IsActivityStreamsApplication returns true if this property has a type of
+// "Application". When true, use the GetActivityStreamsApplication and
+// SetActivityStreamsApplication methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsApplication() bool {
+	return this.activitystreamsApplicationMember != nil
+}
+
+// IsActivityStreamsArrive returns true if this property has a type of "Arrive".
+// When true, use the GetActivityStreamsArrive and SetActivityStreamsArrive
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsArrive() bool {
+	return this.activitystreamsArriveMember != nil
+}
+
+// IsActivityStreamsArticle returns true if this property has a type of "Article".
+// When true, use the GetActivityStreamsArticle and SetActivityStreamsArticle
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsArticle() bool {
+	return this.activitystreamsArticleMember != nil
+}
+
+// IsActivityStreamsAudio returns true if this property has a type of "Audio".
+// When true, use the GetActivityStreamsAudio and SetActivityStreamsAudio
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsAudio() bool {
+	return this.activitystreamsAudioMember != nil
+}
+
+// IsActivityStreamsBlock returns true if this property has a type of "Block".
+// When true, use the GetActivityStreamsBlock and SetActivityStreamsBlock
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsBlock() bool {
+	return this.activitystreamsBlockMember != nil
+}
+
+// IsActivityStreamsCollection returns true if this property has a type of
+// "Collection". When true, use the GetActivityStreamsCollection and
+// SetActivityStreamsCollection methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsCollection() bool {
+	return this.activitystreamsCollectionMember != nil
+}
+
+// IsActivityStreamsCollectionPage returns true if this property has a type of
+// "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
+// SetActivityStreamsCollectionPage methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsCollectionPage() bool {
+	return this.activitystreamsCollectionPageMember != nil
+}
+
+// IsActivityStreamsCreate returns true if this property has a type of "Create".
+// When true, use the GetActivityStreamsCreate and SetActivityStreamsCreate
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsCreate() bool {
+	return this.activitystreamsCreateMember != nil
+}
+
+// IsActivityStreamsDelete returns true if this property has a type of "Delete".
+// When true, use the GetActivityStreamsDelete and SetActivityStreamsDelete
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsDelete() bool {
+	return this.activitystreamsDeleteMember != nil
+}
+
+// IsActivityStreamsDislike returns true if this property has a type of "Dislike".
+// When true, use the GetActivityStreamsDislike and SetActivityStreamsDislike
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsDislike() bool {
+	return this.activitystreamsDislikeMember != nil
+}
+
+// IsActivityStreamsDocument returns true if this property has a type of
+// "Document". When true, use the GetActivityStreamsDocument and
+// SetActivityStreamsDocument methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsDocument() bool {
+	return this.activitystreamsDocumentMember != nil
+}
+
+// IsActivityStreamsEvent returns true if this property has a type of "Event".
+// When true, use the GetActivityStreamsEvent and SetActivityStreamsEvent
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsEvent() bool {
+	return this.activitystreamsEventMember != nil
+}
+
+// IsActivityStreamsFlag returns true if this property has a type of "Flag". When
+// true, use the GetActivityStreamsFlag and SetActivityStreamsFlag methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsFlag() bool {
+	return this.activitystreamsFlagMember != nil
+}
+
+// IsActivityStreamsFollow returns true if this property has a type of "Follow".
+// When true, use the GetActivityStreamsFollow and SetActivityStreamsFollow
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsFollow() bool {
+	return this.activitystreamsFollowMember != nil
+}
+
+// IsActivityStreamsGroup returns true if this property has a type of "Group".
+// When true, use the GetActivityStreamsGroup and SetActivityStreamsGroup
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsGroup() bool {
+	return this.activitystreamsGroupMember != nil
+}
+
+// IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
+// When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsIgnore() bool {
+	return this.activitystreamsIgnoreMember != nil
+}
+
+// IsActivityStreamsImage returns true if this property has a type of "Image".
+// When true, use the GetActivityStreamsImage and SetActivityStreamsImage
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsImage() bool {
+	return this.activitystreamsImageMember != nil
+}
+
+// IsActivityStreamsIntransitiveActivity returns true if this property has a type
+// of "IntransitiveActivity". When true, use the
+// GetActivityStreamsIntransitiveActivity and
+// SetActivityStreamsIntransitiveActivity methods to access and set this
+// property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsIntransitiveActivity() bool {
+	return this.activitystreamsIntransitiveActivityMember != nil
+}
+
+// IsActivityStreamsInvite returns true if this property has a type of "Invite".
+// When true, use the GetActivityStreamsInvite and SetActivityStreamsInvite
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsInvite() bool {
+	return this.activitystreamsInviteMember != nil
+}
+
+// IsActivityStreamsJoin returns true if this property has a type of "Join". When
+// true, use the GetActivityStreamsJoin and SetActivityStreamsJoin methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsJoin() bool {
+	return this.activitystreamsJoinMember != nil
+}
+
+// IsActivityStreamsLeave returns true if this property has a type of "Leave".
+// When true, use the GetActivityStreamsLeave and SetActivityStreamsLeave
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsLeave() bool {
+	return this.activitystreamsLeaveMember != nil
+}
+
+// IsActivityStreamsLike returns true if this property has a type of "Like". When
+// true, use the GetActivityStreamsLike and SetActivityStreamsLike methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsLike() bool {
+	return this.activitystreamsLikeMember != nil
+}
+
+// IsActivityStreamsLink returns true if this property has a type of "Link". When
+// true, use the GetActivityStreamsLink and SetActivityStreamsLink methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsLink() bool {
+	return this.activitystreamsLinkMember != nil
+}
+
+// IsActivityStreamsListen returns true if this property has a type of "Listen".
+// When true, use the GetActivityStreamsListen and SetActivityStreamsListen
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsListen() bool {
+	return this.activitystreamsListenMember != nil
+}
+
+// IsActivityStreamsMention returns true if this property has a type of "Mention".
+// When true, use the GetActivityStreamsMention and SetActivityStreamsMention
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsMention() bool {
+	return this.activitystreamsMentionMember != nil
+}
+
+// IsActivityStreamsMove returns true if this property has a type of "Move". When
+// true, use the GetActivityStreamsMove and SetActivityStreamsMove methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsMove() bool {
+	return this.activitystreamsMoveMember != nil
+}
+
+// IsActivityStreamsNote returns true if this property has a type of "Note". When
+// true, use the GetActivityStreamsNote and SetActivityStreamsNote methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsNote() bool {
+	return this.activitystreamsNoteMember != nil
+}
+
+// IsActivityStreamsObject returns true if this property has a type of "Object".
+// When true, use the GetActivityStreamsObject and SetActivityStreamsObject
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsObject() bool {
+	return this.activitystreamsObjectMember != nil
+}
+
+// IsActivityStreamsOffer returns true if this property has a type of "Offer".
+// When true, use the GetActivityStreamsOffer and SetActivityStreamsOffer
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsOffer() bool {
+	return this.activitystreamsOfferMember != nil
+}
+
+// IsActivityStreamsOrderedCollection returns true if this property has a type of
+// "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
+// and SetActivityStreamsOrderedCollection methods to access and set this
+// property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsOrderedCollection() bool {
+	return this.activitystreamsOrderedCollectionMember != nil
+}
+
+// IsActivityStreamsOrderedCollectionPage returns true if this property has a type
+// of "OrderedCollectionPage". When true, use the
+// GetActivityStreamsOrderedCollectionPage and
+// SetActivityStreamsOrderedCollectionPage methods to access and set this
+// property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsOrderedCollectionPage() bool {
+	return this.activitystreamsOrderedCollectionPageMember != nil
+}
+
+// IsActivityStreamsOrganization returns true if this property has a type of
+// "Organization". When true, use the GetActivityStreamsOrganization and
+// SetActivityStreamsOrganization methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsOrganization() bool {
+	return this.activitystreamsOrganizationMember != nil
+}
+
+// IsActivityStreamsPage returns true if this property has a type of "Page". When
+// true, use the GetActivityStreamsPage and SetActivityStreamsPage methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsPage() bool {
+	return this.activitystreamsPageMember != nil
+}
+
+// IsActivityStreamsPerson returns true if this property has a type of "Person".
+// When true, use the GetActivityStreamsPerson and SetActivityStreamsPerson
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsPerson() bool {
+	return this.activitystreamsPersonMember != nil
+}
+
+// IsActivityStreamsPlace returns true if this property has a type of "Place".
+// When true, use the GetActivityStreamsPlace and SetActivityStreamsPlace
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsPlace() bool {
+	return this.activitystreamsPlaceMember != nil
+}
+
+// IsActivityStreamsProfile returns true if this property has a type of "Profile".
+// When true, use the GetActivityStreamsProfile and SetActivityStreamsProfile
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsProfile() bool {
+	return this.activitystreamsProfileMember != nil
+}
+
+// IsActivityStreamsQuestion returns true if this property has a type of
+// "Question". When true, use the GetActivityStreamsQuestion and
+// SetActivityStreamsQuestion methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsQuestion() bool {
+	return this.activitystreamsQuestionMember != nil
+}
+
+// IsActivityStreamsRead returns true if this property has a type of "Read". When
+// true, use the GetActivityStreamsRead and SetActivityStreamsRead methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsRead() bool {
+	return this.activitystreamsReadMember != nil
+}
+
+// IsActivityStreamsReject returns true if this property has a type of "Reject".
+// When true, use the GetActivityStreamsReject and SetActivityStreamsReject
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsReject() bool {
+	return this.activitystreamsRejectMember != nil
+}
+
+// IsActivityStreamsRelationship returns true if this property has a type of
+// "Relationship". When true, use the GetActivityStreamsRelationship and
+// SetActivityStreamsRelationship methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsRelationship() bool {
+	return this.activitystreamsRelationshipMember != nil
+}
+
+// IsActivityStreamsRemove returns true if this property has a type of "Remove".
+// When true, use the GetActivityStreamsRemove and SetActivityStreamsRemove
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsRemove() bool {
+	return this.activitystreamsRemoveMember != nil
+}
+
+// IsActivityStreamsService returns true if this property has a type of "Service".
+// When true, use the GetActivityStreamsService and SetActivityStreamsService
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsService() bool {
+	return this.activitystreamsServiceMember != nil
+}
+
+// IsActivityStreamsTentativeAccept returns true if this property has a type of
+// "TentativeAccept". When true, use the GetActivityStreamsTentativeAccept and
+// SetActivityStreamsTentativeAccept methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsTentativeAccept() bool {
+	return this.activitystreamsTentativeAcceptMember != nil
+}
+
+// IsActivityStreamsTentativeReject returns true if this property has a type of
+// "TentativeReject". When true, use the GetActivityStreamsTentativeReject and
+// SetActivityStreamsTentativeReject methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsTentativeReject() bool {
+	return this.activitystreamsTentativeRejectMember != nil
+}
+
+// IsActivityStreamsTombstone returns true if this property has a type of
+// "Tombstone". When true, use the GetActivityStreamsTombstone and
+// SetActivityStreamsTombstone methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsTombstone() bool {
+	return this.activitystreamsTombstoneMember != nil
+}
+
+// IsActivityStreamsTravel returns true if this property has a type of "Travel".
+// When true, use the GetActivityStreamsTravel and SetActivityStreamsTravel
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsTravel() bool {
+	return this.activitystreamsTravelMember != nil
+}
+
+// IsActivityStreamsUndo returns true if this property has a type of "Undo". When
+// true, use the GetActivityStreamsUndo and SetActivityStreamsUndo methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsUndo() bool {
+	return this.activitystreamsUndoMember != nil
+}
+
+// IsActivityStreamsUpdate returns true if this property has a type of "Update".
+// When true, use the GetActivityStreamsUpdate and SetActivityStreamsUpdate
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsUpdate() bool {
+	return this.activitystreamsUpdateMember != nil
+}
+
+// IsActivityStreamsVideo returns true if this property has a type of "Video".
+// When true, use the GetActivityStreamsVideo and SetActivityStreamsVideo
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsVideo() bool {
+	return this.activitystreamsVideoMember != nil
+}
+
+// IsActivityStreamsView returns true if this property has a type of "View". When
+// true, use the GetActivityStreamsView and SetActivityStreamsView methods to
+// access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsActivityStreamsView() bool {
+	return this.activitystreamsViewMember != nil
+}
+
+// IsForgeFedBranch returns true if this property has a type of "Branch". When
+// true, use the GetForgeFedBranch and SetForgeFedBranch methods to access and
+// set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsForgeFedBranch() bool {
+	return this.forgefedBranchMember != nil
+}
+
+// IsForgeFedCommit returns true if this property has a type of "Commit". When
+// true, use the GetForgeFedCommit and SetForgeFedCommit methods to access and
+// set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsForgeFedCommit() bool {
+	return this.forgefedCommitMember != nil
+}
+
+// IsForgeFedPush returns true if this property has a type of "Push". When true,
+// use the GetForgeFedPush and SetForgeFedPush methods to access and set this
+// property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsForgeFedPush() bool {
+	return this.forgefedPushMember != nil
+}
+
+// IsForgeFedRepository returns true if this property has a type of "Repository".
+// When true, use the GetForgeFedRepository and SetForgeFedRepository methods
+// to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsForgeFedRepository() bool {
+	return this.forgefedRepositoryMember != nil
+}
+
+// IsForgeFedTicket returns true if this property has a type of "Ticket". When
+// true, use the GetForgeFedTicket and SetForgeFedTicket methods to access and
+// set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsForgeFedTicket() bool {
+	return this.forgefedTicketMember != nil
+}
+
+// IsForgeFedTicketDependency returns true if this property has a type of
+// "TicketDependency". When true, use the GetForgeFedTicketDependency and
+// SetForgeFedTicketDependency methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsForgeFedTicketDependency() bool {
+	return this.forgefedTicketDependencyMember != nil
+}
+
+// IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
+// to access and set this property
+func (this ForgeFedEarlyItemsPropertyIterator) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsSecurityV1PublicKey returns true if this property has a type of "PublicKey".
+// When true, use the GetSecurityV1PublicKey and SetSecurityV1PublicKey
+// methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsSecurityV1PublicKey() bool {
+	return this.securityv1PublicKeyMember != nil
+}
+
+// IsTootEmoji returns true if this property has a type of "Emoji". When true, use
+// the GetTootEmoji and SetTootEmoji methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsTootEmoji() bool {
+	return this.tootEmojiMember != nil
+}
+
+// IsVcardAddress returns true if this property has a type of "Address". When
+// true, use the GetVcardAddress and SetVcardAddress methods to access and set
+// this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsVcardAddress() bool {
+	return this.vcardAddressMember != nil
+}
+
+// IsVcardHome returns true if this property has a type of "Home". When true, use
+// the GetVcardHome and SetVcardHome methods to access and set this property.
+func (this ForgeFedEarlyItemsPropertyIterator) IsVcardHome() bool {
+	return this.vcardHomeMember != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ForgeFedEarlyItemsPropertyIterator) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	var child map[string]string
+	if this.IsActivityStreamsObject() {
+		child = this.GetActivityStreamsObject().JSONLDContext()
+	} else if this.IsActivityStreamsLink() {
+		child = this.GetActivityStreamsLink().JSONLDContext()
+	} else if this.IsActivityStreamsAccept() {
+		child = this.GetActivityStreamsAccept().JSONLDContext()
+	} else if this.IsActivityStreamsActivity() {
+		child = this.GetActivityStreamsActivity().JSONLDContext()
+	} else if this.IsActivityStreamsAdd() {
+		child = this.GetActivityStreamsAdd().JSONLDContext()
+	} else if this.IsVcardAddress() {
+		child = this.GetVcardAddress().JSONLDContext()
+	} else if this.IsActivityStreamsAnnounce() {
+		child = this.GetActivityStreamsAnnounce().JSONLDContext()
+	} else if this.IsActivityStreamsApplication() {
+		child = this.GetActivityStreamsApplication().JSONLDContext()
+	} else if this.IsActivityStreamsArrive() {
+		child = this.GetActivityStreamsArrive().JSONLDContext()
+	} else if this.IsActivityStreamsArticle() {
+		child = this.GetActivityStreamsArticle().JSONLDContext()
+	} else if this.IsActivityStreamsAudio() {
+		child = this.GetActivityStreamsAudio().JSONLDContext()
+	} else if this.IsActivityStreamsBlock() {
+		child = this.GetActivityStreamsBlock().JSONLDContext()
+	} else if this.IsForgeFedBranch() {
+		child = this.GetForgeFedBranch().JSONLDContext()
+	} else if this.IsActivityStreamsCollection() {
+		child = this.GetActivityStreamsCollection().JSONLDContext()
+	} else if this.IsActivityStreamsCollectionPage() {
+		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
+	} else if this.IsForgeFedCommit() {
+		child = this.GetForgeFedCommit().JSONLDContext()
+	} else if this.IsActivityStreamsCreate() {
+		child = this.GetActivityStreamsCreate().JSONLDContext()
+	} else if this.IsActivityStreamsDelete() {
+		child = this.GetActivityStreamsDelete().JSONLDContext()
+	} else if this.IsActivityStreamsDislike() {
+		child = this.GetActivityStreamsDislike().JSONLDContext()
+	} else if this.IsActivityStreamsDocument() {
+		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsTootEmoji() {
+		child = this.GetTootEmoji().JSONLDContext()
+	} else if this.IsActivityStreamsEvent() {
+		child = this.GetActivityStreamsEvent().JSONLDContext()
+	} else if this.IsActivityStreamsFlag() {
+		child = this.GetActivityStreamsFlag().JSONLDContext()
+	} else if this.IsActivityStreamsFollow() {
+		child = this.GetActivityStreamsFollow().JSONLDContext()
+	} else if this.IsActivityStreamsGroup() {
+		child = this.GetActivityStreamsGroup().JSONLDContext()
+	} else if this.IsVcardHome() {
+		child = this.GetVcardHome().JSONLDContext()
+	} else if this.IsActivityStreamsIgnore() {
+		child = this.GetActivityStreamsIgnore().JSONLDContext()
+	} else if this.IsActivityStreamsImage() {
+		child = this.GetActivityStreamsImage().JSONLDContext()
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		child = this.GetActivityStreamsIntransitiveActivity().JSONLDContext()
+	} else if this.IsActivityStreamsInvite() {
+		child = this.GetActivityStreamsInvite().JSONLDContext()
+	} else if this.IsActivityStreamsJoin() {
+		child = this.GetActivityStreamsJoin().JSONLDContext()
+	} else if this.IsActivityStreamsLeave() {
+		child = this.GetActivityStreamsLeave().JSONLDContext()
+	} else if this.IsActivityStreamsLike() {
+		child = this.GetActivityStreamsLike().JSONLDContext()
+	} else if this.IsActivityStreamsListen() {
+		child = this.GetActivityStreamsListen().JSONLDContext()
+	} else if this.IsActivityStreamsMention() {
+		child = this.GetActivityStreamsMention().JSONLDContext()
+	} else if this.IsActivityStreamsMove() {
+		child = this.GetActivityStreamsMove().JSONLDContext()
+	} else if this.IsActivityStreamsNote() {
+		child = this.GetActivityStreamsNote().JSONLDContext()
+	} else if this.IsActivityStreamsOffer() {
+		child = this.GetActivityStreamsOffer().JSONLDContext()
+	} else if this.IsActivityStreamsOrderedCollection() {
+		child = this.GetActivityStreamsOrderedCollection().JSONLDContext()
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		child = this.GetActivityStreamsOrderedCollectionPage().JSONLDContext()
+	} else if this.IsActivityStreamsOrganization() {
+		child = this.GetActivityStreamsOrganization().JSONLDContext()
+	} else if this.IsActivityStreamsPage() {
+		child = this.GetActivityStreamsPage().JSONLDContext()
+	} else if this.IsActivityStreamsPerson() {
+		child = this.GetActivityStreamsPerson().JSONLDContext()
+	} else if this.IsActivityStreamsPlace() {
+		child = this.GetActivityStreamsPlace().JSONLDContext()
+	} else if this.IsActivityStreamsProfile() {
+		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsSecurityV1PublicKey() {
+		child = this.GetSecurityV1PublicKey().JSONLDContext()
+	} else if this.IsForgeFedPush() {
+		child = this.GetForgeFedPush().JSONLDContext()
+	} else if this.IsActivityStreamsQuestion() {
+		child = this.GetActivityStreamsQuestion().JSONLDContext()
+	} else if this.IsActivityStreamsRead() {
+		child = this.GetActivityStreamsRead().JSONLDContext()
+	} else if this.IsActivityStreamsReject() {
+		child = this.GetActivityStreamsReject().JSONLDContext()
+	} else if this.IsActivityStreamsRelationship() {
+		child = this.GetActivityStreamsRelationship().JSONLDContext()
+	} else if this.IsActivityStreamsRemove() {
+		child = this.GetActivityStreamsRemove().JSONLDContext()
+	} else if this.IsForgeFedRepository() {
+		child = this.GetForgeFedRepository().JSONLDContext()
+	} else if this.IsActivityStreamsService() {
+		child = this.GetActivityStreamsService().JSONLDContext()
+	} else if this.IsActivityStreamsTentativeAccept() {
+		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
+	} else if this.IsActivityStreamsTentativeReject() {
+		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
+	} else if this.IsForgeFedTicket() {
+		child = this.GetForgeFedTicket().JSONLDContext()
+	} else if this.IsForgeFedTicketDependency() {
+		child = this.GetForgeFedTicketDependency().JSONLDContext()
+	} else if this.IsActivityStreamsTombstone() {
+		child = this.GetActivityStreamsTombstone().JSONLDContext()
+	} else if this.IsActivityStreamsTravel() {
+		child = this.GetActivityStreamsTravel().JSONLDContext()
+	} else if this.IsActivityStreamsUndo() {
+		child = this.GetActivityStreamsUndo().JSONLDContext()
+	} else if this.IsActivityStreamsUpdate() {
+		child = this.GetActivityStreamsUpdate().JSONLDContext()
+	} else if this.IsActivityStreamsVideo() {
+		child = this.GetActivityStreamsVideo().JSONLDContext()
+	} else if this.IsActivityStreamsView() {
+		child = this.GetActivityStreamsView().JSONLDContext()
+	}
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this ForgeFedEarlyItemsPropertyIterator) KindIndex() int {
+	if this.IsActivityStreamsObject() {
+		return 0
+	}
+	if this.IsActivityStreamsLink() {
+		return 1
+	}
+	if this.IsActivityStreamsAccept() {
+		return 2
+	}
+	if this.IsActivityStreamsActivity() {
+		return 3
+	}
+	if this.IsActivityStreamsAdd() {
+		return 4
+	}
+	if this.IsVcardAddress() {
+		return 5
+	}
+	if this.IsActivityStreamsAnnounce() {
+		return 6
+	}
+	if this.IsActivityStreamsApplication() {
+		return 7
+	}
+	if this.IsActivityStreamsArrive() {
+		return 8
+	}
+	if this.IsActivityStreamsArticle() {
+		return 9
+	}
+	if this.IsActivityStreamsAudio() {
+		return 10
+	}
+	if this.IsActivityStreamsBlock() {
+		return 11
+	}
+	if this.IsForgeFedBranch() {
+		return 12
+	}
+	if this.IsActivityStreamsCollection() {
+		return 13
+	}
+	if this.IsActivityStreamsCollectionPage() {
+		return 14
+	}
+	if this.IsForgeFedCommit() {
+		return 15
+	}
+	if this.IsActivityStreamsCreate() {
+		return 16
+	}
+	if this.IsActivityStreamsDelete() {
+		return 17
+	}
+	if this.IsActivityStreamsDislike() {
+		return 18
+	}
+	if this.IsActivityStreamsDocument() {
+		return 19
+	}
+	if this.IsTootEmoji() {
+		return 20
+	}
+	if this.IsActivityStreamsEvent() {
+		return 21
+	}
+	if this.IsActivityStreamsFlag() {
+		return 22
+	}
+	if this.IsActivityStreamsFollow() {
+		return 23
+	}
+	if this.IsActivityStreamsGroup() {
+		return 24
+	}
+	if this.IsVcardHome() {
+		return 25
+	}
+	if this.IsActivityStreamsIgnore() {
+		return 26
+	}
+	if this.IsActivityStreamsImage() {
+		return 27
+	}
+	if this.IsActivityStreamsIntransitiveActivity() {
+		return 28
+	}
+	if this.IsActivityStreamsInvite() {
+		return 29
+	}
+	if this.IsActivityStreamsJoin() {
+		return 30
+	}
+	if this.IsActivityStreamsLeave() {
+		return 31
+	}
+	if this.IsActivityStreamsLike() {
+		return 32
+	}
+	if this.IsActivityStreamsListen() {
+		return 33
+	}
+	if this.IsActivityStreamsMention() {
+		return 34
+	}
+	if this.IsActivityStreamsMove() {
+		return 35
+	}
+	if this.IsActivityStreamsNote() {
+		return 36
+	}
+	if this.IsActivityStreamsOffer() {
+		return 37
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return 38
+	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return 39
+	}
+	if this.IsActivityStreamsOrganization() {
+		return 40
+	}
+	if this.IsActivityStreamsPage() {
+		return 41
+	}
+	if this.IsActivityStreamsPerson() {
+		return 42
+	}
+	if this.IsActivityStreamsPlace() {
+		return 43
+	}
+	if this.IsActivityStreamsProfile() {
+		return 44
+	}
+	if this.IsSecurityV1PublicKey() {
+		return 45
+	}
+	if this.IsForgeFedPush() {
+		return 46
+	}
+	if this.IsActivityStreamsQuestion() {
+		return 47
+	}
+	if this.IsActivityStreamsRead() {
+		return 48
+	}
+	if this.IsActivityStreamsReject() {
+		return 49
+	}
+	if this.IsActivityStreamsRelationship() {
+		return 50
+	}
+	if this.IsActivityStreamsRemove() {
+		return 51
+	}
+	if this.IsForgeFedRepository() {
+		return 52
+	}
+	if this.IsActivityStreamsService() {
+		return 53
+	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return 54
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return 55
+	}
+	if this.IsForgeFedTicket() {
+		return 56
+	}
+	if this.IsForgeFedTicketDependency() {
+		return 57
+	}
+	if this.IsActivityStreamsTombstone() {
+		return 58
+	}
+	if this.IsActivityStreamsTravel() {
+		return 59
+	}
+	if this.IsActivityStreamsUndo() {
+		return 60
+	}
+	if this.IsActivityStreamsUpdate() {
+		return 61
+	}
+	if this.IsActivityStreamsVideo() {
+		return 62
+	}
+	if this.IsActivityStreamsView() {
+		return 63
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ForgeFedEarlyItemsPropertyIterator) LessThan(o vocab.ForgeFedEarlyItemsPropertyIterator) bool {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return true
+	} else if idx1 > idx2 {
+		return false
+	} else if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().LessThan(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().LessThan(o.GetActivityStreamsLink())
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().LessThan(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().LessThan(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().LessThan(o.GetActivityStreamsAdd())
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().LessThan(o.GetVcardAddress())
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().LessThan(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().LessThan(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().LessThan(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().LessThan(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().LessThan(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().LessThan(o.GetActivityStreamsBlock())
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().LessThan(o.GetForgeFedBranch())
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().LessThan(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().LessThan(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().LessThan(o.GetVcardHome())
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().LessThan(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().LessThan(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().LessThan(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().LessThan(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().LessThan(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().LessThan(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().LessThan(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().LessThan(o.GetActivityStreamsMention())
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().LessThan(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().LessThan(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().LessThan(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().LessThan(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().LessThan(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().LessThan(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().LessThan(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().LessThan(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey().LessThan(o.GetSecurityV1PublicKey())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().LessThan(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().LessThan(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().LessThan(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().LessThan(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().LessThan(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().LessThan(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().LessThan(o.GetActivityStreamsView())
+	} else if this.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	}
+	return false
+}
+
+// Name returns the name of this property: "ForgeFedEarlyItems", prefixed by the
+// alias of its vocabulary if it has one.
+func (this ForgeFedEarlyItemsPropertyIterator) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":ForgeFedEarlyItems"
+	} else {
+		return "ForgeFedEarlyItems"
+	}
+}
+
+// Next returns the next iterator, or nil if there is no next iterator.
+func (this ForgeFedEarlyItemsPropertyIterator) Next() vocab.ForgeFedEarlyItemsPropertyIterator {
+	if this.myIdx+1 >= this.parent.Len() {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx + 1)
+	}
+}
+
+// Prev returns the previous iterator, or nil if there is no previous iterator.
+func (this ForgeFedEarlyItemsPropertyIterator) Prev() vocab.ForgeFedEarlyItemsPropertyIterator {
+	if this.myIdx-1 < 0 {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx - 1)
+	}
+}
+
+// SetActivityStreamsAccept sets the value of this property. Calling
+// IsActivityStreamsAccept afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
+	this.clear()
+	this.activitystreamsAcceptMember = v
+}
+
+// SetActivityStreamsActivity sets the value of this property. Calling
+// IsActivityStreamsActivity afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
+	this.clear()
+	this.activitystreamsActivityMember = v
+}
+
+// SetActivityStreamsAdd sets the value of this property. Calling
+// IsActivityStreamsAdd afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
+	this.clear()
+	this.activitystreamsAddMember = v
+}
+
+// SetActivityStreamsAnnounce sets the value of this property. Calling
+// IsActivityStreamsAnnounce afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
+	this.clear()
+	this.activitystreamsAnnounceMember = v
+}
+
+// SetActivityStreamsApplication sets the value of this property. Calling
+// IsActivityStreamsApplication afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.clear()
+	this.activitystreamsApplicationMember = v
+}
+
+// SetActivityStreamsArrive sets the value of this property. Calling
+// IsActivityStreamsArrive afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
+	this.clear()
+	this.activitystreamsArriveMember = v
+}
+
+// SetActivityStreamsArticle sets the value of this property. Calling
+// IsActivityStreamsArticle afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
+	this.clear()
+	this.activitystreamsArticleMember = v
+}
+
+// SetActivityStreamsAudio sets the value of this property. Calling
+// IsActivityStreamsAudio afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
+	this.clear()
+	this.activitystreamsAudioMember = v
+}
+
+// SetActivityStreamsBlock sets the value of this property. Calling
+// IsActivityStreamsBlock afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
+	this.clear()
+	this.activitystreamsBlockMember = v
+}
+
+// SetActivityStreamsCollection sets the value of this property. Calling
+// IsActivityStreamsCollection afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
+	this.clear()
+	this.activitystreamsCollectionMember = v
+}
+
+// SetActivityStreamsCollectionPage sets the value of this property. Calling
+// IsActivityStreamsCollectionPage afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
+	this.clear()
+	this.activitystreamsCollectionPageMember = v
+}
+
+// SetActivityStreamsCreate sets the value of this property. Calling
+// IsActivityStreamsCreate afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
+	this.clear()
+	this.activitystreamsCreateMember = v
+}
+
+// SetActivityStreamsDelete sets the value of this property. Calling
+// IsActivityStreamsDelete afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
+	this.clear()
+	this.activitystreamsDeleteMember = v
+}
+
+// SetActivityStreamsDislike sets the value of this property. Calling
+// IsActivityStreamsDislike afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
+	this.clear()
+	this.activitystreamsDislikeMember = v
+}
+
+// SetActivityStreamsDocument sets the value of this property. Calling
+// IsActivityStreamsDocument afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
+	this.clear()
+	this.activitystreamsDocumentMember = v
+}
+
+// SetActivityStreamsEvent sets the value of this property. Calling
+// IsActivityStreamsEvent afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
+	this.clear()
+	this.activitystreamsEventMember = v
+}
+
+// SetActivityStreamsFlag sets the value of this property. Calling
+// IsActivityStreamsFlag afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
+	this.clear()
+	this.activitystreamsFlagMember = v
+}
+
+// SetActivityStreamsFollow sets the value of this property. Calling
+// IsActivityStreamsFollow afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
+	this.clear()
+	this.activitystreamsFollowMember = v
+}
+
+// SetActivityStreamsGroup sets the value of this property. Calling
+// IsActivityStreamsGroup afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.clear()
+	this.activitystreamsGroupMember = v
+}
+
+// SetActivityStreamsIgnore sets the value of this property. Calling
+// IsActivityStreamsIgnore afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
+	this.clear()
+	this.activitystreamsIgnoreMember = v
+}
+
+// SetActivityStreamsImage sets the value of this property. Calling
+// IsActivityStreamsImage afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsImage(v vocab.ActivityStreamsImage) {
+	this.clear()
+	this.activitystreamsImageMember = v
+}
+
+// SetActivityStreamsIntransitiveActivity sets the value of this property. Calling
+// IsActivityStreamsIntransitiveActivity afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
+	this.clear()
+	this.activitystreamsIntransitiveActivityMember = v
+}
+
+// SetActivityStreamsInvite sets the value of this property. Calling
+// IsActivityStreamsInvite afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
+	this.clear()
+	this.activitystreamsInviteMember = v
+}
+
+// SetActivityStreamsJoin sets the value of this property. Calling
+// IsActivityStreamsJoin afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
+	this.clear()
+	this.activitystreamsJoinMember = v
+}
+
+// SetActivityStreamsLeave sets the value of this property. Calling
+// IsActivityStreamsLeave afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
+	this.clear()
+	this.activitystreamsLeaveMember = v
+}
+
+// SetActivityStreamsLike sets the value of this property. Calling
+// IsActivityStreamsLike afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsLike(v vocab.ActivityStreamsLike) {
+	this.clear()
+	this.activitystreamsLikeMember = v
+}
+
+// SetActivityStreamsLink sets the value of this property. Calling
+// IsActivityStreamsLink afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsLink(v vocab.ActivityStreamsLink) {
+	this.clear()
+	this.activitystreamsLinkMember = v
+}
+
+// SetActivityStreamsListen sets the value of this property. Calling
+// IsActivityStreamsListen afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsListen(v vocab.ActivityStreamsListen) {
+	this.clear()
+	this.activitystreamsListenMember = v
+}
+
+// SetActivityStreamsMention sets the value of this property. Calling
+// IsActivityStreamsMention afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsMention(v vocab.ActivityStreamsMention) {
+	this.clear()
+	this.activitystreamsMentionMember = v
+}
+
+// SetActivityStreamsMove sets the value of this property. Calling
+// IsActivityStreamsMove afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsMove(v vocab.ActivityStreamsMove) {
+	this.clear()
+	this.activitystreamsMoveMember = v
+}
+
+// SetActivityStreamsNote sets the value of this property. Calling
+// IsActivityStreamsNote afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsNote(v vocab.ActivityStreamsNote) {
+	this.clear()
+	this.activitystreamsNoteMember = v
+}
+
+// SetActivityStreamsObject sets the value of this property. Calling
+// IsActivityStreamsObject afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsObject(v vocab.ActivityStreamsObject) {
+	this.clear()
+	this.activitystreamsObjectMember = v
+}
+
+// SetActivityStreamsOffer sets the value of this property. Calling
+// IsActivityStreamsOffer afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
+	this.clear()
+	this.activitystreamsOfferMember = v
+}
+
+// SetActivityStreamsOrderedCollection sets the value of this property. Calling
+// IsActivityStreamsOrderedCollection afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
+	this.clear()
+	this.activitystreamsOrderedCollectionMember = v
+}
+
+// SetActivityStreamsOrderedCollectionPage sets the value of this property.
+// Calling IsActivityStreamsOrderedCollectionPage afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
+	this.clear()
+	this.activitystreamsOrderedCollectionPageMember = v
+}
+
+// SetActivityStreamsOrganization sets the value of this property. Calling
+// IsActivityStreamsOrganization afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.clear()
+	this.activitystreamsOrganizationMember = v
+}
+
+// SetActivityStreamsPage sets the value of this property. Calling
+// IsActivityStreamsPage afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsPage(v vocab.ActivityStreamsPage) {
+	this.clear()
+	this.activitystreamsPageMember = v
+}
+
+// SetActivityStreamsPerson sets the value of this property. Calling
+// IsActivityStreamsPerson afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.clear()
+	this.activitystreamsPersonMember = v
+}
+
+// SetActivityStreamsPlace sets the value of this property. Calling
+// IsActivityStreamsPlace afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
+	this.clear()
+	this.activitystreamsPlaceMember = v
+}
+
+// SetActivityStreamsProfile sets the value of this property. Calling
+// IsActivityStreamsProfile afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
+	this.clear()
+	this.activitystreamsProfileMember = v
+}
+
+// SetActivityStreamsQuestion sets the value of this property. Calling
+// IsActivityStreamsQuestion afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
+	this.clear()
+	this.activitystreamsQuestionMember = v
+}
+
+// SetActivityStreamsRead sets the value of this property. Calling
+// IsActivityStreamsRead afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsRead(v vocab.ActivityStreamsRead) {
+	this.clear()
+	this.activitystreamsReadMember = v
+}
+
+// SetActivityStreamsReject sets the value of this property. Calling
+// IsActivityStreamsReject afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsReject(v vocab.ActivityStreamsReject) {
+	this.clear()
+	this.activitystreamsRejectMember = v
+}
+
+// SetActivityStreamsRelationship sets the value of this property. Calling
+// IsActivityStreamsRelationship afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
+	this.clear()
+	this.activitystreamsRelationshipMember = v
+}
+
+// SetActivityStreamsRemove sets the value of this property. Calling
+// IsActivityStreamsRemove afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
+	this.clear()
+	this.activitystreamsRemoveMember = v
+}
+
+// SetActivityStreamsService sets the value of this property. Calling
+// IsActivityStreamsService afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.clear()
+	this.activitystreamsServiceMember = v
+}
+
+// SetActivityStreamsTentativeAccept sets the value of this property. Calling
+// IsActivityStreamsTentativeAccept afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
+	this.clear()
+	this.activitystreamsTentativeAcceptMember = v
+}
+
+// SetActivityStreamsTentativeReject sets the value of this property. Calling
+// IsActivityStreamsTentativeReject afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
+	this.clear()
+	this.activitystreamsTentativeRejectMember = v
+}
+
+// SetActivityStreamsTombstone sets the value of this property. Calling
+// IsActivityStreamsTombstone afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
+	this.clear()
+	this.activitystreamsTombstoneMember = v
+}
+
+// SetActivityStreamsTravel sets the value of this property. Calling
+// IsActivityStreamsTravel afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
+	this.clear()
+	this.activitystreamsTravelMember = v
+}
+
+// SetActivityStreamsUndo sets the value of this property. Calling
+// IsActivityStreamsUndo afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
+	this.clear()
+	this.activitystreamsUndoMember = v
+}
+
+// SetActivityStreamsUpdate sets the value of this property. Calling
+// IsActivityStreamsUpdate afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
+	this.clear()
+	this.activitystreamsUpdateMember = v
+}
+
+// SetActivityStreamsVideo sets the value of this property. Calling
+// IsActivityStreamsVideo afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
+	this.clear()
+	this.activitystreamsVideoMember = v
+}
+
+// SetActivityStreamsView sets the value of this property. Calling
+// IsActivityStreamsView afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetActivityStreamsView(v vocab.ActivityStreamsView) {
+	this.clear()
+	this.activitystreamsViewMember = v
+}
+
+// SetForgeFedBranch sets the value of this property. Calling IsForgeFedBranch
+// afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetForgeFedBranch(v vocab.ForgeFedBranch) {
+	this.clear()
+	this.forgefedBranchMember = v
+}
+
+// SetForgeFedCommit sets the value of this property. Calling IsForgeFedCommit
+// afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.clear()
+	this.forgefedCommitMember = v
+}
+
+// SetForgeFedPush sets the value of this property. Calling IsForgeFedPush
+// afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetForgeFedPush(v vocab.ForgeFedPush) {
+	this.clear()
+	this.forgefedPushMember = v
+}
+
+// SetForgeFedRepository sets the value of this property. Calling
+// IsForgeFedRepository afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.clear()
+	this.forgefedRepositoryMember = v
+}
+
+// SetForgeFedTicket sets the value of this property. Calling IsForgeFedTicket
+// afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.clear()
+	this.forgefedTicketMember = v
+}
+
+// SetForgeFedTicketDependency sets the value of this property. Calling
+// IsForgeFedTicketDependency afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.clear()
+	this.forgefedTicketDependencyMember = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetIRI(v *url.URL) {
+	this.clear()
+	this.iri = v
+}
+
+// SetSecurityV1PublicKey sets the value of this property. Calling
+// IsSecurityV1PublicKey afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.clear()
+	this.securityv1PublicKeyMember = v
+}
+
+// SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
+// returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetTootEmoji(v vocab.TootEmoji) {
+	this.clear()
+	this.tootEmojiMember = v
+}
+
+// SetType attempts to set the property for the arbitrary type. Returns an error
+// if it is not a valid type to set on this property.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetType(t vocab.Type) error {
+	if v, ok := t.(vocab.ActivityStreamsObject); ok {
+		this.SetActivityStreamsObject(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsLink); ok {
+		this.SetActivityStreamsLink(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAccept); ok {
+		this.SetActivityStreamsAccept(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsActivity); ok {
+		this.SetActivityStreamsActivity(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAdd); ok {
+		this.SetActivityStreamsAdd(v)
+		return nil
+	}
+	if v, ok := t.(vocab.VcardAddress); ok {
+		this.SetVcardAddress(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAnnounce); ok {
+		this.SetActivityStreamsAnnounce(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsApplication); ok {
+		this.SetActivityStreamsApplication(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsArrive); ok {
+		this.SetActivityStreamsArrive(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsArticle); ok {
+		this.SetActivityStreamsArticle(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsAudio); ok {
+		this.SetActivityStreamsAudio(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsBlock); ok {
+		this.SetActivityStreamsBlock(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedBranch); ok {
+		this.SetForgeFedBranch(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsCollection); ok {
+		this.SetActivityStreamsCollection(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsCollectionPage); ok {
+		this.SetActivityStreamsCollectionPage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedCommit); ok {
+		this.SetForgeFedCommit(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsCreate); ok {
+		this.SetActivityStreamsCreate(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsDelete); ok {
+		this.SetActivityStreamsDelete(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsDislike); ok {
+		this.SetActivityStreamsDislike(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsDocument); ok {
+		this.SetActivityStreamsDocument(v)
+		return nil
+	}
+	if v, ok := t.(vocab.TootEmoji); ok {
+		this.SetTootEmoji(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
+		this.SetActivityStreamsEvent(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsFlag); ok {
+		this.SetActivityStreamsFlag(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsFollow); ok {
+		this.SetActivityStreamsFollow(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsGroup); ok {
+		this.SetActivityStreamsGroup(v)
+		return nil
+	}
+	if v, ok := t.(vocab.VcardHome); ok {
+		this.SetVcardHome(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsIgnore); ok {
+		this.SetActivityStreamsIgnore(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsImage); ok {
+		this.SetActivityStreamsImage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsIntransitiveActivity); ok {
+		this.SetActivityStreamsIntransitiveActivity(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsInvite); ok {
+		this.SetActivityStreamsInvite(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsJoin); ok {
+		this.SetActivityStreamsJoin(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsLeave); ok {
+		this.SetActivityStreamsLeave(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsLike); ok {
+		this.SetActivityStreamsLike(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsListen); ok {
+		this.SetActivityStreamsListen(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsMention); ok {
+		this.SetActivityStreamsMention(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsMove); ok {
+		this.SetActivityStreamsMove(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsNote); ok {
+		this.SetActivityStreamsNote(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOffer); ok {
+		this.SetActivityStreamsOffer(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrderedCollection); ok {
+		this.SetActivityStreamsOrderedCollection(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrderedCollectionPage); ok {
+		this.SetActivityStreamsOrderedCollectionPage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrganization); ok {
+		this.SetActivityStreamsOrganization(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPage); ok {
+		this.SetActivityStreamsPage(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPerson); ok {
+		this.SetActivityStreamsPerson(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPlace); ok {
+		this.SetActivityStreamsPlace(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsProfile); ok {
+		this.SetActivityStreamsProfile(v)
+		return nil
+	}
+	if v, ok := t.(vocab.SecurityV1PublicKey); ok {
+		this.SetSecurityV1PublicKey(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedPush); ok {
+		this.SetForgeFedPush(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
+		this.SetActivityStreamsQuestion(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsRead); ok {
+		this.SetActivityStreamsRead(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsReject); ok {
+		this.SetActivityStreamsReject(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsRelationship); ok {
+		this.SetActivityStreamsRelationship(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsRemove); ok {
+		this.SetActivityStreamsRemove(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedRepository); ok {
+		this.SetForgeFedRepository(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsService); ok {
+		this.SetActivityStreamsService(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTentativeAccept); ok {
+		this.SetActivityStreamsTentativeAccept(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTentativeReject); ok {
+		this.SetActivityStreamsTentativeReject(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedTicket); ok {
+		this.SetForgeFedTicket(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedTicketDependency); ok {
+		this.SetForgeFedTicketDependency(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTombstone); ok {
+		this.SetActivityStreamsTombstone(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsTravel); ok {
+		this.SetActivityStreamsTravel(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsUndo); ok {
+		this.SetActivityStreamsUndo(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsUpdate); ok {
+		this.SetActivityStreamsUpdate(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsVideo); ok {
+		this.SetActivityStreamsVideo(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsView); ok {
+		this.SetActivityStreamsView(v)
+		return nil
+	}
+
+	return fmt.Errorf("illegal type to set on ForgeFedEarlyItems property: %T", t)
+}
+
+// SetVcardAddress sets the value of this property. Calling IsVcardAddress
+// afterwards returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetVcardAddress(v vocab.VcardAddress) {
+	this.clear()
+	this.vcardAddressMember = v
+}
+
+// SetVcardHome sets the value of this property. Calling IsVcardHome afterwards
+// returns true.
+func (this *ForgeFedEarlyItemsPropertyIterator) SetVcardHome(v vocab.VcardHome) {
+	this.clear()
+	this.vcardHomeMember = v
+}
+
+// clear ensures no value of this property is set. Calling HasAny or any of the
+// 'Is' methods afterwards will return false.
+func (this *ForgeFedEarlyItemsPropertyIterator) clear() {
+	this.activitystreamsObjectMember = nil
+	this.activitystreamsLinkMember = nil
+	this.activitystreamsAcceptMember = nil
+	this.activitystreamsActivityMember = nil
+	this.activitystreamsAddMember = nil
+	this.vcardAddressMember = nil
+	this.activitystreamsAnnounceMember = nil
+	this.activitystreamsApplicationMember = nil
+	this.activitystreamsArriveMember = nil
+	this.activitystreamsArticleMember = nil
+	this.activitystreamsAudioMember = nil
+	this.activitystreamsBlockMember = nil
+	this.forgefedBranchMember = nil
+	this.activitystreamsCollectionMember = nil
+	this.activitystreamsCollectionPageMember = nil
+	this.forgefedCommitMember = nil
+	this.activitystreamsCreateMember = nil
+	this.activitystreamsDeleteMember = nil
+	this.activitystreamsDislikeMember = nil
+	this.activitystreamsDocumentMember = nil
+	this.tootEmojiMember = nil
+	this.activitystreamsEventMember = nil
+	this.activitystreamsFlagMember = nil
+	this.activitystreamsFollowMember = nil
+	this.activitystreamsGroupMember = nil
+	this.vcardHomeMember = nil
+	this.activitystreamsIgnoreMember = nil
+	this.activitystreamsImageMember = nil
+	this.activitystreamsIntransitiveActivityMember = nil
+	this.activitystreamsInviteMember = nil
+	this.activitystreamsJoinMember = nil
+	this.activitystreamsLeaveMember = nil
+	this.activitystreamsLikeMember = nil
+	this.activitystreamsListenMember = nil
+	this.activitystreamsMentionMember = nil
+	this.activitystreamsMoveMember = nil
+	this.activitystreamsNoteMember = nil
+	this.activitystreamsOfferMember = nil
+	this.activitystreamsOrderedCollectionMember = nil
+	this.activitystreamsOrderedCollectionPageMember = nil
+	this.activitystreamsOrganizationMember = nil
+	this.activitystreamsPageMember = nil
+	this.activitystreamsPersonMember = nil
+	this.activitystreamsPlaceMember = nil
+	this.activitystreamsProfileMember = nil
+	this.securityv1PublicKeyMember = nil
+	this.forgefedPushMember = nil
+	this.activitystreamsQuestionMember = nil
+	this.activitystreamsReadMember = nil
+	this.activitystreamsRejectMember = nil
+	this.activitystreamsRelationshipMember = nil
+	this.activitystreamsRemoveMember = nil
+	this.forgefedRepositoryMember = nil
+	this.activitystreamsServiceMember = nil
+	this.activitystreamsTentativeAcceptMember = nil
+	this.activitystreamsTentativeRejectMember = nil
+	this.forgefedTicketMember = nil
+	this.forgefedTicketDependencyMember = nil
+	this.activitystreamsTombstoneMember = nil
+	this.activitystreamsTravelMember = nil
+	this.activitystreamsUndoMember = nil
+	this.activitystreamsUpdateMember = nil
+	this.activitystreamsVideoMember = nil
+	this.activitystreamsViewMember = nil
+	this.unknown = nil
+	this.iri = nil
+}
+
+// serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ForgeFedEarlyItemsPropertyIterator) serialize() (interface{}, error) {
+	if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Serialize()
+	} else if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().Serialize()
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Serialize()
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Serialize()
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Serialize()
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().Serialize()
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Serialize()
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Serialize()
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Serialize()
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Serialize()
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Serialize()
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Serialize()
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().Serialize()
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Serialize()
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Serialize()
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Serialize()
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Serialize()
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Serialize()
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Serialize()
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Serialize()
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Serialize()
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Serialize()
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Serialize()
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().Serialize()
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Serialize()
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Serialize()
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Serialize()
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Serialize()
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Serialize()
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Serialize()
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Serialize()
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Serialize()
+	} else if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().Serialize()
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Serialize()
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Serialize()
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Serialize()
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Serialize()
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Serialize()
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Serialize()
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Serialize()
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Serialize()
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Serialize()
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSecurityV1PublicKey() {
+		return this.GetSecurityV1PublicKey().Serialize()
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Serialize()
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Serialize()
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Serialize()
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Serialize()
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Serialize()
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Serialize()
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Serialize()
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Serialize()
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Serialize()
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Serialize()
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Serialize()
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Serialize()
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Serialize()
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Serialize()
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Serialize()
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Serialize()
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Serialize()
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// ForgeFedEarlyItemsProperty is the non-functional property "earlyItems". It is
+// permitted to have one or more values, and of different value types.
+type ForgeFedEarlyItemsProperty struct {
+	properties []*ForgeFedEarlyItemsPropertyIterator
+	alias      string
+}
+
+// DeserializeEarlyItemsProperty creates a "earlyItems" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeEarlyItemsProperty(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+	}
+	propName := "earlyItems"
+	if len(alias) > 0 {
+		propName = fmt.Sprintf("%s:%s", alias, "earlyItems")
+	}
+	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["earlyItems"]
+	}
+
+	if ok {
+		this := &ForgeFedEarlyItemsProperty{
+			alias:      alias,
+			properties: []*ForgeFedEarlyItemsPropertyIterator{},
+		}
+		if list, ok := i.([]interface{}); ok {
+			for _, iterator := range list {
+				if p, err := deserializeForgeFedEarlyItemsPropertyIterator(iterator, aliasMap); err != nil {
+					return this, err
+				} else if p != nil {
+					this.properties = append(this.properties, p)
+				}
+			}
+		} else {
+			if p, err := deserializeForgeFedEarlyItemsPropertyIterator(i, aliasMap); err != nil {
+				return this, err
+			} else if p != nil {
+				this.properties = append(this.properties, p)
+			}
+		}
+		// Set up the properties for iteration.
+		for idx, ele := range this.properties {
+			ele.parent = this
+			ele.myIdx = idx
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewForgeFedEarlyItemsProperty creates a new earlyItems property.
+func NewForgeFedEarlyItemsProperty() *ForgeFedEarlyItemsProperty {
+	return &ForgeFedEarlyItemsProperty{alias: "ff"}
+}
+
+// AppendActivityStreamsAccept appends a Accept value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAcceptMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsActivity appends a Activity value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsActivityMember: v,
+		alias:                         this.alias,
+		myIdx:                         this.Len(),
+		parent:                        this,
+	})
+}
+
+// AppendActivityStreamsAdd appends a Add value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAddMember: v,
+		alias:                    this.alias,
+		myIdx:                    this.Len(),
+		parent:                   this,
+	})
+}
+
+// AppendActivityStreamsAnnounce appends a Announce value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAnnounceMember: v,
+		alias:                         this.alias,
+		myIdx:                         this.Len(),
+		parent:                        this,
+	})
+}
+
+// AppendActivityStreamsApplication appends a Application value to the back of a
+// list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+		myIdx:                            this.Len(),
+		parent:                           this,
+	})
+}
+
+// AppendActivityStreamsArrive appends a Arrive value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsArriveMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsArticle appends a Article value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsArticleMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
+// AppendActivityStreamsAudio appends a Audio value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAudioMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsBlock appends a Block value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsBlockMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsCollection appends a Collection value to the back of a
+// list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCollectionMember: v,
+		alias:                           this.alias,
+		myIdx:                           this.Len(),
+		parent:                          this,
+	})
+}
+
+// AppendActivityStreamsCollectionPage appends a CollectionPage value to the back
+// of a list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCollectionPageMember: v,
+		alias:                               this.alias,
+		myIdx:                               this.Len(),
+		parent:                              this,
+	})
+}
+
+// AppendActivityStreamsCreate appends a Create value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCreateMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsDelete appends a Delete value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDeleteMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsDislike appends a Dislike value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDislikeMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
+// AppendActivityStreamsDocument appends a Document value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDocumentMember: v,
+		alias:                         this.alias,
+		myIdx:                         this.Len(),
+		parent:                        this,
+	})
+}
+
+// AppendActivityStreamsEvent appends a Event value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsEventMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsFlag appends a Flag value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsFlagMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsFollow appends a Follow value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsFollowMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsGroup appends a Group value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsIgnore appends a Ignore value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsIgnoreMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsImage appends a Image value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsImage(v vocab.ActivityStreamsImage) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsImageMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsIntransitiveActivity appends a IntransitiveActivity value
+// to the back of a list of the property "earlyItems". Invalidates iterators
+// that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsIntransitiveActivityMember: v,
+		alias:  this.alias,
+		myIdx:  this.Len(),
+		parent: this,
+	})
+}
+
+// AppendActivityStreamsInvite appends a Invite value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsInviteMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsJoin appends a Join value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsJoinMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsLeave appends a Leave value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLeaveMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsLike appends a Like value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsLike(v vocab.ActivityStreamsLike) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLikeMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsLink appends a Link value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsLink(v vocab.ActivityStreamsLink) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLinkMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsListen appends a Listen value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsListen(v vocab.ActivityStreamsListen) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsListenMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsMention appends a Mention value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsMention(v vocab.ActivityStreamsMention) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsMentionMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
+// AppendActivityStreamsMove appends a Move value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsMove(v vocab.ActivityStreamsMove) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsMoveMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsNote appends a Note value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsNote(v vocab.ActivityStreamsNote) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsNoteMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsObject appends a Object value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsObject(v vocab.ActivityStreamsObject) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsObjectMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsOffer appends a Offer value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOfferMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsOrderedCollection appends a OrderedCollection value to the
+// back of a list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrderedCollectionMember: v,
+		alias:                                  this.alias,
+		myIdx:                                  this.Len(),
+		parent:                                 this,
+	})
+}
+
+// AppendActivityStreamsOrderedCollectionPage appends a OrderedCollectionPage
+// value to the back of a list of the property "earlyItems". Invalidates
+// iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrderedCollectionPageMember: v,
+		alias:  this.alias,
+		myIdx:  this.Len(),
+		parent: this,
+	})
+}
+
+// AppendActivityStreamsOrganization appends a Organization value to the back of a
+// list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+		myIdx:                             this.Len(),
+		parent:                            this,
+	})
+}
+
+// AppendActivityStreamsPage appends a Page value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsPage(v vocab.ActivityStreamsPage) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPageMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsPerson appends a Person value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsPlace appends a Place value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPlaceMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsProfile appends a Profile value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsProfileMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
+// AppendActivityStreamsQuestion appends a Question value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsQuestionMember: v,
+		alias:                         this.alias,
+		myIdx:                         this.Len(),
+		parent:                        this,
+	})
+}
+
+// AppendActivityStreamsRead appends a Read value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsRead(v vocab.ActivityStreamsRead) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsReadMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsReject appends a Reject value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsReject(v vocab.ActivityStreamsReject) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRejectMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsRelationship appends a Relationship value to the back of a
+// list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRelationshipMember: v,
+		alias:                             this.alias,
+		myIdx:                             this.Len(),
+		parent:                            this,
+	})
+}
+
+// AppendActivityStreamsRemove appends a Remove value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRemoveMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsService appends a Service value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
+// AppendActivityStreamsTentativeAccept appends a TentativeAccept value to the
+// back of a list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTentativeAcceptMember: v,
+		alias:                                this.alias,
+		myIdx:                                this.Len(),
+		parent:                               this,
+	})
+}
+
+// AppendActivityStreamsTentativeReject appends a TentativeReject value to the
+// back of a list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTentativeRejectMember: v,
+		alias:                                this.alias,
+		myIdx:                                this.Len(),
+		parent:                               this,
+	})
+}
+
+// AppendActivityStreamsTombstone appends a Tombstone value to the back of a list
+// of the property "earlyItems". Invalidates iterators that are traversing
+// using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTombstoneMember: v,
+		alias:                          this.alias,
+		myIdx:                          this.Len(),
+		parent:                         this,
+	})
+}
+
+// AppendActivityStreamsTravel appends a Travel value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTravelMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsUndo appends a Undo value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsUndoMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendActivityStreamsUpdate appends a Update value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsUpdateMember: v,
+		alias:                       this.alias,
+		myIdx:                       this.Len(),
+		parent:                      this,
+	})
+}
+
+// AppendActivityStreamsVideo appends a Video value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsVideoMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsView appends a View value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendActivityStreamsView(v vocab.ActivityStreamsView) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsViewMember: v,
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+	})
+}
+
+// AppendForgeFedBranch appends a Branch value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendForgeFedBranch(v vocab.ForgeFedBranch) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedCommit appends a Commit value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedPush appends a Push value to the back of a list of the property
+// "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendForgeFedPush(v vocab.ForgeFedPush) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              this.Len(),
+		parent:             this,
+	})
+}
+
+// AppendForgeFedRepository appends a Repository value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    this.Len(),
+		parent:                   this,
+	})
+}
+
+// AppendForgeFedTicket appends a Ticket value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedTicketDependency appends a TicketDependency value to the back of
+// a list of the property "earlyItems". Invalidates iterators that are
+// traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          this.Len(),
+		parent:                         this,
+	})
+}
+
+// AppendIRI appends an IRI value to the back of a list of the property
+// "earlyItems"
+func (this *ForgeFedEarlyItemsProperty) AppendIRI(v *url.URL) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  this.Len(),
+		parent: this,
+	})
+}
+
+// AppendSecurityV1PublicKey appends a PublicKey value to the back of a list of
+// the property "earlyItems". Invalidates iterators that are traversing using
+// Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	})
+}
+
+// AppendTootEmoji appends a Emoji value to the back of a list of the property
+// "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendTootEmoji(v vocab.TootEmoji) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:           this.alias,
+		myIdx:           this.Len(),
+		parent:          this,
+		tootEmojiMember: v,
+	})
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using
+// Prev. Returns an error if the type is not a valid one to set for this
+// property.
+func (this *ForgeFedEarlyItemsProperty) AppendType(t vocab.Type) error {
+	n := &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		myIdx:  this.Len(),
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append(this.properties, n)
+	return nil
+}
+
+// AppendVcardAddress appends a Address value to the back of a list of the
+// property "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendVcardAddress(v vocab.VcardAddress) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:              this.alias,
+		myIdx:              this.Len(),
+		parent:             this,
+		vcardAddressMember: v,
+	})
+}
+
+// AppendVcardHome appends a Home value to the back of a list of the property
+// "earlyItems". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedEarlyItemsProperty) AppendVcardHome(v vocab.VcardHome) {
+	this.properties = append(this.properties, &ForgeFedEarlyItemsPropertyIterator{
+		alias:           this.alias,
+		myIdx:           this.Len(),
+		parent:          this,
+		vcardHomeMember: v,
+	})
+}
+
+// At returns the property value for the specified index. Panics if the index is
+// out of bounds.
+func (this ForgeFedEarlyItemsProperty) At(index int) vocab.ForgeFedEarlyItemsPropertyIterator {
+	return this.properties[index]
+}
+
+// Begin returns the first iterator, or nil if empty. Can be used with the
+// iterator's Next method and this property's End method to iterate from front
+// to back through all values.
+func (this ForgeFedEarlyItemsProperty) Begin() vocab.ForgeFedEarlyItemsPropertyIterator {
+	if this.Empty() {
+		return nil
+	} else {
+		return this.properties[0]
+	}
+}
+
+// Empty returns returns true if there are no elements.
+func (this ForgeFedEarlyItemsProperty) Empty() bool {
+	return this.Len() == 0
+}
+
+// End returns beyond-the-last iterator, which is nil. Can be used with the
+// iterator's Next method and this property's Begin method to iterate from
+// front to back through all values.
+func (this ForgeFedEarlyItemsProperty) End() vocab.ForgeFedEarlyItemsPropertyIterator {
+	return nil
+}
+
+// InsertActivityStreamsAccept inserts a Accept value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsAccept(idx int, v vocab.ActivityStreamsAccept) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAcceptMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsActivity inserts a Activity value at the specified index
+// for a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsActivity(idx int, v vocab.ActivityStreamsActivity) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsActivityMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsAdd inserts a Add value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsAdd(idx int, v vocab.ActivityStreamsAdd) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAddMember: v,
+		alias:                    this.alias,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsAnnounce inserts a Announce value at the specified index
+// for a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsAnnounce(idx int, v vocab.ActivityStreamsAnnounce) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAnnounceMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsApplication inserts a Application value at the specified
+// index for a property "earlyItems". Existing elements at that index and
+// higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+		myIdx:                            idx,
+		parent:                           this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsArrive inserts a Arrive value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsArrive(idx int, v vocab.ActivityStreamsArrive) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsArriveMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsArticle inserts a Article value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsArticle(idx int, v vocab.ActivityStreamsArticle) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsArticleMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsAudio inserts a Audio value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsAudio(idx int, v vocab.ActivityStreamsAudio) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAudioMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsBlock inserts a Block value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsBlock(idx int, v vocab.ActivityStreamsBlock) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsBlockMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsCollection inserts a Collection value at the specified
+// index for a property "earlyItems". Existing elements at that index and
+// higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsCollection(idx int, v vocab.ActivityStreamsCollection) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCollectionMember: v,
+		alias:                           this.alias,
+		myIdx:                           idx,
+		parent:                          this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsCollectionPage inserts a CollectionPage value at the
+// specified index for a property "earlyItems". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsCollectionPage(idx int, v vocab.ActivityStreamsCollectionPage) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCollectionPageMember: v,
+		alias:                               this.alias,
+		myIdx:                               idx,
+		parent:                              this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsCreate inserts a Create value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsCreate(idx int, v vocab.ActivityStreamsCreate) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCreateMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsDelete inserts a Delete value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsDelete(idx int, v vocab.ActivityStreamsDelete) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDeleteMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsDislike inserts a Dislike value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsDislike(idx int, v vocab.ActivityStreamsDislike) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDislikeMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsDocument inserts a Document value at the specified index
+// for a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsDocument(idx int, v vocab.ActivityStreamsDocument) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDocumentMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsEvent inserts a Event value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsEvent(idx int, v vocab.ActivityStreamsEvent) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsEventMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsFlag inserts a Flag value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsFlag(idx int, v vocab.ActivityStreamsFlag) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsFlagMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsFollow inserts a Follow value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsFollow(idx int, v vocab.ActivityStreamsFollow) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsFollowMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsGroup inserts a Group value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsIgnore inserts a Ignore value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsIgnore(idx int, v vocab.ActivityStreamsIgnore) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsIgnoreMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsImage inserts a Image value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsImage(idx int, v vocab.ActivityStreamsImage) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsImageMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsIntransitiveActivity inserts a IntransitiveActivity value
+// at the specified index for a property "earlyItems". Existing elements at
+// that index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsIntransitiveActivity(idx int, v vocab.ActivityStreamsIntransitiveActivity) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsIntransitiveActivityMember: v,
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsInvite inserts a Invite value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsInvite(idx int, v vocab.ActivityStreamsInvite) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsInviteMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsJoin inserts a Join value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsJoin(idx int, v vocab.ActivityStreamsJoin) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsJoinMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsLeave inserts a Leave value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsLeave(idx int, v vocab.ActivityStreamsLeave) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLeaveMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsLike inserts a Like value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsLike(idx int, v vocab.ActivityStreamsLike) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLikeMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsLink inserts a Link value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsLink(idx int, v vocab.ActivityStreamsLink) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLinkMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsListen inserts a Listen value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsListen(idx int, v vocab.ActivityStreamsListen) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsListenMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsMention inserts a Mention value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsMention(idx int, v vocab.ActivityStreamsMention) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsMentionMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsMove inserts a Move value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsMove(idx int, v vocab.ActivityStreamsMove) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsMoveMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsNote inserts a Note value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsNote(idx int, v vocab.ActivityStreamsNote) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsNoteMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsObject inserts a Object value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsObject(idx int, v vocab.ActivityStreamsObject) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsObjectMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsOffer inserts a Offer value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsOffer(idx int, v vocab.ActivityStreamsOffer) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOfferMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsOrderedCollection inserts a OrderedCollection value at the
+// specified index for a property "earlyItems". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsOrderedCollection(idx int, v vocab.ActivityStreamsOrderedCollection) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrderedCollectionMember: v,
+		alias:                                  this.alias,
+		myIdx:                                  idx,
+		parent:                                 this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsOrderedCollectionPage inserts a OrderedCollectionPage
+// value at the specified index for a property "earlyItems". Existing elements
+// at that index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsOrderedCollectionPage(idx int, v vocab.ActivityStreamsOrderedCollectionPage) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrderedCollectionPageMember: v,
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsOrganization inserts a Organization value at the specified
+// index for a property "earlyItems". Existing elements at that index and
+// higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+		myIdx:                             idx,
+		parent:                            this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsPage inserts a Page value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsPage(idx int, v vocab.ActivityStreamsPage) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPageMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsPerson inserts a Person value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsPlace inserts a Place value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsPlace(idx int, v vocab.ActivityStreamsPlace) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPlaceMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsProfile inserts a Profile value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsProfile(idx int, v vocab.ActivityStreamsProfile) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsProfileMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsQuestion inserts a Question value at the specified index
+// for a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsQuestion(idx int, v vocab.ActivityStreamsQuestion) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsQuestionMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsRead inserts a Read value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsRead(idx int, v vocab.ActivityStreamsRead) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsReadMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsReject inserts a Reject value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsReject(idx int, v vocab.ActivityStreamsReject) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRejectMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsRelationship inserts a Relationship value at the specified
+// index for a property "earlyItems". Existing elements at that index and
+// higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsRelationship(idx int, v vocab.ActivityStreamsRelationship) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRelationshipMember: v,
+		alias:                             this.alias,
+		myIdx:                             idx,
+		parent:                            this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsRemove inserts a Remove value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsRemove(idx int, v vocab.ActivityStreamsRemove) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRemoveMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsService inserts a Service value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsTentativeAccept inserts a TentativeAccept value at the
+// specified index for a property "earlyItems". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsTentativeAccept(idx int, v vocab.ActivityStreamsTentativeAccept) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTentativeAcceptMember: v,
+		alias:                                this.alias,
+		myIdx:                                idx,
+		parent:                               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsTentativeReject inserts a TentativeReject value at the
+// specified index for a property "earlyItems". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsTentativeReject(idx int, v vocab.ActivityStreamsTentativeReject) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTentativeRejectMember: v,
+		alias:                                this.alias,
+		myIdx:                                idx,
+		parent:                               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsTombstone inserts a Tombstone value at the specified index
+// for a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsTombstone(idx int, v vocab.ActivityStreamsTombstone) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTombstoneMember: v,
+		alias:                          this.alias,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsTravel inserts a Travel value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsTravel(idx int, v vocab.ActivityStreamsTravel) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTravelMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsUndo inserts a Undo value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsUndo(idx int, v vocab.ActivityStreamsUndo) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsUndoMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsUpdate inserts a Update value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsUpdate(idx int, v vocab.ActivityStreamsUpdate) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsUpdateMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsVideo inserts a Video value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsVideo(idx int, v vocab.ActivityStreamsVideo) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsVideoMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertActivityStreamsView inserts a View value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertActivityStreamsView(idx int, v vocab.ActivityStreamsView) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsViewMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedBranch inserts a Branch value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedCommit inserts a Commit value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedPush inserts a Push value at the specified index for a property
+// "earlyItems". Existing elements at that index and higher are shifted back
+// once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertForgeFedPush(idx int, v vocab.ForgeFedPush) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              idx,
+		parent:             this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedRepository inserts a Repository value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedTicket inserts a Ticket value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedTicketDependency inserts a TicketDependency value at the
+// specified index for a property "earlyItems". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Insert inserts an IRI value at the specified index for a property "earlyItems".
+// Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertIRI(idx int, v *url.URL) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertSecurityV1PublicKey inserts a PublicKey value at the specified index for
+// a property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertSecurityV1PublicKey(idx int, v vocab.SecurityV1PublicKey) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertTootEmoji inserts a Emoji value at the specified index for a property
+// "earlyItems". Existing elements at that index and higher are shifted back
+// once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertTootEmoji(idx int, v vocab.TootEmoji) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		tootEmojiMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "earlyItems". Invalidates all iterators. Returns an error if the
+// type is not a valid one to set for this property.
+func (this *ForgeFedEarlyItemsProperty) InsertType(idx int, t vocab.Type) error {
+	n := &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = n
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+	return nil
+}
+
+// InsertVcardAddress inserts a Address value at the specified index for a
+// property "earlyItems". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertVcardAddress(idx int, v vocab.VcardAddress) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:              this.alias,
+		myIdx:              idx,
+		parent:             this,
+		vcardAddressMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertVcardHome inserts a Home value at the specified index for a property
+// "earlyItems". Existing elements at that index and higher are shifted back
+// once. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) InsertVcardHome(idx int, v vocab.VcardHome) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		vcardHomeMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ForgeFedEarlyItemsProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	for _, elem := range this.properties {
+		child := elem.JSONLDContext()
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		for k, v := range child {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API method specifically needed only for alternate implementations
+// for go-fed. Applications should not use this method. Panics if the index is
+// out of bounds.
+func (this ForgeFedEarlyItemsProperty) KindIndex(idx int) int {
+	return this.properties[idx].KindIndex()
+}
+
+// Len returns the number of values that exist for the "earlyItems" property.
+func (this ForgeFedEarlyItemsProperty) Len() (length int) {
+	return len(this.properties)
+}
+
+// Less computes whether another property is less than this one. Mixing types
+// results in a consistent but arbitrary ordering
+func (this ForgeFedEarlyItemsProperty) Less(i, j int) bool {
+	idx1 := this.KindIndex(i)
+	idx2 := this.KindIndex(j)
+	if idx1 < idx2 {
+		return true
+	} else if idx1 == idx2 {
+		if idx1 == 0 {
+			lhs := this.properties[i].GetActivityStreamsObject()
+			rhs := this.properties[j].GetActivityStreamsObject()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 1 {
+			lhs := this.properties[i].GetActivityStreamsLink()
+			rhs := this.properties[j].GetActivityStreamsLink()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 2 {
+			lhs := this.properties[i].GetActivityStreamsAccept()
+			rhs := this.properties[j].GetActivityStreamsAccept()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 3 {
+			lhs := this.properties[i].GetActivityStreamsActivity()
+			rhs := this.properties[j].GetActivityStreamsActivity()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 4 {
+			lhs := this.properties[i].GetActivityStreamsAdd()
+			rhs := this.properties[j].GetActivityStreamsAdd()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 5 {
+			lhs := this.properties[i].GetVcardAddress()
+			rhs := this.properties[j].GetVcardAddress()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 6 {
+			lhs := this.properties[i].GetActivityStreamsAnnounce()
+			rhs := this.properties[j].GetActivityStreamsAnnounce()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 7 {
+			lhs := this.properties[i].GetActivityStreamsApplication()
+			rhs := this.properties[j].GetActivityStreamsApplication()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 8 {
+			lhs := this.properties[i].GetActivityStreamsArrive()
+			rhs := this.properties[j].GetActivityStreamsArrive()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 9 {
+			lhs := this.properties[i].GetActivityStreamsArticle()
+			rhs := this.properties[j].GetActivityStreamsArticle()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 10 {
+			lhs := this.properties[i].GetActivityStreamsAudio()
+			rhs := this.properties[j].GetActivityStreamsAudio()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 11 {
+			lhs := this.properties[i].GetActivityStreamsBlock()
+			rhs := this.properties[j].GetActivityStreamsBlock()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 12 {
+			lhs := this.properties[i].GetForgeFedBranch()
+			rhs := this.properties[j].GetForgeFedBranch()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 13 {
+			lhs := this.properties[i].GetActivityStreamsCollection()
+			rhs := this.properties[j].GetActivityStreamsCollection()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 14 {
+			lhs := this.properties[i].GetActivityStreamsCollectionPage()
+			rhs := this.properties[j].GetActivityStreamsCollectionPage()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 15 {
+			lhs := this.properties[i].GetForgeFedCommit()
+			rhs := this.properties[j].GetForgeFedCommit()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 16 {
+			lhs := this.properties[i].GetActivityStreamsCreate()
+			rhs := this.properties[j].GetActivityStreamsCreate()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 17 {
+			lhs := this.properties[i].GetActivityStreamsDelete()
+			rhs := this.properties[j].GetActivityStreamsDelete()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 18 {
+			lhs := this.properties[i].GetActivityStreamsDislike()
+			rhs := this.properties[j].GetActivityStreamsDislike()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 19 {
+			lhs := this.properties[i].GetActivityStreamsDocument()
+			rhs := this.properties[j].GetActivityStreamsDocument()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 20 {
+			lhs := this.properties[i].GetTootEmoji()
+			rhs := this.properties[j].GetTootEmoji()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 21 {
+			lhs := this.properties[i].GetActivityStreamsEvent()
+			rhs := this.properties[j].GetActivityStreamsEvent()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 22 {
+			lhs := this.properties[i].GetActivityStreamsFlag()
+			rhs := this.properties[j].GetActivityStreamsFlag()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 23 {
+			lhs := this.properties[i].GetActivityStreamsFollow()
+			rhs := this.properties[j].GetActivityStreamsFollow()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 24 {
+			lhs := this.properties[i].GetActivityStreamsGroup()
+			rhs := this.properties[j].GetActivityStreamsGroup()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 25 {
+			lhs := this.properties[i].GetVcardHome()
+			rhs := this.properties[j].GetVcardHome()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 26 {
+			lhs := this.properties[i].GetActivityStreamsIgnore()
+			rhs := this.properties[j].GetActivityStreamsIgnore()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 27 {
+			lhs := this.properties[i].GetActivityStreamsImage()
+			rhs := this.properties[j].GetActivityStreamsImage()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 28 {
+			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
+			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 29 {
+			lhs := this.properties[i].GetActivityStreamsInvite()
+			rhs := this.properties[j].GetActivityStreamsInvite()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 30 {
+			lhs := this.properties[i].GetActivityStreamsJoin()
+			rhs := this.properties[j].GetActivityStreamsJoin()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 31 {
+			lhs := this.properties[i].GetActivityStreamsLeave()
+			rhs := this.properties[j].GetActivityStreamsLeave()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 32 {
+			lhs := this.properties[i].GetActivityStreamsLike()
+			rhs := this.properties[j].GetActivityStreamsLike()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 33 {
+			lhs := this.properties[i].GetActivityStreamsListen()
+			rhs := this.properties[j].GetActivityStreamsListen()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 34 {
+			lhs := this.properties[i].GetActivityStreamsMention()
+			rhs := this.properties[j].GetActivityStreamsMention()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 35 {
+			lhs := this.properties[i].GetActivityStreamsMove()
+			rhs := this.properties[j].GetActivityStreamsMove()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 36 {
+			lhs := this.properties[i].GetActivityStreamsNote()
+			rhs := this.properties[j].GetActivityStreamsNote()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 37 {
+			lhs := this.properties[i].GetActivityStreamsOffer()
+			rhs := this.properties[j].GetActivityStreamsOffer()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 38 {
+			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
+			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 39 {
+			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
+			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 40 {
+			lhs := this.properties[i].GetActivityStreamsOrganization()
+			rhs := this.properties[j].GetActivityStreamsOrganization()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 41 {
+			lhs := this.properties[i].GetActivityStreamsPage()
+			rhs := this.properties[j].GetActivityStreamsPage()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 42 {
+			lhs := this.properties[i].GetActivityStreamsPerson()
+			rhs := this.properties[j].GetActivityStreamsPerson()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 43 {
+			lhs := this.properties[i].GetActivityStreamsPlace()
+			rhs := this.properties[j].GetActivityStreamsPlace()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 44 {
+			lhs := this.properties[i].GetActivityStreamsProfile()
+			rhs := this.properties[j].GetActivityStreamsProfile()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 45 {
+			lhs := this.properties[i].GetSecurityV1PublicKey()
+			rhs := this.properties[j].GetSecurityV1PublicKey()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 46 {
+			lhs := this.properties[i].GetForgeFedPush()
+			rhs := this.properties[j].GetForgeFedPush()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 47 {
+			lhs := this.properties[i].GetActivityStreamsQuestion()
+			rhs := this.properties[j].GetActivityStreamsQuestion()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 48 {
+			lhs := this.properties[i].GetActivityStreamsRead()
+			rhs := this.properties[j].GetActivityStreamsRead()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 49 {
+			lhs := this.properties[i].GetActivityStreamsReject()
+			rhs := this.properties[j].GetActivityStreamsReject()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 50 {
+			lhs := this.properties[i].GetActivityStreamsRelationship()
+			rhs := this.properties[j].GetActivityStreamsRelationship()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 51 {
+			lhs := this.properties[i].GetActivityStreamsRemove()
+			rhs := this.properties[j].GetActivityStreamsRemove()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 52 {
+			lhs := this.properties[i].GetForgeFedRepository()
+			rhs := this.properties[j].GetForgeFedRepository()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 53 {
+			lhs := this.properties[i].GetActivityStreamsService()
+			rhs := this.properties[j].GetActivityStreamsService()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 54 {
+			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
+			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 55 {
+			lhs := this.properties[i].GetActivityStreamsTentativeReject()
+			rhs := this.properties[j].GetActivityStreamsTentativeReject()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 56 {
+			lhs := this.properties[i].GetForgeFedTicket()
+			rhs := this.properties[j].GetForgeFedTicket()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 57 {
+			lhs := this.properties[i].GetForgeFedTicketDependency()
+			rhs := this.properties[j].GetForgeFedTicketDependency()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 58 {
+			lhs := this.properties[i].GetActivityStreamsTombstone()
+			rhs := this.properties[j].GetActivityStreamsTombstone()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 59 {
+			lhs := this.properties[i].GetActivityStreamsTravel()
+			rhs := this.properties[j].GetActivityStreamsTravel()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 60 {
+			lhs := this.properties[i].GetActivityStreamsUndo()
+			rhs := this.properties[j].GetActivityStreamsUndo()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 61 {
+			lhs := this.properties[i].GetActivityStreamsUpdate()
+			rhs := this.properties[j].GetActivityStreamsUpdate()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 62 {
+			lhs := this.properties[i].GetActivityStreamsVideo()
+			rhs := this.properties[j].GetActivityStreamsVideo()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 63 {
+			lhs := this.properties[i].GetActivityStreamsView()
+			rhs := this.properties[j].GetActivityStreamsView()
+			return lhs.LessThan(rhs)
+		} else if idx1 == -2 {
+			lhs := this.properties[i].GetIRI()
+			rhs := this.properties[j].GetIRI()
+			return lhs.String() < rhs.String()
+		}
+	}
+	return false
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ForgeFedEarlyItemsProperty) LessThan(o vocab.ForgeFedEarlyItemsProperty) bool {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if this.properties[i].LessThan(o.At(i)) {
+			return true
+		} else if o.At(i).LessThan(this.properties[i]) {
+			return false
+		}
+	}
+	return l1 < l2
+}
+
+// Name returns the name of this property: "earlyItems", prefixed by the alias of
+// its vocabulary if it has one.
+func (this ForgeFedEarlyItemsProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":earlyItems"
+	} else {
+		return "earlyItems"
+	}
+}
+
+// PrependActivityStreamsAccept prepends a Accept value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsAcceptMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsActivity prepends a Activity value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsActivityMember: v,
+		alias:                         this.alias,
+		myIdx:                         0,
+		parent:                        this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsAdd prepends a Add value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsAddMember: v,
+		alias:                    this.alias,
+		myIdx:                    0,
+		parent:                   this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsAnnounce prepends a Announce value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsAnnounceMember: v,
+		alias:                         this.alias,
+		myIdx:                         0,
+		parent:                        this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsApplication prepends a Application value to the front of
+// a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+		myIdx:                            0,
+		parent:                           this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsArrive prepends a Arrive value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsArriveMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsArticle prepends a Article value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsArticleMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsAudio prepends a Audio value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsAudioMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsBlock prepends a Block value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsBlockMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsCollection prepends a Collection value to the front of a
+// list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsCollectionMember: v,
+		alias:                           this.alias,
+		myIdx:                           0,
+		parent:                          this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsCollectionPage prepends a CollectionPage value to the
+// front of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsCollectionPageMember: v,
+		alias:                               this.alias,
+		myIdx:                               0,
+		parent:                              this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsCreate prepends a Create value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsCreateMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsDelete prepends a Delete value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsDeleteMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsDislike prepends a Dislike value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsDislikeMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsDocument prepends a Document value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsDocumentMember: v,
+		alias:                         this.alias,
+		myIdx:                         0,
+		parent:                        this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsEvent prepends a Event value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsEventMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsFlag prepends a Flag value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsFlagMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsFollow prepends a Follow value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsFollowMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsGroup prepends a Group value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsIgnore prepends a Ignore value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsIgnoreMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsImage prepends a Image value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsImage(v vocab.ActivityStreamsImage) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsImageMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsIntransitiveActivity prepends a IntransitiveActivity
+// value to the front of a list of the property "earlyItems". Invalidates all
+// iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsIntransitiveActivityMember: v,
+		alias:  this.alias,
+		myIdx:  0,
+		parent: this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsInvite prepends a Invite value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsInviteMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsJoin prepends a Join value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsJoinMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsLeave prepends a Leave value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsLeaveMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsLike prepends a Like value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsLike(v vocab.ActivityStreamsLike) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsLikeMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsLink prepends a Link value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsLink(v vocab.ActivityStreamsLink) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsLinkMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsListen prepends a Listen value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsListen(v vocab.ActivityStreamsListen) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsListenMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsMention prepends a Mention value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsMention(v vocab.ActivityStreamsMention) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsMentionMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsMove prepends a Move value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsMove(v vocab.ActivityStreamsMove) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsMoveMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsNote prepends a Note value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsNote(v vocab.ActivityStreamsNote) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsNoteMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsObject prepends a Object value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsObject(v vocab.ActivityStreamsObject) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsObjectMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsOffer prepends a Offer value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsOfferMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsOrderedCollection prepends a OrderedCollection value to
+// the front of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsOrderedCollectionMember: v,
+		alias:                                  this.alias,
+		myIdx:                                  0,
+		parent:                                 this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsOrderedCollectionPage prepends a OrderedCollectionPage
+// value to the front of a list of the property "earlyItems". Invalidates all
+// iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsOrderedCollectionPageMember: v,
+		alias:  this.alias,
+		myIdx:  0,
+		parent: this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsOrganization prepends a Organization value to the front
+// of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+		myIdx:                             0,
+		parent:                            this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsPage prepends a Page value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsPage(v vocab.ActivityStreamsPage) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsPageMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsPerson prepends a Person value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsPlace prepends a Place value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsPlaceMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsProfile prepends a Profile value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsProfileMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsQuestion prepends a Question value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsQuestionMember: v,
+		alias:                         this.alias,
+		myIdx:                         0,
+		parent:                        this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsRead prepends a Read value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsRead(v vocab.ActivityStreamsRead) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsReadMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsReject prepends a Reject value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsReject(v vocab.ActivityStreamsReject) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsRejectMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsRelationship prepends a Relationship value to the front
+// of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsRelationshipMember: v,
+		alias:                             this.alias,
+		myIdx:                             0,
+		parent:                            this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsRemove prepends a Remove value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsRemoveMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsService prepends a Service value to the front of a list
+// of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsTentativeAccept prepends a TentativeAccept value to the
+// front of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsTentativeAcceptMember: v,
+		alias:                                this.alias,
+		myIdx:                                0,
+		parent:                               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsTentativeReject prepends a TentativeReject value to the
+// front of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsTentativeRejectMember: v,
+		alias:                                this.alias,
+		myIdx:                                0,
+		parent:                               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsTombstone prepends a Tombstone value to the front of a
+// list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsTombstoneMember: v,
+		alias:                          this.alias,
+		myIdx:                          0,
+		parent:                         this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsTravel prepends a Travel value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsTravelMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsUndo prepends a Undo value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsUndoMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsUpdate prepends a Update value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsUpdateMember: v,
+		alias:                       this.alias,
+		myIdx:                       0,
+		parent:                      this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsVideo prepends a Video value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsVideoMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependActivityStreamsView prepends a View value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependActivityStreamsView(v vocab.ActivityStreamsView) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		activitystreamsViewMember: v,
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedBranch prepends a Branch value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependForgeFedBranch(v vocab.ForgeFedBranch) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedCommit prepends a Commit value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedPush prepends a Push value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependForgeFedPush(v vocab.ForgeFedPush) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              0,
+		parent:             this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedRepository prepends a Repository value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    0,
+		parent:                   this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedTicket prepends a Ticket value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedTicketDependency prepends a TicketDependency value to the front
+// of a list of the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          0,
+		parent:                         this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependIRI prepends an IRI value to the front of a list of the property
+// "earlyItems".
+func (this *ForgeFedEarlyItemsProperty) PrependIRI(v *url.URL) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  0,
+		parent: this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependSecurityV1PublicKey prepends a PublicKey value to the front of a list of
+// the property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependTootEmoji prepends a Emoji value to the front of a list of the property
+// "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependTootEmoji(v vocab.TootEmoji) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:           this.alias,
+		myIdx:           0,
+		parent:          this,
+		tootEmojiMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "earlyItems". Invalidates all iterators. Returns an error if the
+// type is not a valid one to set for this property.
+func (this *ForgeFedEarlyItemsProperty) PrependType(t vocab.Type) error {
+	n := &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		myIdx:  0,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{n}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+	return nil
+}
+
+// PrependVcardAddress prepends a Address value to the front of a list of the
+// property "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependVcardAddress(v vocab.VcardAddress) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:              this.alias,
+		myIdx:              0,
+		parent:             this,
+		vcardAddressMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependVcardHome prepends a Home value to the front of a list of the property
+// "earlyItems". Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) PrependVcardHome(v vocab.VcardHome) {
+	this.properties = append([]*ForgeFedEarlyItemsPropertyIterator{{
+		alias:           this.alias,
+		myIdx:           0,
+		parent:          this,
+		vcardHomeMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Remove deletes an element at the specified index from a list of the property
+// "earlyItems", regardless of its type. Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) Remove(idx int) {
+	(this.properties)[idx].parent = nil
+	copy((this.properties)[idx:], (this.properties)[idx+1:])
+	(this.properties)[len(this.properties)-1] = &ForgeFedEarlyItemsPropertyIterator{}
+	this.properties = (this.properties)[:len(this.properties)-1]
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ForgeFedEarlyItemsProperty) Serialize() (interface{}, error) {
+	s := make([]interface{}, 0, len(this.properties))
+	for _, iterator := range this.properties {
+		if b, err := iterator.serialize(); err != nil {
+			return s, err
+		} else {
+			s = append(s, b)
+		}
+	}
+	// Shortcut: if serializing one value, don't return an array -- pretty sure other Fediverse software would choke on a "type" value with array, for example.
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
+
+// SetActivityStreamsAccept sets a Accept value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsAccept(idx int, v vocab.ActivityStreamsAccept) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAcceptMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsActivity sets a Activity value to be at the specified index
+// for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsActivity(idx int, v vocab.ActivityStreamsActivity) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsActivityMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+}
+
+// SetActivityStreamsAdd sets a Add value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsAdd(idx int, v vocab.ActivityStreamsAdd) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAddMember: v,
+		alias:                    this.alias,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+}
+
+// SetActivityStreamsAnnounce sets a Announce value to be at the specified index
+// for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsAnnounce(idx int, v vocab.ActivityStreamsAnnounce) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAnnounceMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+}
+
+// SetActivityStreamsApplication sets a Application value to be at the specified
+// index for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+		myIdx:                            idx,
+		parent:                           this,
+	}
+}
+
+// SetActivityStreamsArrive sets a Arrive value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsArrive(idx int, v vocab.ActivityStreamsArrive) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsArriveMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsArticle sets a Article value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsArticle(idx int, v vocab.ActivityStreamsArticle) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsArticleMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
+// SetActivityStreamsAudio sets a Audio value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsAudio(idx int, v vocab.ActivityStreamsAudio) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsAudioMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsBlock sets a Block value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsBlock(idx int, v vocab.ActivityStreamsBlock) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsBlockMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsCollection sets a Collection value to be at the specified
+// index for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsCollection(idx int, v vocab.ActivityStreamsCollection) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCollectionMember: v,
+		alias:                           this.alias,
+		myIdx:                           idx,
+		parent:                          this,
+	}
+}
+
+// SetActivityStreamsCollectionPage sets a CollectionPage value to be at the
+// specified index for the property "earlyItems". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsCollectionPage(idx int, v vocab.ActivityStreamsCollectionPage) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCollectionPageMember: v,
+		alias:                               this.alias,
+		myIdx:                               idx,
+		parent:                              this,
+	}
+}
+
+// SetActivityStreamsCreate sets a Create value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsCreate(idx int, v vocab.ActivityStreamsCreate) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsCreateMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsDelete sets a Delete value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsDelete(idx int, v vocab.ActivityStreamsDelete) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDeleteMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsDislike sets a Dislike value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsDislike(idx int, v vocab.ActivityStreamsDislike) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDislikeMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
+// SetActivityStreamsDocument sets a Document value to be at the specified index
+// for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsDocument(idx int, v vocab.ActivityStreamsDocument) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsDocumentMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+}
+
+// SetActivityStreamsEvent sets a Event value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsEvent(idx int, v vocab.ActivityStreamsEvent) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsEventMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsFlag sets a Flag value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsFlag(idx int, v vocab.ActivityStreamsFlag) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsFlagMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsFollow sets a Follow value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsFollow(idx int, v vocab.ActivityStreamsFollow) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsFollowMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsGroup sets a Group value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsIgnore sets a Ignore value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsIgnore(idx int, v vocab.ActivityStreamsIgnore) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsIgnoreMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsImage sets a Image value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsImage(idx int, v vocab.ActivityStreamsImage) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsImageMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsIntransitiveActivity sets a IntransitiveActivity value to be
+// at the specified index for the property "earlyItems". Panics if the index
+// is out of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsIntransitiveActivity(idx int, v vocab.ActivityStreamsIntransitiveActivity) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsIntransitiveActivityMember: v,
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+}
+
+// SetActivityStreamsInvite sets a Invite value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsInvite(idx int, v vocab.ActivityStreamsInvite) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsInviteMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsJoin sets a Join value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsJoin(idx int, v vocab.ActivityStreamsJoin) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsJoinMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsLeave sets a Leave value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsLeave(idx int, v vocab.ActivityStreamsLeave) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLeaveMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsLike sets a Like value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsLike(idx int, v vocab.ActivityStreamsLike) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLikeMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsLink sets a Link value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsLink(idx int, v vocab.ActivityStreamsLink) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsLinkMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsListen sets a Listen value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsListen(idx int, v vocab.ActivityStreamsListen) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsListenMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsMention sets a Mention value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsMention(idx int, v vocab.ActivityStreamsMention) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsMentionMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
+// SetActivityStreamsMove sets a Move value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsMove(idx int, v vocab.ActivityStreamsMove) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsMoveMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsNote sets a Note value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsNote(idx int, v vocab.ActivityStreamsNote) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsNoteMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsObject sets a Object value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsObject(idx int, v vocab.ActivityStreamsObject) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsObjectMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsOffer sets a Offer value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsOffer(idx int, v vocab.ActivityStreamsOffer) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOfferMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsOrderedCollection sets a OrderedCollection value to be at the
+// specified index for the property "earlyItems". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsOrderedCollection(idx int, v vocab.ActivityStreamsOrderedCollection) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrderedCollectionMember: v,
+		alias:                                  this.alias,
+		myIdx:                                  idx,
+		parent:                                 this,
+	}
+}
+
+// SetActivityStreamsOrderedCollectionPage sets a OrderedCollectionPage value to
+// be at the specified index for the property "earlyItems". Panics if the
+// index is out of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsOrderedCollectionPage(idx int, v vocab.ActivityStreamsOrderedCollectionPage) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrderedCollectionPageMember: v,
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+}
+
+// SetActivityStreamsOrganization sets a Organization value to be at the specified
+// index for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+		myIdx:                             idx,
+		parent:                            this,
+	}
+}
+
+// SetActivityStreamsPage sets a Page value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsPage(idx int, v vocab.ActivityStreamsPage) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPageMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsPerson sets a Person value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsPlace sets a Place value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsPlace(idx int, v vocab.ActivityStreamsPlace) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsPlaceMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsProfile sets a Profile value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsProfile(idx int, v vocab.ActivityStreamsProfile) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsProfileMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
+// SetActivityStreamsQuestion sets a Question value to be at the specified index
+// for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsQuestion(idx int, v vocab.ActivityStreamsQuestion) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsQuestionMember: v,
+		alias:                         this.alias,
+		myIdx:                         idx,
+		parent:                        this,
+	}
+}
+
+// SetActivityStreamsRead sets a Read value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsRead(idx int, v vocab.ActivityStreamsRead) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsReadMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsReject sets a Reject value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsReject(idx int, v vocab.ActivityStreamsReject) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRejectMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsRelationship sets a Relationship value to be at the specified
+// index for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsRelationship(idx int, v vocab.ActivityStreamsRelationship) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRelationshipMember: v,
+		alias:                             this.alias,
+		myIdx:                             idx,
+		parent:                            this,
+	}
+}
+
+// SetActivityStreamsRemove sets a Remove value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsRemove(idx int, v vocab.ActivityStreamsRemove) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsRemoveMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsService sets a Service value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
+// SetActivityStreamsTentativeAccept sets a TentativeAccept value to be at the
+// specified index for the property "earlyItems". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsTentativeAccept(idx int, v vocab.ActivityStreamsTentativeAccept) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTentativeAcceptMember: v,
+		alias:                                this.alias,
+		myIdx:                                idx,
+		parent:                               this,
+	}
+}
+
+// SetActivityStreamsTentativeReject sets a TentativeReject value to be at the
+// specified index for the property "earlyItems". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsTentativeReject(idx int, v vocab.ActivityStreamsTentativeReject) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTentativeRejectMember: v,
+		alias:                                this.alias,
+		myIdx:                                idx,
+		parent:                               this,
+	}
+}
+
+// SetActivityStreamsTombstone sets a Tombstone value to be at the specified index
+// for the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsTombstone(idx int, v vocab.ActivityStreamsTombstone) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTombstoneMember: v,
+		alias:                          this.alias,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+}
+
+// SetActivityStreamsTravel sets a Travel value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsTravel(idx int, v vocab.ActivityStreamsTravel) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsTravelMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsUndo sets a Undo value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsUndo(idx int, v vocab.ActivityStreamsUndo) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsUndoMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetActivityStreamsUpdate sets a Update value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsUpdate(idx int, v vocab.ActivityStreamsUpdate) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsUpdateMember: v,
+		alias:                       this.alias,
+		myIdx:                       idx,
+		parent:                      this,
+	}
+}
+
+// SetActivityStreamsVideo sets a Video value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsVideo(idx int, v vocab.ActivityStreamsVideo) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsVideoMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
+// SetActivityStreamsView sets a View value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetActivityStreamsView(idx int, v vocab.ActivityStreamsView) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		activitystreamsViewMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+}
+
+// SetForgeFedBranch sets a Branch value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedBranchMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedCommit sets a Commit value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedPush sets a Push value to be at the specified index for the property
+// "earlyItems". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ForgeFedEarlyItemsProperty) SetForgeFedPush(idx int, v vocab.ForgeFedPush) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              idx,
+		parent:             this,
+	}
+}
+
+// SetForgeFedRepository sets a Repository value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+}
+
+// SetForgeFedTicket sets a Ticket value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedTicketDependency sets a TicketDependency value to be at the
+// specified index for the property "earlyItems". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+}
+
+// SetIRI sets an IRI value to be at the specified index for the property
+// "earlyItems". Panics if the index is out of bounds.
+func (this *ForgeFedEarlyItemsProperty) SetIRI(idx int, v *url.URL) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+}
+
+// SetSecurityV1PublicKey sets a PublicKey value to be at the specified index for
+// the property "earlyItems". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetSecurityV1PublicKey(idx int, v vocab.SecurityV1PublicKey) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}
+}
+
+// SetTootEmoji sets a Emoji value to be at the specified index for the property
+// "earlyItems". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ForgeFedEarlyItemsProperty) SetTootEmoji(idx int, v vocab.TootEmoji) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		tootEmojiMember: v,
+	}
+}
+
+// SetType sets an arbitrary type value to the specified index of the property
+// "earlyItems". Invalidates all iterators. Returns an error if the type is
+// not a valid one to set for this property. Panics if the index is out of
+// bounds.
+func (this *ForgeFedEarlyItemsProperty) SetType(idx int, t vocab.Type) error {
+	n := &ForgeFedEarlyItemsPropertyIterator{
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	(this.properties)[idx] = n
+	return nil
+}
+
+// SetVcardAddress sets a Address value to be at the specified index for the
+// property "earlyItems". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ForgeFedEarlyItemsProperty) SetVcardAddress(idx int, v vocab.VcardAddress) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:              this.alias,
+		myIdx:              idx,
+		parent:             this,
+		vcardAddressMember: v,
+	}
+}
+
+// SetVcardHome sets a Home value to be at the specified index for the property
+// "earlyItems". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ForgeFedEarlyItemsProperty) SetVcardHome(idx int, v vocab.VcardHome) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedEarlyItemsPropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		vcardHomeMember: v,
+	}
+}
+
+// Swap swaps the location of values at two indices for the "earlyItems" property.
+func (this ForgeFedEarlyItemsProperty) Swap(i, j int) {
+	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+}
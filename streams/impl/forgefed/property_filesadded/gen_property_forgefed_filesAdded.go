@@ -0,0 +1,535 @@
+package propertyfilesadded
+
+import (
+	"fmt"
+	string1 "github.com/go-fed/activity/streams/values/string"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// ForgeFedFilesAddedPropertyIterator is an iterator for a property. It is
+// permitted to be a single default-valued value type.
+type ForgeFedFilesAddedPropertyIterator struct {
+	xmlschemaStringMember string
+	hasStringMember       bool
+	unknown               interface{}
+	iri                   *url.URL
+	alias                 string
+	myIdx                 int
+	parent                vocab.ForgeFedFilesAddedProperty
+}
+
+// NewForgeFedFilesAddedPropertyIterator creates a new ForgeFedFilesAdded property.
+func NewForgeFedFilesAddedPropertyIterator() *ForgeFedFilesAddedPropertyIterator {
+	return &ForgeFedFilesAddedPropertyIterator{alias: "ff"}
+}
+
+// deserializeForgeFedFilesAddedPropertyIterator creates an iterator from an
+// element that has been unmarshalled from a text or binary format.
+func deserializeForgeFedFilesAddedPropertyIterator(i interface{}, aliasMap map[string]string) (*ForgeFedFilesAddedPropertyIterator, error) {
+	alias := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+	}
+	if s, ok := i.(string); ok {
+		u, err := url.Parse(s)
+		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+		if err == nil && len(u.Scheme) > 0 {
+			this := &ForgeFedFilesAddedPropertyIterator{
+				alias: alias,
+				iri:   u,
+			}
+			return this, nil
+		}
+	}
+	if v, err := string1.DeserializeString(i); err == nil {
+		this := &ForgeFedFilesAddedPropertyIterator{
+			alias:                 alias,
+			hasStringMember:       true,
+			xmlschemaStringMember: v,
+		}
+		return this, nil
+	}
+	this := &ForgeFedFilesAddedPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
+}
+
+// Get returns the value of this property. When IsXMLSchemaString returns false,
+// Get will return any arbitrary value.
+func (this ForgeFedFilesAddedPropertyIterator) Get() string {
+	return this.xmlschemaStringMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this ForgeFedFilesAddedPropertyIterator) GetIRI() *url.URL {
+	return this.iri
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this ForgeFedFilesAddedPropertyIterator) HasAny() bool {
+	return this.IsXMLSchemaString() || this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this ForgeFedFilesAddedPropertyIterator) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsXMLSchemaString returns true if this property is set and not an IRI.
+func (this ForgeFedFilesAddedPropertyIterator) IsXMLSchemaString() bool {
+	return this.hasStringMember
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ForgeFedFilesAddedPropertyIterator) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	var child map[string]string
+
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this ForgeFedFilesAddedPropertyIterator) KindIndex() int {
+	if this.IsXMLSchemaString() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ForgeFedFilesAddedPropertyIterator) LessThan(o vocab.ForgeFedFilesAddedPropertyIterator) bool {
+	// LessThan comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	} else if this.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return true
+	} else if o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return false
+	}
+	// LessThan comparison for the single value or unknown value.
+	if !this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
+		// Both are unknowns.
+		return false
+	} else if this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
+		// Values are always greater than unknown values.
+		return false
+	} else if !this.IsXMLSchemaString() && o.IsXMLSchemaString() {
+		// Unknowns are always less than known values.
+		return true
+	} else {
+		// Actual comparison.
+		return string1.LessString(this.Get(), o.Get())
+	}
+}
+
+// Name returns the name of this property: "ForgeFedFilesAdded", prefixed by the
+// alias of its vocabulary if it has one.
+func (this ForgeFedFilesAddedPropertyIterator) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":ForgeFedFilesAdded"
+	} else {
+		return "ForgeFedFilesAdded"
+	}
+}
+
+// Next returns the next iterator, or nil if there is no next iterator.
+func (this ForgeFedFilesAddedPropertyIterator) Next() vocab.ForgeFedFilesAddedPropertyIterator {
+	if this.myIdx+1 >= this.parent.Len() {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx + 1)
+	}
+}
+
+// Prev returns the previous iterator, or nil if there is no previous iterator.
+func (this ForgeFedFilesAddedPropertyIterator) Prev() vocab.ForgeFedFilesAddedPropertyIterator {
+	if this.myIdx-1 < 0 {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx - 1)
+	}
+}
+
+// Set sets the value of this property. Calling IsXMLSchemaString afterwards will
+// return true.
+func (this *ForgeFedFilesAddedPropertyIterator) Set(v string) {
+	this.clear()
+	this.xmlschemaStringMember = v
+	this.hasStringMember = true
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *ForgeFedFilesAddedPropertyIterator) SetIRI(v *url.URL) {
+	this.clear()
+	this.iri = v
+}
+
+// clear ensures no value of this property is set. Calling IsXMLSchemaString
+// afterwards will return false.
+func (this *ForgeFedFilesAddedPropertyIterator) clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.hasStringMember = false
+}
+
+// serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ForgeFedFilesAddedPropertyIterator) serialize() (interface{}, error) {
+	if this.IsXMLSchemaString() {
+		return string1.SerializeString(this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// ForgeFedFilesAddedProperty is the non-functional property "filesAdded". It is
+// permitted to have one or more values, and of different value types.
+type ForgeFedFilesAddedProperty struct {
+	properties []*ForgeFedFilesAddedPropertyIterator
+	alias      string
+}
+
+// DeserializeFilesAddedProperty creates a "filesAdded" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeFilesAddedProperty(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+	}
+	propName := "filesAdded"
+	if len(alias) > 0 {
+		propName = fmt.Sprintf("%s:%s", alias, "filesAdded")
+	}
+	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["filesAdded"]
+	}
+
+	if ok {
+		this := &ForgeFedFilesAddedProperty{
+			alias:      alias,
+			properties: []*ForgeFedFilesAddedPropertyIterator{},
+		}
+		if list, ok := i.([]interface{}); ok {
+			for _, iterator := range list {
+				if p, err := deserializeForgeFedFilesAddedPropertyIterator(iterator, aliasMap); err != nil {
+					return this, err
+				} else if p != nil {
+					this.properties = append(this.properties, p)
+				}
+			}
+		} else {
+			if p, err := deserializeForgeFedFilesAddedPropertyIterator(i, aliasMap); err != nil {
+				return this, err
+			} else if p != nil {
+				this.properties = append(this.properties, p)
+			}
+		}
+		// Set up the properties for iteration.
+		for idx, ele := range this.properties {
+			ele.parent = this
+			ele.myIdx = idx
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewForgeFedFilesAddedProperty creates a new filesAdded property.
+func NewForgeFedFilesAddedProperty() *ForgeFedFilesAddedProperty {
+	return &ForgeFedFilesAddedProperty{alias: "ff"}
+}
+
+// AppendIRI appends an IRI value to the back of a list of the property
+// "filesAdded"
+func (this *ForgeFedFilesAddedProperty) AppendIRI(v *url.URL) {
+	this.properties = append(this.properties, &ForgeFedFilesAddedPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  this.Len(),
+		parent: this,
+	})
+}
+
+// AppendXMLSchemaString appends a string value to the back of a list of the
+// property "filesAdded". Invalidates iterators that are traversing using Prev.
+func (this *ForgeFedFilesAddedProperty) AppendXMLSchemaString(v string) {
+	this.properties = append(this.properties, &ForgeFedFilesAddedPropertyIterator{
+		alias:                 this.alias,
+		hasStringMember:       true,
+		myIdx:                 this.Len(),
+		parent:                this,
+		xmlschemaStringMember: v,
+	})
+}
+
+// At returns the property value for the specified index. Panics if the index is
+// out of bounds.
+func (this ForgeFedFilesAddedProperty) At(index int) vocab.ForgeFedFilesAddedPropertyIterator {
+	return this.properties[index]
+}
+
+// Begin returns the first iterator, or nil if empty. Can be used with the
+// iterator's Next method and this property's End method to iterate from front
+// to back through all values.
+func (this ForgeFedFilesAddedProperty) Begin() vocab.ForgeFedFilesAddedPropertyIterator {
+	if this.Empty() {
+		return nil
+	} else {
+		return this.properties[0]
+	}
+}
+
+// Empty returns returns true if there are no elements.
+func (this ForgeFedFilesAddedProperty) Empty() bool {
+	return this.Len() == 0
+}
+
+// End returns beyond-the-last iterator, which is nil. Can be used with the
+// iterator's Next method and this property's Begin method to iterate from
+// front to back through all values.
+func (this ForgeFedFilesAddedProperty) End() vocab.ForgeFedFilesAddedPropertyIterator {
+	return nil
+}
+
+// Insert inserts an IRI value at the specified index for a property "filesAdded".
+// Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ForgeFedFilesAddedProperty) InsertIRI(idx int, v *url.URL) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedFilesAddedPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertXMLSchemaString inserts a string value at the specified index for a
+// property "filesAdded". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ForgeFedFilesAddedProperty) InsertXMLSchemaString(idx int, v string) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ForgeFedFilesAddedPropertyIterator{
+		alias:                 this.alias,
+		hasStringMember:       true,
+		myIdx:                 idx,
+		parent:                this,
+		xmlschemaStringMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ForgeFedFilesAddedProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	for _, elem := range this.properties {
+		child := elem.JSONLDContext()
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		for k, v := range child {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API method specifically needed only for alternate implementations
+// for go-fed. Applications should not use this method. Panics if the index is
+// out of bounds.
+func (this ForgeFedFilesAddedProperty) KindIndex(idx int) int {
+	return this.properties[idx].KindIndex()
+}
+
+// Len returns the number of values that exist for the "filesAdded" property.
+func (this ForgeFedFilesAddedProperty) Len() (length int) {
+	return len(this.properties)
+}
+
+// Less computes whether another property is less than this one. Mixing types
+// results in a consistent but arbitrary ordering
+func (this ForgeFedFilesAddedProperty) Less(i, j int) bool {
+	idx1 := this.KindIndex(i)
+	idx2 := this.KindIndex(j)
+	if idx1 < idx2 {
+		return true
+	} else if idx1 == idx2 {
+		if idx1 == 0 {
+			lhs := this.properties[i].Get()
+			rhs := this.properties[j].Get()
+			return string1.LessString(lhs, rhs)
+		} else if idx1 == -2 {
+			lhs := this.properties[i].GetIRI()
+			rhs := this.properties[j].GetIRI()
+			return lhs.String() < rhs.String()
+		}
+	}
+	return false
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ForgeFedFilesAddedProperty) LessThan(o vocab.ForgeFedFilesAddedProperty) bool {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if this.properties[i].LessThan(o.At(i)) {
+			return true
+		} else if o.At(i).LessThan(this.properties[i]) {
+			return false
+		}
+	}
+	return l1 < l2
+}
+
+// Name returns the name of this property: "filesAdded", prefixed by the alias of
+// its vocabulary if it has one.
+func (this ForgeFedFilesAddedProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":filesAdded"
+	} else {
+		return "filesAdded"
+	}
+}
+
+// PrependIRI prepends an IRI value to the front of a list of the property
+// "filesAdded".
+func (this *ForgeFedFilesAddedProperty) PrependIRI(v *url.URL) {
+	this.properties = append([]*ForgeFedFilesAddedPropertyIterator{{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  0,
+		parent: this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependXMLSchemaString prepends a string value to the front of a list of the
+// property "filesAdded". Invalidates all iterators.
+func (this *ForgeFedFilesAddedProperty) PrependXMLSchemaString(v string) {
+	this.properties = append([]*ForgeFedFilesAddedPropertyIterator{{
+		alias:                 this.alias,
+		hasStringMember:       true,
+		myIdx:                 0,
+		parent:                this,
+		xmlschemaStringMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Remove deletes an element at the specified index from a list of the property
+// "filesAdded", regardless of its type. Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ForgeFedFilesAddedProperty) Remove(idx int) {
+	(this.properties)[idx].parent = nil
+	copy((this.properties)[idx:], (this.properties)[idx+1:])
+	(this.properties)[len(this.properties)-1] = &ForgeFedFilesAddedPropertyIterator{}
+	this.properties = (this.properties)[:len(this.properties)-1]
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ForgeFedFilesAddedProperty) Serialize() (interface{}, error) {
+	s := make([]interface{}, 0, len(this.properties))
+	for _, iterator := range this.properties {
+		if b, err := iterator.serialize(); err != nil {
+			return s, err
+		} else {
+			s = append(s, b)
+		}
+	}
+	// Shortcut: if serializing one value, don't return an array -- pretty sure other Fediverse software would choke on a "type" value with array, for example.
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
+
+// Set sets a string value to be at the specified index for the property
+// "filesAdded". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ForgeFedFilesAddedProperty) Set(idx int, v string) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedFilesAddedPropertyIterator{
+		alias:                 this.alias,
+		hasStringMember:       true,
+		myIdx:                 idx,
+		parent:                this,
+		xmlschemaStringMember: v,
+	}
+}
+
+// SetIRI sets an IRI value to be at the specified index for the property
+// "filesAdded". Panics if the index is out of bounds.
+func (this *ForgeFedFilesAddedProperty) SetIRI(idx int, v *url.URL) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ForgeFedFilesAddedPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+}
+
+// Swap swaps the location of values at two indices for the "filesAdded" property.
+func (this ForgeFedFilesAddedProperty) Swap(i, j int) {
+	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+}
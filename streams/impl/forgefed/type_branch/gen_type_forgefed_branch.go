@@ -0,0 +1,1854 @@
+package typebranch
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"strings"
+)
+
+// Represents a named variable reference to a version of the Repository, typically
+// used for committing changes in parallel to other development, and usually
+// eventually merging the changes into the main history line.
+//
+// Example 3 (https://forgefed.org/spec/#ex3-jsonld):
+//   {
+//     "context": "https://example.dev/luke/myrepo",
+//     "name": "master",
+//     "ref": "refs/heads/master",
+//     "type": "Branch"
+//   }
+type ForgeFedBranch struct {
+	ActivityStreamsAltitude     vocab.ActivityStreamsAltitudeProperty
+	ActivityStreamsAttachment   vocab.ActivityStreamsAttachmentProperty
+	ActivityStreamsAttributedTo vocab.ActivityStreamsAttributedToProperty
+	ActivityStreamsAudience     vocab.ActivityStreamsAudienceProperty
+	ActivityStreamsBcc          vocab.ActivityStreamsBccProperty
+	VcardBday                   vocab.VcardBdayProperty
+	ActivityStreamsBto          vocab.ActivityStreamsBtoProperty
+	ActivityStreamsCc           vocab.ActivityStreamsCcProperty
+	ActivityStreamsContent      vocab.ActivityStreamsContentProperty
+	ActivityStreamsContext      vocab.ActivityStreamsContextProperty
+	ActivityStreamsDuration     vocab.ActivityStreamsDurationProperty
+	ActivityStreamsEndTime      vocab.ActivityStreamsEndTimeProperty
+	ActivityStreamsGenerator    vocab.ActivityStreamsGeneratorProperty
+	VcardHasAddress             vocab.VcardHasAddressProperty
+	ActivityStreamsIcon         vocab.ActivityStreamsIconProperty
+	ActivityStreamsId           vocab.ActivityStreamsIdProperty
+	ActivityStreamsImage        vocab.ActivityStreamsImageProperty
+	ActivityStreamsInReplyTo    vocab.ActivityStreamsInReplyToProperty
+	ActivityStreamsLikes        vocab.ActivityStreamsLikesProperty
+	ActivityStreamsLocation     vocab.ActivityStreamsLocationProperty
+	ActivityStreamsMediaType    vocab.ActivityStreamsMediaTypeProperty
+	ActivityStreamsName         vocab.ActivityStreamsNameProperty
+	ActivityStreamsObject       vocab.ActivityStreamsObjectProperty
+	ActivityStreamsPreview      vocab.ActivityStreamsPreviewProperty
+	ActivityStreamsPublished    vocab.ActivityStreamsPublishedProperty
+	ForgeFedRef                 vocab.ForgeFedRefProperty
+	ActivityStreamsReplies      vocab.ActivityStreamsRepliesProperty
+	ActivityStreamsShares       vocab.ActivityStreamsSharesProperty
+	ActivityStreamsStartTime    vocab.ActivityStreamsStartTimeProperty
+	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
+	ActivityStreamsTag          vocab.ActivityStreamsTagProperty
+	ForgeFedTicketsTrackedBy    vocab.ForgeFedTicketsTrackedByProperty
+	ActivityStreamsTo           vocab.ActivityStreamsToProperty
+	ForgeFedTracksTicketsFor    vocab.ForgeFedTracksTicketsForProperty
+	ActivityStreamsType         vocab.ActivityStreamsTypeProperty
+	ActivityStreamsUpdated      vocab.ActivityStreamsUpdatedProperty
+	ActivityStreamsUrl          vocab.ActivityStreamsUrlProperty
+	alias                       string
+	unknown                     map[string]interface{}
+}
+
+// BranchIsDisjointWith returns true if the other provided type is disjoint with
+// the Branch type.
+func BranchIsDisjointWith(other vocab.Type) bool {
+	disjointWith := []string{"Link", "Mention"}
+	for _, disjoint := range disjointWith {
+		if disjoint == other.GetTypeName() {
+			return true
+		}
+	}
+	return false
+}
+
+// BranchIsExtendedBy returns true if the other provided type extends from the
+// Branch type. Note that it returns false if the types are the same; see the
+// "IsOrExtendsBranch" variant instead.
+func BranchIsExtendedBy(other vocab.Type) bool {
+	// Shortcut implementation: is not extended by anything.
+	return false
+}
+
+// DeserializeBranch creates a Branch from a map representation that has been
+// unmarshalled from a text or binary format.
+func DeserializeBranch(m map[string]interface{}, aliasMap map[string]string) (*ForgeFedBranch, error) {
+	alias := ""
+	aliasPrefix := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+		aliasPrefix = a + ":"
+	}
+	this := &ForgeFedBranch{
+		alias:   alias,
+		unknown: make(map[string]interface{}),
+	}
+	typeValue, ok := m["type"]
+	if !ok {
+		// Some implementations use the JSON-LD keyword instead.
+		typeValue, ok = m["@type"]
+	}
+	if !ok {
+		return nil, fmt.Errorf("no \"type\" property in map")
+	} else if typeString, ok := typeValue.(string); ok {
+		typeName := strings.TrimPrefix(typeString, aliasPrefix)
+		if typeName != "Branch" {
+			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "Branch", typeName)
+		}
+		// Fall through, success in finding a proper Type
+	} else if arrType, ok := typeValue.([]interface{}); ok {
+		found := false
+		for _, elemVal := range arrType {
+			if typeString, ok := elemVal.(string); ok && strings.TrimPrefix(typeString, aliasPrefix) == "Branch" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "Branch")
+		}
+		// Fall through, success in finding a proper Type
+	} else {
+		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+	}
+	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeAltitudePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAltitude = p
+	}
+	if p, err := mgr.DeserializeAttachmentPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAttachment = p
+	}
+	if p, err := mgr.DeserializeAttributedToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAttributedTo = p
+	}
+	if p, err := mgr.DeserializeAudiencePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAudience = p
+	}
+	if p, err := mgr.DeserializeBccPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsBcc = p
+	}
+	if p, err := mgr.DeserializeBdayPropertyVcard()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.VcardBday = p
+	}
+	if p, err := mgr.DeserializeBtoPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsBto = p
+	}
+	if p, err := mgr.DeserializeCcPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsCc = p
+	}
+	if p, err := mgr.DeserializeContentPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsContent = p
+	}
+	if p, err := mgr.DeserializeContextPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsContext = p
+	}
+	if p, err := mgr.DeserializeDurationPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsDuration = p
+	}
+	if p, err := mgr.DeserializeEndTimePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsEndTime = p
+	}
+	if p, err := mgr.DeserializeGeneratorPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsGenerator = p
+	}
+	if p, err := mgr.DeserializeHasAddressPropertyVcard()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.VcardHasAddress = p
+	}
+	if p, err := mgr.DeserializeIconPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsIcon = p
+	}
+	if p, err := mgr.DeserializeIdPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsId = p
+	}
+	if p, err := mgr.DeserializeImagePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsImage = p
+	}
+	if p, err := mgr.DeserializeInReplyToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsInReplyTo = p
+	}
+	if p, err := mgr.DeserializeLikesPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsLikes = p
+	}
+	if p, err := mgr.DeserializeLocationPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsLocation = p
+	}
+	if p, err := mgr.DeserializeMediaTypePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsMediaType = p
+	}
+	if p, err := mgr.DeserializeNamePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsName = p
+	}
+	if p, err := mgr.DeserializeObjectPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsObject = p
+	}
+	if p, err := mgr.DeserializePreviewPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsPreview = p
+	}
+	if p, err := mgr.DeserializePublishedPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsPublished = p
+	}
+	if p, err := mgr.DeserializeRefPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedRef = p
+	}
+	if p, err := mgr.DeserializeRepliesPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsReplies = p
+	}
+	if p, err := mgr.DeserializeSharesPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsShares = p
+	}
+	if p, err := mgr.DeserializeStartTimePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsStartTime = p
+	}
+	if p, err := mgr.DeserializeSummaryPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSummary = p
+	}
+	if p, err := mgr.DeserializeTagPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsTag = p
+	}
+	if p, err := mgr.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTicketsTrackedBy = p
+	}
+	if p, err := mgr.DeserializeToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsTo = p
+	}
+	if p, err := mgr.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTracksTicketsFor = p
+	}
+	if p, err := mgr.DeserializeTypePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsType = p
+	}
+	if p, err := mgr.DeserializeUpdatedPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsUpdated = p
+	}
+	if p, err := mgr.DeserializeUrlPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsUrl = p
+	}
+	// End: Known property deserialization
+
+	// Begin: Unknown deserialization
+	for k, v := range m {
+		// Begin: Code that ensures a property name is unknown
+		if k == "@type" {
+			continue
+		} else if k == "altitude" {
+			continue
+		} else if this.ActivityStreamsAltitude != nil && k == this.ActivityStreamsAltitude.Name() {
+			continue
+		} else if k == "attachment" {
+			continue
+		} else if this.ActivityStreamsAttachment != nil && k == this.ActivityStreamsAttachment.Name() {
+			continue
+		} else if k == "attributedTo" {
+			continue
+		} else if this.ActivityStreamsAttributedTo != nil && k == this.ActivityStreamsAttributedTo.Name() {
+			continue
+		} else if k == "audience" {
+			continue
+		} else if this.ActivityStreamsAudience != nil && k == this.ActivityStreamsAudience.Name() {
+			continue
+		} else if k == "bcc" {
+			continue
+		} else if this.ActivityStreamsBcc != nil && k == this.ActivityStreamsBcc.Name() {
+			continue
+		} else if k == "bday" {
+			continue
+		} else if this.VcardBday != nil && k == this.VcardBday.Name() {
+			continue
+		} else if k == "bto" {
+			continue
+		} else if this.ActivityStreamsBto != nil && k == this.ActivityStreamsBto.Name() {
+			continue
+		} else if k == "cc" {
+			continue
+		} else if this.ActivityStreamsCc != nil && k == this.ActivityStreamsCc.Name() {
+			continue
+		} else if k == "content" {
+			continue
+		} else if this.ActivityStreamsContent != nil && k == this.ActivityStreamsContent.Name() {
+			continue
+		} else if k == "contentMap" {
+			continue
+		} else if k == "context" {
+			continue
+		} else if this.ActivityStreamsContext != nil && k == this.ActivityStreamsContext.Name() {
+			continue
+		} else if k == "duration" {
+			continue
+		} else if this.ActivityStreamsDuration != nil && k == this.ActivityStreamsDuration.Name() {
+			continue
+		} else if k == "endTime" {
+			continue
+		} else if this.ActivityStreamsEndTime != nil && k == this.ActivityStreamsEndTime.Name() {
+			continue
+		} else if k == "generator" {
+			continue
+		} else if this.ActivityStreamsGenerator != nil && k == this.ActivityStreamsGenerator.Name() {
+			continue
+		} else if k == "hasAddress" {
+			continue
+		} else if this.VcardHasAddress != nil && k == this.VcardHasAddress.Name() {
+			continue
+		} else if k == "icon" {
+			continue
+		} else if this.ActivityStreamsIcon != nil && k == this.ActivityStreamsIcon.Name() {
+			continue
+		} else if k == "id" {
+			continue
+		} else if this.ActivityStreamsId != nil && k == this.ActivityStreamsId.Name() {
+			continue
+		} else if k == "image" {
+			continue
+		} else if this.ActivityStreamsImage != nil && k == this.ActivityStreamsImage.Name() {
+			continue
+		} else if k == "inReplyTo" {
+			continue
+		} else if this.ActivityStreamsInReplyTo != nil && k == this.ActivityStreamsInReplyTo.Name() {
+			continue
+		} else if k == "likes" {
+			continue
+		} else if this.ActivityStreamsLikes != nil && k == this.ActivityStreamsLikes.Name() {
+			continue
+		} else if k == "location" {
+			continue
+		} else if this.ActivityStreamsLocation != nil && k == this.ActivityStreamsLocation.Name() {
+			continue
+		} else if k == "mediaType" {
+			continue
+		} else if this.ActivityStreamsMediaType != nil && k == this.ActivityStreamsMediaType.Name() {
+			continue
+		} else if k == "name" {
+			continue
+		} else if this.ActivityStreamsName != nil && k == this.ActivityStreamsName.Name() {
+			continue
+		} else if k == "nameMap" {
+			continue
+		} else if k == "object" {
+			continue
+		} else if this.ActivityStreamsObject != nil && k == this.ActivityStreamsObject.Name() {
+			continue
+		} else if k == "preview" {
+			continue
+		} else if this.ActivityStreamsPreview != nil && k == this.ActivityStreamsPreview.Name() {
+			continue
+		} else if k == "published" {
+			continue
+		} else if this.ActivityStreamsPublished != nil && k == this.ActivityStreamsPublished.Name() {
+			continue
+		} else if k == "ref" {
+			continue
+		} else if this.ForgeFedRef != nil && k == this.ForgeFedRef.Name() {
+			continue
+		} else if k == "replies" {
+			continue
+		} else if this.ActivityStreamsReplies != nil && k == this.ActivityStreamsReplies.Name() {
+			continue
+		} else if k == "shares" {
+			continue
+		} else if this.ActivityStreamsShares != nil && k == this.ActivityStreamsShares.Name() {
+			continue
+		} else if k == "startTime" {
+			continue
+		} else if this.ActivityStreamsStartTime != nil && k == this.ActivityStreamsStartTime.Name() {
+			continue
+		} else if k == "summary" {
+			continue
+		} else if this.ActivityStreamsSummary != nil && k == this.ActivityStreamsSummary.Name() {
+			continue
+		} else if k == "summaryMap" {
+			continue
+		} else if k == "tag" {
+			continue
+		} else if this.ActivityStreamsTag != nil && k == this.ActivityStreamsTag.Name() {
+			continue
+		} else if k == "ticketsTrackedBy" {
+			continue
+		} else if this.ForgeFedTicketsTrackedBy != nil && k == this.ForgeFedTicketsTrackedBy.Name() {
+			continue
+		} else if k == "to" {
+			continue
+		} else if this.ActivityStreamsTo != nil && k == this.ActivityStreamsTo.Name() {
+			continue
+		} else if k == "tracksTicketsFor" {
+			continue
+		} else if this.ForgeFedTracksTicketsFor != nil && k == this.ForgeFedTracksTicketsFor.Name() {
+			continue
+		} else if k == "type" {
+			continue
+		} else if this.ActivityStreamsType != nil && k == this.ActivityStreamsType.Name() {
+			continue
+		} else if k == "updated" {
+			continue
+		} else if this.ActivityStreamsUpdated != nil && k == this.ActivityStreamsUpdated.Name() {
+			continue
+		} else if k == "url" {
+			continue
+		} else if this.ActivityStreamsUrl != nil && k == this.ActivityStreamsUrl.Name() {
+			continue
+		} // End: Code that ensures a property name is unknown
+
+		this.unknown[k] = v
+	}
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// ForgeFedBranchExtends returns true if the Branch type extends from the other
+// type.
+func ForgeFedBranchExtends(other vocab.Type) bool {
+	extensions := []string{"Object"}
+	for _, ext := range extensions {
+		if ext == other.GetTypeName() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOrExtendsBranch returns true if the other provided type is the Branch type or
+// extends from the Branch type.
+func IsOrExtendsBranch(other vocab.Type) bool {
+	if other.GetTypeName() == "Branch" {
+		return true
+	}
+	return BranchIsExtendedBy(other)
+}
+
+// NewForgeFedBranch creates a new Branch type
+func NewForgeFedBranch() *ForgeFedBranch {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("ff:Branch")
+	return &ForgeFedBranch{
+		ActivityStreamsType: typeProp,
+		alias:               "ff",
+		unknown:             make(map[string]interface{}, 0),
+	}
+}
+
+// GetActivityStreamsAltitude returns the "altitude" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
+	return this.ActivityStreamsAltitude
+}
+
+// GetActivityStreamsAttachment returns the "attachment" property if it exists,
+// and nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty {
+	return this.ActivityStreamsAttachment
+}
+
+// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
+// exists, and nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
+	return this.ActivityStreamsAttributedTo
+}
+
+// GetActivityStreamsAudience returns the "audience" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty {
+	return this.ActivityStreamsAudience
+}
+
+// GetActivityStreamsBcc returns the "bcc" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty {
+	return this.ActivityStreamsBcc
+}
+
+// GetActivityStreamsBto returns the "bto" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty {
+	return this.ActivityStreamsBto
+}
+
+// GetActivityStreamsCc returns the "cc" property if it exists, and nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsCc() vocab.ActivityStreamsCcProperty {
+	return this.ActivityStreamsCc
+}
+
+// GetActivityStreamsContent returns the "content" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsContent() vocab.ActivityStreamsContentProperty {
+	return this.ActivityStreamsContent
+}
+
+// GetActivityStreamsContext returns the "context" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsContext() vocab.ActivityStreamsContextProperty {
+	return this.ActivityStreamsContext
+}
+
+// GetActivityStreamsDuration returns the "duration" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsDuration() vocab.ActivityStreamsDurationProperty {
+	return this.ActivityStreamsDuration
+}
+
+// GetActivityStreamsEndTime returns the "endTime" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty {
+	return this.ActivityStreamsEndTime
+}
+
+// GetActivityStreamsGenerator returns the "generator" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsGenerator() vocab.ActivityStreamsGeneratorProperty {
+	return this.ActivityStreamsGenerator
+}
+
+// GetActivityStreamsIcon returns the "icon" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty {
+	return this.ActivityStreamsIcon
+}
+
+// GetActivityStreamsId returns the "id" property if it exists, and nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsId() vocab.ActivityStreamsIdProperty {
+	return this.ActivityStreamsId
+}
+
+// GetActivityStreamsImage returns the "image" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsImage() vocab.ActivityStreamsImageProperty {
+	return this.ActivityStreamsImage
+}
+
+// GetActivityStreamsInReplyTo returns the "inReplyTo" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsInReplyTo() vocab.ActivityStreamsInReplyToProperty {
+	return this.ActivityStreamsInReplyTo
+}
+
+// GetActivityStreamsLikes returns the "likes" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty {
+	return this.ActivityStreamsLikes
+}
+
+// GetActivityStreamsLocation returns the "location" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty {
+	return this.ActivityStreamsLocation
+}
+
+// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
+	return this.ActivityStreamsMediaType
+}
+
+// GetActivityStreamsName returns the "name" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
+	return this.ActivityStreamsName
+}
+
+// GetActivityStreamsObject returns the "object" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty {
+	return this.ActivityStreamsObject
+}
+
+// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
+	return this.ActivityStreamsPreview
+}
+
+// GetActivityStreamsPublished returns the "published" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty {
+	return this.ActivityStreamsPublished
+}
+
+// GetActivityStreamsReplies returns the "replies" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty {
+	return this.ActivityStreamsReplies
+}
+
+// GetActivityStreamsShares returns the "shares" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
+	return this.ActivityStreamsShares
+}
+
+// GetActivityStreamsStartTime returns the "startTime" property if it exists, and
+// nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty {
+	return this.ActivityStreamsStartTime
+}
+
+// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
+	return this.ActivityStreamsSummary
+}
+
+// GetActivityStreamsTag returns the "tag" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsTag() vocab.ActivityStreamsTagProperty {
+	return this.ActivityStreamsTag
+}
+
+// GetActivityStreamsTo returns the "to" property if it exists, and nil otherwise.
+func (this ForgeFedBranch) GetActivityStreamsTo() vocab.ActivityStreamsToProperty {
+	return this.ActivityStreamsTo
+}
+
+// GetActivityStreamsType returns the "type" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsType() vocab.ActivityStreamsTypeProperty {
+	return this.ActivityStreamsType
+}
+
+// GetActivityStreamsUpdated returns the "updated" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty {
+	return this.ActivityStreamsUpdated
+}
+
+// GetActivityStreamsUrl returns the "url" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty {
+	return this.ActivityStreamsUrl
+}
+
+// GetForgeFedRef returns the "ref" property if it exists, and nil otherwise.
+func (this ForgeFedBranch) GetForgeFedRef() vocab.ForgeFedRefProperty {
+	return this.ForgeFedRef
+}
+
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ForgeFedBranch) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ForgeFedBranch) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
+// GetTypeName returns the name of this type.
+func (this ForgeFedBranch) GetTypeName() string {
+	return "Branch"
+}
+
+// GetUnknownProperties returns the unknown properties for the Branch type. Note
+// that this should not be used by app developers. It is only used to help
+// determine which implementation is LessThan the other. Developers who are
+// creating a different implementation of this type's interface can use this
+// method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this ForgeFedBranch) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GetVcardBday returns the "bday" property if it exists, and nil otherwise.
+func (this ForgeFedBranch) GetVcardBday() vocab.VcardBdayProperty {
+	return this.VcardBday
+}
+
+// GetVcardHasAddress returns the "hasAddress" property if it exists, and nil
+// otherwise.
+func (this ForgeFedBranch) GetVcardHasAddress() vocab.VcardHasAddressProperty {
+	return this.VcardHasAddress
+}
+
+// IsExtending returns true if the Branch type extends from the other type.
+func (this ForgeFedBranch) IsExtending(other vocab.Type) bool {
+	return ForgeFedBranchExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this ForgeFedBranch) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
+	m = this.helperJSONLDContext(this.VcardBday, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
+	m = this.helperJSONLDContext(this.VcardHasAddress, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsId, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsInReplyTo, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsLikes, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsLocation, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
+	m = this.helperJSONLDContext(this.ForgeFedRef, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
+	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
+	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsType, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
+
+	return m
+}
+
+// LessThan computes if this Branch is lesser, with an arbitrary but stable
+// determination.
+func (this ForgeFedBranch) LessThan(o vocab.ForgeFedBranch) bool {
+	// Begin: Compare known properties
+	// Compare property "altitude"
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "attachment"
+	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "attributedTo"
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "audience"
+	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "bcc"
+	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "bday"
+	if lhs, rhs := this.VcardBday, o.GetVcardBday(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "bto"
+	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "cc"
+	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "content"
+	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "context"
+	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "duration"
+	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "endTime"
+	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "generator"
+	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "hasAddress"
+	if lhs, rhs := this.VcardHasAddress, o.GetVcardHasAddress(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "icon"
+	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "id"
+	if lhs, rhs := this.ActivityStreamsId, o.GetActivityStreamsId(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "image"
+	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "inReplyTo"
+	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "likes"
+	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "location"
+	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "mediaType"
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "name"
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "object"
+	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "preview"
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "published"
+	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "ref"
+	if lhs, rhs := this.ForgeFedRef, o.GetForgeFedRef(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "replies"
+	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "shares"
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "startTime"
+	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "summary"
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "tag"
+	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "ticketsTrackedBy"
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "to"
+	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "tracksTicketsFor"
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "type"
+	if lhs, rhs := this.ActivityStreamsType, o.GetActivityStreamsType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "updated"
+	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "url"
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this ForgeFedBranch) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "Branch"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "Branch"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "altitude"
+	if this.ActivityStreamsAltitude != nil {
+		if i, err := this.ActivityStreamsAltitude.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAltitude.Name()] = i
+		}
+	}
+	// Maybe serialize property "attachment"
+	if this.ActivityStreamsAttachment != nil {
+		if i, err := this.ActivityStreamsAttachment.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAttachment.Name()] = i
+		}
+	}
+	// Maybe serialize property "attributedTo"
+	if this.ActivityStreamsAttributedTo != nil {
+		if i, err := this.ActivityStreamsAttributedTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAttributedTo.Name()] = i
+		}
+	}
+	// Maybe serialize property "audience"
+	if this.ActivityStreamsAudience != nil {
+		if i, err := this.ActivityStreamsAudience.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAudience.Name()] = i
+		}
+	}
+	// Maybe serialize property "bcc"
+	if this.ActivityStreamsBcc != nil {
+		if i, err := this.ActivityStreamsBcc.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsBcc.Name()] = i
+		}
+	}
+	// Maybe serialize property "bday"
+	if this.VcardBday != nil {
+		if i, err := this.VcardBday.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.VcardBday.Name()] = i
+		}
+	}
+	// Maybe serialize property "bto"
+	if this.ActivityStreamsBto != nil {
+		if i, err := this.ActivityStreamsBto.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsBto.Name()] = i
+		}
+	}
+	// Maybe serialize property "cc"
+	if this.ActivityStreamsCc != nil {
+		if i, err := this.ActivityStreamsCc.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsCc.Name()] = i
+		}
+	}
+	// Maybe serialize property "content"
+	if this.ActivityStreamsContent != nil {
+		if i, err := this.ActivityStreamsContent.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsContent.Name()] = i
+		}
+	}
+	// Maybe serialize property "context"
+	if this.ActivityStreamsContext != nil {
+		if i, err := this.ActivityStreamsContext.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsContext.Name()] = i
+		}
+	}
+	// Maybe serialize property "duration"
+	if this.ActivityStreamsDuration != nil {
+		if i, err := this.ActivityStreamsDuration.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsDuration.Name()] = i
+		}
+	}
+	// Maybe serialize property "endTime"
+	if this.ActivityStreamsEndTime != nil {
+		if i, err := this.ActivityStreamsEndTime.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsEndTime.Name()] = i
+		}
+	}
+	// Maybe serialize property "generator"
+	if this.ActivityStreamsGenerator != nil {
+		if i, err := this.ActivityStreamsGenerator.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsGenerator.Name()] = i
+		}
+	}
+	// Maybe serialize property "hasAddress"
+	if this.VcardHasAddress != nil {
+		if i, err := this.VcardHasAddress.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.VcardHasAddress.Name()] = i
+		}
+	}
+	// Maybe serialize property "icon"
+	if this.ActivityStreamsIcon != nil {
+		if i, err := this.ActivityStreamsIcon.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsIcon.Name()] = i
+		}
+	}
+	// Maybe serialize property "id"
+	if this.ActivityStreamsId != nil {
+		if i, err := this.ActivityStreamsId.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsId.Name()] = i
+		}
+	}
+	// Maybe serialize property "image"
+	if this.ActivityStreamsImage != nil {
+		if i, err := this.ActivityStreamsImage.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsImage.Name()] = i
+		}
+	}
+	// Maybe serialize property "inReplyTo"
+	if this.ActivityStreamsInReplyTo != nil {
+		if i, err := this.ActivityStreamsInReplyTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsInReplyTo.Name()] = i
+		}
+	}
+	// Maybe serialize property "likes"
+	if this.ActivityStreamsLikes != nil {
+		if i, err := this.ActivityStreamsLikes.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsLikes.Name()] = i
+		}
+	}
+	// Maybe serialize property "location"
+	if this.ActivityStreamsLocation != nil {
+		if i, err := this.ActivityStreamsLocation.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsLocation.Name()] = i
+		}
+	}
+	// Maybe serialize property "mediaType"
+	if this.ActivityStreamsMediaType != nil {
+		if i, err := this.ActivityStreamsMediaType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsMediaType.Name()] = i
+		}
+	}
+	// Maybe serialize property "name"
+	if this.ActivityStreamsName != nil {
+		if i, err := this.ActivityStreamsName.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsName.Name()] = i
+		}
+	}
+	// Maybe serialize property "object"
+	if this.ActivityStreamsObject != nil {
+		if i, err := this.ActivityStreamsObject.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsObject.Name()] = i
+		}
+	}
+	// Maybe serialize property "preview"
+	if this.ActivityStreamsPreview != nil {
+		if i, err := this.ActivityStreamsPreview.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsPreview.Name()] = i
+		}
+	}
+	// Maybe serialize property "published"
+	if this.ActivityStreamsPublished != nil {
+		if i, err := this.ActivityStreamsPublished.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsPublished.Name()] = i
+		}
+	}
+	// Maybe serialize property "ref"
+	if this.ForgeFedRef != nil {
+		if i, err := this.ForgeFedRef.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedRef.Name()] = i
+		}
+	}
+	// Maybe serialize property "replies"
+	if this.ActivityStreamsReplies != nil {
+		if i, err := this.ActivityStreamsReplies.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsReplies.Name()] = i
+		}
+	}
+	// Maybe serialize property "shares"
+	if this.ActivityStreamsShares != nil {
+		if i, err := this.ActivityStreamsShares.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsShares.Name()] = i
+		}
+	}
+	// Maybe serialize property "startTime"
+	if this.ActivityStreamsStartTime != nil {
+		if i, err := this.ActivityStreamsStartTime.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsStartTime.Name()] = i
+		}
+	}
+	// Maybe serialize property "summary"
+	if this.ActivityStreamsSummary != nil {
+		if i, err := this.ActivityStreamsSummary.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSummary.Name()] = i
+		}
+	}
+	// Maybe serialize property "tag"
+	if this.ActivityStreamsTag != nil {
+		if i, err := this.ActivityStreamsTag.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsTag.Name()] = i
+		}
+	}
+	// Maybe serialize property "ticketsTrackedBy"
+	if this.ForgeFedTicketsTrackedBy != nil {
+		if i, err := this.ForgeFedTicketsTrackedBy.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTicketsTrackedBy.Name()] = i
+		}
+	}
+	// Maybe serialize property "to"
+	if this.ActivityStreamsTo != nil {
+		if i, err := this.ActivityStreamsTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsTo.Name()] = i
+		}
+	}
+	// Maybe serialize property "tracksTicketsFor"
+	if this.ForgeFedTracksTicketsFor != nil {
+		if i, err := this.ForgeFedTracksTicketsFor.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTracksTicketsFor.Name()] = i
+		}
+	}
+	// Maybe serialize property "type"
+	if this.ActivityStreamsType != nil {
+		if i, err := this.ActivityStreamsType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsType.Name()] = i
+		}
+	}
+	// Maybe serialize property "updated"
+	if this.ActivityStreamsUpdated != nil {
+		if i, err := this.ActivityStreamsUpdated.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsUpdated.Name()] = i
+		}
+	}
+	// Maybe serialize property "url"
+	if this.ActivityStreamsUrl != nil {
+		if i, err := this.ActivityStreamsUrl.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsUrl.Name()] = i
+		}
+	}
+	// End: Serialize known properties
+
+	// Begin: Serialize unknown properties
+	for k, v := range this.unknown {
+		// To be safe, ensure we aren't overwriting a known property
+		if _, has := m[k]; !has {
+			m[k] = v
+		}
+	}
+	// End: Serialize unknown properties
+
+	return m, nil
+}
+
+// SetActivityStreamsAltitude sets the "altitude" property.
+func (this *ForgeFedBranch) SetActivityStreamsAltitude(i vocab.ActivityStreamsAltitudeProperty) {
+	this.ActivityStreamsAltitude = i
+}
+
+// SetActivityStreamsAttachment sets the "attachment" property.
+func (this *ForgeFedBranch) SetActivityStreamsAttachment(i vocab.ActivityStreamsAttachmentProperty) {
+	this.ActivityStreamsAttachment = i
+}
+
+// SetActivityStreamsAttributedTo sets the "attributedTo" property.
+func (this *ForgeFedBranch) SetActivityStreamsAttributedTo(i vocab.ActivityStreamsAttributedToProperty) {
+	this.ActivityStreamsAttributedTo = i
+}
+
+// SetActivityStreamsAudience sets the "audience" property.
+func (this *ForgeFedBranch) SetActivityStreamsAudience(i vocab.ActivityStreamsAudienceProperty) {
+	this.ActivityStreamsAudience = i
+}
+
+// SetActivityStreamsBcc sets the "bcc" property.
+func (this *ForgeFedBranch) SetActivityStreamsBcc(i vocab.ActivityStreamsBccProperty) {
+	this.ActivityStreamsBcc = i
+}
+
+// SetActivityStreamsBto sets the "bto" property.
+func (this *ForgeFedBranch) SetActivityStreamsBto(i vocab.ActivityStreamsBtoProperty) {
+	this.ActivityStreamsBto = i
+}
+
+// SetActivityStreamsCc sets the "cc" property.
+func (this *ForgeFedBranch) SetActivityStreamsCc(i vocab.ActivityStreamsCcProperty) {
+	this.ActivityStreamsCc = i
+}
+
+// SetActivityStreamsContent sets the "content" property.
+func (this *ForgeFedBranch) SetActivityStreamsContent(i vocab.ActivityStreamsContentProperty) {
+	this.ActivityStreamsContent = i
+}
+
+// SetActivityStreamsContext sets the "context" property.
+func (this *ForgeFedBranch) SetActivityStreamsContext(i vocab.ActivityStreamsContextProperty) {
+	this.ActivityStreamsContext = i
+}
+
+// SetActivityStreamsDuration sets the "duration" property.
+func (this *ForgeFedBranch) SetActivityStreamsDuration(i vocab.ActivityStreamsDurationProperty) {
+	this.ActivityStreamsDuration = i
+}
+
+// SetActivityStreamsEndTime sets the "endTime" property.
+func (this *ForgeFedBranch) SetActivityStreamsEndTime(i vocab.ActivityStreamsEndTimeProperty) {
+	this.ActivityStreamsEndTime = i
+}
+
+// SetActivityStreamsGenerator sets the "generator" property.
+func (this *ForgeFedBranch) SetActivityStreamsGenerator(i vocab.ActivityStreamsGeneratorProperty) {
+	this.ActivityStreamsGenerator = i
+}
+
+// SetActivityStreamsIcon sets the "icon" property.
+func (this *ForgeFedBranch) SetActivityStreamsIcon(i vocab.ActivityStreamsIconProperty) {
+	this.ActivityStreamsIcon = i
+}
+
+// SetActivityStreamsId sets the "id" property.
+func (this *ForgeFedBranch) SetActivityStreamsId(i vocab.ActivityStreamsIdProperty) {
+	this.ActivityStreamsId = i
+}
+
+// SetActivityStreamsImage sets the "image" property.
+func (this *ForgeFedBranch) SetActivityStreamsImage(i vocab.ActivityStreamsImageProperty) {
+	this.ActivityStreamsImage = i
+}
+
+// SetActivityStreamsInReplyTo sets the "inReplyTo" property.
+func (this *ForgeFedBranch) SetActivityStreamsInReplyTo(i vocab.ActivityStreamsInReplyToProperty) {
+	this.ActivityStreamsInReplyTo = i
+}
+
+// SetActivityStreamsLikes sets the "likes" property.
+func (this *ForgeFedBranch) SetActivityStreamsLikes(i vocab.ActivityStreamsLikesProperty) {
+	this.ActivityStreamsLikes = i
+}
+
+// SetActivityStreamsLocation sets the "location" property.
+func (this *ForgeFedBranch) SetActivityStreamsLocation(i vocab.ActivityStreamsLocationProperty) {
+	this.ActivityStreamsLocation = i
+}
+
+// SetActivityStreamsMediaType sets the "mediaType" property.
+func (this *ForgeFedBranch) SetActivityStreamsMediaType(i vocab.ActivityStreamsMediaTypeProperty) {
+	this.ActivityStreamsMediaType = i
+}
+
+// SetActivityStreamsName sets the "name" property.
+func (this *ForgeFedBranch) SetActivityStreamsName(i vocab.ActivityStreamsNameProperty) {
+	this.ActivityStreamsName = i
+}
+
+// SetActivityStreamsObject sets the "object" property.
+func (this *ForgeFedBranch) SetActivityStreamsObject(i vocab.ActivityStreamsObjectProperty) {
+	this.ActivityStreamsObject = i
+}
+
+// SetActivityStreamsPreview sets the "preview" property.
+func (this *ForgeFedBranch) SetActivityStreamsPreview(i vocab.ActivityStreamsPreviewProperty) {
+	this.ActivityStreamsPreview = i
+}
+
+// SetActivityStreamsPublished sets the "published" property.
+func (this *ForgeFedBranch) SetActivityStreamsPublished(i vocab.ActivityStreamsPublishedProperty) {
+	this.ActivityStreamsPublished = i
+}
+
+// SetActivityStreamsReplies sets the "replies" property.
+func (this *ForgeFedBranch) SetActivityStreamsReplies(i vocab.ActivityStreamsRepliesProperty) {
+	this.ActivityStreamsReplies = i
+}
+
+// SetActivityStreamsShares sets the "shares" property.
+func (this *ForgeFedBranch) SetActivityStreamsShares(i vocab.ActivityStreamsSharesProperty) {
+	this.ActivityStreamsShares = i
+}
+
+// SetActivityStreamsStartTime sets the "startTime" property.
+func (this *ForgeFedBranch) SetActivityStreamsStartTime(i vocab.ActivityStreamsStartTimeProperty) {
+	this.ActivityStreamsStartTime = i
+}
+
+// SetActivityStreamsSummary sets the "summary" property.
+func (this *ForgeFedBranch) SetActivityStreamsSummary(i vocab.ActivityStreamsSummaryProperty) {
+	this.ActivityStreamsSummary = i
+}
+
+// SetActivityStreamsTag sets the "tag" property.
+func (this *ForgeFedBranch) SetActivityStreamsTag(i vocab.ActivityStreamsTagProperty) {
+	this.ActivityStreamsTag = i
+}
+
+// SetActivityStreamsTo sets the "to" property.
+func (this *ForgeFedBranch) SetActivityStreamsTo(i vocab.ActivityStreamsToProperty) {
+	this.ActivityStreamsTo = i
+}
+
+// SetActivityStreamsType sets the "type" property.
+func (this *ForgeFedBranch) SetActivityStreamsType(i vocab.ActivityStreamsTypeProperty) {
+	this.ActivityStreamsType = i
+}
+
+// SetActivityStreamsUpdated sets the "updated" property.
+func (this *ForgeFedBranch) SetActivityStreamsUpdated(i vocab.ActivityStreamsUpdatedProperty) {
+	this.ActivityStreamsUpdated = i
+}
+
+// SetActivityStreamsUrl sets the "url" property.
+func (this *ForgeFedBranch) SetActivityStreamsUrl(i vocab.ActivityStreamsUrlProperty) {
+	this.ActivityStreamsUrl = i
+}
+
+// SetForgeFedRef sets the "ref" property.
+func (this *ForgeFedBranch) SetForgeFedRef(i vocab.ForgeFedRefProperty) {
+	this.ForgeFedRef = i
+}
+
+// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+func (this *ForgeFedBranch) SetForgeFedTicketsTrackedBy(i vocab.ForgeFedTicketsTrackedByProperty) {
+	this.ForgeFedTicketsTrackedBy = i
+}
+
+// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+func (this *ForgeFedBranch) SetForgeFedTracksTicketsFor(i vocab.ForgeFedTracksTicketsForProperty) {
+	this.ForgeFedTracksTicketsFor = i
+}
+
+// SetVcardBday sets the "bday" property.
+func (this *ForgeFedBranch) SetVcardBday(i vocab.VcardBdayProperty) {
+	this.VcardBday = i
+}
+
+// SetVcardHasAddress sets the "hasAddress" property.
+func (this *ForgeFedBranch) SetVcardHasAddress(i vocab.VcardHasAddressProperty) {
+	this.VcardHasAddress = i
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this ForgeFedBranch) VocabularyURI() string {
+	return "https://forgefed.org/ns"
+}
+
+// helperJSONLDContext obtains the context uris and their aliases from a property,
+// if it is not nil.
+func (this ForgeFedBranch) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+	if i == nil {
+		return toMerge
+	}
+	for k, v := range i.JSONLDContext() {
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		toMerge[k] = v
+	}
+	return toMerge
+}
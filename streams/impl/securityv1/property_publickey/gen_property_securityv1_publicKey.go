@@ -0,0 +1,637 @@
+package propertypublickey
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// SecurityV1PublicKeyPropertyIterator is an iterator for a property. It is
+// permitted to be a single nilable value type.
+type SecurityV1PublicKeyPropertyIterator struct {
+	securityv1PublicKeyMember vocab.SecurityV1PublicKey
+	unknown                   interface{}
+	iri                       *url.URL
+	alias                     string
+	myIdx                     int
+	parent                    vocab.SecurityV1PublicKeyProperty
+}
+
+// NewSecurityV1PublicKeyPropertyIterator creates a new SecurityV1PublicKey
+// property.
+func NewSecurityV1PublicKeyPropertyIterator() *SecurityV1PublicKeyPropertyIterator {
+	return &SecurityV1PublicKeyPropertyIterator{alias: ""}
+}
+
+// deserializeSecurityV1PublicKeyPropertyIterator creates an iterator from an
+// element that has been unmarshalled from a text or binary format.
+func deserializeSecurityV1PublicKeyPropertyIterator(i interface{}, aliasMap map[string]string) (*SecurityV1PublicKeyPropertyIterator, error) {
+	alias := ""
+	if a, ok := aliasMap["https://w3id.org/security/v1"]; ok {
+		alias = a
+	}
+	if s, ok := i.(string); ok {
+		u, err := url.Parse(s)
+		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+		if err == nil && len(u.Scheme) > 0 {
+			this := &SecurityV1PublicKeyPropertyIterator{
+				alias: alias,
+				iri:   u,
+			}
+			return this, nil
+		}
+	}
+	if m, ok := i.(map[string]interface{}); ok {
+		if _, ok := m["type"]; !ok {
+			if _, ok = m["@type"]; !ok {
+				// Values may omit their type, as they can only be a PublicKey.
+				typed := make(map[string]interface{}, len(m)+1)
+				for k, v := range m {
+					typed[k] = v
+				}
+				typed["type"] = "PublicKey"
+				m = typed
+			}
+		}
+
+		if v, err := mgr.DeserializePublicKeySecurityV1()(m, aliasMap); err == nil {
+			this := &SecurityV1PublicKeyPropertyIterator{
+				alias:                     alias,
+				securityv1PublicKeyMember: v,
+			}
+			return this, nil
+		}
+	}
+	this := &SecurityV1PublicKeyPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
+}
+
+// Get returns the value of this property. When IsSecurityV1PublicKey returns
+// false, Get will return any arbitrary value.
+func (this SecurityV1PublicKeyPropertyIterator) Get() vocab.SecurityV1PublicKey {
+	return this.securityv1PublicKeyMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this SecurityV1PublicKeyPropertyIterator) GetIRI() *url.URL {
+	return this.iri
+}
+
+// GetType returns the value in this property as a Type. Returns nil if the value
+// is not an ActivityStreams type, such as an IRI or another value.
+func (this SecurityV1PublicKeyPropertyIterator) GetType() vocab.Type {
+	if this.IsSecurityV1PublicKey() {
+		return this.Get()
+	}
+
+	return nil
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this SecurityV1PublicKeyPropertyIterator) HasAny() bool {
+	return this.IsSecurityV1PublicKey() || this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this SecurityV1PublicKeyPropertyIterator) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsSecurityV1PublicKey returns true if this property is set and not an IRI.
+func (this SecurityV1PublicKeyPropertyIterator) IsSecurityV1PublicKey() bool {
+	return this.securityv1PublicKeyMember != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this SecurityV1PublicKeyPropertyIterator) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/v1": this.alias}
+	var child map[string]string
+	if this.IsSecurityV1PublicKey() {
+		child = this.Get().JSONLDContext()
+	}
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this SecurityV1PublicKeyPropertyIterator) KindIndex() int {
+	if this.IsSecurityV1PublicKey() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this SecurityV1PublicKeyPropertyIterator) LessThan(o vocab.SecurityV1PublicKeyPropertyIterator) bool {
+	// LessThan comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	} else if this.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return true
+	} else if o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return false
+	}
+	// LessThan comparison for the single value or unknown value.
+	if !this.IsSecurityV1PublicKey() && !o.IsSecurityV1PublicKey() {
+		// Both are unknowns.
+		return false
+	} else if this.IsSecurityV1PublicKey() && !o.IsSecurityV1PublicKey() {
+		// Values are always greater than unknown values.
+		return false
+	} else if !this.IsSecurityV1PublicKey() && o.IsSecurityV1PublicKey() {
+		// Unknowns are always less than known values.
+		return true
+	} else {
+		// Actual comparison.
+		return this.Get().LessThan(o.Get())
+	}
+}
+
+// Name returns the name of this property: "SecurityV1PublicKey", prefixed by the
+// alias of its vocabulary if it has one.
+func (this SecurityV1PublicKeyPropertyIterator) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":SecurityV1PublicKey"
+	} else {
+		return "SecurityV1PublicKey"
+	}
+}
+
+// Next returns the next iterator, or nil if there is no next iterator.
+func (this SecurityV1PublicKeyPropertyIterator) Next() vocab.SecurityV1PublicKeyPropertyIterator {
+	if this.myIdx+1 >= this.parent.Len() {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx + 1)
+	}
+}
+
+// Prev returns the previous iterator, or nil if there is no previous iterator.
+func (this SecurityV1PublicKeyPropertyIterator) Prev() vocab.SecurityV1PublicKeyPropertyIterator {
+	if this.myIdx-1 < 0 {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx - 1)
+	}
+}
+
+// Set sets the value of this property. Calling IsSecurityV1PublicKey afterwards
+// will return true.
+func (this *SecurityV1PublicKeyPropertyIterator) Set(v vocab.SecurityV1PublicKey) {
+	this.clear()
+	this.securityv1PublicKeyMember = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *SecurityV1PublicKeyPropertyIterator) SetIRI(v *url.URL) {
+	this.clear()
+	this.iri = v
+}
+
+// SetType attempts to set the property for the arbitrary type. Returns an error
+// if it is not a valid type to set on this property.
+func (this *SecurityV1PublicKeyPropertyIterator) SetType(t vocab.Type) error {
+	if v, ok := t.(vocab.SecurityV1PublicKey); ok {
+		this.Set(v)
+		return nil
+	}
+
+	return fmt.Errorf("illegal type to set on SecurityV1PublicKey property: %T", t)
+}
+
+// clear ensures no value of this property is set. Calling IsSecurityV1PublicKey
+// afterwards will return false.
+func (this *SecurityV1PublicKeyPropertyIterator) clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.securityv1PublicKeyMember = nil
+}
+
+// serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this SecurityV1PublicKeyPropertyIterator) serialize() (interface{}, error) {
+	if this.IsSecurityV1PublicKey() {
+		return this.Get().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// SecurityV1PublicKeyProperty is the non-functional property "publicKey". It is
+// permitted to have one or more values, and of different value types.
+type SecurityV1PublicKeyProperty struct {
+	properties []*SecurityV1PublicKeyPropertyIterator
+	alias      string
+}
+
+// DeserializePublicKeyProperty creates a "publicKey" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializePublicKeyProperty(m map[string]interface{}, aliasMap map[string]string) (vocab.SecurityV1PublicKeyProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://w3id.org/security/v1"]; ok {
+		alias = a
+	}
+	propName := "publicKey"
+	if len(alias) > 0 {
+		propName = fmt.Sprintf("%s:%s", alias, "publicKey")
+	}
+	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["publicKey"]
+	}
+
+	if ok {
+		this := &SecurityV1PublicKeyProperty{
+			alias:      alias,
+			properties: []*SecurityV1PublicKeyPropertyIterator{},
+		}
+		if list, ok := i.([]interface{}); ok {
+			for _, iterator := range list {
+				if p, err := deserializeSecurityV1PublicKeyPropertyIterator(iterator, aliasMap); err != nil {
+					return this, err
+				} else if p != nil {
+					this.properties = append(this.properties, p)
+				}
+			}
+		} else {
+			if p, err := deserializeSecurityV1PublicKeyPropertyIterator(i, aliasMap); err != nil {
+				return this, err
+			} else if p != nil {
+				this.properties = append(this.properties, p)
+			}
+		}
+		// Set up the properties for iteration.
+		for idx, ele := range this.properties {
+			ele.parent = this
+			ele.myIdx = idx
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewSecurityV1PublicKeyProperty creates a new publicKey property.
+func NewSecurityV1PublicKeyProperty() *SecurityV1PublicKeyProperty {
+	return &SecurityV1PublicKeyProperty{alias: ""}
+}
+
+// AppendIRI appends an IRI value to the back of a list of the property "publicKey"
+func (this *SecurityV1PublicKeyProperty) AppendIRI(v *url.URL) {
+	this.properties = append(this.properties, &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  this.Len(),
+		parent: this,
+	})
+}
+
+// AppendSecurityV1PublicKey appends a PublicKey value to the back of a list of
+// the property "publicKey". Invalidates iterators that are traversing using
+// Prev.
+func (this *SecurityV1PublicKeyProperty) AppendSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.properties = append(this.properties, &SecurityV1PublicKeyPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	})
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "publicKey". Invalidates iterators that are traversing using Prev.
+// Returns an error if the type is not a valid one to set for this property.
+func (this *SecurityV1PublicKeyProperty) AppendType(t vocab.Type) error {
+	n := &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  this.Len(),
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append(this.properties, n)
+	return nil
+}
+
+// At returns the property value for the specified index. Panics if the index is
+// out of bounds.
+func (this SecurityV1PublicKeyProperty) At(index int) vocab.SecurityV1PublicKeyPropertyIterator {
+	return this.properties[index]
+}
+
+// Begin returns the first iterator, or nil if empty. Can be used with the
+// iterator's Next method and this property's End method to iterate from front
+// to back through all values.
+func (this SecurityV1PublicKeyProperty) Begin() vocab.SecurityV1PublicKeyPropertyIterator {
+	if this.Empty() {
+		return nil
+	} else {
+		return this.properties[0]
+	}
+}
+
+// Empty returns returns true if there are no elements.
+func (this SecurityV1PublicKeyProperty) Empty() bool {
+	return this.Len() == 0
+}
+
+// End returns beyond-the-last iterator, which is nil. Can be used with the
+// iterator's Next method and this property's Begin method to iterate from
+// front to back through all values.
+func (this SecurityV1PublicKeyProperty) End() vocab.SecurityV1PublicKeyPropertyIterator {
+	return nil
+}
+
+// Insert inserts an IRI value at the specified index for a property "publicKey".
+// Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *SecurityV1PublicKeyProperty) InsertIRI(idx int, v *url.URL) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertSecurityV1PublicKey inserts a PublicKey value at the specified index for
+// a property "publicKey". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *SecurityV1PublicKeyProperty) InsertSecurityV1PublicKey(idx int, v vocab.SecurityV1PublicKey) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &SecurityV1PublicKeyPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "publicKey". Invalidates all iterators. Returns an error if the
+// type is not a valid one to set for this property.
+func (this *SecurityV1PublicKeyProperty) InsertType(idx int, t vocab.Type) error {
+	n := &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = n
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+	return nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this SecurityV1PublicKeyProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/v1": this.alias}
+	for _, elem := range this.properties {
+		child := elem.JSONLDContext()
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		for k, v := range child {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API method specifically needed only for alternate implementations
+// for go-fed. Applications should not use this method. Panics if the index is
+// out of bounds.
+func (this SecurityV1PublicKeyProperty) KindIndex(idx int) int {
+	return this.properties[idx].KindIndex()
+}
+
+// Len returns the number of values that exist for the "publicKey" property.
+func (this SecurityV1PublicKeyProperty) Len() (length int) {
+	return len(this.properties)
+}
+
+// Less computes whether another property is less than this one. Mixing types
+// results in a consistent but arbitrary ordering
+func (this SecurityV1PublicKeyProperty) Less(i, j int) bool {
+	idx1 := this.KindIndex(i)
+	idx2 := this.KindIndex(j)
+	if idx1 < idx2 {
+		return true
+	} else if idx1 == idx2 {
+		if idx1 == 0 {
+			lhs := this.properties[i].Get()
+			rhs := this.properties[j].Get()
+			return lhs.LessThan(rhs)
+		} else if idx1 == -2 {
+			lhs := this.properties[i].GetIRI()
+			rhs := this.properties[j].GetIRI()
+			return lhs.String() < rhs.String()
+		}
+	}
+	return false
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this SecurityV1PublicKeyProperty) LessThan(o vocab.SecurityV1PublicKeyProperty) bool {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if this.properties[i].LessThan(o.At(i)) {
+			return true
+		} else if o.At(i).LessThan(this.properties[i]) {
+			return false
+		}
+	}
+	return l1 < l2
+}
+
+// Name returns the name of this property: "publicKey", prefixed by the alias of
+// its vocabulary if it has one.
+func (this SecurityV1PublicKeyProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":publicKey"
+	} else {
+		return "publicKey"
+	}
+}
+
+// PrependIRI prepends an IRI value to the front of a list of the property
+// "publicKey".
+func (this *SecurityV1PublicKeyProperty) PrependIRI(v *url.URL) {
+	this.properties = append([]*SecurityV1PublicKeyPropertyIterator{{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  0,
+		parent: this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependSecurityV1PublicKey prepends a PublicKey value to the front of a list of
+// the property "publicKey". Invalidates all iterators.
+func (this *SecurityV1PublicKeyProperty) PrependSecurityV1PublicKey(v vocab.SecurityV1PublicKey) {
+	this.properties = append([]*SecurityV1PublicKeyPropertyIterator{{
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "publicKey". Invalidates all iterators. Returns an error if the
+// type is not a valid one to set for this property.
+func (this *SecurityV1PublicKeyProperty) PrependType(t vocab.Type) error {
+	n := &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  0,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append([]*SecurityV1PublicKeyPropertyIterator{n}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+	return nil
+}
+
+// Remove deletes an element at the specified index from a list of the property
+// "publicKey", regardless of its type. Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *SecurityV1PublicKeyProperty) Remove(idx int) {
+	(this.properties)[idx].parent = nil
+	copy((this.properties)[idx:], (this.properties)[idx+1:])
+	(this.properties)[len(this.properties)-1] = &SecurityV1PublicKeyPropertyIterator{}
+	this.properties = (this.properties)[:len(this.properties)-1]
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this SecurityV1PublicKeyProperty) Serialize() (interface{}, error) {
+	s := make([]interface{}, 0, len(this.properties))
+	for _, iterator := range this.properties {
+		if b, err := iterator.serialize(); err != nil {
+			return s, err
+		} else {
+			s = append(s, b)
+		}
+	}
+	// Shortcut: if serializing one value, don't return an array -- pretty sure other Fediverse software would choke on a "type" value with array, for example.
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
+
+// Set sets a PublicKey value to be at the specified index for the property
+// "publicKey". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *SecurityV1PublicKeyProperty) Set(idx int, v vocab.SecurityV1PublicKey) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &SecurityV1PublicKeyPropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		securityv1PublicKeyMember: v,
+	}
+}
+
+// SetIRI sets an IRI value to be at the specified index for the property
+// "publicKey". Panics if the index is out of bounds.
+func (this *SecurityV1PublicKeyProperty) SetIRI(idx int, v *url.URL) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+}
+
+// SetType sets an arbitrary type value to the specified index of the property
+// "publicKey". Invalidates all iterators. Returns an error if the type is not
+// a valid one to set for this property. Panics if the index is out of bounds.
+func (this *SecurityV1PublicKeyProperty) SetType(idx int, t vocab.Type) error {
+	n := &SecurityV1PublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	(this.properties)[idx] = n
+	return nil
+}
+
+// Swap swaps the location of values at two indices for the "publicKey" property.
+func (this SecurityV1PublicKeyProperty) Swap(i, j int) {
+	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+}
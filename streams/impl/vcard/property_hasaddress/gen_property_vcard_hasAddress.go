@@ -0,0 +1,266 @@
+package propertyhasaddress
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// VcardHasAddressProperty is the functional property "hasAddress". It is
+// permitted to be one of multiple value types. At most, one type of value can
+// be present, or none at all. Setting a value will clear the other types of
+// values so that only one of the 'Is' methods will return true. It is
+// possible to clear all values, so that this property is empty.
+type VcardHasAddressProperty struct {
+	vcardAddressMember vocab.VcardAddress
+	vcardHomeMember    vocab.VcardHome
+	unknown            interface{}
+	iri                *url.URL
+	alias              string
+}
+
+// DeserializeHasAddressProperty creates a "hasAddress" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeHasAddressProperty(m map[string]interface{}, aliasMap map[string]string) (*VcardHasAddressProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["http://www.w3.org/2006/vcard/ns"]; ok {
+		alias = a
+	}
+	propName := "hasAddress"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "hasAddress")
+	}
+	i, ok := m[propName]
+	if !ok && len(alias) > 0 {
+		// Some implementations, such as Mastodon, define the terms of the vocabulary in the context, and use them without alias.
+		i, ok = m["hasAddress"]
+	}
+
+	if ok {
+		if s, ok := i.(string); ok {
+			u, err := url.Parse(s)
+			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+			if err == nil && len(u.Scheme) > 0 {
+				this := &VcardHasAddressProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
+		if m, ok := i.(map[string]interface{}); ok {
+
+			if v, err := mgr.DeserializeAddressVcard()(m, aliasMap); err == nil {
+				this := &VcardHasAddressProperty{
+					alias:              alias,
+					vcardAddressMember: v,
+				}
+				return this, nil
+			} else if v, err := mgr.DeserializeHomeVcard()(m, aliasMap); err == nil {
+				this := &VcardHasAddressProperty{
+					alias:           alias,
+					vcardHomeMember: v,
+				}
+				return this, nil
+			}
+		}
+		this := &VcardHasAddressProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewVcardHasAddressProperty creates a new hasAddress property.
+func NewVcardHasAddressProperty() *VcardHasAddressProperty {
+	return &VcardHasAddressProperty{alias: "v"}
+}
+
+// Clear ensures no value of this property is set. Calling HasAny or any of the
+// 'Is' methods afterwards will return false.
+func (this *VcardHasAddressProperty) Clear() {
+	this.vcardAddressMember = nil
+	this.vcardHomeMember = nil
+	this.unknown = nil
+	this.iri = nil
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return an arbitrary value.
+func (this VcardHasAddressProperty) GetIRI() *url.URL {
+	return this.iri
+}
+
+// GetType returns the value in this property as a Type. Returns nil if the value
+// is not an ActivityStreams type, such as an IRI or another value.
+func (this VcardHasAddressProperty) GetType() vocab.Type {
+	if this.IsVcardAddress() {
+		return this.GetVcardAddress()
+	}
+	if this.IsVcardHome() {
+		return this.GetVcardHome()
+	}
+
+	return nil
+}
+
+// GetVcardAddress returns the value of this property. When IsVcardAddress returns
+// false, GetVcardAddress will return an arbitrary value.
+func (this VcardHasAddressProperty) GetVcardAddress() vocab.VcardAddress {
+	return this.vcardAddressMember
+}
+
+// GetVcardHome returns the value of this property. When IsVcardHome returns
+// false, GetVcardHome will return an arbitrary value.
+func (this VcardHasAddressProperty) GetVcardHome() vocab.VcardHome {
+	return this.vcardHomeMember
+}
+
+// HasAny returns true if any of the different values is set.
+func (this VcardHasAddressProperty) HasAny() bool {
+	return this.IsVcardAddress() ||
+		this.IsVcardHome() ||
+		this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
+// to access and set this property
+func (this VcardHasAddressProperty) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsVcardAddress returns true if this property has a type of "Address". When
+// true, use the GetVcardAddress and SetVcardAddress methods to access and set
+// this property.
+func (this VcardHasAddressProperty) IsVcardAddress() bool {
+	return this.vcardAddressMember != nil
+}
+
+// IsVcardHome returns true if this property has a type of "Home". When true, use
+// the GetVcardHome and SetVcardHome methods to access and set this property.
+func (this VcardHasAddressProperty) IsVcardHome() bool {
+	return this.vcardHomeMember != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this VcardHasAddressProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"http://www.w3.org/2006/vcard/ns": this.alias}
+	var child map[string]string
+	if this.IsVcardAddress() {
+		child = this.GetVcardAddress().JSONLDContext()
+	} else if this.IsVcardHome() {
+		child = this.GetVcardHome().JSONLDContext()
+	}
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this VcardHasAddressProperty) KindIndex() int {
+	if this.IsVcardAddress() {
+		return 0
+	}
+	if this.IsVcardHome() {
+		return 1
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this VcardHasAddressProperty) LessThan(o vocab.VcardHasAddressProperty) bool {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return true
+	} else if idx1 > idx2 {
+		return false
+	} else if this.IsVcardAddress() {
+		return this.GetVcardAddress().LessThan(o.GetVcardAddress())
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().LessThan(o.GetVcardHome())
+	} else if this.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	}
+	return false
+}
+
+// Name returns the name of this property: "hasAddress", prefixed by the alias of
+// its vocabulary if it has one.
+func (this VcardHasAddressProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":hasAddress"
+	} else {
+		return "hasAddress"
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this VcardHasAddressProperty) Serialize() (interface{}, error) {
+	if this.IsVcardAddress() {
+		return this.GetVcardAddress().Serialize()
+	} else if this.IsVcardHome() {
+		return this.GetVcardHome().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
+func (this *VcardHasAddressProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.iri = v
+}
+
+// SetType attempts to set the property for the arbitrary type. Returns an error
+// if it is not a valid type to set on this property.
+func (this *VcardHasAddressProperty) SetType(t vocab.Type) error {
+	if v, ok := t.(vocab.VcardAddress); ok {
+		this.SetVcardAddress(v)
+		return nil
+	}
+	if v, ok := t.(vocab.VcardHome); ok {
+		this.SetVcardHome(v)
+		return nil
+	}
+
+	return fmt.Errorf("illegal type to set on hasAddress property: %T", t)
+}
+
+// SetVcardAddress sets the value of this property. Calling IsVcardAddress
+// afterwards returns true.
+func (this *VcardHasAddressProperty) SetVcardAddress(v vocab.VcardAddress) {
+	this.Clear()
+	this.vcardAddressMember = v
+}
+
+// SetVcardHome sets the value of this property. Calling IsVcardHome afterwards
+// returns true.
+func (this *VcardHasAddressProperty) SetVcardHome(v vocab.VcardHome) {
+	this.Clear()
+	this.vcardHomeMember = v
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/go-test/deep"
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -425,6 +426,79 @@ func TestJSONResolverErrors(t *testing.T) {
 	}
 }
 
+func TestJSONResolverMultipleTypes(t *testing.T) {
+	const (
+		personAndSchemaPerson = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": ["http://schema.org/Person", "Person"],
+  "name": "Sally"
+}`
+		ticketDependency = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://forgefed.org/ns"],
+  "type": ["Relationship", "TicketDependency"],
+  "relationship": "dependsOn"
+}`
+	)
+	toMap := func(t *testing.T, s string) map[string]interface{} {
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatalf("Cannot json.Unmarshal: %s", err)
+		}
+		return m
+	}
+	t.Run("IgnoresUnknownTypes", func(t *testing.T) {
+		v, err := ToType(context.Background(), toMap(t, personAndSchemaPerson))
+		if err != nil {
+			t.Fatalf("Cannot ToType: %s", err)
+		}
+		if _, ok := v.(vocab.ActivityStreamsPerson); !ok {
+			t.Fatalf("Expected a Person, got %T", v)
+		}
+		if n := v.GetActivityStreamsType().Len(); n != 2 {
+			t.Errorf("Expected 2 types to be retained, got %d", n)
+		}
+	})
+	t.Run("PrefersMostSpecificType", func(t *testing.T) {
+		v, err := ToType(context.Background(), toMap(t, ticketDependency))
+		if err != nil {
+			t.Fatalf("Cannot ToType: %s", err)
+		}
+		if _, ok := v.(vocab.ForgeFedTicketDependency); !ok {
+			t.Fatalf("Expected a TicketDependency, got %T", v)
+		}
+		if n := v.GetActivityStreamsType().Len(); n != 2 {
+			t.Errorf("Expected 2 types to be retained, got %d", n)
+		}
+	})
+	t.Run("FallsBackToLessSpecificType", func(t *testing.T) {
+		var called bool
+		r, err := NewJSONResolver(func(c context.Context, x vocab.ActivityStreamsRelationship) error {
+			called = true
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Cannot create JSONResolver: %s", err)
+		}
+		if err := r.Resolve(context.Background(), toMap(t, ticketDependency)); err != nil {
+			t.Fatalf("Cannot JSONResolver.Resolve: %s", err)
+		}
+		if !called {
+			t.Errorf("Expected the Relationship callback to be called")
+		}
+	})
+	t.Run("NoCallbackMatch", func(t *testing.T) {
+		r, err := NewJSONResolver(func(c context.Context, x vocab.ActivityStreamsNote) error {
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Cannot create JSONResolver: %s", err)
+		}
+		if err := r.Resolve(context.Background(), toMap(t, ticketDependency)); err != ErrNoCallbackMatch {
+			t.Errorf("Expected ErrNoCallbackMatch, got %v", err)
+		}
+	})
+}
+
 func TestNulls(t *testing.T) {
 	const (
 		samIRIInboxString = "https://example.com/sam/inbox"
@@ -586,6 +660,341 @@ func TestNulls(t *testing.T) {
 	}
 }
 
+func TestForgeFed(t *testing.T) {
+	const (
+		ticketIRIString   = "https://example.dev/alice/myrepo/issues/42"
+		assigneeIRIString = "https://example.dev/alice"
+	)
+	ticket := NewForgeFedTicket()
+	id := NewActivityStreamsIdProperty()
+	id.Set(MustParseURL(ticketIRIString))
+	ticket.SetActivityStreamsId(id)
+	assignedTo := NewForgeFedAssignedToProperty()
+	assignedTo.SetIRI(MustParseURL(assigneeIRIString))
+	ticket.SetForgeFedAssignedTo(assignedTo)
+	isResolved := NewForgeFedIsResolvedProperty()
+	isResolved.Set(true)
+	ticket.SetForgeFedIsResolved(isResolved)
+	m, err := ticket.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	}
+	m["@context"] = []interface{}{
+		"https://www.w3.org/ns/activitystreams",
+		map[string]interface{}{
+			"ff": "https://forgefed.org/ns",
+		},
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Cannot json.Marshal: %s", err)
+	}
+	m = make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal: %s", err)
+	}
+	var resolved vocab.ForgeFedTicket
+	r, err := NewJSONResolver(func(c context.Context, x vocab.ForgeFedTicket) error {
+		resolved = x
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Cannot create JSONResolver: %s", err)
+	}
+	if err := r.Resolve(context.Background(), m); err != nil {
+		t.Fatalf("Cannot JSONResolver.Resolve %s: %s", b, err)
+	}
+	if resolved == nil {
+		t.Fatalf("Expected a ForgeFedTicket to be resolved from %s", b)
+	}
+	if a := resolved.GetForgeFedAssignedTo(); a == nil || !a.IsIRI() || a.GetIRI().String() != assigneeIRIString {
+		t.Errorf("Expected assignedTo %q, got %v", assigneeIRIString, a)
+	}
+	if i := resolved.GetForgeFedIsResolved(); i == nil || !i.Get() {
+		t.Errorf("Expected isResolved to be true, got %v", i)
+	}
+}
+
+func TestVcard(t *testing.T) {
+	// As found on Friendica actor profiles.
+	const friendicaPerson = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "vcard": "http://www.w3.org/2006/vcard/ns#"
+    }
+  ],
+  "id": "https://friendica.example/profile/alice",
+  "type": "Person",
+  "vcard:bday": "1990-01-01",
+  "vcard:hasAddress": {
+    "@type": "vcard:Home",
+    "vcard:country-name": "Finland",
+    "vcard:region": "Uusimaa",
+    "vcard:locality": "Helsinki"
+  }
+}`
+	// The JSON-LD "@type" keyword is serialized as "type".
+	const expected = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "vcard": "http://www.w3.org/2006/vcard/ns#"
+    }
+  ],
+  "id": "https://friendica.example/profile/alice",
+  "type": "Person",
+  "vcard:bday": "1990-01-01",
+  "vcard:hasAddress": {
+    "type": "vcard:Home",
+    "vcard:country-name": "Finland",
+    "vcard:region": "Uusimaa",
+    "vcard:locality": "Helsinki"
+  }
+}`
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(friendicaPerson), &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal: %s", err)
+	}
+	var person vocab.ActivityStreamsPerson
+	r, err := NewJSONResolver(func(c context.Context, x vocab.ActivityStreamsPerson) error {
+		person = x
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Cannot create JSONResolver: %s", err)
+	}
+	if err := r.Resolve(context.Background(), m); err != nil {
+		t.Fatalf("Cannot JSONResolver.Resolve: %s", err)
+	}
+	if bday := person.GetVcardBday(); bday == nil || bday.Get() != "1990-01-01" {
+		t.Errorf("Expected bday %q, got %v", "1990-01-01", bday)
+	}
+	addr := person.GetVcardHasAddress()
+	if addr == nil || !addr.IsVcardHome() {
+		t.Fatalf("Expected hasAddress to be a Home, got %v", addr)
+	}
+	if locality := addr.GetVcardHome().GetVcardLocality(); locality == nil || locality.Get() != "Helsinki" {
+		t.Errorf("Expected locality %q, got %v", "Helsinki", locality)
+	}
+	for k := range person.GetUnknownProperties() {
+		if k != "@context" {
+			t.Errorf("Expected no unknown properties, got %q", k)
+		}
+	}
+	ser, err := person.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	}
+	b, err := json.Marshal(ser)
+	if err != nil {
+		t.Fatalf("Cannot json.Marshal: %s", err)
+	}
+	if diff, err := GetJSONDiff(b, []byte(expected)); err == nil && diff != nil {
+		t.Errorf("Serialize JSON equality is false:\n%s", diff)
+	} else if err != nil {
+		t.Errorf("GetJSONDiff returned error: %s", err)
+	}
+}
+
+func TestToot(t *testing.T) {
+	// As found on Mastodon statuses, which use the terms of the vocabulary
+	// without alias.
+	const mastodonQuestion = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "toot": "http://joinmastodon.org/ns#",
+      "Emoji": "toot:Emoji",
+      "blurhash": "toot:blurhash",
+      "focalPoint": {
+        "@container": "@list",
+        "@id": "toot:focalPoint"
+      },
+      "votersCount": "toot:votersCount"
+    }
+  ],
+  "id": "https://mastodon.example/users/alice/statuses/1",
+  "type": "Question",
+  "content": "<p>:blobcat: or not?</p>",
+  "votersCount": 7,
+  "attachment": {
+    "type": "Document",
+    "mediaType": "image/png",
+    "url": "https://mastodon.example/files/1.png",
+    "blurhash": "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH",
+    "focalPoint": [-0.5, 0.25]
+  },
+  "tag": {
+    "id": "https://mastodon.example/emojis/1",
+    "type": "Emoji",
+    "name": ":blobcat:",
+    "icon": {
+      "type": "Image",
+      "url": "https://mastodon.example/files/blobcat.png"
+    }
+  }
+}`
+	// Properties are serialized with the alias of the vocabulary.
+	const expected = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "toot": "http://joinmastodon.org/ns#",
+      "Emoji": "toot:Emoji",
+      "blurhash": "toot:blurhash",
+      "focalPoint": {
+        "@container": "@list",
+        "@id": "toot:focalPoint"
+      },
+      "votersCount": "toot:votersCount"
+    }
+  ],
+  "id": "https://mastodon.example/users/alice/statuses/1",
+  "type": "Question",
+  "content": "<p>:blobcat: or not?</p>",
+  "toot:votersCount": 7,
+  "attachment": {
+    "type": "Document",
+    "mediaType": "image/png",
+    "url": "https://mastodon.example/files/1.png",
+    "toot:blurhash": "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH",
+    "toot:focalPoint": [-0.5, 0.25]
+  },
+  "tag": {
+    "id": "https://mastodon.example/emojis/1",
+    "type": "Emoji",
+    "name": ":blobcat:",
+    "icon": {
+      "type": "Image",
+      "url": "https://mastodon.example/files/blobcat.png"
+    }
+  }
+}`
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(mastodonQuestion), &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal: %s", err)
+	}
+	var question vocab.ActivityStreamsQuestion
+	r, err := NewJSONResolver(func(c context.Context, x vocab.ActivityStreamsQuestion) error {
+		question = x
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Cannot create JSONResolver: %s", err)
+	}
+	if err := r.Resolve(context.Background(), m); err != nil {
+		t.Fatalf("Cannot JSONResolver.Resolve: %s", err)
+	}
+	if voters := question.GetTootVotersCount(); voters == nil || voters.Get() != 7 {
+		t.Errorf("Expected votersCount %d, got %v", 7, voters)
+	}
+	attachment := question.GetActivityStreamsAttachment()
+	if attachment == nil || !attachment.At(0).IsActivityStreamsDocument() {
+		t.Fatalf("Expected a Document attachment, got %v", attachment)
+	}
+	doc := attachment.At(0).GetActivityStreamsDocument()
+	if blurhash := doc.GetTootBlurhash(); blurhash == nil || blurhash.Get() != "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH" {
+		t.Errorf("Expected blurhash, got %v", blurhash)
+	}
+	if focalPoint := doc.GetTootFocalPoint(); focalPoint == nil || focalPoint.Len() != 2 || focalPoint.At(1).Get() != 0.25 {
+		t.Errorf("Expected focalPoint, got %v", focalPoint)
+	}
+	tag := question.GetActivityStreamsTag()
+	if tag == nil || !tag.At(0).IsTootEmoji() {
+		t.Fatalf("Expected an Emoji tag, got %v", tag)
+	}
+	for k := range doc.GetUnknownProperties() {
+		t.Errorf("Expected no unknown properties, got %q", k)
+	}
+	ser, err := question.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	}
+	b, err := json.Marshal(ser)
+	if err != nil {
+		t.Fatalf("Cannot json.Marshal: %s", err)
+	}
+	if diff, err := GetJSONDiff(b, []byte(expected)); err == nil && diff != nil {
+		t.Errorf("Serialize JSON equality is false:\n%s", diff)
+	} else if err != nil {
+		t.Errorf("GetJSONDiff returned error: %s", err)
+	}
+}
+
+func TestSecurityV1(t *testing.T) {
+	// As found on Mastodon actors, whose keys have no type.
+	const mastodonPerson = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://w3id.org/security/v1"
+  ],
+  "id": "https://mastodon.example/users/alice",
+  "type": "Person",
+  "publicKey": {
+    "id": "https://mastodon.example/users/alice#main-key",
+    "owner": "https://mastodon.example/users/alice",
+    "publicKeyPem": "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQ==\n-----END PUBLIC KEY-----\n"
+  }
+}`
+	const expected = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://w3id.org/security/v1"
+  ],
+  "id": "https://mastodon.example/users/alice",
+  "type": "Person",
+  "publicKey": {
+    "id": "https://mastodon.example/users/alice#main-key",
+    "type": "PublicKey",
+    "owner": "https://mastodon.example/users/alice",
+    "publicKeyPem": "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQ==\n-----END PUBLIC KEY-----\n"
+  }
+}`
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(mastodonPerson), &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal: %s", err)
+	}
+	var person vocab.ActivityStreamsPerson
+	r, err := NewJSONResolver(func(c context.Context, x vocab.ActivityStreamsPerson) error {
+		person = x
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Cannot create JSONResolver: %s", err)
+	}
+	if err := r.Resolve(context.Background(), m); err != nil {
+		t.Fatalf("Cannot JSONResolver.Resolve: %s", err)
+	}
+	pks := person.GetSecurityV1PublicKey()
+	if pks == nil || pks.Len() != 1 || !pks.At(0).IsSecurityV1PublicKey() {
+		t.Fatalf("Expected a PublicKey, got %v", pks)
+	}
+	pk := pks.At(0).Get()
+	if owner := pk.GetSecurityV1Owner(); owner == nil || owner.Get().String() != "https://mastodon.example/users/alice" {
+		t.Errorf("Expected owner %q, got %v", "https://mastodon.example/users/alice", owner)
+	}
+	if pem := pk.GetSecurityV1PublicKeyPem(); pem == nil || !strings.HasPrefix(pem.Get(), "-----BEGIN PUBLIC KEY-----") {
+		t.Errorf("Expected publicKeyPem, got %v", pem)
+	}
+	if alias, ok := person.JSONLDContext()["https://w3id.org/security/v1"]; !ok || alias != "" {
+		t.Errorf("Expected the security vocabulary without alias in the context, got %v", person.JSONLDContext())
+	}
+	ser, err := person.Serialize()
+	if err != nil {
+		t.Fatalf("Cannot Serialize: %s", err)
+	}
+	b, err := json.Marshal(ser)
+	if err != nil {
+		t.Fatalf("Cannot json.Marshal: %s", err)
+	}
+	if diff, err := GetJSONDiff(b, []byte(expected)); err == nil && diff != nil {
+		t.Errorf("Serialize JSON equality is false:\n%s", diff)
+	} else if err != nil {
+		t.Errorf("GetJSONDiff returned error: %s", err)
+	}
+}
+
 func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	var i1 interface{}
 	var i2 interface{}
@@ -600,3 +1009,146 @@ func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	}
 	return deep.Equal(i1, i2), nil
 }
+
+func TestDecode(t *testing.T) {
+	ctx := context.Background()
+	t.Run("MatchesToType", func(t *testing.T) {
+		for _, example := range GetTestTable() {
+			if skip, _ := IsKnownResolverError(example); skip {
+				continue
+			}
+			m := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(example.expectedJSON), &m); err != nil {
+				t.Fatalf("%s: Cannot json.Unmarshal: %s", example.name, err)
+			}
+			expected, err := ToType(ctx, m)
+			if err != nil {
+				t.Fatalf("%s: ToType: %s", example.name, err)
+			}
+			actual, err := Decode(ctx, strings.NewReader(example.expectedJSON))
+			if err != nil {
+				t.Fatalf("%s: Decode: %s", example.name, err)
+			}
+			em, _ := expected.Serialize()
+			am, _ := actual.Serialize()
+			if diff := deep.Equal(am, em); diff != nil {
+				t.Errorf("%s: Decode differs from ToType: %v", example.name, diff)
+			}
+		}
+	})
+	t.Run("ResolvesItemsOneAtATime", func(t *testing.T) {
+		const collection = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/outbox",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    "https://example.com/activities/1",
+    {"id": "https://example.com/activities/2", "type": "Create", "object": {"type": "Note", "content": "hi"}}
+  ]
+}`
+		v, err := Decode(ctx, strings.NewReader(collection))
+		if err != nil {
+			t.Fatal(err)
+		}
+		oc, ok := v.(vocab.ActivityStreamsOrderedCollection)
+		if !ok {
+			t.Fatalf("Decode returned %T", v)
+		}
+		items := oc.GetActivityStreamsOrderedItems()
+		if items.Len() != 2 || !items.At(0).IsIRI() || !items.At(1).IsActivityStreamsCreate() {
+			t.Fatalf("unexpected items %v", items)
+		}
+		m, _ := items.At(1).GetActivityStreamsCreate().Serialize()
+		if _, ok := m["@context"]; ok {
+			t.Errorf("item kept the collection's @context")
+		}
+	})
+	t.Run("FallsBackForUnknownItems", func(t *testing.T) {
+		const collection = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Collection",
+  "items": [{"type": "Note"}, {"type": "Emoji", "name": ":blobcat:"}]
+}`
+		v, err := Decode(ctx, strings.NewReader(collection))
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, _ := v.Serialize()
+		if items, ok := m["items"].([]interface{}); !ok || len(items) != 2 {
+			t.Errorf("unexpected items %v", m["items"])
+		}
+	})
+	t.Run("RejectsNonObjects", func(t *testing.T) {
+		if _, err := Decode(ctx, strings.NewReader(`["https://example.com"]`)); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestToTypeWithFallback(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ResolvesKnownTypes", func(t *testing.T) {
+		m := map[string]interface{}{
+			"@context": "https://www.w3.org/ns/activitystreams",
+			"type":     "Note",
+		}
+		v, err := ToTypeWithFallback(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := v.(vocab.ActivityStreamsNote); !ok {
+			t.Fatalf("ToTypeWithFallback returned %T", v)
+		}
+	})
+	t.Run("PreservesUnknownTypes", func(t *testing.T) {
+		const extension = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {"ext": "https://example.com/ns#", "rating": {"@id": "ext:rating", "@type": "xsd:integer"}}
+  ],
+  "id": "https://example.com/reviews/1",
+  "type": "ext:Review",
+  "rating": 4,
+  "object": {"type": "Note", "content": "hi"}
+}`
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(extension), &m); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ToType(ctx, m); err != ErrUnhandledType {
+			t.Fatalf("ToType returned %v", err)
+		}
+		v, err := ToTypeWithFallback(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, ok := v.(*UnknownType)
+		if !ok {
+			t.Fatalf("ToTypeWithFallback returned %T", v)
+		}
+		if u.GetTypeName() != "ext:Review" {
+			t.Errorf("got type name %q", u.GetTypeName())
+		}
+		if id := u.GetActivityStreamsId(); id == nil || id.Get().String() != "https://example.com/reviews/1" {
+			t.Errorf("got id %v", id)
+		}
+		if alias := u.JSONLDContext()["https://example.com/ns#"]; alias != "ext" {
+			t.Errorf("got alias %q", alias)
+		}
+		s, err := u.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := deep.Equal(s, m); diff != nil {
+			t.Errorf("Serialize differs from the resolved value: %v", diff)
+		}
+	})
+	t.Run("RequiresType", func(t *testing.T) {
+		m := map[string]interface{}{
+			"@context": "https://www.w3.org/ns/activitystreams",
+		}
+		if _, err := ToTypeWithFallback(ctx, m); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
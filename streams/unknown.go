@@ -0,0 +1,164 @@
+package streams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// UnknownType is a value whose type is not in the vocabularies go-fed was
+// generated from, such as an extension type of other software. Its 'id' and
+// 'type' are deserialized, and every other property is kept as decoded from
+// JSON, so it serializes again to the value it was resolved from.
+//
+// It lets servers store and relay the values they do not understand, as
+// ActivityPub requires of them.
+type UnknownType struct {
+	id      vocab.ActivityStreamsIdProperty
+	typ     vocab.ActivityStreamsTypeProperty
+	unknown map[string]interface{}
+}
+
+// Type must be implemented by UnknownType.
+var _ vocab.Type = &UnknownType{}
+
+// NewUnknownType creates an UnknownType from the generic JSON map of a value
+// with a 'type' and an '@context', whatever the type is.
+func NewUnknownType(m map[string]interface{}) (*UnknownType, error) {
+	if _, ok := m["type"]; !ok {
+		return nil, fmt.Errorf("cannot determine ActivityStreams type: 'type' property is missing")
+	}
+	rawContext, ok := m["@context"]
+	if !ok {
+		return nil, fmt.Errorf("cannot determine ActivityStreams type: '@context' is missing")
+	}
+	aliasMap := toAliasMap(rawContext)
+	u := &UnknownType{unknown: make(map[string]interface{}, len(m))}
+	var err error
+	if u.id, err = mgr.DeserializeIdPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	}
+	if u.typ, err = mgr.DeserializeTypePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	}
+	for k, v := range m {
+		u.unknown[k] = v
+	}
+	delete(u.unknown, "id")
+	delete(u.unknown, "type")
+	if u.id != nil {
+		delete(u.unknown, u.id.Name())
+	}
+	if u.typ != nil {
+		delete(u.unknown, u.typ.Name())
+	}
+	return u, nil
+}
+
+// ToTypeWithFallback resolves the generic JSON map into a Type like ToType,
+// except that values of unknown types resolve into an UnknownType instead of
+// failing with ErrUnhandledType.
+func ToTypeWithFallback(c context.Context, m map[string]interface{}) (vocab.Type, error) {
+	t, err := ToType(c, m)
+	if err == ErrUnhandledType {
+		return NewUnknownType(m)
+	}
+	return t, err
+}
+
+// GetActivityStreamsId returns the "id" property if it exists, and nil
+// otherwise.
+func (u *UnknownType) GetActivityStreamsId() vocab.ActivityStreamsIdProperty {
+	return u.id
+}
+
+// SetActivityStreamsId sets the "id" property.
+func (u *UnknownType) SetActivityStreamsId(i vocab.ActivityStreamsIdProperty) {
+	u.id = i
+}
+
+// GetActivityStreamsType returns the "type" property, holding every type of
+// the value.
+func (u *UnknownType) GetActivityStreamsType() vocab.ActivityStreamsTypeProperty {
+	return u.typ
+}
+
+// GetTypeName returns the first type of the value, as named in its JSON.
+func (u *UnknownType) GetTypeName() string {
+	if u.typ == nil {
+		return ""
+	}
+	for iter := u.typ.Begin(); iter != u.typ.End(); iter = iter.Next() {
+		if iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString()
+		} else if iter.IsIRI() {
+			return iter.GetIRI().String()
+		}
+	}
+	return ""
+}
+
+// GetUnknownProperties returns the properties of the value other than its
+// 'id' and 'type', as decoded from JSON. Changes to the map are serialized.
+func (u *UnknownType) GetUnknownProperties() map[string]interface{} {
+	return u.unknown
+}
+
+// JSONLDContext returns the vocabularies of the '@context' of the value, and
+// the terms it defines, by their aliases. Term definitions that are not plain
+// strings are not returned, but are kept by Serialize.
+func (u *UnknownType) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	contextVocabularies(m, u.unknown["@context"])
+	return m
+}
+
+// contextVocabularies adds the vocabularies and terms of the JSON-LD context to
+// the map.
+func contextVocabularies(m map[string]string, rawContext interface{}) {
+	switch v := rawContext.(type) {
+	case string:
+		m[v] = ""
+	case []interface{}:
+		for _, elem := range v {
+			contextVocabularies(m, elem)
+		}
+	case map[string]interface{}:
+		for alias, val := range v {
+			if s, ok := val.(string); ok {
+				m[s] = alias
+			}
+		}
+	}
+}
+
+// Serialize converts the value back into its generic JSON map, '@context'
+// included.
+func (u *UnknownType) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{}, len(u.unknown)+2)
+	for k, v := range u.unknown {
+		m[k] = v
+	}
+	if u.id != nil {
+		i, err := u.id.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		m[u.id.Name()] = i
+	}
+	if u.typ != nil {
+		i, err := u.typ.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		m[u.typ.Name()] = i
+	}
+	return m, nil
+}
+
+// VocabularyURI returns no vocabulary, as the type is not in any known to
+// go-fed.
+func (u *UnknownType) VocabularyURI() string {
+	return ""
+}
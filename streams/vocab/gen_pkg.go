@@ -5,6 +5,10 @@ type Type interface {
 	// GetActivityStreamsId returns the "id" property if it exists, and nil
 	// otherwise.
 	GetActivityStreamsId() ActivityStreamsIdProperty
+	// GetActivityStreamsType returns the "type" property if it exists, and
+	// nil otherwise. It holds all of the types given, including any that
+	// are not known.
+	GetActivityStreamsType() ActivityStreamsTypeProperty
 	// GetTypeName returns the ActivityStreams type name.
 	GetTypeName() string
 	// JSONLDContext returns the JSONLD URIs required in the context string
@@ -43,7 +43,8 @@ type ActivityStreamsAccuracyProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsAccuracyProperty) bool
-	// Name returns the name of this property: "accuracy".
+	// Name returns the name of this property: "accuracy", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -45,7 +45,8 @@ type ActivityStreamsAltitudeProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsAltitudeProperty) bool
-	// Name returns the name of this property: "altitude".
+	// Name returns the name of this property: "altitude", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
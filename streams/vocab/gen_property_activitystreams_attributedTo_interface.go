@@ -223,13 +223,49 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// IsActivityStreamsView returns false, GetActivityStreamsView will
 	// return an arbitrary value.
 	GetActivityStreamsView() ActivityStreamsView
+	// GetForgeFedBranch returns the value of this property. When
+	// IsForgeFedBranch returns false, GetForgeFedBranch will return an
+	// arbitrary value.
+	GetForgeFedBranch() ForgeFedBranch
+	// GetForgeFedCommit returns the value of this property. When
+	// IsForgeFedCommit returns false, GetForgeFedCommit will return an
+	// arbitrary value.
+	GetForgeFedCommit() ForgeFedCommit
+	// GetForgeFedPush returns the value of this property. When IsForgeFedPush
+	// returns false, GetForgeFedPush will return an arbitrary value.
+	GetForgeFedPush() ForgeFedPush
+	// GetForgeFedRepository returns the value of this property. When
+	// IsForgeFedRepository returns false, GetForgeFedRepository will
+	// return an arbitrary value.
+	GetForgeFedRepository() ForgeFedRepository
+	// GetForgeFedTicket returns the value of this property. When
+	// IsForgeFedTicket returns false, GetForgeFedTicket will return an
+	// arbitrary value.
+	GetForgeFedTicket() ForgeFedTicket
+	// GetForgeFedTicketDependency returns the value of this property. When
+	// IsForgeFedTicketDependency returns false,
+	// GetForgeFedTicketDependency will return an arbitrary value.
+	GetForgeFedTicketDependency() ForgeFedTicketDependency
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
+	// GetSecurityV1PublicKey returns the value of this property. When
+	// IsSecurityV1PublicKey returns false, GetSecurityV1PublicKey will
+	// return an arbitrary value.
+	GetSecurityV1PublicKey() SecurityV1PublicKey
+	// GetTootEmoji returns the value of this property. When IsTootEmoji
+	// returns false, GetTootEmoji will return an arbitrary value.
+	GetTootEmoji() TootEmoji
 	// GetType returns the value in this property as a Type. Returns nil if
 	// the value is not an ActivityStreams type, such as an IRI or another
 	// value.
 	GetType() Type
+	// GetVcardAddress returns the value of this property. When IsVcardAddress
+	// returns false, GetVcardAddress will return an arbitrary value.
+	GetVcardAddress() VcardAddress
+	// GetVcardHome returns the value of this property. When IsVcardHome
+	// returns false, GetVcardHome will return an arbitrary value.
+	GetVcardHome() VcardHome
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
 	// IsActivityStreamsAccept returns true if this property has a type of
@@ -464,9 +500,50 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// "View". When true, use the GetActivityStreamsView and
 	// SetActivityStreamsView methods to access and set this property.
 	IsActivityStreamsView() bool
+	// IsForgeFedBranch returns true if this property has a type of "Branch".
+	// When true, use the GetForgeFedBranch and SetForgeFedBranch methods
+	// to access and set this property.
+	IsForgeFedBranch() bool
+	// IsForgeFedCommit returns true if this property has a type of "Commit".
+	// When true, use the GetForgeFedCommit and SetForgeFedCommit methods
+	// to access and set this property.
+	IsForgeFedCommit() bool
+	// IsForgeFedPush returns true if this property has a type of "Push". When
+	// true, use the GetForgeFedPush and SetForgeFedPush methods to access
+	// and set this property.
+	IsForgeFedPush() bool
+	// IsForgeFedRepository returns true if this property has a type of
+	// "Repository". When true, use the GetForgeFedRepository and
+	// SetForgeFedRepository methods to access and set this property.
+	IsForgeFedRepository() bool
+	// IsForgeFedTicket returns true if this property has a type of "Ticket".
+	// When true, use the GetForgeFedTicket and SetForgeFedTicket methods
+	// to access and set this property.
+	IsForgeFedTicket() bool
+	// IsForgeFedTicketDependency returns true if this property has a type of
+	// "TicketDependency". When true, use the GetForgeFedTicketDependency
+	// and SetForgeFedTicketDependency methods to access and set this
+	// property.
+	IsForgeFedTicketDependency() bool
 	// IsIRI returns true if this property is an IRI. When true, use GetIRI
 	// and SetIRI to access and set this property
 	IsIRI() bool
+	// IsSecurityV1PublicKey returns true if this property has a type of
+	// "PublicKey". When true, use the GetSecurityV1PublicKey and
+	// SetSecurityV1PublicKey methods to access and set this property.
+	IsSecurityV1PublicKey() bool
+	// IsTootEmoji returns true if this property has a type of "Emoji". When
+	// true, use the GetTootEmoji and SetTootEmoji methods to access and
+	// set this property.
+	IsTootEmoji() bool
+	// IsVcardAddress returns true if this property has a type of "Address".
+	// When true, use the GetVcardAddress and SetVcardAddress methods to
+	// access and set this property.
+	IsVcardAddress() bool
+	// IsVcardHome returns true if this property has a type of "Home". When
+	// true, use the GetVcardHome and SetVcardHome methods to access and
+	// set this property.
+	IsVcardHome() bool
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
 	// in the map is the alias used to import the property's value or
@@ -481,7 +558,8 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsAttributedToPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsAttributedTo".
+	// Name returns the name of this property: "ActivityStreamsAttributedTo",
+	// prefixed by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsAttributedToPropertyIterator
@@ -652,12 +730,42 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// SetActivityStreamsView sets the value of this property. Calling
 	// IsActivityStreamsView afterwards returns true.
 	SetActivityStreamsView(v ActivityStreamsView)
+	// SetForgeFedBranch sets the value of this property. Calling
+	// IsForgeFedBranch afterwards returns true.
+	SetForgeFedBranch(v ForgeFedBranch)
+	// SetForgeFedCommit sets the value of this property. Calling
+	// IsForgeFedCommit afterwards returns true.
+	SetForgeFedCommit(v ForgeFedCommit)
+	// SetForgeFedPush sets the value of this property. Calling IsForgeFedPush
+	// afterwards returns true.
+	SetForgeFedPush(v ForgeFedPush)
+	// SetForgeFedRepository sets the value of this property. Calling
+	// IsForgeFedRepository afterwards returns true.
+	SetForgeFedRepository(v ForgeFedRepository)
+	// SetForgeFedTicket sets the value of this property. Calling
+	// IsForgeFedTicket afterwards returns true.
+	SetForgeFedTicket(v ForgeFedTicket)
+	// SetForgeFedTicketDependency sets the value of this property. Calling
+	// IsForgeFedTicketDependency afterwards returns true.
+	SetForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
 	SetIRI(v *url.URL)
+	// SetSecurityV1PublicKey sets the value of this property. Calling
+	// IsSecurityV1PublicKey afterwards returns true.
+	SetSecurityV1PublicKey(v SecurityV1PublicKey)
+	// SetTootEmoji sets the value of this property. Calling IsTootEmoji
+	// afterwards returns true.
+	SetTootEmoji(v TootEmoji)
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// SetVcardAddress sets the value of this property. Calling IsVcardAddress
+	// afterwards returns true.
+	SetVcardAddress(v VcardAddress)
+	// SetVcardHome sets the value of this property. Calling IsVcardHome
+	// afterwards returns true.
+	SetVcardHome(v VcardHome)
 }
 
 // Identifies one or more entities to which this object is attributed. The
@@ -907,14 +1015,54 @@ type ActivityStreamsAttributedToProperty interface {
 	// the property "attributedTo". Invalidates iterators that are
 	// traversing using Prev.
 	AppendActivityStreamsView(v ActivityStreamsView)
+	// AppendForgeFedBranch appends a Branch value to the back of a list of
+	// the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedBranch(v ForgeFedBranch)
+	// AppendForgeFedCommit appends a Commit value to the back of a list of
+	// the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedCommit(v ForgeFedCommit)
+	// AppendForgeFedPush appends a Push value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendForgeFedPush(v ForgeFedPush)
+	// AppendForgeFedRepository appends a Repository value to the back of a
+	// list of the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedRepository(v ForgeFedRepository)
+	// AppendForgeFedTicket appends a Ticket value to the back of a list of
+	// the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedTicket(v ForgeFedTicket)
+	// AppendForgeFedTicketDependency appends a TicketDependency value to the
+	// back of a list of the property "attributedTo". Invalidates
+	// iterators that are traversing using Prev.
+	AppendForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "attributedTo"
 	AppendIRI(v *url.URL)
+	// AppendSecurityV1PublicKey appends a PublicKey value to the back of a
+	// list of the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendSecurityV1PublicKey(v SecurityV1PublicKey)
+	// AppendTootEmoji appends a Emoji value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendTootEmoji(v TootEmoji)
 	// PrependType prepends an arbitrary type value to the front of a list of
 	// the property "attributedTo". Invalidates iterators that are
 	// traversing using Prev. Returns an error if the type is not a valid
 	// one to set for this property.
 	AppendType(t Type) error
+	// AppendVcardAddress appends a Address value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendVcardAddress(v VcardAddress)
+	// AppendVcardHome appends a Home value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendVcardHome(v VcardHome)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
 	At(index int) ActivityStreamsAttributedToPropertyIterator
@@ -1155,14 +1303,55 @@ type ActivityStreamsAttributedToProperty interface {
 	// for a property "attributedTo". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
 	InsertActivityStreamsView(idx int, v ActivityStreamsView)
+	// InsertForgeFedBranch inserts a Branch value at the specified index for
+	// a property "attributedTo". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedBranch(idx int, v ForgeFedBranch)
+	// InsertForgeFedCommit inserts a Commit value at the specified index for
+	// a property "attributedTo". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedCommit(idx int, v ForgeFedCommit)
+	// InsertForgeFedPush inserts a Push value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertForgeFedPush(idx int, v ForgeFedPush)
+	// InsertForgeFedRepository inserts a Repository value at the specified
+	// index for a property "attributedTo". Existing elements at that
+	// index and higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedRepository(idx int, v ForgeFedRepository)
+	// InsertForgeFedTicket inserts a Ticket value at the specified index for
+	// a property "attributedTo". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedTicket(idx int, v ForgeFedTicket)
+	// InsertForgeFedTicketDependency inserts a TicketDependency value at the
+	// specified index for a property "attributedTo". Existing elements at
+	// that index and higher are shifted back once. Invalidates all
+	// iterators.
+	InsertForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// Insert inserts an IRI value at the specified index for a property
 	// "attributedTo". Existing elements at that index and higher are
 	// shifted back once. Invalidates all iterators.
 	InsertIRI(idx int, v *url.URL)
+	// InsertSecurityV1PublicKey inserts a PublicKey value at the specified
+	// index for a property "attributedTo". Existing elements at that
+	// index and higher are shifted back once. Invalidates all iterators.
+	InsertSecurityV1PublicKey(idx int, v SecurityV1PublicKey)
+	// InsertTootEmoji inserts a Emoji value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertTootEmoji(idx int, v TootEmoji)
 	// PrependType prepends an arbitrary type value to the front of a list of
 	// the property "attributedTo". Invalidates all iterators. Returns an
 	// error if the type is not a valid one to set for this property.
 	InsertType(idx int, t Type) error
+	// InsertVcardAddress inserts a Address value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertVcardAddress(idx int, v VcardAddress)
+	// InsertVcardHome inserts a Home value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertVcardHome(idx int, v VcardHome)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
 	// in the map is the alias used to import the property's value or
@@ -1184,7 +1373,8 @@ type ActivityStreamsAttributedToProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsAttributedToProperty) bool
-	// Name returns the name of this property: "attributedTo".
+	// Name returns the name of this property: "attributedTo", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// PrependActivityStreamsAccept prepends a Accept value to the front of a
 	// list of the property "attributedTo". Invalidates all iterators.
@@ -1358,13 +1548,44 @@ type ActivityStreamsAttributedToProperty interface {
 	// PrependActivityStreamsView prepends a View value to the front of a list
 	// of the property "attributedTo". Invalidates all iterators.
 	PrependActivityStreamsView(v ActivityStreamsView)
+	// PrependForgeFedBranch prepends a Branch value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedBranch(v ForgeFedBranch)
+	// PrependForgeFedCommit prepends a Commit value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedCommit(v ForgeFedCommit)
+	// PrependForgeFedPush prepends a Push value to the front of a list of the
+	// property "attributedTo". Invalidates all iterators.
+	PrependForgeFedPush(v ForgeFedPush)
+	// PrependForgeFedRepository prepends a Repository value to the front of a
+	// list of the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedRepository(v ForgeFedRepository)
+	// PrependForgeFedTicket prepends a Ticket value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedTicket(v ForgeFedTicket)
+	// PrependForgeFedTicketDependency prepends a TicketDependency value to
+	// the front of a list of the property "attributedTo". Invalidates all
+	// iterators.
+	PrependForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "attributedTo".
 	PrependIRI(v *url.URL)
+	// PrependSecurityV1PublicKey prepends a PublicKey value to the front of a
+	// list of the property "attributedTo". Invalidates all iterators.
+	PrependSecurityV1PublicKey(v SecurityV1PublicKey)
+	// PrependTootEmoji prepends a Emoji value to the front of a list of the
+	// property "attributedTo". Invalidates all iterators.
+	PrependTootEmoji(v TootEmoji)
 	// PrependType prepends an arbitrary type value to the front of a list of
 	// the property "attributedTo". Invalidates all iterators. Returns an
 	// error if the type is not a valid one to set for this property.
 	PrependType(t Type) error
+	// PrependVcardAddress prepends a Address value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependVcardAddress(v VcardAddress)
+	// PrependVcardHome prepends a Home value to the front of a list of the
+	// property "attributedTo". Invalidates all iterators.
+	PrependVcardHome(v VcardHome)
 	// Remove deletes an element at the specified index from a list of the
 	// property "attributedTo", regardless of its type. Panics if the
 	// index is out of bounds. Invalidates all iterators.
@@ -1591,14 +1812,54 @@ type ActivityStreamsAttributedToProperty interface {
 	// for the property "attributedTo". Panics if the index is out of
 	// bounds. Invalidates all iterators.
 	SetActivityStreamsView(idx int, v ActivityStreamsView)
+	// SetForgeFedBranch sets a Branch value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedBranch(idx int, v ForgeFedBranch)
+	// SetForgeFedCommit sets a Commit value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedCommit(idx int, v ForgeFedCommit)
+	// SetForgeFedPush sets a Push value to be at the specified index for the
+	// property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedPush(idx int, v ForgeFedPush)
+	// SetForgeFedRepository sets a Repository value to be at the specified
+	// index for the property "attributedTo". Panics if the index is out
+	// of bounds. Invalidates all iterators.
+	SetForgeFedRepository(idx int, v ForgeFedRepository)
+	// SetForgeFedTicket sets a Ticket value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedTicket(idx int, v ForgeFedTicket)
+	// SetForgeFedTicketDependency sets a TicketDependency value to be at the
+	// specified index for the property "attributedTo". Panics if the
+	// index is out of bounds. Invalidates all iterators.
+	SetForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "attributedTo". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
+	// SetSecurityV1PublicKey sets a PublicKey value to be at the specified
+	// index for the property "attributedTo". Panics if the index is out
+	// of bounds. Invalidates all iterators.
+	SetSecurityV1PublicKey(idx int, v SecurityV1PublicKey)
+	// SetTootEmoji sets a Emoji value to be at the specified index for the
+	// property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetTootEmoji(idx int, v TootEmoji)
 	// SetType sets an arbitrary type value to the specified index of the
 	// property "attributedTo". Invalidates all iterators. Returns an
 	// error if the type is not a valid one to set for this property.
 	// Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
+	// SetVcardAddress sets a Address value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetVcardAddress(idx int, v VcardAddress)
+	// SetVcardHome sets a Home value to be at the specified index for the
+	// property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetVcardHome(idx int, v VcardHome)
 	// Swap swaps the location of values at two indices for the "attributedTo"
 	// property.
 	Swap(i, j int)
@@ -59,7 +59,8 @@ type ActivityStreamsContentPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsContentPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsContent".
+	// Name returns the name of this property: "ActivityStreamsContent",
+	// prefixed by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsContentPropertyIterator
@@ -168,7 +169,8 @@ type ActivityStreamsContentProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsContentProperty) bool
-	// Name returns the name of this property: "content".
+	// Name returns the name of this property: "content", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "content".
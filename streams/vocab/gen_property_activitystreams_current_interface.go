@@ -101,7 +101,8 @@ type ActivityStreamsCurrentProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsCurrentProperty) bool
-	// Name returns the name of this property: "current".
+	// Name returns the name of this property: "current", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
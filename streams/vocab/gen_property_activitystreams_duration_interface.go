@@ -48,7 +48,8 @@ type ActivityStreamsDurationProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsDurationProperty) bool
-	// Name returns the name of this property: "duration".
+	// Name returns the name of this property: "duration", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
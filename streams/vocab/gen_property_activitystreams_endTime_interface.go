@@ -46,7 +46,8 @@ type ActivityStreamsEndTimeProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsEndTimeProperty) bool
-	// Name returns the name of this property: "endTime".
+	// Name returns the name of this property: "endTime", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
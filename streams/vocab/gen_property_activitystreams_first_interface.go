@@ -91,7 +91,8 @@ type ActivityStreamsFirstProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsFirstProperty) bool
-	// Name returns the name of this property: "first".
+	// Name returns the name of this property: "first", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -96,7 +96,8 @@ type ActivityStreamsFollowersProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsFollowersProperty) bool
-	// Name returns the name of this property: "followers".
+	// Name returns the name of this property: "followers", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -97,7 +97,8 @@ type ActivityStreamsFollowingProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsFollowingProperty) bool
-	// Name returns the name of this property: "following".
+	// Name returns the name of this property: "following", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
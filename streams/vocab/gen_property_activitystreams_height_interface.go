@@ -44,7 +44,8 @@ type ActivityStreamsHeightProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsHeightProperty) bool
-	// Name returns the name of this property: "height".
+	// Name returns the name of this property: "height", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -41,7 +41,8 @@ type ActivityStreamsHrefProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsHrefProperty) bool
-	// Name returns the name of this property: "href".
+	// Name returns the name of this property: "href", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -43,7 +43,8 @@ type ActivityStreamsHreflangProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsHreflangProperty) bool
-	// Name returns the name of this property: "hreflang".
+	// Name returns the name of this property: "hreflang", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
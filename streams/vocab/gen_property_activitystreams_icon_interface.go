@@ -55,7 +55,8 @@ type ActivityStreamsIconPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsIconPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsIcon".
+	// Name returns the name of this property: "ActivityStreamsIcon", prefixed
+	// by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsIconPropertyIterator
@@ -193,7 +194,8 @@ type ActivityStreamsIconProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsIconProperty) bool
-	// Name returns the name of this property: "icon".
+	// Name returns the name of this property: "icon", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// PrependActivityStreamsImage prepends a Image value to the front of a
 	// list of the property "icon". Invalidates all iterators.
@@ -39,7 +39,8 @@ type ActivityStreamsIdProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsIdProperty) bool
-	// Name returns the name of this property: "id".
+	// Name returns the name of this property: "id", prefixed by the alias of
+	// its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -55,7 +55,8 @@ type ActivityStreamsImagePropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsImagePropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsImage".
+	// Name returns the name of this property: "ActivityStreamsImage",
+	// prefixed by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsImagePropertyIterator
@@ -186,7 +187,8 @@ type ActivityStreamsImageProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsImageProperty) bool
-	// Name returns the name of this property: "image".
+	// Name returns the name of this property: "image", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// PrependActivityStreamsImage prepends a Image value to the front of a
 	// list of the property "image". Invalidates all iterators.
@@ -78,7 +78,8 @@ type ActivityStreamsInboxProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsInboxProperty) bool
-	// Name returns the name of this property: "inbox".
+	// Name returns the name of this property: "inbox", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -90,7 +90,8 @@ type ActivityStreamsLastProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsLastProperty) bool
-	// Name returns the name of this property: "last".
+	// Name returns the name of this property: "last", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -73,7 +73,8 @@ type ActivityStreamsLikesProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsLikesProperty) bool
-	// Name returns the name of this property: "likes".
+	// Name returns the name of this property: "likes", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -45,7 +45,8 @@ type ActivityStreamsMediaTypeProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsMediaTypeProperty) bool
-	// Name returns the name of this property: "mediaType".
+	// Name returns the name of this property: "mediaType", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
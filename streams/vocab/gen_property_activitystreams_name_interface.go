@@ -59,7 +59,8 @@ type ActivityStreamsNamePropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsNamePropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsName".
+	// Name returns the name of this property: "ActivityStreamsName", prefixed
+	// by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsNamePropertyIterator
@@ -157,7 +158,8 @@ type ActivityStreamsNameProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsNameProperty) bool
-	// Name returns the name of this property: "name".
+	// Name returns the name of this property: "name", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "name".
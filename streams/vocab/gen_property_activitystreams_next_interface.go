@@ -98,7 +98,8 @@ type ActivityStreamsNextProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsNextProperty) bool
-	// Name returns the name of this property: "next".
+	// Name returns the name of this property: "next", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -78,7 +78,8 @@ type ActivityStreamsOutboxProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsOutboxProperty) bool
-	// Name returns the name of this property: "outbox".
+	// Name returns the name of this property: "outbox", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
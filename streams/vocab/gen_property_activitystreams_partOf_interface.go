@@ -107,7 +107,8 @@ type ActivityStreamsPartOfProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsPartOfProperty) bool
-	// Name returns the name of this property: "partOf".
+	// Name returns the name of this property: "partOf", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
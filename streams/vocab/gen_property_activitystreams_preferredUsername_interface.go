@@ -63,7 +63,8 @@ type ActivityStreamsPreferredUsernameProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsPreferredUsernameProperty) bool
-	// Name returns the name of this property: "preferredUsername".
+	// Name returns the name of this property: "preferredUsername", prefixed
+	// by the alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -98,7 +98,8 @@ type ActivityStreamsPrevProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsPrevProperty) bool
-	// Name returns the name of this property: "prev".
+	// Name returns the name of this property: "prev", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -44,7 +44,8 @@ type ActivityStreamsPublishedProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsPublishedProperty) bool
-	// Name returns the name of this property: "published".
+	// Name returns the name of this property: "published", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -31,7 +31,8 @@ type ActivityStreamsRelPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsRelPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsRel".
+	// Name returns the name of this property: "ActivityStreamsRel", prefixed
+	// by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsRelPropertyIterator
@@ -113,7 +114,8 @@ type ActivityStreamsRelProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsRelProperty) bool
-	// Name returns the name of this property: "rel".
+	// Name returns the name of this property: "rel", prefixed by the alias of
+	// its vocabulary if it has one.
 	Name() string
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "rel".
@@ -92,7 +92,8 @@ type ActivityStreamsRepliesProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsRepliesProperty) bool
-	// Name returns the name of this property: "replies".
+	// Name returns the name of this property: "replies", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
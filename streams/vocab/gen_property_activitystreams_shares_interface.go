@@ -74,7 +74,8 @@ type ActivityStreamsSharesProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsSharesProperty) bool
-	// Name returns the name of this property: "shares".
+	// Name returns the name of this property: "shares", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
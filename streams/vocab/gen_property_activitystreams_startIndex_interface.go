@@ -53,7 +53,8 @@ type ActivityStreamsStartIndexProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsStartIndexProperty) bool
-	// Name returns the name of this property: "startIndex".
+	// Name returns the name of this property: "startIndex", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
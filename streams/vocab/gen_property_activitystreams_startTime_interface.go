@@ -46,7 +46,8 @@ type ActivityStreamsStartTimeProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsStartTimeProperty) bool
-	// Name returns the name of this property: "startTime".
+	// Name returns the name of this property: "startTime", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -71,7 +71,8 @@ type ActivityStreamsStreamsPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsStreamsPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsStreams".
+	// Name returns the name of this property: "ActivityStreamsStreams",
+	// prefixed by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsStreamsPropertyIterator
@@ -185,7 +186,8 @@ type ActivityStreamsStreamsProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsStreamsProperty) bool
-	// Name returns the name of this property: "streams".
+	// Name returns the name of this property: "streams", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// PrependActivityStreamsCollection prepends a Collection value to the
 	// front of a list of the property "streams". Invalidates all
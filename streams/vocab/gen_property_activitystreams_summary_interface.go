@@ -59,7 +59,8 @@ type ActivityStreamsSummaryPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsSummaryPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsSummary".
+	// Name returns the name of this property: "ActivityStreamsSummary",
+	// prefixed by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsSummaryPropertyIterator
@@ -158,7 +159,8 @@ type ActivityStreamsSummaryProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsSummaryProperty) bool
-	// Name returns the name of this property: "summary".
+	// Name returns the name of this property: "summary", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "summary".
@@ -54,7 +54,8 @@ type ActivityStreamsTotalItemsProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsTotalItemsProperty) bool
-	// Name returns the name of this property: "totalItems".
+	// Name returns the name of this property: "totalItems", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -43,7 +43,8 @@ type ActivityStreamsTypePropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsTypePropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsType".
+	// Name returns the name of this property: "ActivityStreamsType", prefixed
+	// by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsTypePropertyIterator
@@ -125,7 +126,8 @@ type ActivityStreamsTypeProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsTypeProperty) bool
-	// Name returns the name of this property: "type".
+	// Name returns the name of this property: "type", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "type".
@@ -57,7 +57,8 @@ type ActivityStreamsUnitsProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsUnitsProperty) bool
-	// Name returns the name of this property: "units".
+	// Name returns the name of this property: "units", prefixed by the alias
+	// of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -44,7 +44,8 @@ type ActivityStreamsUpdatedProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsUpdatedProperty) bool
-	// Name returns the name of this property: "updated".
+	// Name returns the name of this property: "updated", prefixed by the
+	// alias of its vocabulary if it has one.
 	Name() string
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
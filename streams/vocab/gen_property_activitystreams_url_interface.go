@@ -55,7 +55,8 @@ type ActivityStreamsUrlPropertyIterator interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsUrlPropertyIterator) bool
-	// Name returns the name of this property: "ActivityStreamsUrl".
+	// Name returns the name of this property: "ActivityStreamsUrl", prefixed
+	// by the alias of its vocabulary if it has one.
 	Name() string
 	// Next returns the next iterator, or nil if there is no next iterator.
 	Next() ActivityStreamsUrlPropertyIterator
@@ -189,7 +190,8 @@ type ActivityStreamsUrlProperty interface {
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
 	LessThan(o ActivityStreamsUrlProperty) bool
-	// Name returns the name of this property: "url".
+	// Name returns the name of this property: "url", prefixed by the alias of
+	// its vocabulary if it has one.
 	Name() string
 	// PrependActivityStreamsLink prepends a Link value to the front of a list
 	// of the property "url". Invalidates all iterators.
@@ -0,0 +1,131 @@
+package vocab
+
+import "net/url"
+
+// TootFocalPointPropertyIterator represents a single value for the "focalPoint"
+// property.
+type TootFocalPointPropertyIterator interface {
+	// Get returns the value of this property. When IsXMLSchemaFloat returns
+	// false, Get will return any arbitrary value.
+	Get() float64
+	// GetIRI returns the IRI of this property. When IsIRI returns false,
+	// GetIRI will return any arbitrary value.
+	GetIRI() *url.URL
+	// HasAny returns true if the value or IRI is set.
+	HasAny() bool
+	// IsIRI returns true if this property is an IRI.
+	IsIRI() bool
+	// IsXMLSchemaFloat returns true if this property is set and not an IRI.
+	IsXMLSchemaFloat() bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API detail only for folks looking to replace the
+	// go-fed implementation. Applications should not use this method.
+	KindIndex() int
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o TootFocalPointPropertyIterator) bool
+	// Name returns the name of this property: "TootFocalPoint", prefixed by
+	// the alias of its vocabulary if it has one.
+	Name() string
+	// Next returns the next iterator, or nil if there is no next iterator.
+	Next() TootFocalPointPropertyIterator
+	// Prev returns the previous iterator, or nil if there is no previous
+	// iterator.
+	Prev() TootFocalPointPropertyIterator
+	// Set sets the value of this property. Calling IsXMLSchemaFloat
+	// afterwards will return true.
+	Set(v float64)
+	// SetIRI sets the value of this property. Calling IsIRI afterwards will
+	// return true.
+	SetIRI(v *url.URL)
+}
+
+// The point of interest of the media, as the x and y coordinates from -1.0 to
+// 1.0, with (0, 0) at the center.
+type TootFocalPointProperty interface {
+	// AppendIRI appends an IRI value to the back of a list of the property
+	// "focalPoint"
+	AppendIRI(v *url.URL)
+	// AppendXMLSchemaFloat appends a float value to the back of a list of the
+	// property "focalPoint". Invalidates iterators that are traversing
+	// using Prev.
+	AppendXMLSchemaFloat(v float64)
+	// At returns the property value for the specified index. Panics if the
+	// index is out of bounds.
+	At(index int) TootFocalPointPropertyIterator
+	// Begin returns the first iterator, or nil if empty. Can be used with the
+	// iterator's Next method and this property's End method to iterate
+	// from front to back through all values.
+	Begin() TootFocalPointPropertyIterator
+	// Empty returns returns true if there are no elements.
+	Empty() bool
+	// End returns beyond-the-last iterator, which is nil. Can be used with
+	// the iterator's Next method and this property's Begin method to
+	// iterate from front to back through all values.
+	End() TootFocalPointPropertyIterator
+	// Insert inserts an IRI value at the specified index for a property
+	// "focalPoint". Existing elements at that index and higher are
+	// shifted back once. Invalidates all iterators.
+	InsertIRI(idx int, v *url.URL)
+	// InsertXMLSchemaFloat inserts a float value at the specified index for a
+	// property "focalPoint". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertXMLSchemaFloat(idx int, v float64)
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API method specifically needed only for alternate
+	// implementations for go-fed. Applications should not use this
+	// method. Panics if the index is out of bounds.
+	KindIndex(idx int) int
+	// Len returns the number of values that exist for the "focalPoint"
+	// property.
+	Len() (length int)
+	// Less computes whether another property is less than this one. Mixing
+	// types results in a consistent but arbitrary ordering
+	Less(i, j int) bool
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o TootFocalPointProperty) bool
+	// Name returns the name of this property: "focalPoint", prefixed by the
+	// alias of its vocabulary if it has one.
+	Name() string
+	// PrependIRI prepends an IRI value to the front of a list of the property
+	// "focalPoint".
+	PrependIRI(v *url.URL)
+	// PrependXMLSchemaFloat prepends a float value to the front of a list of
+	// the property "focalPoint". Invalidates all iterators.
+	PrependXMLSchemaFloat(v float64)
+	// Remove deletes an element at the specified index from a list of the
+	// property "focalPoint", regardless of its type. Panics if the index
+	// is out of bounds. Invalidates all iterators.
+	Remove(idx int)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format. Applications should not
+	// need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// Set sets a float value to be at the specified index for the property
+	// "focalPoint". Panics if the index is out of bounds. Invalidates all
+	// iterators.
+	Set(idx int, v float64)
+	// SetIRI sets an IRI value to be at the specified index for the property
+	// "focalPoint". Panics if the index is out of bounds.
+	SetIRI(idx int, v *url.URL)
+	// Swap swaps the location of values at two indices for the "focalPoint"
+	// property.
+	Swap(i, j int)
+}
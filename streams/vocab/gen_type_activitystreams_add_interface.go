@@ -151,6 +151,12 @@ type ActivityStreamsAdd interface {
 	// GetActivityStreamsUrl returns the "url" property if it exists, and nil
 	// otherwise.
 	GetActivityStreamsUrl() ActivityStreamsUrlProperty
+	// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTicketsTrackedBy() ForgeFedTicketsTrackedByProperty
+	// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTracksTicketsFor() ForgeFedTracksTicketsForProperty
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the Add type.
@@ -161,6 +167,12 @@ type ActivityStreamsAdd interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GetVcardBday returns the "bday" property if it exists, and nil
+	// otherwise.
+	GetVcardBday() VcardBdayProperty
+	// GetVcardHasAddress returns the "hasAddress" property if it exists, and
+	// nil otherwise.
+	GetVcardHasAddress() VcardHasAddressProperty
 	// IsExtending returns true if the Add type extends from the other type.
 	IsExtending(other Type) bool
 	// JSONLDContext returns the JSONLD URIs required in the context string
@@ -247,6 +259,14 @@ type ActivityStreamsAdd interface {
 	SetActivityStreamsUpdated(i ActivityStreamsUpdatedProperty)
 	// SetActivityStreamsUrl sets the "url" property.
 	SetActivityStreamsUrl(i ActivityStreamsUrlProperty)
+	// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+	SetForgeFedTicketsTrackedBy(i ForgeFedTicketsTrackedByProperty)
+	// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+	SetForgeFedTracksTicketsFor(i ForgeFedTracksTicketsForProperty)
+	// SetVcardBday sets the "bday" property.
+	SetVcardBday(i VcardBdayProperty)
+	// SetVcardHasAddress sets the "hasAddress" property.
+	SetVcardHasAddress(i VcardHasAddressProperty)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
@@ -122,6 +122,18 @@ type ActivityStreamsImage interface {
 	// GetActivityStreamsWidth returns the "width" property if it exists, and
 	// nil otherwise.
 	GetActivityStreamsWidth() ActivityStreamsWidthProperty
+	// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTicketsTrackedBy() ForgeFedTicketsTrackedByProperty
+	// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTracksTicketsFor() ForgeFedTracksTicketsForProperty
+	// GetTootBlurhash returns the "blurhash" property if it exists, and nil
+	// otherwise.
+	GetTootBlurhash() TootBlurhashProperty
+	// GetTootFocalPoint returns the "focalPoint" property if it exists, and
+	// nil otherwise.
+	GetTootFocalPoint() TootFocalPointProperty
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the Image type.
@@ -132,6 +144,12 @@ type ActivityStreamsImage interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GetVcardBday returns the "bday" property if it exists, and nil
+	// otherwise.
+	GetVcardBday() VcardBdayProperty
+	// GetVcardHasAddress returns the "hasAddress" property if it exists, and
+	// nil otherwise.
+	GetVcardHasAddress() VcardHasAddressProperty
 	// IsExtending returns true if the Image type extends from the other type.
 	IsExtending(other Type) bool
 	// JSONLDContext returns the JSONLD URIs required in the context string
@@ -212,6 +230,18 @@ type ActivityStreamsImage interface {
 	SetActivityStreamsUrl(i ActivityStreamsUrlProperty)
 	// SetActivityStreamsWidth sets the "width" property.
 	SetActivityStreamsWidth(i ActivityStreamsWidthProperty)
+	// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+	SetForgeFedTicketsTrackedBy(i ForgeFedTicketsTrackedByProperty)
+	// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+	SetForgeFedTracksTicketsFor(i ForgeFedTracksTicketsForProperty)
+	// SetTootBlurhash sets the "blurhash" property.
+	SetTootBlurhash(i TootBlurhashProperty)
+	// SetTootFocalPoint sets the "focalPoint" property.
+	SetTootFocalPoint(i TootFocalPointProperty)
+	// SetVcardBday sets the "bday" property.
+	SetVcardBday(i VcardBdayProperty)
+	// SetVcardHasAddress sets the "hasAddress" property.
+	SetVcardHasAddress(i VcardHasAddressProperty)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
@@ -125,6 +125,21 @@ type ActivityStreamsOrganization interface {
 	// GetActivityStreamsUrl returns the "url" property if it exists, and nil
 	// otherwise.
 	GetActivityStreamsUrl() ActivityStreamsUrlProperty
+	// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTicketsTrackedBy() ForgeFedTicketsTrackedByProperty
+	// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTracksTicketsFor() ForgeFedTracksTicketsForProperty
+	// GetSecurityV1PublicKey returns the "publicKey" property if it exists,
+	// and nil otherwise.
+	GetSecurityV1PublicKey() SecurityV1PublicKeyProperty
+	// GetTootDiscoverable returns the "discoverable" property if it exists,
+	// and nil otherwise.
+	GetTootDiscoverable() TootDiscoverableProperty
+	// GetTootFeatured returns the "featured" property if it exists, and nil
+	// otherwise.
+	GetTootFeatured() TootFeaturedProperty
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the
@@ -135,6 +150,12 @@ type ActivityStreamsOrganization interface {
 	// their LessThan implementation, but routine ActivityPub applications
 	// should not use this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GetVcardBday returns the "bday" property if it exists, and nil
+	// otherwise.
+	GetVcardBday() VcardBdayProperty
+	// GetVcardHasAddress returns the "hasAddress" property if it exists, and
+	// nil otherwise.
+	GetVcardHasAddress() VcardHasAddressProperty
 	// IsExtending returns true if the Organization type extends from the
 	// other type.
 	IsExtending(other Type) bool
@@ -227,6 +248,20 @@ type ActivityStreamsOrganization interface {
 	SetActivityStreamsUpdated(i ActivityStreamsUpdatedProperty)
 	// SetActivityStreamsUrl sets the "url" property.
 	SetActivityStreamsUrl(i ActivityStreamsUrlProperty)
+	// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+	SetForgeFedTicketsTrackedBy(i ForgeFedTicketsTrackedByProperty)
+	// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+	SetForgeFedTracksTicketsFor(i ForgeFedTracksTicketsForProperty)
+	// SetSecurityV1PublicKey sets the "publicKey" property.
+	SetSecurityV1PublicKey(i SecurityV1PublicKeyProperty)
+	// SetTootDiscoverable sets the "discoverable" property.
+	SetTootDiscoverable(i TootDiscoverableProperty)
+	// SetTootFeatured sets the "featured" property.
+	SetTootFeatured(i TootFeaturedProperty)
+	// SetVcardBday sets the "bday" property.
+	SetVcardBday(i VcardBdayProperty)
+	// SetVcardHasAddress sets the "hasAddress" property.
+	SetVcardHasAddress(i VcardHasAddressProperty)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
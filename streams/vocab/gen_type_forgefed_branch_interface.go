@@ -0,0 +1,224 @@
+package vocab
+
+// Represents a named variable reference to a version of the Repository, typically
+// used for committing changes in parallel to other development, and usually
+// eventually merging the changes into the main history line.
+//
+// Example 3 (https://forgefed.org/spec/#ex3-jsonld):
+//   {
+//     "context": "https://example.dev/luke/myrepo",
+//     "name": "master",
+//     "ref": "refs/heads/master",
+//     "type": "Branch"
+//   }
+type ForgeFedBranch interface {
+	// GetActivityStreamsAltitude returns the "altitude" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsAltitude() ActivityStreamsAltitudeProperty
+	// GetActivityStreamsAttachment returns the "attachment" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsAttachment() ActivityStreamsAttachmentProperty
+	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
+	// it exists, and nil otherwise.
+	GetActivityStreamsAttributedTo() ActivityStreamsAttributedToProperty
+	// GetActivityStreamsAudience returns the "audience" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsAudience() ActivityStreamsAudienceProperty
+	// GetActivityStreamsBcc returns the "bcc" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsBcc() ActivityStreamsBccProperty
+	// GetActivityStreamsBto returns the "bto" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsBto() ActivityStreamsBtoProperty
+	// GetActivityStreamsCc returns the "cc" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsCc() ActivityStreamsCcProperty
+	// GetActivityStreamsContent returns the "content" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsContent() ActivityStreamsContentProperty
+	// GetActivityStreamsContext returns the "context" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsContext() ActivityStreamsContextProperty
+	// GetActivityStreamsDuration returns the "duration" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsDuration() ActivityStreamsDurationProperty
+	// GetActivityStreamsEndTime returns the "endTime" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsEndTime() ActivityStreamsEndTimeProperty
+	// GetActivityStreamsGenerator returns the "generator" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsGenerator() ActivityStreamsGeneratorProperty
+	// GetActivityStreamsIcon returns the "icon" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsIcon() ActivityStreamsIconProperty
+	// GetActivityStreamsId returns the "id" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsId() ActivityStreamsIdProperty
+	// GetActivityStreamsImage returns the "image" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsImage() ActivityStreamsImageProperty
+	// GetActivityStreamsInReplyTo returns the "inReplyTo" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsInReplyTo() ActivityStreamsInReplyToProperty
+	// GetActivityStreamsLikes returns the "likes" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsLikes() ActivityStreamsLikesProperty
+	// GetActivityStreamsLocation returns the "location" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsLocation() ActivityStreamsLocationProperty
+	// GetActivityStreamsMediaType returns the "mediaType" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsMediaType() ActivityStreamsMediaTypeProperty
+	// GetActivityStreamsName returns the "name" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsName() ActivityStreamsNameProperty
+	// GetActivityStreamsObject returns the "object" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsObject() ActivityStreamsObjectProperty
+	// GetActivityStreamsPreview returns the "preview" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsPreview() ActivityStreamsPreviewProperty
+	// GetActivityStreamsPublished returns the "published" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsPublished() ActivityStreamsPublishedProperty
+	// GetActivityStreamsReplies returns the "replies" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsReplies() ActivityStreamsRepliesProperty
+	// GetActivityStreamsShares returns the "shares" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsShares() ActivityStreamsSharesProperty
+	// GetActivityStreamsStartTime returns the "startTime" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsStartTime() ActivityStreamsStartTimeProperty
+	// GetActivityStreamsSummary returns the "summary" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsSummary() ActivityStreamsSummaryProperty
+	// GetActivityStreamsTag returns the "tag" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsTag() ActivityStreamsTagProperty
+	// GetActivityStreamsTo returns the "to" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsTo() ActivityStreamsToProperty
+	// GetActivityStreamsType returns the "type" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsType() ActivityStreamsTypeProperty
+	// GetActivityStreamsUpdated returns the "updated" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsUpdated() ActivityStreamsUpdatedProperty
+	// GetActivityStreamsUrl returns the "url" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsUrl() ActivityStreamsUrlProperty
+	// GetForgeFedRef returns the "ref" property if it exists, and nil
+	// otherwise.
+	GetForgeFedRef() ForgeFedRefProperty
+	// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTicketsTrackedBy() ForgeFedTicketsTrackedByProperty
+	// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if
+	// it exists, and nil otherwise.
+	GetForgeFedTracksTicketsFor() ForgeFedTracksTicketsForProperty
+	// GetTypeName returns the name of this type.
+	GetTypeName() string
+	// GetUnknownProperties returns the unknown properties for the Branch
+	// type. Note that this should not be used by app developers. It is
+	// only used to help determine which implementation is LessThan the
+	// other. Developers who are creating a different implementation of
+	// this type's interface can use this method in their LessThan
+	// implementation, but routine ActivityPub applications should not use
+	// this to bypass the code generation tool.
+	GetUnknownProperties() map[string]interface{}
+	// GetVcardBday returns the "bday" property if it exists, and nil
+	// otherwise.
+	GetVcardBday() VcardBdayProperty
+	// GetVcardHasAddress returns the "hasAddress" property if it exists, and
+	// nil otherwise.
+	GetVcardHasAddress() VcardHasAddressProperty
+	// IsExtending returns true if the Branch type extends from the other type.
+	IsExtending(other Type) bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this type and the specific properties that are set. The value
+	// in the map is the alias used to import the type and its properties.
+	JSONLDContext() map[string]string
+	// LessThan computes if this Branch is lesser, with an arbitrary but
+	// stable determination.
+	LessThan(o ForgeFedBranch) bool
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format.
+	Serialize() (map[string]interface{}, error)
+	// SetActivityStreamsAltitude sets the "altitude" property.
+	SetActivityStreamsAltitude(i ActivityStreamsAltitudeProperty)
+	// SetActivityStreamsAttachment sets the "attachment" property.
+	SetActivityStreamsAttachment(i ActivityStreamsAttachmentProperty)
+	// SetActivityStreamsAttributedTo sets the "attributedTo" property.
+	SetActivityStreamsAttributedTo(i ActivityStreamsAttributedToProperty)
+	// SetActivityStreamsAudience sets the "audience" property.
+	SetActivityStreamsAudience(i ActivityStreamsAudienceProperty)
+	// SetActivityStreamsBcc sets the "bcc" property.
+	SetActivityStreamsBcc(i ActivityStreamsBccProperty)
+	// SetActivityStreamsBto sets the "bto" property.
+	SetActivityStreamsBto(i ActivityStreamsBtoProperty)
+	// SetActivityStreamsCc sets the "cc" property.
+	SetActivityStreamsCc(i ActivityStreamsCcProperty)
+	// SetActivityStreamsContent sets the "content" property.
+	SetActivityStreamsContent(i ActivityStreamsContentProperty)
+	// SetActivityStreamsContext sets the "context" property.
+	SetActivityStreamsContext(i ActivityStreamsContextProperty)
+	// SetActivityStreamsDuration sets the "duration" property.
+	SetActivityStreamsDuration(i ActivityStreamsDurationProperty)
+	// SetActivityStreamsEndTime sets the "endTime" property.
+	SetActivityStreamsEndTime(i ActivityStreamsEndTimeProperty)
+	// SetActivityStreamsGenerator sets the "generator" property.
+	SetActivityStreamsGenerator(i ActivityStreamsGeneratorProperty)
+	// SetActivityStreamsIcon sets the "icon" property.
+	SetActivityStreamsIcon(i ActivityStreamsIconProperty)
+	// SetActivityStreamsId sets the "id" property.
+	SetActivityStreamsId(i ActivityStreamsIdProperty)
+	// SetActivityStreamsImage sets the "image" property.
+	SetActivityStreamsImage(i ActivityStreamsImageProperty)
+	// SetActivityStreamsInReplyTo sets the "inReplyTo" property.
+	SetActivityStreamsInReplyTo(i ActivityStreamsInReplyToProperty)
+	// SetActivityStreamsLikes sets the "likes" property.
+	SetActivityStreamsLikes(i ActivityStreamsLikesProperty)
+	// SetActivityStreamsLocation sets the "location" property.
+	SetActivityStreamsLocation(i ActivityStreamsLocationProperty)
+	// SetActivityStreamsMediaType sets the "mediaType" property.
+	SetActivityStreamsMediaType(i ActivityStreamsMediaTypeProperty)
+	// SetActivityStreamsName sets the "name" property.
+	SetActivityStreamsName(i ActivityStreamsNameProperty)
+	// SetActivityStreamsObject sets the "object" property.
+	SetActivityStreamsObject(i ActivityStreamsObjectProperty)
+	// SetActivityStreamsPreview sets the "preview" property.
+	SetActivityStreamsPreview(i ActivityStreamsPreviewProperty)
+	// SetActivityStreamsPublished sets the "published" property.
+	SetActivityStreamsPublished(i ActivityStreamsPublishedProperty)
+	// SetActivityStreamsReplies sets the "replies" property.
+	SetActivityStreamsReplies(i ActivityStreamsRepliesProperty)
+	// SetActivityStreamsShares sets the "shares" property.
+	SetActivityStreamsShares(i ActivityStreamsSharesProperty)
+	// SetActivityStreamsStartTime sets the "startTime" property.
+	SetActivityStreamsStartTime(i ActivityStreamsStartTimeProperty)
+	// SetActivityStreamsSummary sets the "summary" property.
+	SetActivityStreamsSummary(i ActivityStreamsSummaryProperty)
+	// SetActivityStreamsTag sets the "tag" property.
+	SetActivityStreamsTag(i ActivityStreamsTagProperty)
+	// SetActivityStreamsTo sets the "to" property.
+	SetActivityStreamsTo(i ActivityStreamsToProperty)
+	// SetActivityStreamsType sets the "type" property.
+	SetActivityStreamsType(i ActivityStreamsTypeProperty)
+	// SetActivityStreamsUpdated sets the "updated" property.
+	SetActivityStreamsUpdated(i ActivityStreamsUpdatedProperty)
+	// SetActivityStreamsUrl sets the "url" property.
+	SetActivityStreamsUrl(i ActivityStreamsUrlProperty)
+	// SetForgeFedRef sets the "ref" property.
+	SetForgeFedRef(i ForgeFedRefProperty)
+	// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+	SetForgeFedTicketsTrackedBy(i ForgeFedTicketsTrackedByProperty)
+	// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+	SetForgeFedTracksTicketsFor(i ForgeFedTracksTicketsForProperty)
+	// SetVcardBday sets the "bday" property.
+	SetVcardBday(i VcardBdayProperty)
+	// SetVcardHasAddress sets the "hasAddress" property.
+	SetVcardHasAddress(i VcardHasAddressProperty)
+	// VocabularyURI returns the vocabulary's URI as a string.
+	VocabularyURI() string
+}